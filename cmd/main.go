@@ -6,12 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"olidesk-api-2/internal/domains"
 	"olidesk-api-2/internal/handlers"
 	"olidesk-api-2/internal/handlers/spec"
 	"olidesk-api-2/internal/repository"
+	"olidesk-api-2/internal/storage"
 	"olidesk-api-2/internal/usecase"
 	"olidesk-api-2/internal/utils/config"
 	"olidesk-api-2/internal/utils/logger"
+	"olidesk-api-2/internal/worker"
 	"os/signal"
 	"syscall"
 	"time"
@@ -80,12 +83,83 @@ func run(ctx context.Context) (err error) {
 	ur := repository.NewPostgresUsersRepository(pool)
 	cr := repository.NewPostgresClientsRepository(pool)
 	fr := repository.NewPostgresFormRepository(pool)
+	ctr := repository.NewPostgresContractsRepository(pool)
+	cfr := repository.NewPostgresCustomFieldsRepository(pool)
+	pr := repository.NewPostgresPortalRepository(pool)
+	ar := repository.NewPostgresAttachmentRepository(pool)
+	cmr := repository.NewPostgresCommentRepository(pool)
+	wlr := repository.NewPostgresWorkLogRepository(pool)
+	sgr := repository.NewPostgresSignatureRepository(pool)
+	sor := repository.NewPostgresServiceOrderTemplateRepository(pool)
+	slr := repository.NewPostgresSLARepository(pool)
+	nr := repository.NewPostgresNotificationRepository(pool)
+	mr := repository.NewPostgresMaintenanceRepository(pool)
+	scr := repository.NewPostgresScheduleRepository(pool)
+	ptr := repository.NewPostgresPartRepository(pool)
+	ir := repository.NewPostgresInvoiceRepository(pool)
+	sfr := repository.NewPostgresSimilarFormRepository(pool)
+	clr := repository.NewPostgresChecklistRepository(pool)
+	ftr := repository.NewPostgresFormTypeRepository(pool)
+	svr := repository.NewPostgresSurveyRepository(pool)
+	dpr := repository.NewPostgresDispatchRepository(pool)
+
+	businessHours, err := domains.ParseBusinessHours(cfg.SLA.Timezone, cfg.SLA.BusinessStart, cfg.SLA.BusinessEnd, cfg.SLA.Workdays)
+	if err != nil {
+		return fmt.Errorf("sla business hours: %w", err)
+	}
 
-	us := usecase.NewUserService(ur, l, mailer)
-	cs := usecase.NewClientService(cr, l)
-	fs := usecase.NewFormService(fr, l)
+	store, err := storage.New(storage.Config{
+		Backend:        cfg.Storage.Backend,
+		LocalDir:       cfg.Storage.LocalDir,
+		PublicURL:      cfg.Server.ServerUrl,
+		SigningKey:     cfg.Storage.SigningKey,
+		S3Endpoint:     cfg.Storage.S3Endpoint,
+		S3Region:       cfg.Storage.S3Region,
+		S3Bucket:       cfg.Storage.S3Bucket,
+		S3AccessKey:    cfg.Storage.S3AccessKey,
+		S3SecretKey:    cfg.Storage.S3SecretKey,
+		S3UsePathStyle: cfg.Storage.S3UsePathStyle,
+	})
+	if err != nil {
+		return err
+	}
 
-	si := handlers.NewHandlers(l, us, cs, fs)
+	us := usecase.NewUserService(ur, l, mailer)
+	cs := usecase.NewClientService(cr, cfr, l)
+	fs := usecase.NewFormService(fr, ctr, cfr, slr, cr, clr, ftr, dpr, businessHours, l)
+	ctrs := usecase.NewContractService(ctr, cr, l)
+	cfs := usecase.NewCustomFieldService(cfr, l)
+	ps := usecase.NewPortalService(pr, cr, fr, l)
+	as := usecase.NewAttachmentService(ar, fr, store, cfg.Storage.URLTTL, l)
+	cms := usecase.NewCommentService(cmr, fr, ar, l)
+	wls := usecase.NewWorkLogService(wlr, fr, l)
+	sgs := usecase.NewSignatureService(sgr, fr, store, cfg.Storage.URLTTL, l)
+	sos := usecase.NewServiceOrderService(sor, fr, cr, sgr, store, cfg.Storage.URLTTL, l)
+	sls := usecase.NewSLAService(slr, l)
+	ns := usecase.NewNotificationService(nr, l)
+	ms := usecase.NewMaintenanceService(mr, fr, ctr, slr, cr, businessHours, cfg.Maintenance.Horizon, l)
+	scs := usecase.NewScheduleService(scr, fr, slr, businessHours, l)
+	pts := usecase.NewPartService(ptr, fr, l)
+	is := usecase.NewInvoiceService(ir, fr, ptr, cr, sor, store, l)
+	sfs := usecase.NewSimilarFormService(sfr, fr, l)
+	cls := usecase.NewChecklistService(clr, fr, ar, l)
+	fts := usecase.NewFormTypeService(ftr, l)
+	svs := usecase.NewSurveyService(svr, fr, mailer, cfg.Survey.From, cfg.Server.FrontendUrl, cfg.Survey.TTL, businessHours.Location, l)
+	dps := usecase.NewDispatchService(dpr, l)
+
+	si := handlers.NewHandlers(l, us, cs, fs, ctrs, cfs, ps, as, cms, wls, sgs, sos, sls, ns, ms, scs, pts, is, sfs, cls, fts, svs, dps)
+
+	// O monitor de SLA e o agendador de manutenções rodam no mesmo processo e
+	// param junto com o servidor.
+	go worker.NewSLAMonitor(sls, cfg.SLA.CheckInterval, l.Named("sla_monitor")).Run(ctx)
+	go worker.NewMaintenanceScheduler(ms, cfg.Maintenance.Interval, l.Named("maintenance_scheduler")).Run(ctx)
+
+	// Sem remetente ou chave do Resend as pesquisas de satisfação ficam na fila.
+	if cfg.ResendAPIKey != "" && cfg.Survey.From != "" {
+		go worker.NewSurveyDispatcher(svs, cfg.Survey.Interval, l.Named("survey_dispatcher")).Run(ctx)
+	} else {
+		l.Warn("satisfaction surveys disabled: RESEND_API_KEY or SURVEY_FROM not set")
+	}
 
 	r.Get("/docs", func(w http.ResponseWriter, r *http.Request) {
 		// Generate beautiful docs from your OpenAPI spec
@@ -100,6 +174,11 @@ func run(ctx context.Context) (err error) {
 	})
 	r.Mount("/api", spec.Handler(&si, spec.WithRouter(protectedRouter)))
 
+	// No backend local os downloads assinados são servidos pela própria API.
+	if local, ok := store.(*storage.Local); ok {
+		r.Handle(storage.LocalRoutePrefix+"*", local.Handler())
+	}
+
 	srv := &http.Server{
 		Addr:         fmt.Sprintf(":%s", cfg.Port),
 		Handler:      r,
@@ -0,0 +1,99 @@
+package domains
+
+import (
+	"path"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// MaxAttachmentSize é o tamanho máximo de um anexo (20 MiB).
+const MaxAttachmentSize = 20 << 20
+
+// allowedAttachmentTypes lista os tipos aceitos, identificados pelo conteúdo
+// do arquivo e não pela extensão ou pelo cabeçalho enviado pelo cliente.
+var allowedAttachmentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+	"text/plain":      true,
+}
+
+// Attachment é um arquivo (foto, laudo) anexado a um atendimento. O conteúdo
+// fica no storage sob StorageKey; Checksum é o SHA-256 em hexadecimal.
+type Attachment struct {
+	ID             uuid.UUID `json:"id"`
+	FormID         uuid.UUID `json:"form_id"`
+	FileName       string    `json:"file_name"`
+	ContentType    string    `json:"content_type"`
+	Size           int64     `json:"size"`
+	Checksum       string    `json:"checksum"`
+	StorageKey     string    `json:"storage_key"`
+	UploadedBy     uuid.UUID `json:"uploaded_by"`
+	UploadedByName string    `json:"uploaded_by_name"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func IsAllowedAttachmentType(contentType string) bool {
+	return allowedAttachmentTypes[contentType]
+}
+
+// AttachmentStorageKey monta a chave do arquivo no storage. O nome original
+// não entra na chave para evitar caracteres problemáticos e colisões.
+func AttachmentStorageKey(formID, attachmentID uuid.UUID) string {
+	return "forms/" + formID.String() + "/attachments/" + attachmentID.String()
+}
+
+// SanitizeFileName remove diretórios e caracteres de controle do nome enviado
+// e limita o tamanho a 255 bytes, preservando a extensão quando possível.
+func SanitizeFileName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = path.Base(name)
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "." || name == "/" || name == ".." {
+		return ""
+	}
+
+	if len(name) > 255 {
+		ext := path.Ext(name)
+		if len(ext) > 16 {
+			ext = ""
+		}
+		base := name[:255-len(ext)]
+		for !utf8.ValidString(base) {
+			base = base[:len(base)-1]
+		}
+		name = base + ext
+	}
+	return name
+}
+
+func (a *Attachment) Validate() error {
+	if a.FileName == "" {
+		return ErrInvalidAttachmentName
+	}
+	if a.Size <= 0 {
+		return ErrEmptyAttachment
+	}
+	if a.Size > MaxAttachmentSize {
+		return ErrAttachmentTooLarge
+	}
+	if !IsAllowedAttachmentType(a.ContentType) {
+		return ErrAttachmentTypeNotAllowed
+	}
+	if len(a.Checksum) != 64 {
+		return ErrInvalidAttachmentChecksum
+	}
+	return nil
+}
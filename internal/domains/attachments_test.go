@@ -0,0 +1,82 @@
+package domains
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachment_Validate(t *testing.T) {
+	checksum := strings.Repeat("a", 64)
+
+	tests := []struct {
+		name        string
+		attachment  Attachment
+		expectedErr error
+	}{
+		{
+			name:       "valid photo",
+			attachment: Attachment{FormID: uuid.New(), FileName: "antes.jpg", ContentType: "image/jpeg", Size: 1024, Checksum: checksum},
+		},
+		{
+			name:       "valid pdf at max size",
+			attachment: Attachment{FormID: uuid.New(), FileName: "laudo.pdf", ContentType: "application/pdf", Size: MaxAttachmentSize, Checksum: checksum},
+		},
+		{
+			name:        "invalid - empty name",
+			attachment:  Attachment{FormID: uuid.New(), ContentType: "image/png", Size: 10, Checksum: checksum},
+			expectedErr: ErrInvalidAttachmentName,
+		},
+		{
+			name:        "invalid - empty file",
+			attachment:  Attachment{FormID: uuid.New(), FileName: "vazio.txt", ContentType: "text/plain", Checksum: checksum},
+			expectedErr: ErrEmptyAttachment,
+		},
+		{
+			name:        "invalid - too large",
+			attachment:  Attachment{FormID: uuid.New(), FileName: "video.pdf", ContentType: "application/pdf", Size: MaxAttachmentSize + 1, Checksum: checksum},
+			expectedErr: ErrAttachmentTooLarge,
+		},
+		{
+			name:        "invalid - executable",
+			attachment:  Attachment{FormID: uuid.New(), FileName: "foto.jpg", ContentType: "application/octet-stream", Size: 10, Checksum: checksum},
+			expectedErr: ErrAttachmentTypeNotAllowed,
+		},
+		{
+			name:        "invalid - checksum",
+			attachment:  Attachment{FormID: uuid.New(), FileName: "foto.jpg", ContentType: "image/jpeg", Size: 10, Checksum: "abc"},
+			expectedErr: ErrInvalidAttachmentChecksum,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.attachment.Validate()
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSanitizeFileName(t *testing.T) {
+	assert.Equal(t, "foto.jpg", SanitizeFileName("../../etc/foto.jpg"))
+	assert.Equal(t, "laudo.pdf", SanitizeFileName(`C:\Users\tecnico\laudo.pdf`))
+	assert.Equal(t, "impressora sala 2.png", SanitizeFileName(" impressora\x00 sala 2.png "))
+	assert.Equal(t, "", SanitizeFileName(".."))
+	assert.Equal(t, "", SanitizeFileName(""))
+
+	long := SanitizeFileName(strings.Repeat("ã", 200) + ".jpeg")
+	assert.LessOrEqual(t, len(long), 255)
+	assert.True(t, strings.HasSuffix(long, ".jpeg"))
+}
+
+func TestAttachmentStorageKey(t *testing.T) {
+	formID := uuid.MustParse("0190a000-0000-7000-8000-000000000001")
+	id := uuid.MustParse("0190a000-0000-7000-8000-000000000002")
+	assert.Equal(t, "forms/0190a000-0000-7000-8000-000000000001/attachments/0190a000-0000-7000-8000-000000000002", AttachmentStorageKey(formID, id))
+}
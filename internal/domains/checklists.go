@@ -0,0 +1,270 @@
+package domains
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de item da lista de verificação. Marcação é o item simples dos planos
+// de manutenção; os modelos usam os demais tipos.
+const (
+	ChecklistKindCheck    = "marcacao"
+	ChecklistKindPassFail = "aprovacao"
+	ChecklistKindNumber   = "numero"
+	ChecklistKindText     = "texto"
+	ChecklistKindPhoto    = "foto"
+)
+
+const (
+	MaxChecklistTemplateNameLength        = 100
+	MaxChecklistTemplateDescriptionLength = 500
+	MaxChecklistUnitLength                = 20
+	MaxChecklistTextAnswerLength          = 2000
+)
+
+// ChecklistTemplateItem é um item do modelo. Unidade e faixa (Min e Max,
+// opcionais) valem só para itens numéricos.
+type ChecklistTemplateItem struct {
+	Label    string   `json:"label"`
+	Kind     string   `json:"kind"`
+	Required bool     `json:"required"`
+	Unit     string   `json:"unit"`
+	Min      *float64 `json:"min"`
+	Max      *float64 `json:"max"`
+}
+
+// ChecklistTemplate é a lista de verificação padrão de um tipo de serviço,
+// copiada para o atendimento na abertura. Alterar o modelo não muda os
+// atendimentos já abertos.
+type ChecklistTemplate struct {
+	ID          uuid.UUID               `json:"id"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Items       []ChecklistTemplateItem `json:"items"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+// Normalize remove os espaços das pontas dos textos do modelo.
+func (t *ChecklistTemplate) Normalize() {
+	t.Name = strings.TrimSpace(t.Name)
+	t.Description = strings.TrimSpace(t.Description)
+	for i := range t.Items {
+		t.Items[i].Label = strings.TrimSpace(t.Items[i].Label)
+		t.Items[i].Unit = strings.TrimSpace(t.Items[i].Unit)
+	}
+}
+
+func (t *ChecklistTemplate) Validate() error {
+	if t.Name == "" || utf8.RuneCountInString(t.Name) > MaxChecklistTemplateNameLength ||
+		utf8.RuneCountInString(t.Description) > MaxChecklistTemplateDescriptionLength {
+		return ErrInvalidChecklistTemplate
+	}
+	if len(t.Items) == 0 || len(t.Items) > MaxChecklistItems {
+		return ErrInvalidChecklistTemplate
+	}
+	for _, item := range t.Items {
+		if err := item.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i ChecklistTemplateItem) validate() error {
+	if i.Label == "" || utf8.RuneCountInString(i.Label) > MaxChecklistItemLength {
+		return ErrInvalidChecklistTemplate
+	}
+	switch i.Kind {
+	case ChecklistKindPassFail, ChecklistKindText, ChecklistKindPhoto:
+		if i.Unit != "" || i.Min != nil || i.Max != nil {
+			return ErrInvalidChecklistTemplate
+		}
+	case ChecklistKindNumber:
+		if utf8.RuneCountInString(i.Unit) > MaxChecklistUnitLength {
+			return ErrInvalidChecklistTemplate
+		}
+		if !isFinite(i.Min) || !isFinite(i.Max) || (i.Min != nil && i.Max != nil && *i.Min > *i.Max) {
+			return ErrInvalidChecklistTemplate
+		}
+	default:
+		return ErrInvalidChecklistTemplate
+	}
+	return nil
+}
+
+// NewChecklist copia os itens do modelo para o atendimento formID.
+func (t *ChecklistTemplate) NewChecklist(formID uuid.UUID) []*ChecklistItem {
+	items := make([]*ChecklistItem, 0, len(t.Items))
+	for i, item := range t.Items {
+		items = append(items, &ChecklistItem{
+			FormID:     formID,
+			TemplateID: t.ID,
+			Position:   i + 1,
+			Label:      item.Label,
+			Kind:       item.Kind,
+			Required:   item.Required,
+			Unit:       item.Unit,
+			Min:        item.Min,
+			Max:        item.Max,
+		})
+	}
+	return items
+}
+
+// ChecklistItem é um item da lista de verificação de um atendimento. Done
+// indica que o item foi respondido; a resposta fica no campo do tipo do item
+// (Passed, Number, Text ou AttachmentID).
+type ChecklistItem struct {
+	ID         uuid.UUID `json:"id"`
+	FormID     uuid.UUID `json:"form_id"`
+	TemplateID uuid.UUID `json:"template_id"`
+	Position   int       `json:"position"`
+	Label      string    `json:"label"`
+	Kind       string    `json:"kind"`
+	Required   bool      `json:"required"`
+	Unit       string    `json:"unit"`
+	Min        *float64  `json:"min"`
+	Max        *float64  `json:"max"`
+
+	Done         bool      `json:"done"`
+	Passed       *bool     `json:"passed"`
+	Number       *float64  `json:"number"`
+	Text         string    `json:"text"`
+	AttachmentID uuid.UUID `json:"attachment_id"`
+	// OutOfRange marca leituras numéricas fora da faixa do item.
+	OutOfRange bool      `json:"out_of_range"`
+	DoneBy     uuid.UUID `json:"done_by"`
+	DoneAt     time.Time `json:"done_at"`
+}
+
+// ChecklistAnswer é a resposta de um item: Done para marcações, Passed para
+// aprovações, Number para leituras, Text para textos e AttachmentID para
+// fotos. Done falso sem outra resposta limpa o item.
+type ChecklistAnswer struct {
+	Done         *bool
+	Passed       *bool
+	Number       *float64
+	Text         *string
+	AttachmentID uuid.UUID
+}
+
+func (a ChecklistAnswer) clears() bool {
+	return a.Done != nil && !*a.Done && a.Passed == nil && a.Number == nil && a.Text == nil && a.AttachmentID == uuid.Nil
+}
+
+// Mark marca ou desmarca o item; ao desmarcar, o autor, a data e a resposta
+// são limpos.
+func (i *ChecklistItem) Mark(done bool, by uuid.UUID, at time.Time) {
+	i.Done = done
+	if !done {
+		i.DoneBy, i.DoneAt = uuid.Nil, time.Time{}
+		i.Passed, i.Number, i.Text, i.AttachmentID, i.OutOfRange = nil, nil, "", uuid.Nil, false
+		return
+	}
+	i.DoneBy, i.DoneAt = by, at
+}
+
+// Answer grava a resposta do item conforme o tipo e marca as leituras fora da
+// faixa. A foto deve ser um anexo do atendimento, conferido por quem chama.
+func (i *ChecklistItem) Answer(a ChecklistAnswer, by uuid.UUID, at time.Time) error {
+	if a.clears() {
+		i.Mark(false, by, at)
+		return nil
+	}
+	if a.Done != nil && !*a.Done {
+		return ErrInvalidChecklistAnswer
+	}
+
+	var (
+		passed *bool
+		number *float64
+		text   string
+	)
+	switch i.Kind {
+	case ChecklistKindCheck:
+		if a.Done == nil || a.Passed != nil || a.Number != nil || a.Text != nil || a.AttachmentID != uuid.Nil {
+			return ErrInvalidChecklistAnswer
+		}
+	case ChecklistKindPassFail:
+		if a.Passed == nil || a.Number != nil || a.Text != nil || a.AttachmentID != uuid.Nil {
+			return ErrInvalidChecklistAnswer
+		}
+		passed = a.Passed
+	case ChecklistKindNumber:
+		if a.Number == nil || !isFinite(a.Number) || a.Passed != nil || a.Text != nil || a.AttachmentID != uuid.Nil {
+			return ErrInvalidChecklistAnswer
+		}
+		number = a.Number
+	case ChecklistKindText:
+		if a.Text == nil || a.Passed != nil || a.Number != nil || a.AttachmentID != uuid.Nil {
+			return ErrInvalidChecklistAnswer
+		}
+		text = strings.TrimSpace(*a.Text)
+		if text == "" || utf8.RuneCountInString(text) > MaxChecklistTextAnswerLength {
+			return ErrInvalidChecklistAnswer
+		}
+	case ChecklistKindPhoto:
+		if a.AttachmentID == uuid.Nil || a.Passed != nil || a.Number != nil || a.Text != nil {
+			return ErrInvalidChecklistAnswer
+		}
+	default:
+		return ErrInvalidChecklistAnswer
+	}
+
+	i.Passed, i.Number, i.Text, i.AttachmentID = passed, number, text, a.AttachmentID
+	i.OutOfRange = i.outOfRange()
+	i.Mark(true, by, at)
+	return nil
+}
+
+func (i *ChecklistItem) outOfRange() bool {
+	if i.Number == nil {
+		return false
+	}
+	return (i.Min != nil && *i.Number < *i.Min) || (i.Max != nil && *i.Number > *i.Max)
+}
+
+// Answered informa se o item tem resposta. Uma foto excluída dos anexos deixa
+// o item pendente de novo.
+func (i *ChecklistItem) Answered() bool {
+	return i.Done && (i.Kind != ChecklistKindPhoto || i.AttachmentID != uuid.Nil)
+}
+
+// PendingRequiredItems devolve os itens obrigatórios ainda sem resposta.
+func PendingRequiredItems(items []*ChecklistItem) []*ChecklistItem {
+	pending := make([]*ChecklistItem, 0)
+	for _, item := range items {
+		if item.Required && !item.Answered() {
+			pending = append(pending, item)
+		}
+	}
+	return pending
+}
+
+// CheckChecklistComplete impede resolver o atendimento com itens obrigatórios
+// sem resposta.
+func CheckChecklistComplete(items []*ChecklistItem) error {
+	if len(PendingRequiredItems(items)) > 0 {
+		return ErrChecklistIncomplete
+	}
+	return nil
+}
+
+// NewChecklist cria os itens de marcação de um atendimento a partir das
+// descrições, como nos planos de manutenção.
+func NewChecklist(formID uuid.UUID, labels []string) []*ChecklistItem {
+	items := make([]*ChecklistItem, 0, len(labels))
+	for i, label := range labels {
+		items = append(items, &ChecklistItem{FormID: formID, Position: i + 1, Label: label, Kind: ChecklistKindCheck})
+	}
+	return items
+}
+
+func isFinite(v *float64) bool {
+	return v == nil || (!math.IsNaN(*v) && !math.IsInf(*v, 0))
+}
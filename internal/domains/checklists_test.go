@@ -0,0 +1,151 @@
+package domains
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func boolPtr(v bool) *bool { return &v }
+
+func stringPtr(v string) *string { return &v }
+
+func validChecklistTemplate() ChecklistTemplate {
+	return ChecklistTemplate{
+		Name: "Preventiva split",
+		Items: []ChecklistTemplateItem{
+			{Label: "Filtros limpos", Kind: ChecklistKindPassFail, Required: true},
+			{Label: "Tensão de alimentação", Kind: ChecklistKindNumber, Required: true, Unit: "V", Min: floatPtr(210), Max: floatPtr(230)},
+			{Label: "Observações", Kind: ChecklistKindText},
+			{Label: "Foto da etiqueta", Kind: ChecklistKindPhoto, Required: true},
+		},
+	}
+}
+
+func TestChecklistTemplate_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*ChecklistTemplate)
+		err    error
+	}{
+		{"valid", func(*ChecklistTemplate) {}, nil},
+		{"number without range", func(tpl *ChecklistTemplate) { tpl.Items[1].Min, tpl.Items[1].Max = nil, nil }, nil},
+		{"empty name", func(tpl *ChecklistTemplate) { tpl.Name = "" }, ErrInvalidChecklistTemplate},
+		{"name too long", func(tpl *ChecklistTemplate) { tpl.Name = strings.Repeat("a", MaxChecklistTemplateNameLength+1) }, ErrInvalidChecklistTemplate},
+		{"no items", func(tpl *ChecklistTemplate) { tpl.Items = nil }, ErrInvalidChecklistTemplate},
+		{"too many items", func(tpl *ChecklistTemplate) {
+			tpl.Items = make([]ChecklistTemplateItem, MaxChecklistItems+1)
+			for i := range tpl.Items {
+				tpl.Items[i] = ChecklistTemplateItem{Label: "Item", Kind: ChecklistKindText}
+			}
+		}, ErrInvalidChecklistTemplate},
+		{"empty label", func(tpl *ChecklistTemplate) { tpl.Items[0].Label = "" }, ErrInvalidChecklistTemplate},
+		{"unknown kind", func(tpl *ChecklistTemplate) { tpl.Items[0].Kind = "assinatura" }, ErrInvalidChecklistTemplate},
+		{"plain check in template", func(tpl *ChecklistTemplate) { tpl.Items[0].Kind = ChecklistKindCheck }, ErrInvalidChecklistTemplate},
+		{"unit on text", func(tpl *ChecklistTemplate) { tpl.Items[2].Unit = "V" }, ErrInvalidChecklistTemplate},
+		{"range on pass/fail", func(tpl *ChecklistTemplate) { tpl.Items[0].Max = floatPtr(1) }, ErrInvalidChecklistTemplate},
+		{"min above max", func(tpl *ChecklistTemplate) { tpl.Items[1].Min = floatPtr(240) }, ErrInvalidChecklistTemplate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tpl := validChecklistTemplate()
+			tt.modify(&tpl)
+			assert.ErrorIs(t, tpl.Validate(), tt.err)
+		})
+	}
+}
+
+func TestChecklistTemplate_NewChecklist(t *testing.T) {
+	tpl := validChecklistTemplate()
+	tpl.ID = uuid.New()
+	formID := uuid.New()
+
+	items := tpl.NewChecklist(formID)
+	require.Len(t, items, 4)
+	assert.Equal(t, formID, items[1].FormID)
+	assert.Equal(t, tpl.ID, items[1].TemplateID)
+	assert.Equal(t, 2, items[1].Position)
+	assert.Equal(t, ChecklistKindNumber, items[1].Kind)
+	assert.Equal(t, "V", items[1].Unit)
+	assert.True(t, items[1].Required)
+	assert.False(t, items[1].Done)
+}
+
+func TestChecklistItem_Answer(t *testing.T) {
+	member, at := uuid.New(), time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+	tpl := validChecklistTemplate()
+	items := tpl.NewChecklist(uuid.New())
+	passFail, number, text, photo := items[0], items[1], items[2], items[3]
+
+	t.Run("pass/fail", func(t *testing.T) {
+		require.NoError(t, passFail.Answer(ChecklistAnswer{Passed: boolPtr(false)}, member, at))
+		assert.True(t, passFail.Done)
+		assert.False(t, *passFail.Passed)
+		assert.Equal(t, member, passFail.DoneBy)
+		assert.Equal(t, at, passFail.DoneAt)
+	})
+
+	t.Run("number in range", func(t *testing.T) {
+		require.NoError(t, number.Answer(ChecklistAnswer{Number: floatPtr(220)}, member, at))
+		assert.Equal(t, 220.0, *number.Number)
+		assert.False(t, number.OutOfRange)
+	})
+
+	t.Run("number out of range is flagged", func(t *testing.T) {
+		require.NoError(t, number.Answer(ChecklistAnswer{Number: floatPtr(190)}, member, at))
+		assert.True(t, number.OutOfRange)
+		require.NoError(t, number.Answer(ChecklistAnswer{Number: floatPtr(231)}, member, at))
+		assert.True(t, number.OutOfRange)
+	})
+
+	t.Run("text is trimmed", func(t *testing.T) {
+		require.NoError(t, text.Answer(ChecklistAnswer{Text: stringPtr("  Dreno obstruído  ")}, member, at))
+		assert.Equal(t, "Dreno obstruído", text.Text)
+	})
+
+	t.Run("photo", func(t *testing.T) {
+		attachment := uuid.New()
+		require.NoError(t, photo.Answer(ChecklistAnswer{AttachmentID: attachment}, member, at))
+		assert.Equal(t, attachment, photo.AttachmentID)
+		assert.True(t, photo.Answered())
+	})
+
+	t.Run("answer of another kind", func(t *testing.T) {
+		assert.ErrorIs(t, passFail.Answer(ChecklistAnswer{Number: floatPtr(1)}, member, at), ErrInvalidChecklistAnswer)
+		assert.ErrorIs(t, number.Answer(ChecklistAnswer{Done: boolPtr(true)}, member, at), ErrInvalidChecklistAnswer)
+		assert.ErrorIs(t, text.Answer(ChecklistAnswer{Text: stringPtr("   ")}, member, at), ErrInvalidChecklistAnswer)
+		assert.ErrorIs(t, photo.Answer(ChecklistAnswer{}, member, at), ErrInvalidChecklistAnswer)
+	})
+
+	t.Run("done false clears the answer", func(t *testing.T) {
+		require.NoError(t, number.Answer(ChecklistAnswer{Done: boolPtr(false)}, member, at))
+		assert.False(t, number.Done)
+		assert.Nil(t, number.Number)
+		assert.False(t, number.OutOfRange)
+		assert.Equal(t, uuid.Nil, number.DoneBy)
+	})
+}
+
+func TestPendingRequiredItems(t *testing.T) {
+	member, at := uuid.New(), time.Now()
+	tpl := validChecklistTemplate()
+	items := tpl.NewChecklist(uuid.New())
+	assert.ErrorIs(t, CheckChecklistComplete(items), ErrChecklistIncomplete)
+	assert.Len(t, PendingRequiredItems(items), 3)
+
+	require.NoError(t, items[0].Answer(ChecklistAnswer{Passed: boolPtr(true)}, member, at))
+	require.NoError(t, items[1].Answer(ChecklistAnswer{Number: floatPtr(250)}, member, at))
+	require.NoError(t, items[3].Answer(ChecklistAnswer{AttachmentID: uuid.New()}, member, at))
+	assert.NoError(t, CheckChecklistComplete(items), "optional text and out-of-range readings do not block")
+
+	// A foto excluída dos anexos volta a deixar o item pendente.
+	items[3].AttachmentID = uuid.Nil
+	assert.Equal(t, []*ChecklistItem{items[3]}, PendingRequiredItems(items))
+
+	plain := NewChecklist(uuid.New(), []string{"Limpar filtros"})
+	assert.NoError(t, CheckChecklistComplete(plain), "maintenance plan items are optional")
+}
@@ -13,8 +13,13 @@ type Client struct {
 	CnpjOrCpf  string        `json:"cnpj_or_cpf"`
 	ClientType string        `json:"client_type"`
 	Address    Address       `json:"address"`
-	CreatedAt  time.Time     `json:"created_at"`
-	UpdatedAt  time.Time     `json:"updated_at"`
+
+	CustomFields CustomFields `json:"custom_fields"`
+	Tags         []string     `json:"tags"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt time.Time `json:"deleted_at"`
 }
 
 type Address struct {
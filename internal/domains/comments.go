@@ -0,0 +1,79 @@
+package domains
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// MaxCommentLength é o tamanho máximo do corpo de um comentário, em
+// caracteres.
+const MaxCommentLength = 10000
+
+// FormComment é um comentário em um atendimento. Body é Markdown e é
+// guardado como enviado; a renderização fica com quem exibe. Comentários
+// internos não aparecem no portal do cliente.
+type FormComment struct {
+	ID         uuid.UUID `json:"id"`
+	FormID     uuid.UUID `json:"form_id"`
+	AuthorID   uuid.UUID `json:"author_id"`
+	AuthorName string    `json:"author_name"`
+	Body       string    `json:"body"`
+	Internal   bool      `json:"internal"`
+	EditCount  int64     `json:"edit_count"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// FormCommentEdit guarda a versão de um comentário anterior a uma edição.
+type FormCommentEdit struct {
+	ID               uuid.UUID `json:"id"`
+	CommentID        uuid.UUID `json:"comment_id"`
+	PreviousBody     string    `json:"previous_body"`
+	PreviousInternal bool      `json:"previous_internal"`
+	EditedBy         uuid.UUID `json:"edited_by"`
+	EditedByName     string    `json:"edited_by_name"`
+	EditedAt         time.Time `json:"edited_at"`
+}
+
+func (c *FormComment) Validate() error {
+	if strings.TrimSpace(c.Body) == "" || utf8.RuneCountInString(c.Body) > MaxCommentLength {
+		return ErrInvalidCommentBody
+	}
+	return nil
+}
+
+// CanEdit informa se o usuário pode editar o comentário: só o autor ou um
+// administrador.
+func (c *FormComment) CanEdit(userID uuid.UUID, admin bool) bool {
+	return admin || (c.AuthorID != uuid.Nil && c.AuthorID == userID)
+}
+
+// Edit troca o corpo e a visibilidade do comentário e devolve o registro com a
+// versão anterior. Quando nada muda, devolve nil sem erro.
+func (c *FormComment) Edit(body string, internal bool, by uuid.UUID, at time.Time) (*FormCommentEdit, error) {
+	if body == c.Body && internal == c.Internal {
+		return nil, nil
+	}
+
+	edit := &FormCommentEdit{
+		CommentID:        c.ID,
+		PreviousBody:     c.Body,
+		PreviousInternal: c.Internal,
+		EditedBy:         by,
+		EditedAt:         at,
+	}
+
+	previousBody, previousInternal := c.Body, c.Internal
+	c.Body, c.Internal = body, internal
+	if err := c.Validate(); err != nil {
+		c.Body, c.Internal = previousBody, previousInternal
+		return nil, err
+	}
+
+	c.UpdatedAt = at
+	c.EditCount++
+	return edit, nil
+}
@@ -0,0 +1,86 @@
+package domains
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormComment_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectedErr error
+	}{
+		{name: "valid markdown", body: "Troquei o **fusor**.\n\n- peça: RM1-8808"},
+		{name: "at the limit", body: strings.Repeat("ç", MaxCommentLength)},
+		{name: "invalid - blank", body: " \n\t", expectedErr: ErrInvalidCommentBody},
+		{name: "invalid - too long", body: strings.Repeat("a", MaxCommentLength+1), expectedErr: ErrInvalidCommentBody},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := FormComment{Body: tt.body}
+			err := c.Validate()
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFormComment_CanEdit(t *testing.T) {
+	author := uuid.New()
+	c := FormComment{AuthorID: author}
+
+	assert.True(t, c.CanEdit(author, false))
+	assert.False(t, c.CanEdit(uuid.New(), false))
+	assert.True(t, c.CanEdit(uuid.New(), true))
+
+	orphan := FormComment{}
+	assert.False(t, orphan.CanEdit(uuid.Nil, false))
+}
+
+func TestFormComment_Edit(t *testing.T) {
+	by := uuid.New()
+	at := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+
+	t.Run("records previous version", func(t *testing.T) {
+		c := FormComment{ID: uuid.New(), Body: "rascunho", Internal: true}
+		edit, err := c.Edit("versão final", false, by, at)
+		assert.NoError(t, err)
+		if assert.NotNil(t, edit) {
+			assert.Equal(t, c.ID, edit.CommentID)
+			assert.Equal(t, "rascunho", edit.PreviousBody)
+			assert.True(t, edit.PreviousInternal)
+			assert.Equal(t, by, edit.EditedBy)
+			assert.Equal(t, at, edit.EditedAt)
+		}
+		assert.Equal(t, "versão final", c.Body)
+		assert.False(t, c.Internal)
+		assert.Equal(t, int64(1), c.EditCount)
+		assert.Equal(t, at, c.UpdatedAt)
+	})
+
+	t.Run("unchanged is a no-op", func(t *testing.T) {
+		c := FormComment{Body: "igual"}
+		edit, err := c.Edit("igual", false, by, at)
+		assert.NoError(t, err)
+		assert.Nil(t, edit)
+		assert.Zero(t, c.EditCount)
+	})
+
+	t.Run("invalid body keeps the comment", func(t *testing.T) {
+		c := FormComment{Body: "original", Internal: true}
+		edit, err := c.Edit("  ", false, by, at)
+		assert.ErrorIs(t, err, ErrInvalidCommentBody)
+		assert.Nil(t, edit)
+		assert.Equal(t, "original", c.Body)
+		assert.True(t, c.Internal)
+	})
+}
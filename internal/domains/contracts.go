@@ -0,0 +1,194 @@
+package domains
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	ContractAlertExpiringSoon  = "contrato_proximo_vencimento"
+	ContractAlertExpired       = "contrato_vencido"
+	ContractAlertHoursNearEnd  = "horas_proximas_do_limite"
+	ContractAlertHoursExceeded = "horas_esgotadas"
+)
+
+// ContractExpiringWindow é a antecedência com que um contrato passa a ser
+// sinalizado como próximo do vencimento.
+const ContractExpiringWindow = 30 * 24 * time.Hour
+
+// ContractHoursWarningRatio é a fração da franquia mensal a partir da qual o
+// consumo passa a ser sinalizado.
+var ContractHoursWarningRatio = decimal.NewFromFloat(0.8)
+
+type Contract struct {
+	ID uuid.UUID `json:"id"`
+
+	Cliente            ClientForm      `json:"cliente"`
+	StartDate          time.Time       `json:"start_date"`
+	EndDate            time.Time       `json:"end_date"`
+	MonthlyHours       decimal.Decimal `json:"monthly_hours"`
+	CoveredServices    []string        `json:"covered_services"`
+	ResponseSLAHours   int32           `json:"response_sla_hours"`
+	ResolutionSLAHours int32           `json:"resolution_sla_hours"`
+	MonthlyPrice       decimal.Decimal `json:"monthly_price"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ContractConsumption é o consumo de horas lançado por um atendimento.
+type ContractConsumption struct {
+	FormID     uuid.UUID       `json:"form_id"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Hours      decimal.Decimal `json:"hours"`
+}
+
+// BillingPeriod é um período de faturamento [Start, End).
+type BillingPeriod struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type PeriodUsage struct {
+	Period         BillingPeriod   `json:"period"`
+	UsedHours      decimal.Decimal `json:"used_hours"`
+	RemainingHours decimal.Decimal `json:"remaining_hours"`
+}
+
+func (c *Contract) Validate() error {
+	if c.Cliente.ID == uuid.Nil {
+		return ErrInvalidClienteId
+	}
+	if c.StartDate.IsZero() || c.EndDate.IsZero() {
+		return ErrInvalidContractPeriod
+	}
+	if c.EndDate.Before(c.StartDate) {
+		return ErrInvalidContractPeriod
+	}
+	if !c.MonthlyHours.IsPositive() {
+		return ErrInvalidContractHours
+	}
+	if c.ResponseSLAHours <= 0 || c.ResolutionSLAHours < c.ResponseSLAHours {
+		return ErrInvalidContractSLA
+	}
+	if c.MonthlyPrice.IsNegative() {
+		return ErrInvalidContractPrice
+	}
+	return nil
+}
+
+// SLAPolicy converte os prazos do contrato, em horas úteis, na política usada
+// para calcular os prazos dos atendimentos cobertos por ele.
+func (c *Contract) SLAPolicy() *SLAPolicy {
+	return &SLAPolicy{
+		ClientType:        "contrato",
+		ResponseMinutes:   int(c.ResponseSLAHours) * 60,
+		ResolutionMinutes: int(c.ResolutionSLAHours) * 60,
+	}
+}
+
+// IsActiveAt indica se a data informada está dentro da vigência do contrato.
+// As datas de início e fim são inclusivas.
+func (c *Contract) IsActiveAt(t time.Time) bool {
+	day := truncateDay(t)
+	return !day.Before(truncateDay(c.StartDate)) && !day.After(truncateDay(c.EndDate))
+}
+
+// BillingPeriodAt retorna o período de faturamento (mês civil, limitado à
+// vigência do contrato) que contém a data informada.
+func (c *Contract) BillingPeriodAt(t time.Time) BillingPeriod {
+	day := truncateDay(t)
+	start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	if contractStart := truncateDay(c.StartDate); start.Before(contractStart) {
+		start = contractStart
+	}
+	if contractEnd := truncateDay(c.EndDate).AddDate(0, 0, 1); end.After(contractEnd) {
+		end = contractEnd
+	}
+	return BillingPeriod{Start: start, End: end}
+}
+
+// BillingPeriods retorna todos os períodos de faturamento desde o início do
+// contrato até a data informada (ou até o fim da vigência, o que vier antes).
+func (c *Contract) BillingPeriods(until time.Time) []BillingPeriod {
+	last := truncateDay(until)
+	if end := truncateDay(c.EndDate); last.After(end) {
+		last = end
+	}
+
+	periods := make([]BillingPeriod, 0)
+	for cursor := truncateDay(c.StartDate); !cursor.After(last); {
+		period := c.BillingPeriodAt(cursor)
+		periods = append(periods, period)
+		cursor = period.End
+	}
+	return periods
+}
+
+// Usage distribui o consumo dos atendimentos pelos períodos de faturamento.
+func (c *Contract) Usage(consumption []ContractConsumption, until time.Time) []PeriodUsage {
+	periods := c.BillingPeriods(until)
+	usage := make([]PeriodUsage, 0, len(periods))
+	for _, period := range periods {
+		used := decimal.Zero
+		for _, entry := range consumption {
+			at := entry.OccurredAt.UTC()
+			if !at.Before(period.Start) && at.Before(period.End) {
+				used = used.Add(entry.Hours)
+			}
+		}
+		usage = append(usage, PeriodUsage{
+			Period:         period,
+			UsedHours:      used,
+			RemainingHours: c.RemainingHours(used),
+		})
+	}
+	return usage
+}
+
+// RemainingHours retorna o saldo de horas do período, nunca negativo.
+func (c *Contract) RemainingHours(used decimal.Decimal) decimal.Decimal {
+	remaining := c.MonthlyHours.Sub(used)
+	if remaining.IsNegative() {
+		return decimal.Zero
+	}
+	return remaining
+}
+
+// Alerts retorna os avisos de vencimento e de consumo de horas do contrato
+// considerando o consumo do período de faturamento atual.
+func (c *Contract) Alerts(now time.Time, usedInPeriod decimal.Decimal) []string {
+	alerts := make([]string, 0)
+
+	today := truncateDay(now)
+	end := truncateDay(c.EndDate)
+	switch {
+	case today.After(end):
+		alerts = append(alerts, ContractAlertExpired)
+		return alerts
+	case !end.After(today.Add(ContractExpiringWindow)):
+		alerts = append(alerts, ContractAlertExpiringSoon)
+	}
+
+	if !c.IsActiveAt(now) {
+		return alerts
+	}
+
+	switch {
+	case usedInPeriod.GreaterThanOrEqual(c.MonthlyHours):
+		alerts = append(alerts, ContractAlertHoursExceeded)
+	case usedInPeriod.GreaterThanOrEqual(c.MonthlyHours.Mul(ContractHoursWarningRatio)):
+		alerts = append(alerts, ContractAlertHoursNearEnd)
+	}
+
+	return alerts
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,227 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestContract() Contract {
+	return Contract{
+		ID:                 uuid.New(),
+		Cliente:            ClientForm{ID: uuid.New(), ClientName: "Padaria Central"},
+		StartDate:          time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC),
+		EndDate:            time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC),
+		MonthlyHours:       decimal.NewFromInt(20),
+		CoveredServices:    []string{"impressoras", "rede"},
+		ResponseSLAHours:   4,
+		ResolutionSLAHours: 24,
+		MonthlyPrice:       decimal.NewFromInt(1500),
+	}
+}
+
+// TestContract_Validate tests the Validate method with various scenarios
+func TestContract_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(c *Contract)
+		expectedErr error
+	}{
+		{
+			name:   "valid contract",
+			mutate: func(c *Contract) {},
+		},
+		{
+			name:        "invalid - nil client",
+			mutate:      func(c *Contract) { c.Cliente.ID = uuid.Nil },
+			expectedErr: ErrInvalidClienteId,
+		},
+		{
+			name:        "invalid - zero start date",
+			mutate:      func(c *Contract) { c.StartDate = time.Time{} },
+			expectedErr: ErrInvalidContractPeriod,
+		},
+		{
+			name:        "invalid - end before start",
+			mutate:      func(c *Contract) { c.EndDate = c.StartDate.AddDate(0, 0, -1) },
+			expectedErr: ErrInvalidContractPeriod,
+		},
+		{
+			name:   "valid - single day contract",
+			mutate: func(c *Contract) { c.EndDate = c.StartDate },
+		},
+		{
+			name:        "invalid - zero monthly hours",
+			mutate:      func(c *Contract) { c.MonthlyHours = decimal.Zero },
+			expectedErr: ErrInvalidContractHours,
+		},
+		{
+			name:        "invalid - zero response sla",
+			mutate:      func(c *Contract) { c.ResponseSLAHours = 0 },
+			expectedErr: ErrInvalidContractSLA,
+		},
+		{
+			name:        "invalid - resolution shorter than response",
+			mutate:      func(c *Contract) { c.ResolutionSLAHours = 2 },
+			expectedErr: ErrInvalidContractSLA,
+		},
+		{
+			name:        "invalid - negative price",
+			mutate:      func(c *Contract) { c.MonthlyPrice = decimal.NewFromInt(-1) },
+			expectedErr: ErrInvalidContractPrice,
+		},
+		{
+			name:   "valid - free contract",
+			mutate: func(c *Contract) { c.MonthlyPrice = decimal.Zero },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestContract()
+			tt.mutate(&c)
+
+			err := c.Validate()
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContract_SLAPolicy(t *testing.T) {
+	c := &Contract{ResponseSLAHours: 4, ResolutionSLAHours: 24}
+
+	policy := c.SLAPolicy()
+	assert.Equal(t, 240, policy.ResponseMinutes)
+	assert.Equal(t, 1440, policy.ResolutionMinutes)
+}
+
+func TestContract_IsActiveAt(t *testing.T) {
+	c := newTestContract()
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"before start", time.Date(2026, time.January, 14, 23, 0, 0, 0, time.UTC), false},
+		{"on start date", time.Date(2026, time.January, 15, 8, 0, 0, 0, time.UTC), true},
+		{"in the middle", time.Date(2026, time.June, 1, 12, 0, 0, 0, time.UTC), true},
+		{"on end date", time.Date(2026, time.December, 31, 23, 59, 0, 0, time.UTC), true},
+		{"after end", time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.IsActiveAt(tt.at))
+		})
+	}
+}
+
+func TestContract_BillingPeriods(t *testing.T) {
+	c := newTestContract()
+
+	periods := c.BillingPeriods(time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC))
+	assert.Len(t, periods, 3)
+
+	assert.Equal(t, time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC), periods[0].Start)
+	assert.Equal(t, time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC), periods[0].End)
+	assert.Equal(t, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), periods[2].Start)
+	assert.Equal(t, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), periods[2].End)
+
+	t.Run("stops at contract end", func(t *testing.T) {
+		all := c.BillingPeriods(time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC))
+		assert.Len(t, all, 12)
+		assert.Equal(t, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), all[len(all)-1].End)
+	})
+
+	t.Run("no periods before start", func(t *testing.T) {
+		assert.Empty(t, c.BillingPeriods(time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("contract ending mid month", func(t *testing.T) {
+		short := newTestContract()
+		short.EndDate = time.Date(2026, time.February, 10, 0, 0, 0, 0, time.UTC)
+		all := short.BillingPeriods(time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC))
+		assert.Len(t, all, 2)
+		assert.Equal(t, time.Date(2026, time.February, 11, 0, 0, 0, 0, time.UTC), all[1].End)
+	})
+}
+
+func TestContract_Usage(t *testing.T) {
+	c := newTestContract()
+	consumption := []ContractConsumption{
+		{FormID: uuid.New(), OccurredAt: time.Date(2026, time.January, 20, 10, 0, 0, 0, time.UTC), Hours: decimal.NewFromInt(5)},
+		{FormID: uuid.New(), OccurredAt: time.Date(2026, time.January, 31, 23, 0, 0, 0, time.UTC), Hours: decimal.NewFromFloat(2.5)},
+		{FormID: uuid.New(), OccurredAt: time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC), Hours: decimal.NewFromInt(25)},
+	}
+
+	usage := c.Usage(consumption, time.Date(2026, time.February, 15, 0, 0, 0, 0, time.UTC))
+	assert.Len(t, usage, 2)
+
+	assert.True(t, usage[0].UsedHours.Equal(decimal.NewFromFloat(7.5)))
+	assert.True(t, usage[0].RemainingHours.Equal(decimal.NewFromFloat(12.5)))
+
+	assert.True(t, usage[1].UsedHours.Equal(decimal.NewFromInt(25)))
+	assert.True(t, usage[1].RemainingHours.IsZero(), "remaining hours must never be negative")
+}
+
+func TestContract_Alerts(t *testing.T) {
+	c := newTestContract()
+
+	tests := []struct {
+		name string
+		now  time.Time
+		used decimal.Decimal
+		want []string
+	}{
+		{
+			name: "no alerts",
+			now:  time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+			used: decimal.NewFromInt(2),
+			want: []string{},
+		},
+		{
+			name: "hours near the limit",
+			now:  time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+			used: decimal.NewFromInt(16),
+			want: []string{ContractAlertHoursNearEnd},
+		},
+		{
+			name: "hours exhausted",
+			now:  time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+			used: decimal.NewFromInt(20),
+			want: []string{ContractAlertHoursExceeded},
+		},
+		{
+			name: "expiring soon",
+			now:  time.Date(2026, time.December, 10, 0, 0, 0, 0, time.UTC),
+			used: decimal.Zero,
+			want: []string{ContractAlertExpiringSoon},
+		},
+		{
+			name: "expiring soon and exhausted",
+			now:  time.Date(2026, time.December, 10, 0, 0, 0, 0, time.UTC),
+			used: decimal.NewFromInt(30),
+			want: []string{ContractAlertExpiringSoon, ContractAlertHoursExceeded},
+		},
+		{
+			name: "expired",
+			now:  time.Date(2027, time.January, 2, 0, 0, 0, 0, time.UTC),
+			used: decimal.NewFromInt(30),
+			want: []string{ContractAlertExpired},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.Alerts(tt.now, tt.used))
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package domains
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Entidades que aceitam campos personalizados.
+const (
+	CustomFieldEntityClient = "cliente"
+	CustomFieldEntityForm   = "atendimento"
+)
+
+// Tipos de campo personalizado.
+const (
+	CustomFieldTypeText    = "texto"
+	CustomFieldTypeNumber  = "numero"
+	CustomFieldTypeDate    = "data"
+	CustomFieldTypeSelect  = "selecao"
+	CustomFieldTypeBoolean = "booleano"
+)
+
+// CustomFieldDateLayout é o formato aceito para campos do tipo data.
+const CustomFieldDateLayout = "2006-01-02"
+
+const (
+	maxTagLength = 50
+	maxTags      = 20
+)
+
+var customFieldKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// CustomFieldDefinition descreve um campo extra definido pelo administrador
+// para clientes ou atendimentos.
+type CustomFieldDefinition struct {
+	ID       uuid.UUID        `json:"id"`
+	Entity   string           `json:"entity"`
+	Key      string           `json:"key"`
+	Label    string           `json:"label"`
+	Type     string           `json:"type"`
+	Required bool             `json:"required"`
+	Options  []string         `json:"options"`
+	Rules    CustomFieldRules `json:"rules"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CustomFieldRules são as regras de validação opcionais de um campo. Regras de
+// tamanho e padrão valem para texto; mínimo e máximo valem para número.
+type CustomFieldRules struct {
+	MinLength *int     `json:"min_length,omitempty"`
+	MaxLength *int     `json:"max_length,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+}
+
+// CustomFields são os valores dos campos personalizados de um registro,
+// indexados pela chave do campo.
+type CustomFields map[string]any
+
+// ListFilter restringe listagens de clientes e atendimentos pelos campos
+// personalizados (igualdade) e pelas tags (o registro deve ter todas).
+// ClientID, quando informado, limita atendimentos a um único cliente.
+type ListFilter struct {
+	CustomFields CustomFields
+	Tags         []string
+	ClientID     uuid.UUID
+}
+
+// CustomFieldError identifica o campo personalizado que falhou na validação.
+type CustomFieldError struct {
+	Key string
+	Err error
+}
+
+func (e *CustomFieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err, e.Key)
+}
+
+func (e *CustomFieldError) Unwrap() error {
+	return e.Err
+}
+
+func (d *CustomFieldDefinition) Validate() error {
+	if d.Entity != CustomFieldEntityClient && d.Entity != CustomFieldEntityForm {
+		return ErrInvalidCustomFieldEntity
+	}
+	if !customFieldKeyRegex.MatchString(d.Key) {
+		return ErrInvalidCustomFieldKey
+	}
+	if strings.TrimSpace(d.Label) == "" {
+		return ErrInvalidCustomFieldLabel
+	}
+
+	switch d.Type {
+	case CustomFieldTypeSelect:
+		if len(d.Options) == 0 {
+			return ErrInvalidCustomFieldOptions
+		}
+		seen := make(map[string]struct{}, len(d.Options))
+		for _, opt := range d.Options {
+			if strings.TrimSpace(opt) == "" {
+				return ErrInvalidCustomFieldOptions
+			}
+			if _, ok := seen[opt]; ok {
+				return ErrInvalidCustomFieldOptions
+			}
+			seen[opt] = struct{}{}
+		}
+	case CustomFieldTypeText, CustomFieldTypeNumber, CustomFieldTypeDate, CustomFieldTypeBoolean:
+		if len(d.Options) > 0 {
+			return ErrInvalidCustomFieldOptions
+		}
+	default:
+		return ErrInvalidCustomFieldType
+	}
+
+	return d.validateRules()
+}
+
+func (d *CustomFieldDefinition) validateRules() error {
+	r := d.Rules
+	hasText := r.MinLength != nil || r.MaxLength != nil || r.Pattern != ""
+	hasNumber := r.Min != nil || r.Max != nil
+
+	if hasText && d.Type != CustomFieldTypeText {
+		return ErrInvalidCustomFieldRules
+	}
+	if hasNumber && d.Type != CustomFieldTypeNumber {
+		return ErrInvalidCustomFieldRules
+	}
+	if (r.MinLength != nil && *r.MinLength < 0) || (r.MaxLength != nil && *r.MaxLength < 1) {
+		return ErrInvalidCustomFieldRules
+	}
+	if r.MinLength != nil && r.MaxLength != nil && *r.MinLength > *r.MaxLength {
+		return ErrInvalidCustomFieldRules
+	}
+	if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return ErrInvalidCustomFieldRules
+	}
+	if r.Pattern != "" {
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			return ErrInvalidCustomFieldRules
+		}
+	}
+	return nil
+}
+
+// ValidateValue confere um valor recebido em JSON contra o tipo e as regras do
+// campo e retorna o valor normalizado para gravação.
+func (d *CustomFieldDefinition) ValidateValue(value any) (any, error) {
+	invalid := &CustomFieldError{Key: d.Key, Err: ErrInvalidCustomFieldValue}
+
+	switch d.Type {
+	case CustomFieldTypeText:
+		s, ok := value.(string)
+		if !ok {
+			return nil, invalid
+		}
+		length := utf8.RuneCountInString(s)
+		if d.Rules.MinLength != nil && length < *d.Rules.MinLength {
+			return nil, invalid
+		}
+		if d.Rules.MaxLength != nil && length > *d.Rules.MaxLength {
+			return nil, invalid
+		}
+		if d.Rules.Pattern != "" {
+			re, err := regexp.Compile(d.Rules.Pattern)
+			if err != nil || !re.MatchString(s) {
+				return nil, invalid
+			}
+		}
+		return s, nil
+	case CustomFieldTypeNumber:
+		n, ok := value.(float64)
+		if !ok {
+			return nil, invalid
+		}
+		if d.Rules.Min != nil && n < *d.Rules.Min {
+			return nil, invalid
+		}
+		if d.Rules.Max != nil && n > *d.Rules.Max {
+			return nil, invalid
+		}
+		return n, nil
+	case CustomFieldTypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return nil, invalid
+		}
+		if _, err := time.Parse(CustomFieldDateLayout, s); err != nil {
+			return nil, invalid
+		}
+		return s, nil
+	case CustomFieldTypeSelect:
+		s, ok := value.(string)
+		if !ok {
+			return nil, invalid
+		}
+		for _, opt := range d.Options {
+			if opt == s {
+				return s, nil
+			}
+		}
+		return nil, invalid
+	case CustomFieldTypeBoolean:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, invalid
+		}
+		return b, nil
+	}
+	return nil, invalid
+}
+
+// ParseFilterValue converte o valor textual de um filtro de listagem para o
+// tipo do campo, de modo que a comparação com o JSONB gravado seja exata.
+func (d *CustomFieldDefinition) ParseFilterValue(raw string) (any, error) {
+	switch d.Type {
+	case CustomFieldTypeNumber:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, &CustomFieldError{Key: d.Key, Err: ErrInvalidCustomFieldValue}
+		}
+		return n, nil
+	case CustomFieldTypeBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, &CustomFieldError{Key: d.Key, Err: ErrInvalidCustomFieldValue}
+		}
+		return b, nil
+	}
+	return d.ValidateValue(raw)
+}
+
+// ValidateCustomFields valida os valores informados contra as definições da
+// entidade. Chaves desconhecidas são rejeitadas, valores nulos são ignorados e
+// campos obrigatórios ausentes geram erro.
+func ValidateCustomFields(defs []*CustomFieldDefinition, values CustomFields) (CustomFields, error) {
+	byKey := make(map[string]*CustomFieldDefinition, len(defs))
+	for _, d := range defs {
+		byKey[d.Key] = d
+	}
+
+	validated := make(CustomFields, len(values))
+	for key, value := range values {
+		d, ok := byKey[key]
+		if !ok {
+			return nil, &CustomFieldError{Key: key, Err: ErrUnknownCustomField}
+		}
+		if value == nil {
+			continue
+		}
+		v, err := d.ValidateValue(value)
+		if err != nil {
+			return nil, err
+		}
+		validated[key] = v
+	}
+
+	for _, d := range defs {
+		if _, ok := validated[d.Key]; d.Required && !ok {
+			return nil, &CustomFieldError{Key: d.Key, Err: ErrCustomFieldRequired}
+		}
+	}
+	return validated, nil
+}
+
+// BuildCustomFieldFilter converte filtros chave/valor recebidos na listagem em
+// valores tipados conforme as definições da entidade.
+func BuildCustomFieldFilter(defs []*CustomFieldDefinition, raw map[string]string) (CustomFields, error) {
+	byKey := make(map[string]*CustomFieldDefinition, len(defs))
+	for _, d := range defs {
+		byKey[d.Key] = d
+	}
+
+	filter := make(CustomFields, len(raw))
+	for key, value := range raw {
+		d, ok := byKey[key]
+		if !ok {
+			return nil, &CustomFieldError{Key: key, Err: ErrUnknownCustomField}
+		}
+		v, err := d.ParseFilterValue(value)
+		if err != nil {
+			return nil, err
+		}
+		filter[key] = v
+	}
+	return filter, nil
+}
+
+// NormalizeTags remove espaços, converte para minúsculas e descarta tags
+// vazias ou repetidas, preservando a ordem informada.
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+		if tag == "" {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, ErrInvalidTag
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > maxTags {
+		return nil, ErrTooManyTags
+	}
+	return normalized, nil
+}
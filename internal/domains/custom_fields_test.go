@@ -0,0 +1,217 @@
+package domains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int { return &v }
+
+func floatPtr(v float64) *float64 { return &v }
+
+// TestCustomFieldDefinition_Validate tests the Validate method with various scenarios
+func TestCustomFieldDefinition_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		def         CustomFieldDefinition
+		expectedErr error
+	}{
+		{
+			name: "valid text field",
+			def:  CustomFieldDefinition{Entity: CustomFieldEntityClient, Key: "numero_contrato", Label: "Nº do contrato", Type: CustomFieldTypeText, Rules: CustomFieldRules{MaxLength: intPtr(20)}},
+		},
+		{
+			name: "valid select field",
+			def:  CustomFieldDefinition{Entity: CustomFieldEntityForm, Key: "marca", Label: "Marca", Type: CustomFieldTypeSelect, Options: []string{"HP", "Epson"}},
+		},
+		{
+			name:        "invalid - unknown entity",
+			def:         CustomFieldDefinition{Entity: "usuario", Key: "andar", Label: "Andar", Type: CustomFieldTypeNumber},
+			expectedErr: ErrInvalidCustomFieldEntity,
+		},
+		{
+			name:        "invalid - key with spaces",
+			def:         CustomFieldDefinition{Entity: CustomFieldEntityClient, Key: "Andar do prédio", Label: "Andar", Type: CustomFieldTypeNumber},
+			expectedErr: ErrInvalidCustomFieldKey,
+		},
+		{
+			name:        "invalid - empty label",
+			def:         CustomFieldDefinition{Entity: CustomFieldEntityClient, Key: "andar", Label: " ", Type: CustomFieldTypeNumber},
+			expectedErr: ErrInvalidCustomFieldLabel,
+		},
+		{
+			name:        "invalid - unknown type",
+			def:         CustomFieldDefinition{Entity: CustomFieldEntityClient, Key: "andar", Label: "Andar", Type: "inteiro"},
+			expectedErr: ErrInvalidCustomFieldType,
+		},
+		{
+			name:        "invalid - select without options",
+			def:         CustomFieldDefinition{Entity: CustomFieldEntityForm, Key: "marca", Label: "Marca", Type: CustomFieldTypeSelect},
+			expectedErr: ErrInvalidCustomFieldOptions,
+		},
+		{
+			name:        "invalid - duplicated options",
+			def:         CustomFieldDefinition{Entity: CustomFieldEntityForm, Key: "marca", Label: "Marca", Type: CustomFieldTypeSelect, Options: []string{"HP", "HP"}},
+			expectedErr: ErrInvalidCustomFieldOptions,
+		},
+		{
+			name:        "invalid - options on text field",
+			def:         CustomFieldDefinition{Entity: CustomFieldEntityForm, Key: "marca", Label: "Marca", Type: CustomFieldTypeText, Options: []string{"HP"}},
+			expectedErr: ErrInvalidCustomFieldOptions,
+		},
+		{
+			name:        "invalid - number rules on text field",
+			def:         CustomFieldDefinition{Entity: CustomFieldEntityClient, Key: "andar", Label: "Andar", Type: CustomFieldTypeText, Rules: CustomFieldRules{Min: floatPtr(0)}},
+			expectedErr: ErrInvalidCustomFieldRules,
+		},
+		{
+			name:        "invalid - min greater than max",
+			def:         CustomFieldDefinition{Entity: CustomFieldEntityClient, Key: "andar", Label: "Andar", Type: CustomFieldTypeNumber, Rules: CustomFieldRules{Min: floatPtr(10), Max: floatPtr(1)}},
+			expectedErr: ErrInvalidCustomFieldRules,
+		},
+		{
+			name:        "invalid - bad pattern",
+			def:         CustomFieldDefinition{Entity: CustomFieldEntityClient, Key: "codigo", Label: "Código", Type: CustomFieldTypeText, Rules: CustomFieldRules{Pattern: "[a-"}},
+			expectedErr: ErrInvalidCustomFieldRules,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.def.Validate()
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCustomFields(t *testing.T) {
+	defs := []*CustomFieldDefinition{
+		{Key: "numero_contrato", Type: CustomFieldTypeText, Required: true, Rules: CustomFieldRules{Pattern: `^CT-\d+$`}},
+		{Key: "andar", Type: CustomFieldTypeNumber, Rules: CustomFieldRules{Min: floatPtr(0), Max: floatPtr(50)}},
+		{Key: "instalado_em", Type: CustomFieldTypeDate},
+		{Key: "marca", Type: CustomFieldTypeSelect, Options: []string{"HP", "Epson"}},
+		{Key: "garantia", Type: CustomFieldTypeBoolean},
+	}
+
+	tests := []struct {
+		name        string
+		values      CustomFields
+		want        CustomFields
+		expectedErr error
+		errKey      string
+	}{
+		{
+			name:   "all types valid",
+			values: CustomFields{"numero_contrato": "CT-42", "andar": 3.0, "instalado_em": "2026-03-01", "marca": "HP", "garantia": true},
+			want:   CustomFields{"numero_contrato": "CT-42", "andar": 3.0, "instalado_em": "2026-03-01", "marca": "HP", "garantia": true},
+		},
+		{
+			name:   "null values are dropped",
+			values: CustomFields{"numero_contrato": "CT-1", "marca": nil},
+			want:   CustomFields{"numero_contrato": "CT-1"},
+		},
+		{
+			name:        "missing required field",
+			values:      CustomFields{"andar": 1.0},
+			expectedErr: ErrCustomFieldRequired,
+			errKey:      "numero_contrato",
+		},
+		{
+			name:        "unknown field",
+			values:      CustomFields{"numero_contrato": "CT-1", "cor": "azul"},
+			expectedErr: ErrUnknownCustomField,
+			errKey:      "cor",
+		},
+		{
+			name:        "pattern mismatch",
+			values:      CustomFields{"numero_contrato": "42"},
+			expectedErr: ErrInvalidCustomFieldValue,
+			errKey:      "numero_contrato",
+		},
+		{
+			name:        "number out of range",
+			values:      CustomFields{"numero_contrato": "CT-1", "andar": 51.0},
+			expectedErr: ErrInvalidCustomFieldValue,
+			errKey:      "andar",
+		},
+		{
+			name:        "number sent as string",
+			values:      CustomFields{"numero_contrato": "CT-1", "andar": "3"},
+			expectedErr: ErrInvalidCustomFieldValue,
+			errKey:      "andar",
+		},
+		{
+			name:        "invalid date",
+			values:      CustomFields{"numero_contrato": "CT-1", "instalado_em": "01/03/2026"},
+			expectedErr: ErrInvalidCustomFieldValue,
+			errKey:      "instalado_em",
+		},
+		{
+			name:        "option not allowed",
+			values:      CustomFields{"numero_contrato": "CT-1", "marca": "Canon"},
+			expectedErr: ErrInvalidCustomFieldValue,
+			errKey:      "marca",
+		},
+		{
+			name:        "boolean sent as string",
+			values:      CustomFields{"numero_contrato": "CT-1", "garantia": "sim"},
+			expectedErr: ErrInvalidCustomFieldValue,
+			errKey:      "garantia",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateCustomFields(defs, tt.values)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				var fieldErr *CustomFieldError
+				if assert.ErrorAs(t, err, &fieldErr) {
+					assert.Equal(t, tt.errKey, fieldErr.Key)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildCustomFieldFilter(t *testing.T) {
+	defs := []*CustomFieldDefinition{
+		{Key: "andar", Type: CustomFieldTypeNumber},
+		{Key: "garantia", Type: CustomFieldTypeBoolean},
+		{Key: "marca", Type: CustomFieldTypeSelect, Options: []string{"HP", "Epson"}},
+	}
+
+	filter, err := BuildCustomFieldFilter(defs, map[string]string{"andar": "3", "garantia": "true", "marca": "HP"})
+	assert.NoError(t, err)
+	assert.Equal(t, CustomFields{"andar": 3.0, "garantia": true, "marca": "HP"}, filter)
+
+	_, err = BuildCustomFieldFilter(defs, map[string]string{"andar": "terceiro"})
+	assert.ErrorIs(t, err, ErrInvalidCustomFieldValue)
+
+	_, err = BuildCustomFieldFilter(defs, map[string]string{"cor": "azul"})
+	assert.ErrorIs(t, err, ErrUnknownCustomField)
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := NormalizeTags([]string{" VIP ", "impressora  laser", "vip", ""})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vip", "impressora laser"}, tags)
+
+	_, err = NormalizeTags([]string{"uma tag muito longa que passa bastante do limite permitido"})
+	assert.ErrorIs(t, err, ErrInvalidTag)
+
+	many := make([]string, 0, 21)
+	for i := 0; i < 21; i++ {
+		many = append(many, string(rune('a'+i)))
+	}
+	_, err = NormalizeTags(many)
+	assert.ErrorIs(t, err, ErrTooManyTags)
+}
@@ -0,0 +1,199 @@
+package domains
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Papéis dos membros que podem ser designados para atendimentos.
+const (
+	MemberRoleInternal = "tecnico_interno"
+	MemberRoleExternal = "tecnico_externo"
+)
+
+// Origem da localização usada na distância até o cliente.
+const (
+	DispatchLocationCurrent = "atual"
+	DispatchLocationHome    = "base"
+)
+
+// A pontuação da sugestão vai de 0 a 100 e soma quatro critérios: distância
+// até o cliente, atendimentos em aberto, atendimentos recentes do mesmo
+// cliente e papel do técnico.
+const (
+	DispatchWeightDistance = 40.0
+	DispatchWeightWorkload = 30.0
+	DispatchWeightHistory  = 20.0
+	DispatchWeightRole     = 10.0
+
+	// DispatchMaxDistanceKm é a distância a partir da qual o critério vale zero.
+	DispatchMaxDistanceKm = 100.0
+	// DispatchMaxOpenForms é a carga a partir da qual o critério vale zero.
+	DispatchMaxOpenForms = 10
+	// DispatchHistoryForms é o número de atendimentos recentes do cliente que
+	// dá a nota máxima no critério.
+	DispatchHistoryForms = 3
+	// DispatchHistoryWindow é o período considerado recente.
+	DispatchHistoryWindow = 90 * 24 * time.Hour
+	// DispatchLocationMaxAge é por quanto tempo a localização atual informada
+	// pelo técnico vale; depois dela a distância parte da base.
+	DispatchLocationMaxAge = 12 * time.Hour
+
+	DefaultDispatchLimit = 5
+	MaxDispatchLimit     = 50
+)
+
+// DispatchCandidate é um técnico ativo que pode ser designado, com a
+// localização e a carga usadas no ranking. HomeLocation e CurrentLocation
+// são nil quando não informadas.
+type DispatchCandidate struct {
+	MemberID          uuid.UUID `json:"member_id"`
+	Name              string    `json:"name"`
+	Role              string    `json:"role"`
+	HomeLocation      *GeoPoint `json:"home_location"`
+	CurrentLocation   *GeoPoint `json:"current_location"`
+	LocatedAt         time.Time `json:"located_at"`
+	OpenForms         int       `json:"open_forms"`
+	RecentClientForms int       `json:"recent_client_forms"`
+	LastClientFormAt  time.Time `json:"last_client_form_at"`
+}
+
+// DispatchScores são os pontos de cada critério.
+type DispatchScores struct {
+	Distance float64 `json:"distance"`
+	Workload float64 `json:"workload"`
+	History  float64 `json:"history"`
+	Role     float64 `json:"role"`
+}
+
+// DispatchSuggestion é um candidato pontuado. DistanceKm só vale com
+// Located; Reasons explica cada critério em texto para quem designa.
+type DispatchSuggestion struct {
+	DispatchCandidate
+	Located        bool           `json:"located"`
+	LocationSource string         `json:"location_source"`
+	DistanceKm     float64        `json:"distance_km"`
+	Score          float64        `json:"score"`
+	Scores         DispatchScores `json:"scores"`
+	Reasons        []string       `json:"reasons"`
+}
+
+// Location devolve o ponto de partida do técnico em now: a localização atual
+// enquanto recente, senão a base. O resultado é nil quando nenhuma vale.
+func (c *DispatchCandidate) Location(now time.Time) (*GeoPoint, string) {
+	if c.CurrentLocation != nil && !c.LocatedAt.IsZero() && now.Sub(c.LocatedAt) <= DispatchLocationMaxAge {
+		return c.CurrentLocation, DispatchLocationCurrent
+	}
+	if c.HomeLocation != nil {
+		return c.HomeLocation, DispatchLocationHome
+	}
+	return nil, ""
+}
+
+// RankDispatchCandidates pontua os candidatos para um atendimento do cliente
+// em client (nil quando o cliente não tem coordenadas) e os devolve do maior
+// para o menor placar, com empate decidido pelo nome. Sem coordenadas de um
+// dos lados o critério de distância vale zero para o candidato.
+func RankDispatchCandidates(candidates []DispatchCandidate, client *GeoPoint, now time.Time) []DispatchSuggestion {
+	suggestions := make([]DispatchSuggestion, 0, len(candidates))
+	for _, c := range candidates {
+		suggestions = append(suggestions, scoreDispatchCandidate(c, client, now))
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Name < suggestions[j].Name
+	})
+
+	return suggestions
+}
+
+func scoreDispatchCandidate(c DispatchCandidate, client *GeoPoint, now time.Time) DispatchSuggestion {
+	s := DispatchSuggestion{DispatchCandidate: c, Reasons: make([]string, 0, 4)}
+
+	origin, source := c.Location(now)
+	switch {
+	case client == nil:
+		s.Reasons = append(s.Reasons, "Cliente sem coordenadas: distância não considerada")
+	case origin == nil:
+		s.Reasons = append(s.Reasons, "Técnico sem localização atual ou base: distância não considerada")
+	default:
+		distance := HaversineKm(*origin, *client)
+		s.Located = true
+		s.LocationSource = source
+		s.DistanceKm = roundKm(distance)
+		s.Scores.Distance = DispatchWeightDistance * math.Max(0, 1-distance/DispatchMaxDistanceKm)
+		from := "da base"
+		if source == DispatchLocationCurrent {
+			from = "da localização atual"
+		}
+		s.Reasons = append(s.Reasons, fmt.Sprintf("A %s km do cliente, a partir %s", formatDecimal(s.DistanceKm, 1), from))
+	}
+
+	s.Scores.Workload = DispatchWeightWorkload * math.Max(0, 1-float64(c.OpenForms)/DispatchMaxOpenForms)
+	switch c.OpenForms {
+	case 0:
+		s.Reasons = append(s.Reasons, "Nenhum atendimento em aberto")
+	case 1:
+		s.Reasons = append(s.Reasons, "1 atendimento em aberto")
+	default:
+		s.Reasons = append(s.Reasons, fmt.Sprintf("%d atendimentos em aberto", c.OpenForms))
+	}
+
+	s.Scores.History = DispatchWeightHistory * math.Min(1, float64(c.RecentClientForms)/DispatchHistoryForms)
+	days := int(DispatchHistoryWindow.Hours() / 24)
+	switch c.RecentClientForms {
+	case 0:
+		s.Reasons = append(s.Reasons, fmt.Sprintf("Não atendeu este cliente nos últimos %d dias", days))
+	case 1:
+		s.Reasons = append(s.Reasons, fmt.Sprintf("Atendeu este cliente 1 vez nos últimos %d dias", days))
+	default:
+		s.Reasons = append(s.Reasons, fmt.Sprintf("Atendeu este cliente %d vezes nos últimos %d dias", c.RecentClientForms, days))
+	}
+
+	if c.Role == MemberRoleInternal {
+		s.Scores.Role = DispatchWeightRole
+		s.Reasons = append(s.Reasons, "Técnico interno")
+	} else {
+		s.Reasons = append(s.Reasons, "Técnico externo")
+	}
+
+	s.Scores = DispatchScores{
+		Distance: roundScore(s.Scores.Distance),
+		Workload: roundScore(s.Scores.Workload),
+		History:  roundScore(s.Scores.History),
+		Role:     roundScore(s.Scores.Role),
+	}
+	s.Score = roundScore(s.Scores.Distance + s.Scores.Workload + s.Scores.History + s.Scores.Role)
+
+	return s
+}
+
+// ValidateMemberLocation confere a localização atual ou a base informada para
+// o técnico.
+func ValidateMemberLocation(p GeoPoint) error {
+	if err := p.Validate(); err != nil {
+		return ErrInvalidMemberLocation
+	}
+	return nil
+}
+
+// NormalizeDispatchLimit aplica o padrão e o máximo ao número de sugestões.
+func NormalizeDispatchLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultDispatchLimit
+	}
+	return min(limit, MaxDispatchLimit)
+}
+
+// formatDecimal formata v com a vírgula decimal usada nos textos.
+func formatDecimal(v float64, decimals int) string {
+	return strings.Replace(fmt.Sprintf("%.*f", decimals, v), ".", ",", 1)
+}
@@ -0,0 +1,127 @@
+package domains
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchCandidate_Location(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	home := &GeoPoint{Latitude: -23.55, Longitude: -46.63}
+	current := &GeoPoint{Latitude: -23.60, Longitude: -46.70}
+
+	tests := []struct {
+		name      string
+		candidate DispatchCandidate
+		want      *GeoPoint
+		source    string
+	}{
+		{"recent current location", DispatchCandidate{HomeLocation: home, CurrentLocation: current, LocatedAt: now.Add(-time.Hour)}, current, DispatchLocationCurrent},
+		{"stale current location falls back to home", DispatchCandidate{HomeLocation: home, CurrentLocation: current, LocatedAt: now.Add(-DispatchLocationMaxAge - time.Minute)}, home, DispatchLocationHome},
+		{"home only", DispatchCandidate{HomeLocation: home}, home, DispatchLocationHome},
+		{"no location", DispatchCandidate{}, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, source := tt.candidate.Location(now)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.source, source)
+		})
+	}
+}
+
+func TestRankDispatchCandidates(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	client := &GeoPoint{Latitude: -23.55, Longitude: -46.63}
+
+	near := DispatchCandidate{
+		MemberID:          uuid.New(),
+		Name:              "Ana",
+		Role:              MemberRoleInternal,
+		CurrentLocation:   &GeoPoint{Latitude: -23.56, Longitude: -46.64},
+		LocatedAt:         now.Add(-time.Hour),
+		OpenForms:         2,
+		RecentClientForms: 1,
+	}
+	busy := DispatchCandidate{
+		MemberID:          uuid.New(),
+		Name:              "Bruno",
+		Role:              MemberRoleInternal,
+		HomeLocation:      client,
+		OpenForms:         12,
+		RecentClientForms: 5,
+	}
+	external := DispatchCandidate{
+		MemberID:     uuid.New(),
+		Name:         "Carla",
+		Role:         MemberRoleExternal,
+		HomeLocation: &GeoPoint{Latitude: -22.90, Longitude: -43.17},
+	}
+	unlocated := DispatchCandidate{MemberID: uuid.New(), Name: "Davi", Role: MemberRoleInternal, OpenForms: 10}
+
+	ranked := RankDispatchCandidates([]DispatchCandidate{unlocated, external, busy, near}, client, now)
+	require.Len(t, ranked, 4)
+	assert.Equal(t, []string{"Ana", "Bruno", "Carla", "Davi"}, []string{ranked[0].Name, ranked[1].Name, ranked[2].Name, ranked[3].Name})
+
+	first := ranked[0]
+	assert.True(t, first.Located)
+	assert.Equal(t, DispatchLocationCurrent, first.LocationSource)
+	assert.InDelta(t, 1.5, first.DistanceKm, 0.1)
+	assert.Equal(t, 24.0, first.Scores.Workload)
+	assert.Equal(t, 6.67, first.Scores.History)
+	assert.Equal(t, DispatchWeightRole, first.Scores.Role)
+	assert.Equal(t, first.Score, roundScore(first.Scores.Distance+first.Scores.Workload+first.Scores.History+first.Scores.Role))
+	assert.Len(t, first.Reasons, 4)
+	assert.Contains(t, first.Reasons[0], "a partir da localização atual")
+	assert.Equal(t, "2 atendimentos em aberto", first.Reasons[1])
+
+	second := ranked[1]
+	assert.Equal(t, DispatchLocationHome, second.LocationSource)
+	assert.Equal(t, DispatchWeightDistance, second.Scores.Distance)
+	assert.Zero(t, second.Scores.Workload, "workload above the limit scores zero")
+	assert.Equal(t, DispatchWeightHistory, second.Scores.History)
+
+	third := ranked[2]
+	assert.Zero(t, third.Scores.Distance, "farther than the limit scores zero")
+	assert.Zero(t, third.Scores.Role)
+	assert.Equal(t, "Técnico externo", third.Reasons[3])
+
+	last := ranked[3]
+	assert.False(t, last.Located)
+	assert.Equal(t, DispatchWeightRole, last.Score, "only the role counts without location and with full workload")
+	assert.Equal(t, "Técnico sem localização atual ou base: distância não considerada", last.Reasons[0])
+}
+
+func TestRankDispatchCandidates_ClientWithoutLocation(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	candidates := []DispatchCandidate{
+		{Name: "Bruno", Role: MemberRoleInternal, HomeLocation: &GeoPoint{Latitude: -23.55, Longitude: -46.63}},
+		{Name: "Ana", Role: MemberRoleInternal},
+	}
+
+	ranked := RankDispatchCandidates(candidates, nil, now)
+	require.Len(t, ranked, 2)
+	assert.Equal(t, "Ana", ranked[0].Name, "ties are broken by name")
+	for _, s := range ranked {
+		assert.False(t, s.Located)
+		assert.Zero(t, s.Scores.Distance)
+		assert.Equal(t, "Cliente sem coordenadas: distância não considerada", s.Reasons[0])
+	}
+}
+
+func TestValidateMemberLocation(t *testing.T) {
+	assert.NoError(t, ValidateMemberLocation(GeoPoint{Latitude: -23.55, Longitude: -46.63}))
+	assert.ErrorIs(t, ValidateMemberLocation(GeoPoint{Latitude: 91}), ErrInvalidMemberLocation)
+	assert.ErrorIs(t, ValidateMemberLocation(GeoPoint{Longitude: math.NaN()}), ErrInvalidMemberLocation)
+}
+
+func TestNormalizeDispatchLimit(t *testing.T) {
+	assert.Equal(t, DefaultDispatchLimit, NormalizeDispatchLimit(0))
+	assert.Equal(t, 3, NormalizeDispatchLimit(3))
+	assert.Equal(t, MaxDispatchLimit, NormalizeDispatchLimit(MaxDispatchLimit+1))
+}
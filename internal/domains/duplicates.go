@@ -0,0 +1,233 @@
+package domains
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	DuplicateReasonDocument = "documento"
+	DuplicateReasonEmail    = "email"
+	DuplicateReasonPhone    = "telefone"
+	DuplicateReasonName     = "nome"
+)
+
+// Pesos de cada critério na pontuação de duplicidade. A soma é limitada a 1.
+const (
+	duplicateWeightDocument = 0.6
+	duplicateWeightEmail    = 0.35
+	duplicateWeightPhone    = 0.3
+	duplicateWeightName     = 0.5
+)
+
+// DuplicateScoreThreshold é a pontuação mínima para que dois clientes sejam
+// considerados prováveis duplicados.
+var DuplicateScoreThreshold = 0.5
+
+// NameSimilarityThreshold é a similaridade mínima de nomes (trigramas) para
+// que o nome conte na pontuação.
+var NameSimilarityThreshold = 0.6
+
+// companySuffixes são termos de razão social ignorados na comparação de nomes.
+var companySuffixes = map[string]struct{}{
+	"ltda": {}, "me": {}, "epp": {}, "eireli": {}, "sa": {}, "s/a": {}, "mei": {},
+}
+
+type DuplicateCandidate struct {
+	Client  Client   `json:"client"`
+	Score   float64  `json:"score"`
+	Reasons []string `json:"reasons"`
+}
+
+type DuplicatePair struct {
+	First   Client   `json:"first"`
+	Second  Client   `json:"second"`
+	Score   float64  `json:"score"`
+	Reasons []string `json:"reasons"`
+}
+
+// ClientMerge registra a unificação de um cliente duplicado (Source) no
+// cliente que permanece (Target).
+type ClientMerge struct {
+	ID             uuid.UUID `json:"id"`
+	SourceClientID uuid.UUID `json:"source_client_id"`
+	TargetClientID uuid.UUID `json:"target_client_id"`
+	SourceSnapshot Client    `json:"source_snapshot"`
+	FormsMoved     int64     `json:"forms_moved"`
+	ContractsMoved int64     `json:"contracts_moved"`
+	MergedBy       uuid.UUID `json:"merged_by"`
+	MergedAt       time.Time `json:"merged_at"`
+}
+
+func (m *ClientMerge) Validate() error {
+	if m.SourceClientID == uuid.Nil || m.TargetClientID == uuid.Nil {
+		return ErrInvalidClienteId
+	}
+	if m.SourceClientID == m.TargetClientID {
+		return ErrClientMergeSameClient
+	}
+	return nil
+}
+
+// CheckContracts aplica aos contratos que passam do cliente de origem as
+// regras de criação de contratos: o destino precisa ser do tipo contrato e
+// nenhum contrato transferido pode coincidir com um contrato do destino.
+func (m *ClientMerge) CheckContracts(targetClientType string, sourceContracts, overlapping int64) error {
+	if sourceContracts == 0 {
+		return nil
+	}
+	if targetClientType != "contrato" {
+		return ErrContractClientType
+	}
+	if overlapping > 0 {
+		return ErrContractOverlap
+	}
+	return nil
+}
+
+// DuplicateScore compara dois clientes e retorna a pontuação de duplicidade
+// (0 a 1) e os critérios que coincidiram.
+func DuplicateScore(a, b *Client) (float64, []string) {
+	score := 0.0
+	reasons := make([]string, 0)
+
+	if docA := NormalizeDocument(a.CnpjOrCpf); docA != "" && docA == NormalizeDocument(b.CnpjOrCpf) {
+		score += duplicateWeightDocument
+		reasons = append(reasons, DuplicateReasonDocument)
+	}
+	if emailA := NormalizeEmail(a.Contact.Email); emailA != "" && emailA == NormalizeEmail(b.Contact.Email) {
+		score += duplicateWeightEmail
+		reasons = append(reasons, DuplicateReasonEmail)
+	}
+	if phoneA := NormalizePhone(a.Contact.Phone); phoneA != "" && phoneA == NormalizePhone(b.Contact.Phone) {
+		score += duplicateWeightPhone
+		reasons = append(reasons, DuplicateReasonPhone)
+	}
+	if similarity := NameSimilarity(a.ClientName, b.ClientName); similarity >= NameSimilarityThreshold {
+		score += duplicateWeightName * similarity
+		reasons = append(reasons, DuplicateReasonName)
+	}
+
+	if score > 1 {
+		score = 1
+	}
+	return score, reasons
+}
+
+// FindDuplicates retorna os clientes existentes que provavelmente são o mesmo
+// cliente informado, do mais para o menos provável.
+func FindDuplicates(client *Client, existing []*Client) []DuplicateCandidate {
+	candidates := make([]DuplicateCandidate, 0)
+	for _, other := range existing {
+		if other.ID == client.ID {
+			continue
+		}
+		if score, reasons := DuplicateScore(client, other); score >= DuplicateScoreThreshold {
+			candidates = append(candidates, DuplicateCandidate{Client: *other, Score: score, Reasons: reasons})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Score > candidates[j].Score })
+	return candidates
+}
+
+// FindDuplicatePairs compara todos os clientes entre si e retorna os pares de
+// prováveis duplicados, do mais para o menos provável.
+func FindDuplicatePairs(clients []*Client) []DuplicatePair {
+	pairs := make([]DuplicatePair, 0)
+	for i := range clients {
+		for j := i + 1; j < len(clients); j++ {
+			if score, reasons := DuplicateScore(clients[i], clients[j]); score >= DuplicateScoreThreshold {
+				pairs = append(pairs, DuplicatePair{First: *clients[i], Second: *clients[j], Score: score, Reasons: reasons})
+			}
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].Score > pairs[j].Score })
+	return pairs
+}
+
+// NormalizeDocument mantém apenas os dígitos de um CPF/CNPJ.
+func NormalizeDocument(doc string) string {
+	return digitsOnly(doc)
+}
+
+// NormalizeEmail remove espaços e ignora maiúsculas/minúsculas.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizePhone mantém apenas os dígitos do telefone, sem o DDI do Brasil.
+func NormalizePhone(phone string) string {
+	digits := digitsOnly(phone)
+	if len(digits) > 11 && strings.HasPrefix(digits, "55") {
+		digits = digits[2:]
+	}
+	return digits
+}
+
+// NormalizeName remove acentos, pontuação e sufixos de razão social do nome.
+func NormalizeName(name string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	plain, _, err := transform.String(t, strings.ToLower(name))
+	if err != nil {
+		plain = strings.ToLower(name)
+	}
+
+	words := strings.FieldsFunc(plain, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '/'
+	})
+	kept := make([]string, 0, len(words))
+	for _, w := range words {
+		if _, ok := companySuffixes[w]; ok {
+			continue
+		}
+		if w = strings.Trim(w, "/"); w != "" {
+			kept = append(kept, w)
+		}
+	}
+	return strings.Join(kept, " ")
+}
+
+// NameSimilarity calcula a similaridade por trigramas (como o pg_trgm) entre
+// dois nomes normalizados, de 0 a 1.
+func NameSimilarity(a, b string) float64 {
+	ta, tb := trigrams(NormalizeName(a)), trigrams(NormalizeName(b))
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for t := range ta {
+		if _, ok := tb[t]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+func trigrams(s string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, word := range strings.Fields(s) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
+
+func digitsOnly(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
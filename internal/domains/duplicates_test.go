@@ -0,0 +1,124 @@
+package domains
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeHelpers(t *testing.T) {
+	assert.Equal(t, "12345678000190", NormalizeDocument("12.345.678/0001-90"))
+	assert.Equal(t, "joao@example.com", NormalizeEmail("  Joao@Example.COM "))
+	assert.Equal(t, "11912345678", NormalizePhone("+55 (11) 91234-5678"))
+	assert.Equal(t, "11912345678", NormalizePhone("(11) 91234-5678"))
+	assert.Equal(t, "padaria sao joao", NormalizeName("Padaria São João LTDA."))
+}
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		min  float64
+		max  float64
+	}{
+		{"identical", "Padaria Central", "Padaria Central", 1, 1},
+		{"accents and suffix", "Padaria São João LTDA", "padaria sao joao", 1, 1},
+		{"typo", "Padaria Central", "Padaria Centrall", 0.6, 0.99},
+		{"different", "Padaria Central", "Oficina do Zé", 0, 0.2},
+		{"empty", "", "Padaria", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NameSimilarity(tt.a, tt.b)
+			assert.GreaterOrEqual(t, got, tt.min)
+			assert.LessOrEqual(t, got, tt.max)
+		})
+	}
+}
+
+func TestDuplicateScore(t *testing.T) {
+	base := Client{
+		ID:         uuid.New(),
+		ClientName: "Padaria Central LTDA",
+		CnpjOrCpf:  "12.345.678/0001-90",
+		Contact:    ContactPerson{Email: "contato@padaria.com", Phone: "+5511912345678"},
+	}
+
+	tests := []struct {
+		name        string
+		other       Client
+		wantReasons []string
+		duplicate   bool
+	}{
+		{
+			name:        "same document only",
+			other:       Client{ClientName: "Outro Nome", CnpjOrCpf: "12345678000190"},
+			wantReasons: []string{DuplicateReasonDocument},
+			duplicate:   true,
+		},
+		{
+			name:        "same email and similar name",
+			other:       Client{ClientName: "Padaria Central", Contact: ContactPerson{Email: "CONTATO@padaria.com"}},
+			wantReasons: []string{DuplicateReasonEmail, DuplicateReasonName},
+			duplicate:   true,
+		},
+		{
+			name:        "same phone only",
+			other:       Client{ClientName: "Mercado Bom Preço", Contact: ContactPerson{Phone: "(11) 91234-5678"}},
+			wantReasons: []string{DuplicateReasonPhone},
+			duplicate:   false,
+		},
+		{
+			name:        "unrelated client",
+			other:       Client{ClientName: "Oficina do Zé", CnpjOrCpf: "98765432000110"},
+			wantReasons: []string{},
+			duplicate:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, reasons := DuplicateScore(&base, &tt.other)
+			assert.Equal(t, tt.wantReasons, reasons)
+			assert.Equal(t, tt.duplicate, score >= DuplicateScoreThreshold)
+			assert.LessOrEqual(t, score, 1.0)
+		})
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	client := &Client{ClientName: "Padaria Central", CnpjOrCpf: "12345678000190"}
+	strong := &Client{ID: uuid.New(), ClientName: "Padaria Central LTDA", CnpjOrCpf: "12.345.678/0001-90"}
+	weak := &Client{ID: uuid.New(), ClientName: "Padaria Central"}
+	other := &Client{ID: uuid.New(), ClientName: "Oficina do Zé"}
+
+	candidates := FindDuplicates(client, []*Client{weak, other, strong})
+	assert.Len(t, candidates, 2)
+	assert.Equal(t, strong.ID, candidates[0].Client.ID, "strongest match must come first")
+	assert.Equal(t, weak.ID, candidates[1].Client.ID)
+
+	pairs := FindDuplicatePairs([]*Client{strong, weak, other})
+	assert.Len(t, pairs, 1)
+	assert.Equal(t, strong.ID, pairs[0].First.ID)
+	assert.Equal(t, weak.ID, pairs[0].Second.ID)
+}
+
+func TestClientMerge_Validate(t *testing.T) {
+	id := uuid.New()
+
+	assert.NoError(t, (&ClientMerge{SourceClientID: uuid.New(), TargetClientID: id}).Validate())
+	assert.ErrorIs(t, (&ClientMerge{SourceClientID: id, TargetClientID: id}).Validate(), ErrClientMergeSameClient)
+	assert.ErrorIs(t, (&ClientMerge{TargetClientID: id}).Validate(), ErrInvalidClienteId)
+}
+
+func TestClientMerge_CheckContracts(t *testing.T) {
+	m := &ClientMerge{SourceClientID: uuid.New(), TargetClientID: uuid.New()}
+
+	assert.NoError(t, m.CheckContracts("avulso", 0, 0))
+	assert.NoError(t, m.CheckContracts("contrato", 2, 0))
+	assert.ErrorIs(t, m.CheckContracts("avulso", 1, 0), ErrContractClientType)
+	assert.ErrorIs(t, m.CheckContracts("contrato", 2, 1), ErrContractOverlap)
+}
@@ -21,22 +21,206 @@ var (
 	ErrInvalidTecnicoResponsavelId = errors.New("invalid technician responsible ID")
 	ErrInvalidDataDeAbertura       = errors.New("invalid open date")
 	ErrInvalidSolutionDescription  = errors.New("solution description invalid")
+	ErrInvalidHoursConsumed        = errors.New("hours consumed must not be negative")
+	ErrFormNotFound                = errors.New("form not found")
+	ErrInvalidFormStatus           = errors.New("invalid form status")
+	ErrInvalidFormTransition       = errors.New("form status transition not allowed")
+	ErrFormStatusReasonRequired    = errors.New("a reason is required to cancel or reopen a form")
+	ErrFormStatusConflict          = errors.New("form status changed concurrently")
+	ErrInvalidFormPeriod           = errors.New("occurred_at range end is before its start")
+	ErrInvalidPageSize             = errors.New("page size out of range")
+	ErrInvalidFormCode             = errors.New("invalid form protocol code")
+	ErrInvalidSearchQuery          = errors.New("search query must have between 1 and 200 characters")
+	ErrInvalidSimilarFeedback      = errors.New("a form cannot be marked as similar to itself")
 
 	// Client validation errors
-	ErrInvalidClientName    = errors.New("client name is required")
-	ErrInvalidClientType    = errors.New("client type is required")
-	ErrInvalidCnpjOrCpf     = errors.New("cnpj or cpf is required")
-	ErrInvalidContactPerson = errors.New("contact person is required")
-	ErrInvalidContactEmail  = errors.New("contact email is required")
-	ErrInvalidContactPhone  = errors.New("contact phone is required")
-	ErrInvalidContactName   = errors.New("contact name is required")
-	ErrInvalidAddress       = errors.New("address is required")
-	ErrInvalidPostalCode    = errors.New("postal code is required")
-	ErrInvalidCountry       = errors.New("country is required")
-	ErrInvalidState         = errors.New("state is required")
-	ErrInvalidCity          = errors.New("city is required")
-	ErrInvalidStreet        = errors.New("street is required")
-	ErrInvalidNumber        = errors.New("number is required")
+	ErrInvalidClientName     = errors.New("client name is required")
+	ErrInvalidClientType     = errors.New("client type is required")
+	ErrInvalidCnpjOrCpf      = errors.New("cnpj or cpf is required")
+	ErrInvalidContactPerson  = errors.New("contact person is required")
+	ErrInvalidContactEmail   = errors.New("contact email is required")
+	ErrInvalidContactPhone   = errors.New("contact phone is required")
+	ErrInvalidContactName    = errors.New("contact name is required")
+	ErrInvalidAddress        = errors.New("address is required")
+	ErrInvalidPostalCode     = errors.New("postal code is required")
+	ErrInvalidCountry        = errors.New("country is required")
+	ErrInvalidState          = errors.New("state is required")
+	ErrInvalidCity           = errors.New("city is required")
+	ErrInvalidStreet         = errors.New("street is required")
+	ErrInvalidNumber         = errors.New("number is required")
+	ErrClientNotFound        = errors.New("client not found")
+	ErrClientHasHistory      = errors.New("client has forms, contracts, invoices or merges and cannot be purged")
+	ErrClientMergeSameClient = errors.New("cannot merge a client into itself")
+
+	// Contract validation errors
+	ErrInvalidContractPeriod = errors.New("contract end date must not be before start date")
+	ErrInvalidContractHours  = errors.New("contract monthly hours must be positive")
+	ErrInvalidContractSLA    = errors.New("contract resolution sla must not be shorter than response sla")
+	ErrInvalidContractPrice  = errors.New("contract price must not be negative")
+	ErrContractClientType    = errors.New("contracts are only allowed for contrato clients")
+	ErrContractOverlap       = errors.New("client already has a contract in this period")
+	ErrContractNotFound      = errors.New("contract not found")
+
+	// Custom field errors
+	ErrInvalidCustomFieldEntity  = errors.New("custom field entity must be cliente or atendimento")
+	ErrInvalidCustomFieldKey     = errors.New("custom field key must be lowercase letters, digits or underscores")
+	ErrInvalidCustomFieldLabel   = errors.New("custom field label is required")
+	ErrInvalidCustomFieldType    = errors.New("invalid custom field type")
+	ErrInvalidCustomFieldOptions = errors.New("select fields need unique, non-empty options and other types must not have options")
+	ErrInvalidCustomFieldRules   = errors.New("invalid custom field validation rules")
+	ErrInvalidCustomFieldValue   = errors.New("invalid custom field value")
+	ErrUnknownCustomField        = errors.New("unknown custom field")
+	ErrCustomFieldRequired       = errors.New("custom field is required")
+	ErrCustomFieldKeyTaken       = errors.New("custom field key already exists for this entity")
+	ErrCustomFieldNotFound       = errors.New("custom field not found")
+	ErrInvalidTag                = errors.New("tags must have at most 50 characters")
+	ErrTooManyTags               = errors.New("a record can have at most 20 tags")
+
+	// Client portal errors
+	ErrClientUserNotFound              = errors.New("client user not found")
+	ErrClientUserEmailTaken            = errors.New("client user email already exists")
+	ErrInvalidPortalRequestDescription = errors.New("request description is required and must have at most 5000 characters")
+	ErrInvalidPortalRequestNote        = errors.New("a reason is required to reject a request")
+	ErrInvalidPortalRequestStatus      = errors.New("request status must be convertida or recusada")
+	ErrPortalRequestNotFound           = errors.New("portal request not found")
+	ErrPortalRequestClosed             = errors.New("portal request was already reviewed")
+	ErrPortalRequestFormMismatch       = errors.New("form belongs to another client")
+
+	// Attachment errors
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrInvalidAttachmentName     = errors.New("attachment file name is required")
+	ErrEmptyAttachment           = errors.New("attachment is empty")
+	ErrAttachmentTooLarge        = errors.New("attachment exceeds the maximum size")
+	ErrAttachmentTypeNotAllowed  = errors.New("attachment content type is not allowed")
+	ErrInvalidAttachmentChecksum = errors.New("attachment checksum must be a hex SHA-256")
+
+	// Comment errors
+	ErrCommentNotFound    = errors.New("comment not found")
+	ErrInvalidCommentBody = errors.New("comment body is required and must have at most 10000 characters")
+	ErrCommentForbidden   = errors.New("only the author or an administrator can edit the comment")
+
+	// Work log errors
+	ErrWorkLogNotFound       = errors.New("work log not found")
+	ErrMemberNotFound        = errors.New("member not found")
+	ErrWorkLogMemberRequired = errors.New("work log technician is required")
+	ErrInvalidWorkLogKind    = errors.New("work log kind must be deslocamento or no_local")
+	ErrInvalidWorkLogNotes   = errors.New("work log notes must have at most 2000 characters")
+	ErrInvalidWorkLogPeriod  = errors.New("work log must end after it starts, not in the future and within 24 hours")
+	ErrInvalidWorkLogGroup   = errors.New("work log totals must be grouped by atendimento, tecnico or cliente")
+	ErrInvalidWorkLogRange   = errors.New("work log totals period end must be after its start")
+	ErrWorkLogOverlap        = errors.New("technician already has a work log in this period")
+	ErrWorkLogNotRunning     = errors.New("work log timer is not running")
+
+	// Signature errors
+	ErrSignatureNotFound        = errors.New("signature not found")
+	ErrFormSigned               = errors.New("signed forms can only be changed by an administrator")
+	ErrFormAlreadySigned        = errors.New("form was already signed")
+	ErrFormNotCompleted         = errors.New("only resolved or closed forms can be signed")
+	ErrInvalidSignatureFormat   = errors.New("signature format must be png, svg or tracos")
+	ErrInvalidSignatureContent  = errors.New("signature content is empty, too large or does not match its format")
+	ErrInvalidSignerName        = errors.New("signer name is required and must have at most 200 characters")
+	ErrInvalidSignerDocument    = errors.New("signer document is required and must have at most 30 characters")
+	ErrInvalidSignatureTime     = errors.New("signature time is required and must not be in the future")
+	ErrInvalidSignatureLocation = errors.New("signature location must have a valid latitude and longitude")
+	ErrInvalidSnapshotVersion   = errors.New("signature snapshot version is not supported")
+
+	// Service order errors
+	ErrInvalidServiceOrderTemplate = errors.New("service order template has an invalid or too long field")
+	ErrInvalidServiceOrderColor    = errors.New("service order color must be in the #RRGGBB format")
+	ErrInvalidServiceOrderTimezone = errors.New("service order timezone must be a valid IANA time zone")
+	ErrServiceOrderLogoTooLarge    = errors.New("service order logo exceeds the maximum size")
+	ErrServiceOrderLogoType        = errors.New("service order logo must be a PNG or JPEG image")
+
+	// SLA errors
+	ErrInvalidSLAPolicy     = errors.New("sla policy response time must be positive and resolution time must not be shorter than it")
+	ErrSLAPolicyNotFound    = errors.New("sla policy not found")
+	ErrInvalidHoliday       = errors.New("holiday date and name are required, name must have at most 100 characters")
+	ErrHolidayAlreadyExists = errors.New("holiday already exists for this date")
+	ErrHolidayNotFound      = errors.New("holiday not found")
+	ErrInvalidBusinessHours = errors.New("business hours must have a start before the end and at least one workday")
+	ErrNotificationNotFound = errors.New("notification not found")
+
+	// Maintenance plan errors
+	ErrInvalidRecurrence       = errors.New("recurrence must be a supported RRULE (FREQ, INTERVAL, BYDAY, BYMONTHDAY, COUNT or UNTIL)")
+	ErrInvalidMaintenancePlan  = errors.New("maintenance plan name, client, start, difficulty level and at least one technician are required")
+	ErrInvalidChecklist        = errors.New("checklist items must have 1 to 200 characters and at most 50 items")
+	ErrMaintenancePlanNotFound = errors.New("maintenance plan not found")
+	ErrMaintenancePlanPaused   = errors.New("maintenance plan is already paused")
+	ErrMaintenancePlanActive   = errors.New("maintenance plan is already active")
+	ErrChecklistItemNotFound   = errors.New("checklist item not found")
+
+	// Scheduling errors
+	ErrInvalidSchedule      = errors.New("schedule must have a technician, a start and an end after the start, at most 24 hours later, once per technician")
+	ErrInvalidCalendarRange = errors.New("calendar range must have a start before the end and span at most 62 days")
+	ErrScheduleConflict     = errors.New("technician is already scheduled for another form in this period")
+	ErrMemberNotAssigned    = errors.New("technician is not assigned to this form")
+	ErrNoFreeSlot           = errors.New("no free slot found within the search range")
+	ErrInvalidRouteOptions  = errors.New("route origin must be a valid coordinate, speed must be between 0 and 200 km/h and stop duration at most 12 hours")
+
+	// Parts and stock errors
+	ErrInvalidPart           = errors.New("part sku and name are required, prices must be non-negative with at most 2 decimal places")
+	ErrInvalidPartUnit       = errors.New("part unit must be one of un, par, cx, kit, m, rolo, kg or l")
+	ErrPartNotFound          = errors.New("part not found")
+	ErrPartSKUAlreadyExists  = errors.New("part sku already exists")
+	ErrPartInactive          = errors.New("part is inactive")
+	ErrInvalidStockLocation  = errors.New("stock location needs a name and a kind; vans need a technician and warehouses must not have one")
+	ErrStockLocationNotFound = errors.New("stock location not found")
+	ErrStockLocationExists   = errors.New("technician already has a van")
+	ErrInvalidStockMovement  = errors.New("stock movement needs a part, a location and a non-zero quantity with at most 3 decimal places")
+	ErrInsufficientStock     = errors.New("insufficient stock at the location")
+	ErrInvalidFormPart       = errors.New("form part needs a part, a location, a positive quantity and a non-negative unit price")
+	ErrFormPartNotFound      = errors.New("form part not found")
+
+	// Invoicing errors
+	ErrInvalidBillingSettings     = errors.New("billing rates and travel fee must be non-negative with at most 2 decimal places and the tax rate between 0 and 100")
+	ErrInvalidInvoice             = errors.New("invoice needs a client, 1 to 100 forms with billable items and notes up to 2000 characters")
+	ErrInvalidInvoiceDiscount     = errors.New("invoice discount must be non-negative with at most 2 decimal places and not exceed the subtotal")
+	ErrInvalidInvoiceTaxRate      = errors.New("invoice tax rate must be between 0 and 100 with at most 2 decimal places")
+	ErrInvalidInvoiceStatus       = errors.New("invoice status must be one of rascunho, emitida, paga or cancelada")
+	ErrInvalidInvoiceTransition   = errors.New("invoice status transition not allowed")
+	ErrInvalidInvoiceCancelReason = errors.New("a reason up to 500 characters is required to cancel an issued invoice")
+	ErrInvoiceNotFound            = errors.New("invoice not found")
+	ErrInvoiceNotDraft            = errors.New("only draft invoices can be changed")
+	ErrInvoiceStatusConflict      = errors.New("invoice status was changed concurrently")
+	ErrFormNotInvoiceable         = errors.New("only resolved or closed forms can be invoiced")
+	ErrFormUnderContract          = errors.New("form is covered by a contract and cannot be invoiced")
+	ErrFormAlreadyInvoiced        = errors.New("form is already in another invoice")
+	ErrInvoiceClientMismatch      = errors.New("all forms of an invoice must belong to the same client")
+	ErrFormNothingToInvoice       = errors.New("form has no hours, parts or travel to invoice")
+
+	// Checklist template errors
+	ErrInvalidChecklistTemplate   = errors.New("checklist template needs a name up to 100 characters and 1 to 50 items with a label and a valid kind; unit and range only for numbers")
+	ErrChecklistTemplateNotFound  = errors.New("checklist template not found")
+	ErrChecklistTemplateNameTaken = errors.New("checklist template name already exists")
+	ErrInvalidChecklistAnswer     = errors.New("checklist answer does not match the item kind")
+	ErrChecklistPhotoNotImage     = errors.New("checklist photo must be an image attachment of the form")
+	ErrChecklistIncomplete        = errors.New("form has required checklist items without an answer")
+
+	// Form type errors
+	ErrInvalidFormType         = errors.New("form type needs a name up to 100 characters and a description up to 500")
+	ErrInvalidFormSchema       = errors.New("invalid form schema")
+	ErrFormTypeNotFound        = errors.New("form type not found")
+	ErrFormTypeNameTaken       = errors.New("form type name already exists")
+	ErrFormTypeVersionNotFound = errors.New("form type version not found")
+	ErrFormTypeInactive        = errors.New("form type is inactive")
+	ErrFormDataWithoutType     = errors.New("form data requires a form type")
+	ErrInvalidFormDataValue    = errors.New("invalid form data value")
+	ErrFormDataRequired        = errors.New("form data field is required")
+	ErrUnknownFormDataField    = errors.New("unknown form data field")
+	ErrFormDataFieldHidden     = errors.New("form data field does not apply to the other answers")
+
+	// Satisfaction survey errors
+	ErrSurveyNotFound        = errors.New("satisfaction survey not found")
+	ErrSurveyExpired         = errors.New("satisfaction survey link has expired")
+	ErrSurveyAlreadyAnswered = errors.New("satisfaction survey was already answered")
+	ErrInvalidSurveyAnswer   = errors.New("survey answer needs a csat score from 1 to 5, an nps score from 0 to 10 and a comment up to 1000 characters")
+	ErrInvalidSurveySummary  = errors.New("survey summary needs a valid grouping, a period granularity when grouping by period and an end after the start")
+
+	// Dispatch suggestion errors
+	ErrInvalidMemberLocation   = errors.New("member location needs a valid latitude and longitude")
+	ErrMemberLocationForbidden = errors.New("only the technician or an administrator can update the current location")
+	ErrFormTechniciansRequired = errors.New("form needs at least one technician or auto assignment")
+	ErrNoDispatchCandidates    = errors.New("no active technician available for auto assignment")
 
 	ErrNoContent = errors.New("no content")
 )
@@ -0,0 +1,73 @@
+package domains
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ações registradas no histórico de atribuição de técnicos.
+const (
+	AssignmentAdded   = "atribuido"
+	AssignmentRemoved = "removido"
+)
+
+// FormAssignmentChange registra a entrada ou a saída de um técnico de um
+// atendimento.
+type FormAssignmentChange struct {
+	ID            uuid.UUID `json:"id"`
+	FormID        uuid.UUID `json:"form_id"`
+	MemberID      uuid.UUID `json:"member_id"`
+	MemberName    string    `json:"member_name"`
+	Action        string    `json:"action"`
+	ChangedBy     uuid.UUID `json:"changed_by"`
+	ChangedByName string    `json:"changed_by_name"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
+
+// AssignTo troca os técnicos do atendimento e devolve as mudanças em relação
+// aos atuais: primeiro as saídas, depois as entradas, na ordem informada.
+// IDs repetidos são ignorados.
+func (a *Atendimentos) AssignTo(memberIDs []uuid.UUID, by uuid.UUID, at time.Time) []*FormAssignmentChange {
+	current := make(map[uuid.UUID]bool, len(a.TecnicoResponsavelId))
+	for _, m := range a.TecnicoResponsavelId {
+		current[m.ID] = true
+	}
+	next := make(map[uuid.UUID]bool, len(memberIDs))
+	members := make([]Member, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		if next[id] {
+			continue
+		}
+		next[id] = true
+		members = append(members, Member{ID: id})
+	}
+
+	changes := make([]*FormAssignmentChange, 0)
+	for _, m := range a.TecnicoResponsavelId {
+		if !next[m.ID] {
+			changes = append(changes, &FormAssignmentChange{
+				FormID:     a.ID,
+				MemberID:   m.ID,
+				MemberName: m.Name,
+				Action:     AssignmentRemoved,
+				ChangedBy:  by,
+				ChangedAt:  at,
+			})
+		}
+	}
+	for _, m := range members {
+		if !current[m.ID] {
+			changes = append(changes, &FormAssignmentChange{
+				FormID:    a.ID,
+				MemberID:  m.ID,
+				Action:    AssignmentAdded,
+				ChangedBy: by,
+				ChangedAt: at,
+			})
+		}
+	}
+
+	a.TecnicoResponsavelId = members
+	return changes
+}
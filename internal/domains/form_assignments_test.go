@@ -0,0 +1,38 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtendimentos_AssignTo(t *testing.T) {
+	by := uuid.New()
+	at := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+	ana, bruno, carla := uuid.New(), uuid.New(), uuid.New()
+
+	form := Atendimentos{
+		ID:                   uuid.New(),
+		TecnicoResponsavelId: []Member{{ID: ana, Name: "Ana"}, {ID: bruno, Name: "Bruno"}},
+	}
+
+	changes := form.AssignTo([]uuid.UUID{bruno, carla, carla}, by, at)
+
+	if assert.Len(t, changes, 2) {
+		assert.Equal(t, AssignmentRemoved, changes[0].Action)
+		assert.Equal(t, ana, changes[0].MemberID)
+		assert.Equal(t, "Ana", changes[0].MemberName)
+		assert.Equal(t, AssignmentAdded, changes[1].Action)
+		assert.Equal(t, carla, changes[1].MemberID)
+		for _, c := range changes {
+			assert.Equal(t, form.ID, c.FormID)
+			assert.Equal(t, by, c.ChangedBy)
+			assert.Equal(t, at, c.ChangedAt)
+		}
+	}
+	assert.Equal(t, []Member{{ID: bruno}, {ID: carla}}, form.TecnicoResponsavelId)
+
+	assert.Empty(t, form.AssignTo([]uuid.UUID{carla, bruno}, by, at))
+}
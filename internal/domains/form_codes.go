@@ -0,0 +1,79 @@
+package domains
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Os atendimentos recebem um protocolo legível, como "OS-2026-000123", para
+// serem citados por telefone. O número recomeça a cada ano e é atribuído na
+// gravação do atendimento, em sequência e sem lacunas.
+const (
+	FormCodePrefix = "OS"
+	formCodeDigits = 6
+	// MaxFormCodeSearch limita o trecho de protocolo usado na busca.
+	MaxFormCodeSearch = 20
+)
+
+// FormCode monta o protocolo do número no ano. Números acima de 999999 usam
+// mais dígitos.
+func FormCode(year int, number int64) string {
+	return fmt.Sprintf("%s-%04d-%0*d", FormCodePrefix, year, formCodeDigits, number)
+}
+
+// FormCodeYear devolve o ano de at no fuso da organização, o mesmo usado ao
+// numerar os atendimentos antigos, para que a virada do ano em UTC não
+// antecipe a sequência do ano seguinte.
+func FormCodeYear(at time.Time, loc *time.Location) int {
+	return at.In(loc).Year()
+}
+
+// ParseFormCode normaliza um protocolo digitado. Aceita minúsculas, espaços
+// nas pontas, o prefixo omitido e o número sem os zeros à esquerda, de modo
+// que "os-2026-123" e "2026-000123" resolvem para "OS-2026-000123".
+func ParseFormCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	code = strings.TrimPrefix(code, FormCodePrefix+"-")
+
+	yearPart, numberPart, ok := strings.Cut(code, "-")
+	if !ok || len(yearPart) != 4 || !isDigits(yearPart) || numberPart == "" || len(numberPart) > 12 || !isDigits(numberPart) {
+		return "", ErrInvalidFormCode
+	}
+	year, _ := strconv.Atoi(yearPart)
+	number, _ := strconv.ParseInt(numberPart, 10, 64)
+	if year < 1000 || number < 1 {
+		return "", ErrInvalidFormCode
+	}
+	return FormCode(year, number), nil
+}
+
+// FormCodeSearchTerm prepara o trecho de protocolo usado para filtrar a
+// listagem: um protocolo completo é normalizado com ParseFormCode; qualquer
+// outro trecho vai em maiúsculas, só com letras, dígitos e hífen.
+func FormCodeSearchTerm(s string) (string, error) {
+	if code, err := ParseFormCode(s); err == nil {
+		return code, nil
+	}
+
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if s == "" || len(s) > MaxFormCodeSearch {
+		return "", ErrInvalidFormCode
+	}
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' {
+			return "", ErrInvalidFormCode
+		}
+	}
+	return s, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,75 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormCode(t *testing.T) {
+	assert.Equal(t, "OS-2026-000123", FormCode(2026, 123))
+	assert.Equal(t, "OS-2026-000001", FormCode(2026, 1))
+	assert.Equal(t, "OS-2027-1234567", FormCode(2027, 1234567))
+}
+
+func TestFormCodeYear(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	assert.NoError(t, err)
+
+	// 22h de 31/12 em São Paulo já é 1º de janeiro em UTC.
+	newYearsEve := time.Date(2026, time.December, 31, 22, 0, 0, 0, loc)
+	assert.Equal(t, 2027, newYearsEve.UTC().Year())
+	assert.Equal(t, 2026, FormCodeYear(newYearsEve.UTC(), loc))
+	assert.Equal(t, 2027, FormCodeYear(newYearsEve.Add(3*time.Hour), loc))
+}
+
+func TestParseFormCode(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    string
+		expectedErr error
+	}{
+		{input: "OS-2026-000123", expected: "OS-2026-000123"},
+		{input: "  os-2026-123 ", expected: "OS-2026-000123"},
+		{input: "2026-000123", expected: "OS-2026-000123"},
+		{input: "OS-2026-1234567", expected: "OS-2026-1234567"},
+		{input: "OS-2026-000000", expectedErr: ErrInvalidFormCode},
+		{input: "OS-26-000123", expectedErr: ErrInvalidFormCode},
+		{input: "OS-2026-", expectedErr: ErrInvalidFormCode},
+		{input: "OS-2026-12a", expectedErr: ErrInvalidFormCode},
+		{input: "OS-2026-000123-1", expectedErr: ErrInvalidFormCode},
+		{input: "XX-2026-000123", expectedErr: ErrInvalidFormCode},
+		{input: "000123", expectedErr: ErrInvalidFormCode},
+		{input: "", expectedErr: ErrInvalidFormCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			code, err := ParseFormCode(tt.input)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, code)
+		})
+	}
+}
+
+func TestFormCodeSearchTerm(t *testing.T) {
+	term, err := FormCodeSearchTerm("os-2026-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "OS-2026-000123", term)
+
+	term, err = FormCodeSearchTerm(" 000123 ")
+	assert.NoError(t, err)
+	assert.Equal(t, "000123", term)
+
+	term, err = FormCodeSearchTerm("os-2026")
+	assert.NoError(t, err)
+	assert.Equal(t, "OS-2026", term)
+
+	_, err = FormCodeSearchTerm("%")
+	assert.ErrorIs(t, err, ErrInvalidFormCode)
+	_, err = FormCodeSearchTerm("   ")
+	assert.ErrorIs(t, err, ErrInvalidFormCode)
+	_, err = FormCodeSearchTerm("OS-2026-0001234567890123")
+	assert.ErrorIs(t, err, ErrInvalidFormCode)
+}
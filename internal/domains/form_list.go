@@ -0,0 +1,52 @@
+package domains
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tamanho de página da listagem de atendimentos.
+const (
+	DefaultFormPageSize = 50
+	MaxFormPageSize     = 200
+)
+
+// FormListFilter restringe e pagina a listagem de atendimentos. Campos vazios
+// não filtram. A paginação é por cursor: After é o ID do último atendimento
+// da página anterior e Limit zero devolve todos os registros.
+type FormListFilter struct {
+	ListFilter
+
+	TecnicoID       uuid.UUID
+	DifficultyLevel string
+	Status          string
+	OccurredFrom    time.Time
+	OccurredTo      time.Time
+
+	// PublicCode é um trecho do protocolo, já preparado por
+	// FormCodeSearchTerm.
+	PublicCode string
+
+	After uuid.UUID
+	Limit int
+}
+
+func (f *FormListFilter) Validate() error {
+	switch f.DifficultyLevel {
+	case "", "low", "medium", "high":
+	default:
+		return ErrInvalidDifficultyLevel
+	}
+	if f.Status != "" && !IsValidFormStatus(f.Status) {
+		return ErrInvalidFormStatus
+	}
+	if !f.OccurredFrom.IsZero() && !f.OccurredTo.IsZero() && f.OccurredTo.Before(f.OccurredFrom) {
+		return ErrInvalidFormPeriod
+	}
+	if f.Limit < 0 || f.Limit > MaxFormPageSize {
+		return ErrInvalidPageSize
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormListFilter_Validate(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name        string
+		filter      FormListFilter
+		expectedErr error
+	}{
+		{
+			name:   "empty filter",
+			filter: FormListFilter{},
+		},
+		{
+			name:   "all filters",
+			filter: FormListFilter{DifficultyLevel: "high", Status: FormStatusInProgress, OccurredFrom: from, OccurredTo: to, Limit: MaxFormPageSize},
+		},
+		{
+			name:   "open-ended period",
+			filter: FormListFilter{OccurredFrom: from},
+		},
+		{
+			name:        "invalid - unknown difficulty",
+			filter:      FormListFilter{DifficultyLevel: "urgent"},
+			expectedErr: ErrInvalidDifficultyLevel,
+		},
+		{
+			name:        "invalid - unknown status",
+			filter:      FormListFilter{Status: "pendente"},
+			expectedErr: ErrInvalidFormStatus,
+		},
+		{
+			name:        "invalid - period end before start",
+			filter:      FormListFilter{OccurredFrom: to, OccurredTo: from},
+			expectedErr: ErrInvalidFormPeriod,
+		},
+		{
+			name:        "invalid - page size above max",
+			filter:      FormListFilter{Limit: MaxFormPageSize + 1},
+			expectedErr: ErrInvalidPageSize,
+		},
+		{
+			name:        "invalid - negative page size",
+			filter:      FormListFilter{Limit: -1},
+			expectedErr: ErrInvalidPageSize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
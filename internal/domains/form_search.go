@@ -0,0 +1,67 @@
+package domains
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tamanhos da busca textual de atendimentos.
+const (
+	DefaultFormSearchPageSize = 20
+	MaxFormSearchPageSize     = 100
+	MaxFormSearchQueryLength  = 200
+	// MaxFormSearchOffset limita o quanto se avança nos resultados; quem não
+	// achou o atendimento nas primeiras páginas deve refinar a busca.
+	MaxFormSearchOffset = 10000
+)
+
+// Delimitadores dos termos encontrados nos trechos vindos do banco. São
+// caracteres de uso privado, retirados do texto antes do destaque, para que
+// o texto do usuário não consiga imitá-los.
+const (
+	FormSearchMarkStart = "\uE000"
+	FormSearchMarkStop  = "\uE001"
+)
+
+var snippetMarks = strings.NewReplacer(FormSearchMarkStart, "<mark>", FormSearchMarkStop, "</mark>")
+
+// FormSearchFilter é a busca textual nos atendimentos: Query segue a sintaxe
+// de busca da web ("frase exata", -palavra, OR) e os filtros da listagem
+// restringem o resultado. A paginação é por deslocamento, já que a ordem é a
+// relevância; After é ignorado.
+type FormSearchFilter struct {
+	FormListFilter
+
+	Query  string
+	Offset int
+}
+
+// Validate remove os espaços das pontas da busca antes de validá-la.
+func (f *FormSearchFilter) Validate() error {
+	f.Query = strings.TrimSpace(f.Query)
+	if f.Query == "" || utf8.RuneCountInString(f.Query) > MaxFormSearchQueryLength {
+		return ErrInvalidSearchQuery
+	}
+	if f.Offset < 0 || f.Offset > MaxFormSearchOffset || f.Limit < 1 || f.Limit > MaxFormSearchPageSize {
+		return ErrInvalidPageSize
+	}
+
+	return f.FormListFilter.Validate()
+}
+
+// FormSearchResult é um atendimento encontrado com a relevância e os trechos
+// do defeito e da solução em HTML: o texto escapado, com os termos buscados
+// entre <mark> e </mark>.
+type FormSearchResult struct {
+	Form            *Atendimentos
+	Rank            float64
+	DefectSnippet   string
+	SolutionSnippet string
+}
+
+// HighlightSnippet escapa o trecho devolvido pelo banco e troca os
+// delimitadores dos termos encontrados por <mark> e </mark>.
+func HighlightSnippet(snippet string) string {
+	return snippetMarks.Replace(html.EscapeString(snippet))
+}
@@ -0,0 +1,80 @@
+package domains
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormSearchFilter_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		filter      FormSearchFilter
+		expectedErr error
+	}{
+		{
+			name:   "query with filters",
+			filter: FormSearchFilter{Query: "impressora epson", FormListFilter: FormListFilter{Status: FormStatusResolved, Limit: 20}},
+		},
+		{
+			name:   "query at max length",
+			filter: FormSearchFilter{Query: strings.Repeat("á", MaxFormSearchQueryLength), FormListFilter: FormListFilter{Limit: MaxFormSearchPageSize}},
+		},
+		{
+			name:        "invalid - blank query",
+			filter:      FormSearchFilter{Query: "   ", FormListFilter: FormListFilter{Limit: 20}},
+			expectedErr: ErrInvalidSearchQuery,
+		},
+		{
+			name:        "invalid - query too long",
+			filter:      FormSearchFilter{Query: strings.Repeat("a", MaxFormSearchQueryLength+1), FormListFilter: FormListFilter{Limit: 20}},
+			expectedErr: ErrInvalidSearchQuery,
+		},
+		{
+			name:        "invalid - negative offset",
+			filter:      FormSearchFilter{Query: "epson", Offset: -1, FormListFilter: FormListFilter{Limit: 20}},
+			expectedErr: ErrInvalidPageSize,
+		},
+		{
+			name:        "invalid - offset too deep",
+			filter:      FormSearchFilter{Query: "epson", Offset: MaxFormSearchOffset + 1, FormListFilter: FormListFilter{Limit: 20}},
+			expectedErr: ErrInvalidPageSize,
+		},
+		{
+			name:        "invalid - page size above max",
+			filter:      FormSearchFilter{Query: "epson", FormListFilter: FormListFilter{Limit: MaxFormSearchPageSize + 1}},
+			expectedErr: ErrInvalidPageSize,
+		},
+		{
+			name:        "invalid - list filter",
+			filter:      FormSearchFilter{Query: "epson", FormListFilter: FormListFilter{Status: "pendente", Limit: 20}},
+			expectedErr: ErrInvalidFormStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFormSearchFilter_ValidateTrimsQuery(t *testing.T) {
+	f := FormSearchFilter{Query: "  padaria  ", FormListFilter: FormListFilter{Limit: 20}}
+	assert.NoError(t, f.Validate())
+	assert.Equal(t, "padaria", f.Query)
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	snippet := "Cliente colou <script>alert(1)</script> na " + FormSearchMarkStart + "impressora" + FormSearchMarkStop + " & scanner"
+	assert.Equal(t,
+		"Cliente colou &lt;script&gt;alert(1)&lt;/script&gt; na <mark>impressora</mark> &amp; scanner",
+		HighlightSnippet(snippet))
+	assert.Equal(t, "", HighlightSnippet(""))
+}
@@ -0,0 +1,115 @@
+package domains
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Situações de um atendimento.
+const (
+	FormStatusOpen            = "aberto"
+	FormStatusScheduled       = "agendado"
+	FormStatusInProgress      = "em_andamento"
+	FormStatusWaitingCustomer = "aguardando_cliente"
+	FormStatusResolved        = "resolvido"
+	FormStatusClosed          = "fechado"
+	FormStatusCanceled        = "cancelado"
+)
+
+// formTransitions lista, para cada situação, as situações seguintes
+// permitidas. Fechado e cancelado são finais; um atendimento resolvido pode
+// ser fechado ou reaberto.
+var formTransitions = map[string][]string{
+	FormStatusOpen:            {FormStatusScheduled, FormStatusInProgress, FormStatusWaitingCustomer, FormStatusResolved, FormStatusCanceled},
+	FormStatusScheduled:       {FormStatusOpen, FormStatusInProgress, FormStatusWaitingCustomer, FormStatusCanceled},
+	FormStatusInProgress:      {FormStatusScheduled, FormStatusWaitingCustomer, FormStatusResolved, FormStatusCanceled},
+	FormStatusWaitingCustomer: {FormStatusScheduled, FormStatusInProgress, FormStatusResolved, FormStatusCanceled},
+	FormStatusResolved:        {FormStatusClosed, FormStatusInProgress},
+	FormStatusClosed:          {},
+	FormStatusCanceled:        {},
+}
+
+// FormStatusChange registra uma mudança de situação de um atendimento.
+type FormStatusChange struct {
+	ID            uuid.UUID `json:"id"`
+	FormID        uuid.UUID `json:"form_id"`
+	FromStatus    string    `json:"from_status"`
+	ToStatus      string    `json:"to_status"`
+	Reason        string    `json:"reason"`
+	ChangedBy     uuid.UUID `json:"changed_by"`
+	ChangedByName string    `json:"changed_by_name"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
+
+func IsValidFormStatus(status string) bool {
+	_, ok := formTransitions[status]
+	return ok
+}
+
+// CanTransitionForm informa se a mudança de from para to é permitida.
+func CanTransitionForm(from, to string) bool {
+	for _, next := range formTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// FormStatusRequiresSolution informa se a situação exige a descrição da
+// solução preenchida.
+func FormStatusRequiresSolution(status string) bool {
+	return status == FormStatusResolved || status == FormStatusClosed
+}
+
+// ChangeStatus aplica TransitionTo respeitando a trava da assinatura: sem ser
+// administrador, o atendimento assinado só pode ser fechado, e a solução
+// assinada é mantida em vez da informada.
+func (a *Atendimentos) ChangeStatus(to, reason, solution string, admin bool, by uuid.UUID, at time.Time) (*FormStatusChange, error) {
+	if err := a.CheckEditable(admin); err != nil {
+		if to != FormStatusClosed {
+			return nil, err
+		}
+		solution = ""
+	}
+	return a.TransitionTo(to, reason, solution, by, at)
+}
+
+// TransitionTo muda a situação do atendimento e devolve o registro da
+// mudança. A solução informada substitui a atual e é obrigatória ao resolver;
+// o motivo é obrigatório ao cancelar e ao reabrir um atendimento resolvido.
+func (a *Atendimentos) TransitionTo(to, reason, solution string, by uuid.UUID, at time.Time) (*FormStatusChange, error) {
+	if !IsValidFormStatus(to) {
+		return nil, ErrInvalidFormStatus
+	}
+	from := a.Status
+	if !CanTransitionForm(from, to) {
+		return nil, ErrInvalidFormTransition
+	}
+
+	reason = strings.TrimSpace(reason)
+	reopening := from == FormStatusResolved && to != FormStatusClosed
+	if (to == FormStatusCanceled || reopening) && reason == "" {
+		return nil, ErrFormStatusReasonRequired
+	}
+
+	if solution = strings.TrimSpace(solution); solution != "" {
+		a.SolutionDescription = solution
+	}
+	if FormStatusRequiresSolution(to) && strings.TrimSpace(a.SolutionDescription) == "" {
+		return nil, ErrInvalidSolutionDescription
+	}
+
+	a.trackSLA(from, to, at)
+	a.Status = to
+	return &FormStatusChange{
+		FormID:     a.ID,
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     reason,
+		ChangedBy:  by,
+		ChangedAt:  at,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransitionForm(t *testing.T) {
+	tests := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{FormStatusOpen, FormStatusScheduled, true},
+		{FormStatusOpen, FormStatusResolved, true},
+		{FormStatusOpen, FormStatusClosed, false},
+		{FormStatusScheduled, FormStatusInProgress, true},
+		{FormStatusInProgress, FormStatusWaitingCustomer, true},
+		{FormStatusWaitingCustomer, FormStatusInProgress, true},
+		{FormStatusResolved, FormStatusClosed, true},
+		{FormStatusResolved, FormStatusInProgress, true},
+		{FormStatusResolved, FormStatusCanceled, false},
+		{FormStatusClosed, FormStatusOpen, false},
+		{FormStatusCanceled, FormStatusOpen, false},
+		{FormStatusOpen, FormStatusOpen, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, CanTransitionForm(tt.from, tt.to))
+		})
+	}
+}
+
+func TestAtendimentos_TransitionTo(t *testing.T) {
+	by := uuid.New()
+	at := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+
+	t.Run("records the change", func(t *testing.T) {
+		form := &Atendimentos{ID: uuid.New(), Status: FormStatusOpen}
+		change, err := form.TransitionTo(FormStatusScheduled, " visita amanhã ", "", by, at)
+		assert.NoError(t, err)
+		assert.Equal(t, FormStatusScheduled, form.Status)
+		assert.Equal(t, &FormStatusChange{
+			FormID:     form.ID,
+			FromStatus: FormStatusOpen,
+			ToStatus:   FormStatusScheduled,
+			Reason:     "visita amanhã",
+			ChangedBy:  by,
+			ChangedAt:  at,
+		}, change)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		form := &Atendimentos{Status: FormStatusOpen}
+		_, err := form.TransitionTo("pendente", "", "", by, at)
+		assert.ErrorIs(t, err, ErrInvalidFormStatus)
+	})
+
+	t.Run("transition not allowed", func(t *testing.T) {
+		form := &Atendimentos{Status: FormStatusClosed}
+		_, err := form.TransitionTo(FormStatusInProgress, "cliente ligou", "", by, at)
+		assert.ErrorIs(t, err, ErrInvalidFormTransition)
+		assert.Equal(t, FormStatusClosed, form.Status)
+	})
+
+	t.Run("resolving requires a solution", func(t *testing.T) {
+		form := &Atendimentos{Status: FormStatusInProgress}
+		_, err := form.TransitionTo(FormStatusResolved, "", "  ", by, at)
+		assert.ErrorIs(t, err, ErrInvalidSolutionDescription)
+		assert.Equal(t, FormStatusInProgress, form.Status)
+
+		_, err = form.TransitionTo(FormStatusResolved, "", "Trocado o fusor", by, at)
+		assert.NoError(t, err)
+		assert.Equal(t, "Trocado o fusor", form.SolutionDescription)
+	})
+
+	t.Run("resolving keeps an existing solution", func(t *testing.T) {
+		form := &Atendimentos{Status: FormStatusInProgress, SolutionDescription: "Reinstalado o driver"}
+		_, err := form.TransitionTo(FormStatusResolved, "", "", by, at)
+		assert.NoError(t, err)
+		assert.Equal(t, "Reinstalado o driver", form.SolutionDescription)
+	})
+
+	t.Run("canceling requires a reason", func(t *testing.T) {
+		form := &Atendimentos{Status: FormStatusOpen}
+		_, err := form.TransitionTo(FormStatusCanceled, "", "", by, at)
+		assert.ErrorIs(t, err, ErrFormStatusReasonRequired)
+
+		_, err = form.TransitionTo(FormStatusCanceled, "aberto em duplicidade", "", by, at)
+		assert.NoError(t, err)
+	})
+
+	t.Run("reopening requires a reason", func(t *testing.T) {
+		form := &Atendimentos{Status: FormStatusResolved, SolutionDescription: "Reiniciado"}
+		_, err := form.TransitionTo(FormStatusInProgress, "", "", by, at)
+		assert.ErrorIs(t, err, ErrFormStatusReasonRequired)
+
+		_, err = form.TransitionTo(FormStatusClosed, "", "", by, at)
+		assert.NoError(t, err)
+	})
+}
+
+func TestAtendimentos_ChangeStatus(t *testing.T) {
+	by := uuid.New()
+	at := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+
+	t.Run("signed form only closes for non-admins", func(t *testing.T) {
+		form := &Atendimentos{Status: FormStatusResolved, Signed: true, SolutionDescription: "Trocado o fusor"}
+		_, err := form.ChangeStatus(FormStatusInProgress, "cliente ligou", "", false, by, at)
+		assert.ErrorIs(t, err, ErrFormSigned)
+		assert.Equal(t, FormStatusResolved, form.Status)
+
+		_, err = form.ChangeStatus(FormStatusClosed, "", "Nada foi feito", false, by, at)
+		assert.NoError(t, err)
+		assert.Equal(t, FormStatusClosed, form.Status)
+		assert.Equal(t, "Trocado o fusor", form.SolutionDescription)
+	})
+
+	t.Run("admin may replace the solution of a signed form", func(t *testing.T) {
+		form := &Atendimentos{Status: FormStatusResolved, Signed: true, SolutionDescription: "Trocado o fusor"}
+		_, err := form.ChangeStatus(FormStatusClosed, "", "Trocado o fusor e o cilindro", true, by, at)
+		assert.NoError(t, err)
+		assert.Equal(t, "Trocado o fusor e o cilindro", form.SolutionDescription)
+	})
+
+	t.Run("unsigned form uses the given solution", func(t *testing.T) {
+		form := &Atendimentos{Status: FormStatusResolved, SolutionDescription: "Reiniciado"}
+		_, err := form.ChangeStatus(FormStatusClosed, "", "Reinstalado o driver", false, by, at)
+		assert.NoError(t, err)
+		assert.Equal(t, "Reinstalado o driver", form.SolutionDescription)
+	})
+}
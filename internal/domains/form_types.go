@@ -0,0 +1,632 @@
+package domains
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Tipos aceitos nas propriedades dos esquemas de tipo de formulário.
+const (
+	FormSchemaTypeObject  = "object"
+	FormSchemaTypeString  = "string"
+	FormSchemaTypeNumber  = "number"
+	FormSchemaTypeInteger = "integer"
+	FormSchemaTypeBoolean = "boolean"
+	FormSchemaTypeArray   = "array"
+)
+
+// Formatos aceitos nas propriedades de texto.
+const (
+	FormSchemaFormatDate     = "date"
+	FormSchemaFormatDateTime = "date-time"
+	FormSchemaFormatEmail    = "email"
+)
+
+const (
+	MaxFormTypeNameLength        = 100
+	MaxFormTypeDescriptionLength = 500
+	// MaxFormSchemaSize limita o tamanho do esquema em bytes.
+	MaxFormSchemaSize = 64 << 10
+	// MaxFormSchemaDepth limita o aninhamento de objetos do esquema.
+	MaxFormSchemaDepth = 5
+	// MaxFormSchemaProperties limita as propriedades de cada objeto, somando
+	// as condicionais.
+	MaxFormSchemaProperties = 100
+)
+
+var formSchemaPropertyRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// FormType é um tipo de formulário definido pelo administrador. Os campos
+// extras ficam nas versões; CurrentVersion é a usada nos novos atendimentos.
+// Tipos inativos não aceitam novos atendimentos, mas os antigos continuam
+// com a versão em que foram abertos.
+type FormType struct {
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Active         bool      `json:"active"`
+	CurrentVersion int       `json:"current_version"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Normalize remove os espaços das pontas do nome e da descrição.
+func (t *FormType) Normalize() {
+	t.Name = strings.TrimSpace(t.Name)
+	t.Description = strings.TrimSpace(t.Description)
+}
+
+func (t *FormType) Validate() error {
+	if t.Name == "" || utf8.RuneCountInString(t.Name) > MaxFormTypeNameLength ||
+		utf8.RuneCountInString(t.Description) > MaxFormTypeDescriptionLength {
+		return ErrInvalidFormType
+	}
+	return nil
+}
+
+// FormTypeVersion é uma versão publicada do esquema de um tipo. Versões não
+// mudam depois de publicadas; o esquema é guardado como foi enviado, para
+// manter a ordem das propriedades na exibição.
+type FormTypeVersion struct {
+	FormTypeID uuid.UUID       `json:"form_type_id"`
+	Version    int             `json:"version"`
+	Schema     json.RawMessage `json:"schema"`
+	CreatedBy  uuid.UUID       `json:"created_by"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// ValidateData confere os dados de um atendimento contra o esquema da versão
+// e devolve os dados sem os valores nulos.
+func (v *FormTypeVersion) ValidateData(data FormData) (FormData, error) {
+	schema, err := ParseFormSchema(v.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return schema.ValidateData(data)
+}
+
+// FormData são os valores dos campos extras de um atendimento, conforme o
+// esquema do seu tipo.
+type FormData map[string]any
+
+// FormSchema é o subconjunto de JSON Schema aceito nos tipos de formulário.
+// A raiz é um objeto; cada propriedade declara o tipo e, conforme ele, as
+// restrições de tamanho, padrão, formato, faixa, itens ou valores aceitos.
+//
+// A visibilidade condicional usa if/then/else (e allOf para mais de uma
+// condição): as propriedades declaradas em then ou else só existem quando o
+// ramo está ativo, e o required do ramo só vale nesse caso. Campos fora das
+// propriedades visíveis são rejeitados.
+type FormSchema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Default     any    `json:"default,omitempty"`
+
+	Type       string                 `json:"type,omitempty"`
+	Properties map[string]*FormSchema `json:"properties,omitempty"`
+	Required   []string               `json:"required,omitempty"`
+
+	Enum  []any `json:"enum,omitempty"`
+	Const any   `json:"const,omitempty"`
+
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Format    string   `json:"format,omitempty"`
+	Minimum   *float64 `json:"minimum,omitempty"`
+	Maximum   *float64 `json:"maximum,omitempty"`
+
+	Items    *FormSchema `json:"items,omitempty"`
+	MinItems *int        `json:"minItems,omitempty"`
+	MaxItems *int        `json:"maxItems,omitempty"`
+
+	If    *FormSchema   `json:"if,omitempty"`
+	Then  *FormSchema   `json:"then,omitempty"`
+	Else  *FormSchema   `json:"else,omitempty"`
+	AllOf []*FormSchema `json:"allOf,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// FormSchemaError aponta, como JSON Pointer, a parte do esquema recusada.
+type FormSchemaError struct {
+	Path string
+}
+
+func (e *FormSchemaError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrInvalidFormSchema, e.Path)
+}
+
+func (e *FormSchemaError) Unwrap() error {
+	return ErrInvalidFormSchema
+}
+
+// FormDataError aponta, como JSON Pointer, o campo dos dados que falhou na
+// validação.
+type FormDataError struct {
+	Path string
+	Err  error
+}
+
+func (e *FormDataError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err, e.Path)
+}
+
+func (e *FormDataError) Unwrap() error {
+	return e.Err
+}
+
+// ParseFormSchema lê e valida o esquema. Palavras-chave fora do subconjunto
+// aceito são recusadas, para o administrador não contar com regras que o
+// servidor não aplica.
+func ParseFormSchema(raw []byte) (*FormSchema, error) {
+	if len(raw) == 0 || len(raw) > MaxFormSchemaSize {
+		return nil, &FormSchemaError{Path: "/"}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	var schema FormSchema
+	if err := dec.Decode(&schema); err != nil || dec.More() {
+		return nil, &FormSchemaError{Path: "/"}
+	}
+	if schema.Type != FormSchemaTypeObject {
+		return nil, &FormSchemaError{Path: "/type"}
+	}
+	if err := schema.checkObject("", 1); err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// ValidateData confere os dados contra o esquema já validado e devolve os
+// dados sem os valores nulos, tratados como ausentes.
+func (s *FormSchema) ValidateData(data FormData) (FormData, error) {
+	if data == nil {
+		data = FormData{}
+	}
+	validated, err := s.validateObject("", data)
+	if err != nil {
+		return nil, err
+	}
+	return FormData(validated), nil
+}
+
+// checkObject valida um objeto: as propriedades da base e dos ramos, o
+// required e as condições.
+func (s *FormSchema) checkObject(path string, depth int) error {
+	if depth > MaxFormSchemaDepth {
+		return &FormSchemaError{Path: path}
+	}
+	if s.hasValueKeywords() || s.Items != nil || s.MinItems != nil || s.MaxItems != nil {
+		return &FormSchemaError{Path: path}
+	}
+
+	declared := make(map[string]*FormSchema)
+	if err := s.declare(path, declared); err != nil {
+		return err
+	}
+	if len(declared) == 0 || len(declared) > MaxFormSchemaProperties {
+		return &FormSchemaError{Path: path + "/properties"}
+	}
+	return s.checkMembers(path, depth, declared)
+}
+
+// declare reúne as propriedades da base e dos ramos then, else e allOf do
+// objeto. Um nome só pode ser declarado uma vez no objeto.
+func (s *FormSchema) declare(path string, declared map[string]*FormSchema) error {
+	for name, prop := range s.Properties {
+		if !formSchemaPropertyRegex.MatchString(name) || prop == nil {
+			return &FormSchemaError{Path: path + "/properties/" + name}
+		}
+		if _, ok := declared[name]; ok {
+			return &FormSchemaError{Path: path + "/properties/" + name}
+		}
+		declared[name] = prop
+	}
+	for i, branch := range s.AllOf {
+		if branch == nil {
+			return &FormSchemaError{Path: path + "/allOf/" + strconv.Itoa(i)}
+		}
+		if err := branch.declare(path+"/allOf/"+strconv.Itoa(i), declared); err != nil {
+			return err
+		}
+	}
+	if s.Then != nil {
+		if err := s.Then.declare(path+"/then", declared); err != nil {
+			return err
+		}
+	}
+	if s.Else != nil {
+		if err := s.Else.declare(path+"/else", declared); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBranch valida um ramo then, else ou allOf, que não declara tipo nem
+// restrições de valor.
+func (s *FormSchema) checkBranch(path string, depth int, declared map[string]*FormSchema) error {
+	if s.Type != "" || s.hasValueKeywords() || s.Items != nil || s.MinItems != nil || s.MaxItems != nil {
+		return &FormSchemaError{Path: path}
+	}
+	return s.checkMembers(path, depth, declared)
+}
+
+// checkMembers valida as propriedades, o required e as condições da base do
+// objeto ou de um ramo; o required pode citar qualquer propriedade do objeto.
+func (s *FormSchema) checkMembers(path string, depth int, declared map[string]*FormSchema) error {
+	for name, prop := range s.Properties {
+		if err := prop.checkProperty(path+"/properties/"+name, depth); err != nil {
+			return err
+		}
+	}
+	for i, name := range s.Required {
+		if _, ok := declared[name]; !ok {
+			return &FormSchemaError{Path: path + "/required/" + strconv.Itoa(i)}
+		}
+	}
+
+	if s.If == nil && (s.Then != nil || s.Else != nil) {
+		return &FormSchemaError{Path: path + "/if"}
+	}
+	if s.If != nil {
+		if s.Then == nil && s.Else == nil {
+			return &FormSchemaError{Path: path + "/then"}
+		}
+		if err := s.If.checkCondition(path+"/if", declared); err != nil {
+			return err
+		}
+	}
+	if s.Then != nil {
+		if err := s.Then.checkBranch(path+"/then", depth, declared); err != nil {
+			return err
+		}
+	}
+	if s.Else != nil {
+		if err := s.Else.checkBranch(path+"/else", depth, declared); err != nil {
+			return err
+		}
+	}
+	for i, branch := range s.AllOf {
+		if err := branch.checkBranch(path+"/allOf/"+strconv.Itoa(i), depth, declared); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCondition valida o if: só properties e required, sobre propriedades
+// do objeto, com restrições de valor sem tipo obrigatório.
+func (s *FormSchema) checkCondition(path string, declared map[string]*FormSchema) error {
+	if s.Type != "" || s.hasValueKeywords() || s.Items != nil || s.MinItems != nil || s.MaxItems != nil ||
+		s.If != nil || s.Then != nil || s.Else != nil || len(s.AllOf) > 0 {
+		return &FormSchemaError{Path: path}
+	}
+	if len(s.Properties) == 0 && len(s.Required) == 0 {
+		return &FormSchemaError{Path: path}
+	}
+	for name, prop := range s.Properties {
+		propPath := path + "/properties/" + name
+		if _, ok := declared[name]; !ok || prop == nil {
+			return &FormSchemaError{Path: propPath}
+		}
+		if prop.Properties != nil || prop.Required != nil || prop.Items != nil || prop.If != nil ||
+			prop.Then != nil || prop.Else != nil || len(prop.AllOf) > 0 {
+			return &FormSchemaError{Path: propPath}
+		}
+		if err := prop.checkValueKeywords(propPath); err != nil {
+			return err
+		}
+	}
+	for i, name := range s.Required {
+		if _, ok := declared[name]; !ok {
+			return &FormSchemaError{Path: path + "/required/" + strconv.Itoa(i)}
+		}
+	}
+	return nil
+}
+
+// checkProperty valida a declaração de um campo: o tipo é obrigatório e as
+// restrições precisam combinar com ele.
+func (s *FormSchema) checkProperty(path string, depth int) error {
+	stringKeywords := s.MinLength != nil || s.MaxLength != nil || s.Pattern != "" || s.Format != ""
+	numberKeywords := s.Minimum != nil || s.Maximum != nil
+	arrayKeywords := s.Items != nil || s.MinItems != nil || s.MaxItems != nil
+	objectKeywords := s.Properties != nil || s.Required != nil || s.If != nil || s.Then != nil || s.Else != nil || len(s.AllOf) > 0
+
+	switch s.Type {
+	case FormSchemaTypeString:
+		if numberKeywords || arrayKeywords || objectKeywords {
+			return &FormSchemaError{Path: path}
+		}
+	case FormSchemaTypeNumber, FormSchemaTypeInteger:
+		if stringKeywords || arrayKeywords || objectKeywords {
+			return &FormSchemaError{Path: path}
+		}
+	case FormSchemaTypeBoolean:
+		if stringKeywords || numberKeywords || arrayKeywords || objectKeywords {
+			return &FormSchemaError{Path: path}
+		}
+	case FormSchemaTypeArray:
+		if stringKeywords || numberKeywords || objectKeywords || s.Enum != nil || s.Const != nil || s.Items == nil {
+			return &FormSchemaError{Path: path}
+		}
+		if err := checkRange(path, s.MinItems, s.MaxItems); err != nil {
+			return err
+		}
+		return s.Items.checkProperty(path+"/items", depth)
+	case FormSchemaTypeObject:
+		return s.checkObject(path, depth+1)
+	default:
+		return &FormSchemaError{Path: path + "/type"}
+	}
+	return s.checkValueKeywords(path)
+}
+
+// checkValueKeywords valida as restrições de valor de um campo ou de uma
+// condição. Sem tipo, enum e const aceitam texto, número e booleano.
+func (s *FormSchema) checkValueKeywords(path string) error {
+	if s.Type == "" && (s.Items != nil || s.MinItems != nil || s.MaxItems != nil) {
+		return &FormSchemaError{Path: path}
+	}
+	if err := checkRange(path, s.MinLength, s.MaxLength); err != nil {
+		return err
+	}
+	if s.Minimum != nil && s.Maximum != nil && *s.Minimum > *s.Maximum {
+		return &FormSchemaError{Path: path + "/minimum"}
+	}
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return &FormSchemaError{Path: path + "/pattern"}
+		}
+		s.pattern = re
+	}
+	switch s.Format {
+	case "", FormSchemaFormatDate, FormSchemaFormatDateTime, FormSchemaFormatEmail:
+	default:
+		return &FormSchemaError{Path: path + "/format"}
+	}
+
+	if s.Enum != nil && len(s.Enum) == 0 {
+		return &FormSchemaError{Path: path + "/enum"}
+	}
+	for i, value := range s.Enum {
+		if !s.acceptsLiteral(value) {
+			return &FormSchemaError{Path: path + "/enum/" + strconv.Itoa(i)}
+		}
+	}
+	if s.Const != nil && !s.acceptsLiteral(s.Const) {
+		return &FormSchemaError{Path: path + "/const"}
+	}
+	return nil
+}
+
+// acceptsLiteral informa se um valor de enum ou const é do tipo do campo.
+func (s *FormSchema) acceptsLiteral(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return s.Type == "" || s.Type == FormSchemaTypeString
+	case float64:
+		return s.Type == "" || s.Type == FormSchemaTypeNumber ||
+			(s.Type == FormSchemaTypeInteger && v == math.Trunc(v))
+	case bool:
+		return s.Type == "" || s.Type == FormSchemaTypeBoolean
+	}
+	return false
+}
+
+func (s *FormSchema) hasValueKeywords() bool {
+	return s.MinLength != nil || s.MaxLength != nil || s.Pattern != "" || s.Format != "" ||
+		s.Minimum != nil || s.Maximum != nil || s.Enum != nil || s.Const != nil
+}
+
+func checkRange(path string, min, max *int) error {
+	if (min != nil && *min < 0) || (max != nil && *max < 0) || (min != nil && max != nil && *min > *max) {
+		return &FormSchemaError{Path: path}
+	}
+	return nil
+}
+
+// validateObject confere um objeto dos dados: monta as propriedades visíveis
+// e o required pelos ramos ativos e valida cada valor informado.
+func (s *FormSchema) validateObject(path string, value map[string]any) (map[string]any, error) {
+	visible := make(map[string]*FormSchema)
+	required := make(map[string]struct{})
+	s.collectVisible(value, visible, required)
+
+	validated := make(map[string]any, len(value))
+	for name, v := range value {
+		if v == nil {
+			continue
+		}
+		prop, ok := visible[name]
+		if !ok {
+			if s.declares(name) {
+				return nil, &FormDataError{Path: path + "/" + name, Err: ErrFormDataFieldHidden}
+			}
+			return nil, &FormDataError{Path: path + "/" + name, Err: ErrUnknownFormDataField}
+		}
+		normalized, err := prop.validateValue(path+"/"+name, v)
+		if err != nil {
+			return nil, err
+		}
+		validated[name] = normalized
+	}
+
+	for name := range required {
+		if _, ok := validated[name]; !ok {
+			return nil, &FormDataError{Path: path + "/" + name, Err: ErrFormDataRequired}
+		}
+	}
+	return validated, nil
+}
+
+// collectVisible soma as propriedades e o required da base e dos ramos
+// ativos para os dados informados.
+func (s *FormSchema) collectVisible(data map[string]any, visible map[string]*FormSchema, required map[string]struct{}) {
+	for name, prop := range s.Properties {
+		visible[name] = prop
+	}
+	for _, name := range s.Required {
+		required[name] = struct{}{}
+	}
+	for _, branch := range s.AllOf {
+		branch.collectVisible(data, visible, required)
+	}
+	if s.If == nil {
+		return
+	}
+	branch := s.Else
+	if s.If.matches(data) {
+		branch = s.Then
+	}
+	if branch != nil {
+		branch.collectVisible(data, visible, required)
+	}
+}
+
+// declares informa se o nome é declarado em algum ramo do objeto, mesmo que
+// inativo.
+func (s *FormSchema) declares(name string) bool {
+	if _, ok := s.Properties[name]; ok {
+		return true
+	}
+	for _, branch := range s.AllOf {
+		if branch.declares(name) {
+			return true
+		}
+	}
+	return (s.Then != nil && s.Then.declares(name)) || (s.Else != nil && s.Else.declares(name))
+}
+
+// matches avalia a condição como no JSON Schema: as propriedades ausentes não
+// reprovam; para exigi-las, a condição usa required.
+func (s *FormSchema) matches(data map[string]any) bool {
+	for _, name := range s.Required {
+		if v, ok := data[name]; !ok || v == nil {
+			return false
+		}
+	}
+	for name, prop := range s.Properties {
+		v, ok := data[name]
+		if !ok || v == nil {
+			continue
+		}
+		if _, err := prop.validateValue("", v); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// validateValue confere um valor recebido em JSON contra o campo e devolve o
+// valor normalizado para gravação.
+func (s *FormSchema) validateValue(path string, value any) (any, error) {
+	invalid := &FormDataError{Path: path, Err: ErrInvalidFormDataValue}
+
+	switch s.Type {
+	case FormSchemaTypeObject:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil, invalid
+		}
+		return s.validateObject(path, obj)
+	case FormSchemaTypeArray:
+		items, ok := value.([]any)
+		if !ok {
+			return nil, invalid
+		}
+		if (s.MinItems != nil && len(items) < *s.MinItems) || (s.MaxItems != nil && len(items) > *s.MaxItems) {
+			return nil, invalid
+		}
+		validated := make([]any, 0, len(items))
+		for i, item := range items {
+			if item == nil {
+				return nil, &FormDataError{Path: path + "/" + strconv.Itoa(i), Err: ErrInvalidFormDataValue}
+			}
+			v, err := s.Items.validateValue(path+"/"+strconv.Itoa(i), item)
+			if err != nil {
+				return nil, err
+			}
+			validated = append(validated, v)
+		}
+		return validated, nil
+	case FormSchemaTypeString:
+		if _, ok := value.(string); !ok {
+			return nil, invalid
+		}
+	case FormSchemaTypeNumber:
+		if _, ok := value.(float64); !ok {
+			return nil, invalid
+		}
+	case FormSchemaTypeInteger:
+		if n, ok := value.(float64); !ok || n != math.Trunc(n) {
+			return nil, invalid
+		}
+	case FormSchemaTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return nil, invalid
+		}
+	}
+
+	if str, ok := value.(string); ok && !s.acceptsString(str) {
+		return nil, invalid
+	}
+	if n, ok := value.(float64); ok &&
+		((s.Minimum != nil && n < *s.Minimum) || (s.Maximum != nil && n > *s.Maximum)) {
+		return nil, invalid
+	}
+	if s.Const != nil && !reflect.DeepEqual(s.Const, value) {
+		return nil, invalid
+	}
+	if s.Enum != nil && !containsValue(s.Enum, value) {
+		return nil, invalid
+	}
+	return value, nil
+}
+
+func (s *FormSchema) acceptsString(value string) bool {
+	length := utf8.RuneCountInString(value)
+	if (s.MinLength != nil && length < *s.MinLength) || (s.MaxLength != nil && length > *s.MaxLength) {
+		return false
+	}
+	if s.pattern != nil && !s.pattern.MatchString(value) {
+		return false
+	}
+	switch s.Format {
+	case FormSchemaFormatDate:
+		_, err := time.Parse(time.DateOnly, value)
+		return err == nil
+	case FormSchemaFormatDateTime:
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case FormSchemaFormatEmail:
+		addr, err := mail.ParseAddress(value)
+		return err == nil && addr.Address == value
+	}
+	return true
+}
+
+func containsValue(values []any, value any) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package domains
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const hvacSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "Manutenção de ar-condicionado",
+	"type": "object",
+	"properties": {
+		"equipamento": {"type": "string", "enum": ["split", "janela", "central"]},
+		"btus": {"type": "integer", "minimum": 7000, "maximum": 60000},
+		"data_instalacao": {"type": "string", "format": "date"},
+		"gas_recarregado": {"type": "boolean"},
+		"contato": {
+			"type": "object",
+			"properties": {
+				"nome": {"type": "string", "minLength": 2, "maxLength": 100},
+				"email": {"type": "string", "format": "email"}
+			},
+			"required": ["nome"]
+		},
+		"fotos": {"type": "array", "items": {"type": "string", "pattern": "^[0-9a-f-]{36}$"}, "maxItems": 3}
+	},
+	"required": ["equipamento"],
+	"allOf": [
+		{
+			"if": {"properties": {"equipamento": {"const": "split"}}, "required": ["equipamento"]},
+			"then": {"properties": {"unidade_externa": {"type": "string", "maxLength": 50}}, "required": ["unidade_externa", "btus"]}
+		},
+		{
+			"if": {"properties": {"gas_recarregado": {"const": true}}, "required": ["gas_recarregado"]},
+			"then": {"properties": {"gas_kg": {"type": "number", "minimum": 0}}, "required": ["gas_kg"]},
+			"else": {"properties": {"motivo_sem_gas": {"type": "string"}}}
+		}
+	]
+}`
+
+func TestFormType_Validate(t *testing.T) {
+	ft := FormType{Name: "  Ar-condicionado ", Description: " Manutenção "}
+	ft.Normalize()
+	assert.Equal(t, "Ar-condicionado", ft.Name)
+	assert.Equal(t, "Manutenção", ft.Description)
+	assert.NoError(t, ft.Validate())
+
+	assert.ErrorIs(t, (&FormType{}).Validate(), ErrInvalidFormType)
+	assert.ErrorIs(t, (&FormType{Name: strings.Repeat("a", MaxFormTypeNameLength+1)}).Validate(), ErrInvalidFormType)
+	assert.ErrorIs(t, (&FormType{Name: "Tipo", Description: strings.Repeat("a", MaxFormTypeDescriptionLength+1)}).Validate(), ErrInvalidFormType)
+}
+
+func TestParseFormSchema(t *testing.T) {
+	_, err := ParseFormSchema([]byte(hvacSchema))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		schema string
+		path   string
+	}{
+		{"not json", `{"type":`, "/"},
+		{"unsupported keyword", `{"type": "object", "properties": {"a": {"type": "string"}}, "additionalProperties": false}`, "/"},
+		{"root not object", `{"type": "string"}`, "/type"},
+		{"no properties", `{"type": "object"}`, "/properties"},
+		{"invalid property name", `{"type": "object", "properties": {"Nome": {"type": "string"}}}`, "/properties/Nome"},
+		{"missing type", `{"type": "object", "properties": {"a": {"maxLength": 3}}}`, "/properties/a/type"},
+		{"unknown type", `{"type": "object", "properties": {"a": {"type": "null"}}}`, "/properties/a/type"},
+		{"keyword of another type", `{"type": "object", "properties": {"a": {"type": "string", "minimum": 1}}}`, "/properties/a"},
+		{"min above max", `{"type": "object", "properties": {"a": {"type": "number", "minimum": 5, "maximum": 1}}}`, "/properties/a/minimum"},
+		{"invalid pattern", `{"type": "object", "properties": {"a": {"type": "string", "pattern": "("}}}`, "/properties/a/pattern"},
+		{"unknown format", `{"type": "object", "properties": {"a": {"type": "string", "format": "uri"}}}`, "/properties/a/format"},
+		{"enum of another type", `{"type": "object", "properties": {"a": {"type": "integer", "enum": [1, 1.5]}}}`, "/properties/a/enum/1"},
+		{"array without items", `{"type": "object", "properties": {"a": {"type": "array"}}}`, "/properties/a"},
+		{"required not declared", `{"type": "object", "properties": {"a": {"type": "string"}}, "required": ["b"]}`, "/required/0"},
+		{"then without if", `{"type": "object", "properties": {"a": {"type": "string"}}, "then": {"required": ["a"]}}`, "/if"},
+		{"condition on undeclared", `{"type": "object", "properties": {"a": {"type": "string"}}, "if": {"properties": {"b": {"const": "x"}}}, "then": {"required": ["a"]}}`, "/if/properties/b"},
+		{"duplicated in branch", `{"type": "object", "properties": {"a": {"type": "string"}}, "if": {"required": ["a"]}, "then": {"properties": {"a": {"type": "string"}}}}`, "/then/properties/a"},
+		{"typed branch", `{"type": "object", "properties": {"a": {"type": "string"}}, "if": {"required": ["a"]}, "then": {"type": "object", "required": ["a"]}}`, "/then"},
+		{"too deep", `{"type": "object", "properties": {"a": {"type": "object", "properties": {"b": {"type": "object", "properties": {"c": {"type": "object", "properties": {"d": {"type": "object", "properties": {"e": {"type": "object", "properties": {"f": {"type": "string"}}}}}}}}}}}}}`, "/properties/a/properties/b/properties/c/properties/d/properties/e"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFormSchema([]byte(tt.schema))
+			require.ErrorIs(t, err, ErrInvalidFormSchema)
+			var schemaErr *FormSchemaError
+			require.ErrorAs(t, err, &schemaErr)
+			assert.Equal(t, tt.path, schemaErr.Path)
+		})
+	}
+}
+
+func TestFormSchema_ValidateData(t *testing.T) {
+	schema, err := ParseFormSchema([]byte(hvacSchema))
+	require.NoError(t, err)
+
+	t.Run("valid with conditional fields", func(t *testing.T) {
+		data, err := schema.ValidateData(FormData{
+			"equipamento":     "split",
+			"btus":            float64(12000),
+			"unidade_externa": "Telhado",
+			"gas_recarregado": true,
+			"gas_kg":          1.5,
+			"data_instalacao": "2024-05-10",
+			"contato":         map[string]any{"nome": "Ana", "email": "ana@example.com"},
+			"fotos":           []any{"0195f0e4-7b1c-7c3a-9d2e-123456789abc"},
+			"motivo_sem_gas":  nil,
+		})
+		require.NoError(t, err)
+		assert.Len(t, data, 8, "null values are dropped")
+	})
+
+	t.Run("else branch", func(t *testing.T) {
+		_, err := schema.ValidateData(FormData{"equipamento": "janela", "motivo_sem_gas": "Sem vazamento"})
+		assert.NoError(t, err)
+	})
+
+	tests := []struct {
+		name string
+		data FormData
+		path string
+		err  error
+	}{
+		{"missing required", FormData{}, "/equipamento", ErrFormDataRequired},
+		{"conditional required", FormData{"equipamento": "split", "btus": float64(9000)}, "/unidade_externa", ErrFormDataRequired},
+		{"hidden field", FormData{"equipamento": "janela", "unidade_externa": "Telhado"}, "/unidade_externa", ErrFormDataFieldHidden},
+		{"hidden by else", FormData{"equipamento": "janela", "gas_recarregado": true, "gas_kg": float64(1), "motivo_sem_gas": "x"}, "/motivo_sem_gas", ErrFormDataFieldHidden},
+		{"unknown field", FormData{"equipamento": "janela", "cor": "branco"}, "/cor", ErrUnknownFormDataField},
+		{"not in enum", FormData{"equipamento": "portatil"}, "/equipamento", ErrInvalidFormDataValue},
+		{"not an integer", FormData{"equipamento": "janela", "btus": 9000.5}, "/btus", ErrInvalidFormDataValue},
+		{"below minimum", FormData{"equipamento": "janela", "btus": float64(5000)}, "/btus", ErrInvalidFormDataValue},
+		{"wrong type", FormData{"equipamento": "janela", "gas_recarregado": "sim"}, "/gas_recarregado", ErrInvalidFormDataValue},
+		{"invalid date", FormData{"equipamento": "janela", "data_instalacao": "10/05/2024"}, "/data_instalacao", ErrInvalidFormDataValue},
+		{"nested required", FormData{"equipamento": "janela", "contato": map[string]any{"email": "ana@example.com"}}, "/contato/nome", ErrFormDataRequired},
+		{"nested format", FormData{"equipamento": "janela", "contato": map[string]any{"nome": "Ana", "email": "Ana <ana@example.com>"}}, "/contato/email", ErrInvalidFormDataValue},
+		{"array item pattern", FormData{"equipamento": "janela", "fotos": []any{"foto.jpg"}}, "/fotos/0", ErrInvalidFormDataValue},
+		{"too many items", FormData{"equipamento": "janela", "fotos": []any{"a", "b", "c", "d"}}, "/fotos", ErrInvalidFormDataValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := schema.ValidateData(tt.data)
+			require.ErrorIs(t, err, tt.err)
+			var dataErr *FormDataError
+			require.ErrorAs(t, err, &dataErr)
+			assert.Equal(t, tt.path, dataErr.Path)
+		})
+	}
+}
+
+func TestFormTypeVersion_ValidateData(t *testing.T) {
+	v1 := FormTypeVersion{Version: 1, Schema: []byte(`{"type": "object", "properties": {"serie": {"type": "string"}}}`)}
+	v2 := FormTypeVersion{Version: 2, Schema: []byte(`{"type": "object", "properties": {"serie": {"type": "string"}, "modelo": {"type": "string"}}, "required": ["modelo"]}`)}
+
+	data := FormData{"serie": "ABC123"}
+	_, err := v1.ValidateData(data)
+	assert.NoError(t, err, "forms keep validating against the version they were created with")
+	_, err = v2.ValidateData(data)
+	assert.ErrorIs(t, err, ErrFormDataRequired)
+}
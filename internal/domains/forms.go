@@ -4,10 +4,17 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type Atendimentos struct {
 	ID uuid.UUID `json:"id"`
+	// PublicCode é o protocolo do atendimento, como "OS-2026-000123",
+	// atribuído pelo repositório na gravação.
+	PublicCode string `json:"public_code"`
+	// PublicCodeYear é o ano da sequência do protocolo, contado no fuso do
+	// expediente (FormCodeYear); precisa estar preenchido na gravação.
+	PublicCodeYear int `json:"-"`
 
 	DataDeAbertura       time.Time  `json:"data_de_abertura"`
 	TecnicoResponsavelId []Member   `json:"tecnicos_responsavel"`
@@ -16,9 +23,37 @@ type Atendimentos struct {
 	DifficultyLevel      string     `json:"difficulty_level"`
 	DefectDescription    string     `json:"defect_description"`
 	SolutionDescription  string     `json:"solution_description"`
+	Status               string     `json:"status"`
+
+	// ContractID é o contrato vigente do cliente na abertura do atendimento
+	// (uuid.Nil quando o cliente não possui contrato).
+	ContractID    uuid.UUID       `json:"contract_id"`
+	HoursConsumed decimal.Decimal `json:"hours_consumed"`
+
+	CustomFields CustomFields `json:"custom_fields"`
+	Tags         []string     `json:"tags"`
+
+	// FormTypeID e FormTypeVersion são o tipo de formulário e a versão do
+	// esquema com que o atendimento foi aberto (uuid.Nil e 0 = sem tipo);
+	// FormData segue sempre essa versão, mesmo após novas publicações.
+	FormTypeID      uuid.UUID `json:"form_type_id"`
+	FormTypeVersion int       `json:"form_type_version"`
+	FormData        FormData  `json:"form_data"`
+
+	// SLA são os prazos de resposta e resolução calculados na abertura.
+	SLA FormSLA `json:"sla"`
+
+	// Signed indica que o cliente assinou o atendimento; a partir daí só
+	// administradores podem alterá-lo.
+	Signed bool `json:"signed"`
+
+	// Checklist são os itens criados junto com o atendimento, copiados de um
+	// modelo; as consultas não preenchem o campo.
+	Checklist []*ChecklistItem `json:"-"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt time.Time `json:"deleted_at"`
 }
 
 type ClientForm struct {
@@ -45,8 +80,15 @@ func (u *Atendimentos) Validate() error {
 	if u.DataDeAbertura.IsZero() {
 		return ErrInvalidDataDeAbertura
 	}
+	if u.HoursConsumed.IsNegative() {
+		return ErrInvalidHoursConsumed
+	}
+	if u.Status != "" && !IsValidFormStatus(u.Status) {
+		return ErrInvalidFormStatus
+	}
 
-	if u.SolutionDescription == "" {
+	// A solução só é exigida depois que o atendimento foi resolvido.
+	if FormStatusRequiresSolution(u.Status) && u.SolutionDescription == "" {
 		return ErrInvalidSolutionDescription
 	}
 
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -215,7 +216,7 @@ func TestAtendimentos_Validate(t *testing.T) {
 			expectedErr: ErrInvalidDataDeAbertura,
 		},
 		{
-			name: "invalid atendimento - empty solution description",
+			name: "valid atendimento - open without solution description",
 			atendimento: Atendimentos{
 				ID:                   uuid.New(),
 				DataDeAbertura:       validDate,
@@ -225,12 +226,66 @@ func TestAtendimentos_Validate(t *testing.T) {
 				DifficultyLevel:      "medio",
 				DefectDescription:    "Problema identificado",
 				SolutionDescription:  "",
+				Status:               FormStatusOpen,
+				CreatedAt:            validDate,
+				UpdatedAt:            validDate,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid atendimento - resolved with empty solution description",
+			atendimento: Atendimentos{
+				ID:                   uuid.New(),
+				DataDeAbertura:       validDate,
+				TecnicoResponsavelId: validMember,
+				Cliente:              validClient,
+				SolicitedBy:          "João da Silva",
+				DifficultyLevel:      "medio",
+				DefectDescription:    "Problema identificado",
+				SolutionDescription:  "",
+				Status:               FormStatusResolved,
 				CreatedAt:            validDate,
 				UpdatedAt:            validDate,
 			},
 			wantErr:     true,
 			expectedErr: ErrInvalidSolutionDescription,
 		},
+		{
+			name: "invalid atendimento - unknown status",
+			atendimento: Atendimentos{
+				ID:                   uuid.New(),
+				DataDeAbertura:       validDate,
+				TecnicoResponsavelId: validMember,
+				Cliente:              validClient,
+				SolicitedBy:          "João da Silva",
+				DifficultyLevel:      "medio",
+				DefectDescription:    "Problema identificado",
+				SolutionDescription:  "Solução aplicada",
+				Status:               "pendente",
+				CreatedAt:            validDate,
+				UpdatedAt:            validDate,
+			},
+			wantErr:     true,
+			expectedErr: ErrInvalidFormStatus,
+		},
+		{
+			name: "invalid atendimento - negative hours consumed",
+			atendimento: Atendimentos{
+				ID:                   uuid.New(),
+				DataDeAbertura:       validDate,
+				TecnicoResponsavelId: validMember,
+				Cliente:              validClient,
+				SolicitedBy:          "João da Silva",
+				DifficultyLevel:      "medio",
+				DefectDescription:    "Problema identificado",
+				SolutionDescription:  "Solução aplicada",
+				HoursConsumed:        decimal.NewFromInt(-1),
+				CreatedAt:            validDate,
+				UpdatedAt:            validDate,
+			},
+			wantErr:     true,
+			expectedErr: ErrInvalidHoursConsumed,
+		},
 	}
 
 	for _, tt := range tests {
@@ -402,6 +457,7 @@ func TestAtendimentos_SolutionDescription_EdgeCases(t *testing.T) {
 				DifficultyLevel:      "medio",
 				DefectDescription:    "Descrição do defeito",
 				SolutionDescription:  tt.value,
+				Status:               FormStatusResolved,
 			}
 
 			err := atendimento.Validate()
@@ -0,0 +1,306 @@
+package domains
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Situações de uma fatura.
+const (
+	InvoiceStatusDraft    = "rascunho"
+	InvoiceStatusIssued   = "emitida"
+	InvoiceStatusPaid     = "paga"
+	InvoiceStatusCanceled = "cancelada"
+)
+
+// Tipos de item da fatura.
+const (
+	InvoiceItemLabor  = "mao_de_obra"
+	InvoiceItemPart   = "peca"
+	InvoiceItemTravel = "deslocamento"
+)
+
+const (
+	MaxInvoiceNotesLength  = 2000
+	MaxInvoiceCancelReason = 500
+	// MaxInvoiceForms limita os atendimentos cobrados em uma única fatura.
+	MaxInvoiceForms = 100
+	// InvoiceRatePlaces é o número de casas decimais da alíquota, em
+	// porcentagem.
+	InvoiceRatePlaces = 2
+)
+
+// invoiceTransitions lista, para cada situação, as situações seguintes
+// permitidas. Paga e cancelada são finais.
+var invoiceTransitions = map[string][]string{
+	InvoiceStatusDraft:    {InvoiceStatusIssued, InvoiceStatusCanceled},
+	InvoiceStatusIssued:   {InvoiceStatusPaid, InvoiceStatusCanceled},
+	InvoiceStatusPaid:     {},
+	InvoiceStatusCanceled: {},
+}
+
+var invoiceDifficultyLabels = map[string]string{
+	"low":    "baixa",
+	"medium": "média",
+	"high":   "alta",
+}
+
+// BillingSettings são os valores de cobrança dos atendimentos avulsos: o
+// valor da hora por nível de dificuldade, a taxa de deslocamento por visita e
+// a alíquota de impostos, em porcentagem, aplicada às novas faturas.
+type BillingSettings struct {
+	HourRateLow    decimal.Decimal `json:"hour_rate_low"`
+	HourRateMedium decimal.Decimal `json:"hour_rate_medium"`
+	HourRateHigh   decimal.Decimal `json:"hour_rate_high"`
+	TravelFee      decimal.Decimal `json:"travel_fee"`
+	TaxRate        decimal.Decimal `json:"tax_rate"`
+	UpdatedBy      uuid.UUID       `json:"updated_by"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// DefaultBillingSettings é usado enquanto a organização não configura os
+// valores; tudo zerado.
+func DefaultBillingSettings() *BillingSettings {
+	return &BillingSettings{
+		HourRateLow:    decimal.Zero,
+		HourRateMedium: decimal.Zero,
+		HourRateHigh:   decimal.Zero,
+		TravelFee:      decimal.Zero,
+		TaxRate:        decimal.Zero,
+	}
+}
+
+func (s *BillingSettings) Validate() error {
+	for _, v := range []decimal.Decimal{s.HourRateLow, s.HourRateMedium, s.HourRateHigh, s.TravelFee} {
+		if !isMoney(v) {
+			return ErrInvalidBillingSettings
+		}
+	}
+	if !IsValidTaxRate(s.TaxRate) {
+		return ErrInvalidBillingSettings
+	}
+	return nil
+}
+
+// HourRate devolve o valor da hora do nível de dificuldade.
+func (s *BillingSettings) HourRate(difficulty string) decimal.Decimal {
+	switch difficulty {
+	case "low":
+		return s.HourRateLow
+	case "medium":
+		return s.HourRateMedium
+	case "high":
+		return s.HourRateHigh
+	}
+	return decimal.Zero
+}
+
+// InvoiceItem é uma linha da fatura. FormID é o atendimento que originou o
+// item (uuid.Nil se o atendimento foi excluído depois).
+type InvoiceItem struct {
+	ID          uuid.UUID       `json:"id"`
+	FormID      uuid.UUID       `json:"form_id"`
+	Kind        string          `json:"kind"`
+	Description string          `json:"description"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+	Total       decimal.Decimal `json:"total"`
+}
+
+// Invoice é a fatura de um ou mais atendimentos avulsos de um cliente. Number
+// é atribuído na emissão, em sequência e sem lacunas; rascunhos têm Number
+// zero. Discount é um valor abatido do subtotal e TaxRate a alíquota, em
+// porcentagem, aplicada sobre o subtotal com desconto.
+type Invoice struct {
+	ID           uuid.UUID       `json:"id"`
+	Number       int64           `json:"number"`
+	ClientID     uuid.UUID       `json:"client_id"`
+	ClientName   string          `json:"client_name"`
+	Status       string          `json:"status"`
+	FormIDs      []uuid.UUID     `json:"form_ids"`
+	Items        []*InvoiceItem  `json:"items"`
+	Subtotal     decimal.Decimal `json:"subtotal"`
+	Discount     decimal.Decimal `json:"discount"`
+	TaxRate      decimal.Decimal `json:"tax_rate"`
+	TaxAmount    decimal.Decimal `json:"tax_amount"`
+	Total        decimal.Decimal `json:"total"`
+	Notes        string          `json:"notes"`
+	DueDate      time.Time       `json:"due_date"`
+	IssuedAt     time.Time       `json:"issued_at"`
+	PaidAt       time.Time       `json:"paid_at"`
+	CanceledAt   time.Time       `json:"canceled_at"`
+	CancelReason string          `json:"cancel_reason"`
+	CreatedBy    uuid.UUID       `json:"created_by"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// InvoiceFilter filtra a listagem de faturas; campos vazios não filtram.
+type InvoiceFilter struct {
+	Status   string    `json:"status"`
+	ClientID uuid.UUID `json:"client_id"`
+}
+
+func IsValidInvoiceStatus(status string) bool {
+	_, ok := invoiceTransitions[status]
+	return ok
+}
+
+// IsValidTaxRate aceita alíquotas de 0 a 100% com até 2 casas decimais.
+func IsValidTaxRate(rate decimal.Decimal) bool {
+	return !rate.IsNegative() && rate.LessThanOrEqual(decimal.NewFromInt(100)) &&
+		rate.Equal(rate.Round(InvoiceRatePlaces))
+}
+
+// CheckInvoiceableForm confere se o atendimento pode ser faturado: precisa
+// estar resolvido ou fechado e não pode estar coberto por contrato.
+func CheckInvoiceableForm(form *Atendimentos) error {
+	if !FormStatusRequiresSolution(form.Status) {
+		return ErrFormNotInvoiceable
+	}
+	if form.ContractID != uuid.Nil {
+		return ErrFormUnderContract
+	}
+	return nil
+}
+
+// BuildInvoiceItems monta os itens de um atendimento: mão de obra pelas horas
+// consumidas e o valor da hora da dificuldade, as peças lançadas com o preço
+// cobrado e, se includeTravel, uma taxa de deslocamento. Itens de valor zero
+// por falta de horas ou de taxa configurada são omitidos.
+func BuildInvoiceItems(form *Atendimentos, parts []*FormPart, settings *BillingSettings, includeTravel bool) []*InvoiceItem {
+	ref := FormReference(form)
+	items := make([]*InvoiceItem, 0, len(parts)+2)
+
+	if form.HoursConsumed.IsPositive() {
+		items = append(items, newInvoiceItem(form.ID, InvoiceItemLabor,
+			fmt.Sprintf("Mão de obra - dificuldade %s - atendimento %s", label(invoiceDifficultyLabels, form.DifficultyLevel), ref),
+			form.HoursConsumed, settings.HourRate(form.DifficultyLevel)))
+	}
+	for _, p := range parts {
+		items = append(items, newInvoiceItem(form.ID, InvoiceItemPart,
+			fmt.Sprintf("%s - %s (%s) - atendimento %s", p.SKU, p.PartName, p.Unit, ref),
+			p.Quantity, p.UnitPrice))
+	}
+	if includeTravel && settings.TravelFee.IsPositive() {
+		items = append(items, newInvoiceItem(form.ID, InvoiceItemTravel,
+			"Deslocamento - atendimento "+ref, decimal.NewFromInt(1), settings.TravelFee))
+	}
+	return items
+}
+
+// FormReference é a referência do atendimento impressa nos documentos: o
+// protocolo ou, na falta dele, o início do ID.
+func FormReference(form *Atendimentos) string {
+	if form.PublicCode != "" {
+		return form.PublicCode
+	}
+	return strings.ToUpper(form.ID.String()[:8])
+}
+
+func newInvoiceItem(formID uuid.UUID, kind, description string, quantity, unitPrice decimal.Decimal) *InvoiceItem {
+	return &InvoiceItem{
+		FormID:      formID,
+		Kind:        kind,
+		Description: description,
+		Quantity:    quantity,
+		UnitPrice:   unitPrice,
+		Total:       quantity.Mul(unitPrice).Round(PartPricePlaces),
+	}
+}
+
+// Recalculate refaz subtotal, impostos e total a partir dos itens, do
+// desconto e da alíquota.
+func (i *Invoice) Recalculate() {
+	i.Subtotal = decimal.Zero
+	for _, item := range i.Items {
+		item.Total = item.Quantity.Mul(item.UnitPrice).Round(PartPricePlaces)
+		i.Subtotal = i.Subtotal.Add(item.Total)
+	}
+	base := i.Subtotal.Sub(i.Discount)
+	i.TaxAmount = base.Mul(i.TaxRate).Div(decimal.NewFromInt(100)).Round(PartPricePlaces)
+	i.Total = base.Add(i.TaxAmount)
+}
+
+func (i *Invoice) Validate() error {
+	if i.ClientID == uuid.Nil || len(i.FormIDs) == 0 || len(i.FormIDs) > MaxInvoiceForms || len(i.Items) == 0 {
+		return ErrInvalidInvoice
+	}
+	if !isMoney(i.Discount) || i.Discount.GreaterThan(i.Subtotal) {
+		return ErrInvalidInvoiceDiscount
+	}
+	if !IsValidTaxRate(i.TaxRate) {
+		return ErrInvalidInvoiceTaxRate
+	}
+	if utf8.RuneCountInString(i.Notes) > MaxInvoiceNotesLength {
+		return ErrInvalidInvoice
+	}
+	return nil
+}
+
+// CanTransitionInvoice informa se a mudança de from para to é permitida.
+func CanTransitionInvoice(from, to string) bool {
+	for _, next := range invoiceTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo muda a situação da fatura e registra a data da mudança. O
+// motivo é obrigatório ao cancelar uma fatura já emitida. O número da fatura
+// emitida é atribuído pelo repositório.
+func (i *Invoice) TransitionTo(to, reason string, at time.Time) error {
+	if !IsValidInvoiceStatus(to) {
+		return ErrInvalidInvoiceStatus
+	}
+	if !CanTransitionInvoice(i.Status, to) {
+		return ErrInvalidInvoiceTransition
+	}
+
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > MaxInvoiceCancelReason {
+		return ErrInvalidInvoiceCancelReason
+	}
+
+	switch to {
+	case InvoiceStatusIssued:
+		i.IssuedAt = at
+	case InvoiceStatusPaid:
+		i.PaidAt = at
+	case InvoiceStatusCanceled:
+		if i.Status == InvoiceStatusIssued && reason == "" {
+			return ErrInvalidInvoiceCancelReason
+		}
+		i.CanceledAt = at
+		i.CancelReason = reason
+	}
+	i.Status = to
+	return nil
+}
+
+// InvoiceNumberLabel formata o número da fatura com seis dígitos.
+func InvoiceNumberLabel(number int64) string {
+	if number == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%06d", number)
+}
+
+// isMoney aceita valores não negativos com até 2 casas decimais.
+func isMoney(v decimal.Decimal) bool {
+	return !v.IsNegative() && v.Equal(v.Round(PartPricePlaces))
+}
+
+func label(labels map[string]string, value string) string {
+	if l, ok := labels[value]; ok {
+		return l
+	}
+	return value
+}
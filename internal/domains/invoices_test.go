@@ -0,0 +1,143 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBillingSettings() *BillingSettings {
+	return &BillingSettings{
+		HourRateLow:    decimal.RequireFromString("80"),
+		HourRateMedium: decimal.RequireFromString("120"),
+		HourRateHigh:   decimal.RequireFromString("180"),
+		TravelFee:      decimal.RequireFromString("45.50"),
+		TaxRate:        decimal.RequireFromString("5"),
+	}
+}
+
+func TestBillingSettings_Validate(t *testing.T) {
+	assert.NoError(t, testBillingSettings().Validate())
+	assert.NoError(t, DefaultBillingSettings().Validate())
+
+	s := testBillingSettings()
+	s.HourRateHigh = decimal.NewFromInt(-1)
+	assert.ErrorIs(t, s.Validate(), ErrInvalidBillingSettings)
+
+	s = testBillingSettings()
+	s.TravelFee = decimal.RequireFromString("10.005")
+	assert.ErrorIs(t, s.Validate(), ErrInvalidBillingSettings)
+
+	s = testBillingSettings()
+	s.TaxRate = decimal.NewFromInt(101)
+	assert.ErrorIs(t, s.Validate(), ErrInvalidBillingSettings)
+
+	assert.True(t, testBillingSettings().HourRate("medium").Equal(decimal.NewFromInt(120)))
+	assert.True(t, testBillingSettings().HourRate("unknown").IsZero())
+}
+
+func TestCheckInvoiceableForm(t *testing.T) {
+	assert.NoError(t, CheckInvoiceableForm(&Atendimentos{Status: FormStatusResolved}))
+	assert.NoError(t, CheckInvoiceableForm(&Atendimentos{Status: FormStatusClosed}))
+	assert.ErrorIs(t, CheckInvoiceableForm(&Atendimentos{Status: FormStatusInProgress}), ErrFormNotInvoiceable)
+	assert.ErrorIs(t, CheckInvoiceableForm(&Atendimentos{Status: FormStatusResolved, ContractID: uuid.New()}), ErrFormUnderContract)
+}
+
+func TestBuildInvoiceItems(t *testing.T) {
+	form := &Atendimentos{
+		ID:              uuid.MustParse("0191c2b4-6a8e-7c3e-9d4a-1b2c3d4e5f60"),
+		DifficultyLevel: "high",
+		HoursConsumed:   decimal.RequireFromString("1.5"),
+	}
+	parts := []*FormPart{
+		{SKU: "CABO-SERIAL", PartName: "Cabo serial", Unit: "un", Quantity: decimal.NewFromInt(2), UnitPrice: decimal.RequireFromString("19.90")},
+	}
+
+	items := BuildInvoiceItems(form, parts, testBillingSettings(), true)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, InvoiceItemLabor, items[0].Kind)
+	assert.Equal(t, "Mão de obra - dificuldade alta - atendimento 0191C2B4", items[0].Description)
+	assert.True(t, items[0].Total.Equal(decimal.NewFromInt(270)))
+
+	assert.Equal(t, InvoiceItemPart, items[1].Kind)
+	assert.True(t, items[1].Total.Equal(decimal.RequireFromString("39.80")))
+
+	assert.Equal(t, InvoiceItemTravel, items[2].Kind)
+	assert.True(t, items[2].Total.Equal(decimal.RequireFromString("45.50")))
+	for _, item := range items {
+		assert.Equal(t, form.ID, item.FormID)
+	}
+
+	form.PublicCode = "OS-2026-000123"
+	items = BuildInvoiceItems(form, nil, testBillingSettings(), false)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Mão de obra - dificuldade alta - atendimento OS-2026-000123", items[0].Description)
+
+	t.Run("without hours or travel", func(t *testing.T) {
+		f := *form
+		f.HoursConsumed = decimal.Zero
+		items := BuildInvoiceItems(&f, nil, testBillingSettings(), false)
+		assert.Empty(t, items)
+	})
+}
+
+func TestInvoice_RecalculateAndValidate(t *testing.T) {
+	inv := &Invoice{
+		ClientID: uuid.New(),
+		FormIDs:  []uuid.UUID{uuid.New()},
+		Items: []*InvoiceItem{
+			{Quantity: decimal.RequireFromString("1.5"), UnitPrice: decimal.NewFromInt(180)},
+			{Quantity: decimal.NewFromInt(3), UnitPrice: decimal.RequireFromString("0.333")},
+		},
+		Discount: decimal.RequireFromString("20"),
+		TaxRate:  decimal.RequireFromString("7.5"),
+	}
+	inv.Recalculate()
+
+	assert.Equal(t, "271", inv.Subtotal.String())
+	assert.Equal(t, "18.83", inv.TaxAmount.String())
+	assert.Equal(t, "269.83", inv.Total.String())
+	assert.NoError(t, inv.Validate())
+
+	inv.Discount = decimal.NewFromInt(300)
+	assert.ErrorIs(t, inv.Validate(), ErrInvalidInvoiceDiscount)
+
+	inv.Discount = decimal.Zero
+	inv.TaxRate = decimal.RequireFromString("5.125")
+	assert.ErrorIs(t, inv.Validate(), ErrInvalidInvoiceTaxRate)
+
+	inv.TaxRate = decimal.Zero
+	inv.Items = nil
+	assert.ErrorIs(t, inv.Validate(), ErrInvalidInvoice)
+}
+
+func TestInvoice_TransitionTo(t *testing.T) {
+	at := time.Date(2026, 5, 4, 12, 0, 0, 0, time.UTC)
+
+	inv := &Invoice{Status: InvoiceStatusDraft}
+	require.NoError(t, inv.TransitionTo(InvoiceStatusIssued, "", at))
+	assert.Equal(t, at, inv.IssuedAt)
+
+	assert.ErrorIs(t, inv.TransitionTo(InvoiceStatusDraft, "", at), ErrInvalidInvoiceTransition)
+	assert.ErrorIs(t, inv.TransitionTo(InvoiceStatusCanceled, " ", at), ErrInvalidInvoiceCancelReason)
+	assert.ErrorIs(t, inv.TransitionTo("estornada", "", at), ErrInvalidInvoiceStatus)
+
+	require.NoError(t, inv.TransitionTo(InvoiceStatusPaid, "", at))
+	assert.Equal(t, at, inv.PaidAt)
+	assert.ErrorIs(t, inv.TransitionTo(InvoiceStatusCanceled, "erro", at), ErrInvalidInvoiceTransition)
+
+	draft := &Invoice{Status: InvoiceStatusDraft}
+	require.NoError(t, draft.TransitionTo(InvoiceStatusCanceled, "", at))
+	assert.Equal(t, at, draft.CanceledAt)
+}
+
+func TestInvoiceNumberLabel(t *testing.T) {
+	assert.Equal(t, "", InvoiceNumberLabel(0))
+	assert.Equal(t, "000123", InvoiceNumberLabel(123))
+	assert.Equal(t, "1234567", InvoiceNumberLabel(1234567))
+}
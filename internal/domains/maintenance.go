@@ -0,0 +1,197 @@
+package domains
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Situações de um plano de manutenção.
+const (
+	MaintenancePlanActive = "ativo"
+	MaintenancePlanPaused = "pausado"
+)
+
+const (
+	MaxMaintenancePlanNameLength    = 50
+	MaxMaintenanceSiteLength        = 100
+	MaxMaintenanceDescriptionLength = 120
+	MaxChecklistItems               = 50
+	MaxChecklistItemLength          = 200
+	// DefaultMaintenanceHorizon é a antecedência com que as visitas viram
+	// atendimentos.
+	DefaultMaintenanceHorizon = 14 * 24 * time.Hour
+	// MaintenanceTag marca os atendimentos gerados pelos planos.
+	MaintenanceTag = "preventiva"
+	// maintenanceLookahead limita a busca das próximas visitas.
+	maintenanceLookahead = 10 * 366 * 24 * time.Hour
+)
+
+// MaintenancePlan gera visitas preventivas para um cliente (ou um local do
+// cliente, em Site) segundo a regra Recurrence, a partir de StartsAt.
+// GeneratedUntil é o fim da última janela já gerada.
+type MaintenancePlan struct {
+	ID              uuid.UUID   `json:"id"`
+	ClientID        uuid.UUID   `json:"client_id"`
+	ClientName      string      `json:"client_name"`
+	Name            string      `json:"name"`
+	Site            string      `json:"site"`
+	Description     string      `json:"description"`
+	Recurrence      string      `json:"recurrence"`
+	StartsAt        time.Time   `json:"starts_at"`
+	DifficultyLevel string      `json:"difficulty_level"`
+	Checklist       []string    `json:"checklist"`
+	TechnicianIDs   []uuid.UUID `json:"technician_ids"`
+	Status          string      `json:"status"`
+	GeneratedUntil  time.Time   `json:"generated_until"`
+	CreatedBy       uuid.UUID   `json:"created_by"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+// Normalize remove espaços, descarta itens vazios da lista de verificação e
+// técnicos repetidos e grava a regra na forma canônica quando ela é válida.
+func (p *MaintenancePlan) Normalize() {
+	p.Name = strings.TrimSpace(p.Name)
+	p.Site = strings.TrimSpace(p.Site)
+	p.Description = strings.TrimSpace(p.Description)
+	if p.Status == "" {
+		p.Status = MaintenancePlanActive
+	}
+
+	checklist := make([]string, 0, len(p.Checklist))
+	for _, item := range p.Checklist {
+		if item = strings.TrimSpace(item); item != "" {
+			checklist = append(checklist, item)
+		}
+	}
+	p.Checklist = checklist
+
+	seen := make(map[uuid.UUID]bool, len(p.TechnicianIDs))
+	technicians := make([]uuid.UUID, 0, len(p.TechnicianIDs))
+	for _, id := range p.TechnicianIDs {
+		if id != uuid.Nil && !seen[id] {
+			seen[id] = true
+			technicians = append(technicians, id)
+		}
+	}
+	p.TechnicianIDs = technicians
+
+	if rule, err := ParseRecurrence(p.Recurrence); err == nil {
+		p.Recurrence = rule.String()
+	}
+}
+
+func (p *MaintenancePlan) Validate() error {
+	if p.Name == "" || utf8.RuneCountInString(p.Name) > MaxMaintenancePlanNameLength {
+		return ErrInvalidMaintenancePlan
+	}
+	if utf8.RuneCountInString(p.Site) > MaxMaintenanceSiteLength ||
+		utf8.RuneCountInString(p.Description) > MaxMaintenanceDescriptionLength {
+		return ErrInvalidMaintenancePlan
+	}
+	if p.ClientID == uuid.Nil || p.StartsAt.IsZero() || len(p.TechnicianIDs) == 0 {
+		return ErrInvalidMaintenancePlan
+	}
+	switch p.DifficultyLevel {
+	case "low", "medium", "high":
+	default:
+		return ErrInvalidDifficultyLevel
+	}
+	if p.Status != MaintenancePlanActive && p.Status != MaintenancePlanPaused {
+		return ErrInvalidMaintenancePlan
+	}
+	if _, err := ParseRecurrence(p.Recurrence); err != nil {
+		return err
+	}
+	if len(p.Checklist) > MaxChecklistItems {
+		return ErrInvalidChecklist
+	}
+	for _, item := range p.Checklist {
+		if item == "" || utf8.RuneCountInString(item) > MaxChecklistItemLength {
+			return ErrInvalidChecklist
+		}
+	}
+	return nil
+}
+
+// Pause interrompe a geração de visitas.
+func (p *MaintenancePlan) Pause() error {
+	if p.Status == MaintenancePlanPaused {
+		return ErrMaintenancePlanPaused
+	}
+	p.Status = MaintenancePlanPaused
+	return nil
+}
+
+// Resume retoma a geração a partir de now: as visitas do período em que o
+// plano ficou pausado não são geradas.
+func (p *MaintenancePlan) Resume(now time.Time) error {
+	if p.Status == MaintenancePlanActive {
+		return ErrMaintenancePlanActive
+	}
+	p.Status = MaintenancePlanActive
+	p.GeneratedUntil = now
+	return nil
+}
+
+// GenerationWindow devolve o intervalo [from, to) de visitas a gerar em now:
+// do fim da última janela gerada (ou de now, na primeira vez) até now mais a
+// antecedência.
+func (p *MaintenancePlan) GenerationWindow(now time.Time, horizon time.Duration) (time.Time, time.Time) {
+	from := p.GeneratedUntil
+	if from.IsZero() {
+		from = now
+	}
+	return from, now.Add(horizon)
+}
+
+// Occurrences devolve as visitas do plano em [from, to), com o horário de
+// StartsAt no fuso loc.
+func (p *MaintenancePlan) Occurrences(loc *time.Location, from, to time.Time) ([]time.Time, error) {
+	rule, err := ParseRecurrence(p.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	return rule.Between(p.StartsAt, loc, from, to), nil
+}
+
+// NextVisits devolve até n visitas a partir de now. Planos pausados não têm
+// próximas visitas.
+func (p *MaintenancePlan) NextVisits(loc *time.Location, now time.Time, n int) []time.Time {
+	if p.Status != MaintenancePlanActive {
+		return []time.Time{}
+	}
+	visits, err := p.Occurrences(loc, now, now.Add(maintenanceLookahead))
+	if err != nil {
+		return []time.Time{}
+	}
+	if len(visits) > n {
+		visits = visits[:n]
+	}
+	return visits
+}
+
+// NewForm monta o atendimento agendado da visita em at. O contrato e os
+// técnicos são definidos por quem grava o atendimento.
+func (p *MaintenancePlan) NewForm(at time.Time) *Atendimentos {
+	description := "Manutenção preventiva"
+	if p.Site != "" {
+		description += " - " + p.Site
+	}
+	if p.Description != "" {
+		description += ": " + p.Description
+	}
+
+	return &Atendimentos{
+		Cliente:           ClientForm{ID: p.ClientID, ClientName: p.ClientName},
+		DataDeAbertura:    at.UTC(),
+		SolicitedBy:       p.Name,
+		DifficultyLevel:   p.DifficultyLevel,
+		DefectDescription: description,
+		Status:            FormStatusScheduled,
+		Tags:              []string{MaintenanceTag},
+	}
+}
@@ -0,0 +1,146 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func validMaintenancePlan() MaintenancePlan {
+	return MaintenancePlan{
+		ClientID:        uuid.New(),
+		Name:            "Preventiva trimestral",
+		Recurrence:      "FREQ=MONTHLY;INTERVAL=3",
+		StartsAt:        time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+		DifficultyLevel: "low",
+		Checklist:       []string{"Limpar filtros", "Medir tensão"},
+		TechnicianIDs:   []uuid.UUID{uuid.New()},
+		Status:          MaintenancePlanActive,
+	}
+}
+
+func TestMaintenancePlan_Validate(t *testing.T) {
+	valid := validMaintenancePlan()
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		change func(*MaintenancePlan)
+		err    error
+	}{
+		{"no name", func(p *MaintenancePlan) { p.Name = "" }, ErrInvalidMaintenancePlan},
+		{"no client", func(p *MaintenancePlan) { p.ClientID = uuid.Nil }, ErrInvalidMaintenancePlan},
+		{"no start", func(p *MaintenancePlan) { p.StartsAt = time.Time{} }, ErrInvalidMaintenancePlan},
+		{"no technicians", func(p *MaintenancePlan) { p.TechnicianIDs = nil }, ErrInvalidMaintenancePlan},
+		{"unknown status", func(p *MaintenancePlan) { p.Status = "arquivado" }, ErrInvalidMaintenancePlan},
+		{"difficulty", func(p *MaintenancePlan) { p.DifficultyLevel = "urgent" }, ErrInvalidDifficultyLevel},
+		{"recurrence", func(p *MaintenancePlan) { p.Recurrence = "FREQ=HOURLY" }, ErrInvalidRecurrence},
+		{"too many items", func(p *MaintenancePlan) { p.Checklist = make([]string, MaxChecklistItems+1) }, ErrInvalidChecklist},
+		{"empty item", func(p *MaintenancePlan) { p.Checklist = []string{""} }, ErrInvalidChecklist},
+	}
+	for _, tt := range tests {
+		p := valid
+		tt.change(&p)
+		assert.ErrorIs(t, p.Validate(), tt.err, tt.name)
+	}
+}
+
+func TestMaintenancePlan_Normalize(t *testing.T) {
+	technician := uuid.New()
+	p := MaintenancePlan{
+		Name:          "  Preventiva ",
+		Recurrence:    "rrule:freq=monthly;interval=1",
+		Checklist:     []string{" Limpar filtros ", "", "  "},
+		TechnicianIDs: []uuid.UUID{technician, uuid.Nil, technician},
+	}
+	p.Normalize()
+
+	assert.Equal(t, "Preventiva", p.Name)
+	assert.Equal(t, "FREQ=MONTHLY", p.Recurrence)
+	assert.Equal(t, []string{"Limpar filtros"}, p.Checklist)
+	assert.Equal(t, []uuid.UUID{technician}, p.TechnicianIDs)
+	assert.Equal(t, MaintenancePlanActive, p.Status)
+}
+
+func TestMaintenancePlan_PauseResume(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	p := validMaintenancePlan()
+	p.GeneratedUntil = now.Add(-30 * 24 * time.Hour)
+
+	assert.ErrorIs(t, p.Resume(now), ErrMaintenancePlanActive)
+	assert.NoError(t, p.Pause())
+	assert.ErrorIs(t, p.Pause(), ErrMaintenancePlanPaused)
+	assert.NoError(t, p.Resume(now))
+	assert.Equal(t, MaintenancePlanActive, p.Status)
+
+	from, to := p.GenerationWindow(now, DefaultMaintenanceHorizon)
+	assert.Equal(t, now, from)
+	assert.Equal(t, now.Add(DefaultMaintenanceHorizon), to)
+}
+
+func TestMaintenancePlan_GenerationWindow(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	p := validMaintenancePlan()
+
+	from, to := p.GenerationWindow(now, time.Hour)
+	assert.Equal(t, now, from)
+	assert.Equal(t, now.Add(time.Hour), to)
+
+	p.GeneratedUntil = now.Add(-time.Hour)
+	from, _ = p.GenerationWindow(now, time.Hour)
+	assert.Equal(t, now.Add(-time.Hour), from)
+
+	occurrences, err := p.Occurrences(time.UTC, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 4, 15, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 7, 15, 12, 0, 0, 0, time.UTC),
+	}, occurrences)
+}
+
+func TestMaintenancePlan_NewForm(t *testing.T) {
+	at := time.Date(2026, 4, 15, 12, 0, 0, 0, time.UTC)
+	p := validMaintenancePlan()
+	p.Site = "Filial Centro"
+	p.Description = "Ar-condicionado"
+
+	form := p.NewForm(at)
+	assert.Equal(t, p.ClientID, form.Cliente.ID)
+	assert.Equal(t, at, form.DataDeAbertura)
+	assert.Equal(t, FormStatusScheduled, form.Status)
+	assert.Equal(t, p.Name, form.SolicitedBy)
+	assert.Equal(t, "Manutenção preventiva - Filial Centro: Ar-condicionado", form.DefectDescription)
+	assert.Equal(t, []string{MaintenanceTag}, form.Tags)
+}
+
+func TestChecklistItem_Mark(t *testing.T) {
+	items := NewChecklist(uuid.New(), []string{"Limpar filtros", "Medir tensão"})
+	assert.Len(t, items, 2)
+	assert.Equal(t, 2, items[1].Position)
+
+	member, at := uuid.New(), time.Now()
+	items[0].Mark(true, member, at)
+	assert.True(t, items[0].Done)
+	assert.Equal(t, member, items[0].DoneBy)
+
+	items[0].Mark(false, member, at)
+	assert.False(t, items[0].Done)
+	assert.Equal(t, uuid.Nil, items[0].DoneBy)
+	assert.True(t, items[0].DoneAt.IsZero())
+}
+
+func TestMaintenancePlan_NextVisits(t *testing.T) {
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	p := validMaintenancePlan()
+
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 4, 15, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 7, 15, 12, 0, 0, 0, time.UTC),
+	}, p.NextVisits(time.UTC, now, 2))
+
+	assert.NoError(t, p.Pause())
+	assert.Empty(t, p.NextVisits(time.UTC, now, 2))
+}
@@ -0,0 +1,61 @@
+package domains
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de notificação.
+const (
+	NotificationSLAAtRisk   = "sla_em_risco"
+	NotificationSLABreached = "sla_violado"
+)
+
+const (
+	DefaultNotificationPageSize = 50
+	MaxNotificationPageSize     = 200
+)
+
+// Notification é um aviso para um usuário sobre um atendimento. ReadAt é zero
+// enquanto o aviso não foi lido.
+type Notification struct {
+	ID         uuid.UUID `json:"id"`
+	UserID     uuid.UUID `json:"user_id"`
+	FormID     uuid.UUID `json:"form_id"`
+	PublicCode string    `json:"public_code"`
+	Kind       string    `json:"kind"`
+	Message    string    `json:"message"`
+	CreatedAt  time.Time `json:"created_at"`
+	ReadAt     time.Time `json:"read_at"`
+}
+
+// SLANotificationKind devolve o tipo de notificação para a situação do SLA,
+// ou "" quando a situação não gera aviso.
+func SLANotificationKind(state string) string {
+	switch state {
+	case SLAStateAtRisk:
+		return NotificationSLAAtRisk
+	case SLAStateBreached:
+		return NotificationSLABreached
+	}
+	return ""
+}
+
+// SLAFlag é o resultado da verificação de um atendimento pelo monitor de SLA:
+// a situação avaliada e a última situação já notificada.
+type SLAFlag struct {
+	FormID        uuid.UUID `json:"form_id"`
+	ClientName    string    `json:"client_name"`
+	State         string    `json:"state"`
+	NotifiedState string    `json:"notified_state"`
+}
+
+// NeedsNotification informa se a situação mudou para em risco ou violado
+// desde o último aviso. Um atendimento violado não volta a avisar em risco.
+func (f SLAFlag) NeedsNotification() bool {
+	if SLANotificationKind(f.State) == "" || f.State == f.NotifiedState {
+		return false
+	}
+	return !(f.State == SLAStateAtRisk && f.NotifiedState == SLAStateBreached)
+}
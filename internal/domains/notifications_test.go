@@ -0,0 +1,27 @@
+package domains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSLAFlag_NeedsNotification(t *testing.T) {
+	tests := []struct {
+		state, notified string
+		want            bool
+	}{
+		{SLAStateAtRisk, "", true},
+		{SLAStateBreached, "", true},
+		{SLAStateBreached, SLAStateAtRisk, true},
+		{SLAStateAtRisk, SLAStateAtRisk, false},
+		{SLAStateBreached, SLAStateBreached, false},
+		{SLAStateAtRisk, SLAStateBreached, false},
+		{SLAStateOnTrack, "", false},
+		{SLAStateMet, SLAStateAtRisk, false},
+	}
+	for _, tt := range tests {
+		flag := SLAFlag{State: tt.state, NotifiedState: tt.notified}
+		assert.Equal(t, tt.want, flag.NeedsNotification(), tt.state+" after "+tt.notified)
+	}
+}
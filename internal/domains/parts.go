@@ -0,0 +1,321 @@
+package domains
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Unidades de medida das peças.
+var PartUnits = []string{"un", "par", "cx", "kit", "m", "rolo", "kg", "l"}
+
+// Tipos de local de estoque: almoxarifado ou veículo de um técnico.
+const (
+	StockLocationWarehouse = "almoxarifado"
+	StockLocationVan       = "veiculo"
+)
+
+// Tipos de movimentação de estoque. Entradas, saídas, ajustes e
+// transferências são lançados pelo almoxarifado; consumos e estornos, pelo
+// lançamento e pela remoção de peças nos atendimentos.
+const (
+	StockMovementIn       = "entrada"
+	StockMovementOut      = "saida"
+	StockMovementAdjust   = "ajuste"
+	StockMovementTransfer = "transferencia"
+	StockMovementConsume  = "consumo"
+	StockMovementReturn   = "estorno"
+)
+
+const (
+	MaxPartSKULength           = 50
+	MaxPartNameLength          = 150
+	MaxStockLocationNameLength = 100
+	MaxStockMovementNotes      = 200
+	// PartQuantityPlaces é o número de casas decimais das quantidades.
+	PartQuantityPlaces = 3
+	// PartPricePlaces é o número de casas decimais de custos e preços.
+	PartPricePlaces = 2
+
+	DefaultStockMovementPageSize = 100
+	MaxStockMovementPageSize     = 500
+)
+
+// Part é uma peça ou material do catálogo. MinStock é o estoque mínimo
+// somando todos os locais; zero desliga o alerta de estoque baixo.
+type Part struct {
+	ID        uuid.UUID       `json:"id"`
+	SKU       string          `json:"sku"`
+	Name      string          `json:"name"`
+	Unit      string          `json:"unit"`
+	Cost      decimal.Decimal `json:"cost"`
+	Price     decimal.Decimal `json:"price"`
+	MinStock  decimal.Decimal `json:"min_stock"`
+	Active    bool            `json:"active"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// Normalize remove espaços e grava o SKU em maiúsculas.
+func (p *Part) Normalize() {
+	p.SKU = strings.ToUpper(strings.TrimSpace(p.SKU))
+	p.Name = strings.TrimSpace(p.Name)
+	p.Unit = strings.ToLower(strings.TrimSpace(p.Unit))
+}
+
+func (p *Part) Validate() error {
+	if p.SKU == "" || utf8.RuneCountInString(p.SKU) > MaxPartSKULength || strings.ContainsAny(p.SKU, " \t\n") {
+		return ErrInvalidPart
+	}
+	if p.Name == "" || utf8.RuneCountInString(p.Name) > MaxPartNameLength {
+		return ErrInvalidPart
+	}
+	if !IsValidPartUnit(p.Unit) {
+		return ErrInvalidPartUnit
+	}
+	if p.Cost.IsNegative() || p.Price.IsNegative() || p.MinStock.IsNegative() {
+		return ErrInvalidPart
+	}
+	if !p.Cost.Equal(p.Cost.Round(PartPricePlaces)) || !p.Price.Equal(p.Price.Round(PartPricePlaces)) ||
+		!p.MinStock.Equal(p.MinStock.Round(PartQuantityPlaces)) {
+		return ErrInvalidPart
+	}
+	return nil
+}
+
+func IsValidPartUnit(unit string) bool {
+	for _, u := range PartUnits {
+		if u == unit {
+			return true
+		}
+	}
+	return false
+}
+
+// StockLocation é um almoxarifado ou o veículo de um técnico (MemberID).
+// Cada técnico tem no máximo um veículo.
+type StockLocation struct {
+	ID         uuid.UUID `json:"id"`
+	Kind       string    `json:"kind"`
+	Name       string    `json:"name"`
+	MemberID   uuid.UUID `json:"member_id"`
+	MemberName string    `json:"member_name"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (l *StockLocation) Normalize() {
+	l.Name = strings.TrimSpace(l.Name)
+}
+
+func (l *StockLocation) Validate() error {
+	if l.Name == "" || utf8.RuneCountInString(l.Name) > MaxStockLocationNameLength {
+		return ErrInvalidStockLocation
+	}
+	switch l.Kind {
+	case StockLocationWarehouse:
+		if l.MemberID != uuid.Nil {
+			return ErrInvalidStockLocation
+		}
+	case StockLocationVan:
+		if l.MemberID == uuid.Nil {
+			return ErrInvalidStockLocation
+		}
+	default:
+		return ErrInvalidStockLocation
+	}
+	return nil
+}
+
+// StockLevel é o saldo de uma peça em um local.
+type StockLevel struct {
+	LocationID   uuid.UUID       `json:"location_id"`
+	LocationName string          `json:"location_name"`
+	PartID       uuid.UUID       `json:"part_id"`
+	SKU          string          `json:"sku"`
+	PartName     string          `json:"part_name"`
+	Unit         string          `json:"unit"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// StockLevelFilter filtra os saldos por local e por peça; campos vazios não
+// filtram.
+type StockLevelFilter struct {
+	LocationID uuid.UUID
+	PartID     uuid.UUID
+}
+
+// StockMovement é uma alteração do saldo de uma peça em um local. Quantity
+// tem sinal: positiva para entradas, negativa para saídas. As duas pernas de
+// uma transferência compartilham TransferID.
+type StockMovement struct {
+	ID           uuid.UUID       `json:"id"`
+	PartID       uuid.UUID       `json:"part_id"`
+	SKU          string          `json:"sku"`
+	PartName     string          `json:"part_name"`
+	LocationID   uuid.UUID       `json:"location_id"`
+	LocationName string          `json:"location_name"`
+	Kind         string          `json:"kind"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	FormID       uuid.UUID       `json:"form_id"`
+	FormPartID   uuid.UUID       `json:"form_part_id"`
+	TransferID   uuid.UUID       `json:"transfer_id"`
+	Notes        string          `json:"notes"`
+	CreatedBy    uuid.UUID       `json:"created_by"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+// StockMovementFilter seleciona as movimentações mais recentes, até Limit.
+type StockMovementFilter struct {
+	PartID     uuid.UUID
+	LocationID uuid.UUID
+	FormID     uuid.UUID
+	Limit      int
+}
+
+// StockMovementRequest é um lançamento manual de estoque. Quantity é sempre
+// positiva, exceto nos ajustes, em que o sinal indica o sentido; Destination
+// é o local de destino das transferências.
+type StockMovementRequest struct {
+	PartID      uuid.UUID
+	LocationID  uuid.UUID
+	Destination uuid.UUID
+	Kind        string
+	Quantity    decimal.Decimal
+	Notes       string
+	CreatedBy   uuid.UUID
+}
+
+// Movements converte o lançamento nas movimentações com sinal: uma para
+// entradas, saídas e ajustes e duas, com o mesmo TransferID, para
+// transferências.
+func (r StockMovementRequest) Movements() ([]*StockMovement, error) {
+	notes := strings.TrimSpace(r.Notes)
+	if r.PartID == uuid.Nil || r.LocationID == uuid.Nil || utf8.RuneCountInString(notes) > MaxStockMovementNotes {
+		return nil, ErrInvalidStockMovement
+	}
+	if r.Quantity.IsZero() || !r.Quantity.Equal(r.Quantity.Round(PartQuantityPlaces)) {
+		return nil, ErrInvalidStockMovement
+	}
+	if r.Kind != StockMovementAdjust && r.Quantity.IsNegative() {
+		return nil, ErrInvalidStockMovement
+	}
+	if (r.Kind == StockMovementTransfer) != (r.Destination != uuid.Nil) || r.Destination == r.LocationID {
+		return nil, ErrInvalidStockMovement
+	}
+
+	movement := func(location uuid.UUID, quantity decimal.Decimal) *StockMovement {
+		return &StockMovement{
+			PartID:     r.PartID,
+			LocationID: location,
+			Kind:       r.Kind,
+			Quantity:   quantity,
+			Notes:      notes,
+			CreatedBy:  r.CreatedBy,
+		}
+	}
+	switch r.Kind {
+	case StockMovementIn, StockMovementAdjust:
+		return []*StockMovement{movement(r.LocationID, r.Quantity)}, nil
+	case StockMovementOut:
+		return []*StockMovement{movement(r.LocationID, r.Quantity.Neg())}, nil
+	case StockMovementTransfer:
+		transferID := uuid.New()
+		out, in := movement(r.LocationID, r.Quantity.Neg()), movement(r.Destination, r.Quantity)
+		out.TransferID, in.TransferID = transferID, transferID
+		return []*StockMovement{out, in}, nil
+	}
+	return nil, ErrInvalidStockMovement
+}
+
+// LowStockItem é uma peça ativa com saldo total igual ou abaixo do mínimo.
+type LowStockItem struct {
+	PartID   uuid.UUID       `json:"part_id"`
+	SKU      string          `json:"sku"`
+	Name     string          `json:"name"`
+	Unit     string          `json:"unit"`
+	MinStock decimal.Decimal `json:"min_stock"`
+	Quantity decimal.Decimal `json:"quantity"`
+}
+
+// Shortage é quanto falta para o saldo voltar ao mínimo.
+func (i LowStockItem) Shortage() decimal.Decimal {
+	return decimal.Max(i.MinStock.Sub(i.Quantity), decimal.Zero)
+}
+
+// FormPart é uma peça usada em um atendimento, retirada de um local de
+// estoque. UnitPrice é o preço cobrado e UnitCost o custo da peça no
+// lançamento.
+type FormPart struct {
+	ID           uuid.UUID       `json:"id"`
+	FormID       uuid.UUID       `json:"form_id"`
+	PartID       uuid.UUID       `json:"part_id"`
+	SKU          string          `json:"sku"`
+	PartName     string          `json:"part_name"`
+	Unit         string          `json:"unit"`
+	LocationID   uuid.UUID       `json:"location_id"`
+	LocationName string          `json:"location_name"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	UnitPrice    decimal.Decimal `json:"unit_price"`
+	UnitCost     decimal.Decimal `json:"unit_cost"`
+	CreatedBy    uuid.UUID       `json:"created_by"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+func (f *FormPart) Validate() error {
+	if f.FormID == uuid.Nil || f.PartID == uuid.Nil || f.LocationID == uuid.Nil {
+		return ErrInvalidFormPart
+	}
+	if !f.Quantity.IsPositive() || !f.Quantity.Equal(f.Quantity.Round(PartQuantityPlaces)) {
+		return ErrInvalidFormPart
+	}
+	if f.UnitPrice.IsNegative() || !f.UnitPrice.Equal(f.UnitPrice.Round(PartPricePlaces)) {
+		return ErrInvalidFormPart
+	}
+	return nil
+}
+
+// Total é o valor cobrado pelo item, arredondado em centavos.
+func (f *FormPart) Total() decimal.Decimal {
+	return f.Quantity.Mul(f.UnitPrice).Round(PartPricePlaces)
+}
+
+// ConsumeMovement é a baixa do estoque pelo lançamento da peça.
+func (f *FormPart) ConsumeMovement() *StockMovement {
+	return &StockMovement{
+		PartID:     f.PartID,
+		LocationID: f.LocationID,
+		Kind:       StockMovementConsume,
+		Quantity:   f.Quantity.Neg(),
+		FormID:     f.FormID,
+		FormPartID: f.ID,
+		CreatedBy:  f.CreatedBy,
+	}
+}
+
+// ReturnMovement devolve a peça ao local de onde saiu quando o lançamento é
+// removido.
+func (f *FormPart) ReturnMovement(by uuid.UUID) *StockMovement {
+	return &StockMovement{
+		PartID:     f.PartID,
+		LocationID: f.LocationID,
+		Kind:       StockMovementReturn,
+		Quantity:   f.Quantity,
+		FormID:     f.FormID,
+		FormPartID: f.ID,
+		CreatedBy:  by,
+	}
+}
+
+// SumFormParts soma o valor cobrado e o custo das peças de um atendimento.
+func SumFormParts(parts []*FormPart) (total, cost decimal.Decimal) {
+	total, cost = decimal.Zero, decimal.Zero
+	for _, p := range parts {
+		total = total.Add(p.Total())
+		cost = cost.Add(p.Quantity.Mul(p.UnitCost).Round(PartPricePlaces))
+	}
+	return total, cost
+}
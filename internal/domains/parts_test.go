@@ -0,0 +1,135 @@
+package domains
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPart_NormalizeAndValidate(t *testing.T) {
+	p := Part{SKU: " cap-10uf ", Name: " Capacitor 10uF ", Unit: "UN", Cost: decimal.RequireFromString("1.20"), Price: decimal.RequireFromString("3.50")}
+	p.Normalize()
+	assert.Equal(t, "CAP-10UF", p.SKU)
+	assert.Equal(t, "Capacitor 10uF", p.Name)
+	assert.NoError(t, p.Validate())
+
+	tests := []struct {
+		name   string
+		mutate func(*Part)
+		want   error
+	}{
+		{"missing sku", func(p *Part) { p.SKU = "" }, ErrInvalidPart},
+		{"sku with spaces", func(p *Part) { p.SKU = "CAP 10" }, ErrInvalidPart},
+		{"missing name", func(p *Part) { p.Name = "" }, ErrInvalidPart},
+		{"unknown unit", func(p *Part) { p.Unit = "ton" }, ErrInvalidPartUnit},
+		{"negative price", func(p *Part) { p.Price = decimal.NewFromInt(-1) }, ErrInvalidPart},
+		{"fractional cents", func(p *Part) { p.Cost = decimal.RequireFromString("1.001") }, ErrInvalidPart},
+		{"negative minimum", func(p *Part) { p.MinStock = decimal.NewFromInt(-1) }, ErrInvalidPart},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			part := p
+			tt.mutate(&part)
+			assert.ErrorIs(t, part.Validate(), tt.want)
+		})
+	}
+}
+
+func TestStockLocation_Validate(t *testing.T) {
+	assert.NoError(t, (&StockLocation{Kind: StockLocationWarehouse, Name: "Central"}).Validate())
+	assert.NoError(t, (&StockLocation{Kind: StockLocationVan, Name: "Van do João", MemberID: uuid.New()}).Validate())
+
+	assert.ErrorIs(t, (&StockLocation{Kind: StockLocationVan, Name: "Van"}).Validate(), ErrInvalidStockLocation)
+	assert.ErrorIs(t, (&StockLocation{Kind: StockLocationWarehouse, Name: "Central", MemberID: uuid.New()}).Validate(), ErrInvalidStockLocation)
+	assert.ErrorIs(t, (&StockLocation{Kind: "loja", Name: "Loja"}).Validate(), ErrInvalidStockLocation)
+	assert.ErrorIs(t, (&StockLocation{Kind: StockLocationWarehouse}).Validate(), ErrInvalidStockLocation)
+}
+
+func TestStockMovementRequest_Movements(t *testing.T) {
+	partID, from, to := uuid.New(), uuid.New(), uuid.New()
+	qty := decimal.RequireFromString("2.5")
+	request := func(kind string, quantity decimal.Decimal, destination uuid.UUID) StockMovementRequest {
+		return StockMovementRequest{PartID: partID, LocationID: from, Destination: destination, Kind: kind, Quantity: quantity}
+	}
+
+	in, err := request(StockMovementIn, qty, uuid.Nil).Movements()
+	assert.NoError(t, err)
+	assert.Len(t, in, 1)
+	assert.True(t, in[0].Quantity.Equal(qty))
+
+	out, err := request(StockMovementOut, qty, uuid.Nil).Movements()
+	assert.NoError(t, err)
+	assert.True(t, out[0].Quantity.Equal(qty.Neg()))
+
+	adjust, err := request(StockMovementAdjust, qty.Neg(), uuid.Nil).Movements()
+	assert.NoError(t, err)
+	assert.True(t, adjust[0].Quantity.Equal(qty.Neg()))
+
+	transfer, err := request(StockMovementTransfer, qty, to).Movements()
+	assert.NoError(t, err)
+	if assert.Len(t, transfer, 2) {
+		assert.Equal(t, from, transfer[0].LocationID)
+		assert.True(t, transfer[0].Quantity.Equal(qty.Neg()))
+		assert.Equal(t, to, transfer[1].LocationID)
+		assert.True(t, transfer[1].Quantity.Equal(qty))
+		assert.NotEqual(t, uuid.Nil, transfer[0].TransferID)
+		assert.Equal(t, transfer[0].TransferID, transfer[1].TransferID)
+	}
+
+	invalid := []StockMovementRequest{
+		request(StockMovementIn, decimal.Zero, uuid.Nil),
+		request(StockMovementIn, qty.Neg(), uuid.Nil),
+		request(StockMovementIn, decimal.RequireFromString("0.0001"), uuid.Nil),
+		request(StockMovementIn, qty, to),
+		request(StockMovementTransfer, qty, uuid.Nil),
+		request(StockMovementTransfer, qty, from),
+		request(StockMovementConsume, qty, uuid.Nil),
+	}
+	for _, r := range invalid {
+		_, err := r.Movements()
+		assert.ErrorIs(t, err, ErrInvalidStockMovement, r.Kind)
+	}
+}
+
+func TestFormPart_TotalsAndMovements(t *testing.T) {
+	by := uuid.New()
+	part := &FormPart{
+		ID:         uuid.New(),
+		FormID:     uuid.New(),
+		PartID:     uuid.New(),
+		LocationID: uuid.New(),
+		Quantity:   decimal.RequireFromString("1.5"),
+		UnitPrice:  decimal.RequireFromString("3.33"),
+		UnitCost:   decimal.RequireFromString("1.10"),
+		CreatedBy:  by,
+	}
+	assert.NoError(t, part.Validate())
+	assert.Equal(t, "5", part.Total().String())
+
+	consume := part.ConsumeMovement()
+	assert.Equal(t, StockMovementConsume, consume.Kind)
+	assert.True(t, consume.Quantity.Equal(part.Quantity.Neg()))
+	assert.Equal(t, part.ID, consume.FormPartID)
+
+	back := part.ReturnMovement(by)
+	assert.Equal(t, StockMovementReturn, back.Kind)
+	assert.True(t, back.Quantity.Equal(part.Quantity))
+
+	total, cost := SumFormParts([]*FormPart{part, part})
+	assert.Equal(t, "10", total.String())
+	assert.Equal(t, "3.3", cost.String())
+
+	zero := *part
+	zero.Quantity = decimal.Zero
+	assert.ErrorIs(t, zero.Validate(), ErrInvalidFormPart)
+}
+
+func TestLowStockItem_Shortage(t *testing.T) {
+	item := LowStockItem{MinStock: decimal.NewFromInt(10), Quantity: decimal.NewFromInt(4)}
+	assert.Equal(t, "6", item.Shortage().String())
+
+	item.Quantity = decimal.NewFromInt(12)
+	assert.True(t, item.Shortage().IsZero())
+}
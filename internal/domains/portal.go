@@ -0,0 +1,111 @@
+package domains
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Situações de uma solicitação aberta pelo portal do cliente.
+const (
+	PortalRequestStatusOpen      = "aberta"
+	PortalRequestStatusConverted = "convertida"
+	PortalRequestStatusRejected  = "recusada"
+)
+
+const maxPortalRequestDescription = 5000
+
+// ClientUser é um usuário do portal do cliente. Ele só enxerga os dados do
+// cliente ao qual está vinculado e não tem acesso às rotas da equipe interna.
+type ClientUser struct {
+	ID          uuid.UUID `json:"id"`
+	ClientID    uuid.UUID `json:"client_id"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+	Password    []byte    `json:"-"`
+	LastLoginAt time.Time `json:"last_login_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (u *ClientUser) Validate() error {
+	if u.ClientID == uuid.Nil {
+		return ErrInvalidClienteId
+	}
+	name := strings.TrimSpace(u.Name)
+	if name == "" {
+		return ErrInvalidUserName
+	}
+	if n := utf8.RuneCountInString(name); n < 2 || n > 100 {
+		return ErrInvalidUserNameLength
+	}
+	if !emailRegex.MatchString(u.Email) {
+		return ErrInvalidUserEmail
+	}
+	if len(u.Password) == 0 {
+		return ErrInvalidUserPassword
+	}
+	return nil
+}
+
+// PortalRequest é uma solicitação de atendimento aberta pelo cliente. A equipe
+// a converte em atendimento ou a recusa; depois disso ela não muda mais.
+type PortalRequest struct {
+	ID            uuid.UUID `json:"id"`
+	ClientID      uuid.UUID `json:"client_id"`
+	ClientName    string    `json:"client_name"`
+	ClientUserID  uuid.UUID `json:"client_user_id"`
+	RequesterName string    `json:"requester_name"`
+	Description   string    `json:"description"`
+	Status        string    `json:"status"`
+	FormID        uuid.UUID `json:"form_id"`
+	ReviewNote    string    `json:"review_note"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func (r *PortalRequest) Validate() error {
+	if r.ClientID == uuid.Nil {
+		return ErrInvalidClienteId
+	}
+	if strings.TrimSpace(r.RequesterName) == "" {
+		return ErrInvalidSolicitedBy
+	}
+	description := strings.TrimSpace(r.Description)
+	if description == "" || utf8.RuneCountInString(description) > maxPortalRequestDescription {
+		return ErrInvalidPortalRequestDescription
+	}
+	return nil
+}
+
+// Convert marca a solicitação como convertida no atendimento informado, que
+// precisa pertencer ao mesmo cliente.
+func (r *PortalRequest) Convert(form *Atendimentos, note string) error {
+	if r.Status != PortalRequestStatusOpen {
+		return ErrPortalRequestClosed
+	}
+	if form.Cliente.ID != r.ClientID {
+		return ErrPortalRequestFormMismatch
+	}
+	r.Status = PortalRequestStatusConverted
+	r.FormID = form.ID
+	r.ReviewNote = strings.TrimSpace(note)
+	return nil
+}
+
+// Reject recusa a solicitação. O motivo é obrigatório porque é exibido ao
+// cliente no portal.
+func (r *PortalRequest) Reject(note string) error {
+	if r.Status != PortalRequestStatusOpen {
+		return ErrPortalRequestClosed
+	}
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return ErrInvalidPortalRequestNote
+	}
+	r.Status = PortalRequestStatusRejected
+	r.ReviewNote = note
+	return nil
+}
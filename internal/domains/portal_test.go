@@ -0,0 +1,107 @@
+package domains
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientUser_Validate(t *testing.T) {
+	clientID := uuid.New()
+
+	tests := []struct {
+		name        string
+		user        ClientUser
+		expectedErr error
+	}{
+		{
+			name: "valid client user",
+			user: ClientUser{ClientID: clientID, Name: "Maria Souza", Email: "maria@cliente.com.br", Password: []byte("hash")},
+		},
+		{
+			name:        "invalid - missing client",
+			user:        ClientUser{Name: "Maria Souza", Email: "maria@cliente.com.br", Password: []byte("hash")},
+			expectedErr: ErrInvalidClienteId,
+		},
+		{
+			name:        "invalid - blank name",
+			user:        ClientUser{ClientID: clientID, Name: "  ", Email: "maria@cliente.com.br", Password: []byte("hash")},
+			expectedErr: ErrInvalidUserName,
+		},
+		{
+			name:        "invalid - name too short",
+			user:        ClientUser{ClientID: clientID, Name: "M", Email: "maria@cliente.com.br", Password: []byte("hash")},
+			expectedErr: ErrInvalidUserNameLength,
+		},
+		{
+			name:        "invalid - malformed email",
+			user:        ClientUser{ClientID: clientID, Name: "Maria Souza", Email: "maria@", Password: []byte("hash")},
+			expectedErr: ErrInvalidUserEmail,
+		},
+		{
+			name:        "invalid - missing password",
+			user:        ClientUser{ClientID: clientID, Name: "Maria Souza", Email: "maria@cliente.com.br"},
+			expectedErr: ErrInvalidUserPassword,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.user.Validate()
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPortalRequest_Validate(t *testing.T) {
+	clientID := uuid.New()
+
+	valid := PortalRequest{ClientID: clientID, RequesterName: "Maria Souza", Description: "Impressora não liga"}
+	assert.NoError(t, valid.Validate())
+
+	noClient := valid
+	noClient.ClientID = uuid.Nil
+	assert.ErrorIs(t, noClient.Validate(), ErrInvalidClienteId)
+
+	blank := valid
+	blank.Description = "   "
+	assert.ErrorIs(t, blank.Validate(), ErrInvalidPortalRequestDescription)
+
+	tooLong := valid
+	tooLong.Description = strings.Repeat("a", maxPortalRequestDescription+1)
+	assert.ErrorIs(t, tooLong.Validate(), ErrInvalidPortalRequestDescription)
+}
+
+func TestPortalRequest_Convert(t *testing.T) {
+	clientID := uuid.New()
+	form := &Atendimentos{ID: uuid.New(), Cliente: ClientForm{ID: clientID}}
+
+	r := PortalRequest{ClientID: clientID, Status: PortalRequestStatusOpen}
+	assert.NoError(t, r.Convert(form, " agendado para amanhã "))
+	assert.Equal(t, PortalRequestStatusConverted, r.Status)
+	assert.Equal(t, form.ID, r.FormID)
+	assert.Equal(t, "agendado para amanhã", r.ReviewNote)
+
+	assert.ErrorIs(t, r.Convert(form, ""), ErrPortalRequestClosed)
+
+	other := PortalRequest{ClientID: uuid.New(), Status: PortalRequestStatusOpen}
+	assert.ErrorIs(t, other.Convert(form, ""), ErrPortalRequestFormMismatch)
+	assert.Equal(t, PortalRequestStatusOpen, other.Status)
+}
+
+func TestPortalRequest_Reject(t *testing.T) {
+	r := PortalRequest{ClientID: uuid.New(), Status: PortalRequestStatusOpen}
+	assert.ErrorIs(t, r.Reject("  "), ErrInvalidPortalRequestNote)
+	assert.Equal(t, PortalRequestStatusOpen, r.Status)
+
+	assert.NoError(t, r.Reject("Equipamento fora do escopo do contrato"))
+	assert.Equal(t, PortalRequestStatusRejected, r.Status)
+
+	assert.ErrorIs(t, r.Reject("outro motivo"), ErrPortalRequestClosed)
+}
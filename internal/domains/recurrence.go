@@ -0,0 +1,341 @@
+package domains
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequências de recorrência (RFC 5545).
+const (
+	RecurrenceDaily   = "DAILY"
+	RecurrenceWeekly  = "WEEKLY"
+	RecurrenceMonthly = "MONTHLY"
+	RecurrenceYearly  = "YEARLY"
+)
+
+const (
+	MaxRecurrenceInterval = 1000
+	// maxRecurrencePeriods limita a expansão para que regras que não geram
+	// ocorrências (como dia 31 a cada 12 meses a partir de abril) terminem.
+	maxRecurrencePeriods = 20000
+)
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// RecurrenceDay é um item de BYDAY: o dia da semana e, nas regras mensais, a
+// ordem no mês (1 = primeiro, -1 = último, 0 = todos).
+type RecurrenceDay struct {
+	N   int
+	Day time.Weekday
+}
+
+// Recurrence é um subconjunto de RRULE: FREQ, INTERVAL, BYDAY (semanal e
+// mensal), BYMONTHDAY (mensal), COUNT e UNTIL. A semana começa na
+// segunda-feira.
+type Recurrence struct {
+	Freq       string
+	Interval   int
+	ByDay      []RecurrenceDay
+	ByMonthDay []int
+	Count      int
+	Until      time.Time
+}
+
+// ParseRecurrence interpreta uma regra como "FREQ=MONTHLY;INTERVAL=3;BYDAY=1MO",
+// com ou sem o prefixo "RRULE:".
+func ParseRecurrence(rule string) (*Recurrence, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(strings.ToUpper(rule)), "RRULE:")
+	if rule == "" {
+		return nil, ErrInvalidRecurrence
+	}
+
+	r := &Recurrence{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" || seen[key] {
+			return nil, ErrInvalidRecurrence
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			r.Freq = value
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if r.Interval < 1 || r.Interval > MaxRecurrenceInterval {
+				err = ErrInvalidRecurrence
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if r.Count < 1 {
+				err = ErrInvalidRecurrence
+			}
+		case "UNTIL":
+			r.Until, err = parseRecurrenceUntil(value)
+		case "BYDAY":
+			r.ByDay, err = parseRecurrenceDays(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseRecurrenceMonthDays(value)
+		default:
+			err = ErrInvalidRecurrence
+		}
+		if err != nil {
+			return nil, ErrInvalidRecurrence
+		}
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Recurrence) Validate() error {
+	switch r.Freq {
+	case RecurrenceDaily, RecurrenceYearly:
+		if len(r.ByDay) > 0 || len(r.ByMonthDay) > 0 {
+			return ErrInvalidRecurrence
+		}
+	case RecurrenceWeekly:
+		if len(r.ByMonthDay) > 0 {
+			return ErrInvalidRecurrence
+		}
+		for _, d := range r.ByDay {
+			if d.N != 0 {
+				return ErrInvalidRecurrence
+			}
+		}
+	case RecurrenceMonthly:
+		if len(r.ByDay) > 0 && len(r.ByMonthDay) > 0 {
+			return ErrInvalidRecurrence
+		}
+	default:
+		return ErrInvalidRecurrence
+	}
+	if r.Interval < 1 || r.Interval > MaxRecurrenceInterval {
+		return ErrInvalidRecurrence
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return ErrInvalidRecurrence
+	}
+	return nil
+}
+
+// String devolve a regra na forma canônica.
+func (r *Recurrence) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			prefix := ""
+			if d.N != 0 {
+				prefix = strconv.Itoa(d.N)
+			}
+			days = append(days, prefix+strings.ToUpper(d.Day.String()[:2]))
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, d := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Between devolve as ocorrências em [from, to) de uma série iniciada em start.
+// As ocorrências mantêm o horário de start no fuso loc, inclusive após mudanças
+// de horário de verão; COUNT conta a partir de start, não de from.
+func (r *Recurrence) Between(start time.Time, loc *time.Location, from, to time.Time) []time.Time {
+	start = start.In(loc)
+	var out []time.Time
+	count := 0
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		for _, t := range r.expand(start, period, loc) {
+			if t.Before(start) {
+				continue
+			}
+			if !r.Until.IsZero() && t.After(r.Until) {
+				return out
+			}
+			if !t.Before(to) {
+				return out
+			}
+			count++
+			if r.Count > 0 && count > r.Count {
+				return out
+			}
+			if !t.Before(from) {
+				out = append(out, t.UTC())
+			}
+		}
+	}
+	return out
+}
+
+// expand devolve, em ordem, os candidatos do período de número n da série.
+func (r *Recurrence) expand(start time.Time, n int, loc *time.Location) []time.Time {
+	y, m, d := start.Date()
+	hh, mm, ss := start.Clock()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, hh, mm, ss, 0, loc)
+	}
+	step := n * r.Interval
+
+	switch r.Freq {
+	case RecurrenceDaily:
+		return []time.Time{at(y, m, d+step)}
+
+	case RecurrenceWeekly:
+		if len(r.ByDay) == 0 {
+			return []time.Time{at(y, m, d+7*step)}
+		}
+		// Segunda-feira da semana de start, deslocada em step semanas.
+		monday := d - (int(start.Weekday())+6)%7 + 7*step
+		out := make([]time.Time, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			out = append(out, at(y, m, monday+(int(day.Day)+6)%7))
+		}
+		return sortedUnique(out)
+
+	case RecurrenceMonthly:
+		first := time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, loc)
+		year, month := first.Year(), first.Month()
+		days := daysIn(year, month)
+		var out []time.Time
+		switch {
+		case len(r.ByMonthDay) > 0:
+			for _, md := range r.ByMonthDay {
+				if md < 0 {
+					md = days + md + 1
+				}
+				if md >= 1 && md <= days {
+					out = append(out, at(year, month, md))
+				}
+			}
+		case len(r.ByDay) > 0:
+			for _, day := range r.ByDay {
+				matches := weekdaysInMonth(year, month, day.Day)
+				switch {
+				case day.N == 0:
+					for _, md := range matches {
+						out = append(out, at(year, month, md))
+					}
+				case day.N > 0 && day.N <= len(matches):
+					out = append(out, at(year, month, matches[day.N-1]))
+				case day.N < 0 && -day.N <= len(matches):
+					out = append(out, at(year, month, matches[len(matches)+day.N]))
+				}
+			}
+		default:
+			// Meses sem o dia de start (como 31) não têm ocorrência.
+			if d <= days {
+				out = append(out, at(year, month, d))
+			}
+		}
+		return sortedUnique(out)
+
+	case RecurrenceYearly:
+		year := y + step
+		if d > daysIn(year, m) {
+			return nil
+		}
+		return []time.Time{at(year, m, d)}
+	}
+	return nil
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func weekdaysInMonth(year int, month time.Month, day time.Weekday) []int {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	md := 1 + (int(day)-int(first.Weekday())+7)%7
+	var out []int
+	for ; md <= daysIn(year, month); md += 7 {
+		out = append(out, md)
+	}
+	return out
+}
+
+func sortedUnique(times []time.Time) []time.Time {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	out := times[:0]
+	for i, t := range times {
+		if i == 0 || !t.Equal(times[i-1]) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func parseRecurrenceUntil(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// Uma data sem horário inclui o dia inteiro.
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+func parseRecurrenceDays(value string) ([]RecurrenceDay, error) {
+	var days []RecurrenceDay
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, ErrInvalidRecurrence
+		}
+		day, ok := rruleWeekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, ErrInvalidRecurrence
+		}
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, ErrInvalidRecurrence
+			}
+		}
+		days = append(days, RecurrenceDay{N: n, Day: day})
+	}
+	return days, nil
+}
+
+func parseRecurrenceMonthDays(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		d, err := strconv.Atoi(item)
+		if err != nil || d == 0 || d < -31 || d > 31 {
+			return nil, ErrInvalidRecurrence
+		}
+		days = append(days, d)
+	}
+	return days, nil
+}
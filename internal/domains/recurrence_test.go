@@ -0,0 +1,133 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	r, err := ParseRecurrence("rrule:freq=monthly;interval=3;byday=1mo,-1fr")
+	assert.NoError(t, err)
+	assert.Equal(t, RecurrenceMonthly, r.Freq)
+	assert.Equal(t, 3, r.Interval)
+	assert.Equal(t, []RecurrenceDay{{N: 1, Day: time.Monday}, {N: -1, Day: time.Friday}}, r.ByDay)
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=3;BYDAY=1MO,-1FR", r.String())
+
+	until, err := ParseRecurrence("FREQ=WEEKLY;UNTIL=20261231")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC), until.Until)
+
+	for _, rule := range []string{
+		"",
+		"FREQ=HOURLY",
+		"INTERVAL=2",
+		"FREQ=MONTHLY;FREQ=WEEKLY",
+		"FREQ=MONTHLY;INTERVAL=0",
+		"FREQ=MONTHLY;INTERVAL=abc",
+		"FREQ=MONTHLY;COUNT=0",
+		"FREQ=MONTHLY;COUNT=3;UNTIL=20261231",
+		"FREQ=MONTHLY;BYDAY=MO;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=DAILY;BYDAY=MO",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYSETPOS=1",
+		"FREQ=WEEKLY;UNTIL=amanha",
+	} {
+		_, err := ParseRecurrence(rule)
+		assert.ErrorIs(t, err, ErrInvalidRecurrence, rule)
+	}
+}
+
+func TestRecurrence_Between(t *testing.T) {
+	loc, _ := time.LoadLocation("America/Sao_Paulo")
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 9, 0, 0, 0, loc).UTC()
+	}
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		from  time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "quarterly",
+			rule:  "FREQ=MONTHLY;INTERVAL=3",
+			start: time.Date(2026, 1, 15, 9, 0, 0, 0, loc),
+			want:  []time.Time{date(2026, 1, 15), date(2026, 4, 15), date(2026, 7, 15), date(2026, 10, 15)},
+		},
+		{
+			name:  "first monday every four months",
+			rule:  "FREQ=MONTHLY;INTERVAL=4;BYDAY=1MO",
+			start: time.Date(2026, 1, 1, 9, 0, 0, 0, loc),
+			want:  []time.Time{date(2026, 1, 5), date(2026, 5, 4), date(2026, 9, 7)},
+		},
+		{
+			name:  "last friday every six months",
+			rule:  "FREQ=MONTHLY;INTERVAL=6;BYDAY=-1FR",
+			start: time.Date(2026, 1, 1, 9, 0, 0, 0, loc),
+			want:  []time.Time{date(2026, 1, 30), date(2026, 7, 31)},
+		},
+		{
+			name:  "skips months without day 31",
+			rule:  "FREQ=MONTHLY;INTERVAL=2",
+			start: time.Date(2026, 1, 31, 9, 0, 0, 0, loc),
+			want:  []time.Time{date(2026, 1, 31), date(2026, 3, 31), date(2026, 5, 31), date(2026, 7, 31)},
+		},
+		{
+			name:  "last day of the month",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			start: time.Date(2026, 1, 10, 9, 0, 0, 0, loc),
+			want:  []time.Time{date(2026, 1, 31), date(2026, 2, 28), date(2026, 3, 31)},
+		},
+		{
+			name:  "weekly on monday and wednesday",
+			rule:  "FREQ=WEEKLY;BYDAY=WE,MO;UNTIL=20260114",
+			start: time.Date(2026, 1, 1, 9, 0, 0, 0, loc),
+			want:  []time.Time{date(2026, 1, 5), date(2026, 1, 7), date(2026, 1, 12), date(2026, 1, 14)},
+		},
+		{
+			name:  "count starts at the series start",
+			rule:  "FREQ=MONTHLY;COUNT=4",
+			start: time.Date(2025, 11, 10, 9, 0, 0, 0, loc),
+			want:  []time.Time{date(2026, 1, 10), date(2026, 2, 10)},
+		},
+		{
+			name:  "window after the start",
+			rule:  "FREQ=DAILY;INTERVAL=10",
+			start: time.Date(2026, 1, 1, 9, 0, 0, 0, loc),
+			from:  date(2026, 12, 1),
+			want:  []time.Time{date(2026, 12, 7), date(2026, 12, 17), date(2026, 12, 27)},
+		},
+		{
+			name:  "yearly skips february 29",
+			rule:  "FREQ=YEARLY",
+			start: time.Date(2024, 2, 29, 9, 0, 0, 0, loc),
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRecurrence(tt.rule)
+			assert.NoError(t, err)
+			windowFrom := from
+			if !tt.from.IsZero() {
+				windowFrom = tt.from
+			}
+			assert.Equal(t, tt.want, r.Between(tt.start, loc, windowFrom, to))
+		})
+	}
+
+	t.Run("rules without occurrences terminate", func(t *testing.T) {
+		r, _ := ParseRecurrence("FREQ=MONTHLY;INTERVAL=12;BYMONTHDAY=31")
+		assert.Empty(t, r.Between(time.Date(2026, 4, 1, 9, 0, 0, 0, loc), loc, from, to))
+	})
+}
@@ -0,0 +1,277 @@
+package domains
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultRouteSpeedKmh é a velocidade média usada nas estimativas de
+	// chegada; as distâncias são em linha reta.
+	DefaultRouteSpeedKmh = 40.0
+	MaxRouteSpeedKmh     = 200.0
+	// DefaultRouteStopDuration é o tempo previsto em cada visita.
+	DefaultRouteStopDuration = time.Hour
+	MaxRouteStopDuration     = 12 * time.Hour
+	earthRadiusKm            = 6371.0
+	// twoOptEpsilon evita trocas sem ganho real por erro de arredondamento.
+	twoOptEpsilon = 1e-9
+)
+
+// GeoPoint é uma coordenada geográfica em graus decimais.
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (p GeoPoint) Validate() error {
+	if math.IsNaN(p.Latitude) || math.IsNaN(p.Longitude) ||
+		p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+		return ErrInvalidRouteOptions
+	}
+	return nil
+}
+
+// HaversineKm é a distância em linha reta, sobre a superfície da Terra, entre
+// dois pontos.
+func HaversineKm(a, b GeoPoint) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// RouteStop é um atendimento a visitar. Sem Located, o cliente não tem
+// coordenadas e o atendimento fica fora da rota.
+type RouteStop struct {
+	FormID            uuid.UUID `json:"form_id"`
+	PublicCode        string    `json:"public_code"`
+	ClientID          uuid.UUID `json:"client_id"`
+	ClientName        string    `json:"client_name"`
+	Address           string    `json:"address"`
+	Status            string    `json:"status"`
+	DefectDescription string    `json:"defect_description"`
+	OccurredAt        time.Time `json:"occurred_at"`
+	Location          GeoPoint  `json:"location"`
+	Located           bool      `json:"located"`
+}
+
+// RouteOptions define o ponto de partida (opcional), o horário de saída, a
+// velocidade média e o tempo em cada visita usados no roteiro.
+type RouteOptions struct {
+	Depot        *GeoPoint
+	Departure    time.Time
+	SpeedKmh     float64
+	StopDuration time.Duration
+}
+
+func (o RouteOptions) Validate() error {
+	if o.Depot != nil {
+		if err := o.Depot.Validate(); err != nil {
+			return err
+		}
+	}
+	if o.Departure.IsZero() || o.SpeedKmh <= 0 || o.SpeedKmh > MaxRouteSpeedKmh ||
+		o.StopDuration < 0 || o.StopDuration > MaxRouteStopDuration {
+		return ErrInvalidRouteOptions
+	}
+	return nil
+}
+
+// PlannedStop é uma parada do roteiro. DistanceKm é a distância desde o
+// ponto anterior (a partida ou a parada anterior).
+type PlannedStop struct {
+	RouteStop
+	Sequence     int       `json:"sequence"`
+	DistanceKm   float64   `json:"distance_km"`
+	CumulativeKm float64   `json:"cumulative_km"`
+	Arrival      time.Time `json:"arrival"`
+	Departure    time.Time `json:"departure"`
+}
+
+// RoutePlan é o roteiro do dia: as paradas em ordem de visita e os
+// atendimentos sem coordenadas, que não entram no roteiro.
+type RoutePlan struct {
+	Depot     *GeoPoint     `json:"depot"`
+	Stops     []PlannedStop `json:"stops"`
+	Unlocated []RouteStop   `json:"unlocated"`
+	TotalKm   float64       `json:"total_km"`
+}
+
+// PlanRoute ordena as paradas para reduzir o deslocamento: vizinho mais
+// próximo seguido de 2-opt, em um caminho aberto que começa no depósito
+// quando há um. Sem depósito, o caminho pode começar em qualquer parada e
+// vale o menor entre os obtidos a partir de cada uma.
+func PlanRoute(stops []RouteStop, opts RouteOptions) RoutePlan {
+	plan := RoutePlan{Depot: opts.Depot, Stops: []PlannedStop{}, Unlocated: []RouteStop{}}
+
+	located := make([]RouteStop, 0, len(stops))
+	for _, s := range stops {
+		if s.Located {
+			located = append(located, s)
+		} else {
+			plan.Unlocated = append(plan.Unlocated, s)
+		}
+	}
+	if len(located) == 0 {
+		return plan
+	}
+
+	points := make([]GeoPoint, 0, len(located)+1)
+	if opts.Depot != nil {
+		points = append(points, *opts.Depot)
+	}
+	for _, s := range located {
+		points = append(points, s.Location)
+	}
+	order := optimizeRoute(distanceMatrix(points), opts.Depot != nil)
+
+	cursor := opts.Departure.UTC()
+	var previous *GeoPoint = opts.Depot
+	for i, idx := range order {
+		stop := located[idx]
+		distance := 0.0
+		if previous != nil {
+			distance = HaversineKm(*previous, stop.Location)
+		}
+		plan.TotalKm += distance
+
+		arrival := cursor.Add(time.Duration(distance / opts.SpeedKmh * float64(time.Hour))).Truncate(time.Second)
+		cursor = arrival.Add(opts.StopDuration)
+		plan.Stops = append(plan.Stops, PlannedStop{
+			RouteStop:    stop,
+			Sequence:     i + 1,
+			DistanceKm:   roundKm(distance),
+			CumulativeKm: roundKm(plan.TotalKm),
+			Arrival:      arrival,
+			Departure:    cursor,
+		})
+		previous = &located[idx].Location
+	}
+	plan.TotalKm = roundKm(plan.TotalKm)
+
+	return plan
+}
+
+// LineString devolve as coordenadas do trajeto, do depósito à última parada,
+// no formato [longitude, latitude] do GeoJSON.
+func (p RoutePlan) LineString() [][]float64 {
+	coordinates := make([][]float64, 0, len(p.Stops)+1)
+	if p.Depot != nil {
+		coordinates = append(coordinates, []float64{p.Depot.Longitude, p.Depot.Latitude})
+	}
+	for _, s := range p.Stops {
+		coordinates = append(coordinates, []float64{s.Location.Longitude, s.Location.Latitude})
+	}
+	return coordinates
+}
+
+func distanceMatrix(points []GeoPoint) [][]float64 {
+	matrix := make([][]float64, len(points))
+	for i := range points {
+		matrix[i] = make([]float64, len(points))
+		for j := range points {
+			matrix[i][j] = HaversineKm(points[i], points[j])
+		}
+	}
+	return matrix
+}
+
+// optimizeRoute devolve a ordem das paradas (índices sem o depósito). Com
+// depot, o ponto 0 da matriz é o depósito e fica fixo no início.
+func optimizeRoute(dist [][]float64, depot bool) []int {
+	var best []int
+	bestLength := math.Inf(1)
+	starts := len(dist)
+	if depot {
+		starts = 1
+	}
+	for start := range starts {
+		path := nearestNeighbour(dist, start)
+		twoOpt(dist, path, depot)
+		if length := pathLength(dist, path); length < bestLength-twoOptEpsilon {
+			best, bestLength = path, length
+		}
+	}
+
+	if !depot {
+		return best
+	}
+	order := make([]int, 0, len(best)-1)
+	for _, idx := range best[1:] {
+		order = append(order, idx-1)
+	}
+	return order
+}
+
+func nearestNeighbour(dist [][]float64, start int) []int {
+	visited := make([]bool, len(dist))
+	path := make([]int, 0, len(dist))
+	current := start
+	for {
+		visited[current] = true
+		path = append(path, current)
+		next := -1
+		for j := range dist {
+			if !visited[j] && (next < 0 || dist[current][j] < dist[current][next]) {
+				next = j
+			}
+		}
+		if next < 0 {
+			return path
+		}
+		current = next
+	}
+}
+
+// twoOpt inverte trechos do caminho aberto enquanto isso o encurtar. Com
+// fixedStart, o primeiro ponto não sai do lugar.
+func twoOpt(dist [][]float64, path []int, fixedStart bool) {
+	first := 0
+	if fixedStart {
+		first = 1
+	}
+	edge := func(a, b int) float64 {
+		if a < 0 || b >= len(path) {
+			return 0
+		}
+		return dist[path[a]][path[b]]
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for i := first; i < len(path)-1; i++ {
+			for k := i + 1; k < len(path); k++ {
+				before := edge(i-1, i) + edge(k, k+1)
+				after := 0.0
+				if i > 0 {
+					after += dist[path[i-1]][path[k]]
+				}
+				if k+1 < len(path) {
+					after += dist[path[i]][path[k+1]]
+				}
+				if after < before-twoOptEpsilon {
+					for a, b := i, k; a < b; a, b = a+1, b-1 {
+						path[a], path[b] = path[b], path[a]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+}
+
+func pathLength(dist [][]float64, path []int) float64 {
+	total := 0.0
+	for i := 1; i < len(path); i++ {
+		total += dist[path[i-1]][path[i]]
+	}
+	return total
+}
+
+func roundKm(km float64) float64 {
+	return math.Round(km*100) / 100
+}
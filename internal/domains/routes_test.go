@@ -0,0 +1,134 @@
+package domains
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHaversineKm(t *testing.T) {
+	saoPaulo := GeoPoint{Latitude: -23.5505, Longitude: -46.6333}
+	rio := GeoPoint{Latitude: -22.9068, Longitude: -43.1729}
+
+	assert.InDelta(t, 361, HaversineKm(saoPaulo, rio), 5)
+	assert.InDelta(t, HaversineKm(saoPaulo, rio), HaversineKm(rio, saoPaulo), 1e-9)
+	assert.Zero(t, HaversineKm(rio, rio))
+}
+
+func routeStop(name string, lng float64) RouteStop {
+	return RouteStop{
+		FormID:     uuid.New(),
+		ClientName: name,
+		Location:   GeoPoint{Latitude: 0, Longitude: lng},
+		Located:    true,
+	}
+}
+
+func stopNames(plan RoutePlan) []string {
+	names := make([]string, 0, len(plan.Stops))
+	for _, s := range plan.Stops {
+		names = append(names, s.ClientName)
+	}
+	return names
+}
+
+func TestPlanRoute_WithDepot(t *testing.T) {
+	departure := time.Date(2026, 3, 10, 11, 0, 0, 0, time.UTC)
+	depot := GeoPoint{}
+	stops := []RouteStop{routeStop("c", 0.3), routeStop("a", 0.1), routeStop("b", 0.2)}
+
+	plan := PlanRoute(stops, RouteOptions{
+		Depot:        &depot,
+		Departure:    departure,
+		SpeedKmh:     DefaultRouteSpeedKmh,
+		StopDuration: 30 * time.Minute,
+	})
+
+	assert.Equal(t, []string{"a", "b", "c"}, stopNames(plan))
+	// 0,1 grau de longitude no equador tem cerca de 11,12 km.
+	first := plan.Stops[0]
+	assert.Equal(t, 1, first.Sequence)
+	assert.InDelta(t, 11.12, first.DistanceKm, 0.01)
+	assert.InDelta(t, 33.36, plan.TotalKm, 0.02)
+	assert.Equal(t, plan.TotalKm, plan.Stops[2].CumulativeKm)
+	assert.WithinDuration(t, departure.Add(1001*time.Second), first.Arrival, time.Second)
+	assert.Equal(t, first.Arrival.Add(30*time.Minute), first.Departure)
+	assert.True(t, plan.Stops[1].Arrival.After(first.Departure))
+
+	assert.Equal(t, [][]float64{{0, 0}, {0.1, 0}, {0.2, 0}, {0.3, 0}}, plan.LineString())
+}
+
+func TestPlanRoute_WithoutDepot(t *testing.T) {
+	stops := []RouteStop{
+		routeStop("b", 0.2), routeStop("d", 0.4), routeStop("a", 0.1), routeStop("c", 0.3),
+	}
+	plan := PlanRoute(stops, RouteOptions{Departure: time.Now(), SpeedKmh: DefaultRouteSpeedKmh})
+
+	names := stopNames(plan)
+	if names[0] == "d" {
+		assert.Equal(t, []string{"d", "c", "b", "a"}, names)
+	} else {
+		assert.Equal(t, []string{"a", "b", "c", "d"}, names)
+	}
+	assert.Zero(t, plan.Stops[0].DistanceKm)
+	assert.InDelta(t, 33.36, plan.TotalKm, 0.02)
+	assert.Len(t, plan.LineString(), 4)
+}
+
+func TestPlanRoute_TwoOptImprovesNearestNeighbour(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	depot := GeoPoint{}
+	improved := 0
+	for range 50 {
+		stops := make([]RouteStop, 0, 8)
+		points := []GeoPoint{depot}
+		for range 8 {
+			p := GeoPoint{Latitude: rng.Float64() - 0.5, Longitude: rng.Float64() - 0.5}
+			stops = append(stops, RouteStop{FormID: uuid.New(), Location: p, Located: true})
+			points = append(points, p)
+		}
+		dist := distanceMatrix(points)
+		greedy := roundKm(pathLength(dist, nearestNeighbour(dist, 0)))
+
+		plan := PlanRoute(stops, RouteOptions{Depot: &depot, Departure: time.Now(), SpeedKmh: DefaultRouteSpeedKmh})
+
+		assert.Len(t, plan.Stops, len(stops))
+		assert.LessOrEqual(t, plan.TotalKm, greedy)
+		if plan.TotalKm < greedy {
+			improved++
+		}
+	}
+	assert.Positive(t, improved)
+}
+
+func TestPlanRoute_UnlocatedStops(t *testing.T) {
+	unlocated := RouteStop{FormID: uuid.New(), ClientName: "sem endereço"}
+	plan := PlanRoute([]RouteStop{unlocated, routeStop("a", 0.1)}, RouteOptions{Departure: time.Now(), SpeedKmh: DefaultRouteSpeedKmh})
+
+	assert.Equal(t, []string{"a"}, stopNames(plan))
+	assert.Equal(t, []RouteStop{unlocated}, plan.Unlocated)
+
+	empty := PlanRoute([]RouteStop{unlocated}, RouteOptions{Departure: time.Now(), SpeedKmh: DefaultRouteSpeedKmh})
+	assert.Empty(t, empty.Stops)
+	assert.Empty(t, empty.LineString())
+}
+
+func TestRouteOptions_Validate(t *testing.T) {
+	valid := RouteOptions{Departure: time.Now(), SpeedKmh: DefaultRouteSpeedKmh, StopDuration: DefaultRouteStopDuration}
+	assert.NoError(t, valid.Validate())
+
+	invalidDepot := valid
+	invalidDepot.Depot = &GeoPoint{Latitude: 91}
+	assert.ErrorIs(t, invalidDepot.Validate(), ErrInvalidRouteOptions)
+
+	noSpeed := valid
+	noSpeed.SpeedKmh = 0
+	assert.ErrorIs(t, noSpeed.Validate(), ErrInvalidRouteOptions)
+
+	longStop := valid
+	longStop.StopDuration = MaxRouteStopDuration + time.Minute
+	assert.ErrorIs(t, longStop.Validate(), ErrInvalidRouteOptions)
+}
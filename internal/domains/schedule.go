@@ -0,0 +1,145 @@
+package domains
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxAssignmentDuration limita a duração prevista de uma visita.
+	MaxAssignmentDuration = 24 * time.Hour
+	// MaxCalendarRange limita o período consultado na agenda.
+	MaxCalendarRange = 62 * 24 * time.Hour
+	// FreeSlotSearchRange é até onde a busca por horário livre avança.
+	FreeSlotSearchRange = 60 * 24 * time.Hour
+)
+
+// AssignmentSchedule é o horário previsto da visita de um técnico em um
+// atendimento, de Start até End.
+type AssignmentSchedule struct {
+	FormID   uuid.UUID `json:"form_id"`
+	MemberID uuid.UUID `json:"member_id"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+}
+
+func (s *AssignmentSchedule) Validate() error {
+	if s.FormID == uuid.Nil || s.MemberID == uuid.Nil || s.Start.IsZero() {
+		return ErrInvalidSchedule
+	}
+	if !s.End.After(s.Start) || s.End.Sub(s.Start) > MaxAssignmentDuration {
+		return ErrInvalidSchedule
+	}
+	return nil
+}
+
+// Overlaps informa se os dois horários são do mesmo técnico, em atendimentos
+// diferentes, e ocupam parte do mesmo período. Um horário que termina quando
+// o outro começa não conflita.
+func (s *AssignmentSchedule) Overlaps(other *AssignmentSchedule) bool {
+	return s.MemberID == other.MemberID && s.FormID != other.FormID &&
+		s.Start.Before(other.End) && other.Start.Before(s.End)
+}
+
+// ValidateSchedules valida a agenda de um atendimento: cada técnico aparece
+// no máximo uma vez.
+func ValidateSchedules(schedules []AssignmentSchedule) error {
+	seen := make(map[uuid.UUID]bool, len(schedules))
+	for i := range schedules {
+		if err := schedules[i].Validate(); err != nil {
+			return err
+		}
+		if seen[schedules[i].MemberID] {
+			return ErrInvalidSchedule
+		}
+		seen[schedules[i].MemberID] = true
+	}
+	return nil
+}
+
+// CalendarEntry é uma visita agendada na agenda de um técnico.
+type CalendarEntry struct {
+	AssignmentSchedule
+	PublicCode        string `json:"public_code"`
+	MemberName        string `json:"member_name"`
+	ClientName        string `json:"client_name"`
+	Status            string `json:"status"`
+	DefectDescription string `json:"defect_description"`
+}
+
+// CalendarFilter seleciona as visitas que começam antes de To e terminam
+// depois de From. Sem MemberID, a agenda é a da equipe toda.
+type CalendarFilter struct {
+	MemberID uuid.UUID
+	From     time.Time
+	To       time.Time
+}
+
+func (f CalendarFilter) Validate() error {
+	if f.From.IsZero() || !f.To.After(f.From) || f.To.Sub(f.From) > MaxCalendarRange {
+		return ErrInvalidCalendarRange
+	}
+	return nil
+}
+
+// ScheduleConflicts devolve as visitas de busy que conflitam com algum dos
+// horários em schedules, sem repetição.
+func ScheduleConflicts(schedules []AssignmentSchedule, busy []*CalendarEntry) []*CalendarEntry {
+	conflicts := []*CalendarEntry{}
+	for _, entry := range busy {
+		for i := range schedules {
+			if schedules[i].Overlaps(&entry.AssignmentSchedule) {
+				conflicts = append(conflicts, entry)
+				break
+			}
+		}
+	}
+	return conflicts
+}
+
+// NextFreeSlot devolve o primeiro início, a partir de from, de um horário de
+// duração d que cabe no expediente de um único dia útil sem conflitar com as
+// visitas em busy. A busca vai até FreeSlotSearchRange depois de from.
+func (c *BusinessCalendar) NextFreeSlot(busy []*CalendarEntry, from time.Time, d time.Duration) (time.Time, bool) {
+	if d <= 0 || d > time.Duration(c.hours.End-c.hours.Start)*time.Minute {
+		return time.Time{}, false
+	}
+
+	sorted := make([]*CalendarEntry, len(busy))
+	copy(sorted, busy)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	loc := c.hours.Location
+	limit := from.Add(FreeSlotSearchRange)
+	cursor := from.In(loc)
+	for cursor.Before(limit) {
+		y, m, day := cursor.Date()
+		next := time.Date(y, m, day+1, 0, 0, 0, 0, loc)
+		if !c.isBusinessDay(cursor) {
+			cursor = next
+			continue
+		}
+
+		open := time.Date(y, m, day, 0, c.hours.Start, 0, 0, loc)
+		closeAt := time.Date(y, m, day, 0, c.hours.End, 0, 0, loc)
+		if cursor.Before(open) {
+			cursor = open
+		}
+		for _, entry := range sorted {
+			if !entry.End.After(cursor) || !entry.Start.Before(closeAt) {
+				continue
+			}
+			if entry.Start.Sub(cursor) >= d {
+				break
+			}
+			cursor = entry.End.In(loc)
+		}
+		if !cursor.Add(d).After(closeAt) {
+			return cursor.UTC(), true
+		}
+		cursor = next
+	}
+	return time.Time{}, false
+}
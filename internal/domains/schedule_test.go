@@ -0,0 +1,111 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignmentSchedule_Validate(t *testing.T) {
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	valid := AssignmentSchedule{FormID: uuid.New(), MemberID: uuid.New(), Start: start, End: start.Add(2 * time.Hour)}
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		mutate func(*AssignmentSchedule)
+	}{
+		{"missing technician", func(s *AssignmentSchedule) { s.MemberID = uuid.Nil }},
+		{"missing start", func(s *AssignmentSchedule) { s.Start = time.Time{} }},
+		{"end before start", func(s *AssignmentSchedule) { s.End = s.Start.Add(-time.Minute) }},
+		{"empty period", func(s *AssignmentSchedule) { s.End = s.Start }},
+		{"too long", func(s *AssignmentSchedule) { s.End = s.Start.Add(MaxAssignmentDuration + time.Minute) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid
+			tt.mutate(&s)
+			assert.ErrorIs(t, s.Validate(), ErrInvalidSchedule)
+		})
+	}
+}
+
+func TestValidateSchedules_RejectsRepeatedTechnician(t *testing.T) {
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	formID, memberID := uuid.New(), uuid.New()
+	s := AssignmentSchedule{FormID: formID, MemberID: memberID, Start: start, End: start.Add(time.Hour)}
+
+	assert.NoError(t, ValidateSchedules([]AssignmentSchedule{s}))
+	assert.ErrorIs(t, ValidateSchedules([]AssignmentSchedule{s, s}), ErrInvalidSchedule)
+}
+
+func TestScheduleConflicts(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2026, 3, 10, hour, 0, 0, 0, time.UTC) }
+	formID, memberID := uuid.New(), uuid.New()
+	entry := func(form, member uuid.UUID, start, end int) *CalendarEntry {
+		return &CalendarEntry{AssignmentSchedule: AssignmentSchedule{FormID: form, MemberID: member, Start: at(start), End: at(end)}}
+	}
+
+	overlapping := entry(uuid.New(), memberID, 11, 13)
+	busy := []*CalendarEntry{
+		overlapping,
+		entry(uuid.New(), memberID, 8, 10),    // termina quando a visita começa
+		entry(uuid.New(), memberID, 12, 14),   // começa quando a visita termina
+		entry(formID, memberID, 10, 12),       // o próprio atendimento
+		entry(uuid.New(), uuid.New(), 10, 12), // outro técnico
+	}
+
+	schedules := []AssignmentSchedule{{FormID: formID, MemberID: memberID, Start: at(10), End: at(12)}}
+	assert.Equal(t, []*CalendarEntry{overlapping}, ScheduleConflicts(schedules, busy))
+
+	schedules[0].End = at(11)
+	assert.Empty(t, ScheduleConflicts(schedules, busy))
+}
+
+func TestBusinessCalendar_NextFreeSlot(t *testing.T) {
+	loc, _ := time.LoadLocation("America/Sao_Paulo")
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 3, day, hour, minute, 0, 0, loc)
+	}
+	memberID := uuid.New()
+	visit := func(start, end time.Time) *CalendarEntry {
+		return &CalendarEntry{AssignmentSchedule: AssignmentSchedule{FormID: uuid.New(), MemberID: memberID, Start: start, End: end}}
+	}
+	// 2026-03-13 é sexta-feira.
+	tests := []struct {
+		name     string
+		busy     []*CalendarEntry
+		from     time.Time
+		duration time.Duration
+		want     time.Time
+	}{
+		{"free agenda", nil, at(10, 9, 0), time.Hour, at(10, 9, 0)},
+		{"before opening", nil, at(10, 6, 0), time.Hour, at(10, 8, 0)},
+		{"after a visit", []*CalendarEntry{visit(at(10, 8, 0), at(10, 11, 0))}, at(10, 8, 0), time.Hour, at(10, 11, 0)},
+		{"gap between visits", []*CalendarEntry{
+			visit(at(10, 13, 0), at(10, 15, 0)),
+			visit(at(10, 8, 0), at(10, 10, 0)),
+		}, at(10, 8, 0), 2 * time.Hour, at(10, 10, 0)},
+		{"gap too short and holiday", []*CalendarEntry{
+			visit(at(10, 8, 0), at(10, 10, 0)),
+			visit(at(10, 11, 0), at(10, 17, 0)),
+		}, at(10, 8, 0), 2 * time.Hour, at(12, 8, 0)},
+		{"does not cross closing", nil, at(13, 17, 0), 2 * time.Hour, at(16, 8, 0)},
+		{"skips holiday", nil, at(10, 18, 0), time.Hour, at(12, 8, 0)},
+	}
+	calendar := testCalendar(t, Holiday{Date: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), Name: "Feriado"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := calendar.NextFreeSlot(tt.busy, tt.from, tt.duration)
+			assert.True(t, ok)
+			assert.Equal(t, tt.want.UTC(), got)
+		})
+	}
+
+	t.Run("longer than business day", func(t *testing.T) {
+		_, ok := calendar.NextFreeSlot(nil, at(10, 8, 0), 11*time.Hour)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,129 @@
+package domains
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	// As datas da ordem de serviço saem no fuso da organização, que não
+	// depende da base de fusos do sistema operacional.
+	_ "time/tzdata"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxServiceOrderLogoSize é o tamanho máximo do logotipo (2 MiB).
+	MaxServiceOrderLogoSize = 2 << 20
+	// MaxServiceOrderFooterLength limita o texto do rodapé, em caracteres.
+	MaxServiceOrderFooterLength = 1000
+)
+
+// Cor dos títulos e fuso das datas quando o modelo não define outros.
+const (
+	DefaultServiceOrderColor    = "#1F4E79"
+	DefaultServiceOrderTimezone = "America/Sao_Paulo"
+)
+
+var serviceOrderColorRegex = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+var serviceOrderLogoTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+}
+
+// ServiceOrderTemplate é o modelo da ordem de serviço em PDF da organização:
+// dados do cabeçalho, cor dos títulos, texto do rodapé e logotipo. O
+// logotipo fica no storage sob LogoKey (vazio quando não há logotipo).
+type ServiceOrderTemplate struct {
+	CompanyName     string    `json:"company_name"`
+	CompanyDocument string    `json:"company_document"`
+	Address         string    `json:"address"`
+	Phone           string    `json:"phone"`
+	Email           string    `json:"email"`
+	Website         string    `json:"website"`
+	FooterText      string    `json:"footer_text"`
+	PrimaryColor    string    `json:"primary_color"`
+	Timezone        string    `json:"timezone"`
+	LogoKey         string    `json:"logo_key"`
+	LogoContentType string    `json:"logo_content_type"`
+	UpdatedBy       uuid.UUID `json:"updated_by"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// DefaultServiceOrderTemplate é o modelo usado enquanto a organização não
+// configura o seu.
+func DefaultServiceOrderTemplate() *ServiceOrderTemplate {
+	return &ServiceOrderTemplate{
+		PrimaryColor: DefaultServiceOrderColor,
+		Timezone:     DefaultServiceOrderTimezone,
+	}
+}
+
+func IsAllowedServiceOrderLogoType(contentType string) bool {
+	return serviceOrderLogoTypes[contentType]
+}
+
+// ServiceOrderLogoKey monta a chave do logotipo no storage. Cada envio usa
+// uma chave nova, para que URLs antigas não mostrem o logotipo novo.
+func ServiceOrderLogoKey(id uuid.UUID) string {
+	return "settings/service-order/logo-" + id.String()
+}
+
+func (t *ServiceOrderTemplate) Validate() error {
+	for _, field := range []struct {
+		value string
+		max   int
+	}{
+		{t.CompanyName, 200},
+		{t.CompanyDocument, 30},
+		{t.Address, 300},
+		{t.Phone, 30},
+		{t.Email, 200},
+		{t.Website, 200},
+		{t.FooterText, MaxServiceOrderFooterLength},
+	} {
+		if utf8.RuneCountInString(field.value) > field.max {
+			return ErrInvalidServiceOrderTemplate
+		}
+	}
+	if t.Email != "" && !emailRegex.MatchString(t.Email) {
+		return ErrInvalidServiceOrderTemplate
+	}
+	if !serviceOrderColorRegex.MatchString(t.PrimaryColor) {
+		return ErrInvalidServiceOrderColor
+	}
+	if _, err := time.LoadLocation(t.Timezone); err != nil || t.Timezone == "" {
+		return ErrInvalidServiceOrderTimezone
+	}
+	return nil
+}
+
+// Location devolve o fuso do modelo, ou UTC se o fuso for inválido.
+func (t *ServiceOrderTemplate) Location() *time.Location {
+	loc, err := time.LoadLocation(t.Timezone)
+	if err != nil || t.Timezone == "" {
+		return time.UTC
+	}
+	return loc
+}
+
+// Normalize remove espaços nas pontas dos campos e aplica a cor e o fuso
+// padrão quando não são informados.
+func (t *ServiceOrderTemplate) Normalize() {
+	t.CompanyName = strings.TrimSpace(t.CompanyName)
+	t.CompanyDocument = strings.TrimSpace(t.CompanyDocument)
+	t.Address = strings.TrimSpace(t.Address)
+	t.Phone = strings.TrimSpace(t.Phone)
+	t.Email = strings.TrimSpace(t.Email)
+	t.Website = strings.TrimSpace(t.Website)
+	t.FooterText = strings.TrimSpace(t.FooterText)
+	t.PrimaryColor = strings.ToUpper(strings.TrimSpace(t.PrimaryColor))
+	if t.PrimaryColor == "" {
+		t.PrimaryColor = DefaultServiceOrderColor
+	}
+	t.Timezone = strings.TrimSpace(t.Timezone)
+	if t.Timezone == "" {
+		t.Timezone = DefaultServiceOrderTimezone
+	}
+}
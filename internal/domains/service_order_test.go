@@ -0,0 +1,66 @@
+package domains
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceOrderTemplate_Normalize(t *testing.T) {
+	tmpl := &ServiceOrderTemplate{CompanyName: "  Olidesk  ", PrimaryColor: " #1f4e79 "}
+	tmpl.Normalize()
+	assert.Equal(t, "Olidesk", tmpl.CompanyName)
+	assert.Equal(t, "#1F4E79", tmpl.PrimaryColor)
+	assert.Equal(t, DefaultServiceOrderTimezone, tmpl.Timezone)
+
+	empty := &ServiceOrderTemplate{}
+	empty.Normalize()
+	assert.Equal(t, DefaultServiceOrderColor, empty.PrimaryColor)
+	assert.NoError(t, empty.Validate())
+}
+
+func TestServiceOrderTemplate_Validate(t *testing.T) {
+	assert.NoError(t, DefaultServiceOrderTemplate().Validate())
+
+	tests := []struct {
+		name   string
+		change func(*ServiceOrderTemplate)
+		err    error
+	}{
+		{"long name", func(t *ServiceOrderTemplate) { t.CompanyName = strings.Repeat("a", 201) }, ErrInvalidServiceOrderTemplate},
+		{"long footer", func(t *ServiceOrderTemplate) { t.FooterText = strings.Repeat("é", MaxServiceOrderFooterLength+1) }, ErrInvalidServiceOrderTemplate},
+		{"email", func(t *ServiceOrderTemplate) { t.Email = "contato" }, ErrInvalidServiceOrderTemplate},
+		{"color", func(t *ServiceOrderTemplate) { t.PrimaryColor = "blue" }, ErrInvalidServiceOrderColor},
+		{"short color", func(t *ServiceOrderTemplate) { t.PrimaryColor = "#FFF" }, ErrInvalidServiceOrderColor},
+		{"timezone", func(t *ServiceOrderTemplate) { t.Timezone = "Marte/Olympus" }, ErrInvalidServiceOrderTimezone},
+		{"empty timezone", func(t *ServiceOrderTemplate) { t.Timezone = "" }, ErrInvalidServiceOrderTimezone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := DefaultServiceOrderTemplate()
+			tt.change(tmpl)
+			assert.ErrorIs(t, tmpl.Validate(), tt.err)
+		})
+	}
+
+	t.Run("footer counts characters", func(t *testing.T) {
+		tmpl := DefaultServiceOrderTemplate()
+		tmpl.FooterText = strings.Repeat("é", MaxServiceOrderFooterLength)
+		assert.NoError(t, tmpl.Validate())
+	})
+}
+
+func TestServiceOrderTemplate_Location(t *testing.T) {
+	loc := DefaultServiceOrderTemplate().Location()
+	assert.Equal(t, DefaultServiceOrderTimezone, loc.String())
+	assert.Equal(t, time.UTC, (&ServiceOrderTemplate{Timezone: "invalido"}).Location())
+	assert.Equal(t, time.UTC, (&ServiceOrderTemplate{}).Location())
+}
+
+func TestIsAllowedServiceOrderLogoType(t *testing.T) {
+	assert.True(t, IsAllowedServiceOrderLogoType("image/png"))
+	assert.True(t, IsAllowedServiceOrderLogoType("image/jpeg"))
+	assert.False(t, IsAllowedServiceOrderLogoType("image/svg+xml"))
+}
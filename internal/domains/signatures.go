@@ -0,0 +1,307 @@
+package domains
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Formatos aceitos para a assinatura do cliente.
+const (
+	SignatureFormatPNG     = "png"
+	SignatureFormatSVG     = "svg"
+	SignatureFormatStrokes = "tracos"
+)
+
+// Versões do conjunto de campos coberto pelo hash do atendimento. A versão
+// fica gravada com a assinatura para que assinaturas antigas continuem sendo
+// conferidas com os campos da época; a 2 inclui o tipo de formulário e os
+// dados estruturados.
+const (
+	SignatureSnapshotV1 = 1
+	SignatureSnapshotV2 = 2
+
+	// CurrentSignatureSnapshot é a versão usada nas novas assinaturas.
+	CurrentSignatureSnapshot = SignatureSnapshotV2
+)
+
+const (
+	// MaxSignatureSize é o tamanho máximo do arquivo da assinatura (1 MiB).
+	MaxSignatureSize = 1 << 20
+	// MaxSignatureClockSkew é quanto o horário informado pelo dispositivo pode
+	// estar à frente do servidor.
+	MaxSignatureClockSkew = 5 * time.Minute
+	// MaxSignerNameLength e MaxSignerDocumentLength limitam os dados de quem
+	// assina, em caracteres.
+	MaxSignerNameLength     = 200
+	MaxSignerDocumentLength = 30
+)
+
+// svgEventHandler encontra atributos como onload= e onclick=.
+var svgEventHandler = regexp.MustCompile(`\son[a-z]+\s*=`)
+
+var signatureContentTypes = map[string]string{
+	SignatureFormatPNG:     "image/png",
+	SignatureFormatSVG:     "image/svg+xml",
+	SignatureFormatStrokes: "application/json",
+}
+
+// FormSignature é a assinatura do cliente na conclusão de um atendimento. O
+// arquivo (imagem ou traços em JSON) fica no storage sob StorageKey, com
+// Checksum em SHA-256; SnapshotHash é o hash do atendimento no momento da
+// assinatura, calculado com os campos de SnapshotVersion e usado para
+// detectar alterações posteriores.
+type FormSignature struct {
+	ID             uuid.UUID `json:"id"`
+	FormID         uuid.UUID `json:"form_id"`
+	SignerName     string    `json:"signer_name"`
+	SignerDocument string    `json:"signer_document"`
+	SignedAt       time.Time `json:"signed_at"`
+	Format         string    `json:"format"`
+	ContentType    string    `json:"content_type"`
+	Size           int64     `json:"size"`
+	Checksum       string    `json:"checksum"`
+	StorageKey     string    `json:"storage_key"`
+
+	// Posição do técnico no momento da coleta; AccuracyMeters é zero quando o
+	// dispositivo não informa a precisão.
+	Latitude       float64 `json:"latitude"`
+	Longitude      float64 `json:"longitude"`
+	AccuracyMeters float64 `json:"accuracy_meters"`
+
+	SnapshotHash    string    `json:"snapshot_hash"`
+	SnapshotVersion int       `json:"snapshot_version"`
+	CapturedBy      uuid.UUID `json:"captured_by"`
+	CapturedByName  string    `json:"captured_by_name"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// SignaturePoint é um ponto de um traço, em coordenadas da área de assinatura.
+// T é o instante do ponto em milissegundos desde o início do traço, quando o
+// dispositivo informa.
+type SignaturePoint struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	T int64   `json:"t,omitempty"`
+}
+
+// SignatureStroke é um traço contínuo, do toque até soltar.
+type SignatureStroke []SignaturePoint
+
+func IsValidSignatureFormat(format string) bool {
+	_, ok := signatureContentTypes[format]
+	return ok
+}
+
+// SignatureContentType devolve o tipo de conteúdo gravado para o formato.
+func SignatureContentType(format string) string {
+	return signatureContentTypes[format]
+}
+
+// SignatureStorageKey monta a chave do arquivo da assinatura no storage.
+func SignatureStorageKey(formID, signatureID uuid.UUID) string {
+	return "forms/" + formID.String() + "/signatures/" + signatureID.String()
+}
+
+// CheckEditable recusa alterações em atendimentos assinados, exceto por
+// administradores.
+func (a *Atendimentos) CheckEditable(admin bool) error {
+	if a.Signed && !admin {
+		return ErrFormSigned
+	}
+	return nil
+}
+
+// CanBeSigned informa se o atendimento está concluído e ainda não foi
+// assinado.
+func (a *Atendimentos) CanBeSigned() error {
+	if a.Signed {
+		return ErrFormAlreadySigned
+	}
+	if a.Status != FormStatusResolved && a.Status != FormStatusClosed {
+		return ErrFormNotCompleted
+	}
+	return nil
+}
+
+// formSnapshot reúne os campos do atendimento cobertos pela assinatura na
+// versão 1. A situação fica de fora para que o atendimento assinado possa ser
+// fechado, e os técnicos são ordenados para que o hash não dependa da ordem
+// do banco.
+type formSnapshot struct {
+	ID                  uuid.UUID      `json:"id"`
+	OccurredAt          string         `json:"occurred_at"`
+	ClientID            uuid.UUID      `json:"client_id"`
+	Technicians         []string       `json:"technicians"`
+	SolicitedBy         string         `json:"solicited_by"`
+	DifficultyLevel     string         `json:"difficulty_level"`
+	DefectDescription   string         `json:"defect_description"`
+	SolutionDescription string         `json:"solution_description"`
+	ContractID          uuid.UUID      `json:"contract_id"`
+	HoursConsumed       string         `json:"hours_consumed"`
+	CustomFields        map[string]any `json:"custom_fields"`
+	Tags                []string       `json:"tags"`
+}
+
+// formSnapshotV2 acrescenta à versão 1 o tipo de formulário, a versão do
+// esquema e os dados estruturados do atendimento.
+type formSnapshotV2 struct {
+	formSnapshot
+	FormTypeID      uuid.UUID      `json:"form_type_id"`
+	FormTypeVersion int            `json:"form_type_version"`
+	FormData        map[string]any `json:"form_data"`
+}
+
+// SnapshotHash calcula o SHA-256, em hexadecimal, do conteúdo do atendimento
+// coberto pela assinatura na versão informada. Qualquer alteração nesses
+// campos muda o hash.
+func (a *Atendimentos) SnapshotHash(version int) (string, error) {
+	technicians := make([]string, 0, len(a.TecnicoResponsavelId))
+	for _, m := range a.TecnicoResponsavelId {
+		technicians = append(technicians, m.ID.String())
+	}
+	sort.Strings(technicians)
+
+	tags := append([]string{}, a.Tags...)
+	sort.Strings(tags)
+
+	customFields := map[string]any(a.CustomFields)
+	if customFields == nil {
+		customFields = map[string]any{}
+	}
+
+	v1 := formSnapshot{
+		ID:                  a.ID,
+		OccurredAt:          a.DataDeAbertura.UTC().Format(time.RFC3339Nano),
+		ClientID:            a.Cliente.ID,
+		Technicians:         technicians,
+		SolicitedBy:         a.SolicitedBy,
+		DifficultyLevel:     a.DifficultyLevel,
+		DefectDescription:   a.DefectDescription,
+		SolutionDescription: a.SolutionDescription,
+		ContractID:          a.ContractID,
+		HoursConsumed:       a.HoursConsumed.String(),
+		CustomFields:        customFields,
+		Tags:                tags,
+	}
+
+	var snapshot any
+	switch version {
+	case SignatureSnapshotV1:
+		snapshot = v1
+	case SignatureSnapshotV2:
+		formData := map[string]any(a.FormData)
+		if formData == nil {
+			formData = map[string]any{}
+		}
+		snapshot = formSnapshotV2{
+			formSnapshot:    v1,
+			FormTypeID:      a.FormTypeID,
+			FormTypeVersion: a.FormTypeVersion,
+			FormData:        formData,
+		}
+	default:
+		return "", ErrInvalidSnapshotVersion
+	}
+
+	// encoding/json ordena as chaves dos mapas, então a serialização é estável.
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(snapshot); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ValidateSignatureStrokes exige ao menos um traço, nenhum traço vazio e
+// coordenadas finitas e não negativas.
+func ValidateSignatureStrokes(strokes []SignatureStroke) error {
+	if len(strokes) == 0 {
+		return ErrInvalidSignatureContent
+	}
+	for _, stroke := range strokes {
+		if len(stroke) == 0 {
+			return ErrInvalidSignatureContent
+		}
+		for _, p := range stroke {
+			if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) || p.X < 0 || p.Y < 0 || p.T < 0 {
+				return ErrInvalidSignatureContent
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateSignatureImage confere se o conteúdo corresponde ao formato. SVGs
+// com scripts, manipuladores de eventos ou referências externas são
+// recusados, pois a imagem é aberta direto no navegador.
+func ValidateSignatureImage(format string, content []byte) error {
+	switch format {
+	case SignatureFormatPNG:
+		if !bytes.HasPrefix(content, []byte("\x89PNG\r\n\x1a\n")) {
+			return ErrInvalidSignatureContent
+		}
+	case SignatureFormatSVG:
+		if !utf8.Valid(content) {
+			return ErrInvalidSignatureContent
+		}
+		svg := strings.ToLower(string(content))
+		if !strings.Contains(svg, "<svg") {
+			return ErrInvalidSignatureContent
+		}
+		for _, unsafe := range []string{"<script", "javascript:", "<foreignobject", "<!entity", "href=\"http", "href='http"} {
+			if strings.Contains(svg, unsafe) {
+				return ErrInvalidSignatureContent
+			}
+		}
+		if svgEventHandler.MatchString(svg) {
+			return ErrInvalidSignatureContent
+		}
+	default:
+		return ErrInvalidSignatureFormat
+	}
+	return nil
+}
+
+func (s *FormSignature) Validate(now time.Time) error {
+	if !IsValidSignatureFormat(s.Format) {
+		return ErrInvalidSignatureFormat
+	}
+	name := strings.TrimSpace(s.SignerName)
+	if name == "" || utf8.RuneCountInString(name) > MaxSignerNameLength {
+		return ErrInvalidSignerName
+	}
+	document := strings.TrimSpace(s.SignerDocument)
+	if document == "" || utf8.RuneCountInString(document) > MaxSignerDocumentLength {
+		return ErrInvalidSignerDocument
+	}
+	if s.SignedAt.IsZero() || s.SignedAt.After(now.Add(MaxSignatureClockSkew)) {
+		return ErrInvalidSignatureTime
+	}
+	if s.Latitude < -90 || s.Latitude > 90 || s.Longitude < -180 || s.Longitude > 180 ||
+		math.IsNaN(s.Latitude) || math.IsNaN(s.Longitude) || s.AccuracyMeters < 0 {
+		return ErrInvalidSignatureLocation
+	}
+	if s.Size <= 0 || s.Size > MaxSignatureSize {
+		return ErrInvalidSignatureContent
+	}
+	if len(s.Checksum) != 64 || len(s.SnapshotHash) != 64 {
+		return ErrInvalidSignatureContent
+	}
+	if s.SnapshotVersion != SignatureSnapshotV1 && s.SnapshotVersion != SignatureSnapshotV2 {
+		return ErrInvalidSnapshotVersion
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package domains
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func signedTestForm() *Atendimentos {
+	return &Atendimentos{
+		ID:             uuid.MustParse("0191c2b4-6a8e-7c3e-9d4a-1b2c3d4e5f60"),
+		DataDeAbertura: time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC),
+		TecnicoResponsavelId: []Member{
+			{ID: uuid.MustParse("0191c2b4-6a8e-7c3e-9d4a-000000000002")},
+			{ID: uuid.MustParse("0191c2b4-6a8e-7c3e-9d4a-000000000001")},
+		},
+		Cliente:             ClientForm{ID: uuid.MustParse("0191c2b4-6a8e-7c3e-9d4a-0000000000c1")},
+		SolicitedBy:         "Maria",
+		DifficultyLevel:     "medium",
+		DefectDescription:   "Impressora sem rede",
+		SolutionDescription: "Cabo trocado",
+		Status:              FormStatusResolved,
+		HoursConsumed:       decimal.RequireFromString("1.5"),
+		CustomFields:        CustomFields{"patrimonio": "A-12", "andar": float64(3)},
+		Tags:                []string{"rede", "impressora"},
+		FormTypeID:          uuid.MustParse("0191c2b4-6a8e-7c3e-9d4a-0000000000f1"),
+		FormTypeVersion:     2,
+		FormData:            FormData{"leitura_inicial": float64(1200), "lacre": "L-99"},
+	}
+}
+
+func TestAtendimentos_SnapshotHash(t *testing.T) {
+	base, err := signedTestForm().SnapshotHash(CurrentSignatureSnapshot)
+	assert.NoError(t, err)
+	assert.Len(t, base, 64)
+
+	t.Run("ignores ordering and status", func(t *testing.T) {
+		form := signedTestForm()
+		form.TecnicoResponsavelId[0], form.TecnicoResponsavelId[1] = form.TecnicoResponsavelId[1], form.TecnicoResponsavelId[0]
+		form.Tags = []string{"impressora", "rede"}
+		form.Status = FormStatusClosed
+		form.UpdatedAt = time.Now()
+		form.TecnicoResponsavelId[0].Name = "Outro nome"
+
+		hash, err := form.SnapshotHash(CurrentSignatureSnapshot)
+		assert.NoError(t, err)
+		assert.Equal(t, base, hash)
+	})
+
+	changes := map[string]func(*Atendimentos){
+		"solution":     func(a *Atendimentos) { a.SolutionDescription = "Cabo trocado." },
+		"hours":        func(a *Atendimentos) { a.HoursConsumed = decimal.RequireFromString("2") },
+		"custom field": func(a *Atendimentos) { a.CustomFields["andar"] = float64(4) },
+		"technicians":  func(a *Atendimentos) { a.TecnicoResponsavelId = a.TecnicoResponsavelId[:1] },
+		"occurred at":  func(a *Atendimentos) { a.DataDeAbertura = a.DataDeAbertura.Add(time.Minute) },
+		"tags":         func(a *Atendimentos) { a.Tags = append(a.Tags, "urgente") },
+		"defect":       func(a *Atendimentos) { a.DefectDescription = "" },
+		"client":       func(a *Atendimentos) { a.Cliente.ID = uuid.New() },
+		"solicited by": func(a *Atendimentos) { a.SolicitedBy = "João" },
+		"difficulty":   func(a *Atendimentos) { a.DifficultyLevel = "high" },
+		"contract":     func(a *Atendimentos) { a.ContractID = uuid.New() },
+		"empty fields": func(a *Atendimentos) { a.CustomFields = nil },
+		"form data":    func(a *Atendimentos) { a.FormData["leitura_inicial"] = float64(1250) },
+		"form type":    func(a *Atendimentos) { a.FormTypeID = uuid.New() },
+		"form version": func(a *Atendimentos) { a.FormTypeVersion = 3 },
+	}
+	for name, change := range changes {
+		t.Run("detects "+name, func(t *testing.T) {
+			form := signedTestForm()
+			change(form)
+			hash, err := form.SnapshotHash(CurrentSignatureSnapshot)
+			assert.NoError(t, err)
+			assert.NotEqual(t, base, hash)
+		})
+	}
+}
+
+func TestAtendimentos_SnapshotHashVersions(t *testing.T) {
+	v1, err := signedTestForm().SnapshotHash(SignatureSnapshotV1)
+	assert.NoError(t, err)
+	v2, err := signedTestForm().SnapshotHash(SignatureSnapshotV2)
+	assert.NoError(t, err)
+	assert.NotEqual(t, v1, v2)
+
+	t.Run("form data change after signing invalidates the signature", func(t *testing.T) {
+		form := signedTestForm()
+		signature := &FormSignature{SnapshotHash: v2, SnapshotVersion: SignatureSnapshotV2}
+		form.FormData["lacre"] = "L-100"
+
+		hash, err := form.SnapshotHash(signature.SnapshotVersion)
+		assert.NoError(t, err)
+		assert.NotEqual(t, signature.SnapshotHash, hash)
+	})
+
+	t.Run("version 1 keeps the original field set", func(t *testing.T) {
+		form := signedTestForm()
+		form.FormData = nil
+		form.FormTypeID = uuid.Nil
+		form.FormTypeVersion = 0
+
+		hash, err := form.SnapshotHash(SignatureSnapshotV1)
+		assert.NoError(t, err)
+		assert.Equal(t, v1, hash)
+		// Hash calculado antes da versão 2; assinaturas antigas continuam válidas.
+		assert.Equal(t, "40b56d900f5179711f12bfa7a844b42b81a4a31c918cc93657e0643c2bdad8c2", hash)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := signedTestForm().SnapshotHash(0)
+		assert.ErrorIs(t, err, ErrInvalidSnapshotVersion)
+	})
+}
+
+func TestAtendimentos_CanBeSigned(t *testing.T) {
+	tests := []struct {
+		status string
+		signed bool
+		err    error
+	}{
+		{FormStatusResolved, false, nil},
+		{FormStatusClosed, false, nil},
+		{FormStatusInProgress, false, ErrFormNotCompleted},
+		{FormStatusCanceled, false, ErrFormNotCompleted},
+		{FormStatusResolved, true, ErrFormAlreadySigned},
+	}
+	for _, tt := range tests {
+		form := &Atendimentos{Status: tt.status, Signed: tt.signed}
+		assert.ErrorIs(t, form.CanBeSigned(), tt.err, tt.status)
+	}
+}
+
+func TestAtendimentos_CheckEditable(t *testing.T) {
+	assert.NoError(t, (&Atendimentos{}).CheckEditable(false))
+	assert.NoError(t, (&Atendimentos{Signed: true}).CheckEditable(true))
+	assert.ErrorIs(t, (&Atendimentos{Signed: true}).CheckEditable(false), ErrFormSigned)
+}
+
+func TestValidateSignatureImage(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 40"><path d="M1 1 L20 30" stroke="black"/></svg>`
+
+	tests := []struct {
+		name    string
+		format  string
+		content string
+		err     error
+	}{
+		{"png", SignatureFormatPNG, string(png), nil},
+		{"png with wrong content", SignatureFormatPNG, svg, ErrInvalidSignatureContent},
+		{"svg", SignatureFormatSVG, svg, nil},
+		{"svg with xml declaration", SignatureFormatSVG, `<?xml version="1.0"?>` + svg, nil},
+		{"not svg", SignatureFormatSVG, "hello", ErrInvalidSignatureContent},
+		{"svg with script", SignatureFormatSVG, strings.Replace(svg, "<path", "<script>alert(1)</script><path", 1), ErrInvalidSignatureContent},
+		{"svg with handler", SignatureFormatSVG, strings.Replace(svg, "<path", `<path onLoad ="x()"`, 1), ErrInvalidSignatureContent},
+		{"svg with external image", SignatureFormatSVG, strings.Replace(svg, "<path", `<image href="https://example.com/x.png"/><path`, 1), ErrInvalidSignatureContent},
+		{"strokes are not images", SignatureFormatStrokes, "[]", ErrInvalidSignatureFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, ValidateSignatureImage(tt.format, []byte(tt.content)), tt.err)
+		})
+	}
+}
+
+func TestValidateSignatureStrokes(t *testing.T) {
+	assert.NoError(t, ValidateSignatureStrokes([]SignatureStroke{{{X: 1, Y: 2}, {X: 3, Y: 4, T: 16}}, {{X: 5, Y: 5}}}))
+	assert.ErrorIs(t, ValidateSignatureStrokes(nil), ErrInvalidSignatureContent)
+	assert.ErrorIs(t, ValidateSignatureStrokes([]SignatureStroke{{}}), ErrInvalidSignatureContent)
+	assert.ErrorIs(t, ValidateSignatureStrokes([]SignatureStroke{{{X: -1, Y: 2}}}), ErrInvalidSignatureContent)
+	assert.ErrorIs(t, ValidateSignatureStrokes([]SignatureStroke{{{X: math.NaN(), Y: 2}}}), ErrInvalidSignatureContent)
+}
+
+func TestFormSignature_Validate(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	valid := func() *FormSignature {
+		return &FormSignature{
+			Format:          SignatureFormatPNG,
+			SignerName:      "Maria Souza",
+			SignerDocument:  "123.456.789-09",
+			SignedAt:        now.Add(-time.Minute),
+			Size:            512,
+			Checksum:        strings.Repeat("a", 64),
+			SnapshotHash:    strings.Repeat("b", 64),
+			SnapshotVersion: CurrentSignatureSnapshot,
+			Latitude:        -23.55,
+			Longitude:       -46.63,
+		}
+	}
+
+	assert.NoError(t, valid().Validate(now))
+
+	tests := []struct {
+		name   string
+		change func(*FormSignature)
+		err    error
+	}{
+		{"format", func(s *FormSignature) { s.Format = "jpg" }, ErrInvalidSignatureFormat},
+		{"blank name", func(s *FormSignature) { s.SignerName = "  " }, ErrInvalidSignerName},
+		{"long name", func(s *FormSignature) { s.SignerName = strings.Repeat("a", MaxSignerNameLength+1) }, ErrInvalidSignerName},
+		{"document", func(s *FormSignature) { s.SignerDocument = "" }, ErrInvalidSignerDocument},
+		{"no time", func(s *FormSignature) { s.SignedAt = time.Time{} }, ErrInvalidSignatureTime},
+		{"future", func(s *FormSignature) { s.SignedAt = now.Add(MaxSignatureClockSkew + time.Second) }, ErrInvalidSignatureTime},
+		{"latitude", func(s *FormSignature) { s.Latitude = 91 }, ErrInvalidSignatureLocation},
+		{"longitude", func(s *FormSignature) { s.Longitude = -181 }, ErrInvalidSignatureLocation},
+		{"accuracy", func(s *FormSignature) { s.AccuracyMeters = -1 }, ErrInvalidSignatureLocation},
+		{"empty", func(s *FormSignature) { s.Size = 0 }, ErrInvalidSignatureContent},
+		{"too large", func(s *FormSignature) { s.Size = MaxSignatureSize + 1 }, ErrInvalidSignatureContent},
+		{"snapshot", func(s *FormSignature) { s.SnapshotHash = "" }, ErrInvalidSignatureContent},
+		{"snapshot version", func(s *FormSignature) { s.SnapshotVersion = 0 }, ErrInvalidSnapshotVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid()
+			tt.change(s)
+			assert.ErrorIs(t, s.Validate(now), tt.err)
+		})
+	}
+
+	t.Run("clock skew tolerance", func(t *testing.T) {
+		s := valid()
+		s.SignedAt = now.Add(MaxSignatureClockSkew)
+		assert.NoError(t, s.Validate(now))
+	})
+}
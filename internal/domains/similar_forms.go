@@ -0,0 +1,124 @@
+package domains
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Atendimentos semelhantes são atendimentos resolvidos cujo defeito parece com
+// o informado. O banco mede a semelhança do texto (trigramas, sem acentos) e
+// devolve SimilarFormCandidates candidatos; a pontuação final favorece o mesmo
+// cliente, os atendimentos recentes e os que já ajudaram outros técnicos.
+const (
+	DefaultSimilarFormsLimit = 5
+	MaxSimilarFormsLimit     = 20
+	SimilarFormCandidates    = 50
+
+	similarSameClientBoost = 0.25
+	similarRecencyHalfLife = 365 * 24 * time.Hour
+	similarHelpfulWeight   = 0.15
+	similarMaxHelpfulBoost = 0.5
+)
+
+// similarMaxMultiplier é o maior fator possível, usado para manter Score
+// entre 0 e 1.
+const similarMaxMultiplier = (1 + similarSameClientBoost) * (1 + similarMaxHelpfulBoost)
+
+// SimilarFormsQuery descreve o defeito a comparar. FormID, quando informado, é
+// o atendimento aberto, que fica fora do resultado; ClientID favorece os
+// atendimentos do mesmo cliente.
+type SimilarFormsQuery struct {
+	FormID      uuid.UUID
+	ClientID    uuid.UUID
+	Description string
+	Limit       int
+}
+
+// Validate remove os espaços das pontas da descrição antes de validá-la.
+func (q *SimilarFormsQuery) Validate() error {
+	q.Description = strings.TrimSpace(q.Description)
+	if q.Description == "" {
+		return ErrInvalidDefectDescription
+	}
+	if q.Limit < 1 || q.Limit > MaxSimilarFormsLimit {
+		return ErrInvalidPageSize
+	}
+	return nil
+}
+
+// SimilarFormCandidate é um atendimento resolvido com a semelhança do texto
+// (0 a 1) e quantas vezes foi marcado como útil.
+type SimilarFormCandidate struct {
+	Form         *Atendimentos
+	Similarity   float64
+	HelpfulCount int
+}
+
+// SimilarForm é o candidato com a pontuação final, de 0 a 1.
+type SimilarForm struct {
+	SimilarFormCandidate
+	Score float64
+}
+
+// RankSimilarForms pontua os candidatos e devolve os q.Limit melhores, do
+// mais para o menos relevante.
+func RankSimilarForms(q SimilarFormsQuery, candidates []SimilarFormCandidate, now time.Time) []SimilarForm {
+	ranked := make([]SimilarForm, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Form.ID == q.FormID {
+			continue
+		}
+		ranked = append(ranked, SimilarForm{SimilarFormCandidate: c, Score: c.score(q.ClientID, now)})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].Similarity > ranked[j].Similarity
+	})
+	if len(ranked) > q.Limit {
+		ranked = ranked[:q.Limit]
+	}
+	return ranked
+}
+
+// score multiplica a semelhança do texto pelo bônus do mesmo cliente, pelo
+// fator de recência (cai à metade da diferença a cada ano, sem passar de
+// metade do valor) e pelo bônus das marcações de útil, que cresce devagar.
+func (c SimilarFormCandidate) score(clientID uuid.UUID, now time.Time) float64 {
+	score := c.Similarity
+	if clientID != uuid.Nil && c.Form.Cliente.ID == clientID {
+		score *= 1 + similarSameClientBoost
+	}
+
+	resolvedAt := c.Form.SLA.ResolvedAt
+	if resolvedAt.IsZero() {
+		resolvedAt = c.Form.DataDeAbertura
+	}
+	age := max(now.Sub(resolvedAt), 0)
+	score *= 0.5 + 0.5*math.Exp2(-float64(age)/float64(similarRecencyHalfLife))
+
+	score *= 1 + min(similarHelpfulWeight*math.Log1p(float64(c.HelpfulCount)), similarMaxHelpfulBoost)
+
+	return score / similarMaxMultiplier
+}
+
+// SimilarFormFeedback registra que SimilarFormID ajudou a resolver FormID.
+type SimilarFormFeedback struct {
+	FormID        uuid.UUID
+	SimilarFormID uuid.UUID
+	UserID        uuid.UUID
+	CreatedAt     time.Time
+}
+
+func (f *SimilarFormFeedback) Validate() error {
+	if f.FormID == uuid.Nil || f.SimilarFormID == uuid.Nil || f.FormID == f.SimilarFormID {
+		return ErrInvalidSimilarFeedback
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func similarCandidate(clientID uuid.UUID, resolvedAt time.Time, similarity float64, helpful int) SimilarFormCandidate {
+	form := &Atendimentos{ID: uuid.New(), Cliente: ClientForm{ID: clientID}, DataDeAbertura: resolvedAt.Add(-time.Hour)}
+	form.SLA.ResolvedAt = resolvedAt
+	return SimilarFormCandidate{Form: form, Similarity: similarity, HelpfulCount: helpful}
+}
+
+func TestSimilarFormsQuery_Validate(t *testing.T) {
+	q := SimilarFormsQuery{Description: "  impressora não imprime  ", Limit: DefaultSimilarFormsLimit}
+	require.NoError(t, q.Validate())
+	assert.Equal(t, "impressora não imprime", q.Description)
+
+	q = SimilarFormsQuery{Description: "   ", Limit: DefaultSimilarFormsLimit}
+	assert.ErrorIs(t, q.Validate(), ErrInvalidDefectDescription)
+
+	q = SimilarFormsQuery{Description: "impressora", Limit: MaxSimilarFormsLimit + 1}
+	assert.ErrorIs(t, q.Validate(), ErrInvalidPageSize)
+
+	q = SimilarFormsQuery{Description: "impressora"}
+	assert.ErrorIs(t, q.Validate(), ErrInvalidPageSize)
+}
+
+func TestRankSimilarForms(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	client := uuid.New()
+	other := uuid.New()
+
+	t.Run("same client outranks a slightly closer text", func(t *testing.T) {
+		sameClient := similarCandidate(client, now, 0.6, 0)
+		closer := similarCandidate(other, now, 0.7, 0)
+
+		ranked := RankSimilarForms(SimilarFormsQuery{ClientID: client, Limit: 5}, []SimilarFormCandidate{closer, sameClient}, now)
+		require.Len(t, ranked, 2)
+		assert.Equal(t, sameClient.Form.ID, ranked[0].Form.ID)
+	})
+
+	t.Run("recent forms outrank old ones", func(t *testing.T) {
+		recent := similarCandidate(other, now.AddDate(0, -1, 0), 0.5, 0)
+		old := similarCandidate(other, now.AddDate(-3, 0, 0), 0.5, 0)
+
+		ranked := RankSimilarForms(SimilarFormsQuery{Limit: 5}, []SimilarFormCandidate{old, recent}, now)
+		assert.Equal(t, recent.Form.ID, ranked[0].Form.ID)
+		assert.Greater(t, ranked[1].Score, 0.0)
+	})
+
+	t.Run("helpful marks raise the score up to a cap", func(t *testing.T) {
+		plain := similarCandidate(other, now, 0.5, 0)
+		helpful := similarCandidate(other, now, 0.5, 3)
+		veryHelpful := similarCandidate(other, now, 0.5, 10000)
+
+		ranked := RankSimilarForms(SimilarFormsQuery{Limit: 5}, []SimilarFormCandidate{plain, helpful, veryHelpful}, now)
+		require.Len(t, ranked, 3)
+		assert.Equal(t, []uuid.UUID{veryHelpful.Form.ID, helpful.Form.ID, plain.Form.ID},
+			[]uuid.UUID{ranked[0].Form.ID, ranked[1].Form.ID, ranked[2].Form.ID})
+		assert.LessOrEqual(t, ranked[0].Score, 1.0)
+	})
+
+	t.Run("perfect match from the same client today scores below 1", func(t *testing.T) {
+		best := similarCandidate(client, now, 1, 0)
+		ranked := RankSimilarForms(SimilarFormsQuery{ClientID: client, Limit: 1}, []SimilarFormCandidate{best}, now)
+		assert.InDelta(t, 1/(1+similarMaxHelpfulBoost), ranked[0].Score, 1e-9)
+	})
+
+	t.Run("excludes the open form and applies the limit", func(t *testing.T) {
+		open := similarCandidate(client, now, 1, 0)
+		a := similarCandidate(other, now, 0.4, 0)
+		b := similarCandidate(other, now, 0.3, 0)
+
+		ranked := RankSimilarForms(SimilarFormsQuery{FormID: open.Form.ID, Limit: 1}, []SimilarFormCandidate{open, a, b}, now)
+		require.Len(t, ranked, 1)
+		assert.Equal(t, a.Form.ID, ranked[0].Form.ID)
+	})
+}
+
+func TestSimilarFormFeedback_Validate(t *testing.T) {
+	id := uuid.New()
+	assert.NoError(t, (&SimilarFormFeedback{FormID: id, SimilarFormID: uuid.New()}).Validate())
+	assert.ErrorIs(t, (&SimilarFormFeedback{FormID: id, SimilarFormID: id}).Validate(), ErrInvalidSimilarFeedback)
+	assert.ErrorIs(t, (&SimilarFormFeedback{FormID: id}).Validate(), ErrInvalidSimilarFeedback)
+}
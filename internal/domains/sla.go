@@ -0,0 +1,265 @@
+package domains
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Situações do SLA de um atendimento.
+const (
+	SLAStateOnTrack  = "no_prazo"
+	SLAStateAtRisk   = "em_risco"
+	SLAStateBreached = "violado"
+	SLAStateMet      = "cumprido"
+)
+
+const (
+	// SLAAtRiskRatio é a fração do prazo, em horas úteis, a partir da qual o
+	// atendimento pendente passa a ser considerado em risco.
+	SLAAtRiskRatio = 0.8
+	// MaxSLAMinutes limita os prazos das políticas a 90 dias corridos.
+	MaxSLAMinutes           = 90 * 24 * 60
+	MaxHolidayNameLength    = 100
+	maxBusinessCalendarDays = 3660
+)
+
+// SLAPolicy define os prazos de primeira resposta e de resolução, em minutos
+// úteis, para um nível de dificuldade e um tipo de cliente.
+type SLAPolicy struct {
+	DifficultyLevel   string    `json:"difficulty_level"`
+	ClientType        string    `json:"client_type"`
+	ResponseMinutes   int       `json:"response_minutes"`
+	ResolutionMinutes int       `json:"resolution_minutes"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+func (p *SLAPolicy) Validate() error {
+	switch p.DifficultyLevel {
+	case "low", "medium", "high":
+	default:
+		return ErrInvalidDifficultyLevel
+	}
+	if p.ClientType != "avulso" && p.ClientType != "contrato" {
+		return ErrInvalidClientType
+	}
+	if p.ResponseMinutes <= 0 || p.ResolutionMinutes < p.ResponseMinutes || p.ResolutionMinutes > MaxSLAMinutes {
+		return ErrInvalidSLAPolicy
+	}
+	return nil
+}
+
+// Holiday é um dia sem expediente, que não conta nos prazos.
+type Holiday struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+}
+
+func (h *Holiday) Validate() error {
+	if h.Date.IsZero() {
+		return ErrInvalidHoliday
+	}
+	if h.Name == "" || utf8.RuneCountInString(h.Name) > MaxHolidayNameLength {
+		return ErrInvalidHoliday
+	}
+	return nil
+}
+
+// BusinessHours é o expediente: de Start a End (minutos desde a meia-noite)
+// nos dias da semana em Workdays, no fuso Location.
+type BusinessHours struct {
+	Location *time.Location
+	Start    int
+	End      int
+	Workdays []time.Weekday
+}
+
+func (b BusinessHours) Validate() error {
+	if b.Location == nil || b.Start < 0 || b.End > 24*60 || b.Start >= b.End || len(b.Workdays) == 0 {
+		return ErrInvalidBusinessHours
+	}
+	for _, d := range b.Workdays {
+		if d < time.Sunday || d > time.Saturday {
+			return ErrInvalidBusinessHours
+		}
+	}
+	return nil
+}
+
+// ParseBusinessHours monta o expediente a partir da configuração: fuso IANA,
+// abertura e fechamento em HH:MM e dias da semana separados por vírgula
+// (0 = domingo).
+func ParseBusinessHours(timezone, start, end, workdays string) (BusinessHours, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return BusinessHours{}, ErrInvalidBusinessHours
+	}
+	hours := BusinessHours{Location: loc}
+	if hours.Start, err = parseClock(start); err != nil {
+		return BusinessHours{}, err
+	}
+	if hours.End, err = parseClock(end); err != nil {
+		return BusinessHours{}, err
+	}
+	for _, d := range strings.Split(workdays, ",") {
+		day, err := strconv.Atoi(strings.TrimSpace(d))
+		if err != nil {
+			return BusinessHours{}, ErrInvalidBusinessHours
+		}
+		hours.Workdays = append(hours.Workdays, time.Weekday(day))
+	}
+	return hours, hours.Validate()
+}
+
+func parseClock(s string) (int, error) {
+	if s == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, ErrInvalidBusinessHours
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// BusinessCalendar combina o expediente com os feriados para somar horas
+// úteis a um instante.
+type BusinessCalendar struct {
+	hours    BusinessHours
+	workdays [7]bool
+	holidays map[string]bool
+}
+
+func NewBusinessCalendar(hours BusinessHours, holidays []Holiday) *BusinessCalendar {
+	c := &BusinessCalendar{hours: hours, holidays: make(map[string]bool, len(holidays))}
+	for _, d := range hours.Workdays {
+		c.workdays[d] = true
+	}
+	for _, h := range holidays {
+		c.holidays[h.Date.Format(time.DateOnly)] = true
+	}
+	return c
+}
+
+// Add soma d em horas úteis a t: o tempo fora do expediente, nos fins de
+// semana e nos feriados não conta. Um atendimento aberto fora do expediente
+// começa a contar na abertura do próximo dia útil.
+func (c *BusinessCalendar) Add(t time.Time, d time.Duration) time.Time {
+	loc := c.hours.Location
+	cursor := t.In(loc)
+	remaining := d
+	for range maxBusinessCalendarDays {
+		y, m, day := cursor.Date()
+		if c.isBusinessDay(cursor) {
+			open := time.Date(y, m, day, 0, c.hours.Start, 0, 0, loc)
+			closeAt := time.Date(y, m, day, 0, c.hours.End, 0, 0, loc)
+			if cursor.Before(open) {
+				cursor = open
+			}
+			if cursor.Before(closeAt) {
+				available := closeAt.Sub(cursor)
+				if remaining <= available {
+					return cursor.Add(remaining).UTC()
+				}
+				remaining -= available
+			}
+		}
+		cursor = time.Date(y, m, day+1, 0, 0, 0, 0, loc)
+	}
+	// Sem dias úteis no horizonte do calendário o prazo é somado corrido.
+	return t.Add(d).UTC()
+}
+
+func (c *BusinessCalendar) isBusinessDay(t time.Time) bool {
+	return c.workdays[t.Weekday()] && !c.holidays[t.Format(time.DateOnly)]
+}
+
+// FormSLA são os prazos do atendimento, calculados na abertura. Os instantes
+// *RiskAt marcam quando o atendimento pendente passa a estar em risco;
+// RespondedAt e ResolvedAt são zero enquanto a etapa está pendente.
+type FormSLA struct {
+	ResponseDueAt    time.Time `json:"response_due_at"`
+	ResponseRiskAt   time.Time `json:"response_risk_at"`
+	ResolutionDueAt  time.Time `json:"resolution_due_at"`
+	ResolutionRiskAt time.Time `json:"resolution_risk_at"`
+	RespondedAt      time.Time `json:"responded_at"`
+	ResolvedAt       time.Time `json:"resolved_at"`
+}
+
+// NewFormSLA calcula os prazos de um atendimento aberto em openedAt.
+func NewFormSLA(policy *SLAPolicy, calendar *BusinessCalendar, openedAt time.Time) FormSLA {
+	response := time.Duration(policy.ResponseMinutes) * time.Minute
+	resolution := time.Duration(policy.ResolutionMinutes) * time.Minute
+	return FormSLA{
+		ResponseDueAt:    calendar.Add(openedAt, response),
+		ResponseRiskAt:   calendar.Add(openedAt, time.Duration(float64(response)*SLAAtRiskRatio)),
+		ResolutionDueAt:  calendar.Add(openedAt, resolution),
+		ResolutionRiskAt: calendar.Add(openedAt, time.Duration(float64(resolution)*SLAAtRiskRatio)),
+	}
+}
+
+// Enabled informa se o atendimento tem prazos; atendimentos anteriores ao SLA
+// ou sem política para o nível e tipo de cliente não têm.
+func (s FormSLA) Enabled() bool {
+	return !s.ResolutionDueAt.IsZero()
+}
+
+// State devolve a situação do SLA em now, considerando a pior entre a primeira
+// resposta e a resolução. Atendimentos sem prazos devolvem "".
+func (s FormSLA) State(now time.Time) string {
+	if !s.Enabled() {
+		return ""
+	}
+	response := stageState(s.ResponseDueAt, s.ResponseRiskAt, s.RespondedAt, now)
+	resolution := stageState(s.ResolutionDueAt, s.ResolutionRiskAt, s.ResolvedAt, now)
+	switch {
+	case response == SLAStateBreached || resolution == SLAStateBreached:
+		return SLAStateBreached
+	case response == SLAStateAtRisk || resolution == SLAStateAtRisk:
+		return SLAStateAtRisk
+	case response == SLAStateMet && resolution == SLAStateMet:
+		return SLAStateMet
+	}
+	return SLAStateOnTrack
+}
+
+func stageState(due, risk, done, now time.Time) string {
+	if !done.IsZero() {
+		if done.After(due) {
+			return SLAStateBreached
+		}
+		return SLAStateMet
+	}
+	switch {
+	case now.After(due):
+		return SLAStateBreached
+	case !now.Before(risk):
+		return SLAStateAtRisk
+	}
+	return SLAStateOnTrack
+}
+
+// SLAState devolve a situação do SLA do atendimento. Atendimentos cancelados
+// não têm SLA.
+func (a *Atendimentos) SLAState(now time.Time) string {
+	if a.Status == FormStatusCanceled {
+		return ""
+	}
+	return a.SLA.State(now)
+}
+
+// trackSLA registra a primeira resposta, quando o atendimento sai da situação
+// aberto, e a resolução; ao reabrir um atendimento resolvido a resolução volta
+// a ficar pendente.
+func (a *Atendimentos) trackSLA(from, to string, at time.Time) {
+	if a.SLA.RespondedAt.IsZero() && from == FormStatusOpen && to != FormStatusCanceled {
+		a.SLA.RespondedAt = at
+	}
+	switch {
+	case to == FormStatusResolved:
+		a.SLA.ResolvedAt = at
+	case from == FormStatusResolved && to != FormStatusClosed:
+		a.SLA.ResolvedAt = time.Time{}
+	}
+}
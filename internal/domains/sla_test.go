@@ -0,0 +1,189 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCalendar(t *testing.T, holidays ...Holiday) *BusinessCalendar {
+	t.Helper()
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	assert.NoError(t, err)
+	hours := BusinessHours{
+		Location: loc,
+		Start:    8 * 60,
+		End:      18 * 60,
+		Workdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	}
+	assert.NoError(t, hours.Validate())
+	return NewBusinessCalendar(hours, holidays)
+}
+
+func TestBusinessCalendar_Add(t *testing.T) {
+	loc, _ := time.LoadLocation("America/Sao_Paulo")
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 3, day, hour, minute, 0, 0, loc)
+	}
+	// 2026-03-13 é sexta-feira.
+	tests := []struct {
+		name string
+		from time.Time
+		add  time.Duration
+		want time.Time
+	}{
+		{"same day", at(10, 9, 0), 2 * time.Hour, at(10, 11, 0)},
+		{"ends at closing", at(10, 16, 0), 2 * time.Hour, at(10, 18, 0)},
+		{"next day", at(10, 17, 0), 2 * time.Hour, at(11, 9, 0)},
+		{"before opening", at(10, 6, 30), time.Hour, at(10, 9, 0)},
+		{"after closing", at(10, 20, 0), time.Hour, at(11, 9, 0)},
+		{"over the weekend", at(13, 17, 0), 3 * time.Hour, at(16, 10, 0)},
+		{"opened on saturday", at(14, 10, 0), 30 * time.Minute, at(16, 8, 30)},
+		{"several days", at(10, 8, 0), 25 * time.Hour, at(12, 13, 0)},
+	}
+	calendar := testCalendar(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.want.Equal(calendar.Add(tt.from, tt.add)), calendar.Add(tt.from, tt.add).In(loc))
+		})
+	}
+
+	t.Run("skips holidays", func(t *testing.T) {
+		calendar := testCalendar(t, Holiday{Date: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), Name: "Feriado"})
+		assert.True(t, at(12, 9, 0).Equal(calendar.Add(at(10, 17, 0), 2*time.Hour)))
+	})
+
+	t.Run("returns UTC", func(t *testing.T) {
+		assert.Equal(t, time.UTC, calendar.Add(at(10, 9, 0), time.Hour).Location())
+	})
+}
+
+func TestBusinessHours_Validate(t *testing.T) {
+	valid := BusinessHours{Location: time.UTC, Start: 480, End: 1080, Workdays: []time.Weekday{time.Monday}}
+	assert.NoError(t, valid.Validate())
+
+	for name, change := range map[string]func(*BusinessHours){
+		"no location":   func(b *BusinessHours) { b.Location = nil },
+		"start after":   func(b *BusinessHours) { b.Start = 1100 },
+		"end past day":  func(b *BusinessHours) { b.End = 24*60 + 1 },
+		"no workdays":   func(b *BusinessHours) { b.Workdays = nil },
+		"invalid day":   func(b *BusinessHours) { b.Workdays = []time.Weekday{7} },
+		"empty opening": func(b *BusinessHours) { b.End = b.Start },
+	} {
+		b := valid
+		change(&b)
+		assert.ErrorIs(t, b.Validate(), ErrInvalidBusinessHours, name)
+	}
+}
+
+func TestParseBusinessHours(t *testing.T) {
+	hours, err := ParseBusinessHours("America/Sao_Paulo", "08:00", "18:30", "1, 2,3,4,5")
+	assert.NoError(t, err)
+	assert.Equal(t, 8*60, hours.Start)
+	assert.Equal(t, 18*60+30, hours.End)
+	assert.Equal(t, []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, hours.Workdays)
+	assert.Equal(t, "America/Sao_Paulo", hours.Location.String())
+
+	allDay, err := ParseBusinessHours("UTC", "00:00", "24:00", "0,1,2,3,4,5,6")
+	assert.NoError(t, err)
+	assert.Equal(t, 24*60, allDay.End)
+
+	for name, args := range map[string][4]string{
+		"timezone": {"Marte/Olympus", "08:00", "18:00", "1"},
+		"start":    {"UTC", "8h", "18:00", "1"},
+		"end":      {"UTC", "08:00", "25:00", "1"},
+		"reversed": {"UTC", "18:00", "08:00", "1"},
+		"workdays": {"UTC", "08:00", "18:00", "seg"},
+		"weekday":  {"UTC", "08:00", "18:00", "7"},
+	} {
+		_, err := ParseBusinessHours(args[0], args[1], args[2], args[3])
+		assert.ErrorIs(t, err, ErrInvalidBusinessHours, name)
+	}
+}
+
+func TestSLAPolicy_Validate(t *testing.T) {
+	valid := SLAPolicy{DifficultyLevel: "medium", ClientType: "contrato", ResponseMinutes: 60, ResolutionMinutes: 480}
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		change func(*SLAPolicy)
+		err    error
+	}{
+		{"difficulty", func(p *SLAPolicy) { p.DifficultyLevel = "urgent" }, ErrInvalidDifficultyLevel},
+		{"client type", func(p *SLAPolicy) { p.ClientType = "governo" }, ErrInvalidClientType},
+		{"zero response", func(p *SLAPolicy) { p.ResponseMinutes = 0 }, ErrInvalidSLAPolicy},
+		{"resolution before response", func(p *SLAPolicy) { p.ResolutionMinutes = 30 }, ErrInvalidSLAPolicy},
+		{"too long", func(p *SLAPolicy) { p.ResolutionMinutes = MaxSLAMinutes + 1 }, ErrInvalidSLAPolicy},
+	}
+	for _, tt := range tests {
+		p := valid
+		tt.change(&p)
+		assert.ErrorIs(t, p.Validate(), tt.err, tt.name)
+	}
+}
+
+func TestHoliday_Validate(t *testing.T) {
+	assert.NoError(t, (&Holiday{Date: time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), Name: "Natal"}).Validate())
+	assert.ErrorIs(t, (&Holiday{Name: "Natal"}).Validate(), ErrInvalidHoliday)
+	assert.ErrorIs(t, (&Holiday{Date: time.Now()}).Validate(), ErrInvalidHoliday)
+}
+
+func TestFormSLA_State(t *testing.T) {
+	loc, _ := time.LoadLocation("America/Sao_Paulo")
+	opened := time.Date(2026, 3, 10, 8, 0, 0, 0, loc)
+	sla := NewFormSLA(&SLAPolicy{ResponseMinutes: 60, ResolutionMinutes: 600}, testCalendar(t), opened)
+
+	assert.True(t, time.Date(2026, 3, 10, 9, 0, 0, 0, loc).Equal(sla.ResponseDueAt))
+	assert.True(t, time.Date(2026, 3, 10, 8, 48, 0, 0, loc).Equal(sla.ResponseRiskAt))
+	assert.True(t, time.Date(2026, 3, 10, 18, 0, 0, 0, loc).Equal(sla.ResolutionDueAt))
+	assert.True(t, time.Date(2026, 3, 10, 16, 0, 0, 0, loc).Equal(sla.ResolutionRiskAt))
+
+	at := func(hour, minute int) time.Time { return time.Date(2026, 3, 10, hour, minute, 0, 0, loc) }
+	assert.Equal(t, SLAStateOnTrack, sla.State(at(8, 30)))
+	assert.Equal(t, SLAStateAtRisk, sla.State(at(8, 48)))
+	assert.Equal(t, SLAStateBreached, sla.State(at(9, 1)))
+
+	responded := sla
+	responded.RespondedAt = at(8, 50)
+	assert.Equal(t, SLAStateOnTrack, responded.State(at(12, 0)))
+	assert.Equal(t, SLAStateAtRisk, responded.State(at(16, 30)))
+	assert.Equal(t, SLAStateBreached, responded.State(at(18, 1)))
+
+	resolved := responded
+	resolved.ResolvedAt = at(17, 0)
+	assert.Equal(t, SLAStateMet, resolved.State(at(20, 0)))
+
+	late := sla
+	late.RespondedAt = at(9, 30)
+	late.ResolvedAt = at(10, 0)
+	assert.Equal(t, SLAStateBreached, late.State(at(10, 0)))
+
+	assert.Equal(t, "", FormSLA{}.State(at(10, 0)))
+	assert.Equal(t, "", (&Atendimentos{Status: FormStatusCanceled, SLA: sla}).SLAState(at(10, 0)))
+}
+
+func TestAtendimentos_TransitionTo_TracksSLA(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	form := &Atendimentos{Status: FormStatusOpen, SolutionDescription: "Cabo trocado"}
+
+	_, err := form.TransitionTo(FormStatusInProgress, "", "", uuid.Nil, now)
+	assert.NoError(t, err)
+	assert.Equal(t, now, form.SLA.RespondedAt)
+
+	_, err = form.TransitionTo(FormStatusResolved, "", "", uuid.Nil, now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, now, form.SLA.RespondedAt)
+	assert.Equal(t, now.Add(time.Hour), form.SLA.ResolvedAt)
+
+	_, err = form.TransitionTo(FormStatusInProgress, "Voltou a falhar", "", uuid.Nil, now.Add(2*time.Hour))
+	assert.NoError(t, err)
+	assert.True(t, form.SLA.ResolvedAt.IsZero())
+
+	canceled := &Atendimentos{Status: FormStatusOpen}
+	_, err = canceled.TransitionTo(FormStatusCanceled, "Duplicado", "", uuid.Nil, now)
+	assert.NoError(t, err)
+	assert.True(t, canceled.SLA.RespondedAt.IsZero())
+}
@@ -0,0 +1,239 @@
+package domains
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// A pesquisa de satisfação é criada quando o atendimento é resolvido e enviada
+// por e-mail ao contato do cliente com um link de uso único. O link leva um
+// token aleatório; só o hash SHA-256 do token fica gravado.
+const (
+	MinCSATScore = 1
+	MaxCSATScore = 5
+	MinNPSScore  = 0
+	MaxNPSScore  = 10
+
+	// CSATSatisfiedScore é a menor nota considerada satisfeita (4 ou 5).
+	CSATSatisfiedScore = 4
+	// NPSPromoterScore e NPSPassiveScore separam promotores (9 e 10), neutros
+	// (7 e 8) e detratores (0 a 6).
+	NPSPromoterScore = 9
+	NPSPassiveScore  = 7
+
+	MaxSurveyCommentLength = 1000
+
+	// DefaultSurveyTTL é a validade do link depois do envio.
+	DefaultSurveyTTL = 7 * 24 * time.Hour
+	// MaxSurveySendAttempts limita as tentativas de envio de uma pesquisa.
+	MaxSurveySendAttempts = 5
+	// SurveySendBatchSize é quantas pesquisas pendentes são enviadas por rodada.
+	SurveySendBatchSize = 50
+
+	surveyTokenBytes = 32
+)
+
+// Situações de uma pesquisa, derivadas das datas de envio, validade e resposta.
+const (
+	SurveyStatusPending  = "pendente"
+	SurveyStatusSent     = "enviada"
+	SurveyStatusAnswered = "respondida"
+	SurveyStatusExpired  = "expirada"
+)
+
+// Agrupamentos e granularidades do resumo das pesquisas.
+const (
+	SurveyGroupMember = "tecnico"
+	SurveyGroupClient = "cliente"
+	SurveyGroupPeriod = "periodo"
+
+	SurveyPeriodDay   = "dia"
+	SurveyPeriodWeek  = "semana"
+	SurveyPeriodMonth = "mes"
+)
+
+// SatisfactionSurvey é a pesquisa de um atendimento. SentAt, ExpiresAt e
+// RespondedAt são zero enquanto a pesquisa não foi enviada ou respondida.
+type SatisfactionSurvey struct {
+	ID         uuid.UUID `json:"id"`
+	FormID     uuid.UUID `json:"form_id"`
+	FormCode   string    `json:"form_code"`
+	ClientID   uuid.UUID `json:"client_id"`
+	ClientName string    `json:"client_name"`
+
+	// ContactName e ContactEmail são o contato atual do cliente, usados no
+	// envio; SentTo guarda o endereço para o qual a pesquisa foi enviada.
+	ContactName  string `json:"contact_name"`
+	ContactEmail string `json:"contact_email"`
+	SentTo       string `json:"sent_to"`
+
+	TokenHash    string    `json:"-"`
+	SendAttempts int       `json:"send_attempts"`
+	SentAt       time.Time `json:"sent_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+
+	CSATScore   int       `json:"csat_score"`
+	NPSScore    int       `json:"nps_score"`
+	Comment     string    `json:"comment"`
+	RespondedAt time.Time `json:"responded_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SurveyAnswer é a resposta do cliente.
+type SurveyAnswer struct {
+	CSATScore int    `json:"csat_score"`
+	NPSScore  int    `json:"nps_score"`
+	Comment   string `json:"comment"`
+}
+
+// SurveySummaryFilter define o agrupamento e o período do resumo. O período
+// considera a data de envio da pesquisa, isto é, quando o atendimento foi
+// concluído; Granularity só vale para o agrupamento por período.
+type SurveySummaryFilter struct {
+	GroupBy     string    `json:"group_by"`
+	Granularity string    `json:"granularity"`
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	ClientID    uuid.UUID `json:"client_id"`
+	MemberID    uuid.UUID `json:"member_id"`
+}
+
+// SurveyTotals são as contagens de um grupo do resumo. Um atendimento com
+// vários técnicos conta para cada um deles no agrupamento por técnico.
+type SurveyTotals struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	PeriodStart time.Time `json:"period_start"`
+
+	Sent       int `json:"sent"`
+	Answered   int `json:"answered"`
+	CSATSum    int `json:"csat_sum"`
+	Satisfied  int `json:"satisfied"`
+	Promoters  int `json:"promoters"`
+	Passives   int `json:"passives"`
+	Detractors int `json:"detractors"`
+}
+
+// NewSurveyToken gera o token do link da pesquisa e o hash gravado no banco.
+func NewSurveyToken() (token, hash string, err error) {
+	buf := make([]byte, surveyTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, HashSurveyToken(token), nil
+}
+
+// HashSurveyToken devolve o hash usado para localizar a pesquisa pelo token.
+func HashSurveyToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Status devolve a situação da pesquisa em now.
+func (s *SatisfactionSurvey) Status(now time.Time) string {
+	switch {
+	case !s.RespondedAt.IsZero():
+		return SurveyStatusAnswered
+	case s.SentAt.IsZero():
+		return SurveyStatusPending
+	case !now.Before(s.ExpiresAt):
+		return SurveyStatusExpired
+	}
+	return SurveyStatusSent
+}
+
+// CanAnswer informa se a pesquisa aceita resposta em now: precisa ter sido
+// enviada, estar dentro da validade e ainda não ter resposta.
+func (s *SatisfactionSurvey) CanAnswer(now time.Time) error {
+	switch s.Status(now) {
+	case SurveyStatusAnswered:
+		return ErrSurveyAlreadyAnswered
+	case SurveyStatusExpired:
+		return ErrSurveyExpired
+	case SurveyStatusPending:
+		return ErrSurveyNotFound
+	}
+	return nil
+}
+
+// Normalize remove os espaços das pontas do comentário.
+func (a *SurveyAnswer) Normalize() {
+	a.Comment = strings.TrimSpace(a.Comment)
+}
+
+func (a *SurveyAnswer) Validate() error {
+	if a.CSATScore < MinCSATScore || a.CSATScore > MaxCSATScore {
+		return ErrInvalidSurveyAnswer
+	}
+	if a.NPSScore < MinNPSScore || a.NPSScore > MaxNPSScore {
+		return ErrInvalidSurveyAnswer
+	}
+	if utf8.RuneCountInString(a.Comment) > MaxSurveyCommentLength {
+		return ErrInvalidSurveyAnswer
+	}
+	return nil
+}
+
+func (f SurveySummaryFilter) Validate() error {
+	switch f.GroupBy {
+	case SurveyGroupMember, SurveyGroupClient:
+	case SurveyGroupPeriod:
+		switch f.Granularity {
+		case SurveyPeriodDay, SurveyPeriodWeek, SurveyPeriodMonth:
+		default:
+			return ErrInvalidSurveySummary
+		}
+	default:
+		return ErrInvalidSurveySummary
+	}
+	if !f.From.IsZero() && !f.To.IsZero() && !f.To.After(f.From) {
+		return ErrInvalidSurveySummary
+	}
+	return nil
+}
+
+// ResponseRate devolve a fração das pesquisas enviadas que foram respondidas.
+func (t SurveyTotals) ResponseRate() float64 {
+	if t.Sent == 0 {
+		return 0
+	}
+	return roundScore(float64(t.Answered) / float64(t.Sent))
+}
+
+// CSATAverage devolve a nota média de satisfação, de 1 a 5.
+func (t SurveyTotals) CSATAverage() float64 {
+	if t.Answered == 0 {
+		return 0
+	}
+	return roundScore(float64(t.CSATSum) / float64(t.Answered))
+}
+
+// CSATPercent devolve o percentual de respostas satisfeitas (notas 4 e 5).
+func (t SurveyTotals) CSATPercent() float64 {
+	if t.Answered == 0 {
+		return 0
+	}
+	return roundScore(float64(t.Satisfied) * 100 / float64(t.Answered))
+}
+
+// NPS devolve o percentual de promotores menos o de detratores, de -100 a 100.
+func (t SurveyTotals) NPS() float64 {
+	if t.Answered == 0 {
+		return 0
+	}
+	return roundScore(float64(t.Promoters-t.Detractors) * 100 / float64(t.Answered))
+}
+
+func roundScore(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,100 @@
+package domains
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSurveyToken(t *testing.T) {
+	token, hash, err := NewSurveyToken()
+	require.NoError(t, err)
+	assert.Len(t, token, 43, "32 bytes in unpadded base64url")
+	assert.Equal(t, HashSurveyToken(token), hash)
+	assert.Len(t, hash, 64)
+
+	other, _, err := NewSurveyToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func TestSatisfactionSurvey_Status(t *testing.T) {
+	sentAt := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	expiresAt := sentAt.Add(DefaultSurveyTTL)
+
+	tests := []struct {
+		name   string
+		survey SatisfactionSurvey
+		now    time.Time
+		status string
+		err    error
+	}{
+		{"not sent yet", SatisfactionSurvey{}, sentAt, SurveyStatusPending, ErrSurveyNotFound},
+		{"sent", SatisfactionSurvey{SentAt: sentAt, ExpiresAt: expiresAt}, sentAt.Add(time.Hour), SurveyStatusSent, nil},
+		{"expired at the limit", SatisfactionSurvey{SentAt: sentAt, ExpiresAt: expiresAt}, expiresAt, SurveyStatusExpired, ErrSurveyExpired},
+		{"answered", SatisfactionSurvey{SentAt: sentAt, ExpiresAt: expiresAt, RespondedAt: sentAt.Add(time.Hour)}, expiresAt.Add(time.Hour), SurveyStatusAnswered, ErrSurveyAlreadyAnswered},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.status, tt.survey.Status(tt.now))
+			assert.ErrorIs(t, tt.survey.CanAnswer(tt.now), tt.err)
+		})
+	}
+}
+
+func TestSurveyAnswer_Validate(t *testing.T) {
+	answer := SurveyAnswer{CSATScore: 5, NPSScore: 10, Comment: "  Técnico pontual  "}
+	answer.Normalize()
+	assert.Equal(t, "Técnico pontual", answer.Comment)
+	assert.NoError(t, answer.Validate())
+	assert.NoError(t, (&SurveyAnswer{CSATScore: 1, NPSScore: 0}).Validate())
+
+	for name, a := range map[string]SurveyAnswer{
+		"csat below":   {CSATScore: 0, NPSScore: 5},
+		"csat above":   {CSATScore: 6, NPSScore: 5},
+		"nps below":    {CSATScore: 3, NPSScore: -1},
+		"nps above":    {CSATScore: 3, NPSScore: 11},
+		"long comment": {CSATScore: 3, NPSScore: 5, Comment: strings.Repeat("á", MaxSurveyCommentLength+1)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, a.Validate(), ErrInvalidSurveyAnswer)
+		})
+	}
+}
+
+func TestSurveySummaryFilter_Validate(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, SurveySummaryFilter{GroupBy: SurveyGroupMember}.Validate())
+	assert.NoError(t, SurveySummaryFilter{GroupBy: SurveyGroupClient, From: from, To: from.AddDate(0, 1, 0)}.Validate())
+	assert.NoError(t, SurveySummaryFilter{GroupBy: SurveyGroupPeriod, Granularity: SurveyPeriodWeek}.Validate())
+
+	assert.ErrorIs(t, SurveySummaryFilter{GroupBy: "atendimento"}.Validate(), ErrInvalidSurveySummary)
+	assert.ErrorIs(t, SurveySummaryFilter{GroupBy: SurveyGroupPeriod}.Validate(), ErrInvalidSurveySummary)
+	assert.ErrorIs(t, SurveySummaryFilter{GroupBy: SurveyGroupMember, From: from, To: from}.Validate(), ErrInvalidSurveySummary)
+}
+
+func TestSurveyTotals_Scores(t *testing.T) {
+	totals := SurveyTotals{
+		Sent:       8,
+		Answered:   6,
+		CSATSum:    25,
+		Satisfied:  5,
+		Promoters:  3,
+		Passives:   2,
+		Detractors: 1,
+	}
+	assert.Equal(t, 0.75, totals.ResponseRate())
+	assert.Equal(t, 4.17, totals.CSATAverage())
+	assert.Equal(t, 83.33, totals.CSATPercent())
+	assert.Equal(t, 33.33, totals.NPS())
+
+	empty := SurveyTotals{Sent: 3}
+	assert.Zero(t, empty.ResponseRate())
+	assert.Zero(t, empty.CSATAverage())
+	assert.Zero(t, empty.CSATPercent())
+	assert.Zero(t, empty.NPS())
+}
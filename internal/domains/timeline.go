@@ -0,0 +1,53 @@
+package domains
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de evento da linha do tempo de um atendimento.
+const (
+	TimelineComment    = "comentario"
+	TimelineStatus     = "situacao"
+	TimelineAssignment = "atribuicao"
+	TimelineAttachment = "anexo"
+)
+
+// TimelineEvent é um item da linha do tempo. Apenas o campo correspondente a
+// Type é preenchido.
+type TimelineEvent struct {
+	Type       string                `json:"type"`
+	At         time.Time             `json:"at"`
+	ActorID    uuid.UUID             `json:"actor_id"`
+	ActorName  string                `json:"actor_name"`
+	Comment    *FormComment          `json:"comment,omitempty"`
+	Status     *FormStatusChange     `json:"status,omitempty"`
+	Assignment *FormAssignmentChange `json:"assignment,omitempty"`
+	Attachment *Attachment           `json:"attachment,omitempty"`
+}
+
+// BuildTimeline junta os registros de um atendimento em ordem cronológica.
+// Eventos no mesmo instante mantêm a ordem dos argumentos, e cada lista já
+// vem ordenada do banco.
+func BuildTimeline(comments []*FormComment, statuses []*FormStatusChange, assignments []*FormAssignmentChange, attachments []*Attachment) []TimelineEvent {
+	events := make([]TimelineEvent, 0, len(comments)+len(statuses)+len(assignments)+len(attachments))
+	for _, c := range comments {
+		events = append(events, TimelineEvent{Type: TimelineComment, At: c.CreatedAt, ActorID: c.AuthorID, ActorName: c.AuthorName, Comment: c})
+	}
+	for _, s := range statuses {
+		events = append(events, TimelineEvent{Type: TimelineStatus, At: s.ChangedAt, ActorID: s.ChangedBy, ActorName: s.ChangedByName, Status: s})
+	}
+	for _, a := range assignments {
+		events = append(events, TimelineEvent{Type: TimelineAssignment, At: a.ChangedAt, ActorID: a.ChangedBy, ActorName: a.ChangedByName, Assignment: a})
+	}
+	for _, a := range attachments {
+		events = append(events, TimelineEvent{Type: TimelineAttachment, At: a.CreatedAt, ActorID: a.UploadedBy, ActorName: a.UploadedByName, Attachment: a})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].At.Before(events[j].At)
+	})
+	return events
+}
@@ -0,0 +1,47 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	base := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+	author := uuid.New()
+
+	comments := []*FormComment{
+		{ID: uuid.New(), AuthorID: author, AuthorName: "Ana", CreatedAt: base.Add(10 * time.Minute)},
+		{ID: uuid.New(), AuthorID: author, AuthorName: "Ana", CreatedAt: base.Add(30 * time.Minute)},
+	}
+	statuses := []*FormStatusChange{
+		{ID: uuid.New(), ToStatus: FormStatusInProgress, ChangedBy: author, ChangedByName: "Ana", ChangedAt: base.Add(10 * time.Minute)},
+	}
+	assignments := []*FormAssignmentChange{
+		{ID: uuid.New(), Action: AssignmentAdded, ChangedAt: base},
+	}
+	attachments := []*Attachment{
+		{ID: uuid.New(), UploadedBy: author, UploadedByName: "Ana", CreatedAt: base.Add(20 * time.Minute)},
+	}
+
+	events := BuildTimeline(comments, statuses, assignments, attachments)
+
+	types := make([]string, 0, len(events))
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	assert.Equal(t, []string{
+		TimelineAssignment,
+		TimelineComment,
+		TimelineStatus,
+		TimelineAttachment,
+		TimelineComment,
+	}, types)
+
+	assert.Same(t, comments[0], events[1].Comment)
+	assert.Equal(t, "Ana", events[2].ActorName)
+	assert.Equal(t, author, events[3].ActorID)
+	assert.Empty(t, BuildTimeline(nil, nil, nil, nil))
+}
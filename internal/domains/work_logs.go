@@ -0,0 +1,160 @@
+package domains
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Tipos de apontamento de horas.
+const (
+	WorkLogTravel = "deslocamento"
+	WorkLogOnSite = "no_local"
+)
+
+// Origens de um apontamento: cronômetro iniciado e encerrado pelo técnico ou
+// lançamento manual com início e fim.
+const (
+	WorkLogSourceTimer  = "cronometro"
+	WorkLogSourceManual = "manual"
+)
+
+// Agrupamentos dos totais de horas apontadas.
+const (
+	WorkLogGroupForm   = "atendimento"
+	WorkLogGroupMember = "tecnico"
+	WorkLogGroupClient = "cliente"
+)
+
+const (
+	// MaxWorkLogDuration limita um apontamento a um dia; cronômetros
+	// esquecidos devem ser encerrados informando o fim correto.
+	MaxWorkLogDuration    = 24 * time.Hour
+	MaxWorkLogNotesLength = 2000
+)
+
+// WorkLog é um período de trabalho de um técnico em um atendimento. EndedAt
+// zero indica cronômetro em andamento.
+type WorkLog struct {
+	ID         uuid.UUID `json:"id"`
+	FormID     uuid.UUID `json:"form_id"`
+	MemberID   uuid.UUID `json:"member_id"`
+	MemberName string    `json:"member_name"`
+	Kind       string    `json:"kind"`
+	Source     string    `json:"source"`
+	StartedAt  time.Time `json:"started_at"`
+	EndedAt    time.Time `json:"ended_at"`
+	Notes      string    `json:"notes"`
+	CreatedBy  uuid.UUID `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// WorkLogTotal soma, em segundos, as horas apontadas de um atendimento,
+// técnico ou cliente.
+type WorkLogTotal struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	TravelSeconds int64     `json:"travel_seconds"`
+	OnSiteSeconds int64     `json:"on_site_seconds"`
+}
+
+// WorkLogTotalsFilter define o agrupamento e o período dos totais. Apontamentos
+// que cruzam os limites do período contam apenas a parte dentro dele.
+type WorkLogTotalsFilter struct {
+	GroupBy  string    `json:"group_by"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	ClientID uuid.UUID `json:"client_id"`
+	MemberID uuid.UUID `json:"member_id"`
+}
+
+func IsValidWorkLogKind(kind string) bool {
+	return kind == WorkLogTravel || kind == WorkLogOnSite
+}
+
+func (w *WorkLog) Running() bool {
+	return w.EndedAt.IsZero()
+}
+
+// Validate confere o apontamento em relação ao instante now: não aceita
+// horários futuros nem períodos vazios ou maiores que MaxWorkLogDuration.
+func (w *WorkLog) Validate(now time.Time) error {
+	if w.MemberID == uuid.Nil {
+		return ErrWorkLogMemberRequired
+	}
+	if !IsValidWorkLogKind(w.Kind) {
+		return ErrInvalidWorkLogKind
+	}
+	if utf8.RuneCountInString(w.Notes) > MaxWorkLogNotesLength {
+		return ErrInvalidWorkLogNotes
+	}
+	if w.StartedAt.IsZero() || w.StartedAt.After(now) {
+		return ErrInvalidWorkLogPeriod
+	}
+	if w.Running() {
+		return nil
+	}
+	if !w.EndedAt.After(w.StartedAt) || w.EndedAt.After(now) || w.EndedAt.Sub(w.StartedAt) > MaxWorkLogDuration {
+		return ErrInvalidWorkLogPeriod
+	}
+	return nil
+}
+
+// Stop encerra o cronômetro em at.
+func (w *WorkLog) Stop(at, now time.Time) error {
+	if !w.Running() {
+		return ErrWorkLogNotRunning
+	}
+	w.EndedAt = at
+	if err := w.Validate(now); err != nil {
+		w.EndedAt = time.Time{}
+		return err
+	}
+	w.UpdatedAt = now
+	return nil
+}
+
+// Duration devolve o tempo apontado; para cronômetros em andamento, o tempo
+// decorrido até now.
+func (w *WorkLog) Duration(now time.Time) time.Duration {
+	if w.Running() {
+		return now.Sub(w.StartedAt)
+	}
+	return w.EndedAt.Sub(w.StartedAt)
+}
+
+// SumWorkLogs soma os apontamentos encerrados por tipo.
+func SumWorkLogs(logs []*WorkLog) WorkLogTotal {
+	var total WorkLogTotal
+	for _, w := range logs {
+		if w.Running() {
+			continue
+		}
+		seconds := int64(w.EndedAt.Sub(w.StartedAt) / time.Second)
+		switch w.Kind {
+		case WorkLogTravel:
+			total.TravelSeconds += seconds
+		case WorkLogOnSite:
+			total.OnSiteSeconds += seconds
+		}
+	}
+	return total
+}
+
+func (t WorkLogTotal) TotalSeconds() int64 {
+	return t.TravelSeconds + t.OnSiteSeconds
+}
+
+func (f WorkLogTotalsFilter) Validate() error {
+	switch f.GroupBy {
+	case WorkLogGroupForm, WorkLogGroupMember, WorkLogGroupClient:
+	default:
+		return ErrInvalidWorkLogGroup
+	}
+	if !f.From.IsZero() && !f.To.IsZero() && !f.To.After(f.From) {
+		return ErrInvalidWorkLogRange
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package domains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkLog_Validate(t *testing.T) {
+	now := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+	member := uuid.New()
+
+	tests := []struct {
+		name        string
+		log         WorkLog
+		expectedErr error
+	}{
+		{
+			name: "valid manual entry",
+			log:  WorkLog{MemberID: member, Kind: WorkLogOnSite, StartedAt: now.Add(-2 * time.Hour), EndedAt: now.Add(-time.Hour)},
+		},
+		{
+			name: "valid running timer",
+			log:  WorkLog{MemberID: member, Kind: WorkLogTravel, StartedAt: now.Add(-10 * time.Minute)},
+		},
+		{
+			name:        "invalid - no technician",
+			log:         WorkLog{Kind: WorkLogOnSite, StartedAt: now.Add(-time.Hour)},
+			expectedErr: ErrWorkLogMemberRequired,
+		},
+		{
+			name:        "invalid - unknown kind",
+			log:         WorkLog{MemberID: member, Kind: "almoco", StartedAt: now.Add(-time.Hour)},
+			expectedErr: ErrInvalidWorkLogKind,
+		},
+		{
+			name:        "invalid - ends before it starts",
+			log:         WorkLog{MemberID: member, Kind: WorkLogOnSite, StartedAt: now.Add(-time.Hour), EndedAt: now.Add(-2 * time.Hour)},
+			expectedErr: ErrInvalidWorkLogPeriod,
+		},
+		{
+			name:        "invalid - empty period",
+			log:         WorkLog{MemberID: member, Kind: WorkLogOnSite, StartedAt: now.Add(-time.Hour), EndedAt: now.Add(-time.Hour)},
+			expectedErr: ErrInvalidWorkLogPeriod,
+		},
+		{
+			name:        "invalid - in the future",
+			log:         WorkLog{MemberID: member, Kind: WorkLogOnSite, StartedAt: now.Add(-time.Hour), EndedAt: now.Add(time.Hour)},
+			expectedErr: ErrInvalidWorkLogPeriod,
+		},
+		{
+			name:        "invalid - longer than a day",
+			log:         WorkLog{MemberID: member, Kind: WorkLogOnSite, StartedAt: now.Add(-25 * time.Hour), EndedAt: now},
+			expectedErr: ErrInvalidWorkLogPeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.log.Validate(now)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkLog_Stop(t *testing.T) {
+	now := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+	log := WorkLog{MemberID: uuid.New(), Kind: WorkLogOnSite, StartedAt: now.Add(-time.Hour)}
+
+	assert.ErrorIs(t, log.Stop(now.Add(-2*time.Hour), now), ErrInvalidWorkLogPeriod)
+	assert.True(t, log.Running())
+
+	assert.NoError(t, log.Stop(now, now))
+	assert.Equal(t, time.Hour, log.Duration(now))
+	assert.ErrorIs(t, log.Stop(now, now), ErrWorkLogNotRunning)
+}
+
+func TestSumWorkLogs(t *testing.T) {
+	base := time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC)
+	logs := []*WorkLog{
+		{Kind: WorkLogTravel, StartedAt: base, EndedAt: base.Add(30 * time.Minute)},
+		{Kind: WorkLogOnSite, StartedAt: base.Add(30 * time.Minute), EndedAt: base.Add(2 * time.Hour)},
+		{Kind: WorkLogTravel, StartedAt: base.Add(2 * time.Hour)},
+	}
+
+	total := SumWorkLogs(logs)
+	assert.Equal(t, int64(1800), total.TravelSeconds)
+	assert.Equal(t, int64(5400), total.OnSiteSeconds)
+	assert.Equal(t, int64(7200), total.TotalSeconds())
+}
+
+func TestWorkLogTotalsFilter_Validate(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, WorkLogTotalsFilter{GroupBy: WorkLogGroupClient}.Validate())
+	assert.NoError(t, WorkLogTotalsFilter{GroupBy: WorkLogGroupMember, From: from, To: from.AddDate(0, 1, 0)}.Validate())
+	assert.ErrorIs(t, WorkLogTotalsFilter{GroupBy: "contrato"}.Validate(), ErrInvalidWorkLogGroup)
+	assert.ErrorIs(t, WorkLogTotalsFilter{GroupBy: WorkLogGroupForm, From: from, To: from}.Validate(), ErrInvalidWorkLogRange)
+}
@@ -2,32 +2,75 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"olidesk-api-2/internal/domains"
 	"olidesk-api-2/internal/handlers/spec"
 	"olidesk-api-2/internal/usecase"
+	"olidesk-api-2/internal/utils/validators"
 
 	"github.com/discord-gophers/goapi-gen/types"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
 type Handlers struct {
-	validator      *validator.Validate
-	logger         *zap.Logger
-	usersUsecase   usecase.UserUseCase
-	clientsUsecase usecase.ClientUseCase
-	formsUsecase   usecase.FormsUseCase
+	validator            *validator.Validate
+	logger               *zap.Logger
+	usersUsecase         usecase.UserUseCase
+	clientsUsecase       usecase.ClientUseCase
+	formsUsecase         usecase.FormsUseCase
+	contractsUsecase     usecase.ContractUseCase
+	customFieldsUsecase  usecase.CustomFieldUseCase
+	portalUsecase        usecase.PortalUseCase
+	attachmentsUsecase   usecase.AttachmentsUseCase
+	commentsUsecase      usecase.CommentsUseCase
+	workLogsUsecase      usecase.WorkLogsUseCase
+	signaturesUsecase    usecase.SignaturesUseCase
+	serviceOrderUsecase  usecase.ServiceOrderUseCase
+	slaUsecase           usecase.SLAUseCase
+	notificationsUsecase usecase.NotificationsUseCase
+	maintenanceUsecase   usecase.MaintenanceUseCase
+	scheduleUsecase      usecase.ScheduleUseCase
+	partsUsecase         usecase.PartsUseCase
+	invoicesUsecase      usecase.InvoicesUseCase
+	similarFormsUsecase  usecase.SimilarFormsUseCase
+	checklistsUsecase    usecase.ChecklistsUseCase
+	formTypesUsecase     usecase.FormTypesUseCase
+	surveysUsecase       usecase.SurveysUseCase
+	dispatchUsecase      usecase.DispatchUseCase
 }
 
-func NewHandlers(logger *zap.Logger, usersUsecase usecase.UserUseCase, clientsUsecase usecase.ClientUseCase, formsUsecase usecase.FormsUseCase) Handlers {
+func NewHandlers(logger *zap.Logger, usersUsecase usecase.UserUseCase, clientsUsecase usecase.ClientUseCase, formsUsecase usecase.FormsUseCase, contractsUsecase usecase.ContractUseCase, customFieldsUsecase usecase.CustomFieldUseCase, portalUsecase usecase.PortalUseCase, attachmentsUsecase usecase.AttachmentsUseCase, commentsUsecase usecase.CommentsUseCase, workLogsUsecase usecase.WorkLogsUseCase, signaturesUsecase usecase.SignaturesUseCase, serviceOrderUsecase usecase.ServiceOrderUseCase, slaUsecase usecase.SLAUseCase, notificationsUsecase usecase.NotificationsUseCase, maintenanceUsecase usecase.MaintenanceUseCase, scheduleUsecase usecase.ScheduleUseCase, partsUsecase usecase.PartsUseCase, invoicesUsecase usecase.InvoicesUseCase, similarFormsUsecase usecase.SimilarFormsUseCase, checklistsUsecase usecase.ChecklistsUseCase, formTypesUsecase usecase.FormTypesUseCase, surveysUsecase usecase.SurveysUseCase, dispatchUsecase usecase.DispatchUseCase) Handlers {
 	validator := validator.New(validator.WithRequiredStructEnabled())
+	validator.RegisterValidation("cpf_cnpj", validators.CpfCnpj)
 	return Handlers{
 		validator,
 		logger,
 		usersUsecase,
 		clientsUsecase,
 		formsUsecase,
+		contractsUsecase,
+		customFieldsUsecase,
+		portalUsecase,
+		attachmentsUsecase,
+		commentsUsecase,
+		workLogsUsecase,
+		signaturesUsecase,
+		serviceOrderUsecase,
+		slaUsecase,
+		notificationsUsecase,
+		maintenanceUsecase,
+		scheduleUsecase,
+		partsUsecase,
+		invoicesUsecase,
+		similarFormsUsecase,
+		checklistsUsecase,
+		formTypesUsecase,
+		surveysUsecase,
+		dispatchUsecase,
 	}
 }
 
@@ -54,8 +97,9 @@ func (api *Handlers) PostCreateClient(w http.ResponseWriter, r *http.Request) *s
 		})
 	}
 
-	id, err := api.clientsUsecase.CreateClient(usecase.CreateClientInput{
+	input := usecase.CreateClientInput{
 		ClientName: payload.NomeCliente,
+		CnpjOrCpf:  payload.CnpjOuCpf,
 		ClientType: payload.TipoCliente.ToValue(),
 		Contact: usecase.ContactPerson{
 			Email:          string(payload.EmailContato),
@@ -74,8 +118,40 @@ func (api *Handlers) PostCreateClient(w http.ResponseWriter, r *http.Request) *s
 			State:        payload.Endereco.Estado,
 			Street:       payload.Endereco.Rua,
 		},
-	}, r.Context())
+		CustomFields: fromSpecCampos(payload.CamposPersonalizados),
+		Tags:         payload.Tags,
+	}
+
+	if payload.IgnorarDuplicados == nil || !*payload.IgnorarDuplicados {
+		candidates, err := api.clientsUsecase.FindDuplicates(input, r.Context())
+		if err != nil {
+			return spec.PostCreateClientJSON500Response(spec.ErrorResponse{
+				Message: ErrInternalError,
+			})
+		}
+		if len(candidates) > 0 {
+			list := make([]spec.CandidatoDuplicado, 0, len(candidates))
+			for _, c := range candidates {
+				list = append(list, spec.CandidatoDuplicado{
+					Cliente:   toSpecClienteResumo(c.Client),
+					Pontuacao: c.Score,
+					Motivos:   toSpecMotivos(c.Reasons),
+				})
+			}
+			return spec.PostCreateClientJSON409Response(spec.AvisoDuplicidade{
+				Message:    "Existem clientes parecidos já cadastrados",
+				Candidatos: list,
+			})
+		}
+	}
+
+	id, err := api.clientsUsecase.CreateClient(input, r.Context())
 	if err != nil {
+		if msg, ok := customFieldErrorMessage(err); ok {
+			return spec.PostCreateClientJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
 		return spec.PostCreateClientJSON500Response(spec.ErrorResponse{
 			Message: ErrInternalError,
 		})
@@ -90,7 +166,7 @@ func (api *Handlers) PostCreateClient(w http.ResponseWriter, r *http.Request) *s
 
 // Get all clients
 // (GET /v1/clients/list)
-func (api *Handlers) GetV1clientsList(w http.ResponseWriter, r *http.Request) *spec.Response {
+func (api *Handlers) GetV1clientsList(w http.ResponseWriter, r *http.Request, params spec.GetV1clientsListParams) *spec.Response {
 	_, err := GetUserIDFromContext(r.Context())
 	if err != nil {
 		return spec.GetV1clientsListJSON500Response(spec.ErrorResponse{
@@ -98,8 +174,20 @@ func (api *Handlers) GetV1clientsList(w http.ResponseWriter, r *http.Request) *s
 		})
 	}
 
-	clients, err := api.clientsUsecase.ListClient(r.Context())
+	filter, ok := parseListFilter(params.Campo, params.Tag)
+	if !ok {
+		return spec.GetV1clientsListJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidCustomFieldArg,
+		})
+	}
+
+	clients, err := api.clientsUsecase.ListClient(filter, r.Context())
 	if err != nil {
+		if msg, ok := customFieldErrorMessage(err); ok {
+			return spec.GetV1clientsListJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
 		return spec.GetV1clientsListJSON500Response(spec.ErrorResponse{
 			Message: ErrInternalError,
 		})
@@ -128,6 +216,8 @@ func (api *Handlers) GetV1clientsList(w http.ResponseWriter, r *http.Request) *s
 				Estado:     client.Address.State,
 				Rua:        client.Address.Street,
 			},
+			CamposPersonalizados: toSpecCampos(client.CustomFields),
+			Tags:                 toSpecTags(client.Tags),
 
 			CreatedAt: client.CreatedAt.UTC(),
 			UpdatedAt: client.UpdatedAt.UTC(),
@@ -208,7 +298,14 @@ func (api *Handlers) PutClient(w http.ResponseWriter, r *http.Request, clientID
 			State:        payload.Endereco.Estado,
 			Street:       payload.Endereco.Rua,
 		},
+		CustomFields: fromSpecCampos(payload.CamposPersonalizados),
+		Tags:         payload.Tags,
 	}, r.Context()); err != nil {
+		if msg, ok := customFieldErrorMessage(err); ok {
+			return spec.PutClientJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
 		return spec.PutClientJSON500Response(spec.ErrorResponse{
 			Message: ErrInternalError,
 		})
@@ -260,8 +357,10 @@ func (api *Handlers) GetByIDClient(w http.ResponseWriter, r *http.Request, clien
 				Estado:     c.Address.State,
 				Rua:        c.Address.Street,
 			},
-			CreatedAt: c.CreatedAt,
-			UpdatedAt: c.UpdatedAt,
+			CamposPersonalizados: toSpecCampos(c.CustomFields),
+			Tags:                 toSpecTags(c.Tags),
+			CreatedAt:            c.CreatedAt,
+			UpdatedAt:            c.UpdatedAt,
 		},
 	})
 }
@@ -269,7 +368,7 @@ func (api *Handlers) GetByIDClient(w http.ResponseWriter, r *http.Request, clien
 // Form client
 // (POST /v1/forms/create)
 func (api *Handlers) PostCreateForm(w http.ResponseWriter, r *http.Request) *spec.Response {
-	_, err := GetUserIDFromContext(r.Context())
+	userID, err := GetUserIDFromContext(r.Context())
 	if err != nil {
 		return spec.PostCreateFormJSON400Response(spec.ErrorResponse{
 			Message: ErrNotAuthorized,
@@ -294,6 +393,26 @@ func (api *Handlers) PostCreateForm(w http.ResponseWriter, r *http.Request) *spe
 		tecIDs = append(tecIDs, uuid.MustParse(tecID))
 	}
 
+	hoursConsumed := decimal.Zero
+	if payload.HorasConsumidas != nil {
+		hoursConsumed = decimal.NewFromFloat(*payload.HorasConsumidas)
+	}
+
+	solution := ""
+	if payload.DescricaoSolucao != nil {
+		solution = *payload.DescricaoSolucao
+	}
+
+	templateID := uuid.Nil
+	if payload.ChecklistModeloID != nil {
+		templateID = uuid.MustParse(*payload.ChecklistModeloID)
+	}
+
+	formTypeID := uuid.Nil
+	if payload.TipoFormularioID != nil {
+		formTypeID = uuid.MustParse(*payload.TipoFormularioID)
+	}
+
 	id, err := api.formsUsecase.CreateForm(usecase.CreateFormInput{
 		TecnicoResponsavelId: tecIDs,
 		DataDeAbertura:       payload.DataOcorrencia,
@@ -301,9 +420,52 @@ func (api *Handlers) PostCreateForm(w http.ResponseWriter, r *http.Request) *spe
 		SolicitedBy:          payload.Solicitante,
 		DifficultyLevel:      payload.NivelDificuldade.ToValue(),
 		DefectDescription:    payload.DescricaoDefeito,
-		SolutionDescription:  payload.DescricaoSolucao,
+		SolutionDescription:  solution,
+		HoursConsumed:        hoursConsumed,
+		CustomFields:         fromSpecCampos(payload.CamposPersonalizados),
+		Tags:                 payload.Tags,
+		ChecklistTemplateID:  templateID,
+		FormTypeID:           formTypeID,
+		FormData:             fromSpecDados(payload.Dados),
+		AutoAssign:           payload.AtribuirAutomaticamente != nil && *payload.AtribuirAutomaticamente,
+		CreatedBy:            userID,
 	}, r.Context())
 	if err != nil {
+		if errors.Is(err, domains.ErrClientNotFound) {
+			return spec.PostCreateFormJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		}
+		if errors.Is(err, domains.ErrChecklistTemplateNotFound) {
+			return spec.PostCreateFormJSON400Response(spec.ErrorResponse{
+				Message: ErrChecklistTemplateNotFound,
+			})
+		}
+		if errors.Is(err, domains.ErrFormTypeNotFound) {
+			return spec.PostCreateFormJSON400Response(spec.ErrorResponse{
+				Message: ErrFormTypeNotFound,
+			})
+		}
+		if errors.Is(err, domains.ErrFormTechniciansRequired) {
+			return spec.PostCreateFormJSON400Response(spec.ErrorResponse{
+				Message: ErrFormTechniciansRequired,
+			})
+		}
+		if errors.Is(err, domains.ErrNoDispatchCandidates) {
+			return spec.PostCreateFormJSON400Response(spec.ErrorResponse{
+				Message: ErrNoDispatchCandidates,
+			})
+		}
+		if msg, ok := formTypeErrorMessage(err); ok {
+			return spec.PostCreateFormJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
+		if msg, ok := customFieldErrorMessage(err); ok {
+			return spec.PostCreateFormJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
 		return spec.PostCreateFormJSON500Response(spec.ErrorResponse{
 			Message: ErrInternalError,
 		})
@@ -318,14 +480,26 @@ func (api *Handlers) PostCreateForm(w http.ResponseWriter, r *http.Request) *spe
 // Delete form
 // (DELETE /v1/forms/delete/{formID})
 func (api *Handlers) DeleteForm(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
-	_, err := GetUserIDFromContext(r.Context())
+	userID, err := GetUserIDFromContext(r.Context())
 	if err != nil {
 		return spec.DeleteFormJSON400Response(spec.ErrorResponse{
 			Message: ErrNotAuthorized,
 		})
 	}
 
-	if err := api.formsUsecase.DeleteForm(uuid.MustParse(formID), r.Context()); err != nil {
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.DeleteFormJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	if err := api.formsUsecase.DeleteForm(uuid.MustParse(formID), admin, r.Context()); err != nil {
+		if errors.Is(err, domains.ErrFormSigned) {
+			return spec.DeleteFormJSON403Response(spec.ErrorResponse{
+				Message: ErrFormSigned,
+			})
+		}
 		return spec.DeleteFormJSON500Response(spec.ErrorResponse{
 			Message: ErrInternalError,
 		})
@@ -338,7 +512,7 @@ func (api *Handlers) DeleteForm(w http.ResponseWriter, r *http.Request, formID s
 
 // List forms
 // (GET /v1/forms/list)
-func (api *Handlers) ListForms(w http.ResponseWriter, r *http.Request) *spec.Response {
+func (api *Handlers) ListForms(w http.ResponseWriter, r *http.Request, params spec.ListFormsParams) *spec.Response {
 	_, err := GetUserIDFromContext(r.Context())
 	if err != nil {
 		return spec.ListFormsJSON401Response(spec.ErrorResponse{
@@ -346,8 +520,33 @@ func (api *Handlers) ListForms(w http.ResponseWriter, r *http.Request) *spec.Res
 		})
 	}
 
-	rawForms, err := api.formsUsecase.ListForms(r.Context())
+	filter, ok := parseListFilter(params.Campo, params.Tag)
+	if !ok {
+		return spec.ListFormsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidCustomFieldArg,
+		})
+	}
+
+	input, ok := parseListFormsParams(params)
+	if !ok {
+		return spec.ListFormsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidFormListFilter,
+		})
+	}
+	input.ListFilterInput = filter
+
+	rawForms, err := api.formsUsecase.ListForms(input, r.Context())
 	if err != nil {
+		if msg, ok := customFieldErrorMessage(err); ok {
+			return spec.ListFormsJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
+		if isFormListFilterError(err) {
+			return spec.ListFormsJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidFormListFilter,
+			})
+		}
 		return spec.ListFormsJSON500Response(spec.ErrorResponse{
 			Message: ErrInternalError,
 		})
@@ -355,36 +554,110 @@ func (api *Handlers) ListForms(w http.ResponseWriter, r *http.Request) *spec.Res
 
 	listForm := make([]spec.Formulario, 0, len(rawForms.Forms))
 	for _, f := range rawForms.Forms {
-		listTecnicos := make([]spec.Tecnico, 0, len(f.TecnicoResponsavelId))
-		for _, t := range f.TecnicoResponsavelId {
-			listTecnicos = append(listTecnicos, spec.Tecnico{
-				ID:   t.ID.String(),
-				Nome: t.Name,
+		listForm = append(listForm, toSpecFormulario(f))
+	}
+
+	lista := spec.ListaFormulario{
+		Formularios: listForm,
+	}
+	if rawForms.NextCursor != uuid.Nil {
+		cursor := rawForms.NextCursor.String()
+		lista.ProximoCursor = &cursor
+	}
+
+	return spec.ListFormsJSON200Response(lista)
+}
+
+// Search forms
+// (GET /v1/forms/search)
+func (api *Handlers) SearchForms(w http.ResponseWriter, r *http.Request, params spec.SearchFormsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.SearchFormsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	filter, ok := parseListFilter(params.Campo, params.Tag)
+	if !ok {
+		return spec.SearchFormsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidCustomFieldArg,
+		})
+	}
+
+	listParams := spec.ListFormsParams{
+		ClienteID:   params.ClienteID,
+		TecnicoID:   params.TecnicoID,
+		Status:      params.Status,
+		OcorridoDe:  params.OcorridoDe,
+		OcorridoAte: params.OcorridoAte,
+		Protocolo:   params.Protocolo,
+		Limite:      params.Limite,
+	}
+	if params.NivelDificuldade != nil {
+		level := spec.ListFormsParamsNivelDificuldade(*params.NivelDificuldade)
+		listParams.NivelDificuldade = &level
+	}
+	listInput, ok := parseListFormsParams(listParams)
+	if !ok || (params.Pagina != nil && *params.Pagina < 1) {
+		return spec.SearchFormsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidFormListFilter,
+		})
+	}
+	listInput.ListFilterInput = filter
+
+	input := usecase.SearchFormsInput{
+		ListFormsInput: listInput,
+		Query:          params.Q,
+	}
+	if params.Pagina != nil {
+		input.Page = *params.Pagina
+	}
+
+	output, err := api.formsUsecase.SearchForms(input, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidSearchQuery) {
+			return spec.SearchFormsJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSearchQuery,
+			})
+		}
+		if msg, ok := customFieldErrorMessage(err); ok {
+			return spec.SearchFormsJSON400Response(spec.ErrorResponse{
+				Message: msg,
 			})
 		}
+		if isFormListFilterError(err) {
+			return spec.SearchFormsJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidFormListFilter,
+			})
+		}
+		return spec.SearchFormsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
 
-		listForm = append(listForm, spec.Formulario{
-			ID:                  f.ID.String(),
-			DataOcorrencia:      f.DataDeAbertura,
-			Solicitante:         f.SolicitedBy,
-			NivelDificuldade:    getLevel(f.DifficultyLevel),
-			DescricaoDefeito:    f.DefectDescription,
-			DescricaoSolucao:    f.SolutionDescription,
-			TecnicosResponsavel: listTecnicos,
-			UpdatedAt:           f.UpdatedAt.UTC(),
-			CreatedAt:           f.CreatedAt.UTC(),
+	resultados := make([]spec.ResultadoBuscaAtendimento, 0, len(output.Results))
+	for _, res := range output.Results {
+		resultados = append(resultados, spec.ResultadoBuscaAtendimento{
+			Formulario:    toSpecFormulario(res.Form),
+			Relevancia:    res.Rank,
+			TrechoDefeito: res.DefectSnippet,
+			TrechoSolucao: res.SolutionSnippet,
 		})
 	}
 
-	return spec.ListFormsJSON200Response(spec.ListaFormulario{
-		Formularios: listForm,
+	return spec.SearchFormsJSON200Response(spec.BuscaAtendimentos{
+		Resultados: resultados,
+		Total:      output.Total,
+		Pagina:     output.Page,
+		Limite:     output.Limit,
 	})
 }
 
 // Update form
 // (PUT /v1/forms/update/{formID})
 func (api *Handlers) PutForm(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
-	_, err := GetUserIDFromContext(r.Context())
+	userID, err := GetUserIDFromContext(r.Context())
 	if err != nil {
 		return spec.PutFormJSON401Response(spec.ErrorResponse{
 			Message: ErrNotAuthorized,
@@ -408,6 +681,19 @@ func (api *Handlers) PutForm(w http.ResponseWriter, r *http.Request, formID stri
 		tecIDs = append(tecIDs, uuid.MustParse(tecID))
 	}
 
+	var hoursConsumed *decimal.Decimal
+	if payload.HorasConsumidas != nil {
+		h := decimal.NewFromFloat(*payload.HorasConsumidas)
+		hoursConsumed = &h
+	}
+
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.PutFormJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
 	if err := api.formsUsecase.UpdateForm(
 		uuid.MustParse(formID),
 		usecase.UpdateFormInput{
@@ -417,9 +703,35 @@ func (api *Handlers) PutForm(w http.ResponseWriter, r *http.Request, formID stri
 			TecnicoResponsavelId: tecIDs,
 			DefectDescription:    payload.DescricaoDefeito,
 			SolutionDescription:  payload.DescricaoSolucao,
+			HoursConsumed:        hoursConsumed,
+			CustomFields:         fromSpecCampos(payload.CamposPersonalizados),
+			Tags:                 payload.Tags,
+			FormData:             fromSpecDados(payload.Dados),
+			UpdatedBy:            userID,
+			Admin:                admin,
 		},
 		r.Context(),
 	); err != nil {
+		if errors.Is(err, domains.ErrFormSigned) {
+			return spec.PutFormJSON403Response(spec.ErrorResponse{
+				Message: ErrFormSigned,
+			})
+		}
+		if errors.Is(err, domains.ErrClientNotFound) {
+			return spec.PutFormJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		}
+		if msg, ok := customFieldErrorMessage(err); ok {
+			return spec.PutFormJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
+		if msg, ok := formTypeErrorMessage(err); ok {
+			return spec.PutFormJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
 		return spec.PutFormJSON500Response(spec.ErrorResponse{
 			Message: ErrInternalError,
 		})
@@ -447,28 +759,73 @@ func (api *Handlers) GetFormByID(w http.ResponseWriter, r *http.Request, formID
 		})
 	}
 
-	listTecnicos := make([]spec.Tecnico, 0, len(f.Form.TecnicoResponsavelId))
-	for _, t := range f.Form.TecnicoResponsavelId {
+	return spec.GetFormByIDJSON200Response(spec.BuscaFormulario{
+		Formulario: toSpecFormulario(f.Form),
+	})
+}
+
+// Get form by protocol code
+// (GET /v1/forms/by-code/{code})
+func (api *Handlers) GetFormByCode(w http.ResponseWriter, r *http.Request, code string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetFormByCodeJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	f, err := api.formsUsecase.GetFormByCode(code, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrInvalidFormCode):
+			return spec.GetFormByCodeJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidFormCode,
+			})
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.GetFormByCodeJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.GetFormByCodeJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetFormByCodeJSON200Response(spec.BuscaFormulario{
+		Formulario: toSpecFormulario(f.Form),
+	})
+}
+
+func toSpecFormulario(f usecase.FormsOutput) spec.Formulario {
+	listTecnicos := make([]spec.Tecnico, 0, len(f.TecnicoResponsavelId))
+	for _, t := range f.TecnicoResponsavelId {
 		listTecnicos = append(listTecnicos, spec.Tecnico{
 			ID:   t.ID.String(),
 			Nome: t.Name,
 		})
 	}
 
-	return spec.GetFormByIDJSON200Response(spec.BuscaFormulario{
-		Formulario: spec.Formulario{
-			ID:                  f.Form.ID.String(),
-			DataOcorrencia:      f.Form.DataDeAbertura,
-			Solicitante:         f.Form.SolicitedBy,
-			NivelDificuldade:    getLevel(f.Form.DifficultyLevel),
-			DescricaoDefeito:    f.Form.DefectDescription,
-			DescricaoSolucao:    f.Form.SolutionDescription,
-			TecnicosResponsavel: listTecnicos,
-			UpdatedAt:           f.Form.UpdatedAt.UTC(),
-			CreatedAt:           f.Form.CreatedAt.UTC(),
-		},
-	})
-
+	return spec.Formulario{
+		ID:                   f.ID.String(),
+		Protocolo:            f.PublicCode,
+		DataOcorrencia:       f.DataDeAbertura,
+		Solicitante:          f.SolicitedBy,
+		NivelDificuldade:     getLevel(f.DifficultyLevel),
+		DescricaoDefeito:     f.DefectDescription,
+		DescricaoSolucao:     f.SolutionDescription,
+		Status:               toSpecStatusAtendimento(f.Status),
+		TecnicosResponsavel:  listTecnicos,
+		HorasConsumidas:      f.HoursConsumed.InexactFloat64(),
+		ContratoID:           getContractID(f.ContractID),
+		CamposPersonalizados: toSpecCampos(f.CustomFields),
+		Tags:                 toSpecTags(f.Tags),
+		SLA:                  toSpecSLAAtendimento(f.SLA),
+		TipoFormularioID:     getFormTypeID(f.FormTypeID),
+		VersaoTipoFormulario: getFormTypeVersion(f.FormTypeVersion),
+		Dados:                toSpecDados(f.FormData),
+		UpdatedAt:            f.UpdatedAt.UTC(),
+		CreatedAt:            f.CreatedAt.UTC(),
+	}
 }
 
 // Get members
@@ -679,3 +1036,26 @@ func getClientType(clientType string) spec.ClienteTipoCliente {
 		return spec.ClienteTipoClienteAvulso
 	}
 }
+
+func getContractID(id uuid.UUID) *string {
+	if id == uuid.Nil {
+		return nil
+	}
+	contractID := id.String()
+	return &contractID
+}
+
+func getFormTypeID(id uuid.UUID) *string {
+	if id == uuid.Nil {
+		return nil
+	}
+	formTypeID := id.String()
+	return &formTypeID
+}
+
+func getFormTypeVersion(version int) *int {
+	if version == 0 {
+		return nil
+	}
+	return &version
+}
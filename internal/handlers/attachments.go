@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAttachmentMissing        = "Envie o arquivo no campo \"arquivo\" (multipart/form-data)"
+	ErrAttachmentTooLarge       = "Arquivo maior que o limite de 20 MiB"
+	ErrAttachmentTypeNotAllowed = "Tipo de arquivo não permitido: envie imagens (JPEG, PNG, GIF, WebP), PDF ou texto"
+	ErrAttachmentEmpty          = "Arquivo vazio ou sem nome"
+)
+
+// attachmentFormField é o campo do multipart que traz o arquivo.
+const attachmentFormField = "arquivo"
+
+// attachmentUploadTimeout substitui os 5s do servidor no upload: 20 MiB por
+// rede móvel levam bem mais que isso.
+const attachmentUploadTimeout = 5 * time.Minute
+
+// Upload form attachment
+// (POST /v1/forms/{formID}/attachments)
+func (api *Handlers) PostFormAttachment(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostFormAttachmentJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.PostFormAttachmentJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	// Sem suporte a prazos (ex.: httptest) o upload segue com os do servidor.
+	rc := http.NewResponseController(w)
+	deadline := time.Now().Add(attachmentUploadTimeout)
+	_ = rc.SetReadDeadline(deadline)
+	_ = rc.SetWriteDeadline(deadline)
+
+	// Folga de 1 MiB para os cabeçalhos do multipart; o limite do arquivo em
+	// si é conferido no usecase.
+	r.Body = http.MaxBytesReader(w, r.Body, domains.MaxAttachmentSize+1<<20)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return spec.PostFormAttachmentJSON400Response(spec.ErrorResponse{
+			Message: ErrAttachmentMissing,
+		})
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				return spec.PostFormAttachmentJSON413Response(spec.ErrorResponse{
+					Message: ErrAttachmentTooLarge,
+				})
+			}
+			return spec.PostFormAttachmentJSON400Response(spec.ErrorResponse{
+				Message: ErrAttachmentMissing,
+			})
+		}
+		if part.FormName() != attachmentFormField || part.FileName() == "" {
+			_, _ = io.Copy(io.Discard, part)
+			continue
+		}
+
+		attachment, err := api.attachmentsUsecase.UploadAttachment(usecase.UploadAttachmentInput{
+			FormID:     uuid.MustParse(formID),
+			FileName:   part.FileName(),
+			Content:    part,
+			UploadedBy: userID,
+			Admin:      admin,
+		}, r.Context())
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			switch {
+			case errors.Is(err, domains.ErrFormNotFound):
+				return spec.PostFormAttachmentJSON404Response(spec.ErrorResponse{
+					Message: ErrNotFound,
+				})
+			case errors.Is(err, domains.ErrFormSigned):
+				return spec.PostFormAttachmentJSON403Response(spec.ErrorResponse{
+					Message: ErrFormSigned,
+				})
+			case errors.Is(err, domains.ErrAttachmentTooLarge), errors.As(err, &maxErr):
+				return spec.PostFormAttachmentJSON413Response(spec.ErrorResponse{
+					Message: ErrAttachmentTooLarge,
+				})
+			case errors.Is(err, domains.ErrAttachmentTypeNotAllowed):
+				return spec.PostFormAttachmentJSON415Response(spec.ErrorResponse{
+					Message: ErrAttachmentTypeNotAllowed,
+				})
+			case errors.Is(err, domains.ErrEmptyAttachment), errors.Is(err, domains.ErrInvalidAttachmentName):
+				return spec.PostFormAttachmentJSON400Response(spec.ErrorResponse{
+					Message: ErrAttachmentEmpty,
+				})
+			}
+			return spec.PostFormAttachmentJSON500Response(spec.ErrorResponse{
+				Message: ErrInternalError,
+			})
+		}
+
+		return spec.PostFormAttachmentJSON201Response(toSpecAnexo(*attachment))
+	}
+}
+
+// List form attachments
+// (GET /v1/forms/{formID}/attachments)
+func (api *Handlers) ListFormAttachments(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListFormAttachmentsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	attachments, err := api.attachmentsUsecase.ListAttachments(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.ListFormAttachmentsJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.ListFormAttachmentsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	anexos := make([]spec.AnexoAtendimento, 0, len(attachments))
+	for _, a := range attachments {
+		anexos = append(anexos, toSpecAnexo(a))
+	}
+
+	return spec.ListFormAttachmentsJSON200Response(spec.ListaAnexos{
+		Anexos: anexos,
+	})
+}
+
+// Delete form attachment
+// (DELETE /v1/forms/{formID}/attachments/{attachmentID})
+func (api *Handlers) DeleteFormAttachment(w http.ResponseWriter, r *http.Request, formID string, attachmentID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteFormAttachmentJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.DeleteFormAttachmentJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	if err := api.attachmentsUsecase.DeleteAttachment(uuid.MustParse(formID), uuid.MustParse(attachmentID), admin, r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrAttachmentNotFound):
+			return spec.DeleteFormAttachmentJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrFormSigned):
+			return spec.DeleteFormAttachmentJSON403Response(spec.ErrorResponse{
+				Message: ErrFormSigned,
+			})
+		}
+		return spec.DeleteFormAttachmentJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteFormAttachmentJSON204Response(spec.Resp204{
+		Message: "Anexo removido com sucesso",
+	})
+}
+
+func toSpecAnexo(a usecase.AttachmentOutput) spec.AnexoAtendimento {
+	anexo := spec.AnexoAtendimento{
+		ID:             a.ID.String(),
+		NomeArquivo:    a.FileName,
+		TipoConteudo:   a.ContentType,
+		Tamanho:        a.Size,
+		Sha256:         a.Checksum,
+		NomeEnviadoPor: a.UploadedByName,
+		CreatedAt:      a.CreatedAt.UTC(),
+		URLDownload:    a.DownloadURL,
+		URLExpiraEm:    a.URLExpiresAt.UTC(),
+	}
+	if a.UploadedBy != uuid.Nil {
+		uploadedBy := a.UploadedBy.String()
+		anexo.EnviadoPor = &uploadedBy
+	}
+	return anexo
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"olidesk-api-2/internal/utils/tokens"
 	"os"
 	"strings"
 
@@ -16,28 +17,50 @@ import (
 type ContextKey string
 
 const (
-	UserIDKey ContextKey = "user_id"
+	UserIDKey       ContextKey = "user_id"
+	ClientUserIDKey ContextKey = "client_user_id"
 )
 
+// portalRoutePrefix agrupa as rotas do portal do cliente. Tokens de usuários
+// do portal só são aceitos nelas, e tokens da equipe não são aceitos nelas.
+const portalRoutePrefix = "/api/v1/portal/"
+
 // CustomClaims define as claims customizadas do JWT
 type CustomClaims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
+	UserID   string `json:"user_id"`
+	Email    string `json:"email"`
+	Scope    string `json:"scope,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // publicRoutes é a lista de rotas que não exigem autenticação
 var publicRoutes = map[string]bool{
-	"/api/v1/users/login": true,
+	"/api/v1/users/login":  true,
+	"/api/v1/portal/login": true,
+}
+
+// publicRoutePrefixes são prefixos de rotas públicas que terminam com um
+// parâmetro, como o token da pesquisa de satisfação
+var publicRoutePrefixes = []string{
+	"/api/v1/surveys/respond/",
 }
 
 // isPublicRoute verifica se uma rota é pública
 func isPublicRoute(path string) bool {
-	return publicRoutes[path]
+	if publicRoutes[path] {
+		return true
+	}
+	for _, prefix := range publicRoutePrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 // JWTMiddleware valida o token JWT e injeta o user ID no contexto
-// Rotas públicas definidas em publicRoutes não exigem autenticação
+// Rotas públicas definidas em publicRoutes e publicRoutePrefixes não exigem autenticação
 func JWTMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Verifica se a rota é pública
@@ -89,6 +112,23 @@ func JWTMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
+		// Separa os usuários do portal da equipe interna: cada tipo de token
+		// só acessa o seu grupo de rotas e usa uma chave própria no contexto
+		isPortalRoute := strings.HasPrefix(r.URL.Path, portalRoutePrefix)
+		if claims.Scope == tokens.ScopeClient {
+			if !isPortalRoute {
+				writeErrorResponse(w, "Usuários do portal só podem acessar as rotas do portal", http.StatusForbidden)
+				return
+			}
+			ctx := context.WithValue(r.Context(), ClientUserIDKey, claims.UserID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+		if isPortalRoute {
+			writeErrorResponse(w, "Rota exclusiva do portal do cliente", http.StatusForbidden)
+			return
+		}
+
 		// Injeta o user ID no contexto
 		ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
 
@@ -106,6 +146,25 @@ func GetUserIDFromContext(ctx context.Context) (uuid.UUID, error) {
 	return uuid.MustParse(userID), nil
 }
 
+// GetClientUserIDFromContext extrai o ID do usuário do portal do contexto da
+// requisição
+func GetClientUserIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	clientUserID, ok := ctx.Value(ClientUserIDKey).(string)
+	if !ok || clientUserID == "" {
+		return uuid.Nil, fmt.Errorf("client_user_id não encontrado no contexto")
+	}
+	return uuid.Parse(clientUserID)
+}
+
+// isAdmin verifica se o usuário autenticado possui o cargo de administrador
+func (api *Handlers) isAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	user, err := api.usersUsecase.GetUser(userID, ctx)
+	if err != nil {
+		return false, err
+	}
+	return user.Role == "administrador", nil
+}
+
 // writeErrorResponse escreve uma resposta de erro em JSON
 func writeErrorResponse(w http.ResponseWriter, message string, statusCode int) {
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,400 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrChecklistItemNotFound      = "Item da lista de verificação não encontrado"
+	ErrInvalidChecklistTemplate   = "Modelo de lista de verificação inválido: unidade e faixa valem só para itens numéricos e o mínimo não pode passar do máximo"
+	ErrChecklistTemplateNotFound  = "Modelo de lista de verificação não encontrado"
+	ErrChecklistTemplateNameTaken = "Já existe um modelo de lista de verificação com esse nome"
+	ErrInvalidChecklistAnswer     = "Resposta inválida para o tipo do item da lista de verificação"
+	ErrChecklistPhotoNotImage     = "A foto do item deve ser uma imagem anexada ao atendimento"
+	ErrChecklistIncomplete        = "Responda os itens obrigatórios da lista de verificação antes de resolver o atendimento"
+)
+
+// Create checklist template
+// (POST /v1/checklist-templates/create)
+func (api *Handlers) PostCreateChecklistTemplate(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostCreateChecklistTemplateJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostCreateChecklistTemplateJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.CriarModeloChecklist
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostCreateChecklistTemplateJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostCreateChecklistTemplateJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	id, err := api.checklistsUsecase.CreateTemplate(fromSpecModeloChecklist(payload), r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrChecklistTemplateNameTaken):
+			return spec.PostCreateChecklistTemplateJSON409Response(spec.ErrorResponse{
+				Message: ErrChecklistTemplateNameTaken,
+			})
+		case errors.Is(err, domains.ErrInvalidChecklistTemplate):
+			return spec.PostCreateChecklistTemplateJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidChecklistTemplate,
+			})
+		}
+		return spec.PostCreateChecklistTemplateJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostCreateChecklistTemplateJSON201Response(spec.Resp200{
+		Message: "Modelo de lista de verificação criado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List checklist templates
+// (GET /v1/checklist-templates/list)
+func (api *Handlers) ListChecklistTemplates(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListChecklistTemplatesJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.checklistsUsecase.ListTemplates(r.Context())
+	if err != nil {
+		return spec.ListChecklistTemplatesJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	modelos := make([]spec.ModeloChecklist, 0, len(output.Templates))
+	for _, t := range output.Templates {
+		modelos = append(modelos, toSpecModeloChecklist(t))
+	}
+
+	return spec.ListChecklistTemplatesJSON200Response(spec.ListaModelosChecklist{
+		Modelos: modelos,
+	})
+}
+
+// Get checklist template
+// (GET /v1/checklist-templates/{templateID})
+func (api *Handlers) GetChecklistTemplate(w http.ResponseWriter, r *http.Request, templateID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetChecklistTemplateJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.checklistsUsecase.GetTemplate(uuid.MustParse(templateID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrChecklistTemplateNotFound) {
+			return spec.GetChecklistTemplateJSON404Response(spec.ErrorResponse{
+				Message: ErrChecklistTemplateNotFound,
+			})
+		}
+		return spec.GetChecklistTemplateJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetChecklistTemplateJSON200Response(toSpecModeloChecklist(*output))
+}
+
+// Update checklist template
+// (PUT /v1/checklist-templates/update/{templateID})
+func (api *Handlers) PutChecklistTemplate(w http.ResponseWriter, r *http.Request, templateID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutChecklistTemplateJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutChecklistTemplateJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.CriarModeloChecklist
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutChecklistTemplateJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutChecklistTemplateJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	err = api.checklistsUsecase.UpdateTemplate(uuid.MustParse(templateID), fromSpecModeloChecklist(payload), r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrChecklistTemplateNotFound):
+			return spec.PutChecklistTemplateJSON404Response(spec.ErrorResponse{
+				Message: ErrChecklistTemplateNotFound,
+			})
+		case errors.Is(err, domains.ErrChecklistTemplateNameTaken):
+			return spec.PutChecklistTemplateJSON409Response(spec.ErrorResponse{
+				Message: ErrChecklistTemplateNameTaken,
+			})
+		case errors.Is(err, domains.ErrInvalidChecklistTemplate):
+			return spec.PutChecklistTemplateJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidChecklistTemplate,
+			})
+		}
+		return spec.PutChecklistTemplateJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutChecklistTemplateJSON204Response(spec.Resp204{
+		Message: "Modelo de lista de verificação atualizado com sucesso",
+	})
+}
+
+// Delete checklist template
+// (DELETE /v1/checklist-templates/delete/{templateID})
+func (api *Handlers) DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request, templateID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteChecklistTemplateJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.DeleteChecklistTemplateJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	if err := api.checklistsUsecase.DeleteTemplate(uuid.MustParse(templateID), r.Context()); err != nil {
+		if errors.Is(err, domains.ErrChecklistTemplateNotFound) {
+			return spec.DeleteChecklistTemplateJSON404Response(spec.ErrorResponse{
+				Message: ErrChecklistTemplateNotFound,
+			})
+		}
+		return spec.DeleteChecklistTemplateJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteChecklistTemplateJSON204Response(spec.Resp204{
+		Message: "Modelo de lista de verificação excluído com sucesso",
+	})
+}
+
+// List form checklist
+// (GET /v1/forms/{formID}/checklist)
+func (api *Handlers) ListFormChecklist(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListFormChecklistJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.checklistsUsecase.ListChecklist(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.ListFormChecklistJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.ListFormChecklistJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	itens := make([]spec.ItemChecklist, 0, len(output.Items))
+	for _, item := range output.Items {
+		itens = append(itens, toSpecItemChecklist(item))
+	}
+
+	return spec.ListFormChecklistJSON200Response(spec.ListaItensChecklist{
+		Itens:                 itens,
+		PendentesObrigatorios: output.PendingRequired,
+		ForaDaFaixa:           output.OutOfRange,
+	})
+}
+
+// Answer checklist item
+// (PUT /v1/forms/{formID}/checklist/{itemID})
+func (api *Handlers) PutFormChecklistItem(w http.ResponseWriter, r *http.Request, formID string, itemID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutFormChecklistItemJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.MarcarItemChecklist
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutFormChecklistItemJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutFormChecklistItemJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.PutFormChecklistItemJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	input := usecase.AnswerChecklistItemInput{
+		FormID: uuid.MustParse(formID),
+		ItemID: uuid.MustParse(itemID),
+		Done:   payload.Feito,
+		Passed: payload.Aprovado,
+		Number: payload.Valor,
+		Text:   payload.Texto,
+		UserID: userID,
+		Admin:  admin,
+	}
+	if payload.AnexoID != nil {
+		input.AttachmentID = uuid.MustParse(*payload.AnexoID)
+	}
+
+	output, err := api.checklistsUsecase.AnswerChecklistItem(input, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PutFormChecklistItemJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrChecklistItemNotFound):
+			return spec.PutFormChecklistItemJSON404Response(spec.ErrorResponse{
+				Message: ErrChecklistItemNotFound,
+			})
+		case errors.Is(err, domains.ErrFormSigned):
+			return spec.PutFormChecklistItemJSON403Response(spec.ErrorResponse{
+				Message: ErrFormSigned,
+			})
+		case errors.Is(err, domains.ErrInvalidChecklistAnswer):
+			return spec.PutFormChecklistItemJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidChecklistAnswer,
+			})
+		case errors.Is(err, domains.ErrChecklistPhotoNotImage),
+			errors.Is(err, domains.ErrAttachmentNotFound):
+			return spec.PutFormChecklistItemJSON400Response(spec.ErrorResponse{
+				Message: ErrChecklistPhotoNotImage,
+			})
+		}
+		return spec.PutFormChecklistItemJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutFormChecklistItemJSON200Response(toSpecItemChecklist(*output))
+}
+
+func fromSpecModeloChecklist(payload spec.CriarModeloChecklist) usecase.ChecklistTemplateInput {
+	input := usecase.ChecklistTemplateInput{
+		Name:  payload.Nome,
+		Items: make([]usecase.ChecklistTemplateItemInput, 0, len(payload.Itens)),
+	}
+	if payload.Descricao != nil {
+		input.Description = *payload.Descricao
+	}
+	for _, i := range payload.Itens {
+		item := usecase.ChecklistTemplateItemInput{
+			Label: i.Descricao,
+			Kind:  i.Tipo.ToValue(),
+			Min:   i.Minimo,
+			Max:   i.Maximo,
+		}
+		if i.Obrigatorio != nil {
+			item.Required = *i.Obrigatorio
+		}
+		if i.Unidade != nil {
+			item.Unit = *i.Unidade
+		}
+		input.Items = append(input.Items, item)
+	}
+	return input
+}
+
+func toSpecModeloChecklist(t usecase.ChecklistTemplateOutput) spec.ModeloChecklist {
+	itens := make([]spec.ItemModeloChecklist, 0, len(t.Items))
+	for _, i := range t.Items {
+		var tipo spec.TipoItemModeloChecklist
+		_ = tipo.FromValue(i.Kind)
+		required := i.Required
+		itens = append(itens, spec.ItemModeloChecklist{
+			Descricao:   i.Label,
+			Tipo:        tipo,
+			Obrigatorio: &required,
+			Unidade:     optionalString(i.Unit),
+			Minimo:      i.Min,
+			Maximo:      i.Max,
+		})
+	}
+	return spec.ModeloChecklist{
+		ID:        t.ID.String(),
+		Nome:      t.Name,
+		Descricao: t.Description,
+		Itens:     itens,
+		CreatedAt: t.CreatedAt.UTC(),
+		UpdatedAt: t.UpdatedAt.UTC(),
+	}
+}
+
+func toSpecItemChecklist(i usecase.ChecklistItemOutput) spec.ItemChecklist {
+	item := spec.ItemChecklist{
+		ID:          i.ID.String(),
+		Posicao:     i.Position,
+		Descricao:   i.Label,
+		Tipo:        i.Kind,
+		Obrigatorio: i.Required,
+		Unidade:     i.Unit,
+		Minimo:      i.Min,
+		Maximo:      i.Max,
+		Feito:       i.Done,
+		Aprovado:    i.Passed,
+		Valor:       i.Number,
+		Texto:       i.Text,
+		ForaDaFaixa: i.OutOfRange,
+	}
+	if i.AttachmentID != uuid.Nil {
+		attachmentID := i.AttachmentID.String()
+		item.AnexoID = &attachmentID
+	}
+	if i.DoneBy != uuid.Nil {
+		doneBy := i.DoneBy.String()
+		item.FeitoPor = &doneBy
+	}
+	if !i.DoneAt.IsZero() {
+		doneAt := i.DoneAt.UTC()
+		item.FeitoEm = &doneAt
+	}
+	return item
+}
@@ -0,0 +1,301 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidComment   = "Comentário inválido: informe um texto com até 10000 caracteres"
+	ErrCommentForbidden = "Apenas o autor ou um administrador pode editar o comentário"
+)
+
+// Create form comment
+// (POST /v1/forms/{formID}/comments)
+func (api *Handlers) PostFormComment(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostFormCommentJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.CriarComentario
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostFormCommentJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostFormCommentJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidComment,
+		})
+	}
+
+	internal := false
+	if payload.Interno != nil {
+		internal = *payload.Interno
+	}
+
+	id, err := api.commentsUsecase.CreateComment(uuid.MustParse(formID), usecase.CreateCommentInput{
+		AuthorID: userID,
+		Body:     payload.Corpo,
+		Internal: internal,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PostFormCommentJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrInvalidCommentBody):
+			return spec.PostFormCommentJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidComment,
+			})
+		}
+		return spec.PostFormCommentJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostFormCommentJSON201Response(spec.Resp200{
+		Message: "Comentário criado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List form comments
+// (GET /v1/forms/{formID}/comments)
+func (api *Handlers) ListFormComments(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListFormCommentsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	comments, err := api.commentsUsecase.ListComments(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.ListFormCommentsJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.ListFormCommentsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.ListFormCommentsJSON200Response(toSpecListaComentarios(comments.Comments))
+}
+
+// Edit form comment
+// (PUT /v1/forms/{formID}/comments/{commentID})
+func (api *Handlers) PutFormComment(w http.ResponseWriter, r *http.Request, formID string, commentID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutFormCommentJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.EditarComentario
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutFormCommentJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutFormCommentJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidComment,
+		})
+	}
+
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.PutFormCommentJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	if err := api.commentsUsecase.EditComment(
+		uuid.MustParse(formID),
+		uuid.MustParse(commentID),
+		usecase.EditCommentInput{
+			Body:     payload.Corpo,
+			Internal: payload.Interno,
+			EditedBy: userID,
+			Admin:    admin,
+		},
+		r.Context(),
+	); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrCommentNotFound):
+			return spec.PutFormCommentJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrCommentForbidden):
+			return spec.PutFormCommentJSON403Response(spec.ErrorResponse{
+				Message: ErrCommentForbidden,
+			})
+		case errors.Is(err, domains.ErrInvalidCommentBody):
+			return spec.PutFormCommentJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidComment,
+			})
+		}
+		return spec.PutFormCommentJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutFormCommentJSON204Response(spec.Resp204{
+		Message: "Comentário atualizado com sucesso",
+	})
+}
+
+// List comment edits
+// (GET /v1/forms/{formID}/comments/{commentID}/edits)
+func (api *Handlers) ListFormCommentEdits(w http.ResponseWriter, r *http.Request, formID string, commentID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListFormCommentEditsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	history, err := api.commentsUsecase.ListCommentEdits(uuid.MustParse(formID), uuid.MustParse(commentID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrCommentNotFound) {
+			return spec.ListFormCommentEditsJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.ListFormCommentEditsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	edicoes := make([]spec.EdicaoComentario, 0, len(history.Edits))
+	for _, e := range history.Edits {
+		edicao := spec.EdicaoComentario{
+			ID:              e.ID.String(),
+			CorpoAnterior:   e.PreviousBody,
+			InternoAnterior: e.PreviousInternal,
+			NomeEditadoPor:  e.EditedByName,
+			EditadoEm:       e.EditedAt.UTC(),
+		}
+		if e.EditedBy != uuid.Nil {
+			editedBy := e.EditedBy.String()
+			edicao.EditadoPor = &editedBy
+		}
+		edicoes = append(edicoes, edicao)
+	}
+
+	return spec.ListFormCommentEditsJSON200Response(spec.ListaEdicoesComentario{
+		Edicoes: edicoes,
+	})
+}
+
+// Get form timeline
+// (GET /v1/forms/{formID}/timeline)
+func (api *Handlers) GetFormTimeline(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetFormTimelineJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	timeline, err := api.commentsUsecase.GetTimeline(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.GetFormTimelineJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.GetFormTimelineJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	eventos := make([]spec.EventoLinhaDoTempo, 0, len(timeline.Events))
+	for _, e := range timeline.Events {
+		var tipo spec.TipoEventoLinhaDoTempo
+		_ = tipo.FromValue(e.Type)
+
+		evento := spec.EventoLinhaDoTempo{
+			Tipo:       tipo,
+			OcorridoEm: e.At.UTC(),
+			NomeAutor:  e.ActorName,
+		}
+		if e.ActorID != uuid.Nil {
+			actorID := e.ActorID.String()
+			evento.AutorID = &actorID
+		}
+
+		switch {
+		case e.Comment != nil:
+			comentario := toSpecComentario(*e.Comment)
+			evento.Comentario = &comentario
+		case e.Status != nil:
+			situacao := toSpecAlteracaoStatus(*e.Status)
+			evento.Situacao = &situacao
+		case e.Assignment != nil:
+			var acao spec.AlteracaoAtribuicaoAcao
+			_ = acao.FromValue(e.Assignment.Action)
+			atribuicao := spec.AlteracaoAtribuicao{
+				ID:          e.Assignment.ID.String(),
+				NomeTecnico: e.Assignment.MemberName,
+				Acao:        acao,
+			}
+			if e.Assignment.MemberID != uuid.Nil {
+				memberID := e.Assignment.MemberID.String()
+				atribuicao.TecnicoID = &memberID
+			}
+			evento.Atribuicao = &atribuicao
+		case e.Attachment != nil:
+			evento.Anexo = &spec.AnexoLinhaDoTempo{
+				ID:           e.Attachment.ID.String(),
+				NomeArquivo:  e.Attachment.FileName,
+				TipoConteudo: e.Attachment.ContentType,
+				Tamanho:      e.Attachment.Size,
+			}
+		}
+		eventos = append(eventos, evento)
+	}
+
+	return spec.GetFormTimelineJSON200Response(spec.LinhaDoTempo{
+		Eventos: eventos,
+	})
+}
+
+func toSpecListaComentarios(comments []usecase.CommentOutput) spec.ListaComentarios {
+	comentarios := make([]spec.Comentario, 0, len(comments))
+	for _, c := range comments {
+		comentarios = append(comentarios, toSpecComentario(c))
+	}
+	return spec.ListaComentarios{Comentarios: comentarios}
+}
+
+func toSpecComentario(c usecase.CommentOutput) spec.Comentario {
+	comentario := spec.Comentario{
+		ID:        c.ID.String(),
+		NomeAutor: c.AuthorName,
+		Corpo:     c.Body,
+		Interno:   c.Internal,
+		Editado:   c.Edited,
+		CreatedAt: c.CreatedAt.UTC(),
+		UpdatedAt: c.UpdatedAt.UTC(),
+	}
+	if c.AuthorID != uuid.Nil {
+		authorID := c.AuthorID.String()
+		comentario.AutorID = &authorID
+	}
+	return comentario
+}
@@ -0,0 +1,319 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrContractOverlap    = "Cliente já possui contrato no período informado"
+	ErrContractClientType = "Contratos só podem ser criados para clientes do tipo contrato"
+)
+
+// Create contract
+// (POST /v1/contracts/create)
+func (api *Handlers) PostCreateContract(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostCreateContractJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.CriarContrato
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostCreateContractJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostCreateContractJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	id, err := api.contractsUsecase.CreateContract(usecase.CreateContractInput{
+		ClienteId:          uuid.MustParse(payload.ClienteID),
+		StartDate:          payload.DataInicio.Time,
+		EndDate:            payload.DataFim.Time,
+		MonthlyHours:       decimal.NewFromFloat(payload.HorasMensais),
+		CoveredServices:    payload.ServicosCobertos,
+		ResponseSLAHours:   payload.SLARespostaHoras,
+		ResolutionSLAHours: payload.SLAResolucaoHoras,
+		MonthlyPrice:       decimal.NewFromFloat(payload.ValorMensal),
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrContractOverlap):
+			return spec.PostCreateContractJSON409Response(spec.ErrorResponse{
+				Message: ErrContractOverlap,
+			})
+		case errors.Is(err, domains.ErrContractClientType):
+			return spec.PostCreateContractJSON400Response(spec.ErrorResponse{
+				Message: ErrContractClientType,
+			})
+		case isContractValidationError(err):
+			return spec.PostCreateContractJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		}
+		return spec.PostCreateContractJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostCreateContractJSON201Response(spec.Resp200{
+		Message: "Contrato criado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List contracts
+// (GET /v1/contracts/list)
+func (api *Handlers) ListContracts(w http.ResponseWriter, r *http.Request, params spec.ListContractsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListContractsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	clientID := uuid.Nil
+	if params.ClienteID != nil {
+		clientID = uuid.MustParse(*params.ClienteID)
+	}
+
+	contracts, err := api.contractsUsecase.ListContracts(clientID, r.Context())
+	if err != nil {
+		return spec.ListContractsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	list := make([]spec.Contrato, 0, len(contracts.Contracts))
+	for _, c := range contracts.Contracts {
+		list = append(list, toSpecContrato(c))
+	}
+
+	return spec.ListContractsJSON200Response(spec.ListaContratos{
+		Contratos: list,
+	})
+}
+
+// List contract alerts
+// (GET /v1/contracts/alerts)
+func (api *Handlers) ListContractAlerts(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListContractAlertsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	contracts, err := api.contractsUsecase.ListContractAlerts(r.Context())
+	if err != nil {
+		return spec.ListContractAlertsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	list := make([]spec.Contrato, 0, len(contracts.Contracts))
+	for _, c := range contracts.Contracts {
+		list = append(list, toSpecContrato(c))
+	}
+
+	return spec.ListContractAlertsJSON200Response(spec.ListaContratos{
+		Contratos: list,
+	})
+}
+
+// Update contract
+// (PUT /v1/contracts/update/{contractID})
+func (api *Handlers) PutContract(w http.ResponseWriter, r *http.Request, contractID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutContractJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.AtualizarContrato
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutContractJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutContractJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	if err := api.contractsUsecase.UpdateContract(
+		uuid.MustParse(contractID),
+		usecase.UpdateContractInput{
+			StartDate:          payload.DataInicio.Time,
+			EndDate:            payload.DataFim.Time,
+			MonthlyHours:       decimal.NewFromFloat(payload.HorasMensais),
+			CoveredServices:    payload.ServicosCobertos,
+			ResponseSLAHours:   payload.SLARespostaHoras,
+			ResolutionSLAHours: payload.SLAResolucaoHoras,
+			MonthlyPrice:       decimal.NewFromFloat(payload.ValorMensal),
+		},
+		r.Context(),
+	); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrContractNotFound):
+			return spec.PutContractJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrContractOverlap):
+			return spec.PutContractJSON409Response(spec.ErrorResponse{
+				Message: ErrContractOverlap,
+			})
+		case isContractValidationError(err):
+			return spec.PutContractJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		}
+		return spec.PutContractJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutContractJSON204Response(spec.Resp204{
+		Message: "Contrato atualizado com sucesso",
+	})
+}
+
+// Delete contract
+// (DELETE /v1/contracts/delete/{contractID})
+func (api *Handlers) DeleteContract(w http.ResponseWriter, r *http.Request, contractID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteContractJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	if err := api.contractsUsecase.DeleteContract(uuid.MustParse(contractID), r.Context()); err != nil {
+		return spec.DeleteContractJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteContractJSON204Response(spec.Resp204{
+		Message: "Contrato deletado com sucesso",
+	})
+}
+
+// Get contract
+// (GET /v1/contracts/{contractID})
+func (api *Handlers) GetContractByID(w http.ResponseWriter, r *http.Request, contractID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetContractByIDJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	c, err := api.contractsUsecase.GetContract(uuid.MustParse(contractID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrContractNotFound) {
+			return spec.GetContractByIDJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.GetContractByIDJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetContractByIDJSON200Response(spec.BuscaContrato{
+		Contrato: toSpecContrato(c.Contract),
+	})
+}
+
+// Get contract usage
+// (GET /v1/contracts/{contractID}/usage)
+func (api *Handlers) GetContractUsage(w http.ResponseWriter, r *http.Request, contractID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetContractUsageJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	usage, err := api.contractsUsecase.GetContractUsage(uuid.MustParse(contractID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrContractNotFound) {
+			return spec.GetContractUsageJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.GetContractUsageJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	periods := make([]spec.PeriodoContrato, 0, len(usage.Periods))
+	for _, p := range usage.Periods {
+		periods = append(periods, spec.PeriodoContrato{
+			Inicio:           types.Date{Time: p.Start},
+			Fim:              types.Date{Time: p.End.AddDate(0, 0, -1)},
+			HorasContratadas: p.ContractedHours.InexactFloat64(),
+			HorasUtilizadas:  p.UsedHours.InexactFloat64(),
+			HorasRestantes:   p.RemainingHours.InexactFloat64(),
+		})
+	}
+
+	return spec.GetContractUsageJSON200Response(spec.UsoContrato{
+		Contrato: toSpecContrato(usage.Contract),
+		Periodos: periods,
+	})
+}
+
+func toSpecContrato(c usecase.ContractOutput) spec.Contrato {
+	alerts := make([]spec.ContratoAlertas, 0, len(c.Alerts))
+	for _, a := range c.Alerts {
+		var alert spec.ContratoAlertas
+		if err := alert.FromValue(a); err == nil {
+			alerts = append(alerts, alert)
+		}
+	}
+
+	return spec.Contrato{
+		ID:                     c.ID.String(),
+		ClienteID:              c.Cliente.ID.String(),
+		NomeCliente:            c.Cliente.ClientName,
+		DataInicio:             types.Date{Time: c.StartDate},
+		DataFim:                types.Date{Time: c.EndDate},
+		HorasMensais:           c.MonthlyHours.InexactFloat64(),
+		ServicosCobertos:       c.CoveredServices,
+		SLARespostaHoras:       c.ResponseSLAHours,
+		SLAResolucaoHoras:      c.ResolutionSLAHours,
+		ValorMensal:            c.MonthlyPrice.InexactFloat64(),
+		HorasUtilizadasPeriodo: c.UsedHoursInPeriod.InexactFloat64(),
+		HorasRestantesPeriodo:  c.RemainingHoursInPeriod.InexactFloat64(),
+		Alertas:                alerts,
+		CreatedAt:              c.CreatedAt.UTC(),
+		UpdatedAt:              c.UpdatedAt.UTC(),
+	}
+}
+
+func isContractValidationError(err error) bool {
+	return errors.Is(err, domains.ErrInvalidClienteId) ||
+		errors.Is(err, domains.ErrClientNotFound) ||
+		errors.Is(err, domains.ErrInvalidContractPeriod) ||
+		errors.Is(err, domains.ErrInvalidContractHours) ||
+		errors.Is(err, domains.ErrInvalidContractSLA) ||
+		errors.Is(err, domains.ErrInvalidContractPrice)
+}
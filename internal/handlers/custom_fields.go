@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCustomFieldKeyTaken   = "Já existe um campo personalizado com essa chave"
+	ErrInvalidCustomField    = "Definição de campo personalizado inválida"
+	ErrInvalidCustomValue    = "Valor inválido para o campo personalizado"
+	ErrUnknownCustomField    = "Campo personalizado desconhecido"
+	ErrRequiredCustomField   = "Campo personalizado obrigatório não informado"
+	ErrInvalidTags           = "Tags inválidas: informe até 20 tags com até 50 caracteres"
+	ErrInvalidCustomFieldArg = "Filtro de campo personalizado deve estar no formato chave:valor"
+)
+
+// Create custom field
+// (POST /v1/custom-fields/create)
+func (api *Handlers) PostCreateCustomField(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostCreateCustomFieldJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostCreateCustomFieldJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.CriarCampoPersonalizado
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostCreateCustomFieldJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostCreateCustomFieldJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	required := false
+	if payload.Obrigatorio != nil {
+		required = *payload.Obrigatorio
+	}
+
+	id, err := api.customFieldsUsecase.CreateCustomField(usecase.CreateCustomFieldInput{
+		Entity:   payload.Entidade.ToValue(),
+		Key:      payload.Chave,
+		Label:    payload.Rotulo,
+		Type:     payload.Tipo.ToValue(),
+		Required: required,
+		Options:  payload.Opcoes,
+		Rules:    fromSpecRegras(payload.Regras),
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrCustomFieldKeyTaken):
+			return spec.PostCreateCustomFieldJSON409Response(spec.ErrorResponse{
+				Message: ErrCustomFieldKeyTaken,
+			})
+		case isCustomFieldDefinitionError(err):
+			return spec.PostCreateCustomFieldJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidCustomField,
+			})
+		}
+		return spec.PostCreateCustomFieldJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostCreateCustomFieldJSON201Response(spec.Resp200{
+		Message: "Campo personalizado criado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List custom fields
+// (GET /v1/custom-fields/list)
+func (api *Handlers) ListCustomFields(w http.ResponseWriter, r *http.Request, params spec.ListCustomFieldsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListCustomFieldsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	entity := ""
+	if params.Entidade != nil {
+		entity = params.Entidade.ToValue()
+	}
+
+	fields, err := api.customFieldsUsecase.ListCustomFields(entity, r.Context())
+	if err != nil {
+		return spec.ListCustomFieldsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	list := make([]spec.CampoPersonalizado, 0, len(fields))
+	for _, f := range fields {
+		var entidade spec.EntidadeCampoPersonalizado
+		_ = entidade.FromValue(f.Entity)
+		var tipo spec.TipoCampoPersonalizado
+		_ = tipo.FromValue(f.Type)
+
+		options := f.Options
+		if options == nil {
+			options = []string{}
+		}
+
+		list = append(list, spec.CampoPersonalizado{
+			ID:          f.ID.String(),
+			Entidade:    entidade,
+			Chave:       f.Key,
+			Rotulo:      f.Label,
+			Tipo:        tipo,
+			Obrigatorio: f.Required,
+			Opcoes:      options,
+			Regras: spec.RegrasCampoPersonalizado{
+				TamanhoMinimo: f.Rules.MinLength,
+				TamanhoMaximo: f.Rules.MaxLength,
+				Padrao:        optionalString(f.Rules.Pattern),
+				Minimo:        f.Rules.Min,
+				Maximo:        f.Rules.Max,
+			},
+			CreatedAt: f.CreatedAt.UTC(),
+			UpdatedAt: f.UpdatedAt.UTC(),
+		})
+	}
+
+	return spec.ListCustomFieldsJSON200Response(spec.ListaCamposPersonalizados{
+		Campos: list,
+	})
+}
+
+// Update custom field
+// (PUT /v1/custom-fields/update/{fieldID})
+func (api *Handlers) PutCustomField(w http.ResponseWriter, r *http.Request, fieldID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutCustomFieldJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutCustomFieldJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.AtualizarCampoPersonalizado
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutCustomFieldJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutCustomFieldJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	if err := api.customFieldsUsecase.UpdateCustomField(
+		uuid.MustParse(fieldID),
+		usecase.UpdateCustomFieldInput{
+			Label:    payload.Rotulo,
+			Required: payload.Obrigatorio,
+			Options:  payload.Opcoes,
+			Rules:    fromSpecRegras(payload.Regras),
+		},
+		r.Context(),
+	); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrCustomFieldNotFound):
+			return spec.PutCustomFieldJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case isCustomFieldDefinitionError(err):
+			return spec.PutCustomFieldJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidCustomField,
+			})
+		}
+		return spec.PutCustomFieldJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutCustomFieldJSON204Response(spec.Resp204{
+		Message: "Campo personalizado atualizado com sucesso",
+	})
+}
+
+// Delete custom field
+// (DELETE /v1/custom-fields/delete/{fieldID})
+func (api *Handlers) DeleteCustomField(w http.ResponseWriter, r *http.Request, fieldID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteCustomFieldJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.DeleteCustomFieldJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	if err := api.customFieldsUsecase.DeleteCustomField(uuid.MustParse(fieldID), r.Context()); err != nil {
+		if errors.Is(err, domains.ErrCustomFieldNotFound) {
+			return spec.DeleteCustomFieldJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.DeleteCustomFieldJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteCustomFieldJSON204Response(spec.Resp204{
+		Message: "Campo personalizado deletado com sucesso",
+	})
+}
+
+func fromSpecRegras(r *spec.RegrasCampoPersonalizado) usecase.CustomFieldRules {
+	if r == nil {
+		return usecase.CustomFieldRules{}
+	}
+	rules := usecase.CustomFieldRules{
+		MinLength: r.TamanhoMinimo,
+		MaxLength: r.TamanhoMaximo,
+		Min:       r.Minimo,
+		Max:       r.Maximo,
+	}
+	if r.Padrao != nil {
+		rules.Pattern = *r.Padrao
+	}
+	return rules
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// fromSpecCampos retorna nil quando o payload não trouxe campos
+// personalizados, o que na atualização significa manter os atuais.
+func fromSpecCampos(c *spec.CamposPersonalizados) map[string]any {
+	if c == nil {
+		return nil
+	}
+	if c.AdditionalProperties == nil {
+		return map[string]any{}
+	}
+	return c.AdditionalProperties
+}
+
+func toSpecCampos(fields map[string]any) spec.CamposPersonalizados {
+	if fields == nil {
+		fields = map[string]any{}
+	}
+	return spec.CamposPersonalizados{AdditionalProperties: fields}
+}
+
+func toSpecTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// parseListFilter lê os filtros "campo=chave:valor" e "tag" das listagens.
+func parseListFilter(campos, tags []string) (usecase.ListFilterInput, bool) {
+	fields := make(map[string]string, len(campos))
+	for _, c := range campos {
+		key, value, ok := strings.Cut(c, ":")
+		if !ok || key == "" {
+			return usecase.ListFilterInput{}, false
+		}
+		fields[key] = value
+	}
+	return usecase.ListFilterInput{CustomFields: fields, Tags: tags}, true
+}
+
+// customFieldErrorMessage traduz erros de validação de campos personalizados
+// e tags para a mensagem da API, incluindo a chave do campo quando houver.
+func customFieldErrorMessage(err error) (string, bool) {
+	if errors.Is(err, domains.ErrInvalidTag) || errors.Is(err, domains.ErrTooManyTags) {
+		return ErrInvalidTags, true
+	}
+
+	var fieldErr *domains.CustomFieldError
+	if !errors.As(err, &fieldErr) {
+		return "", false
+	}
+	switch {
+	case errors.Is(err, domains.ErrUnknownCustomField):
+		return ErrUnknownCustomField + ": " + fieldErr.Key, true
+	case errors.Is(err, domains.ErrCustomFieldRequired):
+		return ErrRequiredCustomField + ": " + fieldErr.Key, true
+	}
+	return ErrInvalidCustomValue + ": " + fieldErr.Key, true
+}
+
+func isCustomFieldDefinitionError(err error) bool {
+	return errors.Is(err, domains.ErrInvalidCustomFieldEntity) ||
+		errors.Is(err, domains.ErrInvalidCustomFieldKey) ||
+		errors.Is(err, domains.ErrInvalidCustomFieldLabel) ||
+		errors.Is(err, domains.ErrInvalidCustomFieldType) ||
+		errors.Is(err, domains.ErrInvalidCustomFieldOptions) ||
+		errors.Is(err, domains.ErrInvalidCustomFieldRules)
+}
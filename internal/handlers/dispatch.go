@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidDispatchRequest  = "Informe um cliente válido e um limite de 1 a 50 sugestões"
+	ErrInvalidMemberLocation   = "Localização inválida: informe o tipo (atual ou base), a latitude entre -90 e 90 e a longitude entre -180 e 180"
+	ErrMemberLocationForbidden = "Somente administradores alteram a base de outros técnicos; o técnico pode informar apenas a própria localização atual"
+	ErrDispatchMemberNotFound  = "Técnico não encontrado"
+	ErrFormTechniciansRequired = "Informe ao menos um técnico responsável ou ative a atribuição automática"
+	ErrNoDispatchCandidates    = "Nenhum técnico ativo disponível para a atribuição automática"
+)
+
+// Dispatch suggestions
+// (GET /v1/dispatch/suggestions)
+func (api *Handlers) GetDispatchSuggestions(w http.ResponseWriter, r *http.Request, params spec.GetDispatchSuggestionsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetDispatchSuggestionsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	clientID, err := uuid.Parse(params.ClienteID)
+	if err != nil {
+		return spec.GetDispatchSuggestionsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidDispatchRequest,
+		})
+	}
+	limit := 0
+	if params.Limite != nil {
+		limit = *params.Limite
+	}
+
+	out, err := api.dispatchUsecase.SuggestTechnicians(usecase.SuggestTechniciansInput{
+		ClientID: clientID,
+		Limit:    limit,
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrClientNotFound) {
+			return spec.GetDispatchSuggestionsJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidDispatchRequest,
+			})
+		}
+		return spec.GetDispatchSuggestionsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	suggestions := make([]spec.SugestaoDesignacao, 0, len(out.Suggestions))
+	for _, s := range out.Suggestions {
+		suggestion := spec.SugestaoDesignacao{
+			TecnicoID: s.MemberID.String(),
+			Nome:      s.Name,
+			Papel:     s.Role,
+			Pontuacao: s.Score,
+			Pontos: spec.PontosDesignacao{
+				Distancia: s.Scores.Distance,
+				Carga:     s.Scores.Workload,
+				Historico: s.Scores.History,
+				Papel:     s.Scores.Role,
+			},
+			AtendimentosAbertos:         s.OpenForms,
+			AtendimentosRecentesCliente: s.RecentClientForms,
+			UltimoAtendimentoCliente:    optionalTime(s.LastClientFormAt),
+			Motivos:                     s.Reasons,
+		}
+		if s.Located {
+			distance := s.DistanceKm
+			var source spec.TipoLocalizacaoTecnico
+			_ = source.FromValue(s.LocationSource)
+			suggestion.DistanciaKm = &distance
+			suggestion.OrigemLocalizacao = &source
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	return spec.GetDispatchSuggestionsJSON200Response(spec.SugestoesDesignacao{
+		ClienteID:         out.ClientID.String(),
+		ClienteLocalizado: out.ClientLocated,
+		Sugestoes:         suggestions,
+	})
+}
+
+// Update member location
+// (PUT /v1/members/{memberID}/location)
+func (api *Handlers) PutMemberLocation(w http.ResponseWriter, r *http.Request, memberID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutMemberLocationJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.LocalizacaoTecnico
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutMemberLocationJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutMemberLocationJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidMemberLocation,
+		})
+	}
+
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.PutMemberLocationJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	err = api.dispatchUsecase.UpdateMemberLocation(uuid.MustParse(memberID), usecase.MemberLocationInput{
+		Kind:        payload.Tipo.ToValue(),
+		Latitude:    payload.Latitude,
+		Longitude:   payload.Longitude,
+		RequestedBy: userID,
+		IsAdmin:     admin,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrInvalidMemberLocation):
+			return spec.PutMemberLocationJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidMemberLocation,
+			})
+		case errors.Is(err, domains.ErrMemberLocationForbidden):
+			return spec.PutMemberLocationJSON403Response(spec.ErrorResponse{
+				Message: ErrMemberLocationForbidden,
+			})
+		case errors.Is(err, domains.ErrMemberNotFound):
+			return spec.PutMemberLocationJSON404Response(spec.ErrorResponse{
+				Message: ErrDispatchMemberNotFound,
+			})
+		}
+		return spec.PutMemberLocationJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutMemberLocationJSON204Response(spec.Resp204{
+		Message: "Localização do técnico atualizada com sucesso",
+	})
+}
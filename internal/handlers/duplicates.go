@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrClientMergeSameClient      = "O cliente de origem deve ser diferente do cliente de destino"
+	ErrClientMergeContractType    = "O cliente de origem possui contratos e o cliente de destino não é do tipo contrato"
+	ErrClientMergeContractOverlap = "Um contrato do cliente de origem coincide com o período de um contrato do cliente de destino"
+)
+
+// List duplicate clients
+// (GET /v1/clients/duplicates)
+func (api *Handlers) ListDuplicateClients(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListDuplicateClientsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	pairs, err := api.clientsUsecase.ListDuplicatePairs(r.Context())
+	if err != nil {
+		return spec.ListDuplicateClientsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	list := make([]spec.ParDuplicado, 0, len(pairs))
+	for _, p := range pairs {
+		list = append(list, spec.ParDuplicado{
+			ClienteA:  toSpecClienteResumo(p.First),
+			ClienteB:  toSpecClienteResumo(p.Second),
+			Pontuacao: p.Score,
+			Motivos:   toSpecMotivos(p.Reasons),
+		})
+	}
+
+	return spec.ListDuplicateClientsJSON200Response(spec.ListaDuplicidades{
+		Pares: list,
+	})
+}
+
+// Merge clients
+// (POST /v1/clients/merge)
+func (api *Handlers) MergeClients(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.MergeClientsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.MergeClientsJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.UnificarClientes
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.MergeClientsJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.MergeClientsJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	merge, err := api.clientsUsecase.MergeClients(usecase.MergeClientsInput{
+		SourceID: uuid.MustParse(payload.ClienteOrigemID),
+		TargetID: uuid.MustParse(payload.ClienteDestinoID),
+		MergedBy: userID,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrClientMergeSameClient):
+			return spec.MergeClientsJSON400Response(spec.ErrorResponse{
+				Message: ErrClientMergeSameClient,
+			})
+		case errors.Is(err, domains.ErrClientNotFound):
+			return spec.MergeClientsJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrContractClientType):
+			return spec.MergeClientsJSON400Response(spec.ErrorResponse{
+				Message: ErrClientMergeContractType,
+			})
+		case errors.Is(err, domains.ErrContractOverlap):
+			return spec.MergeClientsJSON409Response(spec.ErrorResponse{
+				Message: ErrClientMergeContractOverlap,
+			})
+		}
+		return spec.MergeClientsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.MergeClientsJSON200Response(toSpecUnificacao(*merge))
+}
+
+// List client merges
+// (GET /v1/clients/{clientID}/merges)
+func (api *Handlers) ListClientMerges(w http.ResponseWriter, r *http.Request, clientID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListClientMergesJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	merges, err := api.clientsUsecase.ListClientMerges(uuid.MustParse(clientID), r.Context())
+	if err != nil {
+		return spec.ListClientMergesJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	list := make([]spec.Unificacao, 0, len(merges))
+	for _, m := range merges {
+		list = append(list, toSpecUnificacao(m))
+	}
+
+	return spec.ListClientMergesJSON200Response(spec.ListaUnificacoes{
+		Unificacoes: list,
+	})
+}
+
+func toSpecClienteResumo(c usecase.ClientSummary) spec.ClienteResumo {
+	return spec.ClienteResumo{
+		ID:              c.ID.String(),
+		NomeCliente:     c.ClientName,
+		CnpjOuCpf:       c.CnpjOrCpf,
+		EmailContato:    c.Email,
+		TelefoneContato: c.Phone,
+	}
+}
+
+func toSpecMotivos(reasons []string) []spec.MotivoDuplicidade {
+	motivos := make([]spec.MotivoDuplicidade, 0, len(reasons))
+	for _, reason := range reasons {
+		var motivo spec.MotivoDuplicidade
+		if err := motivo.FromValue(reason); err == nil {
+			motivos = append(motivos, motivo)
+		}
+	}
+	return motivos
+}
+
+func toSpecUnificacao(m usecase.ClientMergeOutput) spec.Unificacao {
+	var mergedBy *string
+	if m.MergedBy != uuid.Nil {
+		id := m.MergedBy.String()
+		mergedBy = &id
+	}
+
+	return spec.Unificacao{
+		ID:                       m.ID.String(),
+		ClienteOrigem:            toSpecClienteResumo(m.Source),
+		ClienteDestinoID:         m.TargetID.String(),
+		AtendimentosTransferidos: m.FormsMoved,
+		ContratosTransferidos:    m.ContractsMoved,
+		UnificadoPor:             mergedBy,
+		UnificadoEm:              m.MergedAt.UTC(),
+	}
+}
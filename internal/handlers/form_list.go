@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidFormListFilter = "Filtros inválidos: verifique IDs, período (início antes do fim), protocolo e limite (1 a 200)"
+	ErrInvalidFormCode       = "Protocolo inválido: use o formato OS-AAAA-NNNNNN"
+	ErrInvalidSearchQuery    = "Informe de 1 a 200 caracteres para a busca"
+)
+
+// parseListFormsParams converte os filtros e a paginação da listagem de
+// atendimentos; campos personalizados e tags são tratados por parseListFilter.
+func parseListFormsParams(params spec.ListFormsParams) (usecase.ListFormsInput, bool) {
+	var input usecase.ListFormsInput
+	var ok bool
+
+	if input.ClientID, ok = parseOptionalUUID(params.ClienteID); !ok {
+		return input, false
+	}
+	if input.TecnicoID, ok = parseOptionalUUID(params.TecnicoID); !ok {
+		return input, false
+	}
+	if input.Cursor, ok = parseOptionalUUID(params.Cursor); !ok {
+		return input, false
+	}
+	if params.NivelDificuldade != nil {
+		input.DifficultyLevel = string(*params.NivelDificuldade)
+	}
+	if params.Status != nil {
+		input.Status = params.Status.ToValue()
+	}
+	if params.OcorridoDe != nil {
+		input.OccurredFrom = params.OcorridoDe.UTC()
+	}
+	if params.OcorridoAte != nil {
+		input.OccurredTo = params.OcorridoAte.UTC()
+	}
+	if params.Protocolo != nil {
+		input.PublicCode = *params.Protocolo
+	}
+	if params.Limite != nil {
+		if *params.Limite < 1 {
+			return input, false
+		}
+		input.Limit = *params.Limite
+	}
+
+	return input, true
+}
+
+func parseOptionalUUID(s *string) (uuid.UUID, bool) {
+	if s == nil || *s == "" {
+		return uuid.Nil, true
+	}
+	id, err := uuid.Parse(*s)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func isFormListFilterError(err error) bool {
+	return errors.Is(err, domains.ErrInvalidDifficultyLevel) ||
+		errors.Is(err, domains.ErrInvalidFormStatus) ||
+		errors.Is(err, domains.ErrInvalidFormPeriod) ||
+		errors.Is(err, domains.ErrInvalidPageSize) ||
+		errors.Is(err, domains.ErrInvalidFormCode)
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidFormTransition    = "Mudança de situação não permitida a partir da situação atual"
+	ErrFormStatusReasonRequired = "Informe o motivo para cancelar ou reabrir o atendimento"
+	ErrFormSolutionRequired     = "Informe a descrição da solução para resolver o atendimento"
+	ErrFormStatusConflict       = "A situação do atendimento foi alterada por outro usuário; recarregue e tente novamente"
+)
+
+// Change form status
+// (POST /v1/forms/status/{formID})
+func (api *Handlers) PostFormStatus(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostFormStatusJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.AlterarStatusFormulario
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostFormStatusJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostFormStatusJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.PostFormStatusJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	input := usecase.TransitionFormStatusInput{
+		Status:    payload.Status.ToValue(),
+		ChangedBy: userID,
+		Admin:     admin,
+	}
+	if payload.Motivo != nil {
+		input.Reason = *payload.Motivo
+	}
+	if payload.DescricaoSolucao != nil {
+		input.SolutionDescription = *payload.DescricaoSolucao
+	}
+
+	if err := api.formsUsecase.TransitionFormStatus(uuid.MustParse(formID), input, r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PostFormStatusJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrFormSigned):
+			return spec.PostFormStatusJSON403Response(spec.ErrorResponse{
+				Message: ErrFormSigned,
+			})
+		case errors.Is(err, domains.ErrInvalidFormStatus):
+			return spec.PostFormStatusJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		case errors.Is(err, domains.ErrFormStatusReasonRequired):
+			return spec.PostFormStatusJSON400Response(spec.ErrorResponse{
+				Message: ErrFormStatusReasonRequired,
+			})
+		case errors.Is(err, domains.ErrInvalidSolutionDescription):
+			return spec.PostFormStatusJSON400Response(spec.ErrorResponse{
+				Message: ErrFormSolutionRequired,
+			})
+		case errors.Is(err, domains.ErrInvalidFormTransition):
+			return spec.PostFormStatusJSON409Response(spec.ErrorResponse{
+				Message: ErrInvalidFormTransition,
+			})
+		case errors.Is(err, domains.ErrChecklistIncomplete):
+			return spec.PostFormStatusJSON409Response(spec.ErrorResponse{
+				Message: ErrChecklistIncomplete,
+			})
+		case errors.Is(err, domains.ErrFormStatusConflict):
+			return spec.PostFormStatusJSON409Response(spec.ErrorResponse{
+				Message: ErrFormStatusConflict,
+			})
+		}
+		return spec.PostFormStatusJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostFormStatusJSON204Response(spec.Resp204{
+		Message: "Situação do atendimento alterada com sucesso",
+	})
+}
+
+// List form status history
+// (GET /v1/forms/status-history/{formID})
+func (api *Handlers) ListFormStatusHistory(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListFormStatusHistoryJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	history, err := api.formsUsecase.ListFormStatusHistory(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.ListFormStatusHistoryJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.ListFormStatusHistoryJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	alteracoes := make([]spec.AlteracaoStatusFormulario, 0, len(history.Changes))
+	for _, c := range history.Changes {
+		alteracoes = append(alteracoes, toSpecAlteracaoStatus(c))
+	}
+
+	return spec.ListFormStatusHistoryJSON200Response(spec.HistoricoStatusFormulario{
+		Alteracoes: alteracoes,
+	})
+}
+
+func toSpecAlteracaoStatus(c usecase.FormStatusChangeOutput) spec.AlteracaoStatusFormulario {
+	alteracao := spec.AlteracaoStatusFormulario{
+		ID:              c.ID.String(),
+		StatusAnterior:  toSpecStatusAtendimento(c.FromStatus),
+		StatusNovo:      toSpecStatusAtendimento(c.ToStatus),
+		Motivo:          c.Reason,
+		NomeAlteradoPor: c.ChangedByName,
+		AlteradoEm:      c.ChangedAt.UTC(),
+	}
+	if c.ChangedBy != uuid.Nil {
+		changedBy := c.ChangedBy.String()
+		alteracao.AlteradoPor = &changedBy
+	}
+	return alteracao
+}
+
+func toSpecStatusAtendimento(status string) spec.StatusAtendimento {
+	var s spec.StatusAtendimento
+	_ = s.FromValue(status)
+	return s
+}
@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidFormType         = "Tipo de formulário inválido: informe um nome de até 100 caracteres e uma descrição de até 500"
+	ErrInvalidFormSchema       = "Esquema do tipo de formulário inválido"
+	ErrFormTypeNotFound        = "Tipo de formulário não encontrado"
+	ErrFormTypeNameTaken       = "Já existe um tipo de formulário com esse nome"
+	ErrFormTypeVersionNotFound = "Versão do tipo de formulário não encontrada"
+	ErrFormTypeInactive        = "Tipo de formulário inativo não aceita novos atendimentos"
+	ErrFormDataWithoutType     = "Os dados do formulário exigem um tipo de formulário"
+	ErrInvalidFormDataValue    = "Valor inválido nos dados do formulário"
+	ErrRequiredFormData        = "Campo obrigatório nos dados do formulário"
+	ErrUnknownFormDataField    = "Campo desconhecido nos dados do formulário"
+	ErrFormDataFieldHidden     = "Campo não se aplica às demais respostas do formulário"
+)
+
+// Create form type
+// (POST /v1/form-types/create)
+func (api *Handlers) PostCreateFormType(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostCreateFormTypeJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostCreateFormTypeJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.CriarTipoFormulario
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostCreateFormTypeJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostCreateFormTypeJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	description := ""
+	if payload.Descricao != nil {
+		description = *payload.Descricao
+	}
+
+	id, err := api.formTypesUsecase.CreateFormType(usecase.CreateFormTypeInput{
+		Name:        payload.Nome,
+		Description: description,
+		Schema:      payload.Esquema,
+		CreatedBy:   userID,
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormTypeNameTaken) {
+			return spec.PostCreateFormTypeJSON409Response(spec.ErrorResponse{
+				Message: ErrFormTypeNameTaken,
+			})
+		}
+		if msg, ok := formTypeErrorMessage(err); ok {
+			return spec.PostCreateFormTypeJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
+		return spec.PostCreateFormTypeJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostCreateFormTypeJSON201Response(spec.Resp200{
+		Message: "Tipo de formulário criado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List form types
+// (GET /v1/form-types/list)
+func (api *Handlers) ListFormTypes(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListFormTypesJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.formTypesUsecase.ListFormTypes(r.Context())
+	if err != nil {
+		return spec.ListFormTypesJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	tipos := make([]spec.TipoFormulario, 0, len(output.FormTypes))
+	for _, t := range output.FormTypes {
+		tipos = append(tipos, toSpecTipoFormulario(t))
+	}
+
+	return spec.ListFormTypesJSON200Response(spec.ListaTiposFormulario{
+		Tipos: tipos,
+	})
+}
+
+// Get form type
+// (GET /v1/form-types/{formTypeID})
+func (api *Handlers) GetFormType(w http.ResponseWriter, r *http.Request, formTypeID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetFormTypeJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.formTypesUsecase.GetFormType(uuid.MustParse(formTypeID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormTypeNotFound) {
+			return spec.GetFormTypeJSON404Response(spec.ErrorResponse{
+				Message: ErrFormTypeNotFound,
+			})
+		}
+		return spec.GetFormTypeJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetFormTypeJSON200Response(spec.TipoFormularioDetalhe{
+		Tipo:    toSpecTipoFormulario(output.FormTypeOutput),
+		Esquema: output.Schema,
+	})
+}
+
+// Update form type
+// (PUT /v1/form-types/update/{formTypeID})
+func (api *Handlers) PutFormType(w http.ResponseWriter, r *http.Request, formTypeID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutFormTypeJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutFormTypeJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.AtualizarTipoFormulario
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutFormTypeJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutFormTypeJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	description := ""
+	if payload.Descricao != nil {
+		description = *payload.Descricao
+	}
+
+	if err := api.formTypesUsecase.UpdateFormType(uuid.MustParse(formTypeID), usecase.UpdateFormTypeInput{
+		Name:        payload.Nome,
+		Description: description,
+		Active:      payload.Ativo,
+	}, r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormTypeNotFound):
+			return spec.PutFormTypeJSON404Response(spec.ErrorResponse{
+				Message: ErrFormTypeNotFound,
+			})
+		case errors.Is(err, domains.ErrFormTypeNameTaken):
+			return spec.PutFormTypeJSON409Response(spec.ErrorResponse{
+				Message: ErrFormTypeNameTaken,
+			})
+		case errors.Is(err, domains.ErrInvalidFormType):
+			return spec.PutFormTypeJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidFormType,
+			})
+		}
+		return spec.PutFormTypeJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutFormTypeJSON204Response(spec.Resp204{
+		Message: "Tipo de formulário atualizado com sucesso",
+	})
+}
+
+// Publish form type version
+// (POST /v1/form-types/{formTypeID}/versions)
+func (api *Handlers) PostFormTypeVersion(w http.ResponseWriter, r *http.Request, formTypeID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostFormTypeVersionJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostFormTypeVersionJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.PublicarVersaoTipoFormulario
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostFormTypeVersionJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	output, err := api.formTypesUsecase.PublishFormTypeVersion(usecase.PublishFormTypeVersionInput{
+		FormTypeID: uuid.MustParse(formTypeID),
+		Schema:     payload.Esquema,
+		CreatedBy:  userID,
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormTypeNotFound) {
+			return spec.PostFormTypeVersionJSON404Response(spec.ErrorResponse{
+				Message: ErrFormTypeNotFound,
+			})
+		}
+		if msg, ok := formTypeErrorMessage(err); ok {
+			return spec.PostFormTypeVersionJSON400Response(spec.ErrorResponse{
+				Message: msg,
+			})
+		}
+		return spec.PostFormTypeVersionJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostFormTypeVersionJSON201Response(toSpecVersaoTipoFormulario(*output))
+}
+
+// List form type versions
+// (GET /v1/form-types/{formTypeID}/versions)
+func (api *Handlers) ListFormTypeVersions(w http.ResponseWriter, r *http.Request, formTypeID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListFormTypeVersionsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.formTypesUsecase.ListFormTypeVersions(uuid.MustParse(formTypeID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormTypeNotFound) {
+			return spec.ListFormTypeVersionsJSON404Response(spec.ErrorResponse{
+				Message: ErrFormTypeNotFound,
+			})
+		}
+		return spec.ListFormTypeVersionsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	versoes := make([]spec.VersaoTipoFormulario, 0, len(output.Versions))
+	for _, v := range output.Versions {
+		versoes = append(versoes, toSpecVersaoTipoFormulario(v))
+	}
+
+	return spec.ListFormTypeVersionsJSON200Response(spec.ListaVersoesTipoFormulario{
+		Versoes: versoes,
+	})
+}
+
+// Get form type version
+// (GET /v1/form-types/{formTypeID}/versions/{version})
+func (api *Handlers) GetFormTypeVersion(w http.ResponseWriter, r *http.Request, formTypeID string, version int) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetFormTypeVersionJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.formTypesUsecase.GetFormTypeVersion(uuid.MustParse(formTypeID), version, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormTypeVersionNotFound) {
+			return spec.GetFormTypeVersionJSON404Response(spec.ErrorResponse{
+				Message: ErrFormTypeVersionNotFound,
+			})
+		}
+		return spec.GetFormTypeVersionJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetFormTypeVersionJSON200Response(toSpecVersaoTipoFormulario(*output))
+}
+
+func toSpecTipoFormulario(t usecase.FormTypeOutput) spec.TipoFormulario {
+	return spec.TipoFormulario{
+		ID:          t.ID.String(),
+		Nome:        t.Name,
+		Descricao:   t.Description,
+		Ativo:       t.Active,
+		VersaoAtual: t.CurrentVersion,
+		CreatedAt:   t.CreatedAt.UTC(),
+		UpdatedAt:   t.UpdatedAt.UTC(),
+	}
+}
+
+func toSpecVersaoTipoFormulario(v usecase.FormTypeVersionOutput) spec.VersaoTipoFormulario {
+	var createdBy *string
+	if v.CreatedBy != uuid.Nil {
+		id := v.CreatedBy.String()
+		createdBy = &id
+	}
+	return spec.VersaoTipoFormulario{
+		TipoFormularioID: v.FormTypeID.String(),
+		Versao:           v.Version,
+		Esquema:          v.Schema,
+		CriadoPor:        createdBy,
+		CreatedAt:        v.CreatedAt.UTC(),
+	}
+}
+
+// fromSpecDados retorna nil quando o payload não trouxe dados, o que na
+// atualização significa manter os atuais.
+func fromSpecDados(d *spec.DadosFormulario) map[string]any {
+	if d == nil {
+		return nil
+	}
+	if d.AdditionalProperties == nil {
+		return map[string]any{}
+	}
+	return d.AdditionalProperties
+}
+
+func toSpecDados(data map[string]any) spec.DadosFormulario {
+	if data == nil {
+		data = map[string]any{}
+	}
+	return spec.DadosFormulario{AdditionalProperties: data}
+}
+
+// formTypeErrorMessage traduz os erros de cadastro do tipo e de validação dos
+// dados do formulário, indicando o caminho recusado.
+func formTypeErrorMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, domains.ErrInvalidFormType):
+		return ErrInvalidFormType, true
+	case errors.Is(err, domains.ErrFormTypeInactive):
+		return ErrFormTypeInactive, true
+	case errors.Is(err, domains.ErrFormDataWithoutType):
+		return ErrFormDataWithoutType, true
+	}
+
+	var schemaErr *domains.FormSchemaError
+	if errors.As(err, &schemaErr) {
+		return ErrInvalidFormSchema + ": " + schemaErr.Path, true
+	}
+
+	var dataErr *domains.FormDataError
+	if !errors.As(err, &dataErr) {
+		return "", false
+	}
+	switch {
+	case errors.Is(err, domains.ErrUnknownFormDataField):
+		return ErrUnknownFormDataField + ": " + dataErr.Path, true
+	case errors.Is(err, domains.ErrFormDataRequired):
+		return ErrRequiredFormData + ": " + dataErr.Path, true
+	case errors.Is(err, domains.ErrFormDataFieldHidden):
+		return ErrFormDataFieldHidden + ": " + dataErr.Path, true
+	}
+	return ErrInvalidFormDataValue + ": " + dataErr.Path, true
+}
@@ -0,0 +1,508 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+	"strconv"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidBillingSettings     = "Valores de cobrança inválidos: informe valores não negativos com até 2 casas decimais e alíquota de 0 a 100%"
+	ErrInvalidInvoice             = "Fatura inválida: informe de 1 a 100 atendimentos e observações com até 2000 caracteres"
+	ErrInvalidInvoiceDiscount     = "Desconto inválido: informe um valor não negativo com até 2 casas decimais e que não supere o subtotal"
+	ErrInvalidInvoiceTaxRate      = "Alíquota inválida: informe uma porcentagem de 0 a 100 com até 2 casas decimais"
+	ErrInvalidInvoiceStatus       = "Situação inválida: use rascunho, emitida, paga ou cancelada"
+	ErrInvalidInvoiceTransition   = "Mudança de situação não permitida para a fatura"
+	ErrInvalidInvoiceCancelReason = "Informe o motivo do cancelamento, com até 500 caracteres"
+	ErrInvalidInvoiceFilter       = "Filtro inválido: informe situação e cliente válidos"
+	ErrInvoiceNotFound            = "Fatura não encontrada"
+	ErrInvoiceNotDraft            = "Somente rascunhos podem ser alterados"
+	ErrInvoiceStatusConflict      = "A situação da fatura foi alterada por outra pessoa; consulte a fatura e tente novamente"
+	ErrFormNotInvoiceable         = "Somente atendimentos resolvidos ou fechados podem ser faturados"
+	ErrFormUnderContract          = "O atendimento está coberto por contrato e não é faturado avulso"
+	ErrFormAlreadyInvoiced        = "O atendimento já está em outra fatura não cancelada"
+	ErrInvoiceClientMismatch      = "Os atendimentos da fatura precisam ser do mesmo cliente"
+	ErrFormNothingToInvoice       = "O atendimento não tem horas, peças nem deslocamento a cobrar"
+)
+
+// Get billing settings
+// (GET /v1/settings/billing)
+func (api *Handlers) GetBillingSettings(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetBillingSettingsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	settings, err := api.invoicesUsecase.GetBillingSettings(r.Context())
+	if err != nil {
+		return spec.GetBillingSettingsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetBillingSettingsJSON200Response(toSpecConfiguracaoCobranca(settings))
+}
+
+// Update billing settings
+// (PUT /v1/settings/billing)
+func (api *Handlers) PutBillingSettings(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutBillingSettingsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutBillingSettingsJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.AtualizarConfiguracaoCobranca
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutBillingSettingsJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutBillingSettingsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidBillingSettings,
+		})
+	}
+
+	settings, err := api.invoicesUsecase.UpdateBillingSettings(usecase.UpdateBillingSettingsInput{
+		HourRateLow:    decimal.NewFromFloat(payload.ValorHoraBaixa),
+		HourRateMedium: decimal.NewFromFloat(payload.ValorHoraMedia),
+		HourRateHigh:   decimal.NewFromFloat(payload.ValorHoraAlta),
+		TravelFee:      decimal.NewFromFloat(payload.TaxaDeslocamento),
+		TaxRate:        decimal.NewFromFloat(payload.AliquotaImpostos),
+		UpdatedBy:      userID,
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidBillingSettings) {
+			return spec.PutBillingSettingsJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidBillingSettings,
+			})
+		}
+		return spec.PutBillingSettingsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutBillingSettingsJSON200Response(toSpecConfiguracaoCobranca(settings))
+}
+
+// Create invoice
+// (POST /v1/invoices/create)
+func (api *Handlers) PostCreateInvoice(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostCreateInvoiceJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostCreateInvoiceJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.CriarFatura
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostCreateInvoiceJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostCreateInvoiceJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidInvoice,
+		})
+	}
+
+	input := usecase.CreateInvoiceInput{
+		FormIDs:       parseUUIDs(payload.AtendimentoIds),
+		Discount:      decimal.Zero,
+		IncludeTravel: payload.IncluirDeslocamento != nil && *payload.IncluirDeslocamento,
+		CreatedBy:     userID,
+	}
+	if payload.Desconto != nil {
+		input.Discount = decimal.NewFromFloat(*payload.Desconto)
+	}
+	if payload.AliquotaImpostos != nil {
+		rate := decimal.NewFromFloat(*payload.AliquotaImpostos)
+		input.TaxRate = &rate
+	}
+	if payload.Observacoes != nil {
+		input.Notes = *payload.Observacoes
+	}
+	if payload.Vencimento != nil {
+		input.DueDate = payload.Vencimento.Time
+	}
+
+	id, err := api.invoicesUsecase.CreateInvoice(input, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound), errors.Is(err, domains.ErrClientNotFound):
+			return spec.PostCreateInvoiceJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrFormNotInvoiceable):
+			return spec.PostCreateInvoiceJSON409Response(spec.ErrorResponse{
+				Message: ErrFormNotInvoiceable,
+			})
+		case errors.Is(err, domains.ErrFormUnderContract):
+			return spec.PostCreateInvoiceJSON409Response(spec.ErrorResponse{
+				Message: ErrFormUnderContract,
+			})
+		case errors.Is(err, domains.ErrFormAlreadyInvoiced):
+			return spec.PostCreateInvoiceJSON409Response(spec.ErrorResponse{
+				Message: ErrFormAlreadyInvoiced,
+			})
+		case errors.Is(err, domains.ErrInvoiceClientMismatch):
+			return spec.PostCreateInvoiceJSON409Response(spec.ErrorResponse{
+				Message: ErrInvoiceClientMismatch,
+			})
+		case errors.Is(err, domains.ErrFormNothingToInvoice):
+			return spec.PostCreateInvoiceJSON409Response(spec.ErrorResponse{
+				Message: ErrFormNothingToInvoice,
+			})
+		case errors.Is(err, domains.ErrInvalidInvoiceDiscount):
+			return spec.PostCreateInvoiceJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoiceDiscount,
+			})
+		case errors.Is(err, domains.ErrInvalidInvoiceTaxRate):
+			return spec.PostCreateInvoiceJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoiceTaxRate,
+			})
+		case errors.Is(err, domains.ErrInvalidInvoice):
+			return spec.PostCreateInvoiceJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoice,
+			})
+		}
+		return spec.PostCreateInvoiceJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostCreateInvoiceJSON201Response(spec.Resp200{
+		Message: "Fatura criada com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List invoices
+// (GET /v1/invoices/list)
+func (api *Handlers) ListInvoices(w http.ResponseWriter, r *http.Request, params spec.ListInvoicesParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListInvoicesJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	clientID, ok := parseOptionalUUID(params.ClienteID)
+	if !ok {
+		return spec.ListInvoicesJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidInvoiceFilter,
+		})
+	}
+	input := usecase.ListInvoicesInput{ClientID: clientID}
+	if params.Situacao != nil {
+		input.Status = string(*params.Situacao)
+	}
+
+	invoices, err := api.invoicesUsecase.ListInvoices(input, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidInvoiceStatus) {
+			return spec.ListInvoicesJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoiceFilter,
+			})
+		}
+		return spec.ListInvoicesJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.ListaFaturas{Faturas: make([]spec.Fatura, 0, len(invoices))}
+	for _, inv := range invoices {
+		resp.Faturas = append(resp.Faturas, toSpecFatura(inv))
+	}
+	return spec.ListInvoicesJSON200Response(resp)
+}
+
+// Get invoice
+// (GET /v1/invoices/{invoiceID})
+func (api *Handlers) GetInvoice(w http.ResponseWriter, r *http.Request, invoiceID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetInvoiceJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	inv, err := api.invoicesUsecase.GetInvoice(uuid.MustParse(invoiceID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvoiceNotFound) {
+			return spec.GetInvoiceJSON404Response(spec.ErrorResponse{
+				Message: ErrInvoiceNotFound,
+			})
+		}
+		return spec.GetInvoiceJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetInvoiceJSON200Response(toSpecFatura(*inv))
+}
+
+// Update invoice
+// (PUT /v1/invoices/{invoiceID})
+func (api *Handlers) PutUpdateInvoice(w http.ResponseWriter, r *http.Request, invoiceID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutUpdateInvoiceJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutUpdateInvoiceJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.AtualizarFatura
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutUpdateInvoiceJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutUpdateInvoiceJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidInvoice,
+		})
+	}
+
+	input := usecase.UpdateInvoiceInput{
+		Discount: decimal.NewFromFloat(payload.Desconto),
+		TaxRate:  decimal.NewFromFloat(payload.AliquotaImpostos),
+	}
+	if payload.Observacoes != nil {
+		input.Notes = *payload.Observacoes
+	}
+	if payload.Vencimento != nil {
+		input.DueDate = payload.Vencimento.Time
+	}
+
+	if err := api.invoicesUsecase.UpdateInvoice(uuid.MustParse(invoiceID), input, r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrInvoiceNotFound):
+			return spec.PutUpdateInvoiceJSON404Response(spec.ErrorResponse{
+				Message: ErrInvoiceNotFound,
+			})
+		case errors.Is(err, domains.ErrInvoiceNotDraft):
+			return spec.PutUpdateInvoiceJSON409Response(spec.ErrorResponse{
+				Message: ErrInvoiceNotDraft,
+			})
+		case errors.Is(err, domains.ErrInvalidInvoiceDiscount):
+			return spec.PutUpdateInvoiceJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoiceDiscount,
+			})
+		case errors.Is(err, domains.ErrInvalidInvoiceTaxRate):
+			return spec.PutUpdateInvoiceJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoiceTaxRate,
+			})
+		case errors.Is(err, domains.ErrInvalidInvoice):
+			return spec.PutUpdateInvoiceJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoice,
+			})
+		}
+		return spec.PutUpdateInvoiceJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutUpdateInvoiceJSON204Response(spec.Resp204{})
+}
+
+// Change invoice status
+// (PUT /v1/invoices/{invoiceID}/status)
+func (api *Handlers) PutInvoiceStatus(w http.ResponseWriter, r *http.Request, invoiceID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutInvoiceStatusJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutInvoiceStatusJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.MudarSituacaoFatura
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutInvoiceStatusJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutInvoiceStatusJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidInvoiceCancelReason,
+		})
+	}
+
+	input := usecase.ChangeInvoiceStatusInput{Status: payload.Situacao.ToValue()}
+	if payload.Motivo != nil {
+		input.Reason = *payload.Motivo
+	}
+
+	inv, err := api.invoicesUsecase.ChangeInvoiceStatus(uuid.MustParse(invoiceID), input, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrInvoiceNotFound):
+			return spec.PutInvoiceStatusJSON404Response(spec.ErrorResponse{
+				Message: ErrInvoiceNotFound,
+			})
+		case errors.Is(err, domains.ErrInvalidInvoiceStatus):
+			return spec.PutInvoiceStatusJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoiceStatus,
+			})
+		case errors.Is(err, domains.ErrInvalidInvoiceCancelReason):
+			return spec.PutInvoiceStatusJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoiceCancelReason,
+			})
+		case errors.Is(err, domains.ErrInvalidInvoiceTransition):
+			return spec.PutInvoiceStatusJSON409Response(spec.ErrorResponse{
+				Message: ErrInvalidInvoiceTransition,
+			})
+		case errors.Is(err, domains.ErrInvoiceStatusConflict):
+			return spec.PutInvoiceStatusJSON409Response(spec.ErrorResponse{
+				Message: ErrInvoiceStatusConflict,
+			})
+		}
+		return spec.PutInvoiceStatusJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutInvoiceStatusJSON200Response(toSpecFatura(*inv))
+}
+
+// Download invoice PDF
+// (GET /v1/invoices/{invoiceID}/pdf)
+func (api *Handlers) GetInvoicePdf(w http.ResponseWriter, r *http.Request, invoiceID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetInvoicePdfJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	doc, err := api.invoicesUsecase.RenderInvoice(uuid.MustParse(invoiceID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvoiceNotFound) {
+			return spec.GetInvoicePdfJSON404Response(spec.ErrorResponse{
+				Message: ErrInvoiceNotFound,
+			})
+		}
+		return spec.GetInvoicePdfJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `inline; filename="`+doc.FileName+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(doc.Content)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(doc.Content)
+	return nil
+}
+
+func toSpecConfiguracaoCobranca(s *usecase.BillingSettingsOutput) spec.ConfiguracaoCobranca {
+	config := spec.ConfiguracaoCobranca{
+		ValorHoraBaixa:   s.HourRateLow.InexactFloat64(),
+		ValorHoraMedia:   s.HourRateMedium.InexactFloat64(),
+		ValorHoraAlta:    s.HourRateHigh.InexactFloat64(),
+		TaxaDeslocamento: s.TravelFee.InexactFloat64(),
+		AliquotaImpostos: s.TaxRate.InexactFloat64(),
+	}
+	if s.UpdatedBy != uuid.Nil {
+		updatedBy := s.UpdatedBy.String()
+		config.UpdatedBy = &updatedBy
+	}
+	if !s.UpdatedAt.IsZero() {
+		updatedAt := s.UpdatedAt.UTC()
+		config.UpdatedAt = &updatedAt
+	}
+	return config
+}
+
+func toSpecFatura(inv usecase.InvoiceOutput) spec.Fatura {
+	fatura := spec.Fatura{
+		ID:               inv.ID.String(),
+		ClienteID:        inv.ClientID.String(),
+		NomeCliente:      inv.ClientName,
+		Subtotal:         inv.Subtotal.InexactFloat64(),
+		Desconto:         inv.Discount.InexactFloat64(),
+		AliquotaImpostos: inv.TaxRate.InexactFloat64(),
+		ValorImpostos:    inv.TaxAmount.InexactFloat64(),
+		Total:            inv.Total.InexactFloat64(),
+		Observacoes:      optionalString(inv.Notes),
+		CreatedAt:        inv.CreatedAt.UTC(),
+		UpdatedAt:        inv.UpdatedAt.UTC(),
+	}
+	_ = fatura.Situacao.FromValue(inv.Status)
+	if inv.Number != 0 {
+		number := inv.Number
+		fatura.Numero = &number
+	}
+	for _, id := range inv.FormIDs {
+		fatura.AtendimentoIds = append(fatura.AtendimentoIds, id.String())
+	}
+	for _, item := range inv.Items {
+		itemFatura := spec.ItemFatura{
+			ID:            item.ID.String(),
+			Descricao:     item.Description,
+			Quantidade:    item.Quantity.InexactFloat64(),
+			ValorUnitario: item.UnitPrice.InexactFloat64(),
+			Total:         item.Total.InexactFloat64(),
+		}
+		if item.FormID != uuid.Nil {
+			formID := item.FormID.String()
+			itemFatura.AtendimentoID = &formID
+		}
+		_ = itemFatura.Tipo.FromValue(item.Kind)
+		fatura.Itens = append(fatura.Itens, itemFatura)
+	}
+	if !inv.DueDate.IsZero() {
+		fatura.Vencimento = &types.Date{Time: inv.DueDate}
+	}
+	fatura.EmitidaEm = optionalTime(inv.IssuedAt)
+	fatura.PagaEm = optionalTime(inv.PaidAt)
+	fatura.CanceladaEm = optionalTime(inv.CanceledAt)
+	fatura.MotivoCancelamento = optionalString(inv.CancelReason)
+	if inv.CreatedBy != uuid.Nil {
+		createdBy := inv.CreatedBy.String()
+		fatura.CreatedBy = &createdBy
+	}
+	return fatura
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
@@ -0,0 +1,335 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidMaintenancePlan  = "Plano inválido: informe nome, cliente, início, nível de dificuldade, ao menos um técnico e uma lista de até 50 itens de até 200 caracteres"
+	ErrInvalidRecurrence       = "Recorrência inválida: use uma regra RRULE com FREQ (DAILY, WEEKLY, MONTHLY ou YEARLY) e, opcionalmente, INTERVAL, BYDAY, BYMONTHDAY, COUNT ou UNTIL"
+	ErrMaintenancePlanNotFound = "Plano de manutenção não encontrado"
+	ErrMaintenanceClient       = "Cliente não encontrado"
+	ErrMaintenanceTechnician   = "Técnico não encontrado"
+	ErrMaintenancePlanPaused   = "O plano de manutenção já está pausado"
+	ErrMaintenancePlanActive   = "O plano de manutenção já está ativo"
+)
+
+// Create maintenance plan
+// (POST /v1/maintenance-plans/create)
+func (api *Handlers) PostCreateMaintenancePlan(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostCreateMaintenancePlanJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.CriarPlanoManutencao
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostCreateMaintenancePlanJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostCreateMaintenancePlanJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidMaintenancePlan,
+		})
+	}
+
+	id, err := api.maintenanceUsecase.CreatePlan(usecase.MaintenancePlanInput{
+		ClientID:        uuid.MustParse(payload.ClienteID),
+		Name:            payload.Nome,
+		Site:            stringOrEmpty(payload.Local),
+		Description:     stringOrEmpty(payload.Descricao),
+		Recurrence:      payload.Recorrencia,
+		StartsAt:        payload.Inicio,
+		DifficultyLevel: payload.NivelDificuldade.ToValue(),
+		Checklist:       payload.Checklist,
+		TechnicianIDs:   parseUUIDs(payload.Tecnicos),
+		CreatedBy:       userID,
+	}, r.Context())
+	if err != nil {
+		if message, ok := maintenancePlanErrorMessage(err); ok {
+			return spec.PostCreateMaintenancePlanJSON400Response(spec.ErrorResponse{
+				Message: message,
+			})
+		}
+		return spec.PostCreateMaintenancePlanJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostCreateMaintenancePlanJSON201Response(spec.Resp200{
+		Message: "Plano de manutenção criado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List maintenance plans
+// (GET /v1/maintenance-plans/list)
+func (api *Handlers) ListMaintenancePlans(w http.ResponseWriter, r *http.Request, params spec.ListMaintenancePlansParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListMaintenancePlansJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	clientID, ok := parseOptionalUUID(params.ClienteID)
+	if !ok {
+		return spec.ListMaintenancePlansJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	output, err := api.maintenanceUsecase.ListPlans(usecase.ListMaintenancePlansInput{
+		ClientID: clientID,
+		Status:   stringOrEmpty(params.Situacao),
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidMaintenancePlan) {
+			return spec.ListMaintenancePlansJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		}
+		return spec.ListMaintenancePlansJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	planos := make([]spec.PlanoManutencao, 0, len(output.Plans))
+	for _, p := range output.Plans {
+		planos = append(planos, toSpecPlanoManutencao(p))
+	}
+
+	return spec.ListMaintenancePlansJSON200Response(spec.ListaPlanosManutencao{
+		Planos: planos,
+	})
+}
+
+// Get maintenance plan
+// (GET /v1/maintenance-plans/{planID})
+func (api *Handlers) GetMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetMaintenancePlanJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.maintenanceUsecase.GetPlan(uuid.MustParse(planID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrMaintenancePlanNotFound) {
+			return spec.GetMaintenancePlanJSON404Response(spec.ErrorResponse{
+				Message: ErrMaintenancePlanNotFound,
+			})
+		}
+		return spec.GetMaintenancePlanJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetMaintenancePlanJSON200Response(toSpecPlanoManutencao(*output))
+}
+
+// Update maintenance plan
+// (PUT /v1/maintenance-plans/update/{planID})
+func (api *Handlers) PutMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutMaintenancePlanJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.AtualizarPlanoManutencao
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutMaintenancePlanJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutMaintenancePlanJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidMaintenancePlan,
+		})
+	}
+
+	err = api.maintenanceUsecase.UpdatePlan(uuid.MustParse(planID), usecase.MaintenancePlanInput{
+		Name:            payload.Nome,
+		Site:            stringOrEmpty(payload.Local),
+		Description:     stringOrEmpty(payload.Descricao),
+		Recurrence:      payload.Recorrencia,
+		StartsAt:        payload.Inicio,
+		DifficultyLevel: payload.NivelDificuldade.ToValue(),
+		Checklist:       payload.Checklist,
+		TechnicianIDs:   parseUUIDs(payload.Tecnicos),
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrMaintenancePlanNotFound) {
+			return spec.PutMaintenancePlanJSON404Response(spec.ErrorResponse{
+				Message: ErrMaintenancePlanNotFound,
+			})
+		}
+		if message, ok := maintenancePlanErrorMessage(err); ok {
+			return spec.PutMaintenancePlanJSON400Response(spec.ErrorResponse{
+				Message: message,
+			})
+		}
+		return spec.PutMaintenancePlanJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutMaintenancePlanJSON204Response(spec.Resp204{})
+}
+
+// Delete maintenance plan
+// (DELETE /v1/maintenance-plans/delete/{planID})
+func (api *Handlers) DeleteMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteMaintenancePlanJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	if err := api.maintenanceUsecase.DeletePlan(uuid.MustParse(planID), r.Context()); err != nil {
+		if errors.Is(err, domains.ErrMaintenancePlanNotFound) {
+			return spec.DeleteMaintenancePlanJSON404Response(spec.ErrorResponse{
+				Message: ErrMaintenancePlanNotFound,
+			})
+		}
+		return spec.DeleteMaintenancePlanJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteMaintenancePlanJSON204Response(spec.Resp204{})
+}
+
+// Pause maintenance plan
+// (POST /v1/maintenance-plans/{planID}/pause)
+func (api *Handlers) PostPauseMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostPauseMaintenancePlanJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	if err := api.maintenanceUsecase.PausePlan(uuid.MustParse(planID), r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrMaintenancePlanNotFound):
+			return spec.PostPauseMaintenancePlanJSON404Response(spec.ErrorResponse{
+				Message: ErrMaintenancePlanNotFound,
+			})
+		case errors.Is(err, domains.ErrMaintenancePlanPaused):
+			return spec.PostPauseMaintenancePlanJSON409Response(spec.ErrorResponse{
+				Message: ErrMaintenancePlanPaused,
+			})
+		}
+		return spec.PostPauseMaintenancePlanJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostPauseMaintenancePlanJSON204Response(spec.Resp204{})
+}
+
+// Resume maintenance plan
+// (POST /v1/maintenance-plans/{planID}/resume)
+func (api *Handlers) PostResumeMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostResumeMaintenancePlanJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	if err := api.maintenanceUsecase.ResumePlan(uuid.MustParse(planID), r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrMaintenancePlanNotFound):
+			return spec.PostResumeMaintenancePlanJSON404Response(spec.ErrorResponse{
+				Message: ErrMaintenancePlanNotFound,
+			})
+		case errors.Is(err, domains.ErrMaintenancePlanActive):
+			return spec.PostResumeMaintenancePlanJSON409Response(spec.ErrorResponse{
+				Message: ErrMaintenancePlanActive,
+			})
+		}
+		return spec.PostResumeMaintenancePlanJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostResumeMaintenancePlanJSON204Response(spec.Resp204{})
+}
+
+// maintenancePlanErrorMessage devolve a mensagem dos erros de validação do
+// plano, respondidos com 400.
+func maintenancePlanErrorMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, domains.ErrInvalidRecurrence):
+		return ErrInvalidRecurrence, true
+	case errors.Is(err, domains.ErrInvalidMaintenancePlan),
+		errors.Is(err, domains.ErrInvalidChecklist),
+		errors.Is(err, domains.ErrInvalidDifficultyLevel):
+		return ErrInvalidMaintenancePlan, true
+	case errors.Is(err, domains.ErrClientNotFound):
+		return ErrMaintenanceClient, true
+	case errors.Is(err, domains.ErrMemberNotFound):
+		return ErrMaintenanceTechnician, true
+	}
+	return "", false
+}
+
+func toSpecPlanoManutencao(p usecase.MaintenancePlanOutput) spec.PlanoManutencao {
+	tecnicos := make([]string, 0, len(p.TechnicianIDs))
+	for _, id := range p.TechnicianIDs {
+		tecnicos = append(tecnicos, id.String())
+	}
+	plano := spec.PlanoManutencao{
+		ID:               p.ID.String(),
+		ClienteID:        p.ClientID.String(),
+		ClienteNome:      p.ClientName,
+		Nome:             p.Name,
+		Local:            p.Site,
+		Descricao:        p.Description,
+		Recorrencia:      p.Recurrence,
+		Inicio:           p.StartsAt.UTC(),
+		NivelDificuldade: p.DifficultyLevel,
+		Checklist:        p.Checklist,
+		Tecnicos:         tecnicos,
+		ProximasVisitas:  p.NextVisits,
+		CreatedAt:        p.CreatedAt.UTC(),
+		UpdatedAt:        p.UpdatedAt.UTC(),
+	}
+	_ = plano.Situacao.FromValue(p.Status)
+	if !p.GeneratedUntil.IsZero() {
+		generatedUntil := p.GeneratedUntil.UTC()
+		plano.GeradoAte = &generatedUntil
+	}
+	return plano
+}
+
+func parseUUIDs(ids []string) []uuid.UUID {
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, uuid.MustParse(id))
+	}
+	return out
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
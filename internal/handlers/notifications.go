@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// List notifications
+// (GET /v1/notifications)
+func (api *Handlers) ListNotifications(w http.ResponseWriter, r *http.Request, params spec.ListNotificationsParams) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListNotificationsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	input := usecase.ListNotificationsInput{UserID: userID}
+	if params.NaoLidas != nil {
+		input.UnreadOnly = *params.NaoLidas
+	}
+	if params.Limite != nil {
+		input.Limit = *params.Limite
+	}
+
+	output, err := api.notificationsUsecase.ListNotifications(input, r.Context())
+	if err != nil {
+		return spec.ListNotificationsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	notificacoes := make([]spec.Notificacao, 0, len(output.Notifications))
+	for _, n := range output.Notifications {
+		notificacao := spec.Notificacao{
+			ID:        n.ID.String(),
+			Mensagem:  n.Message,
+			CreatedAt: n.CreatedAt.UTC(),
+		}
+		_ = notificacao.Tipo.FromValue(n.Kind)
+		if n.FormID != uuid.Nil {
+			formID := n.FormID.String()
+			notificacao.FormularioID = &formID
+		}
+		if n.PublicCode != "" {
+			publicCode := n.PublicCode
+			notificacao.Protocolo = &publicCode
+		}
+		if !n.ReadAt.IsZero() {
+			readAt := n.ReadAt.UTC()
+			notificacao.LidaEm = &readAt
+		}
+		notificacoes = append(notificacoes, notificacao)
+	}
+
+	return spec.ListNotificationsJSON200Response(spec.ListaNotificacoes{
+		Notificacoes: notificacoes,
+	})
+}
+
+// Mark notification as read
+// (POST /v1/notifications/{notificationID}/read)
+func (api *Handlers) PostNotificationRead(w http.ResponseWriter, r *http.Request, notificationID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostNotificationReadJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	if err := api.notificationsUsecase.MarkRead(uuid.MustParse(notificationID), userID, r.Context()); err != nil {
+		if errors.Is(err, domains.ErrNotificationNotFound) {
+			return spec.PostNotificationReadJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.PostNotificationReadJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostNotificationReadJSON204Response(spec.Resp204{
+		Message: "Notificação marcada como lida",
+	})
+}
+
+// Mark all notifications as read
+// (POST /v1/notifications/read-all)
+func (api *Handlers) PostNotificationsReadAll(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostNotificationsReadAllJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	if _, err := api.notificationsUsecase.MarkAllRead(userID, r.Context()); err != nil {
+		return spec.PostNotificationsReadAllJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostNotificationsReadAllJSON204Response(spec.Resp204{
+		Message: "Notificações marcadas como lidas",
+	})
+}
@@ -0,0 +1,693 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidPart           = "Peça inválida: informe SKU sem espaços com até 50 caracteres, nome com até 150 caracteres e custo, preço e estoque mínimo não negativos com até 2 casas decimais"
+	ErrInvalidPartUnit       = "Unidade inválida: use un, par, cx, kit, m, rolo, kg ou l"
+	ErrPartSKUAlreadyExists  = "Já existe uma peça com esse SKU"
+	ErrPartNotFound          = "Peça não encontrada"
+	ErrPartInactive          = "A peça está inativa e não pode ser lançada"
+	ErrInvalidStockLocation  = "Local de estoque inválido: informe nome e tipo; veículos precisam de um técnico e almoxarifados não podem ter técnico"
+	ErrStockLocationExists   = "O técnico já possui um veículo cadastrado"
+	ErrStockLocationNotFound = "Local de estoque não encontrado"
+	ErrStockMemberNotFound   = "Técnico não encontrado"
+	ErrInvalidStockMovement  = "Movimentação inválida: informe peça, local e quantidade diferente de zero com até 3 casas decimais; o destino só vale para transferências e deve ser outro local"
+	ErrInsufficientStock     = "Saldo insuficiente no local de estoque"
+	ErrInvalidFormPart       = "Peça do atendimento inválida: informe peça, local, quantidade positiva com até 3 casas decimais e preço com até 2 casas decimais"
+	ErrInvalidStockFilter    = "Filtro inválido: informe identificadores válidos"
+)
+
+// Create part
+// (POST /v1/parts/create)
+func (api *Handlers) PostCreatePart(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostCreatePartJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostCreatePartJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.CriarPeca
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostCreatePartJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostCreatePartJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidPart,
+		})
+	}
+
+	minStock := decimal.Zero
+	if payload.EstoqueMinimo != nil {
+		minStock = decimal.NewFromFloat(*payload.EstoqueMinimo)
+	}
+
+	id, err := api.partsUsecase.CreatePart(usecase.CreatePartInput{
+		SKU:      payload.Sku,
+		Name:     payload.Nome,
+		Unit:     payload.Unidade.ToValue(),
+		Cost:     decimal.NewFromFloat(payload.Custo),
+		Price:    decimal.NewFromFloat(payload.Preco),
+		MinStock: minStock,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrPartSKUAlreadyExists):
+			return spec.PostCreatePartJSON409Response(spec.ErrorResponse{
+				Message: ErrPartSKUAlreadyExists,
+			})
+		case errors.Is(err, domains.ErrInvalidPartUnit):
+			return spec.PostCreatePartJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidPartUnit,
+			})
+		case errors.Is(err, domains.ErrInvalidPart):
+			return spec.PostCreatePartJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidPart,
+			})
+		}
+		return spec.PostCreatePartJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostCreatePartJSON201Response(spec.Resp200{
+		Message: "Peça cadastrada com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List parts
+// (GET /v1/parts/list)
+func (api *Handlers) ListParts(w http.ResponseWriter, r *http.Request, params spec.ListPartsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListPartsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	search := ""
+	if params.Busca != nil {
+		search = strings.TrimSpace(*params.Busca)
+	}
+	includeInactive := params.IncluirInativas != nil && *params.IncluirInativas
+
+	parts, err := api.partsUsecase.ListParts(search, includeInactive, r.Context())
+	if err != nil {
+		return spec.ListPartsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.ListaPecas{Pecas: make([]spec.Peca, 0, len(parts))}
+	for _, p := range parts {
+		peca := spec.Peca{
+			ID:            p.ID.String(),
+			Sku:           p.SKU,
+			Nome:          p.Name,
+			Custo:         p.Cost.InexactFloat64(),
+			Preco:         p.Price.InexactFloat64(),
+			EstoqueMinimo: p.MinStock.InexactFloat64(),
+			Ativa:         p.Active,
+			CreatedAt:     p.CreatedAt,
+			UpdatedAt:     p.UpdatedAt,
+		}
+		_ = peca.Unidade.FromValue(p.Unit)
+		resp.Pecas = append(resp.Pecas, peca)
+	}
+
+	return spec.ListPartsJSON200Response(resp)
+}
+
+// Update part
+// (PUT /v1/parts/update/{partID})
+func (api *Handlers) PutUpdatePart(w http.ResponseWriter, r *http.Request, partID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutUpdatePartJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutUpdatePartJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.AtualizarPeca
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutUpdatePartJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutUpdatePartJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidPart,
+		})
+	}
+
+	err = api.partsUsecase.UpdatePart(uuid.MustParse(partID), usecase.UpdatePartInput{
+		SKU:      payload.Sku,
+		Name:     payload.Nome,
+		Unit:     payload.Unidade.ToValue(),
+		Cost:     decimal.NewFromFloat(payload.Custo),
+		Price:    decimal.NewFromFloat(payload.Preco),
+		MinStock: decimal.NewFromFloat(payload.EstoqueMinimo),
+		Active:   payload.Ativa,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrPartNotFound):
+			return spec.PutUpdatePartJSON404Response(spec.ErrorResponse{
+				Message: ErrPartNotFound,
+			})
+		case errors.Is(err, domains.ErrPartSKUAlreadyExists):
+			return spec.PutUpdatePartJSON409Response(spec.ErrorResponse{
+				Message: ErrPartSKUAlreadyExists,
+			})
+		case errors.Is(err, domains.ErrInvalidPartUnit):
+			return spec.PutUpdatePartJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidPartUnit,
+			})
+		case errors.Is(err, domains.ErrInvalidPart):
+			return spec.PutUpdatePartJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidPart,
+			})
+		}
+		return spec.PutUpdatePartJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutUpdatePartJSON204Response(spec.Resp204{})
+}
+
+// Create stock location
+// (POST /v1/stock-locations/create)
+func (api *Handlers) PostCreateStockLocation(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostCreateStockLocationJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostCreateStockLocationJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.CriarLocalEstoque
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostCreateStockLocationJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostCreateStockLocationJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidStockLocation,
+		})
+	}
+	memberID, ok := parseOptionalUUID(payload.TecnicoID)
+	if !ok {
+		return spec.PostCreateStockLocationJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidStockLocation,
+		})
+	}
+
+	id, err := api.partsUsecase.CreateStockLocation(usecase.CreateStockLocationInput{
+		Kind:     payload.Tipo.ToValue(),
+		Name:     payload.Nome,
+		MemberID: memberID,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrStockLocationExists):
+			return spec.PostCreateStockLocationJSON409Response(spec.ErrorResponse{
+				Message: ErrStockLocationExists,
+			})
+		case errors.Is(err, domains.ErrMemberNotFound):
+			return spec.PostCreateStockLocationJSON400Response(spec.ErrorResponse{
+				Message: ErrStockMemberNotFound,
+			})
+		case errors.Is(err, domains.ErrInvalidStockLocation):
+			return spec.PostCreateStockLocationJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidStockLocation,
+			})
+		}
+		return spec.PostCreateStockLocationJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostCreateStockLocationJSON201Response(spec.Resp200{
+		Message: "Local de estoque cadastrado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List stock locations
+// (GET /v1/stock-locations/list)
+func (api *Handlers) ListStockLocations(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListStockLocationsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	locations, err := api.partsUsecase.ListStockLocations(r.Context())
+	if err != nil {
+		return spec.ListStockLocationsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.ListaLocaisEstoque{Locais: make([]spec.LocalEstoque, 0, len(locations))}
+	for _, l := range locations {
+		local := spec.LocalEstoque{
+			ID:        l.ID.String(),
+			Nome:      l.Name,
+			CreatedAt: l.CreatedAt,
+		}
+		_ = local.Tipo.FromValue(l.Kind)
+		if l.MemberID != uuid.Nil {
+			id, name := l.MemberID.String(), l.MemberName
+			local.TecnicoID = &id
+			local.NomeTecnico = &name
+		}
+		resp.Locais = append(resp.Locais, local)
+	}
+
+	return spec.ListStockLocationsJSON200Response(resp)
+}
+
+// List stock levels
+// (GET /v1/stock/levels)
+func (api *Handlers) ListStockLevels(w http.ResponseWriter, r *http.Request, params spec.ListStockLevelsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListStockLevelsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	locationID, ok := parseOptionalUUID(params.LocalID)
+	if !ok {
+		return spec.ListStockLevelsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidStockFilter,
+		})
+	}
+	partID, ok := parseOptionalUUID(params.PecaID)
+	if !ok {
+		return spec.ListStockLevelsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidStockFilter,
+		})
+	}
+
+	levels, err := api.partsUsecase.ListStockLevels(locationID, partID, r.Context())
+	if err != nil {
+		return spec.ListStockLevelsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.ListaSaldosEstoque{Saldos: make([]spec.SaldoEstoque, 0, len(levels))}
+	for _, s := range levels {
+		resp.Saldos = append(resp.Saldos, spec.SaldoEstoque{
+			LocalID:    s.LocationID.String(),
+			NomeLocal:  s.LocationName,
+			PecaID:     s.PartID.String(),
+			Sku:        s.SKU,
+			NomePeca:   s.PartName,
+			Unidade:    s.Unit,
+			Quantidade: s.Quantity.InexactFloat64(),
+			UpdatedAt:  s.UpdatedAt,
+		})
+	}
+
+	return spec.ListStockLevelsJSON200Response(resp)
+}
+
+// Create stock movement
+// (POST /v1/stock/movements)
+func (api *Handlers) PostStockMovement(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostStockMovementJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostStockMovementJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.LancarMovimentacaoEstoque
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostStockMovementJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostStockMovementJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidStockMovement,
+		})
+	}
+	destination, ok := parseOptionalUUID(payload.LocalDestinoID)
+	if !ok {
+		return spec.PostStockMovementJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidStockMovement,
+		})
+	}
+
+	notes := ""
+	if payload.Observacao != nil {
+		notes = *payload.Observacao
+	}
+
+	ids, err := api.partsUsecase.CreateStockMovement(usecase.CreateStockMovementInput{
+		PartID:      uuid.MustParse(payload.PecaID),
+		LocationID:  uuid.MustParse(payload.LocalID),
+		Destination: destination,
+		Kind:        payload.Tipo.ToValue(),
+		Quantity:    decimal.NewFromFloat(payload.Quantidade),
+		Notes:       notes,
+		CreatedBy:   userID,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrInsufficientStock):
+			return spec.PostStockMovementJSON409Response(spec.ErrorResponse{
+				Message: ErrInsufficientStock,
+			})
+		case errors.Is(err, domains.ErrPartNotFound):
+			return spec.PostStockMovementJSON404Response(spec.ErrorResponse{
+				Message: ErrPartNotFound,
+			})
+		case errors.Is(err, domains.ErrStockLocationNotFound):
+			return spec.PostStockMovementJSON404Response(spec.ErrorResponse{
+				Message: ErrStockLocationNotFound,
+			})
+		case errors.Is(err, domains.ErrInvalidStockMovement):
+			return spec.PostStockMovementJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidStockMovement,
+			})
+		}
+		return spec.PostStockMovementJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.MovimentacaoLancada{
+		Message: "Movimentação lançada com sucesso",
+		Ids:     make([]string, 0, len(ids)),
+	}
+	for _, id := range ids {
+		resp.Ids = append(resp.Ids, id.String())
+	}
+	return spec.PostStockMovementJSON201Response(resp)
+}
+
+// List stock movements
+// (GET /v1/stock/movements)
+func (api *Handlers) ListStockMovements(w http.ResponseWriter, r *http.Request, params spec.ListStockMovementsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListStockMovementsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	input := usecase.ListStockMovementsInput{}
+	var ok bool
+	if input.PartID, ok = parseOptionalUUID(params.PecaID); !ok {
+		return spec.ListStockMovementsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidStockFilter,
+		})
+	}
+	if input.LocationID, ok = parseOptionalUUID(params.LocalID); !ok {
+		return spec.ListStockMovementsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidStockFilter,
+		})
+	}
+	if input.FormID, ok = parseOptionalUUID(params.AtendimentoID); !ok {
+		return spec.ListStockMovementsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidStockFilter,
+		})
+	}
+	if params.Limite != nil {
+		input.Limit = *params.Limite
+	}
+
+	movements, err := api.partsUsecase.ListStockMovements(input, r.Context())
+	if err != nil {
+		return spec.ListStockMovementsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.ListaMovimentacoesEstoque{Movimentacoes: make([]spec.MovimentacaoEstoque, 0, len(movements))}
+	for _, m := range movements {
+		mov := spec.MovimentacaoEstoque{
+			ID:         m.ID.String(),
+			PecaID:     m.PartID.String(),
+			Sku:        m.SKU,
+			NomePeca:   m.PartName,
+			LocalID:    m.LocationID.String(),
+			NomeLocal:  m.LocationName,
+			Quantidade: m.Quantity.InexactFloat64(),
+			Observacao: m.Notes,
+			CreatedAt:  m.CreatedAt,
+		}
+		_ = mov.Tipo.FromValue(m.Kind)
+		if m.FormID != uuid.Nil {
+			id := m.FormID.String()
+			mov.AtendimentoID = &id
+		}
+		if m.TransferID != uuid.Nil {
+			id := m.TransferID.String()
+			mov.TransferenciaID = &id
+		}
+		resp.Movimentacoes = append(resp.Movimentacoes, mov)
+	}
+
+	return spec.ListStockMovementsJSON200Response(resp)
+}
+
+// Low stock report
+// (GET /v1/stock/low)
+func (api *Handlers) GetLowStockReport(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetLowStockReportJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	items, err := api.partsUsecase.LowStockReport(r.Context())
+	if err != nil {
+		return spec.GetLowStockReportJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.RelatorioEstoqueBaixo{Itens: make([]spec.ItemEstoqueBaixo, 0, len(items))}
+	for _, i := range items {
+		resp.Itens = append(resp.Itens, spec.ItemEstoqueBaixo{
+			PecaID:        i.PartID.String(),
+			Sku:           i.SKU,
+			Nome:          i.Name,
+			Unidade:       i.Unit,
+			EstoqueMinimo: i.MinStock.InexactFloat64(),
+			Quantidade:    i.Quantity.InexactFloat64(),
+			Falta:         i.Shortage.InexactFloat64(),
+		})
+	}
+
+	return spec.GetLowStockReportJSON200Response(resp)
+}
+
+// Add form part
+// (POST /v1/forms/{formID}/parts)
+func (api *Handlers) PostFormPart(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostFormPartJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.PostFormPartJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	var payload spec.LancarPecaAtendimento
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostFormPartJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostFormPartJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidFormPart,
+		})
+	}
+
+	input := usecase.AddFormPartInput{
+		PartID:     uuid.MustParse(payload.PecaID),
+		LocationID: uuid.MustParse(payload.LocalID),
+		Quantity:   decimal.NewFromFloat(payload.Quantidade),
+		CreatedBy:  userID,
+		Admin:      admin,
+	}
+	if payload.PrecoUnitario != nil {
+		price := decimal.NewFromFloat(*payload.PrecoUnitario)
+		input.UnitPrice = &price
+	}
+
+	id, err := api.partsUsecase.AddFormPart(uuid.MustParse(formID), input, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PostFormPartJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrPartNotFound):
+			return spec.PostFormPartJSON404Response(spec.ErrorResponse{
+				Message: ErrPartNotFound,
+			})
+		case errors.Is(err, domains.ErrStockLocationNotFound):
+			return spec.PostFormPartJSON404Response(spec.ErrorResponse{
+				Message: ErrStockLocationNotFound,
+			})
+		case errors.Is(err, domains.ErrFormSigned):
+			return spec.PostFormPartJSON403Response(spec.ErrorResponse{
+				Message: ErrFormSigned,
+			})
+		case errors.Is(err, domains.ErrInsufficientStock):
+			return spec.PostFormPartJSON409Response(spec.ErrorResponse{
+				Message: ErrInsufficientStock,
+			})
+		case errors.Is(err, domains.ErrPartInactive):
+			return spec.PostFormPartJSON400Response(spec.ErrorResponse{
+				Message: ErrPartInactive,
+			})
+		case errors.Is(err, domains.ErrInvalidFormPart):
+			return spec.PostFormPartJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidFormPart,
+			})
+		}
+		return spec.PostFormPartJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostFormPartJSON201Response(spec.Resp200{
+		Message: "Peça lançada com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List form parts
+// (GET /v1/forms/{formID}/parts)
+func (api *Handlers) ListFormParts(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListFormPartsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	parts, err := api.partsUsecase.ListFormParts(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.ListFormPartsJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.ListFormPartsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.ListaPecasAtendimento{
+		Pecas:      make([]spec.PecaAtendimento, 0, len(parts.Parts)),
+		Total:      parts.Total.InexactFloat64(),
+		CustoTotal: parts.TotalCost.InexactFloat64(),
+	}
+	for _, p := range parts.Parts {
+		resp.Pecas = append(resp.Pecas, spec.PecaAtendimento{
+			ID:            p.ID.String(),
+			PecaID:        p.PartID.String(),
+			Sku:           p.SKU,
+			NomePeca:      p.PartName,
+			Unidade:       p.Unit,
+			LocalID:       p.LocationID.String(),
+			NomeLocal:     p.LocationName,
+			Quantidade:    p.Quantity.InexactFloat64(),
+			PrecoUnitario: p.UnitPrice.InexactFloat64(),
+			Total:         p.Total.InexactFloat64(),
+			CreatedAt:     p.CreatedAt,
+		})
+	}
+
+	return spec.ListFormPartsJSON200Response(resp)
+}
+
+// Remove form part
+// (DELETE /v1/forms/{formID}/parts/{formPartID})
+func (api *Handlers) DeleteFormPart(w http.ResponseWriter, r *http.Request, formID string, formPartID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteFormPartJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.DeleteFormPartJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	if err := api.partsUsecase.RemoveFormPart(uuid.MustParse(formID), uuid.MustParse(formPartID), userID, admin, r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormPartNotFound):
+			return spec.DeleteFormPartJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrFormSigned):
+			return spec.DeleteFormPartJSON403Response(spec.ErrorResponse{
+				Message: ErrFormSigned,
+			})
+		}
+		return spec.DeleteFormPartJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteFormPartJSON204Response(spec.Resp204{})
+}
@@ -0,0 +1,580 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+	"strings"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidCredentials        = "E-mail ou senha inválidos"
+	ErrClientUserEmailTaken      = "Já existe um usuário do portal com esse e-mail"
+	ErrPortalRequestClosed       = "Solicitação já foi revisada"
+	ErrPortalRequestFormMismatch = "O atendimento informado pertence a outro cliente"
+	ErrPortalRequestFormRequired = "Informe o atendimento criado para converter a solicitação"
+	ErrPortalRequestNote         = "Informe o motivo para recusar a solicitação"
+)
+
+// portalUser carrega o usuário do portal autenticado. O cliente usado nas
+// consultas vem do cadastro, e não do token, para que um usuário removido ou
+// transferido de cliente perca o acesso imediatamente.
+func (api *Handlers) portalUser(ctx context.Context) (*domains.ClientUser, error) {
+	clientUserID, err := GetClientUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return api.portalUsecase.GetClientUser(clientUserID, ctx)
+}
+
+// Portal login
+// (POST /v1/portal/login)
+func (api *Handlers) PostPortalLogin(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var payload spec.LoginReq
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostPortalLoginJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostPortalLoginJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	token, err := api.portalUsecase.LoginClientUser(usecase.LoginUserInput{
+		Email:    string(payload.Email),
+		Password: payload.Password,
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidCredentials) {
+			return spec.PostPortalLoginJSON401Response(spec.ErrorResponse{
+				Message: ErrInvalidCredentials,
+			})
+		}
+		api.logger.Error("failed to login client user", zap.Error(err))
+		return spec.PostPortalLoginJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostPortalLoginJSON200Response(spec.LoginRes{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		ExpiresIn:   &token.ExpiresIn,
+	})
+}
+
+// Portal account
+// (GET /v1/portal/me)
+func (api *Handlers) GetPortalAccount(w http.ResponseWriter, r *http.Request) *spec.Response {
+	user, err := api.portalUser(r.Context())
+	if err != nil {
+		return spec.GetPortalAccountJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	client, err := api.clientsUsecase.GetClient(user.ClientID, r.Context())
+	if err != nil {
+		return spec.GetPortalAccountJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetPortalAccountJSON200Response(spec.ContaPortal{
+		ID:          user.ID.String(),
+		Nome:        user.Name,
+		Email:       types.Email(user.Email),
+		ClienteID:   user.ClientID.String(),
+		NomeCliente: client.ClientName,
+	})
+}
+
+// Open portal request
+// (POST /v1/portal/requests/create)
+func (api *Handlers) PostPortalRequest(w http.ResponseWriter, r *http.Request) *spec.Response {
+	user, err := api.portalUser(r.Context())
+	if err != nil {
+		return spec.PostPortalRequestJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.CriarSolicitacaoPortal
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostPortalRequestJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostPortalRequestJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	id, err := api.portalUsecase.CreatePortalRequest(user, usecase.CreatePortalRequestInput{
+		Description: payload.Descricao,
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidPortalRequestDescription) {
+			return spec.PostPortalRequestJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		}
+		return spec.PostPortalRequestJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostPortalRequestJSON201Response(spec.Resp200{
+		Message: "Solicitação aberta com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List own portal requests
+// (GET /v1/portal/requests/list)
+func (api *Handlers) ListPortalOwnRequests(w http.ResponseWriter, r *http.Request) *spec.Response {
+	user, err := api.portalUser(r.Context())
+	if err != nil {
+		return spec.ListPortalOwnRequestsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	requests, err := api.portalUsecase.ListPortalRequests(usecase.ListPortalRequestsInput{
+		ClientID: user.ClientID,
+	}, r.Context())
+	if err != nil {
+		return spec.ListPortalOwnRequestsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.ListPortalOwnRequestsJSON200Response(spec.ListaSolicitacoesPortal{
+		Solicitacoes: toSpecSolicitacoes(requests),
+	})
+}
+
+// List own forms
+// (GET /v1/portal/forms/list)
+func (api *Handlers) ListPortalForms(w http.ResponseWriter, r *http.Request) *spec.Response {
+	user, err := api.portalUser(r.Context())
+	if err != nil {
+		return spec.ListPortalFormsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	forms, err := api.portalUsecase.ListClientForms(user.ClientID, r.Context())
+	if err != nil {
+		return spec.ListPortalFormsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	atendimentos := make([]spec.AtendimentoPortal, 0, len(forms))
+	for _, f := range forms {
+		atendimentos = append(atendimentos, toSpecAtendimentoPortal(f))
+	}
+
+	return spec.ListPortalFormsJSON200Response(spec.ListaAtendimentosPortal{
+		Atendimentos: atendimentos,
+	})
+}
+
+// Get own form
+// (GET /v1/portal/forms/{formID})
+func (api *Handlers) GetPortalForm(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	user, err := api.portalUser(r.Context())
+	if err != nil {
+		return spec.GetPortalFormJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	id, err := uuid.Parse(formID)
+	if err != nil {
+		return spec.GetPortalFormJSON404Response(spec.ErrorResponse{
+			Message: ErrNotFound,
+		})
+	}
+
+	form, err := api.portalUsecase.GetClientForm(user.ClientID, id, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.GetPortalFormJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.GetPortalFormJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetPortalFormJSON200Response(spec.BuscaAtendimentoPortal{
+		Atendimento: toSpecAtendimentoPortal(*form),
+	})
+}
+
+// List own form comments
+// (GET /v1/portal/forms/{formID}/comments)
+func (api *Handlers) ListPortalFormComments(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	user, err := api.portalUser(r.Context())
+	if err != nil {
+		return spec.ListPortalFormCommentsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	id, err := uuid.Parse(formID)
+	if err != nil {
+		return spec.ListPortalFormCommentsJSON404Response(spec.ErrorResponse{
+			Message: ErrNotFound,
+		})
+	}
+
+	comments, err := api.commentsUsecase.ListClientComments(user.ClientID, id, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.ListPortalFormCommentsJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.ListPortalFormCommentsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.ListPortalFormCommentsJSON200Response(toSpecListaComentarios(comments.Comments))
+}
+
+// Download forms report
+// (GET /v1/portal/reports/forms)
+func (api *Handlers) GetPortalFormsReport(w http.ResponseWriter, r *http.Request) *spec.Response {
+	user, err := api.portalUser(r.Context())
+	if err != nil {
+		return spec.GetPortalFormsReportJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	forms, err := api.portalUsecase.ListClientForms(user.ClientID, r.Context())
+	if err != nil {
+		return spec.GetPortalFormsReportJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	// O relatório usa ponto e vírgula como separador, padrão do Excel em
+	// português, e é escrito direto na resposta.
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="atendimentos.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	cw.Comma = ';'
+	_ = cw.Write([]string{"id", "protocolo", "data_de_abertura", "solicitante", "defeito", "solucao", "situacao", "horas_consumidas", "tecnicos"})
+	for _, f := range forms {
+		_ = cw.Write([]string{
+			f.ID.String(),
+			f.PublicCode,
+			f.DataDeAbertura.Format("2006-01-02 15:04"),
+			f.SolicitedBy,
+			f.DefectDescription,
+			f.SolutionDescription,
+			f.Status,
+			f.HoursConsumed.String(),
+			strings.Join(f.Tecnicos, ", "),
+		})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		api.logger.Error("failed to write portal report", zap.Error(err))
+	}
+
+	return nil
+}
+
+// Create portal user
+// (POST /v1/portal-users/create)
+func (api *Handlers) PostCreatePortalUser(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostCreatePortalUserJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostCreatePortalUserJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.CriarUsuarioPortal
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostCreatePortalUserJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostCreatePortalUserJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	id, err := api.portalUsecase.CreateClientUser(usecase.CreateClientUserInput{
+		ClientID: uuid.MustParse(payload.ClienteID),
+		Name:     payload.Nome,
+		Email:    string(payload.Email),
+		Password: payload.Password,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrClientNotFound):
+			return spec.PostCreatePortalUserJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrClientUserEmailTaken):
+			return spec.PostCreatePortalUserJSON409Response(spec.ErrorResponse{
+				Message: ErrClientUserEmailTaken,
+			})
+		case errors.Is(err, domains.ErrInvalidUserName),
+			errors.Is(err, domains.ErrInvalidUserNameLength),
+			errors.Is(err, domains.ErrInvalidUserEmail):
+			return spec.PostCreatePortalUserJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		}
+		return spec.PostCreatePortalUserJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostCreatePortalUserJSON201Response(spec.Resp200{
+		Message: "Usuário do portal criado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List portal users
+// (GET /v1/portal-users/list)
+func (api *Handlers) ListPortalUsers(w http.ResponseWriter, r *http.Request, params spec.ListPortalUsersParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListPortalUsersJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	users, err := api.portalUsecase.ListClientUsers(uuid.MustParse(params.ClienteID), r.Context())
+	if err != nil {
+		return spec.ListPortalUsersJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	usuarios := make([]spec.UsuarioPortal, 0, len(users))
+	for _, u := range users {
+		usuario := spec.UsuarioPortal{
+			ID:        u.ID.String(),
+			ClienteID: u.ClientID.String(),
+			Nome:      u.Name,
+			Email:     types.Email(u.Email),
+			CreatedAt: u.CreatedAt.UTC(),
+		}
+		if !u.LastLoginAt.IsZero() {
+			lastLogin := u.LastLoginAt.UTC()
+			usuario.UltimoLogin = &lastLogin
+		}
+		usuarios = append(usuarios, usuario)
+	}
+
+	return spec.ListPortalUsersJSON200Response(spec.ListaUsuariosPortal{
+		Usuarios: usuarios,
+	})
+}
+
+// Delete portal user
+// (DELETE /v1/portal-users/delete/{portalUserID})
+func (api *Handlers) DeletePortalUser(w http.ResponseWriter, r *http.Request, portalUserID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeletePortalUserJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.DeletePortalUserJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	if err := api.portalUsecase.DeleteClientUser(uuid.MustParse(portalUserID), r.Context()); err != nil {
+		if errors.Is(err, domains.ErrClientUserNotFound) {
+			return spec.DeletePortalUserJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.DeletePortalUserJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeletePortalUserJSON204Response(spec.Resp204{
+		Message: "Usuário do portal removido com sucesso",
+	})
+}
+
+// List portal requests
+// (GET /v1/portal-requests/list)
+func (api *Handlers) ListPortalRequests(w http.ResponseWriter, r *http.Request, params spec.ListPortalRequestsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListPortalRequestsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	input := usecase.ListPortalRequestsInput{}
+	if params.ClienteID != nil {
+		input.ClientID = uuid.MustParse(*params.ClienteID)
+	}
+	if params.Status != nil {
+		input.Status = params.Status.ToValue()
+	}
+
+	requests, err := api.portalUsecase.ListPortalRequests(input, r.Context())
+	if err != nil {
+		return spec.ListPortalRequestsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.ListPortalRequestsJSON200Response(spec.ListaSolicitacoesPortal{
+		Solicitacoes: toSpecSolicitacoes(requests),
+	})
+}
+
+// Review portal request
+// (PUT /v1/portal-requests/review/{requestID})
+func (api *Handlers) PutReviewPortalRequest(w http.ResponseWriter, r *http.Request, requestID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutReviewPortalRequestJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.RevisarSolicitacaoPortal
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutReviewPortalRequestJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutReviewPortalRequestJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	input := usecase.ReviewPortalRequestInput{Status: payload.Status.ToValue()}
+	if payload.FormularioID != nil {
+		input.FormID = uuid.MustParse(*payload.FormularioID)
+	}
+	if payload.Observacao != nil {
+		input.Note = *payload.Observacao
+	}
+	if input.Status == domains.PortalRequestStatusConverted && input.FormID == uuid.Nil {
+		return spec.PutReviewPortalRequestJSON400Response(spec.ErrorResponse{
+			Message: ErrPortalRequestFormRequired,
+		})
+	}
+
+	if err := api.portalUsecase.ReviewPortalRequest(uuid.MustParse(requestID), input, r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrPortalRequestNotFound):
+			return spec.PutReviewPortalRequestJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrPortalRequestClosed):
+			return spec.PutReviewPortalRequestJSON409Response(spec.ErrorResponse{
+				Message: ErrPortalRequestClosed,
+			})
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PutReviewPortalRequestJSON400Response(spec.ErrorResponse{
+				Message: ErrPortalRequestFormRequired,
+			})
+		case errors.Is(err, domains.ErrPortalRequestFormMismatch):
+			return spec.PutReviewPortalRequestJSON400Response(spec.ErrorResponse{
+				Message: ErrPortalRequestFormMismatch,
+			})
+		case errors.Is(err, domains.ErrInvalidPortalRequestNote):
+			return spec.PutReviewPortalRequestJSON400Response(spec.ErrorResponse{
+				Message: ErrPortalRequestNote,
+			})
+		case errors.Is(err, domains.ErrInvalidPortalRequestStatus):
+			return spec.PutReviewPortalRequestJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		}
+		return spec.PutReviewPortalRequestJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutReviewPortalRequestJSON204Response(spec.Resp204{
+		Message: "Solicitação revisada com sucesso",
+	})
+}
+
+func toSpecSolicitacoes(requests []usecase.PortalRequestOutput) []spec.SolicitacaoPortal {
+	solicitacoes := make([]spec.SolicitacaoPortal, 0, len(requests))
+	for _, req := range requests {
+		var status spec.StatusSolicitacaoPortal
+		_ = status.FromValue(req.Status)
+
+		solicitacao := spec.SolicitacaoPortal{
+			ID:          req.ID.String(),
+			ClienteID:   req.Cliente.ID.String(),
+			NomeCliente: req.Cliente.ClientName,
+			Solicitante: req.RequesterName,
+			Descricao:   req.Description,
+			Status:      status,
+			Observacao:  optionalString(req.ReviewNote),
+			CreatedAt:   req.CreatedAt.UTC(),
+			UpdatedAt:   req.UpdatedAt.UTC(),
+		}
+		if req.FormID != uuid.Nil {
+			formID := req.FormID.String()
+			solicitacao.FormularioID = &formID
+		}
+		solicitacoes = append(solicitacoes, solicitacao)
+	}
+	return solicitacoes
+}
+
+func toSpecAtendimentoPortal(f usecase.PortalFormOutput) spec.AtendimentoPortal {
+	return spec.AtendimentoPortal{
+		ID:               f.ID.String(),
+		Protocolo:        f.PublicCode,
+		DataDeAbertura:   f.DataDeAbertura.UTC(),
+		SolicitadoPor:    f.SolicitedBy,
+		DescricaoDefeito: f.DefectDescription,
+		DescricaoSolucao: f.SolutionDescription,
+		Status:           toSpecStatusAtendimento(f.Status),
+		HorasConsumidas:  f.HoursConsumed.InexactFloat64(),
+		Tecnicos:         f.Tecnicos,
+		UpdatedAt:        f.UpdatedAt,
+	}
+}
@@ -0,0 +1,308 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidSchedule      = "Agenda inválida: cada técnico deve aparecer uma vez, com início e fim, e a visita deve durar no máximo 24 horas"
+	ErrInvalidCalendarRange = "Período inválido: o início deve ser anterior ao fim e o período deve ter no máximo 62 dias"
+	ErrScheduleConflict     = "Há técnicos com outra visita agendada no período"
+	ErrMemberNotAssigned    = "O técnico não está atribuído ao atendimento"
+	ErrNoFreeSlot           = "Nenhum horário livre encontrado nos próximos 60 dias"
+	ErrInvalidRouteOptions  = "Roteiro inválido: informe latitude e longitude da partida juntas, velocidade de até 200 km/h e visitas de até 12 horas"
+)
+
+// Schedule form technicians
+// (PUT /v1/forms/{formID}/schedule)
+func (api *Handlers) PutFormSchedule(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutFormScheduleJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.AgendarAtendimento
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutFormScheduleJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutFormScheduleJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidSchedule,
+		})
+	}
+
+	admin, err := api.isAdmin(r.Context(), userID)
+	if err != nil {
+		return spec.PutFormScheduleJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	assignments := make([]usecase.AssignmentScheduleInput, 0, len(payload.Agendamentos))
+	for _, a := range payload.Agendamentos {
+		assignments = append(assignments, usecase.AssignmentScheduleInput{
+			MemberID: uuid.MustParse(a.TecnicoID),
+			Start:    a.Inicio,
+			End:      a.Fim,
+		})
+	}
+
+	output, err := api.scheduleUsecase.ScheduleForm(uuid.MustParse(formID), usecase.ScheduleFormInput{
+		Assignments:     assignments,
+		IgnoreConflicts: payload.IgnorarConflitos != nil && *payload.IgnorarConflitos,
+		Admin:           admin,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrScheduleConflict):
+			return spec.PutFormScheduleJSON409Response(spec.AvisoConflitoAgenda{
+				Message:   ErrScheduleConflict,
+				Conflitos: toSpecItensAgenda(output.Conflicts),
+			})
+		case errors.Is(err, domains.ErrInvalidSchedule):
+			return spec.PutFormScheduleJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSchedule,
+			})
+		case errors.Is(err, domains.ErrMemberNotAssigned):
+			return spec.PutFormScheduleJSON400Response(spec.ErrorResponse{
+				Message: ErrMemberNotAssigned,
+			})
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PutFormScheduleJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrFormSigned):
+			return spec.PutFormScheduleJSON403Response(spec.ErrorResponse{
+				Message: ErrFormSigned,
+			})
+		}
+		return spec.PutFormScheduleJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutFormScheduleJSON204Response(spec.Resp204{})
+}
+
+// Get team calendar
+// (GET /v1/technicians/calendar)
+func (api *Handlers) GetTeamCalendar(w http.ResponseWriter, r *http.Request, params spec.GetTeamCalendarParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetTeamCalendarJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.scheduleUsecase.Calendar(usecase.CalendarInput{
+		From: params.Inicio,
+		To:   params.Fim,
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidCalendarRange) {
+			return spec.GetTeamCalendarJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidCalendarRange,
+			})
+		}
+		return spec.GetTeamCalendarJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetTeamCalendarJSON200Response(spec.AgendaTecnicos{
+		Itens: toSpecItensAgenda(output.Entries),
+	})
+}
+
+// Get technician calendar
+// (GET /v1/technicians/{memberID}/calendar)
+func (api *Handlers) GetTechnicianCalendar(w http.ResponseWriter, r *http.Request, memberID string, params spec.GetTechnicianCalendarParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetTechnicianCalendarJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.scheduleUsecase.Calendar(usecase.CalendarInput{
+		MemberID: uuid.MustParse(memberID),
+		From:     params.Inicio,
+		To:       params.Fim,
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidCalendarRange) {
+			return spec.GetTechnicianCalendarJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidCalendarRange,
+			})
+		}
+		return spec.GetTechnicianCalendarJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetTechnicianCalendarJSON200Response(spec.AgendaTecnicos{
+		Itens: toSpecItensAgenda(output.Entries),
+	})
+}
+
+// Find technician next free slot
+// (GET /v1/technicians/{memberID}/next-free-slot)
+func (api *Handlers) GetTechnicianNextFreeSlot(w http.ResponseWriter, r *http.Request, memberID string, params spec.GetTechnicianNextFreeSlotParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetTechnicianNextFreeSlotJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	input := usecase.NextFreeSlotInput{
+		MemberID: uuid.MustParse(memberID),
+		Duration: time.Duration(params.DuracaoMinutos) * time.Minute,
+	}
+	if params.APartirDe != nil {
+		input.From = *params.APartirDe
+	}
+
+	output, err := api.scheduleUsecase.NextFreeSlot(input, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrInvalidSchedule):
+			return spec.GetTechnicianNextFreeSlotJSON400Response(spec.ErrorResponse{
+				Message: ErrBadRequest,
+			})
+		case errors.Is(err, domains.ErrNoFreeSlot):
+			return spec.GetTechnicianNextFreeSlotJSON404Response(spec.ErrorResponse{
+				Message: ErrNoFreeSlot,
+			})
+		}
+		return spec.GetTechnicianNextFreeSlotJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetTechnicianNextFreeSlotJSON200Response(spec.HorarioLivre{
+		Inicio: output.Start,
+		Fim:    output.End,
+	})
+}
+
+// Get technician daily route
+// (GET /v1/technicians/{memberID}/route)
+func (api *Handlers) GetTechnicianRoute(w http.ResponseWriter, r *http.Request, memberID string, params spec.GetTechnicianRouteParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetTechnicianRouteJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	if (params.OrigemLatitude == nil) != (params.OrigemLongitude == nil) {
+		return spec.GetTechnicianRouteJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidRouteOptions,
+		})
+	}
+
+	input := usecase.RouteInput{
+		MemberID: uuid.MustParse(memberID),
+		Date:     params.Date.Time,
+	}
+	if params.OrigemLatitude != nil {
+		input.Depot = &domains.GeoPoint{Latitude: *params.OrigemLatitude, Longitude: *params.OrigemLongitude}
+	}
+	if params.Saida != nil {
+		input.Departure = *params.Saida
+	}
+	if params.VelocidadeKmh != nil {
+		input.SpeedKmh = *params.VelocidadeKmh
+	}
+	if params.TempoParadaMinutos != nil {
+		input.StopDuration = time.Duration(*params.TempoParadaMinutos) * time.Minute
+	}
+
+	output, err := api.scheduleUsecase.Route(input, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidRouteOptions) {
+			return spec.GetTechnicianRouteJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidRouteOptions,
+			})
+		}
+		return spec.GetTechnicianRouteJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	paradas := make([]spec.ParadaRota, 0, len(output.Stops))
+	for _, s := range output.Stops {
+		paradas = append(paradas, spec.ParadaRota{
+			Ordem:                s.Sequence,
+			AtendimentoID:        s.FormID.String(),
+			Protocolo:            s.PublicCode,
+			ClienteID:            s.ClientID.String(),
+			ClienteNome:          s.ClientName,
+			Endereco:             s.Address,
+			Situacao:             s.Status,
+			Descricao:            s.DefectDescription,
+			Latitude:             s.Latitude,
+			Longitude:            s.Longitude,
+			DistanciaKm:          s.DistanceKm,
+			DistanciaAcumuladaKm: s.CumulativeKm,
+			Chegada:              s.Arrival,
+			Saida:                s.Departure,
+		})
+	}
+	semLocalizacao := make([]spec.AtendimentoSemLocalizacao, 0, len(output.Unlocated))
+	for _, s := range output.Unlocated {
+		semLocalizacao = append(semLocalizacao, spec.AtendimentoSemLocalizacao{
+			AtendimentoID: s.FormID.String(),
+			Protocolo:     s.PublicCode,
+			ClienteID:     s.ClientID.String(),
+			ClienteNome:   s.ClientName,
+			Endereco:      s.Address,
+			Situacao:      s.Status,
+			Descricao:     s.DefectDescription,
+		})
+	}
+
+	return spec.GetTechnicianRouteJSON200Response(spec.RotaTecnico{
+		Data:             types.Date{Time: output.Date},
+		Saida:            output.Departure,
+		DistanciaTotalKm: output.TotalKm,
+		Paradas:          paradas,
+		SemLocalizacao:   semLocalizacao,
+		Trajeto: spec.GeoJSONLineString{
+			Type:        spec.GeoJSONLineStringTypeLineString,
+			Coordinates: output.Path,
+		},
+	})
+}
+
+func toSpecItensAgenda(entries []usecase.CalendarEntryOutput) []spec.ItemAgenda {
+	itens := make([]spec.ItemAgenda, 0, len(entries))
+	for _, e := range entries {
+		itens = append(itens, spec.ItemAgenda{
+			AtendimentoID: e.FormID.String(),
+			Protocolo:     e.PublicCode,
+			TecnicoID:     e.MemberID.String(),
+			TecnicoNome:   e.MemberName,
+			ClienteNome:   e.ClientName,
+			Situacao:      e.Status,
+			Descricao:     e.DefectDescription,
+			Inicio:        e.Start,
+			Fim:           e.End,
+		})
+	}
+	return itens
+}
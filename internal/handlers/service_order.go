@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidServiceOrderTemplate = "Modelo inválido: confira o tamanho dos campos e o e-mail"
+	ErrInvalidServiceOrderColor    = "Cor inválida: use o formato #RRGGBB"
+	ErrInvalidServiceOrderTimezone = "Fuso horário inválido: use um nome IANA, como America/Sao_Paulo"
+	ErrServiceOrderLogoMissing     = "Envie o logotipo no campo \"arquivo\" (multipart/form-data)"
+	ErrServiceOrderLogoTooLarge    = "Logotipo maior que o limite de 2 MiB"
+	ErrServiceOrderLogoType        = "Logotipo inválido: envie uma imagem PNG ou JPEG"
+)
+
+// Download service order PDF
+// (GET /v1/forms/{formID}/pdf)
+func (api *Handlers) GetFormServiceOrderPdf(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetFormServiceOrderPdfJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	order, err := api.serviceOrderUsecase.RenderServiceOrder(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.GetFormServiceOrderPdfJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.GetFormServiceOrderPdfJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	// O PDF é escrito direto na resposta, como o relatório CSV do portal.
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `inline; filename="`+order.FileName+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(order.Content)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(order.Content)
+	return nil
+}
+
+// Get service order template
+// (GET /v1/settings/service-order)
+func (api *Handlers) GetServiceOrderTemplate(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetServiceOrderTemplateJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	template, err := api.serviceOrderUsecase.GetTemplate(r.Context())
+	if err != nil {
+		return spec.GetServiceOrderTemplateJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetServiceOrderTemplateJSON200Response(toSpecModeloOrdemServico(*template))
+}
+
+// Update service order template
+// (PUT /v1/settings/service-order)
+func (api *Handlers) PutServiceOrderTemplate(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutServiceOrderTemplateJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutServiceOrderTemplateJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.AtualizarModeloOrdemServico
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutServiceOrderTemplateJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutServiceOrderTemplateJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidServiceOrderTemplate,
+		})
+	}
+
+	template, err := api.serviceOrderUsecase.UpdateTemplate(usecase.UpdateServiceOrderTemplateInput{
+		CompanyName:     payload.NomeEmpresa,
+		CompanyDocument: payload.DocumentoEmpresa,
+		Address:         payload.Endereco,
+		Phone:           payload.Telefone,
+		Email:           payload.Email,
+		Website:         payload.Site,
+		FooterText:      payload.TextoRodape,
+		PrimaryColor:    payload.CorPrimaria,
+		Timezone:        payload.FusoHorario,
+		UpdatedBy:       userID,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrInvalidServiceOrderTemplate):
+			return spec.PutServiceOrderTemplateJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidServiceOrderTemplate,
+			})
+		case errors.Is(err, domains.ErrInvalidServiceOrderColor):
+			return spec.PutServiceOrderTemplateJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidServiceOrderColor,
+			})
+		case errors.Is(err, domains.ErrInvalidServiceOrderTimezone):
+			return spec.PutServiceOrderTemplateJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidServiceOrderTimezone,
+			})
+		}
+		return spec.PutServiceOrderTemplateJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutServiceOrderTemplateJSON200Response(toSpecModeloOrdemServico(*template))
+}
+
+// Upload service order logo
+// (PUT /v1/settings/service-order/logo)
+func (api *Handlers) PutServiceOrderLogo(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutServiceOrderLogoJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutServiceOrderLogoJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	// Folga de 1 MiB para os cabeçalhos do multipart, como nos anexos.
+	r.Body = http.MaxBytesReader(w, r.Body, domains.MaxServiceOrderLogoSize+1<<20)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return spec.PutServiceOrderLogoJSON400Response(spec.ErrorResponse{
+			Message: ErrServiceOrderLogoMissing,
+		})
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				return spec.PutServiceOrderLogoJSON413Response(spec.ErrorResponse{
+					Message: ErrServiceOrderLogoTooLarge,
+				})
+			}
+			return spec.PutServiceOrderLogoJSON400Response(spec.ErrorResponse{
+				Message: ErrServiceOrderLogoMissing,
+			})
+		}
+		if part.FormName() != attachmentFormField || part.FileName() == "" {
+			_, _ = io.Copy(io.Discard, part)
+			continue
+		}
+
+		template, err := api.serviceOrderUsecase.UploadLogo(usecase.UploadServiceOrderLogoInput{
+			Content:   part,
+			UpdatedBy: userID,
+		}, r.Context())
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			switch {
+			case errors.Is(err, domains.ErrServiceOrderLogoTooLarge), errors.As(err, &maxErr):
+				return spec.PutServiceOrderLogoJSON413Response(spec.ErrorResponse{
+					Message: ErrServiceOrderLogoTooLarge,
+				})
+			case errors.Is(err, domains.ErrServiceOrderLogoType):
+				return spec.PutServiceOrderLogoJSON415Response(spec.ErrorResponse{
+					Message: ErrServiceOrderLogoType,
+				})
+			}
+			return spec.PutServiceOrderLogoJSON500Response(spec.ErrorResponse{
+				Message: ErrInternalError,
+			})
+		}
+
+		return spec.PutServiceOrderLogoJSON200Response(toSpecModeloOrdemServico(*template))
+	}
+}
+
+// Delete service order logo
+// (DELETE /v1/settings/service-order/logo)
+func (api *Handlers) DeleteServiceOrderLogo(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteServiceOrderLogoJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.DeleteServiceOrderLogoJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	template, err := api.serviceOrderUsecase.DeleteLogo(userID, r.Context())
+	if err != nil {
+		return spec.DeleteServiceOrderLogoJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteServiceOrderLogoJSON200Response(toSpecModeloOrdemServico(*template))
+}
+
+func toSpecModeloOrdemServico(t usecase.ServiceOrderTemplateOutput) spec.ModeloOrdemServico {
+	modelo := spec.ModeloOrdemServico{
+		NomeEmpresa:      t.CompanyName,
+		DocumentoEmpresa: t.CompanyDocument,
+		Endereco:         t.Address,
+		Telefone:         t.Phone,
+		Email:            t.Email,
+		Site:             t.Website,
+		TextoRodape:      t.FooterText,
+		CorPrimaria:      t.PrimaryColor,
+		FusoHorario:      t.Timezone,
+	}
+	if t.LogoURL != "" {
+		url, expiresAt := t.LogoURL, t.LogoURLExpiresAt.UTC()
+		modelo.URLLogotipo = &url
+		modelo.URLLogotipoExpiraEm = &expiresAt
+	}
+	if t.UpdatedBy != uuid.Nil {
+		updatedBy := t.UpdatedBy.String()
+		modelo.AtualizadoPor = &updatedBy
+	}
+	if !t.UpdatedAt.IsZero() {
+		updatedAt := t.UpdatedAt.UTC()
+		modelo.UpdatedAt = &updatedAt
+	}
+	return modelo
+}
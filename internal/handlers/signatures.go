@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidSignature     = "Assinatura inválida: envie a imagem PNG ou SVG (até 1 MiB) ou os traços, conforme o formato"
+	ErrInvalidSigner        = "Informe o nome (até 200 caracteres) e o documento (até 30 caracteres) de quem assinou"
+	ErrInvalidSignatureTime = "A data da assinatura não pode estar no futuro"
+	ErrInvalidSignatureGeo  = "Localização inválida: informe latitude entre -90 e 90 e longitude entre -180 e 180"
+	ErrFormAlreadySigned    = "O atendimento já foi assinado"
+	ErrFormNotCompleted     = "Apenas atendimentos resolvidos ou fechados podem ser assinados"
+	ErrFormSigned           = "O atendimento foi assinado pelo cliente e só pode ser alterado por um administrador"
+	ErrSignatureNotFound    = "O atendimento não possui assinatura"
+)
+
+// A imagem vem em base64 no JSON, daí a folga sobre o tamanho do arquivo.
+const signatureRequestMaxBytes = 2 * domains.MaxSignatureSize
+
+// Sign form
+// (POST /v1/forms/{formID}/signature)
+func (api *Handlers) PostFormSignature(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostFormSignatureJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, signatureRequestMaxBytes)
+	var payload spec.AssinarAtendimento
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return spec.PostFormSignatureJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSignature,
+			})
+		}
+		return spec.PostFormSignatureJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostFormSignatureJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+
+	input := usecase.SignFormInput{
+		FormID:         uuid.MustParse(formID),
+		Format:         payload.Formato.ToValue(),
+		Image:          payload.Imagem,
+		SignerName:     payload.NomeAssinante,
+		SignerDocument: payload.DocumentoAssinante,
+		SignedAt:       time.Now().UTC(),
+		Latitude:       payload.Latitude,
+		Longitude:      payload.Longitude,
+		CapturedBy:     userID,
+	}
+	if payload.AssinadoEm != nil {
+		input.SignedAt = *payload.AssinadoEm
+	}
+	if payload.PrecisaoMetros != nil {
+		input.AccuracyMeters = *payload.PrecisaoMetros
+	}
+	for _, stroke := range payload.Tracos {
+		points := make(domains.SignatureStroke, 0, len(stroke))
+		for _, p := range stroke {
+			point := domains.SignaturePoint{X: p.X, Y: p.Y}
+			if p.T != nil {
+				point.T = *p.T
+			}
+			points = append(points, point)
+		}
+		input.Strokes = append(input.Strokes, points)
+	}
+
+	signature, err := api.signaturesUsecase.SignForm(input, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PostFormSignatureJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrFormAlreadySigned):
+			return spec.PostFormSignatureJSON409Response(spec.ErrorResponse{
+				Message: ErrFormAlreadySigned,
+			})
+		case errors.Is(err, domains.ErrFormNotCompleted):
+			return spec.PostFormSignatureJSON409Response(spec.ErrorResponse{
+				Message: ErrFormNotCompleted,
+			})
+		case errors.Is(err, domains.ErrInvalidSignatureFormat), errors.Is(err, domains.ErrInvalidSignatureContent):
+			return spec.PostFormSignatureJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSignature,
+			})
+		case errors.Is(err, domains.ErrInvalidSignerName), errors.Is(err, domains.ErrInvalidSignerDocument):
+			return spec.PostFormSignatureJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSigner,
+			})
+		case errors.Is(err, domains.ErrInvalidSignatureTime):
+			return spec.PostFormSignatureJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSignatureTime,
+			})
+		case errors.Is(err, domains.ErrInvalidSignatureLocation):
+			return spec.PostFormSignatureJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSignatureGeo,
+			})
+		}
+		return spec.PostFormSignatureJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostFormSignatureJSON201Response(toSpecAssinatura(*signature))
+}
+
+// Get form signature
+// (GET /v1/forms/{formID}/signature)
+func (api *Handlers) GetFormSignature(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetFormSignatureJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	signature, err := api.signaturesUsecase.GetSignature(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.GetFormSignatureJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrSignatureNotFound):
+			return spec.GetFormSignatureJSON404Response(spec.ErrorResponse{
+				Message: ErrSignatureNotFound,
+			})
+		}
+		return spec.GetFormSignatureJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetFormSignatureJSON200Response(toSpecAssinatura(*signature))
+}
+
+func toSpecAssinatura(s usecase.SignatureOutput) spec.AssinaturaAtendimento {
+	assinatura := spec.AssinaturaAtendimento{
+		ID:                 s.ID.String(),
+		NomeAssinante:      s.SignerName,
+		DocumentoAssinante: s.SignerDocument,
+		AssinadoEm:         s.SignedAt.UTC(),
+		TipoConteudo:       s.ContentType,
+		Tamanho:            s.Size,
+		Sha256:             s.Checksum,
+		Latitude:           s.Latitude,
+		Longitude:          s.Longitude,
+		NomeColetadoPor:    s.CapturedByName,
+		HashAtendimento:    s.SnapshotHash,
+		HashAtual:          s.CurrentHash,
+		Integro:            s.Intact,
+		URLDownload:        s.DownloadURL,
+		URLExpiraEm:        s.URLExpiresAt.UTC(),
+		CreatedAt:          s.CreatedAt.UTC(),
+	}
+	_ = assinatura.Formato.FromValue(s.Format)
+	if s.AccuracyMeters > 0 {
+		accuracy := s.AccuracyMeters
+		assinatura.PrecisaoMetros = &accuracy
+	}
+	if s.CapturedBy != uuid.Nil {
+		capturedBy := s.CapturedBy.String()
+		assinatura.ColetadoPor = &capturedBy
+	}
+	return assinatura
+}
@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidSimilarQuery    = "Informe a descrição do defeito (até 500 caracteres), um cliente válido e limite de 1 a 20"
+	ErrInvalidSimilarFeedback = "Um atendimento não pode ser marcado como semelhante a ele mesmo"
+)
+
+// List similar forms by description
+// (GET /v1/forms/similar)
+func (api *Handlers) ListSimilarFormsByDescription(w http.ResponseWriter, r *http.Request, params spec.ListSimilarFormsByDescriptionParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListSimilarFormsByDescriptionJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	clientID, ok := parseOptionalUUID(params.ClienteID)
+	if !ok || len(params.Descricao) > 500 {
+		return spec.ListSimilarFormsByDescriptionJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidSimilarQuery,
+		})
+	}
+
+	input := usecase.SimilarFormsInput{
+		Description: params.Descricao,
+		ClientID:    clientID,
+	}
+	if params.Limite != nil {
+		if *params.Limite < 1 {
+			return spec.ListSimilarFormsByDescriptionJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSimilarQuery,
+			})
+		}
+		input.Limit = *params.Limite
+	}
+
+	output, err := api.similarFormsUsecase.SimilarToDescription(input, r.Context())
+	if err != nil {
+		if isSimilarQueryError(err) {
+			return spec.ListSimilarFormsByDescriptionJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSimilarQuery,
+			})
+		}
+		return spec.ListSimilarFormsByDescriptionJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.ListSimilarFormsByDescriptionJSON200Response(toSpecSimilarForms(output))
+}
+
+// List similar forms
+// (GET /v1/forms/{formID}/similar)
+func (api *Handlers) ListSimilarForms(w http.ResponseWriter, r *http.Request, formID string, params spec.ListSimilarFormsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListSimilarFormsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	limit := 0
+	if params.Limite != nil {
+		if *params.Limite < 1 {
+			return spec.ListSimilarFormsJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSimilarQuery,
+			})
+		}
+		limit = *params.Limite
+	}
+
+	output, err := api.similarFormsUsecase.SimilarToForm(uuid.MustParse(formID), limit, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.ListSimilarFormsJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case isSimilarQueryError(err):
+			return spec.ListSimilarFormsJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSimilarQuery,
+			})
+		}
+		return spec.ListSimilarFormsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.ListSimilarFormsJSON200Response(toSpecSimilarForms(output))
+}
+
+// Mark similar form as helpful
+// (POST /v1/forms/{formID}/similar/{similarFormID}/helpful)
+func (api *Handlers) PostSimilarFormHelpful(w http.ResponseWriter, r *http.Request, formID string, similarFormID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostSimilarFormHelpfulJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	err = api.similarFormsUsecase.MarkHelpful(usecase.SimilarFormFeedbackInput{
+		FormID:        uuid.MustParse(formID),
+		SimilarFormID: uuid.MustParse(similarFormID),
+		UserID:        userID,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PostSimilarFormHelpfulJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrInvalidSimilarFeedback):
+			return spec.PostSimilarFormHelpfulJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSimilarFeedback,
+			})
+		}
+		return spec.PostSimilarFormHelpfulJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostSimilarFormHelpfulJSON204Response(spec.Resp204{
+		Message: "Sugestão marcada como útil",
+	})
+}
+
+// Unmark similar form as helpful
+// (DELETE /v1/forms/{formID}/similar/{similarFormID}/helpful)
+func (api *Handlers) DeleteSimilarFormHelpful(w http.ResponseWriter, r *http.Request, formID string, similarFormID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteSimilarFormHelpfulJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	err = api.similarFormsUsecase.UnmarkHelpful(usecase.SimilarFormFeedbackInput{
+		FormID:        uuid.MustParse(formID),
+		SimilarFormID: uuid.MustParse(similarFormID),
+		UserID:        userID,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.DeleteSimilarFormHelpfulJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrInvalidSimilarFeedback):
+			return spec.DeleteSimilarFormHelpfulJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSimilarFeedback,
+			})
+		}
+		return spec.DeleteSimilarFormHelpfulJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteSimilarFormHelpfulJSON204Response(spec.Resp204{
+		Message: "Marcação de útil removida",
+	})
+}
+
+func isSimilarQueryError(err error) bool {
+	return errors.Is(err, domains.ErrInvalidDefectDescription) ||
+		errors.Is(err, domains.ErrInvalidPageSize)
+}
+
+func toSpecSimilarForms(output *usecase.ListSimilarFormsOutput) spec.ListaAtendimentosSemelhantes {
+	atendimentos := make([]spec.AtendimentoSemelhante, 0, len(output.Forms))
+	for _, f := range output.Forms {
+		item := spec.AtendimentoSemelhante{
+			FormularioID:     f.FormID.String(),
+			Protocolo:        f.PublicCode,
+			ClienteID:        f.ClientID.String(),
+			NomeCliente:      f.ClientName,
+			DescricaoDefeito: f.DefectDescription,
+			DescricaoSolucao: f.SolutionDescription,
+			Status:           toSpecStatusAtendimento(f.Status),
+			Semelhanca:       f.Similarity,
+			Pontuacao:        f.Score,
+			VotosUtil:        f.HelpfulCount,
+		}
+		if !f.ResolvedAt.IsZero() {
+			resolvedAt := f.ResolvedAt
+			item.ResolvidoEm = &resolvedAt
+		}
+		atendimentos = append(atendimentos, item)
+	}
+	return spec.ListaAtendimentosSemelhantes{Atendimentos: atendimentos}
+}
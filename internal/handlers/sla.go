@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+)
+
+var (
+	ErrInvalidSLAPolicy     = "Política inválida: o prazo de resolução não pode ser menor que o de resposta nem passar de 90 dias"
+	ErrInvalidHoliday       = "Feriado inválido: informe o dia e um nome de até 100 caracteres"
+	ErrHolidayAlreadyExists = "Já existe um feriado cadastrado neste dia"
+)
+
+// List SLA policies
+// (GET /v1/sla/policies)
+func (api *Handlers) ListSLAPolicies(w http.ResponseWriter, r *http.Request) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListSLAPoliciesJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	output, err := api.slaUsecase.ListPolicies(r.Context())
+	if err != nil {
+		return spec.ListSLAPoliciesJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	politicas := make([]spec.PoliticaSLA, 0, len(output.Policies))
+	for _, p := range output.Policies {
+		politicas = append(politicas, toSpecPoliticaSLA(p))
+	}
+
+	return spec.ListSLAPoliciesJSON200Response(spec.ListaPoliticasSLA{
+		Politicas: politicas,
+	})
+}
+
+// Update SLA policy
+// (PUT /v1/sla/policies)
+func (api *Handlers) PutSLAPolicy(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PutSLAPolicyJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PutSLAPolicyJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.AtualizarPoliticaSLA
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PutSLAPolicyJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PutSLAPolicyJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidSLAPolicy,
+		})
+	}
+
+	policy, err := api.slaUsecase.UpdatePolicy(usecase.UpdateSLAPolicyInput{
+		DifficultyLevel:   payload.NivelDificuldade.ToValue(),
+		ClientType:        payload.TipoCliente.ToValue(),
+		ResponseMinutes:   payload.MinutosResposta,
+		ResolutionMinutes: payload.MinutosResolucao,
+	}, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidSLAPolicy) ||
+			errors.Is(err, domains.ErrInvalidDifficultyLevel) ||
+			errors.Is(err, domains.ErrInvalidClientType) {
+			return spec.PutSLAPolicyJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSLAPolicy,
+			})
+		}
+		return spec.PutSLAPolicyJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PutSLAPolicyJSON200Response(toSpecPoliticaSLA(*policy))
+}
+
+// List holidays
+// (GET /v1/sla/holidays)
+func (api *Handlers) ListHolidays(w http.ResponseWriter, r *http.Request, params spec.ListHolidaysParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListHolidaysJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	from := time.Date(time.Now().Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	if params.APartirDe != nil {
+		from = params.APartirDe.Time
+	}
+
+	output, err := api.slaUsecase.ListHolidays(from, r.Context())
+	if err != nil {
+		return spec.ListHolidaysJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	feriados := make([]spec.Feriado, 0, len(output.Holidays))
+	for _, h := range output.Holidays {
+		feriados = append(feriados, spec.Feriado{
+			Data: types.Date{Time: h.Date},
+			Nome: h.Name,
+		})
+	}
+
+	return spec.ListHolidaysJSON200Response(spec.ListaFeriados{
+		Feriados: feriados,
+	})
+}
+
+// Create holiday
+// (POST /v1/sla/holidays)
+func (api *Handlers) PostHoliday(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostHolidayJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PostHolidayJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	var payload spec.CriarFeriado
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostHolidayJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostHolidayJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidHoliday,
+		})
+	}
+
+	if err := api.slaUsecase.CreateHoliday(usecase.CreateHolidayInput{
+		Date: payload.Data.Time,
+		Name: payload.Nome,
+	}, r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrInvalidHoliday):
+			return spec.PostHolidayJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidHoliday,
+			})
+		case errors.Is(err, domains.ErrHolidayAlreadyExists):
+			return spec.PostHolidayJSON409Response(spec.ErrorResponse{
+				Message: ErrHolidayAlreadyExists,
+			})
+		}
+		return spec.PostHolidayJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostHolidayJSON201Response(spec.Feriado{
+		Data: payload.Data,
+		Nome: payload.Nome,
+	})
+}
+
+// Delete holiday
+// (DELETE /v1/sla/holidays/{date})
+func (api *Handlers) DeleteHoliday(w http.ResponseWriter, r *http.Request, date types.Date) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteHolidayJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.DeleteHolidayJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	if err := api.slaUsecase.DeleteHoliday(date.Time, r.Context()); err != nil {
+		if errors.Is(err, domains.ErrHolidayNotFound) {
+			return spec.DeleteHolidayJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.DeleteHolidayJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteHolidayJSON204Response(spec.Resp204{
+		Message: "Feriado removido com sucesso",
+	})
+}
+
+func toSpecPoliticaSLA(p usecase.SLAPolicyOutput) spec.PoliticaSLA {
+	politica := spec.PoliticaSLA{
+		MinutosResposta:  p.ResponseMinutes,
+		MinutosResolucao: p.ResolutionMinutes,
+		UpdatedAt:        p.UpdatedAt.UTC(),
+	}
+	_ = politica.NivelDificuldade.FromValue(p.DifficultyLevel)
+	_ = politica.TipoCliente.FromValue(p.ClientType)
+	return politica
+}
+
+// toSpecSLAAtendimento devolve nil para atendimentos sem SLA.
+func toSpecSLAAtendimento(s usecase.FormSLAOutput) *spec.SLAAtendimento {
+	if s.State == "" {
+		return nil
+	}
+	sla := &spec.SLAAtendimento{
+		PrazoResposta:  s.ResponseDueAt.UTC(),
+		PrazoResolucao: s.ResolutionDueAt.UTC(),
+	}
+	_ = sla.Situacao.FromValue(s.State)
+	if !s.RespondedAt.IsZero() {
+		respondedAt := s.RespondedAt.UTC()
+		sla.RespondidoEm = &respondedAt
+	}
+	if !s.ResolvedAt.IsZero() {
+		resolvedAt := s.ResolvedAt.UTC()
+		sla.ResolvidoEm = &resolvedAt
+	}
+	return sla
+}
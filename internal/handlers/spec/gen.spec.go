@@ -28,6 +28,46 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AgrupamentoApontamentos.
+var (
+	UnknownAgrupamentoApontamentos = AgrupamentoApontamentos{}
+
+	AgrupamentoApontamentosAtendimento = AgrupamentoApontamentos{"atendimento"}
+
+	AgrupamentoApontamentosCliente = AgrupamentoApontamentos{"cliente"}
+
+	AgrupamentoApontamentosTecnico = AgrupamentoApontamentos{"tecnico"}
+)
+
+// Defines values for AgrupamentoPesquisas.
+var (
+	UnknownAgrupamentoPesquisas = AgrupamentoPesquisas{}
+
+	AgrupamentoPesquisasCliente = AgrupamentoPesquisas{"cliente"}
+
+	AgrupamentoPesquisasPeriodo = AgrupamentoPesquisas{"periodo"}
+
+	AgrupamentoPesquisasTecnico = AgrupamentoPesquisas{"tecnico"}
+)
+
+// Defines values for AlteracaoAtribuicaoAcao.
+var (
+	UnknownAlteracaoAtribuicaoAcao = AlteracaoAtribuicaoAcao{}
+
+	AlteracaoAtribuicaoAcaoAtribuido = AlteracaoAtribuicaoAcao{"atribuido"}
+
+	AlteracaoAtribuicaoAcaoRemovido = AlteracaoAtribuicaoAcao{"removido"}
+)
+
+// Defines values for ApontamentoOrigem.
+var (
+	UnknownApontamentoOrigem = ApontamentoOrigem{}
+
+	ApontamentoOrigemCronometro = ApontamentoOrigem{"cronometro"}
+
+	ApontamentoOrigemManual = ApontamentoOrigem{"manual"}
+)
+
 // Defines values for AtualizarClienteTipoCliente.
 var (
 	UnknownAtualizarClienteTipoCliente = AtualizarClienteTipoCliente{}
@@ -48,6 +88,37 @@ var (
 	AtualizarFormularioNivelDificuldadeMedium = AtualizarFormularioNivelDificuldade{"medium"}
 )
 
+// Defines values for AtualizarPlanoManutencaoNivelDificuldade.
+var (
+	UnknownAtualizarPlanoManutencaoNivelDificuldade = AtualizarPlanoManutencaoNivelDificuldade{}
+
+	AtualizarPlanoManutencaoNivelDificuldadeHigh = AtualizarPlanoManutencaoNivelDificuldade{"high"}
+
+	AtualizarPlanoManutencaoNivelDificuldadeLow = AtualizarPlanoManutencaoNivelDificuldade{"low"}
+
+	AtualizarPlanoManutencaoNivelDificuldadeMedium = AtualizarPlanoManutencaoNivelDificuldade{"medium"}
+)
+
+// Defines values for AtualizarPoliticaSLANivelDificuldade.
+var (
+	UnknownAtualizarPoliticaSLANivelDificuldade = AtualizarPoliticaSLANivelDificuldade{}
+
+	AtualizarPoliticaSLANivelDificuldadeHigh = AtualizarPoliticaSLANivelDificuldade{"high"}
+
+	AtualizarPoliticaSLANivelDificuldadeLow = AtualizarPoliticaSLANivelDificuldade{"low"}
+
+	AtualizarPoliticaSLANivelDificuldadeMedium = AtualizarPoliticaSLANivelDificuldade{"medium"}
+)
+
+// Defines values for AtualizarPoliticaSLATipoCliente.
+var (
+	UnknownAtualizarPoliticaSLATipoCliente = AtualizarPoliticaSLATipoCliente{}
+
+	AtualizarPoliticaSLATipoClienteAvulso = AtualizarPoliticaSLATipoCliente{"avulso"}
+
+	AtualizarPoliticaSLATipoClienteContrato = AtualizarPoliticaSLATipoCliente{"contrato"}
+)
+
 // Defines values for ClienteTipoCliente.
 var (
 	UnknownClienteTipoCliente = ClienteTipoCliente{}
@@ -57,6 +128,28 @@ var (
 	ClienteTipoClienteContrato = ClienteTipoCliente{"contrato"}
 )
 
+// Defines values for ClienteLixeiraTipoCliente.
+var (
+	UnknownClienteLixeiraTipoCliente = ClienteLixeiraTipoCliente{}
+
+	ClienteLixeiraTipoClienteAvulso = ClienteLixeiraTipoCliente{"avulso"}
+
+	ClienteLixeiraTipoClienteContrato = ClienteLixeiraTipoCliente{"contrato"}
+)
+
+// Defines values for ContratoAlertas.
+var (
+	UnknownContratoAlertas = ContratoAlertas{}
+
+	ContratoAlertasContratoProximoVencimento = ContratoAlertas{"contrato_proximo_vencimento"}
+
+	ContratoAlertasContratoVencido = ContratoAlertas{"contrato_vencido"}
+
+	ContratoAlertasHorasEsgotadas = ContratoAlertas{"horas_esgotadas"}
+
+	ContratoAlertasHorasProximasDoLimite = ContratoAlertas{"horas_proximas_do_limite"}
+)
+
 // Defines values for CriarClienteTipoCliente.
 var (
 	UnknownCriarClienteTipoCliente = CriarClienteTipoCliente{}
@@ -77,6 +170,17 @@ var (
 	CriarFormularioNivelDificuldadeMedium = CriarFormularioNivelDificuldade{"medium"}
 )
 
+// Defines values for CriarPlanoManutencaoNivelDificuldade.
+var (
+	UnknownCriarPlanoManutencaoNivelDificuldade = CriarPlanoManutencaoNivelDificuldade{}
+
+	CriarPlanoManutencaoNivelDificuldadeHigh = CriarPlanoManutencaoNivelDificuldade{"high"}
+
+	CriarPlanoManutencaoNivelDificuldadeLow = CriarPlanoManutencaoNivelDificuldade{"low"}
+
+	CriarPlanoManutencaoNivelDificuldadeMedium = CriarPlanoManutencaoNivelDificuldade{"medium"}
+)
+
 // Defines values for CriarUsuarioCargo.
 var (
 	UnknownCriarUsuarioCargo = CriarUsuarioCargo{}
@@ -88,6 +192,26 @@ var (
 	CriarUsuarioCargoTecnicoInterno = CriarUsuarioCargo{"tecnico_interno"}
 )
 
+// Defines values for EntidadeCampoPersonalizado.
+var (
+	UnknownEntidadeCampoPersonalizado = EntidadeCampoPersonalizado{}
+
+	EntidadeCampoPersonalizadoAtendimento = EntidadeCampoPersonalizado{"atendimento"}
+
+	EntidadeCampoPersonalizadoCliente = EntidadeCampoPersonalizado{"cliente"}
+)
+
+// Defines values for FormatoAssinatura.
+var (
+	UnknownFormatoAssinatura = FormatoAssinatura{}
+
+	FormatoAssinaturaPng = FormatoAssinatura{"png"}
+
+	FormatoAssinaturaSvg = FormatoAssinatura{"svg"}
+
+	FormatoAssinaturaTracos = FormatoAssinatura{"tracos"}
+)
+
 // Defines values for FormularioNivelDificuldade.
 var (
 	UnknownFormularioNivelDificuldade = FormularioNivelDificuldade{}
@@ -99,1220 +223,11264 @@ var (
 	FormularioNivelDificuldadeMedium = FormularioNivelDificuldade{"medium"}
 )
 
-// AtualizarCliente defines model for AtualizarCliente.
-type AtualizarCliente struct {
-	// CPF ou CNPJ (com ou sem máscara)
-	CnpjOuCpf string `json:"cnpj_ou_cpf" validate:"required,cpf_cnpj"`
+// Defines values for GeoJSONLineStringType.
+var (
+	UnknownGeoJSONLineStringType = GeoJSONLineStringType{}
 
-	// Email de contato
-	EmailContato openapi_types.Email `json:"email_contato" validate:"omitempty,email"`
-	Endereco     Endereco            `json:"endereco"`
-	NomeCliente  string              `json:"nome_cliente" validate:"required,min=2,max=500"`
-	NomeContato  string              `json:"nome_contato" validate:"required,min=2,max=500"`
+	GeoJSONLineStringTypeLineString = GeoJSONLineStringType{"LineString"}
+)
 
-	// Telefone de contato no formato E.164 (ex: +5511912345678)
-	TelefoneContato string                      `json:"telefone_contato" validate:"omitempty,e164"`
-	TipoCliente     AtualizarClienteTipoCliente `json:"tipo_cliente" validate:"required,oneof=avulso contrato"`
-}
+// Defines values for GranularidadePesquisas.
+var (
+	UnknownGranularidadePesquisas = GranularidadePesquisas{}
 
-// AtualizarFormulario defines model for AtualizarFormulario.
-type AtualizarFormulario struct {
-	DataOcorrencia      time.Time                           `json:"data_ocorrencia" validate:"required"`
-	DescricaoDefeito    string                              `json:"descricao_defeito" validate:"required,min=2,max=500"`
-	DescricaoSolucao    string                              `json:"descricao_solucao" validate:"required,min=2,max=500"`
-	NivelDificuldade    AtualizarFormularioNivelDificuldade `json:"nivel_dificuldade" validate:"required,oneof=low medium high"`
-	Solicitante         string                              `json:"solicitante" validate:"required,min=2,max=500"`
-	TecnicosResponsavel []string                            `json:"tecnicos_responsavel" validate:"required,min=1,dive,uuid"`
-}
+	GranularidadePesquisasDia = GranularidadePesquisas{"dia"}
 
-// AtualizarUsuario defines model for AtualizarUsuario.
-type AtualizarUsuario struct {
-	// Email de contato
-	Email *openapi_types.Email `json:"email,omitempty" validate:"omitempty,email"`
-	Nome  *string              `json:"nome,omitempty" validate:"omitempty,min=2,max=500"`
-}
+	GranularidadePesquisasMes = GranularidadePesquisas{"mes"}
 
-// BuscaCliente defines model for BuscaCliente.
-type BuscaCliente struct {
-	Cliente *Cliente `json:"cliente,omitempty"`
-}
+	GranularidadePesquisasSemana = GranularidadePesquisas{"semana"}
+)
 
-// BuscaFormulario defines model for BuscaFormulario.
-type BuscaFormulario struct {
-	Formulario Formulario `json:"formulario"`
-}
+// Defines values for MotivoDuplicidade.
+var (
+	UnknownMotivoDuplicidade = MotivoDuplicidade{}
 
-// BuscaUsuario defines model for BuscaUsuario.
-type BuscaUsuario struct {
-	Usuario *Usuario `json:"usuario,omitempty"`
-}
+	MotivoDuplicidadeDocumento = MotivoDuplicidade{"documento"}
 
-// Cliente defines model for Cliente.
-type Cliente struct {
-	// CPF ou CNPJ (com ou sem máscara)
-	CnpjOuCpf string    `json:"cnpj_ou_cpf" validate:"required,min=11,max=18"`
-	CreatedAt time.Time `json:"created_at" validate:"required"`
+	MotivoDuplicidadeEmail = MotivoDuplicidade{"email"}
 
-	// Email de contato
-	EmailContato openapi_types.Email `json:"email_contato" validate:"omitempty,email"`
-	Endereco     Endereco            `json:"endereco"`
-	ID           string              `json:"id" validate:"required,uuid"`
-	NomeCliente  string              `json:"nome_cliente" validate:"required,min=2,max=500"`
-	NomeContato  string              `json:"nome_contato" validate:"required,min=2,max=500"`
+	MotivoDuplicidadeNome = MotivoDuplicidade{"nome"}
 
-	// Telefone de contato no formato E.164 (ex: +5511912345678)
-	TelefoneContato string             `json:"telefone_contato" validate:"omitempty,e164"`
-	TipoCliente     ClienteTipoCliente `json:"tipo_cliente" validate:"required,oneof=avulso contrato"`
-	UpdatedAt       time.Time          `json:"updated_at" validate:"required"`
-}
+	MotivoDuplicidadeTelefone = MotivoDuplicidade{"telefone"}
+)
 
-// CriarCliente defines model for CriarCliente.
-type CriarCliente struct {
-	// CPF ou CNPJ (com ou sem máscara)
-	CnpjOuCpf string `json:"cnpj_ou_cpf" validate:"required,cpf_cnpj"`
+// Defines values for NotificacaoTipo.
+var (
+	UnknownNotificacaoTipo = NotificacaoTipo{}
 
-	// Email de contato
-	EmailContato openapi_types.Email `json:"email_contato" validate:"omitempty,email"`
-	Endereco     Endereco            `json:"endereco"`
-	NomeCliente  string              `json:"nome_cliente" validate:"required,min=2,max=500"`
-	NomeContato  string              `json:"nome_contato" validate:"required,min=2,max=500"`
+	NotificacaoTipoSLAEmRisco = NotificacaoTipo{"sla_em_risco"}
 
-	// Telefone de contato no formato E.164 (ex: +5511912345678)
-	TelefoneContato string                  `json:"telefone_contato" validate:"omitempty,e164"`
-	TipoCliente     CriarClienteTipoCliente `json:"tipo_cliente" validate:"required,oneof=avulso contrato"`
-}
+	NotificacaoTipoSLAViolado = NotificacaoTipo{"sla_violado"}
+)
 
-// CriarFormulario defines model for CriarFormulario.
-type CriarFormulario struct {
-	ClienteID        string    `json:"cliente_id" validate:"required,uuid"`
-	DataOcorrencia   time.Time `json:"data_ocorrencia" validate:"required"`
-	DescricaoDefeito string    `json:"descricao_defeito" validate:"required,min=2,max=500"`
-	DescricaoSolucao string    `json:"descricao_solucao" validate:"required,min=2,max=500"`
+// Defines values for PoliticaSLANivelDificuldade.
+var (
+	UnknownPoliticaSLANivelDificuldade = PoliticaSLANivelDificuldade{}
 
-	// Nível de dificuldade
-	NivelDificuldade    CriarFormularioNivelDificuldade `json:"nivel_dificuldade" validate:"required,oneof=low medium high"`
-	Solicitante         string                          `json:"solicitante" validate:"required,min=2,max=500"`
-	TecnicosResponsavel []string                        `json:"tecnicos_responsavel" validate:"required,min=1,dive,uuid"`
-}
+	PoliticaSLANivelDificuldadeHigh = PoliticaSLANivelDificuldade{"high"}
 
-// CriarUsuario defines model for CriarUsuario.
-type CriarUsuario struct {
-	Cargo CriarUsuarioCargo `json:"cargo" validate:"required,oneof=tecnico_interno tecnico_externo administrador"`
+	PoliticaSLANivelDificuldadeLow = PoliticaSLANivelDificuldade{"low"}
 
-	// Email de contato
-	Email openapi_types.Email `json:"email" validate:"omitempty,email"`
-	Nome  string              `json:"nome" validate:"required,min=2,max=500"`
+	PoliticaSLANivelDificuldadeMedium = PoliticaSLANivelDificuldade{"medium"}
+)
 
-	// Senha do usuário
-	Password string `json:"password" validate:"required,min=8,max=64"`
-}
+// Defines values for PoliticaSLATipoCliente.
+var (
+	UnknownPoliticaSLATipoCliente = PoliticaSLATipoCliente{}
 
-// Endereco defines model for Endereco.
-type Endereco struct {
-	// Bairro da residência
-	Bairro string `json:"bairro" validate:"required,min=2,max=500"`
+	PoliticaSLATipoClienteAvulso = PoliticaSLATipoCliente{"avulso"}
 
-	// CEP brasileiro
-	Cep string `json:"cep" validate:"required,min=8,max=9"`
+	PoliticaSLATipoClienteContrato = PoliticaSLATipoCliente{"contrato"}
+)
 
-	// Cidade da residencia
-	Cidade string `json:"cidade" validate:"required,min=2,max=500"`
+// Defines values for RevisarSolicitacaoPortalStatus.
+var (
+	UnknownRevisarSolicitacaoPortalStatus = RevisarSolicitacaoPortalStatus{}
 
-	// Complemento do endereço (opcional)
-	Complement string `json:"complement" validate:"omitempty,max=100"`
+	RevisarSolicitacaoPortalStatusConvertida = RevisarSolicitacaoPortalStatus{"convertida"}
 
-	// UF do estado da residencia
-	Estado string `json:"estado" validate:"required,min=2,max=2"`
+	RevisarSolicitacaoPortalStatusRecusada = RevisarSolicitacaoPortalStatus{"recusada"}
+)
 
-	// Latitude geográfica (opcional)
-	Latitude float64 `json:"latitude" validate:"omitempty,gte=-90,lte=90"`
+// Defines values for SituacaoFatura.
+var (
+	UnknownSituacaoFatura = SituacaoFatura{}
 
-	// Longitude geográfica (opcional)
-	Longitude float64 `json:"longitude" validate:"omitempty,gte=-180,lte=180"`
+	SituacaoFaturaCancelada = SituacaoFatura{"cancelada"}
 
-	// Número da residência
-	Numero string `json:"numero" validate:"required,min=1,max=10"`
+	SituacaoFaturaEmitida = SituacaoFatura{"emitida"}
 
-	// UF do pais da residencia
-	Pais string `json:"pais" validate:"required,min=2,max=2"`
+	SituacaoFaturaPaga = SituacaoFatura{"paga"}
 
-	// Rua da residência
-	Rua string `json:"rua" validate:"required,min=2,max=500"`
-}
+	SituacaoFaturaRascunho = SituacaoFatura{"rascunho"}
+)
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Message string `json:"message"`
-}
+// Defines values for SituacaoPesquisa.
+var (
+	UnknownSituacaoPesquisa = SituacaoPesquisa{}
 
-// Formulario defines model for Formulario.
-type Formulario struct {
-	CreatedAt           time.Time                  `json:"created_at" validate:"required"`
-	DataOcorrencia      time.Time                  `json:"data_ocorrencia" validate:"required"`
-	DescricaoDefeito    string                     `json:"descricao_defeito" validate:"required,min=2,max=500"`
-	DescricaoSolucao    string                     `json:"descricao_solucao" validate:"required,min=2,max=500"`
-	ID                  string                     `json:"id" validate:"required,uuid"`
-	NivelDificuldade    FormularioNivelDificuldade `json:"nivel_dificuldade" validate:"required,oneof=low medium high"`
-	Solicitante         string                     `json:"solicitante" validate:"required,min=2,max=500"`
-	TecnicosResponsavel []Tecnico                  `json:"tecnicos_responsavel" validate:"required,min=1,dive,uuid"`
-	UpdatedAt           time.Time                  `json:"updated_at" validate:"required"`
-}
+	SituacaoPesquisaEnviada = SituacaoPesquisa{"enviada"}
 
-// ListaClientes defines model for ListaClientes.
-type ListaClientes struct {
-	Clientes []Cliente `json:"clientes"`
-}
+	SituacaoPesquisaExpirada = SituacaoPesquisa{"expirada"}
 
-// ListaFormulario defines model for ListaFormulario.
-type ListaFormulario struct {
-	Formularios []Formulario `json:"formularios"`
-}
+	SituacaoPesquisaPendente = SituacaoPesquisa{"pendente"}
 
-// ListaUsuarios defines model for ListaUsuarios.
-type ListaUsuarios struct {
-	Usuarios []Usuario `json:"usuarios"`
-}
+	SituacaoPesquisaRespondida = SituacaoPesquisa{"respondida"}
+)
 
-// LoginReq defines model for LoginReq.
-type LoginReq struct {
-	// Email de contato
-	Email openapi_types.Email `json:"email" validate:"required,email"`
+// Defines values for SituacaoPlanoManutencao.
+var (
+	UnknownSituacaoPlanoManutencao = SituacaoPlanoManutencao{}
 
-	// Senha do usuário
-	Password string `json:"password" validate:"required,min=8,max=64"`
-}
+	SituacaoPlanoManutencaoAtivo = SituacaoPlanoManutencao{"ativo"}
 
-// LoginRes defines model for LoginRes.
-type LoginRes struct {
-	// JWT de acesso
-	AccessToken string `json:"access_token"`
+	SituacaoPlanoManutencaoPausado = SituacaoPlanoManutencao{"pausado"}
+)
 
-	// Tempo de expiração em segundos
-	ExpiresIn *int   `json:"expires_in,omitempty"`
-	TokenType string `json:"token_type"`
-}
+// Defines values for SituacaoSLA.
+var (
+	UnknownSituacaoSLA = SituacaoSLA{}
 
-// Resp200 defines model for Resp200.
-type Resp200 struct {
-	ID      string `json:"id" validate:"required,uuid"`
-	Message string `json:"message" validate:"required"`
-}
+	SituacaoSLACumprido = SituacaoSLA{"cumprido"}
 
-// Resp204 defines model for Resp204.
-type Resp204 struct {
-	Message string `json:"message" validate:"required"`
-}
+	SituacaoSLAEmRisco = SituacaoSLA{"em_risco"}
 
-// Tecnico defines model for Tecnico.
-type Tecnico struct {
-	ID   string `json:"id" validate:"required,uuid"`
-	Nome string `json:"nome" validate:"required,min=2,max=500"`
-}
+	SituacaoSLANoPrazo = SituacaoSLA{"no_prazo"}
 
-// Usuario defines model for Usuario.
-type Usuario struct {
-	Cargo     string    `json:"cargo" validate:"required"`
-	CreatedAt time.Time `json:"created_at" validate:"required"`
+	SituacaoSLAViolado = SituacaoSLA{"violado"}
+)
 
-	// Email de contato
-	Email     openapi_types.Email `json:"email" validate:"omitempty,email"`
-	ID        string              `json:"id" validate:"required,uuid"`
-	Nome      string              `json:"nome" validate:"required,min=2,max=500"`
-	UpdatedAt time.Time           `json:"updated_at" validate:"required"`
-}
+// Defines values for StatusAtendimento.
+var (
+	UnknownStatusAtendimento = StatusAtendimento{}
 
-// AtualizarClienteTipoCliente defines model for AtualizarCliente.TipoCliente.
-type AtualizarClienteTipoCliente struct {
-	value string
-}
+	StatusAtendimentoAberto = StatusAtendimento{"aberto"}
 
-func (t *AtualizarClienteTipoCliente) ToValue() string {
-	return t.value
-}
-func (t AtualizarClienteTipoCliente) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
-func (t *AtualizarClienteTipoCliente) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	return t.FromValue(value)
-}
-func (t *AtualizarClienteTipoCliente) FromValue(value string) error {
-	switch value {
+	StatusAtendimentoAgendado = StatusAtendimento{"agendado"}
 
-	case AtualizarClienteTipoClienteAvulso.value:
-		t.value = value
-		return nil
+	StatusAtendimentoAguardandoCliente = StatusAtendimento{"aguardando_cliente"}
 
-	case AtualizarClienteTipoClienteContrato.value:
-		t.value = value
-		return nil
+	StatusAtendimentoCancelado = StatusAtendimento{"cancelado"}
 
-	}
-	return fmt.Errorf("unknown enum value: %v", value)
-}
+	StatusAtendimentoEmAndamento = StatusAtendimento{"em_andamento"}
 
-// AtualizarFormularioNivelDificuldade defines model for AtualizarFormulario.NivelDificuldade.
-type AtualizarFormularioNivelDificuldade struct {
-	value string
-}
+	StatusAtendimentoFechado = StatusAtendimento{"fechado"}
 
-func (t *AtualizarFormularioNivelDificuldade) ToValue() string {
-	return t.value
-}
-func (t AtualizarFormularioNivelDificuldade) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
-func (t *AtualizarFormularioNivelDificuldade) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	return t.FromValue(value)
-}
-func (t *AtualizarFormularioNivelDificuldade) FromValue(value string) error {
-	switch value {
+	StatusAtendimentoResolvido = StatusAtendimento{"resolvido"}
+)
 
-	case AtualizarFormularioNivelDificuldadeHigh.value:
-		t.value = value
-		return nil
+// Defines values for StatusSolicitacaoPortal.
+var (
+	UnknownStatusSolicitacaoPortal = StatusSolicitacaoPortal{}
 
-	case AtualizarFormularioNivelDificuldadeLow.value:
-		t.value = value
-		return nil
+	StatusSolicitacaoPortalAberta = StatusSolicitacaoPortal{"aberta"}
 
-	case AtualizarFormularioNivelDificuldadeMedium.value:
-		t.value = value
-		return nil
+	StatusSolicitacaoPortalConvertida = StatusSolicitacaoPortal{"convertida"}
 
-	}
-	return fmt.Errorf("unknown enum value: %v", value)
-}
+	StatusSolicitacaoPortalRecusada = StatusSolicitacaoPortal{"recusada"}
+)
 
-// ClienteTipoCliente defines model for Cliente.TipoCliente.
-type ClienteTipoCliente struct {
-	value string
-}
+// Defines values for TipoApontamento.
+var (
+	UnknownTipoApontamento = TipoApontamento{}
 
-func (t *ClienteTipoCliente) ToValue() string {
-	return t.value
-}
-func (t ClienteTipoCliente) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
-func (t *ClienteTipoCliente) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	return t.FromValue(value)
-}
-func (t *ClienteTipoCliente) FromValue(value string) error {
-	switch value {
+	TipoApontamentoDeslocamento = TipoApontamento{"deslocamento"}
 
-	case ClienteTipoClienteAvulso.value:
-		t.value = value
-		return nil
+	TipoApontamentoNoLocal = TipoApontamento{"no_local"}
+)
 
-	case ClienteTipoClienteContrato.value:
-		t.value = value
-		return nil
+// Defines values for TipoCampoPersonalizado.
+var (
+	UnknownTipoCampoPersonalizado = TipoCampoPersonalizado{}
 
-	}
-	return fmt.Errorf("unknown enum value: %v", value)
-}
+	TipoCampoPersonalizadoBooleano = TipoCampoPersonalizado{"booleano"}
 
-// CriarClienteTipoCliente defines model for CriarCliente.TipoCliente.
-type CriarClienteTipoCliente struct {
-	value string
-}
+	TipoCampoPersonalizadoData = TipoCampoPersonalizado{"data"}
 
-func (t *CriarClienteTipoCliente) ToValue() string {
-	return t.value
-}
-func (t CriarClienteTipoCliente) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
-func (t *CriarClienteTipoCliente) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	return t.FromValue(value)
-}
-func (t *CriarClienteTipoCliente) FromValue(value string) error {
-	switch value {
+	TipoCampoPersonalizadoNumero = TipoCampoPersonalizado{"numero"}
 
-	case CriarClienteTipoClienteAvulso.value:
-		t.value = value
-		return nil
+	TipoCampoPersonalizadoSelecao = TipoCampoPersonalizado{"selecao"}
 
-	case CriarClienteTipoClienteContrato.value:
-		t.value = value
-		return nil
+	TipoCampoPersonalizadoTexto = TipoCampoPersonalizado{"texto"}
+)
 
-	}
-	return fmt.Errorf("unknown enum value: %v", value)
-}
+// Defines values for TipoEventoLinhaDoTempo.
+var (
+	UnknownTipoEventoLinhaDoTempo = TipoEventoLinhaDoTempo{}
 
-// Nível de dificuldade
-type CriarFormularioNivelDificuldade struct {
-	value string
-}
+	TipoEventoLinhaDoTempoAnexo = TipoEventoLinhaDoTempo{"anexo"}
 
-func (t *CriarFormularioNivelDificuldade) ToValue() string {
-	return t.value
-}
-func (t CriarFormularioNivelDificuldade) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
-func (t *CriarFormularioNivelDificuldade) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	return t.FromValue(value)
-}
-func (t *CriarFormularioNivelDificuldade) FromValue(value string) error {
-	switch value {
+	TipoEventoLinhaDoTempoAtribuicao = TipoEventoLinhaDoTempo{"atribuicao"}
 
-	case CriarFormularioNivelDificuldadeHigh.value:
-		t.value = value
-		return nil
+	TipoEventoLinhaDoTempoComentario = TipoEventoLinhaDoTempo{"comentario"}
 
-	case CriarFormularioNivelDificuldadeLow.value:
-		t.value = value
-		return nil
+	TipoEventoLinhaDoTempoSituacao = TipoEventoLinhaDoTempo{"situacao"}
+)
 
-	case CriarFormularioNivelDificuldadeMedium.value:
-		t.value = value
-		return nil
+// Defines values for TipoItemFatura.
+var (
+	UnknownTipoItemFatura = TipoItemFatura{}
 
-	}
-	return fmt.Errorf("unknown enum value: %v", value)
-}
+	TipoItemFaturaDeslocamento = TipoItemFatura{"deslocamento"}
 
-// CriarUsuarioCargo defines model for CriarUsuario.Cargo.
-type CriarUsuarioCargo struct {
-	value string
-}
+	TipoItemFaturaMaoDeObra = TipoItemFatura{"mao_de_obra"}
 
-func (t *CriarUsuarioCargo) ToValue() string {
-	return t.value
-}
-func (t CriarUsuarioCargo) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
-func (t *CriarUsuarioCargo) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	return t.FromValue(value)
-}
-func (t *CriarUsuarioCargo) FromValue(value string) error {
-	switch value {
+	TipoItemFaturaPeca = TipoItemFatura{"peca"}
+)
 
-	case CriarUsuarioCargoAdministrador.value:
-		t.value = value
-		return nil
+// Defines values for TipoItemModeloChecklist.
+var (
+	UnknownTipoItemModeloChecklist = TipoItemModeloChecklist{}
 
-	case CriarUsuarioCargoTecnicoExterno.value:
-		t.value = value
-		return nil
+	TipoItemModeloChecklistAprovacao = TipoItemModeloChecklist{"aprovacao"}
 
-	case CriarUsuarioCargoTecnicoInterno.value:
-		t.value = value
-		return nil
+	TipoItemModeloChecklistFoto = TipoItemModeloChecklist{"foto"}
 
-	}
-	return fmt.Errorf("unknown enum value: %v", value)
-}
+	TipoItemModeloChecklistNumero = TipoItemModeloChecklist{"numero"}
 
-// FormularioNivelDificuldade defines model for Formulario.NivelDificuldade.
-type FormularioNivelDificuldade struct {
-	value string
-}
+	TipoItemModeloChecklistTexto = TipoItemModeloChecklist{"texto"}
+)
 
-func (t *FormularioNivelDificuldade) ToValue() string {
-	return t.value
-}
-func (t FormularioNivelDificuldade) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
-func (t *FormularioNivelDificuldade) UnmarshalJSON(data []byte) error {
-	var value string
-	if err := json.Unmarshal(data, &value); err != nil {
-		return err
-	}
-	return t.FromValue(value)
-}
-func (t *FormularioNivelDificuldade) FromValue(value string) error {
-	switch value {
+// Defines values for TipoLancamentoEstoque.
+var (
+	UnknownTipoLancamentoEstoque = TipoLancamentoEstoque{}
 
-	case FormularioNivelDificuldadeHigh.value:
-		t.value = value
-		return nil
+	TipoLancamentoEstoqueAjuste = TipoLancamentoEstoque{"ajuste"}
 
-	case FormularioNivelDificuldadeLow.value:
-		t.value = value
-		return nil
+	TipoLancamentoEstoqueEntrada = TipoLancamentoEstoque{"entrada"}
 
-	case FormularioNivelDificuldadeMedium.value:
-		t.value = value
-		return nil
+	TipoLancamentoEstoqueSaida = TipoLancamentoEstoque{"saida"}
 
-	}
-	return fmt.Errorf("unknown enum value: %v", value)
-}
+	TipoLancamentoEstoqueTransferencia = TipoLancamentoEstoque{"transferencia"}
+)
 
-// PostCreateClientJSONBody defines parameters for PostCreateClient.
-type PostCreateClientJSONBody CriarCliente
+// Defines values for TipoLocalEstoque.
+var (
+	UnknownTipoLocalEstoque = TipoLocalEstoque{}
 
-// PutClientJSONBody defines parameters for PutClient.
-type PutClientJSONBody AtualizarCliente
+	TipoLocalEstoqueAlmoxarifado = TipoLocalEstoque{"almoxarifado"}
 
-// PostCreateFormJSONBody defines parameters for PostCreateForm.
-type PostCreateFormJSONBody CriarFormulario
+	TipoLocalEstoqueVeiculo = TipoLocalEstoque{"veiculo"}
+)
 
-// PutFormJSONBody defines parameters for PutForm.
-type PutFormJSONBody AtualizarFormulario
+// Defines values for TipoLocalizacaoTecnico.
+var (
+	UnknownTipoLocalizacaoTecnico = TipoLocalizacaoTecnico{}
 
-// PostCreateUserJSONBody defines parameters for PostCreateUser.
-type PostCreateUserJSONBody CriarUsuario
+	TipoLocalizacaoTecnicoAtual = TipoLocalizacaoTecnico{"atual"}
 
-// PostLoginUserJSONBody defines parameters for PostLoginUser.
-type PostLoginUserJSONBody LoginReq
+	TipoLocalizacaoTecnicoBase = TipoLocalizacaoTecnico{"base"}
+)
 
-// PutUpdateUserJSONBody defines parameters for PutUpdateUser.
-type PutUpdateUserJSONBody AtualizarUsuario
+// Defines values for TipoMovimentacaoEstoque.
+var (
+	UnknownTipoMovimentacaoEstoque = TipoMovimentacaoEstoque{}
 
-// PostCreateClientJSONRequestBody defines body for PostCreateClient for application/json ContentType.
-type PostCreateClientJSONRequestBody PostCreateClientJSONBody
+	TipoMovimentacaoEstoqueAjuste = TipoMovimentacaoEstoque{"ajuste"}
 
-// Bind implements render.Binder.
-func (PostCreateClientJSONRequestBody) Bind(*http.Request) error {
-	return nil
-}
+	TipoMovimentacaoEstoqueConsumo = TipoMovimentacaoEstoque{"consumo"}
 
-// PutClientJSONRequestBody defines body for PutClient for application/json ContentType.
-type PutClientJSONRequestBody PutClientJSONBody
+	TipoMovimentacaoEstoqueEntrada = TipoMovimentacaoEstoque{"entrada"}
 
-// Bind implements render.Binder.
-func (PutClientJSONRequestBody) Bind(*http.Request) error {
-	return nil
-}
+	TipoMovimentacaoEstoqueEstorno = TipoMovimentacaoEstoque{"estorno"}
 
-// PostCreateFormJSONRequestBody defines body for PostCreateForm for application/json ContentType.
-type PostCreateFormJSONRequestBody PostCreateFormJSONBody
+	TipoMovimentacaoEstoqueSaida = TipoMovimentacaoEstoque{"saida"}
 
-// Bind implements render.Binder.
-func (PostCreateFormJSONRequestBody) Bind(*http.Request) error {
-	return nil
-}
+	TipoMovimentacaoEstoqueTransferencia = TipoMovimentacaoEstoque{"transferencia"}
+)
 
-// PutFormJSONRequestBody defines body for PutForm for application/json ContentType.
-type PutFormJSONRequestBody PutFormJSONBody
+// Defines values for UnidadePeca.
+var (
+	UnknownUnidadePeca = UnidadePeca{}
 
-// Bind implements render.Binder.
-func (PutFormJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	UnidadePecaCx = UnidadePeca{"cx"}
+
+	UnidadePecaKg = UnidadePeca{"kg"}
+
+	UnidadePecaKit = UnidadePeca{"kit"}
+
+	UnidadePecaL = UnidadePeca{"l"}
+
+	UnidadePecaM = UnidadePeca{"m"}
+
+	UnidadePecaPar = UnidadePeca{"par"}
+
+	UnidadePecaRolo = UnidadePeca{"rolo"}
+
+	UnidadePecaUn = UnidadePeca{"un"}
+)
+
+// AgendaTecnicos defines model for AgendaTecnicos.
+type AgendaTecnicos struct {
+	Itens []ItemAgenda `json:"itens"`
 }
 
-// PostCreateUserJSONRequestBody defines body for PostCreateUser for application/json ContentType.
-type PostCreateUserJSONRequestBody PostCreateUserJSONBody
+// AgendamentoTecnico defines model for AgendamentoTecnico.
+type AgendamentoTecnico struct {
+	// Fim previsto da visita (até 24 horas depois do início)
+	Fim time.Time `json:"fim"`
 
-// Bind implements render.Binder.
-func (PostCreateUserJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// Início previsto da visita
+	Inicio time.Time `json:"inicio"`
+
+	// Técnico (membro) atribuído ao atendimento
+	TecnicoID string `json:"tecnico_id" validate:"required,uuid"`
 }
 
-// PostLoginUserJSONRequestBody defines body for PostLoginUser for application/json ContentType.
-type PostLoginUserJSONRequestBody PostLoginUserJSONBody
+// AgendarAtendimento defines model for AgendarAtendimento.
+type AgendarAtendimento struct {
+	// Horário de cada técnico; lista vazia remove a agenda do atendimento
+	Agendamentos []AgendamentoTecnico `json:"agendamentos" validate:"max=50,dive"`
 
-// Bind implements render.Binder.
-func (PostLoginUserJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// Grava a agenda mesmo que algum técnico já tenha outra visita no período (padrão false)
+	IgnorarConflitos *bool `json:"ignorar_conflitos,omitempty"`
 }
 
-// PutUpdateUserJSONRequestBody defines body for PutUpdateUser for application/json ContentType.
-type PutUpdateUserJSONRequestBody PutUpdateUserJSONBody
+// AlteracaoAtribuicao defines model for AlteracaoAtribuicao.
+type AlteracaoAtribuicao struct {
+	Acao        AlteracaoAtribuicaoAcao `json:"acao"`
+	ID          string                  `json:"id"`
+	NomeTecnico string                  `json:"nome_tecnico"`
 
-// Bind implements render.Binder.
-func (PutUpdateUserJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// Técnico atribuído ou removido (ausente se o técnico foi removido do sistema)
+	TecnicoID *string `json:"tecnico_id,omitempty"`
 }
 
-// Response is a common response struct for all the API calls.
-// A Response object may be instantiated via functions for specific operation responses.
-// It may also be instantiated directly, for the purpose of responding with a single status code.
-type Response struct {
-	body        interface{}
-	Code        int
-	contentType string
+// AlteracaoStatusFormulario defines model for AlteracaoStatusFormulario.
+type AlteracaoStatusFormulario struct {
+	AlteradoEm time.Time `json:"alterado_em"`
+
+	// Usuário que fez a mudança (ausente se o usuário foi removido)
+	AlteradoPor     *string `json:"alterado_por,omitempty"`
+	ID              string  `json:"id"`
+	Motivo          string  `json:"motivo"`
+	NomeAlteradoPor string  `json:"nome_alterado_por"`
+
+	// Situação do atendimento
+	StatusAnterior StatusAtendimento `json:"status_anterior"`
+
+	// Situação do atendimento
+	StatusNovo StatusAtendimento `json:"status_novo"`
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
-func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
-	return nil
+// AlterarStatusFormulario defines model for AlterarStatusFormulario.
+type AlterarStatusFormulario struct {
+	// Solução aplicada; obrigatória ao resolver se o atendimento ainda não tiver uma
+	DescricaoSolucao *string `json:"descricao_solucao,omitempty" validate:"omitempty,min=2,max=500"`
+
+	// Motivo da mudança; obrigatório ao cancelar ou reabrir
+	Motivo *string `json:"motivo,omitempty" validate:"omitempty,max=500"`
+
+	// Situação do atendimento
+	Status StatusAtendimento `json:"status"`
 }
 
-// Status is a builder method to override the default status code for a response.
-func (resp *Response) Status(code int) *Response {
-	resp.Code = code
-	return resp
+// AnexoAtendimento defines model for AnexoAtendimento.
+type AnexoAtendimento struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Usuário que enviou o arquivo (ausente se o usuário foi removido)
+	EnviadoPor     *string `json:"enviado_por,omitempty"`
+	ID             string  `json:"id"`
+	NomeArquivo    string  `json:"nome_arquivo"`
+	NomeEnviadoPor string  `json:"nome_enviado_por"`
+
+	// SHA-256 do conteúdo em hexadecimal
+	Sha256 string `json:"sha256"`
+
+	// Tamanho em bytes
+	Tamanho int64 `json:"tamanho"`
+
+	// Tipo identificado pelo conteúdo do arquivo
+	TipoConteudo string `json:"tipo_conteudo"`
+
+	// URL assinada para baixar o arquivo
+	URLDownload string `json:"url_download"`
+
+	// Momento em que url_download deixa de valer
+	URLExpiraEm time.Time `json:"url_expira_em"`
 }
 
-// ContentType is a builder method to override the default content type for a response.
-func (resp *Response) ContentType(contentType string) *Response {
-	resp.contentType = contentType
-	return resp
+// AnexoLinhaDoTempo defines model for AnexoLinhaDoTempo.
+type AnexoLinhaDoTempo struct {
+	ID           string `json:"id"`
+	NomeArquivo  string `json:"nome_arquivo"`
+	Tamanho      int64  `json:"tamanho"`
+	TipoConteudo string `json:"tipo_conteudo"`
 }
 
-// MarshalJSON implements the json.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalJSON() ([]byte, error) {
-	return json.Marshal(resp.body)
+// Apontamento defines model for Apontamento.
+type Apontamento struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Duração; para cronômetros em andamento, o tempo decorrido até a consulta
+	DuracaoSegundos int64 `json:"duracao_segundos"`
+	EmAndamento     bool  `json:"em_andamento"`
+
+	// Ausente enquanto o cronômetro está em andamento
+	Fim         *time.Time        `json:"fim,omitempty"`
+	ID          string            `json:"id"`
+	Inicio      time.Time         `json:"inicio"`
+	NomeTecnico string            `json:"nome_tecnico"`
+	Observacao  string            `json:"observacao"`
+	Origem      ApontamentoOrigem `json:"origem"`
+
+	// Técnico do apontamento (ausente se o técnico foi removido)
+	TecnicoID *string `json:"tecnico_id,omitempty"`
+
+	// Tipo do apontamento de horas
+	Tipo TipoApontamento `json:"tipo"`
 }
 
-// MarshalXML implements the xml.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	return e.Encode(resp.body)
+// AssinarAtendimento defines model for AssinarAtendimento.
+type AssinarAtendimento struct {
+	// Data e hora da assinatura no dispositivo; se ausente, o horário do servidor
+	AssinadoEm         *time.Time `json:"assinado_em,omitempty"`
+	DocumentoAssinante string     `json:"documento_assinante" validate:"required,max=30"`
+
+	// Formato da assinatura
+	Formato FormatoAssinatura `json:"formato"`
+
+	// Imagem PNG ou SVG em base64 (formatos png e svg)
+	Imagem         []byte   `json:"imagem,omitempty"`
+	Latitude       float64  `json:"latitude" validate:"min=-90,max=90"`
+	Longitude      float64  `json:"longitude" validate:"min=-180,max=180"`
+	NomeAssinante  string   `json:"nome_assinante" validate:"required,max=200"`
+	PrecisaoMetros *float64 `json:"precisao_metros,omitempty" validate:"omitempty,min=0"`
+
+	// Traços da assinatura (formato tracos)
+	Tracos [][]PontoAssinatura `json:"tracos,omitempty"`
 }
 
-// PostCreateClientJSON200Response is a constructor method for a PostCreateClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateClientJSON200Response(body Resp200) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// AssinaturaAtendimento defines model for AssinaturaAtendimento.
+type AssinaturaAtendimento struct {
+	AssinadoEm time.Time `json:"assinado_em"`
+
+	// Técnico que coletou a assinatura (ausente se o usuário foi removido)
+	ColetadoPor        *string   `json:"coletado_por,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+	DocumentoAssinante string    `json:"documento_assinante"`
+
+	// Formato da assinatura
+	Formato FormatoAssinatura `json:"formato"`
+
+	// SHA-256 do conteúdo do atendimento no momento da assinatura
+	HashAtendimento string `json:"hash_atendimento"`
+
+	// SHA-256 do conteúdo atual do atendimento
+	HashAtual string `json:"hash_atual"`
+	ID        string `json:"id"`
+
+	// Falso quando o atendimento foi alterado depois da assinatura
+	Integro         bool     `json:"integro"`
+	Latitude        float64  `json:"latitude"`
+	Longitude       float64  `json:"longitude"`
+	NomeAssinante   string   `json:"nome_assinante"`
+	NomeColetadoPor string   `json:"nome_coletado_por"`
+	PrecisaoMetros  *float64 `json:"precisao_metros,omitempty"`
+
+	// SHA-256 do arquivo da assinatura
+	Sha256       string `json:"sha256"`
+	Tamanho      int64  `json:"tamanho"`
+	TipoConteudo string `json:"tipo_conteudo"`
+
+	// URL assinada para baixar a assinatura
+	URLDownload string    `json:"url_download"`
+	URLExpiraEm time.Time `json:"url_expira_em"`
 }
 
-// PostCreateClientJSON400Response is a constructor method for a PostCreateClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateClientJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// AtendimentoPortal defines model for AtendimentoPortal.
+type AtendimentoPortal struct {
+	DataDeAbertura   time.Time `json:"data_de_abertura"`
+	DescricaoDefeito string    `json:"descricao_defeito"`
+	DescricaoSolucao string    `json:"descricao_solucao"`
+	HorasConsumidas  float64   `json:"horas_consumidas"`
+	ID               string    `json:"id"`
+
+	// Protocolo do atendimento (OS-AAAA-NNNNNN)
+	Protocolo     string `json:"protocolo"`
+	SolicitadoPor string `json:"solicitado_por"`
+
+	// Situação do atendimento
+	Status    StatusAtendimento `json:"status"`
+	Tecnicos  []string          `json:"tecnicos"`
+	UpdatedAt time.Time         `json:"updated_at"`
 }
 
-// PostCreateClientJSON500Response is a constructor method for a PostCreateClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateClientJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// AtendimentoSemLocalizacao defines model for AtendimentoSemLocalizacao.
+type AtendimentoSemLocalizacao struct {
+	AtendimentoID string `json:"atendimento_id"`
+	ClienteID     string `json:"cliente_id"`
+	ClienteNome   string `json:"cliente_nome"`
+	Descricao     string `json:"descricao"`
+	Endereco      string `json:"endereco"`
+
+	// Protocolo sequencial do atendimento no ano (OS-AAAA-NNNNNN)
+	Protocolo string `json:"protocolo"`
+	Situacao  string `json:"situacao"`
 }
 
-// DeleteClientJSON204Response is a constructor method for a DeleteClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteClientJSON204Response(body Resp204) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// AtendimentoSemelhante defines model for AtendimentoSemelhante.
+type AtendimentoSemelhante struct {
+	ClienteID        string `json:"cliente_id"`
+	DescricaoDefeito string `json:"descricao_defeito"`
+	DescricaoSolucao string `json:"descricao_solucao"`
+	FormularioID     string `json:"formulario_id"`
+	NomeCliente      string `json:"nome_cliente"`
+
+	// Pontuação usada na ordem, de 0 a 1 (semelhança ajustada por cliente, recência e votos de útil)
+	Pontuacao float64 `json:"pontuacao"`
+
+	// Protocolo do atendimento (OS-AAAA-NNNNNN)
+	Protocolo   string     `json:"protocolo"`
+	ResolvidoEm *time.Time `json:"resolvido_em,omitempty"`
+
+	// Semelhança entre os textos dos defeitos, de 0 a 1
+	Semelhanca float64 `json:"semelhanca"`
+
+	// Situação do atendimento
+	Status StatusAtendimento `json:"status"`
+
+	// Quantas vezes o atendimento foi marcado como útil
+	VotosUtil int `json:"votos_util"`
 }
 
-// DeleteClientJSON400Response is a constructor method for a DeleteClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteClientJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// AtualizarCampoPersonalizado defines model for AtualizarCampoPersonalizado.
+type AtualizarCampoPersonalizado struct {
+	Obrigatorio bool     `json:"obrigatorio"`
+	Opcoes      []string `json:"opcoes,omitempty"`
+
+	// Regras de validação; tamanho e padrão valem para texto, mínimo e máximo para número
+	Regras *RegrasCampoPersonalizado `json:"regras,omitempty"`
+	Rotulo string                    `json:"rotulo" validate:"required,max=100"`
 }
 
-// DeleteClientJSON401Response is a constructor method for a DeleteClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteClientJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// AtualizarCliente defines model for AtualizarCliente.
+type AtualizarCliente struct {
+	// Valores dos campos personalizados, indexados pela chave (texto, número, data AAAA-MM-DD, opção ou booleano)
+	CamposPersonalizados *CamposPersonalizados `json:"campos_personalizados,omitempty"`
+
+	// CPF ou CNPJ (com ou sem máscara)
+	CnpjOuCpf string `json:"cnpj_ou_cpf" validate:"required,cpf_cnpj"`
+
+	// Email de contato
+	EmailContato openapi_types.Email `json:"email_contato" validate:"omitempty,email"`
+	Endereco     Endereco            `json:"endereco"`
+	NomeCliente  string              `json:"nome_cliente" validate:"required,min=2,max=500"`
+	NomeContato  string              `json:"nome_contato" validate:"required,min=2,max=500"`
+
+	// Tags livres (até 20, com até 50 caracteres cada)
+	Tags []string `json:"tags,omitempty" validate:"omitempty,max=20,dive,max=50"`
+
+	// Telefone de contato no formato E.164 (ex: +5511912345678)
+	TelefoneContato string                      `json:"telefone_contato" validate:"omitempty,e164"`
+	TipoCliente     AtualizarClienteTipoCliente `json:"tipo_cliente" validate:"required,oneof=avulso contrato"`
 }
 
-// DeleteClientJSON500Response is a constructor method for a DeleteClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteClientJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// AtualizarConfiguracaoCobranca defines model for AtualizarConfiguracaoCobranca.
+type AtualizarConfiguracaoCobranca struct {
+	// Porcentagem com até 2 casas decimais
+	AliquotaImpostos float64 `json:"aliquota_impostos" validate:"gte=0,lte=100"`
+	TaxaDeslocamento float64 `json:"taxa_deslocamento" validate:"gte=0"`
+	ValorHoraAlta    float64 `json:"valor_hora_alta" validate:"gte=0"`
+
+	// Valor com até 2 casas decimais
+	ValorHoraBaixa float64 `json:"valor_hora_baixa" validate:"gte=0"`
+	ValorHoraMedia float64 `json:"valor_hora_media" validate:"gte=0"`
 }
 
-// GetV1clientsListJSON200Response is a constructor method for a GetV1clientsList response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetV1clientsListJSON200Response(body ListaClientes) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// AtualizarContrato defines model for AtualizarContrato.
+type AtualizarContrato struct {
+	DataFim           openapi_types.Date `json:"data_fim" validate:"required"`
+	DataInicio        openapi_types.Date `json:"data_inicio" validate:"required"`
+	HorasMensais      float64            `json:"horas_mensais" validate:"required,gt=0"`
+	ServicosCobertos  []string           `json:"servicos_cobertos,omitempty" validate:"omitempty,dive,min=2,max=100"`
+	SLAResolucaoHoras int32              `json:"sla_resolucao_horas" validate:"required,gte=1"`
+	SLARespostaHoras  int32              `json:"sla_resposta_horas" validate:"required,gte=1"`
+	ValorMensal       float64            `json:"valor_mensal" validate:"gte=0"`
 }
 
-// GetV1clientsListJSON500Response is a constructor method for a GetV1clientsList response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetV1clientsListJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// AtualizarFatura defines model for AtualizarFatura.
+type AtualizarFatura struct {
+	AliquotaImpostos float64             `json:"aliquota_impostos" validate:"gte=0,lte=100"`
+	Desconto         float64             `json:"desconto" validate:"gte=0"`
+	Observacoes      *string             `json:"observacoes,omitempty" validate:"omitempty,max=2000"`
+	Vencimento       *openapi_types.Date `json:"vencimento,omitempty"`
 }
 
-// PutClientJSON204Response is a constructor method for a PutClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutClientJSON204Response(body Resp204) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// AtualizarFormulario defines model for AtualizarFormulario.
+type AtualizarFormulario struct {
+	// Valores dos campos personalizados, indexados pela chave (texto, número, data AAAA-MM-DD, opção ou booleano)
+	CamposPersonalizados *CamposPersonalizados `json:"campos_personalizados,omitempty"`
+
+	// Valores dos campos extras do tipo de formulário, conforme o esquema da versão
+	Dados            *DadosFormulario `json:"dados,omitempty"`
+	DataOcorrencia   time.Time        `json:"data_ocorrencia" validate:"required"`
+	DescricaoDefeito string           `json:"descricao_defeito" validate:"required,min=2,max=500"`
+	DescricaoSolucao string           `json:"descricao_solucao" validate:"required,min=2,max=500"`
+
+	// Horas do atendimento a debitar do contrato ativo do cliente
+	HorasConsumidas  *float64                            `json:"horas_consumidas,omitempty" validate:"omitempty,gte=0"`
+	NivelDificuldade AtualizarFormularioNivelDificuldade `json:"nivel_dificuldade" validate:"required,oneof=low medium high"`
+	Solicitante      string                              `json:"solicitante" validate:"required,min=2,max=500"`
+
+	// Tags livres (até 20, com até 50 caracteres cada)
+	Tags                []string `json:"tags,omitempty" validate:"omitempty,max=20,dive,max=50"`
+	TecnicosResponsavel []string `json:"tecnicos_responsavel" validate:"required,min=1,dive,uuid"`
 }
 
-// PutClientJSON400Response is a constructor method for a PutClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutClientJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// AtualizarModeloOrdemServico defines model for AtualizarModeloOrdemServico.
+type AtualizarModeloOrdemServico struct {
+	// Cor dos títulos em hexadecimal (#RRGGBB); vazio usa a cor padrão
+	CorPrimaria string `json:"cor_primaria"`
+
+	// CNPJ ou CPF da empresa
+	DocumentoEmpresa string `json:"documento_empresa" validate:"max=30"`
+	Email            string `json:"email" validate:"omitempty,email,max=200"`
+	Endereco         string `json:"endereco" validate:"max=300"`
+
+	// Fuso das datas impressas (IANA); vazio usa America/Sao_Paulo
+	FusoHorario string `json:"fuso_horario"`
+	NomeEmpresa string `json:"nome_empresa" validate:"max=200"`
+	Site        string `json:"site" validate:"max=200"`
+	Telefone    string `json:"telefone" validate:"max=30"`
+
+	// Texto impresso no rodapé de todas as páginas
+	TextoRodape string `json:"texto_rodape"`
 }
 
-// PutClientJSON401Response is a constructor method for a PutClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutClientJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// AtualizarPeca defines model for AtualizarPeca.
+type AtualizarPeca struct {
+	// Peças inativas não podem ser lançadas em atendimentos
+	Ativa         bool    `json:"ativa"`
+	Custo         float64 `json:"custo" validate:"gte=0"`
+	EstoqueMinimo float64 `json:"estoque_minimo" validate:"gte=0"`
+	Nome          string  `json:"nome" validate:"required,max=150"`
+	Preco         float64 `json:"preco" validate:"gte=0"`
+	Sku           string  `json:"sku" validate:"required,max=50"`
+
+	// Unidade de medida da peça
+	Unidade UnidadePeca `json:"unidade"`
 }
 
-// PutClientJSON500Response is a constructor method for a PutClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutClientJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// AtualizarPlanoManutencao defines model for AtualizarPlanoManutencao.
+type AtualizarPlanoManutencao struct {
+	// Itens da lista de verificação padrão
+	Checklist []string `json:"checklist,omitempty" validate:"omitempty,max=50,dive,min=1,max=200"`
+
+	// Descrição do serviço preventivo
+	Descricao *string `json:"descricao,omitempty" validate:"omitempty,max=120"`
+
+	// Primeira visita da série; define o horário das visitas
+	Inicio time.Time `json:"inicio"`
+
+	// Local do cliente atendido (vazio para o cliente inteiro)
+	Local            *string                                  `json:"local,omitempty" validate:"omitempty,max=100"`
+	NivelDificuldade AtualizarPlanoManutencaoNivelDificuldade `json:"nivel_dificuldade"`
+
+	// Nome do plano, usado como solicitante dos atendimentos
+	Nome string `json:"nome" validate:"required,min=1,max=50"`
+
+	// Regra de recorrência RRULE com FREQ (DAILY, WEEKLY, MONTHLY ou YEARLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT e UNTIL, como FREQ=MONTHLY;INTERVAL=3
+	Recorrencia string `json:"recorrencia" validate:"required"`
+
+	// Técnicos (membros) atribuídos às visitas
+	Tecnicos []string `json:"tecnicos" validate:"required,min=1,dive,uuid"`
 }
 
-// GetByIDClientJSON200Response is a constructor method for a GetByIDClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetByIDClientJSON200Response(body BuscaCliente) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// AtualizarPoliticaSLA defines model for AtualizarPoliticaSLA.
+type AtualizarPoliticaSLA struct {
+	// Prazo de resolução em minutos úteis (não pode ser menor que o de resposta)
+	MinutosResolucao int `json:"minutos_resolucao" validate:"required,min=1"`
+
+	// Prazo da primeira resposta em minutos úteis
+	MinutosResposta  int                                  `json:"minutos_resposta" validate:"required,min=1"`
+	NivelDificuldade AtualizarPoliticaSLANivelDificuldade `json:"nivel_dificuldade"`
+	TipoCliente      AtualizarPoliticaSLATipoCliente      `json:"tipo_cliente"`
 }
 
-// GetByIDClientJSON400Response is a constructor method for a GetByIDClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetByIDClientJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// AtualizarTipoFormulario defines model for AtualizarTipoFormulario.
+type AtualizarTipoFormulario struct {
+	// Tipos inativos não aceitam novos atendimentos
+	Ativo     bool    `json:"ativo"`
+	Descricao *string `json:"descricao,omitempty" validate:"omitempty,max=500"`
+	Nome      string  `json:"nome" validate:"required,max=100"`
 }
 
-// GetByIDClientJSON401Response is a constructor method for a GetByIDClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetByIDClientJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// AtualizarUsuario defines model for AtualizarUsuario.
+type AtualizarUsuario struct {
+	// Email de contato
+	Email *openapi_types.Email `json:"email,omitempty" validate:"omitempty,email"`
+	Nome  *string              `json:"nome,omitempty" validate:"omitempty,min=2,max=500"`
 }
 
-// GetByIDClientJSON500Response is a constructor method for a GetByIDClient response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetByIDClientJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// AvisoConflitoAgenda defines model for AvisoConflitoAgenda.
+type AvisoConflitoAgenda struct {
+	// Visitas dos técnicos que ocupam parte do período pedido
+	Conflitos []ItemAgenda `json:"conflitos"`
+	Message   string       `json:"message"`
 }
 
-// PostCreateFormJSON201Response is a constructor method for a PostCreateForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateFormJSON201Response(body Resp200) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// AvisoDuplicidade defines model for AvisoDuplicidade.
+type AvisoDuplicidade struct {
+	Candidatos []CandidatoDuplicado `json:"candidatos"`
+	Message    string               `json:"message"`
 }
 
-// PostCreateFormJSON400Response is a constructor method for a PostCreateForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateFormJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// BuscaAtendimentoPortal defines model for BuscaAtendimentoPortal.
+type BuscaAtendimentoPortal struct {
+	Atendimento AtendimentoPortal `json:"atendimento"`
 }
 
-// PostCreateFormJSON500Response is a constructor method for a PostCreateForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateFormJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// BuscaAtendimentos defines model for BuscaAtendimentos.
+type BuscaAtendimentos struct {
+	Limite     int                         `json:"limite"`
+	Pagina     int                         `json:"pagina"`
+	Resultados []ResultadoBuscaAtendimento `json:"resultados"`
+
+	// Quantidade de atendimentos encontrados em todas as páginas
+	Total int `json:"total"`
 }
 
-// DeleteFormJSON204Response is a constructor method for a DeleteForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteFormJSON204Response(body Resp204) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// BuscaCliente defines model for BuscaCliente.
+type BuscaCliente struct {
+	Cliente *Cliente `json:"cliente,omitempty"`
 }
 
-// DeleteFormJSON400Response is a constructor method for a DeleteForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteFormJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// BuscaContrato defines model for BuscaContrato.
+type BuscaContrato struct {
+	Contrato Contrato `json:"contrato"`
 }
 
-// DeleteFormJSON500Response is a constructor method for a DeleteForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteFormJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// BuscaFormulario defines model for BuscaFormulario.
+type BuscaFormulario struct {
+	Formulario Formulario `json:"formulario"`
 }
 
-// ListFormsJSON200Response is a constructor method for a ListForms response.
-// A *Response is returned with the configured status code and content type from the spec.
-func ListFormsJSON200Response(body ListaFormulario) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// BuscaUsuario defines model for BuscaUsuario.
+type BuscaUsuario struct {
+	Usuario *Usuario `json:"usuario,omitempty"`
 }
 
-// ListFormsJSON401Response is a constructor method for a ListForms response.
-// A *Response is returned with the configured status code and content type from the spec.
-func ListFormsJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// CampoPersonalizado defines model for CampoPersonalizado.
+type CampoPersonalizado struct {
+	Chave       string                     `json:"chave"`
+	CreatedAt   time.Time                  `json:"created_at"`
+	Entidade    EntidadeCampoPersonalizado `json:"entidade"`
+	ID          string                     `json:"id"`
+	Obrigatorio bool                       `json:"obrigatorio"`
+	Opcoes      []string                   `json:"opcoes"`
+
+	// Regras de validação; tamanho e padrão valem para texto, mínimo e máximo para número
+	Regras    RegrasCampoPersonalizado `json:"regras"`
+	Rotulo    string                   `json:"rotulo"`
+	Tipo      TipoCampoPersonalizado   `json:"tipo"`
+	UpdatedAt time.Time                `json:"updated_at"`
 }
 
-// ListFormsJSON500Response is a constructor method for a ListForms response.
-// A *Response is returned with the configured status code and content type from the spec.
-func ListFormsJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// Valores dos campos personalizados, indexados pela chave (texto, número, data AAAA-MM-DD, opção ou booleano)
+type CamposPersonalizados struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// PutFormJSON204Response is a constructor method for a PutForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutFormJSON204Response(body Resp204) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// CandidatoDuplicado defines model for CandidatoDuplicado.
+type CandidatoDuplicado struct {
+	Cliente ClienteResumo       `json:"cliente"`
+	Motivos []MotivoDuplicidade `json:"motivos"`
+
+	// Probabilidade de duplicidade, de 0 a 1
+	Pontuacao float64 `json:"pontuacao"`
 }
 
-// PutFormJSON400Response is a constructor method for a PutForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutFormJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// Cliente defines model for Cliente.
+type Cliente struct {
+	// Valores dos campos personalizados, indexados pela chave (texto, número, data AAAA-MM-DD, opção ou booleano)
+	CamposPersonalizados CamposPersonalizados `json:"campos_personalizados"`
+
+	// CPF ou CNPJ (com ou sem máscara)
+	CnpjOuCpf string    `json:"cnpj_ou_cpf" validate:"required,min=11,max=18"`
+	CreatedAt time.Time `json:"created_at" validate:"required"`
+
+	// Email de contato
+	EmailContato openapi_types.Email `json:"email_contato" validate:"omitempty,email"`
+	Endereco     Endereco            `json:"endereco"`
+	ID           string              `json:"id" validate:"required,uuid"`
+	NomeCliente  string              `json:"nome_cliente" validate:"required,min=2,max=500"`
+	NomeContato  string              `json:"nome_contato" validate:"required,min=2,max=500"`
+
+	// Tags livres do registro
+	Tags []string `json:"tags"`
+
+	// Telefone de contato no formato E.164 (ex: +5511912345678)
+	TelefoneContato string             `json:"telefone_contato" validate:"omitempty,e164"`
+	TipoCliente     ClienteTipoCliente `json:"tipo_cliente" validate:"required,oneof=avulso contrato"`
+	UpdatedAt       time.Time          `json:"updated_at" validate:"required"`
 }
 
-// PutFormJSON401Response is a constructor method for a PutForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutFormJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// ClienteLixeira defines model for ClienteLixeira.
+type ClienteLixeira struct {
+	CnpjOuCpf string `json:"cnpj_ou_cpf"`
+
+	// Data e hora em que o cliente foi enviado para a lixeira
+	DeletedAt   time.Time                 `json:"deleted_at"`
+	ID          string                    `json:"id"`
+	NomeCliente string                    `json:"nome_cliente"`
+	TipoCliente ClienteLixeiraTipoCliente `json:"tipo_cliente"`
 }
 
-// PutFormJSON500Response is a constructor method for a PutForm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutFormJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// ClienteResumo defines model for ClienteResumo.
+type ClienteResumo struct {
+	CnpjOuCpf       string `json:"cnpj_ou_cpf"`
+	EmailContato    string `json:"email_contato"`
+	ID              string `json:"id"`
+	NomeCliente     string `json:"nome_cliente"`
+	TelefoneContato string `json:"telefone_contato"`
 }
 
-// GetFormByIDJSON200Response is a constructor method for a GetFormByID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetFormByIDJSON200Response(body BuscaFormulario) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// Comentario defines model for Comentario.
+type Comentario struct {
+	// Usuário que escreveu o comentário (ausente se o usuário foi removido)
+	AutorID *string `json:"autor_id,omitempty"`
+
+	// Texto do comentário em Markdown
+	Corpo     string    `json:"corpo"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Indica se o comentário já foi editado
+	Editado   bool      `json:"editado"`
+	ID        string    `json:"id"`
+	Interno   bool      `json:"interno"`
+	NomeAutor string    `json:"nome_autor"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// GetFormByIDJSON400Response is a constructor method for a GetFormByID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetFormByIDJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// ConfiguracaoCobranca defines model for ConfiguracaoCobranca.
+type ConfiguracaoCobranca struct {
+	// Alíquota de impostos, em porcentagem, aplicada às novas faturas
+	AliquotaImpostos float64 `json:"aliquota_impostos"`
+
+	// Taxa de deslocamento cobrada por atendimento
+	TaxaDeslocamento float64    `json:"taxa_deslocamento"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+	UpdatedBy        *string    `json:"updated_by,omitempty"`
+
+	// Valor da hora dos atendimentos de dificuldade alta
+	ValorHoraAlta float64 `json:"valor_hora_alta"`
+
+	// Valor da hora dos atendimentos de dificuldade baixa
+	ValorHoraBaixa float64 `json:"valor_hora_baixa"`
+
+	// Valor da hora dos atendimentos de dificuldade média
+	ValorHoraMedia float64 `json:"valor_hora_media"`
 }
 
-// GetFormByIDJSON401Response is a constructor method for a GetFormByID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetFormByIDJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// ContaPortal defines model for ContaPortal.
+type ContaPortal struct {
+	ClienteID   string              `json:"cliente_id"`
+	Email       openapi_types.Email `json:"email"`
+	ID          string              `json:"id"`
+	Nome        string              `json:"nome"`
+	NomeCliente string              `json:"nome_cliente"`
 }
 
-// GetFormByIDJSON500Response is a constructor method for a GetFormByID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetFormByIDJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// Contrato defines model for Contrato.
+type Contrato struct {
+	// Avisos de vencimento e de consumo do contrato
+	Alertas   []ContratoAlertas `json:"alertas"`
+	ClienteID string            `json:"cliente_id"`
+	CreatedAt time.Time         `json:"created_at"`
+
+	// Fim da vigência (inclusivo)
+	DataFim openapi_types.Date `json:"data_fim"`
+
+	// Início da vigência (inclusivo)
+	DataInicio openapi_types.Date `json:"data_inicio"`
+
+	// Franquia de horas por período de faturamento
+	HorasMensais float64 `json:"horas_mensais"`
+
+	// Saldo de horas no período de faturamento atual
+	HorasRestantesPeriodo float64 `json:"horas_restantes_periodo"`
+
+	// Horas consumidas no período de faturamento atual
+	HorasUtilizadasPeriodo float64  `json:"horas_utilizadas_periodo"`
+	ID                     string   `json:"id"`
+	NomeCliente            string   `json:"nome_cliente"`
+	ServicosCobertos       []string `json:"servicos_cobertos"`
+
+	// Meta de resolução em horas
+	SLAResolucaoHoras int32 `json:"sla_resolucao_horas"`
+
+	// Meta de primeira resposta em horas
+	SLARespostaHoras int32     `json:"sla_resposta_horas"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	ValorMensal      float64   `json:"valor_mensal"`
 }
 
-// ListMembersJSON200Response is a constructor method for a ListMembers response.
-// A *Response is returned with the configured status code and content type from the spec.
-func ListMembersJSON200Response(body ListaUsuarios) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// CriarApontamento defines model for CriarApontamento.
+type CriarApontamento struct {
+	Fim        time.Time `json:"fim"`
+	Inicio     time.Time `json:"inicio"`
+	Observacao *string   `json:"observacao,omitempty" validate:"omitempty,max=2000"`
+	TecnicoID  string    `json:"tecnico_id" validate:"required,uuid"`
+
+	// Tipo do apontamento de horas
+	Tipo TipoApontamento `json:"tipo"`
 }
 
-// ListMembersJSON400Response is a constructor method for a ListMembers response.
-// A *Response is returned with the configured status code and content type from the spec.
-func ListMembersJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CriarCampoPersonalizado defines model for CriarCampoPersonalizado.
+type CriarCampoPersonalizado struct {
+	// Letras minúsculas, dígitos e sublinhado, começando por letra
+	Chave       string                     `json:"chave" validate:"required,max=50"`
+	Entidade    EntidadeCampoPersonalizado `json:"entidade"`
+	Obrigatorio *bool                      `json:"obrigatorio,omitempty"`
+
+	// Opções permitidas (somente para o tipo selecao)
+	Opcoes []string `json:"opcoes,omitempty"`
+
+	// Regras de validação; tamanho e padrão valem para texto, mínimo e máximo para número
+	Regras *RegrasCampoPersonalizado `json:"regras,omitempty"`
+	Rotulo string                    `json:"rotulo" validate:"required,max=100"`
+	Tipo   TipoCampoPersonalizado    `json:"tipo"`
 }
 
-// ListMembersJSON401Response is a constructor method for a ListMembers response.
-// A *Response is returned with the configured status code and content type from the spec.
-func ListMembersJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// CriarCliente defines model for CriarCliente.
+type CriarCliente struct {
+	// Valores dos campos personalizados, indexados pela chave (texto, número, data AAAA-MM-DD, opção ou booleano)
+	CamposPersonalizados *CamposPersonalizados `json:"campos_personalizados,omitempty"`
+
+	// CPF ou CNPJ (com ou sem máscara)
+	CnpjOuCpf string `json:"cnpj_ou_cpf" validate:"required,cpf_cnpj"`
+
+	// Email de contato
+	EmailContato openapi_types.Email `json:"email_contato" validate:"omitempty,email"`
+	Endereco     Endereco            `json:"endereco"`
+
+	// Cria o cliente mesmo que existam prováveis duplicados
+	IgnorarDuplicados *bool  `json:"ignorar_duplicados,omitempty"`
+	NomeCliente       string `json:"nome_cliente" validate:"required,min=2,max=500"`
+	NomeContato       string `json:"nome_contato" validate:"required,min=2,max=500"`
+
+	// Tags livres (até 20, com até 50 caracteres cada)
+	Tags []string `json:"tags,omitempty" validate:"omitempty,max=20,dive,max=50"`
+
+	// Telefone de contato no formato E.164 (ex: +5511912345678)
+	TelefoneContato string                  `json:"telefone_contato" validate:"omitempty,e164"`
+	TipoCliente     CriarClienteTipoCliente `json:"tipo_cliente" validate:"required,oneof=avulso contrato"`
 }
 
-// ListMembersJSON404Response is a constructor method for a ListMembers response.
-// A *Response is returned with the configured status code and content type from the spec.
-func ListMembersJSON404Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        404,
-		contentType: "application/json",
-	}
+// CriarComentario defines model for CriarComentario.
+type CriarComentario struct {
+	// Texto do comentário em Markdown
+	Corpo string `json:"corpo" validate:"required,max=10000"`
+
+	// Visível apenas para a equipe (padrão false)
+	Interno *bool `json:"interno,omitempty"`
 }
 
-// ListMembersJSON500Response is a constructor method for a ListMembers response.
-// A *Response is returned with the configured status code and content type from the spec.
-func ListMembersJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// CriarContrato defines model for CriarContrato.
+type CriarContrato struct {
+	ClienteID         string             `json:"cliente_id" validate:"required,uuid"`
+	DataFim           openapi_types.Date `json:"data_fim" validate:"required"`
+	DataInicio        openapi_types.Date `json:"data_inicio" validate:"required"`
+	HorasMensais      float64            `json:"horas_mensais" validate:"required,gt=0"`
+	ServicosCobertos  []string           `json:"servicos_cobertos,omitempty" validate:"omitempty,dive,min=2,max=100"`
+	SLAResolucaoHoras int32              `json:"sla_resolucao_horas" validate:"required,gte=1"`
+	SLARespostaHoras  int32              `json:"sla_resposta_horas" validate:"required,gte=1"`
+	ValorMensal       float64            `json:"valor_mensal" validate:"gte=0"`
 }
 
-// PostCreateUserJSON200Response is a constructor method for a PostCreateUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateUserJSON200Response(body Resp200) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// CriarFatura defines model for CriarFatura.
+type CriarFatura struct {
+	// Alíquota de impostos em porcentagem; ausente usa a configurada
+	AliquotaImpostos *float64 `json:"aliquota_impostos,omitempty" validate:"omitempty,gte=0,lte=100"`
+
+	// Atendimentos avulsos, resolvidos ou fechados, de um mesmo cliente
+	AtendimentoIds []string `json:"atendimento_ids" validate:"required,min=1,max=100,dive,uuid"`
+
+	// Valor abatido do subtotal, com até 2 casas decimais
+	Desconto *float64 `json:"desconto,omitempty" validate:"omitempty,gte=0"`
+
+	// Cobra a taxa de deslocamento em cada atendimento
+	IncluirDeslocamento *bool               `json:"incluir_deslocamento,omitempty"`
+	Observacoes         *string             `json:"observacoes,omitempty" validate:"omitempty,max=2000"`
+	Vencimento          *openapi_types.Date `json:"vencimento,omitempty"`
 }
 
-// PostCreateUserJSON400Response is a constructor method for a PostCreateUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateUserJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CriarFeriado defines model for CriarFeriado.
+type CriarFeriado struct {
+	Data openapi_types.Date `json:"data" validate:"required"`
+	Nome string             `json:"nome" validate:"required,min=1,max=100"`
 }
 
-// PostCreateUserJSON422Response is a constructor method for a PostCreateUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateUserJSON422Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        422,
-		contentType: "application/json",
-	}
+// CriarFormulario defines model for CriarFormulario.
+type CriarFormulario struct {
+	// Sem tecnicos_responsavel, designa o técnico mais bem colocado nas sugestões de designação
+	AtribuirAutomaticamente *bool `json:"atribuir_automaticamente,omitempty"`
+
+	// Valores dos campos personalizados, indexados pela chave (texto, número, data AAAA-MM-DD, opção ou booleano)
+	CamposPersonalizados *CamposPersonalizados `json:"campos_personalizados,omitempty"`
+
+	// Modelo cuja lista de verificação é copiada para o atendimento
+	ChecklistModeloID *string `json:"checklist_modelo_id,omitempty" validate:"omitempty,uuid"`
+	ClienteID         string  `json:"cliente_id" validate:"required,uuid"`
+
+	// Valores dos campos extras do tipo de formulário, conforme o esquema da versão
+	Dados            *DadosFormulario `json:"dados,omitempty"`
+	DataOcorrencia   time.Time        `json:"data_ocorrencia" validate:"required"`
+	DescricaoDefeito string           `json:"descricao_defeito" validate:"required,min=2,max=500"`
+
+	// Solução aplicada; pode ser informada depois, ao resolver o atendimento
+	DescricaoSolucao *string `json:"descricao_solucao,omitempty" validate:"omitempty,min=2,max=500"`
+
+	// Horas do atendimento a debitar do contrato ativo do cliente
+	HorasConsumidas *float64 `json:"horas_consumidas,omitempty" validate:"omitempty,gte=0"`
+
+	// Nível de dificuldade
+	NivelDificuldade CriarFormularioNivelDificuldade `json:"nivel_dificuldade" validate:"required,oneof=low medium high"`
+	Solicitante      string                          `json:"solicitante" validate:"required,min=2,max=500"`
+
+	// Tags livres (até 20, com até 50 caracteres cada)
+	Tags []string `json:"tags,omitempty" validate:"omitempty,max=20,dive,max=50"`
+
+	// Técnicos do atendimento; obrigatório sem atribuir_automaticamente
+	TecnicosResponsavel []string `json:"tecnicos_responsavel,omitempty" validate:"omitempty,dive,uuid"`
+
+	// Tipo de formulário; os dados são validados contra a versão atual do esquema
+	TipoFormularioID *string `json:"tipo_formulario_id,omitempty" validate:"omitempty,uuid"`
 }
 
-// PostCreateUserJSON500Response is a constructor method for a PostCreateUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostCreateUserJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// CriarLocalEstoque defines model for CriarLocalEstoque.
+type CriarLocalEstoque struct {
+	Nome string `json:"nome" validate:"required,max=100"`
+
+	// Técnico dono do veículo; obrigatório para veículos e proibido para almoxarifados
+	TecnicoID *string `json:"tecnico_id,omitempty" validate:"omitempty,uuid"`
+
+	// Tipo do local de estoque
+	Tipo TipoLocalEstoque `json:"tipo"`
 }
 
-// DeleteUserAccountJSON204Response is a constructor method for a DeleteUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteUserAccountJSON204Response(body Resp204) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// CriarModeloChecklist defines model for CriarModeloChecklist.
+type CriarModeloChecklist struct {
+	Descricao *string               `json:"descricao,omitempty" validate:"omitempty,max=500"`
+	Itens     []ItemModeloChecklist `json:"itens" validate:"required,min=1,max=50,dive"`
+
+	// Nome do modelo, normalmente o tipo de serviço
+	Nome string `json:"nome" validate:"required,max=100"`
 }
 
-// DeleteUserAccountJSON400Response is a constructor method for a DeleteUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteUserAccountJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CriarPeca defines model for CriarPeca.
+type CriarPeca struct {
+	// Custo unitário, com até 2 casas decimais
+	Custo float64 `json:"custo" validate:"gte=0"`
+
+	// Estoque mínimo somando todos os locais; zero ou ausente desliga o alerta
+	EstoqueMinimo *float64 `json:"estoque_minimo,omitempty" validate:"omitempty,gte=0"`
+	Nome          string   `json:"nome" validate:"required,max=150"`
+
+	// Preço unitário de venda, com até 2 casas decimais
+	Preco float64 `json:"preco" validate:"gte=0"`
+
+	// Código da peça; gravado em maiúsculas e sem espaços
+	Sku string `json:"sku" validate:"required,max=50"`
+
+	// Unidade de medida da peça
+	Unidade UnidadePeca `json:"unidade"`
 }
 
-// DeleteUserAccountJSON401Response is a constructor method for a DeleteUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteUserAccountJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// CriarPlanoManutencao defines model for CriarPlanoManutencao.
+type CriarPlanoManutencao struct {
+	// Itens da lista de verificação padrão
+	Checklist []string `json:"checklist,omitempty" validate:"omitempty,max=50,dive,min=1,max=200"`
+	ClienteID string   `json:"cliente_id" validate:"required,uuid"`
+
+	// Descrição do serviço preventivo
+	Descricao *string `json:"descricao,omitempty" validate:"omitempty,max=120"`
+
+	// Primeira visita da série; define o horário das visitas
+	Inicio time.Time `json:"inicio"`
+
+	// Local do cliente atendido (vazio para o cliente inteiro)
+	Local            *string                              `json:"local,omitempty" validate:"omitempty,max=100"`
+	NivelDificuldade CriarPlanoManutencaoNivelDificuldade `json:"nivel_dificuldade"`
+
+	// Nome do plano, usado como solicitante dos atendimentos
+	Nome string `json:"nome" validate:"required,min=1,max=50"`
+
+	// Regra de recorrência RRULE com FREQ (DAILY, WEEKLY, MONTHLY ou YEARLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT e UNTIL, como FREQ=MONTHLY;INTERVAL=3
+	Recorrencia string `json:"recorrencia" validate:"required"`
+
+	// Técnicos (membros) atribuídos às visitas
+	Tecnicos []string `json:"tecnicos" validate:"required,min=1,dive,uuid"`
 }
 
-// DeleteUserAccountJSON404Response is a constructor method for a DeleteUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteUserAccountJSON404Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        404,
-		contentType: "application/json",
-	}
+// CriarSolicitacaoPortal defines model for CriarSolicitacaoPortal.
+type CriarSolicitacaoPortal struct {
+	// Descrição do problema
+	Descricao string `json:"descricao" validate:"required,max=5000"`
 }
 
-// DeleteUserAccountJSON500Response is a constructor method for a DeleteUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func DeleteUserAccountJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// CriarTipoFormulario defines model for CriarTipoFormulario.
+type CriarTipoFormulario struct {
+	Descricao *string `json:"descricao,omitempty" validate:"omitempty,max=500"`
+
+	// JSON Schema (subconjunto do draft 2020-12) dos campos extras: type, properties, required, enum, const, minLength, maxLength, pattern, format (date, date-time, email), minimum, maximum, items, minItems, maxItems, if/then/else e allOf
+	Esquema json.RawMessage `json:"esquema"`
+	Nome    string          `json:"nome" validate:"required,max=100"`
 }
 
-// GetUserAccountJSON200Response is a constructor method for a GetUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetUserAccountJSON200Response(body BuscaUsuario) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// CriarUsuario defines model for CriarUsuario.
+type CriarUsuario struct {
+	Cargo CriarUsuarioCargo `json:"cargo" validate:"required,oneof=tecnico_interno tecnico_externo administrador"`
+
+	// Email de contato
+	Email openapi_types.Email `json:"email" validate:"omitempty,email"`
+	Nome  string              `json:"nome" validate:"required,min=2,max=500"`
+
+	// Senha do usuário
+	Password string `json:"password" validate:"required,min=8,max=64"`
 }
 
-// GetUserAccountJSON400Response is a constructor method for a GetUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetUserAccountJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CriarUsuarioPortal defines model for CriarUsuarioPortal.
+type CriarUsuarioPortal struct {
+	ClienteID string              `json:"cliente_id" validate:"required,uuid"`
+	Email     openapi_types.Email `json:"email" validate:"required,email,max=100"`
+	Nome      string              `json:"nome" validate:"required,min=2,max=100"`
+	Password  string              `json:"password" validate:"required,min=8,max=64"`
 }
 
-// GetUserAccountJSON401Response is a constructor method for a GetUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetUserAccountJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// Valores dos campos extras do tipo de formulário, conforme o esquema da versão
+type DadosFormulario struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// GetUserAccountJSON404Response is a constructor method for a GetUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetUserAccountJSON404Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        404,
-		contentType: "application/json",
-	}
+// EdicaoComentario defines model for EdicaoComentario.
+type EdicaoComentario struct {
+	CorpoAnterior string    `json:"corpo_anterior"`
+	EditadoEm     time.Time `json:"editado_em"`
+
+	// Usuário que fez a edição (ausente se o usuário foi removido)
+	EditadoPor      *string `json:"editado_por,omitempty"`
+	ID              string  `json:"id"`
+	InternoAnterior bool    `json:"interno_anterior"`
+	NomeEditadoPor  string  `json:"nome_editado_por"`
 }
 
-// GetUserAccountJSON500Response is a constructor method for a GetUserAccount response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetUserAccountJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// EditarComentario defines model for EditarComentario.
+type EditarComentario struct {
+	// Novo texto do comentário em Markdown
+	Corpo string `json:"corpo" validate:"required,max=10000"`
+
+	// Nova visibilidade; se ausente, mantém a atual
+	Interno *bool `json:"interno,omitempty"`
 }
 
-// PostLoginUserJSON200Response is a constructor method for a PostLoginUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostLoginUserJSON200Response(body LoginRes) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// EncerrarApontamento defines model for EncerrarApontamento.
+type EncerrarApontamento struct {
+	// Fim do período; se ausente, o cronômetro é encerrado agora
+	Fim *time.Time `json:"fim,omitempty"`
+
+	// Substitui as observações do apontamento
+	Observacao *string `json:"observacao,omitempty" validate:"omitempty,max=2000"`
 }
 
-// PostLoginUserJSON400Response is a constructor method for a PostLoginUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostLoginUserJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// Endereco defines model for Endereco.
+type Endereco struct {
+	// Bairro da residência
+	Bairro string `json:"bairro" validate:"required,min=2,max=500"`
+
+	// CEP brasileiro
+	Cep string `json:"cep" validate:"required,min=8,max=9"`
+
+	// Cidade da residencia
+	Cidade string `json:"cidade" validate:"required,min=2,max=500"`
+
+	// Complemento do endereço (opcional)
+	Complement string `json:"complement" validate:"omitempty,max=100"`
+
+	// UF do estado da residencia
+	Estado string `json:"estado" validate:"required,min=2,max=2"`
+
+	// Latitude geográfica (opcional)
+	Latitude float64 `json:"latitude" validate:"omitempty,gte=-90,lte=90"`
+
+	// Longitude geográfica (opcional)
+	Longitude float64 `json:"longitude" validate:"omitempty,gte=-180,lte=180"`
+
+	// Número da residência
+	Numero string `json:"numero" validate:"required,min=1,max=10"`
+
+	// UF do pais da residencia
+	Pais string `json:"pais" validate:"required,min=2,max=2"`
+
+	// Rua da residência
+	Rua string `json:"rua" validate:"required,min=2,max=500"`
 }
 
-// PostLoginUserJSON500Response is a constructor method for a PostLoginUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostLoginUserJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Message string `json:"message"`
 }
 
-// PutUpdateUserJSON204Response is a constructor method for a PutUpdateUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutUpdateUserJSON204Response(body Resp204) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// Evento da linha do tempo; apenas o campo correspondente ao tipo vem preenchido
+type EventoLinhaDoTempo struct {
+	Anexo      *AnexoLinhaDoTempo   `json:"anexo,omitempty"`
+	Atribuicao *AlteracaoAtribuicao `json:"atribuicao,omitempty"`
+
+	// Usuário responsável pelo evento (ausente se desconhecido)
+	AutorID    *string                    `json:"autor_id,omitempty"`
+	Comentario *Comentario                `json:"comentario,omitempty"`
+	NomeAutor  string                     `json:"nome_autor"`
+	OcorridoEm time.Time                  `json:"ocorrido_em"`
+	Situacao   *AlteracaoStatusFormulario `json:"situacao,omitempty"`
+
+	// Tipo do evento da linha do tempo
+	Tipo TipoEventoLinhaDoTempo `json:"tipo"`
 }
 
-// PutUpdateUserJSON400Response is a constructor method for a PutUpdateUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutUpdateUserJSON400Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// Fatura defines model for Fatura.
+type Fatura struct {
+	AliquotaImpostos float64 `json:"aliquota_impostos"`
+
+	// Preenchido apenas na consulta de uma fatura
+	AtendimentoIds []string   `json:"atendimento_ids,omitempty"`
+	CanceladaEm    *time.Time `json:"cancelada_em,omitempty"`
+	ClienteID      string     `json:"cliente_id"`
+	CreatedAt      time.Time  `json:"created_at"`
+	CreatedBy      *string    `json:"created_by,omitempty"`
+	Desconto       float64    `json:"desconto"`
+	EmitidaEm      *time.Time `json:"emitida_em,omitempty"`
+	ID             string     `json:"id"`
+
+	// Preenchido apenas na consulta de uma fatura
+	Itens              []ItemFatura `json:"itens,omitempty"`
+	MotivoCancelamento *string      `json:"motivo_cancelamento,omitempty"`
+	NomeCliente        string       `json:"nome_cliente"`
+
+	// Número sequencial atribuído na emissão; ausente nos rascunhos
+	Numero      *int64     `json:"numero,omitempty"`
+	Observacoes *string    `json:"observacoes,omitempty"`
+	PagaEm      *time.Time `json:"paga_em,omitempty"`
+
+	// Situação da fatura
+	Situacao      SituacaoFatura      `json:"situacao"`
+	Subtotal      float64             `json:"subtotal"`
+	Total         float64             `json:"total"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+	ValorImpostos float64             `json:"valor_impostos"`
+	Vencimento    *openapi_types.Date `json:"vencimento,omitempty"`
 }
 
-// PutUpdateUserJSON401Response is a constructor method for a PutUpdateUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutUpdateUserJSON401Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        401,
-		contentType: "application/json",
-	}
+// Feriado defines model for Feriado.
+type Feriado struct {
+	Data openapi_types.Date `json:"data"`
+	Nome string             `json:"nome"`
 }
 
-// PutUpdateUserJSON404Response is a constructor method for a PutUpdateUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutUpdateUserJSON404Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        404,
-		contentType: "application/json",
-	}
+// Formulario defines model for Formulario.
+type Formulario struct {
+	// Valores dos campos personalizados, indexados pela chave (texto, número, data AAAA-MM-DD, opção ou booleano)
+	CamposPersonalizados CamposPersonalizados `json:"campos_personalizados"`
+
+	// Contrato ao qual o atendimento foi vinculado (se houver)
+	ContratoID *string   `json:"contrato_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at" validate:"required"`
+
+	// Valores dos campos extras do tipo de formulário, conforme o esquema da versão
+	Dados            DadosFormulario `json:"dados"`
+	DataOcorrencia   time.Time       `json:"data_ocorrencia" validate:"required"`
+	DescricaoDefeito string          `json:"descricao_defeito" validate:"required,min=2,max=500"`
+
+	// Solução aplicada (vazia até o atendimento ser resolvido)
+	DescricaoSolucao string `json:"descricao_solucao"`
+
+	// Horas debitadas do contrato
+	HorasConsumidas  float64                    `json:"horas_consumidas"`
+	ID               string                     `json:"id" validate:"required,uuid"`
+	NivelDificuldade FormularioNivelDificuldade `json:"nivel_dificuldade" validate:"required,oneof=low medium high"`
+
+	// Protocolo sequencial do atendimento no ano (OS-AAAA-NNNNNN)
+	Protocolo string `json:"protocolo"`
+
+	// Prazos de SLA do atendimento, em horário útil
+	SLA         *SLAAtendimento `json:"sla,omitempty"`
+	Solicitante string          `json:"solicitante" validate:"required,min=2,max=500"`
+
+	// Situação do atendimento
+	Status StatusAtendimento `json:"status"`
+
+	// Tags livres do registro
+	Tags                []string  `json:"tags"`
+	TecnicosResponsavel []Tecnico `json:"tecnicos_responsavel" validate:"required,min=1,dive,uuid"`
+
+	// Tipo de formulário do atendimento (se houver)
+	TipoFormularioID *string   `json:"tipo_formulario_id,omitempty"`
+	UpdatedAt        time.Time `json:"updated_at" validate:"required"`
+
+	// Versão do esquema com que o atendimento foi aberto e deve ser exibido
+	VersaoTipoFormulario *int `json:"versao_tipo_formulario,omitempty"`
 }
 
-// PutUpdateUserJSON500Response is a constructor method for a PutUpdateUser response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutUpdateUserJSON500Response(body ErrorResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
-	}
+// FormularioLixeira defines model for FormularioLixeira.
+type FormularioLixeira struct {
+	ClienteID      string    `json:"cliente_id"`
+	DataOcorrencia time.Time `json:"data_ocorrencia"`
+
+	// Data e hora em que o atendimento foi enviado para a lixeira
+	DeletedAt   time.Time `json:"deleted_at"`
+	ID          string    `json:"id"`
+	NomeCliente string    `json:"nome_cliente"`
+
+	// Protocolo sequencial do atendimento no ano (OS-AAAA-NNNNNN)
+	Protocolo   string `json:"protocolo"`
+	Solicitante string `json:"solicitante"`
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create client
-	// (POST /v1/clients/create)
-	PostCreateClient(w http.ResponseWriter, r *http.Request) *Response
-	// Delete client
-	// (DELETE /v1/clients/delete/{clientID})
-	DeleteClient(w http.ResponseWriter, r *http.Request, clientID string) *Response
-	// Get all clients
-	// (GET /v1/clients/list)
-	GetV1clientsList(w http.ResponseWriter, r *http.Request) *Response
-	// Update client
-	// (PUT /v1/clients/update/{clientID})
+// Trajeto do roteiro em GeoJSON, com coordenadas [longitude, latitude]
+type GeoJSONLineString struct {
+	Coordinates [][]float64           `json:"coordinates"`
+	Type        GeoJSONLineStringType `json:"type"`
+}
+
+// HistoricoStatusFormulario defines model for HistoricoStatusFormulario.
+type HistoricoStatusFormulario struct {
+	Alteracoes []AlteracaoStatusFormulario `json:"alteracoes"`
+}
+
+// HorarioLivre defines model for HorarioLivre.
+type HorarioLivre struct {
+	Fim    time.Time `json:"fim"`
+	Inicio time.Time `json:"inicio"`
+}
+
+// IniciarApontamento defines model for IniciarApontamento.
+type IniciarApontamento struct {
+	Observacao *string `json:"observacao,omitempty" validate:"omitempty,max=2000"`
+	TecnicoID  string  `json:"tecnico_id" validate:"required,uuid"`
+
+	// Tipo do apontamento de horas
+	Tipo TipoApontamento `json:"tipo"`
+}
+
+// ItemAgenda defines model for ItemAgenda.
+type ItemAgenda struct {
+	AtendimentoID string `json:"atendimento_id"`
+	ClienteNome   string `json:"cliente_nome"`
+
+	// Descrição do defeito
+	Descricao string    `json:"descricao"`
+	Fim       time.Time `json:"fim"`
+	Inicio    time.Time `json:"inicio"`
+
+	// Protocolo do atendimento (OS-AAAA-NNNNNN)
+	Protocolo string `json:"protocolo"`
+
+	// Situação do atendimento
+	Situacao    string `json:"situacao"`
+	TecnicoID   string `json:"tecnico_id"`
+	TecnicoNome string `json:"tecnico_nome"`
+}
+
+// ItemChecklist defines model for ItemChecklist.
+type ItemChecklist struct {
+	// Foto anexada ao atendimento como resposta do item
+	AnexoID *string `json:"anexo_id,omitempty"`
+
+	// Resposta dos itens de aprovação
+	Aprovado  *bool  `json:"aprovado,omitempty"`
+	Descricao string `json:"descricao"`
+
+	// Indica se o item foi respondido
+	Feito   bool       `json:"feito"`
+	FeitoEm *time.Time `json:"feito_em,omitempty"`
+
+	// Usuário que verificou o item
+	FeitoPor *string `json:"feito_por,omitempty"`
+
+	// Leitura fora da faixa aceita pelo item
+	ForaDaFaixa bool     `json:"fora_da_faixa"`
+	ID          string   `json:"id"`
+	Maximo      *float64 `json:"maximo,omitempty"`
+	Minimo      *float64 `json:"minimo,omitempty"`
+	Obrigatorio bool     `json:"obrigatorio"`
+	Posicao     int      `json:"posicao"`
+
+	// Resposta dos itens de texto
+	Texto string `json:"texto"`
+
+	// Tipo de resposta (marcacao, aprovacao, numero, texto ou foto)
+	Tipo    string `json:"tipo"`
+	Unidade string `json:"unidade"`
+
+	// Leitura dos itens numéricos
+	Valor *float64 `json:"valor,omitempty"`
+}
+
+// ItemEstoqueBaixo defines model for ItemEstoqueBaixo.
+type ItemEstoqueBaixo struct {
+	EstoqueMinimo float64 `json:"estoque_minimo"`
+
+	// Quantidade que falta para voltar ao estoque mínimo
+	Falta  float64 `json:"falta"`
+	Nome   string  `json:"nome"`
+	PecaID string  `json:"peca_id"`
+
+	// Saldo somado em todos os locais
+	Quantidade float64 `json:"quantidade"`
+	Sku        string  `json:"sku"`
+	Unidade    string  `json:"unidade"`
+}
+
+// ItemFatura defines model for ItemFatura.
+type ItemFatura struct {
+	// Atendimento que originou o item; ausente se o atendimento foi excluído
+	AtendimentoID *string `json:"atendimento_id,omitempty"`
+	Descricao     string  `json:"descricao"`
+	ID            string  `json:"id"`
+	Quantidade    float64 `json:"quantidade"`
+
+	// Tipo do item da fatura
+	Tipo          TipoItemFatura `json:"tipo"`
+	Total         float64        `json:"total"`
+	ValorUnitario float64        `json:"valor_unitario"`
+}
+
+// ItemModeloChecklist defines model for ItemModeloChecklist.
+type ItemModeloChecklist struct {
+	Descricao string `json:"descricao" validate:"required,max=200"`
+
+	// Maior leitura aceita (somente itens numéricos); leituras acima ficam sinalizadas
+	Maximo *float64 `json:"maximo,omitempty"`
+
+	// Menor leitura aceita (somente itens numéricos); leituras abaixo ficam sinalizadas
+	Minimo *float64 `json:"minimo,omitempty"`
+
+	// Item precisa de resposta para resolver o atendimento
+	Obrigatorio *bool `json:"obrigatorio,omitempty"`
+
+	// Tipo de resposta do item
+	Tipo TipoItemModeloChecklist `json:"tipo"`
+
+	// Unidade da leitura (somente itens numéricos)
+	Unidade *string `json:"unidade,omitempty" validate:"omitempty,max=20"`
+}
+
+// LancarMovimentacaoEstoque defines model for LancarMovimentacaoEstoque.
+type LancarMovimentacaoEstoque struct {
+	// Local de destino; obrigatório somente nas transferências
+	LocalDestinoID *string `json:"local_destino_id,omitempty" validate:"omitempty,uuid"`
+
+	// Local movimentado; nas transferências, o local de origem
+	LocalID    string  `json:"local_id" validate:"required,uuid"`
+	Observacao *string `json:"observacao,omitempty" validate:"omitempty,max=200"`
+	PecaID     string  `json:"peca_id" validate:"required,uuid"`
+
+	// Quantidade com até 3 casas decimais; positiva, exceto nos ajustes, em que o sinal indica o sentido
+	Quantidade float64 `json:"quantidade"`
+
+	// Tipo do lançamento manual de estoque
+	Tipo TipoLancamentoEstoque `json:"tipo"`
+}
+
+// LancarPecaAtendimento defines model for LancarPecaAtendimento.
+type LancarPecaAtendimento struct {
+	// Local de estoque de onde a peça saiu (almoxarifado ou veículo do técnico)
+	LocalID string `json:"local_id" validate:"required,uuid"`
+	PecaID  string `json:"peca_id" validate:"required,uuid"`
+
+	// Preço unitário cobrado; se ausente, usa o preço de venda do catálogo
+	PrecoUnitario *float64 `json:"preco_unitario,omitempty" validate:"omitempty,gte=0"`
+
+	// Quantidade usada, com até 3 casas decimais
+	Quantidade float64 `json:"quantidade" validate:"gt=0"`
+}
+
+// LinhaDoTempo defines model for LinhaDoTempo.
+type LinhaDoTempo struct {
+	Eventos []EventoLinhaDoTempo `json:"eventos"`
+}
+
+// ListaAnexos defines model for ListaAnexos.
+type ListaAnexos struct {
+	Anexos []AnexoAtendimento `json:"anexos"`
+}
+
+// ListaApontamentos defines model for ListaApontamentos.
+type ListaApontamentos struct {
+	Apontamentos []Apontamento     `json:"apontamentos"`
+	Total        TotalApontamentos `json:"total"`
+}
+
+// ListaAtendimentosPortal defines model for ListaAtendimentosPortal.
+type ListaAtendimentosPortal struct {
+	Atendimentos []AtendimentoPortal `json:"atendimentos"`
+}
+
+// ListaAtendimentosSemelhantes defines model for ListaAtendimentosSemelhantes.
+type ListaAtendimentosSemelhantes struct {
+	Atendimentos []AtendimentoSemelhante `json:"atendimentos"`
+}
+
+// ListaCamposPersonalizados defines model for ListaCamposPersonalizados.
+type ListaCamposPersonalizados struct {
+	Campos []CampoPersonalizado `json:"campos"`
+}
+
+// ListaClientes defines model for ListaClientes.
+type ListaClientes struct {
+	Clientes []Cliente `json:"clientes"`
+}
+
+// ListaClientesLixeira defines model for ListaClientesLixeira.
+type ListaClientesLixeira struct {
+	Clientes []ClienteLixeira `json:"clientes"`
+}
+
+// ListaComentarios defines model for ListaComentarios.
+type ListaComentarios struct {
+	Comentarios []Comentario `json:"comentarios"`
+}
+
+// ListaContratos defines model for ListaContratos.
+type ListaContratos struct {
+	Contratos []Contrato `json:"contratos"`
+}
+
+// ListaDuplicidades defines model for ListaDuplicidades.
+type ListaDuplicidades struct {
+	Pares []ParDuplicado `json:"pares"`
+}
+
+// ListaEdicoesComentario defines model for ListaEdicoesComentario.
+type ListaEdicoesComentario struct {
+	Edicoes []EdicaoComentario `json:"edicoes"`
+}
+
+// ListaFaturas defines model for ListaFaturas.
+type ListaFaturas struct {
+	Faturas []Fatura `json:"faturas"`
+}
+
+// ListaFeriados defines model for ListaFeriados.
+type ListaFeriados struct {
+	Feriados []Feriado `json:"feriados"`
+}
+
+// ListaFormulario defines model for ListaFormulario.
+type ListaFormulario struct {
+	Formularios []Formulario `json:"formularios"`
+
+	// Cursor da próxima página (ausente na última página)
+	ProximoCursor *string `json:"proximo_cursor,omitempty"`
+}
+
+// ListaFormulariosLixeira defines model for ListaFormulariosLixeira.
+type ListaFormulariosLixeira struct {
+	Formularios []FormularioLixeira `json:"formularios"`
+}
+
+// ListaItensChecklist defines model for ListaItensChecklist.
+type ListaItensChecklist struct {
+	// Leituras fora da faixa aceita
+	ForaDaFaixa int             `json:"fora_da_faixa"`
+	Itens       []ItemChecklist `json:"itens"`
+
+	// Itens obrigatórios sem resposta, que impedem resolver o atendimento
+	PendentesObrigatorios int `json:"pendentes_obrigatorios"`
+}
+
+// ListaLocaisEstoque defines model for ListaLocaisEstoque.
+type ListaLocaisEstoque struct {
+	Locais []LocalEstoque `json:"locais"`
+}
+
+// ListaModelosChecklist defines model for ListaModelosChecklist.
+type ListaModelosChecklist struct {
+	Modelos []ModeloChecklist `json:"modelos"`
+}
+
+// ListaMovimentacoesEstoque defines model for ListaMovimentacoesEstoque.
+type ListaMovimentacoesEstoque struct {
+	Movimentacoes []MovimentacaoEstoque `json:"movimentacoes"`
+}
+
+// ListaNotificacoes defines model for ListaNotificacoes.
+type ListaNotificacoes struct {
+	Notificacoes []Notificacao `json:"notificacoes"`
+}
+
+// ListaPecas defines model for ListaPecas.
+type ListaPecas struct {
+	Pecas []Peca `json:"pecas"`
+}
+
+// ListaPecasAtendimento defines model for ListaPecasAtendimento.
+type ListaPecasAtendimento struct {
+	// Custo das peças no lançamento
+	CustoTotal float64           `json:"custo_total"`
+	Pecas      []PecaAtendimento `json:"pecas"`
+
+	// Valor cobrado pelas peças
+	Total float64 `json:"total"`
+}
+
+// ListaPlanosManutencao defines model for ListaPlanosManutencao.
+type ListaPlanosManutencao struct {
+	Planos []PlanoManutencao `json:"planos"`
+}
+
+// ListaPoliticasSLA defines model for ListaPoliticasSLA.
+type ListaPoliticasSLA struct {
+	Politicas []PoliticaSLA `json:"politicas"`
+}
+
+// ListaSaldosEstoque defines model for ListaSaldosEstoque.
+type ListaSaldosEstoque struct {
+	Saldos []SaldoEstoque `json:"saldos"`
+}
+
+// ListaSolicitacoesPortal defines model for ListaSolicitacoesPortal.
+type ListaSolicitacoesPortal struct {
+	Solicitacoes []SolicitacaoPortal `json:"solicitacoes"`
+}
+
+// ListaTiposFormulario defines model for ListaTiposFormulario.
+type ListaTiposFormulario struct {
+	Tipos []TipoFormulario `json:"tipos"`
+}
+
+// ListaUnificacoes defines model for ListaUnificacoes.
+type ListaUnificacoes struct {
+	Unificacoes []Unificacao `json:"unificacoes"`
+}
+
+// ListaUsuarios defines model for ListaUsuarios.
+type ListaUsuarios struct {
+	Usuarios []Usuario `json:"usuarios"`
+}
+
+// ListaUsuariosPortal defines model for ListaUsuariosPortal.
+type ListaUsuariosPortal struct {
+	Usuarios []UsuarioPortal `json:"usuarios"`
+}
+
+// ListaVersoesTipoFormulario defines model for ListaVersoesTipoFormulario.
+type ListaVersoesTipoFormulario struct {
+	Versoes []VersaoTipoFormulario `json:"versoes"`
+}
+
+// LocalEstoque defines model for LocalEstoque.
+type LocalEstoque struct {
+	CreatedAt   time.Time `json:"created_at"`
+	ID          string    `json:"id"`
+	Nome        string    `json:"nome"`
+	NomeTecnico *string   `json:"nome_tecnico,omitempty"`
+
+	// Técnico dono do veículo (somente veículos)
+	TecnicoID *string `json:"tecnico_id,omitempty"`
+
+	// Tipo do local de estoque
+	Tipo TipoLocalEstoque `json:"tipo"`
+}
+
+// LocalizacaoTecnico defines model for LocalizacaoTecnico.
+type LocalizacaoTecnico struct {
+	Latitude  float64 `json:"latitude" validate:"gte=-90,lte=90"`
+	Longitude float64 `json:"longitude" validate:"gte=-180,lte=180"`
+
+	// Localização do técnico: atual (informada em campo) ou base (casa ou escritório)
+	Tipo TipoLocalizacaoTecnico `json:"tipo"`
+}
+
+// LoginReq defines model for LoginReq.
+type LoginReq struct {
+	// Email de contato
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+
+	// Senha do usuário
+	Password string `json:"password" validate:"required,min=8,max=64"`
+}
+
+// LoginRes defines model for LoginRes.
+type LoginRes struct {
+	// JWT de acesso
+	AccessToken string `json:"access_token"`
+
+	// Tempo de expiração em segundos
+	ExpiresIn *int   `json:"expires_in,omitempty"`
+	TokenType string `json:"token_type"`
+}
+
+// Informe a resposta no campo do tipo do item (feito, aprovado, valor, texto ou anexo_id); feito falso sem outra resposta limpa o item
+type MarcarItemChecklist struct {
+	// Foto já anexada ao atendimento
+	AnexoID  *string  `json:"anexo_id,omitempty" validate:"omitempty,uuid"`
+	Aprovado *bool    `json:"aprovado,omitempty"`
+	Feito    *bool    `json:"feito,omitempty"`
+	Texto    *string  `json:"texto,omitempty" validate:"omitempty,max=2000"`
+	Valor    *float64 `json:"valor,omitempty"`
+}
+
+// ModeloChecklist defines model for ModeloChecklist.
+type ModeloChecklist struct {
+	CreatedAt time.Time             `json:"created_at"`
+	Descricao string                `json:"descricao"`
+	ID        string                `json:"id"`
+	Itens     []ItemModeloChecklist `json:"itens"`
+	Nome      string                `json:"nome"`
+	UpdatedAt time.Time             `json:"updated_at"`
+}
+
+// ModeloOrdemServico defines model for ModeloOrdemServico.
+type ModeloOrdemServico struct {
+	AtualizadoPor *string `json:"atualizado_por,omitempty"`
+
+	// Cor dos títulos em hexadecimal (#RRGGBB); vazio usa a cor padrão
+	CorPrimaria string `json:"cor_primaria"`
+
+	// CNPJ ou CPF da empresa
+	DocumentoEmpresa string `json:"documento_empresa" validate:"max=30"`
+	Email            string `json:"email" validate:"omitempty,email,max=200"`
+	Endereco         string `json:"endereco" validate:"max=300"`
+
+	// Fuso das datas impressas (IANA); vazio usa America/Sao_Paulo
+	FusoHorario string `json:"fuso_horario"`
+	NomeEmpresa string `json:"nome_empresa" validate:"max=200"`
+	Site        string `json:"site" validate:"max=200"`
+	Telefone    string `json:"telefone" validate:"max=30"`
+
+	// Texto impresso no rodapé de todas as páginas
+	TextoRodape string     `json:"texto_rodape"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// URL assinada do logotipo; ausente quando não há logotipo
+	URLLogotipo         *string    `json:"url_logotipo,omitempty"`
+	URLLogotipoExpiraEm *time.Time `json:"url_logotipo_expira_em,omitempty"`
+}
+
+// MovimentacaoEstoque defines model for MovimentacaoEstoque.
+type MovimentacaoEstoque struct {
+	// Atendimento do consumo ou do estorno
+	AtendimentoID *string   `json:"atendimento_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	ID            string    `json:"id"`
+	LocalID       string    `json:"local_id"`
+	NomeLocal     string    `json:"nome_local"`
+	NomePeca      string    `json:"nome_peca"`
+	Observacao    string    `json:"observacao"`
+	PecaID        string    `json:"peca_id"`
+
+	// Positiva para entradas no local, negativa para saídas
+	Quantidade float64 `json:"quantidade"`
+	Sku        string  `json:"sku"`
+
+	// Tipo da movimentação de estoque; consumo e estorno vêm das peças lançadas e removidas dos atendimentos
+	Tipo TipoMovimentacaoEstoque `json:"tipo"`
+
+	// Comum às duas pernas de uma transferência
+	TransferenciaID *string `json:"transferencia_id,omitempty"`
+}
+
+// MovimentacaoLancada defines model for MovimentacaoLancada.
+type MovimentacaoLancada struct {
+	// Movimentações gravadas; duas nas transferências (saída e entrada)
+	Ids     []string `json:"ids"`
+	Message string   `json:"message"`
+}
+
+// MudarSituacaoFatura defines model for MudarSituacaoFatura.
+type MudarSituacaoFatura struct {
+	// Obrigatório ao cancelar uma fatura emitida
+	Motivo *string `json:"motivo,omitempty" validate:"omitempty,max=500"`
+
+	// Situação da fatura
+	Situacao SituacaoFatura `json:"situacao"`
+}
+
+// Notificacao defines model for Notificacao.
+type Notificacao struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Atendimento relacionado
+	FormularioID *string `json:"formulario_id,omitempty"`
+	ID           string  `json:"id"`
+
+	// Ausente enquanto a notificação não foi lida
+	LidaEm   *time.Time `json:"lida_em,omitempty"`
+	Mensagem string     `json:"mensagem"`
+
+	// Protocolo do atendimento relacionado (OS-AAAA-NNNNNN)
+	Protocolo *string `json:"protocolo,omitempty"`
+
+	// Tipo da notificação
+	Tipo NotificacaoTipo `json:"tipo"`
+}
+
+// ParDuplicado defines model for ParDuplicado.
+type ParDuplicado struct {
+	ClienteA ClienteResumo       `json:"cliente_a"`
+	ClienteB ClienteResumo       `json:"cliente_b"`
+	Motivos  []MotivoDuplicidade `json:"motivos"`
+
+	// Probabilidade de duplicidade, de 0 a 1
+	Pontuacao float64 `json:"pontuacao"`
+}
+
+// ParadaRota defines model for ParadaRota.
+type ParadaRota struct {
+	AtendimentoID string `json:"atendimento_id"`
+
+	// Chegada estimada
+	Chegada              time.Time `json:"chegada"`
+	ClienteID            string    `json:"cliente_id"`
+	ClienteNome          string    `json:"cliente_nome"`
+	Descricao            string    `json:"descricao"`
+	DistanciaAcumuladaKm float64   `json:"distancia_acumulada_km"`
+
+	// Distância em linha reta desde o ponto anterior
+	DistanciaKm float64 `json:"distancia_km"`
+	Endereco    string  `json:"endereco"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+
+	// Posição da parada no roteiro, a partir de 1
+	Ordem int `json:"ordem"`
+
+	// Protocolo do atendimento (OS-AAAA-NNNNNN)
+	Protocolo string `json:"protocolo"`
+
+	// Saída estimada, após o tempo da visita
+	Saida    time.Time `json:"saida"`
+	Situacao string    `json:"situacao"`
+}
+
+// Peca defines model for Peca.
+type Peca struct {
+	Ativa         bool      `json:"ativa"`
+	CreatedAt     time.Time `json:"created_at"`
+	Custo         float64   `json:"custo"`
+	EstoqueMinimo float64   `json:"estoque_minimo"`
+	ID            string    `json:"id"`
+	Nome          string    `json:"nome"`
+	Preco         float64   `json:"preco"`
+	Sku           string    `json:"sku"`
+
+	// Unidade de medida da peça
+	Unidade   UnidadePeca `json:"unidade"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// PecaAtendimento defines model for PecaAtendimento.
+type PecaAtendimento struct {
+	CreatedAt     time.Time `json:"created_at"`
+	ID            string    `json:"id"`
+	LocalID       string    `json:"local_id"`
+	NomeLocal     string    `json:"nome_local"`
+	NomePeca      string    `json:"nome_peca"`
+	PecaID        string    `json:"peca_id"`
+	PrecoUnitario float64   `json:"preco_unitario"`
+	Quantidade    float64   `json:"quantidade"`
+	Sku           string    `json:"sku"`
+
+	// Quantidade vezes o preço unitário, arredondado em centavos
+	Total   float64 `json:"total"`
+	Unidade string  `json:"unidade"`
+}
+
+// PeriodoContrato defines model for PeriodoContrato.
+type PeriodoContrato struct {
+	// Último dia do período
+	Fim              openapi_types.Date `json:"fim"`
+	HorasContratadas float64            `json:"horas_contratadas"`
+	HorasRestantes   float64            `json:"horas_restantes"`
+	HorasUtilizadas  float64            `json:"horas_utilizadas"`
+
+	// Primeiro dia do período
+	Inicio openapi_types.Date `json:"inicio"`
+}
+
+// PesquisaPublica defines model for PesquisaPublica.
+type PesquisaPublica struct {
+	Cliente     string    `json:"cliente"`
+	ExpiraEm    time.Time `json:"expira_em"`
+	NotaCsatMax int       `json:"nota_csat_max"`
+	NotaCsatMin int       `json:"nota_csat_min"`
+	NotaNpsMax  int       `json:"nota_nps_max"`
+	NotaNpsMin  int       `json:"nota_nps_min"`
+	Protocolo   string    `json:"protocolo"`
+	ResolvidoEm time.Time `json:"resolvido_em"`
+	Respondida  bool      `json:"respondida"`
+	Tecnicos    []string  `json:"tecnicos"`
+}
+
+// PesquisaSatisfacao defines model for PesquisaSatisfacao.
+type PesquisaSatisfacao struct {
+	Comentario *string `json:"comentario,omitempty"`
+
+	// Quando o atendimento foi resolvido
+	CriadaEm  time.Time  `json:"criada_em"`
+	EnviadaEm *time.Time `json:"enviada_em,omitempty"`
+
+	// E-mail para o qual a pesquisa foi enviada
+	EnviadaPara  *string    `json:"enviada_para,omitempty"`
+	ExpiraEm     *time.Time `json:"expira_em,omitempty"`
+	FormularioID string     `json:"formulario_id"`
+	ID           string     `json:"id"`
+	NotaCsat     *int       `json:"nota_csat,omitempty"`
+	NotaNps      *int       `json:"nota_nps,omitempty"`
+	RespondidaEm *time.Time `json:"respondida_em,omitempty"`
+
+	// Situação da pesquisa de satisfação
+	Situacao SituacaoPesquisa `json:"situacao"`
+
+	// Tentativas de envio que falharam
+	TentativasEnvio int `json:"tentativas_envio"`
+}
+
+// PlanoManutencao defines model for PlanoManutencao.
+type PlanoManutencao struct {
+	Checklist   []string  `json:"checklist"`
+	ClienteID   string    `json:"cliente_id"`
+	ClienteNome string    `json:"cliente_nome"`
+	CreatedAt   time.Time `json:"created_at"`
+	Descricao   string    `json:"descricao"`
+
+	// Fim da última janela de visitas já gerada
+	GeradoAte        *time.Time `json:"gerado_ate,omitempty"`
+	ID               string     `json:"id"`
+	Inicio           time.Time  `json:"inicio"`
+	Local            string     `json:"local"`
+	NivelDificuldade string     `json:"nivel_dificuldade"`
+	Nome             string     `json:"nome"`
+
+	// Próximas visitas do plano (somente na busca de um plano ativo)
+	ProximasVisitas []time.Time `json:"proximas_visitas"`
+	Recorrencia     string      `json:"recorrencia"`
+
+	// Situação do plano de manutenção
+	Situacao  SituacaoPlanoManutencao `json:"situacao"`
+	Tecnicos  []string                `json:"tecnicos"`
+	UpdatedAt time.Time               `json:"updated_at"`
+}
+
+// PoliticaSLA defines model for PoliticaSLA.
+type PoliticaSLA struct {
+	// Prazo de resolução em minutos úteis (não pode ser menor que o de resposta)
+	MinutosResolucao int `json:"minutos_resolucao" validate:"required,min=1"`
+
+	// Prazo da primeira resposta em minutos úteis
+	MinutosResposta  int                         `json:"minutos_resposta" validate:"required,min=1"`
+	NivelDificuldade PoliticaSLANivelDificuldade `json:"nivel_dificuldade"`
+	TipoCliente      PoliticaSLATipoCliente      `json:"tipo_cliente"`
+	UpdatedAt        time.Time                   `json:"updated_at"`
+}
+
+// PontoAssinatura defines model for PontoAssinatura.
+type PontoAssinatura struct {
+	// Milissegundos desde o início do traço
+	T *int64  `json:"t,omitempty"`
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Pontos de cada critério da sugestão
+type PontosDesignacao struct {
+	// De 0 a 30; zero a partir de 10 atendimentos em aberto
+	Carga float64 `json:"carga"`
+
+	// De 0 a 40; zero a partir de 100 km ou sem localização
+	Distancia float64 `json:"distancia"`
+
+	// De 0 a 20; máximo a partir de 3 atendimentos do cliente nos últimos 90 dias
+	Historico float64 `json:"historico"`
+
+	// 10 para técnicos internos, 0 para externos
+	Papel float64 `json:"papel"`
+}
+
+// PublicarVersaoTipoFormulario defines model for PublicarVersaoTipoFormulario.
+type PublicarVersaoTipoFormulario struct {
+	// JSON Schema (subconjunto do draft 2020-12) dos campos extras: type, properties, required, enum, const, minLength, maxLength, pattern, format (date, date-time, email), minimum, maximum, items, minItems, maxItems, if/then/else e allOf
+	Esquema json.RawMessage `json:"esquema"`
+}
+
+// Regras de validação; tamanho e padrão valem para texto, mínimo e máximo para número
+type RegrasCampoPersonalizado struct {
+	Maximo *float64 `json:"maximo,omitempty"`
+	Minimo *float64 `json:"minimo,omitempty"`
+
+	// Expressão regular que o valor deve satisfazer
+	Padrao        *string `json:"padrao,omitempty"`
+	TamanhoMaximo *int    `json:"tamanho_maximo,omitempty"`
+	TamanhoMinimo *int    `json:"tamanho_minimo,omitempty"`
+}
+
+// RelatorioEstoqueBaixo defines model for RelatorioEstoqueBaixo.
+type RelatorioEstoqueBaixo struct {
+	Itens []ItemEstoqueBaixo `json:"itens"`
+}
+
+// Resp200 defines model for Resp200.
+type Resp200 struct {
+	ID      string `json:"id" validate:"required,uuid"`
+	Message string `json:"message" validate:"required"`
+}
+
+// Resp204 defines model for Resp204.
+type Resp204 struct {
+	Message string `json:"message" validate:"required"`
+}
+
+// ResponderPesquisa defines model for ResponderPesquisa.
+type ResponderPesquisa struct {
+	Comentario *string `json:"comentario,omitempty" validate:"omitempty,max=1000"`
+
+	// Satisfação com o atendimento, de 1 a 5
+	NotaCsat int `json:"nota_csat" validate:"min=1,max=5"`
+
+	// Probabilidade de recomendar a empresa, de 0 a 10
+	NotaNps int `json:"nota_nps" validate:"min=0,max=10"`
+}
+
+// ResultadoBuscaAtendimento defines model for ResultadoBuscaAtendimento.
+type ResultadoBuscaAtendimento struct {
+	Formulario Formulario `json:"formulario"`
+
+	// Relevância do atendimento para a busca (maior é mais relevante)
+	Relevancia float64 `json:"relevancia"`
+
+	// Trecho do defeito em HTML, com o texto escapado e os termos encontrados entre <mark> e </mark>
+	TrechoDefeito string `json:"trecho_defeito"`
+
+	// Trecho da solução em HTML, com o texto escapado e os termos encontrados entre <mark> e </mark>
+	TrechoSolucao string `json:"trecho_solucao"`
+}
+
+// ResumoPesquisas defines model for ResumoPesquisas.
+type ResumoPesquisas struct {
+	// Agrupamento do resumo das pesquisas
+	AgrupadoPor AgrupamentoPesquisas `json:"agrupado_por"`
+
+	// Tamanho dos períodos do resumo, no fuso do expediente
+	Granularidade *GranularidadePesquisas `json:"granularidade,omitempty"`
+	Grupos        []TotalPesquisas        `json:"grupos"`
+}
+
+// RevisarSolicitacaoPortal defines model for RevisarSolicitacaoPortal.
+type RevisarSolicitacaoPortal struct {
+	// Atendimento criado para a solicitação (obrigatório ao converter)
+	FormularioID *string `json:"formulario_id,omitempty" validate:"omitempty,uuid"`
+
+	// Observação exibida ao cliente (obrigatória ao recusar)
+	Observacao *string                        `json:"observacao,omitempty" validate:"max=1000"`
+	Status     RevisarSolicitacaoPortalStatus `json:"status"`
+}
+
+// RotaTecnico defines model for RotaTecnico.
+type RotaTecnico struct {
+	Data             openapi_types.Date `json:"data"`
+	DistanciaTotalKm float64            `json:"distancia_total_km"`
+	Paradas          []ParadaRota       `json:"paradas"`
+
+	// Horário de saída usado nas estimativas
+	Saida time.Time `json:"saida"`
+
+	// Atendimentos de clientes sem coordenadas, fora do roteiro
+	SemLocalizacao []AtendimentoSemLocalizacao `json:"sem_localizacao"`
+
+	// Trajeto do roteiro em GeoJSON, com coordenadas [longitude, latitude]
+	Trajeto GeoJSONLineString `json:"trajeto"`
+}
+
+// Prazos de SLA do atendimento, em horário útil
+type SLAAtendimento struct {
+	// Prazo de resolução
+	PrazoResolucao time.Time `json:"prazo_resolucao"`
+
+	// Prazo da primeira resposta
+	PrazoResposta time.Time `json:"prazo_resposta"`
+
+	// Resolução; ausente enquanto pendente
+	ResolvidoEm *time.Time `json:"resolvido_em,omitempty"`
+
+	// Saída da situação aberto; ausente enquanto pendente
+	RespondidoEm *time.Time `json:"respondido_em,omitempty"`
+
+	// Situação do SLA do atendimento
+	Situacao SituacaoSLA `json:"situacao"`
+}
+
+// SaldoEstoque defines model for SaldoEstoque.
+type SaldoEstoque struct {
+	LocalID    string    `json:"local_id"`
+	NomeLocal  string    `json:"nome_local"`
+	NomePeca   string    `json:"nome_peca"`
+	PecaID     string    `json:"peca_id"`
+	Quantidade float64   `json:"quantidade"`
+	Sku        string    `json:"sku"`
+	Unidade    string    `json:"unidade"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// SolicitacaoPortal defines model for SolicitacaoPortal.
+type SolicitacaoPortal struct {
+	ClienteID string    `json:"cliente_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Descricao string    `json:"descricao"`
+
+	// Atendimento gerado a partir da solicitação
+	FormularioID *string `json:"formulario_id,omitempty"`
+	ID           string  `json:"id"`
+	NomeCliente  string  `json:"nome_cliente"`
+
+	// Observação da equipe ao converter ou recusar
+	Observacao  *string `json:"observacao,omitempty"`
+	Solicitante string  `json:"solicitante"`
+
+	// Situação da solicitação aberta pelo portal
+	Status    StatusSolicitacaoPortal `json:"status"`
+	UpdatedAt time.Time               `json:"updated_at"`
+}
+
+// SugestaoDesignacao defines model for SugestaoDesignacao.
+type SugestaoDesignacao struct {
+	AtendimentosAbertos int `json:"atendimentos_abertos"`
+
+	// Atendimentos do cliente nos últimos 90 dias
+	AtendimentosRecentesCliente int `json:"atendimentos_recentes_cliente"`
+
+	// Distância em linha reta até o cliente (ausente sem localização do técnico ou do cliente)
+	DistanciaKm *float64 `json:"distancia_km,omitempty"`
+
+	// Explicação de cada critério
+	Motivos []string `json:"motivos"`
+	Nome    string   `json:"nome"`
+
+	// Localização do técnico: atual (informada em campo) ou base (casa ou escritório)
+	OrigemLocalizacao *TipoLocalizacaoTecnico `json:"origem_localizacao,omitempty"`
+
+	// tecnico_interno ou tecnico_externo
+	Papel string `json:"papel"`
+
+	// Pontos de cada critério da sugestão
+	Pontos PontosDesignacao `json:"pontos"`
+
+	// Soma dos pontos, de 0 a 100
+	Pontuacao float64 `json:"pontuacao"`
+	TecnicoID string  `json:"tecnico_id"`
+
+	// Abertura do último atendimento do técnico para o cliente
+	UltimoAtendimentoCliente *time.Time `json:"ultimo_atendimento_cliente,omitempty"`
+}
+
+// SugestoesDesignacao defines model for SugestoesDesignacao.
+type SugestoesDesignacao struct {
+	ClienteID string `json:"cliente_id"`
+
+	// Falso quando o cliente não tem coordenadas; a distância não entra no ranking
+	ClienteLocalizado bool                 `json:"cliente_localizado"`
+	Sugestoes         []SugestaoDesignacao `json:"sugestoes"`
+}
+
+// Tecnico defines model for Tecnico.
+type Tecnico struct {
+	ID   string `json:"id" validate:"required,uuid"`
+	Nome string `json:"nome" validate:"required,min=2,max=500"`
+}
+
+// TipoFormulario defines model for TipoFormulario.
+type TipoFormulario struct {
+	Ativo     bool      `json:"ativo"`
+	CreatedAt time.Time `json:"created_at"`
+	Descricao string    `json:"descricao"`
+	ID        string    `json:"id"`
+	Nome      string    `json:"nome"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Versão do esquema usada nos novos atendimentos
+	VersaoAtual int `json:"versao_atual"`
+}
+
+// TipoFormularioDetalhe defines model for TipoFormularioDetalhe.
+type TipoFormularioDetalhe struct {
+	// JSON Schema (subconjunto do draft 2020-12) dos campos extras: type, properties, required, enum, const, minLength, maxLength, pattern, format (date, date-time, email), minimum, maximum, items, minItems, maxItems, if/then/else e allOf
+	Esquema json.RawMessage `json:"esquema"`
+	Tipo    TipoFormulario  `json:"tipo"`
+}
+
+// TotaisApontamentos defines model for TotaisApontamentos.
+type TotaisApontamentos struct {
+	// Agrupamento dos totais de horas apontadas
+	AgrupadoPor AgrupamentoApontamentos `json:"agrupado_por"`
+	Totais      []TotalApontamentos     `json:"totais"`
+}
+
+// TotalApontamentos defines model for TotalApontamentos.
+type TotalApontamentos struct {
+	DeslocamentoSegundos int64 `json:"deslocamento_segundos"`
+
+	// Atendimento, técnico ou cliente do total (ausente para técnicos removidos)
+	ID              *string `json:"id,omitempty"`
+	NoLocalSegundos int64   `json:"no_local_segundos"`
+
+	// Nome do técnico ou do cliente; para atendimentos, o nome do cliente
+	Nome string `json:"nome"`
+
+	// Total em horas, com duas casas decimais
+	TotalHoras    float64 `json:"total_horas"`
+	TotalSegundos int64   `json:"total_segundos"`
+}
+
+// TotalPesquisas defines model for TotalPesquisas.
+type TotalPesquisas struct {
+	// Nota média de satisfação, de 1 a 5
+	CsatMedio float64 `json:"csat_medio"`
+
+	// Percentual de respostas com nota 4 ou 5
+	CsatPercentual float64 `json:"csat_percentual"`
+	Detratores     int     `json:"detratores"`
+	Enviadas       int     `json:"enviadas"`
+
+	// Técnico ou cliente do grupo (ausente no agrupamento por período)
+	ID *string `json:"id,omitempty"`
+
+	// Início do período, no agrupamento por período
+	InicioPeriodo *time.Time `json:"inicio_periodo,omitempty"`
+	Neutros       int        `json:"neutros"`
+
+	// Nome do técnico ou do cliente
+	Nome string `json:"nome"`
+
+	// Percentual de promotores (9 e 10) menos o de detratores (0 a 6), de -100 a 100
+	Nps        float64 `json:"nps"`
+	Promotores int     `json:"promotores"`
+	Respostas  int     `json:"respostas"`
+
+	// Fração das pesquisas enviadas que foram respondidas, de 0 a 1
+	TaxaResposta float64 `json:"taxa_resposta"`
+}
+
+// Unificacao defines model for Unificacao.
+type Unificacao struct {
+	AtendimentosTransferidos int64         `json:"atendimentos_transferidos"`
+	ClienteDestinoID         string        `json:"cliente_destino_id"`
+	ClienteOrigem            ClienteResumo `json:"cliente_origem"`
+	ContratosTransferidos    int64         `json:"contratos_transferidos"`
+	ID                       string        `json:"id"`
+	UnificadoEm              time.Time     `json:"unificado_em"`
+	UnificadoPor             *string       `json:"unificado_por,omitempty"`
+}
+
+// UnificarClientes defines model for UnificarClientes.
+type UnificarClientes struct {
+	// Cliente que permanece e recebe os atendimentos e contratos
+	ClienteDestinoID string `json:"cliente_destino_id" validate:"required,uuid"`
+
+	// Cliente duplicado, que será arquivado
+	ClienteOrigemID string `json:"cliente_origem_id" validate:"required,uuid"`
+}
+
+// UsoContrato defines model for UsoContrato.
+type UsoContrato struct {
+	Contrato Contrato          `json:"contrato"`
+	Periodos []PeriodoContrato `json:"periodos"`
+}
+
+// Usuario defines model for Usuario.
+type Usuario struct {
+	Cargo     string    `json:"cargo" validate:"required"`
+	CreatedAt time.Time `json:"created_at" validate:"required"`
+
+	// Email de contato
+	Email     openapi_types.Email `json:"email" validate:"omitempty,email"`
+	ID        string              `json:"id" validate:"required,uuid"`
+	Nome      string              `json:"nome" validate:"required,min=2,max=500"`
+	UpdatedAt time.Time           `json:"updated_at" validate:"required"`
+}
+
+// UsuarioPortal defines model for UsuarioPortal.
+type UsuarioPortal struct {
+	ClienteID string              `json:"cliente_id"`
+	CreatedAt time.Time           `json:"created_at"`
+	Email     openapi_types.Email `json:"email"`
+	ID        string              `json:"id"`
+	Nome      string              `json:"nome"`
+
+	// Ausente quando o usuário nunca acessou o portal
+	UltimoLogin *time.Time `json:"ultimo_login,omitempty"`
+}
+
+// VersaoTipoFormulario defines model for VersaoTipoFormulario.
+type VersaoTipoFormulario struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Administrador que publicou a versão
+	CriadoPor *string `json:"criado_por,omitempty"`
+
+	// JSON Schema (subconjunto do draft 2020-12) dos campos extras: type, properties, required, enum, const, minLength, maxLength, pattern, format (date, date-time, email), minimum, maximum, items, minItems, maxItems, if/then/else e allOf
+	Esquema          json.RawMessage `json:"esquema"`
+	TipoFormularioID string          `json:"tipo_formulario_id"`
+	Versao           int             `json:"versao"`
+}
+
+// Agrupamento dos totais de horas apontadas
+type AgrupamentoApontamentos struct {
+	value string
+}
+
+func (t *AgrupamentoApontamentos) ToValue() string {
+	return t.value
+}
+func (t AgrupamentoApontamentos) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *AgrupamentoApontamentos) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *AgrupamentoApontamentos) FromValue(value string) error {
+	switch value {
+
+	case AgrupamentoApontamentosAtendimento.value:
+		t.value = value
+		return nil
+
+	case AgrupamentoApontamentosCliente.value:
+		t.value = value
+		return nil
+
+	case AgrupamentoApontamentosTecnico.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Agrupamento do resumo das pesquisas
+type AgrupamentoPesquisas struct {
+	value string
+}
+
+func (t *AgrupamentoPesquisas) ToValue() string {
+	return t.value
+}
+func (t AgrupamentoPesquisas) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *AgrupamentoPesquisas) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *AgrupamentoPesquisas) FromValue(value string) error {
+	switch value {
+
+	case AgrupamentoPesquisasCliente.value:
+		t.value = value
+		return nil
+
+	case AgrupamentoPesquisasPeriodo.value:
+		t.value = value
+		return nil
+
+	case AgrupamentoPesquisasTecnico.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// AlteracaoAtribuicaoAcao defines model for AlteracaoAtribuicao.Acao.
+type AlteracaoAtribuicaoAcao struct {
+	value string
+}
+
+func (t *AlteracaoAtribuicaoAcao) ToValue() string {
+	return t.value
+}
+func (t AlteracaoAtribuicaoAcao) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *AlteracaoAtribuicaoAcao) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *AlteracaoAtribuicaoAcao) FromValue(value string) error {
+	switch value {
+
+	case AlteracaoAtribuicaoAcaoAtribuido.value:
+		t.value = value
+		return nil
+
+	case AlteracaoAtribuicaoAcaoRemovido.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// ApontamentoOrigem defines model for Apontamento.Origem.
+type ApontamentoOrigem struct {
+	value string
+}
+
+func (t *ApontamentoOrigem) ToValue() string {
+	return t.value
+}
+func (t ApontamentoOrigem) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ApontamentoOrigem) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ApontamentoOrigem) FromValue(value string) error {
+	switch value {
+
+	case ApontamentoOrigemCronometro.value:
+		t.value = value
+		return nil
+
+	case ApontamentoOrigemManual.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// AtualizarClienteTipoCliente defines model for AtualizarCliente.TipoCliente.
+type AtualizarClienteTipoCliente struct {
+	value string
+}
+
+func (t *AtualizarClienteTipoCliente) ToValue() string {
+	return t.value
+}
+func (t AtualizarClienteTipoCliente) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *AtualizarClienteTipoCliente) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *AtualizarClienteTipoCliente) FromValue(value string) error {
+	switch value {
+
+	case AtualizarClienteTipoClienteAvulso.value:
+		t.value = value
+		return nil
+
+	case AtualizarClienteTipoClienteContrato.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// AtualizarFormularioNivelDificuldade defines model for AtualizarFormulario.NivelDificuldade.
+type AtualizarFormularioNivelDificuldade struct {
+	value string
+}
+
+func (t *AtualizarFormularioNivelDificuldade) ToValue() string {
+	return t.value
+}
+func (t AtualizarFormularioNivelDificuldade) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *AtualizarFormularioNivelDificuldade) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *AtualizarFormularioNivelDificuldade) FromValue(value string) error {
+	switch value {
+
+	case AtualizarFormularioNivelDificuldadeHigh.value:
+		t.value = value
+		return nil
+
+	case AtualizarFormularioNivelDificuldadeLow.value:
+		t.value = value
+		return nil
+
+	case AtualizarFormularioNivelDificuldadeMedium.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// AtualizarPlanoManutencaoNivelDificuldade defines model for AtualizarPlanoManutencao.NivelDificuldade.
+type AtualizarPlanoManutencaoNivelDificuldade struct {
+	value string
+}
+
+func (t *AtualizarPlanoManutencaoNivelDificuldade) ToValue() string {
+	return t.value
+}
+func (t AtualizarPlanoManutencaoNivelDificuldade) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *AtualizarPlanoManutencaoNivelDificuldade) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *AtualizarPlanoManutencaoNivelDificuldade) FromValue(value string) error {
+	switch value {
+
+	case AtualizarPlanoManutencaoNivelDificuldadeHigh.value:
+		t.value = value
+		return nil
+
+	case AtualizarPlanoManutencaoNivelDificuldadeLow.value:
+		t.value = value
+		return nil
+
+	case AtualizarPlanoManutencaoNivelDificuldadeMedium.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// AtualizarPoliticaSLANivelDificuldade defines model for AtualizarPoliticaSLA.NivelDificuldade.
+type AtualizarPoliticaSLANivelDificuldade struct {
+	value string
+}
+
+func (t *AtualizarPoliticaSLANivelDificuldade) ToValue() string {
+	return t.value
+}
+func (t AtualizarPoliticaSLANivelDificuldade) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *AtualizarPoliticaSLANivelDificuldade) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *AtualizarPoliticaSLANivelDificuldade) FromValue(value string) error {
+	switch value {
+
+	case AtualizarPoliticaSLANivelDificuldadeHigh.value:
+		t.value = value
+		return nil
+
+	case AtualizarPoliticaSLANivelDificuldadeLow.value:
+		t.value = value
+		return nil
+
+	case AtualizarPoliticaSLANivelDificuldadeMedium.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// AtualizarPoliticaSLATipoCliente defines model for AtualizarPoliticaSLA.TipoCliente.
+type AtualizarPoliticaSLATipoCliente struct {
+	value string
+}
+
+func (t *AtualizarPoliticaSLATipoCliente) ToValue() string {
+	return t.value
+}
+func (t AtualizarPoliticaSLATipoCliente) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *AtualizarPoliticaSLATipoCliente) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *AtualizarPoliticaSLATipoCliente) FromValue(value string) error {
+	switch value {
+
+	case AtualizarPoliticaSLATipoClienteAvulso.value:
+		t.value = value
+		return nil
+
+	case AtualizarPoliticaSLATipoClienteContrato.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// ClienteTipoCliente defines model for Cliente.TipoCliente.
+type ClienteTipoCliente struct {
+	value string
+}
+
+func (t *ClienteTipoCliente) ToValue() string {
+	return t.value
+}
+func (t ClienteTipoCliente) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ClienteTipoCliente) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ClienteTipoCliente) FromValue(value string) error {
+	switch value {
+
+	case ClienteTipoClienteAvulso.value:
+		t.value = value
+		return nil
+
+	case ClienteTipoClienteContrato.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// ClienteLixeiraTipoCliente defines model for ClienteLixeira.TipoCliente.
+type ClienteLixeiraTipoCliente struct {
+	value string
+}
+
+func (t *ClienteLixeiraTipoCliente) ToValue() string {
+	return t.value
+}
+func (t ClienteLixeiraTipoCliente) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ClienteLixeiraTipoCliente) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ClienteLixeiraTipoCliente) FromValue(value string) error {
+	switch value {
+
+	case ClienteLixeiraTipoClienteAvulso.value:
+		t.value = value
+		return nil
+
+	case ClienteLixeiraTipoClienteContrato.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// ContratoAlertas defines model for Contrato.Alertas.
+type ContratoAlertas struct {
+	value string
+}
+
+func (t *ContratoAlertas) ToValue() string {
+	return t.value
+}
+func (t ContratoAlertas) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ContratoAlertas) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ContratoAlertas) FromValue(value string) error {
+	switch value {
+
+	case ContratoAlertasContratoProximoVencimento.value:
+		t.value = value
+		return nil
+
+	case ContratoAlertasContratoVencido.value:
+		t.value = value
+		return nil
+
+	case ContratoAlertasHorasEsgotadas.value:
+		t.value = value
+		return nil
+
+	case ContratoAlertasHorasProximasDoLimite.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// CriarClienteTipoCliente defines model for CriarCliente.TipoCliente.
+type CriarClienteTipoCliente struct {
+	value string
+}
+
+func (t *CriarClienteTipoCliente) ToValue() string {
+	return t.value
+}
+func (t CriarClienteTipoCliente) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *CriarClienteTipoCliente) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *CriarClienteTipoCliente) FromValue(value string) error {
+	switch value {
+
+	case CriarClienteTipoClienteAvulso.value:
+		t.value = value
+		return nil
+
+	case CriarClienteTipoClienteContrato.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Nível de dificuldade
+type CriarFormularioNivelDificuldade struct {
+	value string
+}
+
+func (t *CriarFormularioNivelDificuldade) ToValue() string {
+	return t.value
+}
+func (t CriarFormularioNivelDificuldade) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *CriarFormularioNivelDificuldade) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *CriarFormularioNivelDificuldade) FromValue(value string) error {
+	switch value {
+
+	case CriarFormularioNivelDificuldadeHigh.value:
+		t.value = value
+		return nil
+
+	case CriarFormularioNivelDificuldadeLow.value:
+		t.value = value
+		return nil
+
+	case CriarFormularioNivelDificuldadeMedium.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// CriarPlanoManutencaoNivelDificuldade defines model for CriarPlanoManutencao.NivelDificuldade.
+type CriarPlanoManutencaoNivelDificuldade struct {
+	value string
+}
+
+func (t *CriarPlanoManutencaoNivelDificuldade) ToValue() string {
+	return t.value
+}
+func (t CriarPlanoManutencaoNivelDificuldade) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *CriarPlanoManutencaoNivelDificuldade) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *CriarPlanoManutencaoNivelDificuldade) FromValue(value string) error {
+	switch value {
+
+	case CriarPlanoManutencaoNivelDificuldadeHigh.value:
+		t.value = value
+		return nil
+
+	case CriarPlanoManutencaoNivelDificuldadeLow.value:
+		t.value = value
+		return nil
+
+	case CriarPlanoManutencaoNivelDificuldadeMedium.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// CriarUsuarioCargo defines model for CriarUsuario.Cargo.
+type CriarUsuarioCargo struct {
+	value string
+}
+
+func (t *CriarUsuarioCargo) ToValue() string {
+	return t.value
+}
+func (t CriarUsuarioCargo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *CriarUsuarioCargo) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *CriarUsuarioCargo) FromValue(value string) error {
+	switch value {
+
+	case CriarUsuarioCargoAdministrador.value:
+		t.value = value
+		return nil
+
+	case CriarUsuarioCargoTecnicoExterno.value:
+		t.value = value
+		return nil
+
+	case CriarUsuarioCargoTecnicoInterno.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// EntidadeCampoPersonalizado defines model for EntidadeCampoPersonalizado.
+type EntidadeCampoPersonalizado struct {
+	value string
+}
+
+func (t *EntidadeCampoPersonalizado) ToValue() string {
+	return t.value
+}
+func (t EntidadeCampoPersonalizado) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *EntidadeCampoPersonalizado) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *EntidadeCampoPersonalizado) FromValue(value string) error {
+	switch value {
+
+	case EntidadeCampoPersonalizadoAtendimento.value:
+		t.value = value
+		return nil
+
+	case EntidadeCampoPersonalizadoCliente.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Formato da assinatura
+type FormatoAssinatura struct {
+	value string
+}
+
+func (t *FormatoAssinatura) ToValue() string {
+	return t.value
+}
+func (t FormatoAssinatura) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *FormatoAssinatura) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *FormatoAssinatura) FromValue(value string) error {
+	switch value {
+
+	case FormatoAssinaturaPng.value:
+		t.value = value
+		return nil
+
+	case FormatoAssinaturaSvg.value:
+		t.value = value
+		return nil
+
+	case FormatoAssinaturaTracos.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// FormularioNivelDificuldade defines model for Formulario.NivelDificuldade.
+type FormularioNivelDificuldade struct {
+	value string
+}
+
+func (t *FormularioNivelDificuldade) ToValue() string {
+	return t.value
+}
+func (t FormularioNivelDificuldade) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *FormularioNivelDificuldade) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *FormularioNivelDificuldade) FromValue(value string) error {
+	switch value {
+
+	case FormularioNivelDificuldadeHigh.value:
+		t.value = value
+		return nil
+
+	case FormularioNivelDificuldadeLow.value:
+		t.value = value
+		return nil
+
+	case FormularioNivelDificuldadeMedium.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// GeoJSONLineStringType defines model for GeoJSONLineString.Type.
+type GeoJSONLineStringType struct {
+	value string
+}
+
+func (t *GeoJSONLineStringType) ToValue() string {
+	return t.value
+}
+func (t GeoJSONLineStringType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *GeoJSONLineStringType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *GeoJSONLineStringType) FromValue(value string) error {
+	switch value {
+
+	case GeoJSONLineStringTypeLineString.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tamanho dos períodos do resumo, no fuso do expediente
+type GranularidadePesquisas struct {
+	value string
+}
+
+func (t *GranularidadePesquisas) ToValue() string {
+	return t.value
+}
+func (t GranularidadePesquisas) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *GranularidadePesquisas) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *GranularidadePesquisas) FromValue(value string) error {
+	switch value {
+
+	case GranularidadePesquisasDia.value:
+		t.value = value
+		return nil
+
+	case GranularidadePesquisasMes.value:
+		t.value = value
+		return nil
+
+	case GranularidadePesquisasSemana.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Critério que coincidiu entre os clientes
+type MotivoDuplicidade struct {
+	value string
+}
+
+func (t *MotivoDuplicidade) ToValue() string {
+	return t.value
+}
+func (t MotivoDuplicidade) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *MotivoDuplicidade) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *MotivoDuplicidade) FromValue(value string) error {
+	switch value {
+
+	case MotivoDuplicidadeDocumento.value:
+		t.value = value
+		return nil
+
+	case MotivoDuplicidadeEmail.value:
+		t.value = value
+		return nil
+
+	case MotivoDuplicidadeNome.value:
+		t.value = value
+		return nil
+
+	case MotivoDuplicidadeTelefone.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tipo da notificação
+type NotificacaoTipo struct {
+	value string
+}
+
+func (t *NotificacaoTipo) ToValue() string {
+	return t.value
+}
+func (t NotificacaoTipo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *NotificacaoTipo) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *NotificacaoTipo) FromValue(value string) error {
+	switch value {
+
+	case NotificacaoTipoSLAEmRisco.value:
+		t.value = value
+		return nil
+
+	case NotificacaoTipoSLAViolado.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// PoliticaSLANivelDificuldade defines model for PoliticaSLA.NivelDificuldade.
+type PoliticaSLANivelDificuldade struct {
+	value string
+}
+
+func (t *PoliticaSLANivelDificuldade) ToValue() string {
+	return t.value
+}
+func (t PoliticaSLANivelDificuldade) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *PoliticaSLANivelDificuldade) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *PoliticaSLANivelDificuldade) FromValue(value string) error {
+	switch value {
+
+	case PoliticaSLANivelDificuldadeHigh.value:
+		t.value = value
+		return nil
+
+	case PoliticaSLANivelDificuldadeLow.value:
+		t.value = value
+		return nil
+
+	case PoliticaSLANivelDificuldadeMedium.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// PoliticaSLATipoCliente defines model for PoliticaSLA.TipoCliente.
+type PoliticaSLATipoCliente struct {
+	value string
+}
+
+func (t *PoliticaSLATipoCliente) ToValue() string {
+	return t.value
+}
+func (t PoliticaSLATipoCliente) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *PoliticaSLATipoCliente) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *PoliticaSLATipoCliente) FromValue(value string) error {
+	switch value {
+
+	case PoliticaSLATipoClienteAvulso.value:
+		t.value = value
+		return nil
+
+	case PoliticaSLATipoClienteContrato.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// RevisarSolicitacaoPortalStatus defines model for RevisarSolicitacaoPortal.Status.
+type RevisarSolicitacaoPortalStatus struct {
+	value string
+}
+
+func (t *RevisarSolicitacaoPortalStatus) ToValue() string {
+	return t.value
+}
+func (t RevisarSolicitacaoPortalStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *RevisarSolicitacaoPortalStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *RevisarSolicitacaoPortalStatus) FromValue(value string) error {
+	switch value {
+
+	case RevisarSolicitacaoPortalStatusConvertida.value:
+		t.value = value
+		return nil
+
+	case RevisarSolicitacaoPortalStatusRecusada.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Situação da fatura
+type SituacaoFatura struct {
+	value string
+}
+
+func (t *SituacaoFatura) ToValue() string {
+	return t.value
+}
+func (t SituacaoFatura) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *SituacaoFatura) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *SituacaoFatura) FromValue(value string) error {
+	switch value {
+
+	case SituacaoFaturaCancelada.value:
+		t.value = value
+		return nil
+
+	case SituacaoFaturaEmitida.value:
+		t.value = value
+		return nil
+
+	case SituacaoFaturaPaga.value:
+		t.value = value
+		return nil
+
+	case SituacaoFaturaRascunho.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Situação da pesquisa de satisfação
+type SituacaoPesquisa struct {
+	value string
+}
+
+func (t *SituacaoPesquisa) ToValue() string {
+	return t.value
+}
+func (t SituacaoPesquisa) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *SituacaoPesquisa) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *SituacaoPesquisa) FromValue(value string) error {
+	switch value {
+
+	case SituacaoPesquisaEnviada.value:
+		t.value = value
+		return nil
+
+	case SituacaoPesquisaExpirada.value:
+		t.value = value
+		return nil
+
+	case SituacaoPesquisaPendente.value:
+		t.value = value
+		return nil
+
+	case SituacaoPesquisaRespondida.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Situação do plano de manutenção
+type SituacaoPlanoManutencao struct {
+	value string
+}
+
+func (t *SituacaoPlanoManutencao) ToValue() string {
+	return t.value
+}
+func (t SituacaoPlanoManutencao) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *SituacaoPlanoManutencao) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *SituacaoPlanoManutencao) FromValue(value string) error {
+	switch value {
+
+	case SituacaoPlanoManutencaoAtivo.value:
+		t.value = value
+		return nil
+
+	case SituacaoPlanoManutencaoPausado.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Situação do SLA do atendimento
+type SituacaoSLA struct {
+	value string
+}
+
+func (t *SituacaoSLA) ToValue() string {
+	return t.value
+}
+func (t SituacaoSLA) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *SituacaoSLA) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *SituacaoSLA) FromValue(value string) error {
+	switch value {
+
+	case SituacaoSLACumprido.value:
+		t.value = value
+		return nil
+
+	case SituacaoSLAEmRisco.value:
+		t.value = value
+		return nil
+
+	case SituacaoSLANoPrazo.value:
+		t.value = value
+		return nil
+
+	case SituacaoSLAViolado.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Situação do atendimento
+type StatusAtendimento struct {
+	value string
+}
+
+func (t *StatusAtendimento) ToValue() string {
+	return t.value
+}
+func (t StatusAtendimento) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *StatusAtendimento) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *StatusAtendimento) FromValue(value string) error {
+	switch value {
+
+	case StatusAtendimentoAberto.value:
+		t.value = value
+		return nil
+
+	case StatusAtendimentoAgendado.value:
+		t.value = value
+		return nil
+
+	case StatusAtendimentoAguardandoCliente.value:
+		t.value = value
+		return nil
+
+	case StatusAtendimentoCancelado.value:
+		t.value = value
+		return nil
+
+	case StatusAtendimentoEmAndamento.value:
+		t.value = value
+		return nil
+
+	case StatusAtendimentoFechado.value:
+		t.value = value
+		return nil
+
+	case StatusAtendimentoResolvido.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Situação da solicitação aberta pelo portal
+type StatusSolicitacaoPortal struct {
+	value string
+}
+
+func (t *StatusSolicitacaoPortal) ToValue() string {
+	return t.value
+}
+func (t StatusSolicitacaoPortal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *StatusSolicitacaoPortal) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *StatusSolicitacaoPortal) FromValue(value string) error {
+	switch value {
+
+	case StatusSolicitacaoPortalAberta.value:
+		t.value = value
+		return nil
+
+	case StatusSolicitacaoPortalConvertida.value:
+		t.value = value
+		return nil
+
+	case StatusSolicitacaoPortalRecusada.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tipo do apontamento de horas
+type TipoApontamento struct {
+	value string
+}
+
+func (t *TipoApontamento) ToValue() string {
+	return t.value
+}
+func (t TipoApontamento) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TipoApontamento) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TipoApontamento) FromValue(value string) error {
+	switch value {
+
+	case TipoApontamentoDeslocamento.value:
+		t.value = value
+		return nil
+
+	case TipoApontamentoNoLocal.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// TipoCampoPersonalizado defines model for TipoCampoPersonalizado.
+type TipoCampoPersonalizado struct {
+	value string
+}
+
+func (t *TipoCampoPersonalizado) ToValue() string {
+	return t.value
+}
+func (t TipoCampoPersonalizado) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TipoCampoPersonalizado) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TipoCampoPersonalizado) FromValue(value string) error {
+	switch value {
+
+	case TipoCampoPersonalizadoBooleano.value:
+		t.value = value
+		return nil
+
+	case TipoCampoPersonalizadoData.value:
+		t.value = value
+		return nil
+
+	case TipoCampoPersonalizadoNumero.value:
+		t.value = value
+		return nil
+
+	case TipoCampoPersonalizadoSelecao.value:
+		t.value = value
+		return nil
+
+	case TipoCampoPersonalizadoTexto.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tipo do evento da linha do tempo
+type TipoEventoLinhaDoTempo struct {
+	value string
+}
+
+func (t *TipoEventoLinhaDoTempo) ToValue() string {
+	return t.value
+}
+func (t TipoEventoLinhaDoTempo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TipoEventoLinhaDoTempo) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TipoEventoLinhaDoTempo) FromValue(value string) error {
+	switch value {
+
+	case TipoEventoLinhaDoTempoAnexo.value:
+		t.value = value
+		return nil
+
+	case TipoEventoLinhaDoTempoAtribuicao.value:
+		t.value = value
+		return nil
+
+	case TipoEventoLinhaDoTempoComentario.value:
+		t.value = value
+		return nil
+
+	case TipoEventoLinhaDoTempoSituacao.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tipo do item da fatura
+type TipoItemFatura struct {
+	value string
+}
+
+func (t *TipoItemFatura) ToValue() string {
+	return t.value
+}
+func (t TipoItemFatura) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TipoItemFatura) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TipoItemFatura) FromValue(value string) error {
+	switch value {
+
+	case TipoItemFaturaDeslocamento.value:
+		t.value = value
+		return nil
+
+	case TipoItemFaturaMaoDeObra.value:
+		t.value = value
+		return nil
+
+	case TipoItemFaturaPeca.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tipo de resposta do item
+type TipoItemModeloChecklist struct {
+	value string
+}
+
+func (t *TipoItemModeloChecklist) ToValue() string {
+	return t.value
+}
+func (t TipoItemModeloChecklist) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TipoItemModeloChecklist) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TipoItemModeloChecklist) FromValue(value string) error {
+	switch value {
+
+	case TipoItemModeloChecklistAprovacao.value:
+		t.value = value
+		return nil
+
+	case TipoItemModeloChecklistFoto.value:
+		t.value = value
+		return nil
+
+	case TipoItemModeloChecklistNumero.value:
+		t.value = value
+		return nil
+
+	case TipoItemModeloChecklistTexto.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tipo do lançamento manual de estoque
+type TipoLancamentoEstoque struct {
+	value string
+}
+
+func (t *TipoLancamentoEstoque) ToValue() string {
+	return t.value
+}
+func (t TipoLancamentoEstoque) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TipoLancamentoEstoque) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TipoLancamentoEstoque) FromValue(value string) error {
+	switch value {
+
+	case TipoLancamentoEstoqueAjuste.value:
+		t.value = value
+		return nil
+
+	case TipoLancamentoEstoqueEntrada.value:
+		t.value = value
+		return nil
+
+	case TipoLancamentoEstoqueSaida.value:
+		t.value = value
+		return nil
+
+	case TipoLancamentoEstoqueTransferencia.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tipo do local de estoque
+type TipoLocalEstoque struct {
+	value string
+}
+
+func (t *TipoLocalEstoque) ToValue() string {
+	return t.value
+}
+func (t TipoLocalEstoque) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TipoLocalEstoque) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TipoLocalEstoque) FromValue(value string) error {
+	switch value {
+
+	case TipoLocalEstoqueAlmoxarifado.value:
+		t.value = value
+		return nil
+
+	case TipoLocalEstoqueVeiculo.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Localização do técnico: atual (informada em campo) ou base (casa ou escritório)
+type TipoLocalizacaoTecnico struct {
+	value string
+}
+
+func (t *TipoLocalizacaoTecnico) ToValue() string {
+	return t.value
+}
+func (t TipoLocalizacaoTecnico) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TipoLocalizacaoTecnico) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TipoLocalizacaoTecnico) FromValue(value string) error {
+	switch value {
+
+	case TipoLocalizacaoTecnicoAtual.value:
+		t.value = value
+		return nil
+
+	case TipoLocalizacaoTecnicoBase.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tipo da movimentação de estoque; consumo e estorno vêm das peças lançadas e removidas dos atendimentos
+type TipoMovimentacaoEstoque struct {
+	value string
+}
+
+func (t *TipoMovimentacaoEstoque) ToValue() string {
+	return t.value
+}
+func (t TipoMovimentacaoEstoque) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TipoMovimentacaoEstoque) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TipoMovimentacaoEstoque) FromValue(value string) error {
+	switch value {
+
+	case TipoMovimentacaoEstoqueAjuste.value:
+		t.value = value
+		return nil
+
+	case TipoMovimentacaoEstoqueConsumo.value:
+		t.value = value
+		return nil
+
+	case TipoMovimentacaoEstoqueEntrada.value:
+		t.value = value
+		return nil
+
+	case TipoMovimentacaoEstoqueEstorno.value:
+		t.value = value
+		return nil
+
+	case TipoMovimentacaoEstoqueSaida.value:
+		t.value = value
+		return nil
+
+	case TipoMovimentacaoEstoqueTransferencia.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Unidade de medida da peça
+type UnidadePeca struct {
+	value string
+}
+
+func (t *UnidadePeca) ToValue() string {
+	return t.value
+}
+func (t UnidadePeca) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *UnidadePeca) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *UnidadePeca) FromValue(value string) error {
+	switch value {
+
+	case UnidadePecaCx.value:
+		t.value = value
+		return nil
+
+	case UnidadePecaKg.value:
+		t.value = value
+		return nil
+
+	case UnidadePecaKit.value:
+		t.value = value
+		return nil
+
+	case UnidadePecaL.value:
+		t.value = value
+		return nil
+
+	case UnidadePecaM.value:
+		t.value = value
+		return nil
+
+	case UnidadePecaPar.value:
+		t.value = value
+		return nil
+
+	case UnidadePecaRolo.value:
+		t.value = value
+		return nil
+
+	case UnidadePecaUn.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// PostCreateChecklistTemplateJSONBody defines parameters for PostCreateChecklistTemplate.
+type PostCreateChecklistTemplateJSONBody CriarModeloChecklist
+
+// PutChecklistTemplateJSONBody defines parameters for PutChecklistTemplate.
+type PutChecklistTemplateJSONBody CriarModeloChecklist
+
+// PostCreateClientJSONBody defines parameters for PostCreateClient.
+type PostCreateClientJSONBody CriarCliente
+
+// GetV1clientsListParams defines parameters for GetV1clientsList.
+type GetV1clientsListParams struct {
+	// Filtro por campo personalizado no formato chave:valor (pode se repetir)
+	Campo []string `json:"campo,omitempty"`
+
+	// Tag que o registro deve ter (pode se repetir; todas são exigidas)
+	Tag []string `json:"tag,omitempty"`
+}
+
+// MergeClientsJSONBody defines parameters for MergeClients.
+type MergeClientsJSONBody UnificarClientes
+
+// PutClientJSONBody defines parameters for PutClient.
+type PutClientJSONBody AtualizarCliente
+
+// PostCreateContractJSONBody defines parameters for PostCreateContract.
+type PostCreateContractJSONBody CriarContrato
+
+// ListContractsParams defines parameters for ListContracts.
+type ListContractsParams struct {
+	// Client ID
+	ClienteID *string `json:"cliente_id,omitempty"`
+}
+
+// PutContractJSONBody defines parameters for PutContract.
+type PutContractJSONBody AtualizarContrato
+
+// PostCreateCustomFieldJSONBody defines parameters for PostCreateCustomField.
+type PostCreateCustomFieldJSONBody CriarCampoPersonalizado
+
+// ListCustomFieldsParams defines parameters for ListCustomFields.
+type ListCustomFieldsParams struct {
+	// Entity (cliente or atendimento)
+	Entidade *EntidadeCampoPersonalizado `json:"entidade,omitempty"`
+}
+
+// PutCustomFieldJSONBody defines parameters for PutCustomField.
+type PutCustomFieldJSONBody AtualizarCampoPersonalizado
+
+// GetDispatchSuggestionsParams defines parameters for GetDispatchSuggestions.
+type GetDispatchSuggestionsParams struct {
+	// Cliente do novo atendimento
+	ClienteID string `json:"cliente_id"`
+
+	// Número de sugestões (padrão 5, máximo 50)
+	Limite *int `json:"limite,omitempty"`
+}
+
+// PostCreateFormTypeJSONBody defines parameters for PostCreateFormType.
+type PostCreateFormTypeJSONBody CriarTipoFormulario
+
+// PutFormTypeJSONBody defines parameters for PutFormType.
+type PutFormTypeJSONBody AtualizarTipoFormulario
+
+// PostFormTypeVersionJSONBody defines parameters for PostFormTypeVersion.
+type PostFormTypeVersionJSONBody PublicarVersaoTipoFormulario
+
+// PostCreateFormJSONBody defines parameters for PostCreateForm.
+type PostCreateFormJSONBody CriarFormulario
+
+// ListFormsParams defines parameters for ListForms.
+type ListFormsParams struct {
+	// Filtra por cliente
+	ClienteID *string `json:"cliente_id,omitempty"`
+
+	// Filtra pelos atendimentos em que o técnico (membro) é responsável
+	TecnicoID *string `json:"tecnico_id,omitempty"`
+
+	// Filtra pelo nível de dificuldade
+	NivelDificuldade *ListFormsParamsNivelDificuldade `json:"nivel_dificuldade,omitempty"`
+
+	// Filtra pela situação do atendimento
+	Status *StatusAtendimento `json:"status,omitempty"`
+
+	// Início do período de ocorrência (inclusive)
+	OcorridoDe *time.Time `json:"ocorrido_de,omitempty"`
+
+	// Fim do período de ocorrência (inclusive)
+	OcorridoAte *time.Time `json:"ocorrido_ate,omitempty"`
+
+	// Protocolo completo ou trecho dele (por exemplo 2026-0001)
+	Protocolo *string `json:"protocolo,omitempty"`
+
+	// Valor de proximo_cursor retornado pela página anterior
+	Cursor *string `json:"cursor,omitempty"`
+
+	// Quantidade de atendimentos por página (padrão 50, máximo 200)
+	Limite *int `json:"limite,omitempty"`
+
+	// Filtro por campo personalizado no formato chave:valor (pode se repetir)
+	Campo []string `json:"campo,omitempty"`
+
+	// Tag que o registro deve ter (pode se repetir; todas são exigidas)
+	Tag []string `json:"tag,omitempty"`
+}
+
+// ListFormsParamsNivelDificuldade defines parameters for ListForms.
+type ListFormsParamsNivelDificuldade string
+
+// SearchFormsParams defines parameters for SearchForms.
+type SearchFormsParams struct {
+	// Termos buscados no defeito, na solução, no solicitante e no nome do cliente, sem diferenciar acentos. Aceita "frase exata", -termo para excluir e OR
+	Q string `json:"q"`
+
+	// Filtra por cliente
+	ClienteID *string `json:"cliente_id,omitempty"`
+
+	// Filtra pelos atendimentos em que o técnico (membro) é responsável
+	TecnicoID *string `json:"tecnico_id,omitempty"`
+
+	// Filtra pelo nível de dificuldade
+	NivelDificuldade *SearchFormsParamsNivelDificuldade `json:"nivel_dificuldade,omitempty"`
+
+	// Filtra pela situação do atendimento
+	Status *StatusAtendimento `json:"status,omitempty"`
+
+	// Início do período de ocorrência (inclusive)
+	OcorridoDe *time.Time `json:"ocorrido_de,omitempty"`
+
+	// Fim do período de ocorrência (inclusive)
+	OcorridoAte *time.Time `json:"ocorrido_ate,omitempty"`
+
+	// Protocolo completo ou trecho dele (por exemplo 2026-0001)
+	Protocolo *string `json:"protocolo,omitempty"`
+
+	// Página dos resultados, a partir de 1
+	Pagina *int `json:"pagina,omitempty"`
+
+	// Quantidade de resultados por página (padrão 20, máximo 100)
+	Limite *int `json:"limite,omitempty"`
+
+	// Filtro por campo personalizado no formato chave:valor (pode se repetir)
+	Campo []string `json:"campo,omitempty"`
+
+	// Tag que o registro deve ter (pode se repetir; todas são exigidas)
+	Tag []string `json:"tag,omitempty"`
+}
+
+// SearchFormsParamsNivelDificuldade defines parameters for SearchForms.
+type SearchFormsParamsNivelDificuldade string
+
+// ListSimilarFormsByDescriptionParams defines parameters for ListSimilarFormsByDescription.
+type ListSimilarFormsByDescriptionParams struct {
+	// Descrição do defeito a comparar
+	Descricao string `json:"descricao"`
+
+	// Cliente do atendimento, que favorece os atendimentos dele
+	ClienteID *string `json:"cliente_id,omitempty"`
+
+	// Quantidade de sugestões (padrão 5, máximo 20)
+	Limite *int `json:"limite,omitempty"`
+}
+
+// PostFormStatusJSONBody defines parameters for PostFormStatus.
+type PostFormStatusJSONBody AlterarStatusFormulario
+
+// PutFormJSONBody defines parameters for PutForm.
+type PutFormJSONBody AtualizarFormulario
+
+// PutFormChecklistItemJSONBody defines parameters for PutFormChecklistItem.
+type PutFormChecklistItemJSONBody MarcarItemChecklist
+
+// PostFormCommentJSONBody defines parameters for PostFormComment.
+type PostFormCommentJSONBody CriarComentario
+
+// PutFormCommentJSONBody defines parameters for PutFormComment.
+type PutFormCommentJSONBody EditarComentario
+
+// PostFormPartJSONBody defines parameters for PostFormPart.
+type PostFormPartJSONBody LancarPecaAtendimento
+
+// PutFormScheduleJSONBody defines parameters for PutFormSchedule.
+type PutFormScheduleJSONBody AgendarAtendimento
+
+// PostFormSignatureJSONBody defines parameters for PostFormSignature.
+type PostFormSignatureJSONBody AssinarAtendimento
+
+// ListSimilarFormsParams defines parameters for ListSimilarForms.
+type ListSimilarFormsParams struct {
+	// Quantidade de sugestões (padrão 5, máximo 20)
+	Limite *int `json:"limite,omitempty"`
+}
+
+// PostFormWorkLogJSONBody defines parameters for PostFormWorkLog.
+type PostFormWorkLogJSONBody CriarApontamento
+
+// PostStartFormWorkLogJSONBody defines parameters for PostStartFormWorkLog.
+type PostStartFormWorkLogJSONBody IniciarApontamento
+
+// PostStopFormWorkLogJSONBody defines parameters for PostStopFormWorkLog.
+type PostStopFormWorkLogJSONBody EncerrarApontamento
+
+// PostCreateInvoiceJSONBody defines parameters for PostCreateInvoice.
+type PostCreateInvoiceJSONBody CriarFatura
+
+// ListInvoicesParams defines parameters for ListInvoices.
+type ListInvoicesParams struct {
+	// Filtra pela situação
+	Situacao *ListInvoicesParamsSituacao `json:"situacao,omitempty"`
+
+	// Filtra pelo cliente
+	ClienteID *string `json:"cliente_id,omitempty"`
+}
+
+// ListInvoicesParamsSituacao defines parameters for ListInvoices.
+type ListInvoicesParamsSituacao string
+
+// PutUpdateInvoiceJSONBody defines parameters for PutUpdateInvoice.
+type PutUpdateInvoiceJSONBody AtualizarFatura
+
+// PutInvoiceStatusJSONBody defines parameters for PutInvoiceStatus.
+type PutInvoiceStatusJSONBody MudarSituacaoFatura
+
+// PostCreateMaintenancePlanJSONBody defines parameters for PostCreateMaintenancePlan.
+type PostCreateMaintenancePlanJSONBody CriarPlanoManutencao
+
+// ListMaintenancePlansParams defines parameters for ListMaintenancePlans.
+type ListMaintenancePlansParams struct {
+	// Filtra por cliente
+	ClienteID *string `json:"cliente_id,omitempty"`
+
+	// Filtra pela situação (ativo ou pausado)
+	Situacao *string `json:"situacao,omitempty"`
+}
+
+// PutMaintenancePlanJSONBody defines parameters for PutMaintenancePlan.
+type PutMaintenancePlanJSONBody AtualizarPlanoManutencao
+
+// PutMemberLocationJSONBody defines parameters for PutMemberLocation.
+type PutMemberLocationJSONBody LocalizacaoTecnico
+
+// ListNotificationsParams defines parameters for ListNotifications.
+type ListNotificationsParams struct {
+	// Lista só as notificações não lidas
+	NaoLidas *bool `json:"nao_lidas,omitempty"`
+
+	// Quantidade de notificações (padrão 50, máximo 200)
+	Limite *int `json:"limite,omitempty"`
+}
+
+// PostCreatePartJSONBody defines parameters for PostCreatePart.
+type PostCreatePartJSONBody CriarPeca
+
+// ListPartsParams defines parameters for ListParts.
+type ListPartsParams struct {
+	// Trecho do SKU ou do nome
+	Busca *string `json:"busca,omitempty"`
+
+	// Inclui as peças inativas
+	IncluirInativas *bool `json:"incluir_inativas,omitempty"`
+}
+
+// PutUpdatePartJSONBody defines parameters for PutUpdatePart.
+type PutUpdatePartJSONBody AtualizarPeca
+
+// ListPortalRequestsParams defines parameters for ListPortalRequests.
+type ListPortalRequestsParams struct {
+	// Filtra por cliente
+	ClienteID *string `json:"cliente_id,omitempty"`
+
+	// Filtra pela situação
+	Status *StatusSolicitacaoPortal `json:"status,omitempty"`
+}
+
+// PutReviewPortalRequestJSONBody defines parameters for PutReviewPortalRequest.
+type PutReviewPortalRequestJSONBody RevisarSolicitacaoPortal
+
+// PostCreatePortalUserJSONBody defines parameters for PostCreatePortalUser.
+type PostCreatePortalUserJSONBody CriarUsuarioPortal
+
+// ListPortalUsersParams defines parameters for ListPortalUsers.
+type ListPortalUsersParams struct {
+	// ID do cliente
+	ClienteID string `json:"cliente_id"`
+}
+
+// PostPortalLoginJSONBody defines parameters for PostPortalLogin.
+type PostPortalLoginJSONBody LoginReq
+
+// PostPortalRequestJSONBody defines parameters for PostPortalRequest.
+type PostPortalRequestJSONBody CriarSolicitacaoPortal
+
+// PutBillingSettingsJSONBody defines parameters for PutBillingSettings.
+type PutBillingSettingsJSONBody AtualizarConfiguracaoCobranca
+
+// PutServiceOrderTemplateJSONBody defines parameters for PutServiceOrderTemplate.
+type PutServiceOrderTemplateJSONBody AtualizarModeloOrdemServico
+
+// ListHolidaysParams defines parameters for ListHolidays.
+type ListHolidaysParams struct {
+	// Lista os feriados a partir deste dia (padrão 1º de janeiro do ano atual)
+	APartirDe *openapi_types.Date `json:"a_partir_de,omitempty"`
+}
+
+// PostHolidayJSONBody defines parameters for PostHoliday.
+type PostHolidayJSONBody CriarFeriado
+
+// PutSLAPolicyJSONBody defines parameters for PutSLAPolicy.
+type PutSLAPolicyJSONBody AtualizarPoliticaSLA
+
+// PostCreateStockLocationJSONBody defines parameters for PostCreateStockLocation.
+type PostCreateStockLocationJSONBody CriarLocalEstoque
+
+// ListStockLevelsParams defines parameters for ListStockLevels.
+type ListStockLevelsParams struct {
+	// Filtra pelo local de estoque
+	LocalID *string `json:"local_id,omitempty"`
+
+	// Filtra pela peça
+	PecaID *string `json:"peca_id,omitempty"`
+}
+
+// ListStockMovementsParams defines parameters for ListStockMovements.
+type ListStockMovementsParams struct {
+	// Filtra pela peça
+	PecaID *string `json:"peca_id,omitempty"`
+
+	// Filtra pelo local de estoque
+	LocalID *string `json:"local_id,omitempty"`
+
+	// Filtra pelo atendimento
+	AtendimentoID *string `json:"atendimento_id,omitempty"`
+
+	// Quantidade de movimentações (padrão 100, máximo 500)
+	Limite *int `json:"limite,omitempty"`
+}
+
+// PostStockMovementJSONBody defines parameters for PostStockMovement.
+type PostStockMovementJSONBody LancarMovimentacaoEstoque
+
+// PostAnswerSurveyJSONBody defines parameters for PostAnswerSurvey.
+type PostAnswerSurveyJSONBody ResponderPesquisa
+
+// GetSurveySummaryParams defines parameters for GetSurveySummary.
+type GetSurveySummaryParams struct {
+	// Agrupamento do resumo
+	AgruparPor AgrupamentoPesquisas `json:"agrupar_por"`
+
+	// Tamanho do período, obrigatório no agrupamento por período
+	Granularidade *GranularidadePesquisas `json:"granularidade,omitempty"`
+
+	// Início do período (inclusive)
+	De *time.Time `json:"de,omitempty"`
+
+	// Fim do período (exclusive)
+	Ate *time.Time `json:"ate,omitempty"`
+
+	// Filtra pelo cliente
+	ClienteID *string `json:"cliente_id,omitempty"`
+
+	// Filtra pelo técnico
+	TecnicoID *string `json:"tecnico_id,omitempty"`
+}
+
+// GetTeamCalendarParams defines parameters for GetTeamCalendar.
+type GetTeamCalendarParams struct {
+	// Início do período consultado
+	Inicio time.Time `json:"inicio"`
+
+	// Fim do período consultado (até 62 dias depois do início)
+	Fim time.Time `json:"fim"`
+}
+
+// GetTechnicianCalendarParams defines parameters for GetTechnicianCalendar.
+type GetTechnicianCalendarParams struct {
+	// Início do período consultado
+	Inicio time.Time `json:"inicio"`
+
+	// Fim do período consultado (até 62 dias depois do início)
+	Fim time.Time `json:"fim"`
+}
+
+// GetTechnicianNextFreeSlotParams defines parameters for GetTechnicianNextFreeSlot.
+type GetTechnicianNextFreeSlotParams struct {
+	// Duração da visita em minutos
+	DuracaoMinutos int `json:"duracao_minutos"`
+
+	// Início da busca (padrão agora)
+	APartirDe *time.Time `json:"a_partir_de,omitempty"`
+}
+
+// GetTechnicianRouteParams defines parameters for GetTechnicianRoute.
+type GetTechnicianRouteParams struct {
+	// Dia do roteiro (AAAA-MM-DD), no fuso do expediente
+	Date openapi_types.Date `json:"date"`
+
+	// Latitude do ponto de partida (opcional, junto com origem_longitude)
+	OrigemLatitude *float64 `json:"origem_latitude,omitempty"`
+
+	// Longitude do ponto de partida
+	OrigemLongitude *float64 `json:"origem_longitude,omitempty"`
+
+	// Horário de saída (padrão abertura do expediente do dia)
+	Saida *time.Time `json:"saida,omitempty"`
+
+	// Velocidade média para as estimativas (padrão 40 km/h)
+	VelocidadeKmh *float64 `json:"velocidade_kmh,omitempty"`
+
+	// Tempo previsto em cada visita (padrão 60 minutos)
+	TempoParadaMinutos *int `json:"tempo_parada_minutos,omitempty"`
+}
+
+// PostCreateUserJSONBody defines parameters for PostCreateUser.
+type PostCreateUserJSONBody CriarUsuario
+
+// PostLoginUserJSONBody defines parameters for PostLoginUser.
+type PostLoginUserJSONBody LoginReq
+
+// PutUpdateUserJSONBody defines parameters for PutUpdateUser.
+type PutUpdateUserJSONBody AtualizarUsuario
+
+// GetWorkLogTotalsParams defines parameters for GetWorkLogTotals.
+type GetWorkLogTotalsParams struct {
+	// Agrupamento dos totais
+	AgruparPor AgrupamentoApontamentos `json:"agrupar_por"`
+
+	// Início do período (inclusive)
+	De *time.Time `json:"de,omitempty"`
+
+	// Fim do período (exclusive)
+	Ate *time.Time `json:"ate,omitempty"`
+
+	// Filtra pelo cliente
+	ClienteID *string `json:"cliente_id,omitempty"`
+
+	// Filtra pelo técnico
+	TecnicoID *string `json:"tecnico_id,omitempty"`
+}
+
+// PostCreateChecklistTemplateJSONRequestBody defines body for PostCreateChecklistTemplate for application/json ContentType.
+type PostCreateChecklistTemplateJSONRequestBody PostCreateChecklistTemplateJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateChecklistTemplateJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutChecklistTemplateJSONRequestBody defines body for PutChecklistTemplate for application/json ContentType.
+type PutChecklistTemplateJSONRequestBody PutChecklistTemplateJSONBody
+
+// Bind implements render.Binder.
+func (PutChecklistTemplateJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreateClientJSONRequestBody defines body for PostCreateClient for application/json ContentType.
+type PostCreateClientJSONRequestBody PostCreateClientJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateClientJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// MergeClientsJSONRequestBody defines body for MergeClients for application/json ContentType.
+type MergeClientsJSONRequestBody MergeClientsJSONBody
+
+// Bind implements render.Binder.
+func (MergeClientsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutClientJSONRequestBody defines body for PutClient for application/json ContentType.
+type PutClientJSONRequestBody PutClientJSONBody
+
+// Bind implements render.Binder.
+func (PutClientJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreateContractJSONRequestBody defines body for PostCreateContract for application/json ContentType.
+type PostCreateContractJSONRequestBody PostCreateContractJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateContractJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutContractJSONRequestBody defines body for PutContract for application/json ContentType.
+type PutContractJSONRequestBody PutContractJSONBody
+
+// Bind implements render.Binder.
+func (PutContractJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreateCustomFieldJSONRequestBody defines body for PostCreateCustomField for application/json ContentType.
+type PostCreateCustomFieldJSONRequestBody PostCreateCustomFieldJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateCustomFieldJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutCustomFieldJSONRequestBody defines body for PutCustomField for application/json ContentType.
+type PutCustomFieldJSONRequestBody PutCustomFieldJSONBody
+
+// Bind implements render.Binder.
+func (PutCustomFieldJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreateFormTypeJSONRequestBody defines body for PostCreateFormType for application/json ContentType.
+type PostCreateFormTypeJSONRequestBody PostCreateFormTypeJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateFormTypeJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutFormTypeJSONRequestBody defines body for PutFormType for application/json ContentType.
+type PutFormTypeJSONRequestBody PutFormTypeJSONBody
+
+// Bind implements render.Binder.
+func (PutFormTypeJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostFormTypeVersionJSONRequestBody defines body for PostFormTypeVersion for application/json ContentType.
+type PostFormTypeVersionJSONRequestBody PostFormTypeVersionJSONBody
+
+// Bind implements render.Binder.
+func (PostFormTypeVersionJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreateFormJSONRequestBody defines body for PostCreateForm for application/json ContentType.
+type PostCreateFormJSONRequestBody PostCreateFormJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateFormJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostFormStatusJSONRequestBody defines body for PostFormStatus for application/json ContentType.
+type PostFormStatusJSONRequestBody PostFormStatusJSONBody
+
+// Bind implements render.Binder.
+func (PostFormStatusJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutFormJSONRequestBody defines body for PutForm for application/json ContentType.
+type PutFormJSONRequestBody PutFormJSONBody
+
+// Bind implements render.Binder.
+func (PutFormJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutFormChecklistItemJSONRequestBody defines body for PutFormChecklistItem for application/json ContentType.
+type PutFormChecklistItemJSONRequestBody PutFormChecklistItemJSONBody
+
+// Bind implements render.Binder.
+func (PutFormChecklistItemJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostFormCommentJSONRequestBody defines body for PostFormComment for application/json ContentType.
+type PostFormCommentJSONRequestBody PostFormCommentJSONBody
+
+// Bind implements render.Binder.
+func (PostFormCommentJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutFormCommentJSONRequestBody defines body for PutFormComment for application/json ContentType.
+type PutFormCommentJSONRequestBody PutFormCommentJSONBody
+
+// Bind implements render.Binder.
+func (PutFormCommentJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostFormPartJSONRequestBody defines body for PostFormPart for application/json ContentType.
+type PostFormPartJSONRequestBody PostFormPartJSONBody
+
+// Bind implements render.Binder.
+func (PostFormPartJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutFormScheduleJSONRequestBody defines body for PutFormSchedule for application/json ContentType.
+type PutFormScheduleJSONRequestBody PutFormScheduleJSONBody
+
+// Bind implements render.Binder.
+func (PutFormScheduleJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostFormSignatureJSONRequestBody defines body for PostFormSignature for application/json ContentType.
+type PostFormSignatureJSONRequestBody PostFormSignatureJSONBody
+
+// Bind implements render.Binder.
+func (PostFormSignatureJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostFormWorkLogJSONRequestBody defines body for PostFormWorkLog for application/json ContentType.
+type PostFormWorkLogJSONRequestBody PostFormWorkLogJSONBody
+
+// Bind implements render.Binder.
+func (PostFormWorkLogJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostStartFormWorkLogJSONRequestBody defines body for PostStartFormWorkLog for application/json ContentType.
+type PostStartFormWorkLogJSONRequestBody PostStartFormWorkLogJSONBody
+
+// Bind implements render.Binder.
+func (PostStartFormWorkLogJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostStopFormWorkLogJSONRequestBody defines body for PostStopFormWorkLog for application/json ContentType.
+type PostStopFormWorkLogJSONRequestBody PostStopFormWorkLogJSONBody
+
+// Bind implements render.Binder.
+func (PostStopFormWorkLogJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreateInvoiceJSONRequestBody defines body for PostCreateInvoice for application/json ContentType.
+type PostCreateInvoiceJSONRequestBody PostCreateInvoiceJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateInvoiceJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutUpdateInvoiceJSONRequestBody defines body for PutUpdateInvoice for application/json ContentType.
+type PutUpdateInvoiceJSONRequestBody PutUpdateInvoiceJSONBody
+
+// Bind implements render.Binder.
+func (PutUpdateInvoiceJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutInvoiceStatusJSONRequestBody defines body for PutInvoiceStatus for application/json ContentType.
+type PutInvoiceStatusJSONRequestBody PutInvoiceStatusJSONBody
+
+// Bind implements render.Binder.
+func (PutInvoiceStatusJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreateMaintenancePlanJSONRequestBody defines body for PostCreateMaintenancePlan for application/json ContentType.
+type PostCreateMaintenancePlanJSONRequestBody PostCreateMaintenancePlanJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateMaintenancePlanJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutMaintenancePlanJSONRequestBody defines body for PutMaintenancePlan for application/json ContentType.
+type PutMaintenancePlanJSONRequestBody PutMaintenancePlanJSONBody
+
+// Bind implements render.Binder.
+func (PutMaintenancePlanJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutMemberLocationJSONRequestBody defines body for PutMemberLocation for application/json ContentType.
+type PutMemberLocationJSONRequestBody PutMemberLocationJSONBody
+
+// Bind implements render.Binder.
+func (PutMemberLocationJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreatePartJSONRequestBody defines body for PostCreatePart for application/json ContentType.
+type PostCreatePartJSONRequestBody PostCreatePartJSONBody
+
+// Bind implements render.Binder.
+func (PostCreatePartJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutUpdatePartJSONRequestBody defines body for PutUpdatePart for application/json ContentType.
+type PutUpdatePartJSONRequestBody PutUpdatePartJSONBody
+
+// Bind implements render.Binder.
+func (PutUpdatePartJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutReviewPortalRequestJSONRequestBody defines body for PutReviewPortalRequest for application/json ContentType.
+type PutReviewPortalRequestJSONRequestBody PutReviewPortalRequestJSONBody
+
+// Bind implements render.Binder.
+func (PutReviewPortalRequestJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreatePortalUserJSONRequestBody defines body for PostCreatePortalUser for application/json ContentType.
+type PostCreatePortalUserJSONRequestBody PostCreatePortalUserJSONBody
+
+// Bind implements render.Binder.
+func (PostCreatePortalUserJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostPortalLoginJSONRequestBody defines body for PostPortalLogin for application/json ContentType.
+type PostPortalLoginJSONRequestBody PostPortalLoginJSONBody
+
+// Bind implements render.Binder.
+func (PostPortalLoginJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostPortalRequestJSONRequestBody defines body for PostPortalRequest for application/json ContentType.
+type PostPortalRequestJSONRequestBody PostPortalRequestJSONBody
+
+// Bind implements render.Binder.
+func (PostPortalRequestJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutBillingSettingsJSONRequestBody defines body for PutBillingSettings for application/json ContentType.
+type PutBillingSettingsJSONRequestBody PutBillingSettingsJSONBody
+
+// Bind implements render.Binder.
+func (PutBillingSettingsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutServiceOrderTemplateJSONRequestBody defines body for PutServiceOrderTemplate for application/json ContentType.
+type PutServiceOrderTemplateJSONRequestBody PutServiceOrderTemplateJSONBody
+
+// Bind implements render.Binder.
+func (PutServiceOrderTemplateJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostHolidayJSONRequestBody defines body for PostHoliday for application/json ContentType.
+type PostHolidayJSONRequestBody PostHolidayJSONBody
+
+// Bind implements render.Binder.
+func (PostHolidayJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutSLAPolicyJSONRequestBody defines body for PutSLAPolicy for application/json ContentType.
+type PutSLAPolicyJSONRequestBody PutSLAPolicyJSONBody
+
+// Bind implements render.Binder.
+func (PutSLAPolicyJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreateStockLocationJSONRequestBody defines body for PostCreateStockLocation for application/json ContentType.
+type PostCreateStockLocationJSONRequestBody PostCreateStockLocationJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateStockLocationJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostStockMovementJSONRequestBody defines body for PostStockMovement for application/json ContentType.
+type PostStockMovementJSONRequestBody PostStockMovementJSONBody
+
+// Bind implements render.Binder.
+func (PostStockMovementJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostAnswerSurveyJSONRequestBody defines body for PostAnswerSurvey for application/json ContentType.
+type PostAnswerSurveyJSONRequestBody PostAnswerSurveyJSONBody
+
+// Bind implements render.Binder.
+func (PostAnswerSurveyJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostCreateUserJSONRequestBody defines body for PostCreateUser for application/json ContentType.
+type PostCreateUserJSONRequestBody PostCreateUserJSONBody
+
+// Bind implements render.Binder.
+func (PostCreateUserJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostLoginUserJSONRequestBody defines body for PostLoginUser for application/json ContentType.
+type PostLoginUserJSONRequestBody PostLoginUserJSONBody
+
+// Bind implements render.Binder.
+func (PostLoginUserJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutUpdateUserJSONRequestBody defines body for PutUpdateUser for application/json ContentType.
+type PutUpdateUserJSONRequestBody PutUpdateUserJSONBody
+
+// Bind implements render.Binder.
+func (PutUpdateUserJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+}
+
+// Render implements the render.Renderer interface. It sets the Content-Type header
+// and status code based on the response definition.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", resp.contentType)
+	render.Status(r, resp.Code)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// PostCreateChecklistTemplateJSON201Response is a constructor method for a PostCreateChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateChecklistTemplateJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateChecklistTemplateJSON400Response is a constructor method for a PostCreateChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateChecklistTemplateJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateChecklistTemplateJSON401Response is a constructor method for a PostCreateChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateChecklistTemplateJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateChecklistTemplateJSON403Response is a constructor method for a PostCreateChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateChecklistTemplateJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateChecklistTemplateJSON409Response is a constructor method for a PostCreateChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateChecklistTemplateJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateChecklistTemplateJSON500Response is a constructor method for a PostCreateChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateChecklistTemplateJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteChecklistTemplateJSON204Response is a constructor method for a DeleteChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteChecklistTemplateJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteChecklistTemplateJSON401Response is a constructor method for a DeleteChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteChecklistTemplateJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteChecklistTemplateJSON403Response is a constructor method for a DeleteChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteChecklistTemplateJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteChecklistTemplateJSON404Response is a constructor method for a DeleteChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteChecklistTemplateJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteChecklistTemplateJSON500Response is a constructor method for a DeleteChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteChecklistTemplateJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListChecklistTemplatesJSON200Response is a constructor method for a ListChecklistTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListChecklistTemplatesJSON200Response(body ListaModelosChecklist) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListChecklistTemplatesJSON401Response is a constructor method for a ListChecklistTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListChecklistTemplatesJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListChecklistTemplatesJSON500Response is a constructor method for a ListChecklistTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListChecklistTemplatesJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutChecklistTemplateJSON204Response is a constructor method for a PutChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutChecklistTemplateJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutChecklistTemplateJSON400Response is a constructor method for a PutChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutChecklistTemplateJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutChecklistTemplateJSON401Response is a constructor method for a PutChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutChecklistTemplateJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutChecklistTemplateJSON403Response is a constructor method for a PutChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutChecklistTemplateJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutChecklistTemplateJSON404Response is a constructor method for a PutChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutChecklistTemplateJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutChecklistTemplateJSON409Response is a constructor method for a PutChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutChecklistTemplateJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutChecklistTemplateJSON500Response is a constructor method for a PutChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutChecklistTemplateJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetChecklistTemplateJSON200Response is a constructor method for a GetChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetChecklistTemplateJSON200Response(body ModeloChecklist) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetChecklistTemplateJSON401Response is a constructor method for a GetChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetChecklistTemplateJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetChecklistTemplateJSON404Response is a constructor method for a GetChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetChecklistTemplateJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetChecklistTemplateJSON500Response is a constructor method for a GetChecklistTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetChecklistTemplateJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateClientJSON200Response is a constructor method for a PostCreateClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateClientJSON200Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateClientJSON400Response is a constructor method for a PostCreateClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateClientJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateClientJSON409Response is a constructor method for a PostCreateClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateClientJSON409Response(body AvisoDuplicidade) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateClientJSON500Response is a constructor method for a PostCreateClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateClientJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteClientJSON204Response is a constructor method for a DeleteClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteClientJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteClientJSON400Response is a constructor method for a DeleteClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteClientJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteClientJSON401Response is a constructor method for a DeleteClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteClientJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteClientJSON500Response is a constructor method for a DeleteClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteClientJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListDuplicateClientsJSON200Response is a constructor method for a ListDuplicateClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDuplicateClientsJSON200Response(body ListaDuplicidades) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListDuplicateClientsJSON401Response is a constructor method for a ListDuplicateClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDuplicateClientsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListDuplicateClientsJSON500Response is a constructor method for a ListDuplicateClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDuplicateClientsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetV1clientsListJSON200Response is a constructor method for a GetV1clientsList response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetV1clientsListJSON200Response(body ListaClientes) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetV1clientsListJSON400Response is a constructor method for a GetV1clientsList response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetV1clientsListJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetV1clientsListJSON500Response is a constructor method for a GetV1clientsList response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetV1clientsListJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// MergeClientsJSON200Response is a constructor method for a MergeClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func MergeClientsJSON200Response(body Unificacao) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// MergeClientsJSON400Response is a constructor method for a MergeClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func MergeClientsJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// MergeClientsJSON401Response is a constructor method for a MergeClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func MergeClientsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// MergeClientsJSON403Response is a constructor method for a MergeClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func MergeClientsJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// MergeClientsJSON404Response is a constructor method for a MergeClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func MergeClientsJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// MergeClientsJSON409Response is a constructor method for a MergeClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func MergeClientsJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// MergeClientsJSON500Response is a constructor method for a MergeClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func MergeClientsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PurgeClientJSON204Response is a constructor method for a PurgeClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeClientJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PurgeClientJSON401Response is a constructor method for a PurgeClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeClientJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PurgeClientJSON403Response is a constructor method for a PurgeClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeClientJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PurgeClientJSON404Response is a constructor method for a PurgeClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeClientJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PurgeClientJSON409Response is a constructor method for a PurgeClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeClientJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PurgeClientJSON500Response is a constructor method for a PurgeClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeClientJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// RestoreClientJSON204Response is a constructor method for a RestoreClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreClientJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// RestoreClientJSON401Response is a constructor method for a RestoreClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreClientJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// RestoreClientJSON403Response is a constructor method for a RestoreClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreClientJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// RestoreClientJSON404Response is a constructor method for a RestoreClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreClientJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// RestoreClientJSON500Response is a constructor method for a RestoreClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreClientJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListDeletedClientsJSON200Response is a constructor method for a ListDeletedClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDeletedClientsJSON200Response(body ListaClientesLixeira) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListDeletedClientsJSON401Response is a constructor method for a ListDeletedClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDeletedClientsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListDeletedClientsJSON403Response is a constructor method for a ListDeletedClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDeletedClientsJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// ListDeletedClientsJSON500Response is a constructor method for a ListDeletedClients response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDeletedClientsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutClientJSON204Response is a constructor method for a PutClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutClientJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutClientJSON400Response is a constructor method for a PutClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutClientJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutClientJSON401Response is a constructor method for a PutClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutClientJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutClientJSON500Response is a constructor method for a PutClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutClientJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetByIDClientJSON200Response is a constructor method for a GetByIDClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetByIDClientJSON200Response(body BuscaCliente) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetByIDClientJSON400Response is a constructor method for a GetByIDClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetByIDClientJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetByIDClientJSON401Response is a constructor method for a GetByIDClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetByIDClientJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetByIDClientJSON500Response is a constructor method for a GetByIDClient response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetByIDClientJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListClientMergesJSON200Response is a constructor method for a ListClientMerges response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListClientMergesJSON200Response(body ListaUnificacoes) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListClientMergesJSON401Response is a constructor method for a ListClientMerges response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListClientMergesJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListClientMergesJSON500Response is a constructor method for a ListClientMerges response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListClientMergesJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListContractAlertsJSON200Response is a constructor method for a ListContractAlerts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListContractAlertsJSON200Response(body ListaContratos) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListContractAlertsJSON401Response is a constructor method for a ListContractAlerts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListContractAlertsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListContractAlertsJSON500Response is a constructor method for a ListContractAlerts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListContractAlertsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateContractJSON201Response is a constructor method for a PostCreateContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateContractJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateContractJSON400Response is a constructor method for a PostCreateContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateContractJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateContractJSON401Response is a constructor method for a PostCreateContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateContractJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateContractJSON409Response is a constructor method for a PostCreateContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateContractJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateContractJSON500Response is a constructor method for a PostCreateContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateContractJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteContractJSON204Response is a constructor method for a DeleteContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteContractJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteContractJSON401Response is a constructor method for a DeleteContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteContractJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteContractJSON500Response is a constructor method for a DeleteContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteContractJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListContractsJSON200Response is a constructor method for a ListContracts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListContractsJSON200Response(body ListaContratos) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListContractsJSON401Response is a constructor method for a ListContracts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListContractsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListContractsJSON500Response is a constructor method for a ListContracts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListContractsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutContractJSON204Response is a constructor method for a PutContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutContractJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutContractJSON400Response is a constructor method for a PutContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutContractJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutContractJSON401Response is a constructor method for a PutContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutContractJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutContractJSON404Response is a constructor method for a PutContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutContractJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutContractJSON409Response is a constructor method for a PutContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutContractJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutContractJSON500Response is a constructor method for a PutContract response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutContractJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetContractByIDJSON200Response is a constructor method for a GetContractByID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetContractByIDJSON200Response(body BuscaContrato) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetContractByIDJSON401Response is a constructor method for a GetContractByID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetContractByIDJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetContractByIDJSON404Response is a constructor method for a GetContractByID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetContractByIDJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetContractByIDJSON500Response is a constructor method for a GetContractByID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetContractByIDJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetContractUsageJSON200Response is a constructor method for a GetContractUsage response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetContractUsageJSON200Response(body UsoContrato) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetContractUsageJSON401Response is a constructor method for a GetContractUsage response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetContractUsageJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetContractUsageJSON404Response is a constructor method for a GetContractUsage response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetContractUsageJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetContractUsageJSON500Response is a constructor method for a GetContractUsage response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetContractUsageJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateCustomFieldJSON201Response is a constructor method for a PostCreateCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateCustomFieldJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateCustomFieldJSON400Response is a constructor method for a PostCreateCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateCustomFieldJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateCustomFieldJSON401Response is a constructor method for a PostCreateCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateCustomFieldJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateCustomFieldJSON403Response is a constructor method for a PostCreateCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateCustomFieldJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateCustomFieldJSON409Response is a constructor method for a PostCreateCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateCustomFieldJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateCustomFieldJSON500Response is a constructor method for a PostCreateCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateCustomFieldJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteCustomFieldJSON204Response is a constructor method for a DeleteCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteCustomFieldJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteCustomFieldJSON401Response is a constructor method for a DeleteCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteCustomFieldJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteCustomFieldJSON403Response is a constructor method for a DeleteCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteCustomFieldJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteCustomFieldJSON404Response is a constructor method for a DeleteCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteCustomFieldJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteCustomFieldJSON500Response is a constructor method for a DeleteCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteCustomFieldJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListCustomFieldsJSON200Response is a constructor method for a ListCustomFields response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListCustomFieldsJSON200Response(body ListaCamposPersonalizados) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListCustomFieldsJSON401Response is a constructor method for a ListCustomFields response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListCustomFieldsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListCustomFieldsJSON500Response is a constructor method for a ListCustomFields response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListCustomFieldsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutCustomFieldJSON204Response is a constructor method for a PutCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutCustomFieldJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutCustomFieldJSON400Response is a constructor method for a PutCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutCustomFieldJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutCustomFieldJSON401Response is a constructor method for a PutCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutCustomFieldJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutCustomFieldJSON403Response is a constructor method for a PutCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutCustomFieldJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutCustomFieldJSON404Response is a constructor method for a PutCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutCustomFieldJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutCustomFieldJSON500Response is a constructor method for a PutCustomField response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutCustomFieldJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetDispatchSuggestionsJSON200Response is a constructor method for a GetDispatchSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDispatchSuggestionsJSON200Response(body SugestoesDesignacao) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetDispatchSuggestionsJSON400Response is a constructor method for a GetDispatchSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDispatchSuggestionsJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetDispatchSuggestionsJSON401Response is a constructor method for a GetDispatchSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDispatchSuggestionsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetDispatchSuggestionsJSON500Response is a constructor method for a GetDispatchSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDispatchSuggestionsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateFormTypeJSON201Response is a constructor method for a PostCreateFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateFormTypeJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateFormTypeJSON400Response is a constructor method for a PostCreateFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateFormTypeJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateFormTypeJSON401Response is a constructor method for a PostCreateFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateFormTypeJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateFormTypeJSON403Response is a constructor method for a PostCreateFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateFormTypeJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateFormTypeJSON409Response is a constructor method for a PostCreateFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateFormTypeJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateFormTypeJSON500Response is a constructor method for a PostCreateFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateFormTypeJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormTypesJSON200Response is a constructor method for a ListFormTypes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormTypesJSON200Response(body ListaTiposFormulario) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormTypesJSON401Response is a constructor method for a ListFormTypes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormTypesJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormTypesJSON500Response is a constructor method for a ListFormTypes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormTypesJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutFormTypeJSON204Response is a constructor method for a PutFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormTypeJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutFormTypeJSON400Response is a constructor method for a PutFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormTypeJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutFormTypeJSON401Response is a constructor method for a PutFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormTypeJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutFormTypeJSON403Response is a constructor method for a PutFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormTypeJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutFormTypeJSON404Response is a constructor method for a PutFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormTypeJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutFormTypeJSON409Response is a constructor method for a PutFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormTypeJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutFormTypeJSON500Response is a constructor method for a PutFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormTypeJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTypeJSON200Response is a constructor method for a GetFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTypeJSON200Response(body TipoFormularioDetalhe) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTypeJSON401Response is a constructor method for a GetFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTypeJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTypeJSON404Response is a constructor method for a GetFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTypeJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTypeJSON500Response is a constructor method for a GetFormType response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTypeJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormTypeVersionsJSON200Response is a constructor method for a ListFormTypeVersions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormTypeVersionsJSON200Response(body ListaVersoesTipoFormulario) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormTypeVersionsJSON401Response is a constructor method for a ListFormTypeVersions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormTypeVersionsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormTypeVersionsJSON404Response is a constructor method for a ListFormTypeVersions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormTypeVersionsJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListFormTypeVersionsJSON500Response is a constructor method for a ListFormTypeVersions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormTypeVersionsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostFormTypeVersionJSON201Response is a constructor method for a PostFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormTypeVersionJSON201Response(body VersaoTipoFormulario) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostFormTypeVersionJSON400Response is a constructor method for a PostFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormTypeVersionJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostFormTypeVersionJSON401Response is a constructor method for a PostFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormTypeVersionJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostFormTypeVersionJSON403Response is a constructor method for a PostFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormTypeVersionJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostFormTypeVersionJSON404Response is a constructor method for a PostFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormTypeVersionJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostFormTypeVersionJSON500Response is a constructor method for a PostFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormTypeVersionJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTypeVersionJSON200Response is a constructor method for a GetFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTypeVersionJSON200Response(body VersaoTipoFormulario) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTypeVersionJSON401Response is a constructor method for a GetFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTypeVersionJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTypeVersionJSON404Response is a constructor method for a GetFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTypeVersionJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTypeVersionJSON500Response is a constructor method for a GetFormTypeVersion response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTypeVersionJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetFormByCodeJSON200Response is a constructor method for a GetFormByCode response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormByCodeJSON200Response(body BuscaFormulario) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetFormByCodeJSON400Response is a constructor method for a GetFormByCode response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormByCodeJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetFormByCodeJSON401Response is a constructor method for a GetFormByCode response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormByCodeJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetFormByCodeJSON404Response is a constructor method for a GetFormByCode response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormByCodeJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetFormByCodeJSON500Response is a constructor method for a GetFormByCode response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormByCodeJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateFormJSON201Response is a constructor method for a PostCreateForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateFormJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateFormJSON400Response is a constructor method for a PostCreateForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateFormJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateFormJSON500Response is a constructor method for a PostCreateForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateFormJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormJSON204Response is a constructor method for a DeleteForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormJSON400Response is a constructor method for a DeleteForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormJSON403Response is a constructor method for a DeleteForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormJSON500Response is a constructor method for a DeleteForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormsJSON200Response is a constructor method for a ListForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormsJSON200Response(body ListaFormulario) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormsJSON400Response is a constructor method for a ListForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormsJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// ListFormsJSON401Response is a constructor method for a ListForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormsJSON500Response is a constructor method for a ListForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PurgeFormJSON204Response is a constructor method for a PurgeForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeFormJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PurgeFormJSON401Response is a constructor method for a PurgeForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeFormJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PurgeFormJSON403Response is a constructor method for a PurgeForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeFormJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PurgeFormJSON404Response is a constructor method for a PurgeForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeFormJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PurgeFormJSON500Response is a constructor method for a PurgeForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PurgeFormJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// RestoreFormJSON204Response is a constructor method for a RestoreForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreFormJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// RestoreFormJSON401Response is a constructor method for a RestoreForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreFormJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// RestoreFormJSON403Response is a constructor method for a RestoreForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreFormJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// RestoreFormJSON404Response is a constructor method for a RestoreForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreFormJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// RestoreFormJSON500Response is a constructor method for a RestoreForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func RestoreFormJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// SearchFormsJSON200Response is a constructor method for a SearchForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func SearchFormsJSON200Response(body BuscaAtendimentos) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// SearchFormsJSON400Response is a constructor method for a SearchForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func SearchFormsJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// SearchFormsJSON401Response is a constructor method for a SearchForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func SearchFormsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// SearchFormsJSON500Response is a constructor method for a SearchForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func SearchFormsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListSimilarFormsByDescriptionJSON200Response is a constructor method for a ListSimilarFormsByDescription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSimilarFormsByDescriptionJSON200Response(body ListaAtendimentosSemelhantes) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListSimilarFormsByDescriptionJSON400Response is a constructor method for a ListSimilarFormsByDescription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSimilarFormsByDescriptionJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// ListSimilarFormsByDescriptionJSON401Response is a constructor method for a ListSimilarFormsByDescription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSimilarFormsByDescriptionJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListSimilarFormsByDescriptionJSON500Response is a constructor method for a ListSimilarFormsByDescription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSimilarFormsByDescriptionJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormStatusHistoryJSON200Response is a constructor method for a ListFormStatusHistory response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormStatusHistoryJSON200Response(body HistoricoStatusFormulario) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormStatusHistoryJSON401Response is a constructor method for a ListFormStatusHistory response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormStatusHistoryJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormStatusHistoryJSON404Response is a constructor method for a ListFormStatusHistory response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormStatusHistoryJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListFormStatusHistoryJSON500Response is a constructor method for a ListFormStatusHistory response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormStatusHistoryJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostFormStatusJSON204Response is a constructor method for a PostFormStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormStatusJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostFormStatusJSON400Response is a constructor method for a PostFormStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormStatusJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostFormStatusJSON401Response is a constructor method for a PostFormStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormStatusJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostFormStatusJSON403Response is a constructor method for a PostFormStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormStatusJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostFormStatusJSON404Response is a constructor method for a PostFormStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormStatusJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostFormStatusJSON409Response is a constructor method for a PostFormStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormStatusJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostFormStatusJSON500Response is a constructor method for a PostFormStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormStatusJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListDeletedFormsJSON200Response is a constructor method for a ListDeletedForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDeletedFormsJSON200Response(body ListaFormulariosLixeira) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListDeletedFormsJSON401Response is a constructor method for a ListDeletedForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDeletedFormsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListDeletedFormsJSON403Response is a constructor method for a ListDeletedForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDeletedFormsJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// ListDeletedFormsJSON500Response is a constructor method for a ListDeletedForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListDeletedFormsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutFormJSON204Response is a constructor method for a PutForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutFormJSON400Response is a constructor method for a PutForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutFormJSON401Response is a constructor method for a PutForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutFormJSON403Response is a constructor method for a PutForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutFormJSON500Response is a constructor method for a PutForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetFormByIDJSON200Response is a constructor method for a GetFormByID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormByIDJSON200Response(body BuscaFormulario) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetFormByIDJSON400Response is a constructor method for a GetFormByID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormByIDJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetFormByIDJSON401Response is a constructor method for a GetFormByID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormByIDJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetFormByIDJSON500Response is a constructor method for a GetFormByID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormByIDJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormAttachmentsJSON200Response is a constructor method for a ListFormAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormAttachmentsJSON200Response(body ListaAnexos) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormAttachmentsJSON401Response is a constructor method for a ListFormAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormAttachmentsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormAttachmentsJSON404Response is a constructor method for a ListFormAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormAttachmentsJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListFormAttachmentsJSON500Response is a constructor method for a ListFormAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormAttachmentsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostFormAttachmentJSON201Response is a constructor method for a PostFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormAttachmentJSON201Response(body AnexoAtendimento) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostFormAttachmentJSON400Response is a constructor method for a PostFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormAttachmentJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostFormAttachmentJSON401Response is a constructor method for a PostFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormAttachmentJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostFormAttachmentJSON403Response is a constructor method for a PostFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormAttachmentJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostFormAttachmentJSON404Response is a constructor method for a PostFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormAttachmentJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostFormAttachmentJSON413Response is a constructor method for a PostFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormAttachmentJSON413Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        413,
+		contentType: "application/json",
+	}
+}
+
+// PostFormAttachmentJSON415Response is a constructor method for a PostFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormAttachmentJSON415Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        415,
+		contentType: "application/json",
+	}
+}
+
+// PostFormAttachmentJSON500Response is a constructor method for a PostFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormAttachmentJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormAttachmentJSON204Response is a constructor method for a DeleteFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormAttachmentJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormAttachmentJSON401Response is a constructor method for a DeleteFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormAttachmentJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormAttachmentJSON403Response is a constructor method for a DeleteFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormAttachmentJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormAttachmentJSON404Response is a constructor method for a DeleteFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormAttachmentJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormAttachmentJSON500Response is a constructor method for a DeleteFormAttachment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormAttachmentJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormChecklistJSON200Response is a constructor method for a ListFormChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormChecklistJSON200Response(body ListaItensChecklist) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormChecklistJSON401Response is a constructor method for a ListFormChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormChecklistJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormChecklistJSON404Response is a constructor method for a ListFormChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormChecklistJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListFormChecklistJSON500Response is a constructor method for a ListFormChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormChecklistJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutFormChecklistItemJSON200Response is a constructor method for a PutFormChecklistItem response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormChecklistItemJSON200Response(body ItemChecklist) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutFormChecklistItemJSON400Response is a constructor method for a PutFormChecklistItem response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormChecklistItemJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutFormChecklistItemJSON401Response is a constructor method for a PutFormChecklistItem response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormChecklistItemJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutFormChecklistItemJSON403Response is a constructor method for a PutFormChecklistItem response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormChecklistItemJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutFormChecklistItemJSON404Response is a constructor method for a PutFormChecklistItem response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormChecklistItemJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutFormChecklistItemJSON500Response is a constructor method for a PutFormChecklistItem response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormChecklistItemJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormCommentsJSON200Response is a constructor method for a ListFormComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormCommentsJSON200Response(body ListaComentarios) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormCommentsJSON401Response is a constructor method for a ListFormComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormCommentsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormCommentsJSON404Response is a constructor method for a ListFormComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormCommentsJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListFormCommentsJSON500Response is a constructor method for a ListFormComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormCommentsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostFormCommentJSON201Response is a constructor method for a PostFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormCommentJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostFormCommentJSON400Response is a constructor method for a PostFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormCommentJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostFormCommentJSON401Response is a constructor method for a PostFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormCommentJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostFormCommentJSON404Response is a constructor method for a PostFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormCommentJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostFormCommentJSON500Response is a constructor method for a PostFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormCommentJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutFormCommentJSON204Response is a constructor method for a PutFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormCommentJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutFormCommentJSON400Response is a constructor method for a PutFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormCommentJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutFormCommentJSON401Response is a constructor method for a PutFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormCommentJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutFormCommentJSON403Response is a constructor method for a PutFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormCommentJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutFormCommentJSON404Response is a constructor method for a PutFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormCommentJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutFormCommentJSON500Response is a constructor method for a PutFormComment response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormCommentJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormCommentEditsJSON200Response is a constructor method for a ListFormCommentEdits response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormCommentEditsJSON200Response(body ListaEdicoesComentario) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormCommentEditsJSON401Response is a constructor method for a ListFormCommentEdits response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormCommentEditsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormCommentEditsJSON404Response is a constructor method for a ListFormCommentEdits response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormCommentEditsJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListFormCommentEditsJSON500Response is a constructor method for a ListFormCommentEdits response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormCommentEditsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormPartsJSON200Response is a constructor method for a ListFormParts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormPartsJSON200Response(body ListaPecasAtendimento) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormPartsJSON401Response is a constructor method for a ListFormParts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormPartsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormPartsJSON404Response is a constructor method for a ListFormParts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormPartsJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListFormPartsJSON500Response is a constructor method for a ListFormParts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormPartsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostFormPartJSON201Response is a constructor method for a PostFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormPartJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostFormPartJSON400Response is a constructor method for a PostFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormPartJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostFormPartJSON401Response is a constructor method for a PostFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormPartJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostFormPartJSON403Response is a constructor method for a PostFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormPartJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostFormPartJSON404Response is a constructor method for a PostFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormPartJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostFormPartJSON409Response is a constructor method for a PostFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormPartJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostFormPartJSON500Response is a constructor method for a PostFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormPartJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormPartJSON204Response is a constructor method for a DeleteFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormPartJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormPartJSON401Response is a constructor method for a DeleteFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormPartJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormPartJSON403Response is a constructor method for a DeleteFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormPartJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormPartJSON404Response is a constructor method for a DeleteFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormPartJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormPartJSON500Response is a constructor method for a DeleteFormPart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormPartJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetFormServiceOrderPdfJSON401Response is a constructor method for a GetFormServiceOrderPdf response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormServiceOrderPdfJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetFormServiceOrderPdfJSON404Response is a constructor method for a GetFormServiceOrderPdf response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormServiceOrderPdfJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetFormServiceOrderPdfJSON500Response is a constructor method for a GetFormServiceOrderPdf response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormServiceOrderPdfJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutFormScheduleJSON204Response is a constructor method for a PutFormSchedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormScheduleJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutFormScheduleJSON400Response is a constructor method for a PutFormSchedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormScheduleJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutFormScheduleJSON401Response is a constructor method for a PutFormSchedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormScheduleJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutFormScheduleJSON403Response is a constructor method for a PutFormSchedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormScheduleJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutFormScheduleJSON404Response is a constructor method for a PutFormSchedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormScheduleJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutFormScheduleJSON409Response is a constructor method for a PutFormSchedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormScheduleJSON409Response(body AvisoConflitoAgenda) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutFormScheduleJSON500Response is a constructor method for a PutFormSchedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutFormScheduleJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetFormSignatureJSON200Response is a constructor method for a GetFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormSignatureJSON200Response(body AssinaturaAtendimento) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetFormSignatureJSON401Response is a constructor method for a GetFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormSignatureJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetFormSignatureJSON404Response is a constructor method for a GetFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormSignatureJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetFormSignatureJSON500Response is a constructor method for a GetFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormSignatureJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostFormSignatureJSON201Response is a constructor method for a PostFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormSignatureJSON201Response(body AssinaturaAtendimento) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostFormSignatureJSON400Response is a constructor method for a PostFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormSignatureJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostFormSignatureJSON401Response is a constructor method for a PostFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormSignatureJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostFormSignatureJSON404Response is a constructor method for a PostFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormSignatureJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostFormSignatureJSON409Response is a constructor method for a PostFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormSignatureJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostFormSignatureJSON500Response is a constructor method for a PostFormSignature response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormSignatureJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListSimilarFormsJSON200Response is a constructor method for a ListSimilarForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSimilarFormsJSON200Response(body ListaAtendimentosSemelhantes) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListSimilarFormsJSON400Response is a constructor method for a ListSimilarForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSimilarFormsJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// ListSimilarFormsJSON401Response is a constructor method for a ListSimilarForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSimilarFormsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListSimilarFormsJSON404Response is a constructor method for a ListSimilarForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSimilarFormsJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListSimilarFormsJSON500Response is a constructor method for a ListSimilarForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSimilarFormsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteSimilarFormHelpfulJSON204Response is a constructor method for a DeleteSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteSimilarFormHelpfulJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteSimilarFormHelpfulJSON400Response is a constructor method for a DeleteSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteSimilarFormHelpfulJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteSimilarFormHelpfulJSON401Response is a constructor method for a DeleteSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteSimilarFormHelpfulJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteSimilarFormHelpfulJSON404Response is a constructor method for a DeleteSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteSimilarFormHelpfulJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteSimilarFormHelpfulJSON500Response is a constructor method for a DeleteSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteSimilarFormHelpfulJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostSimilarFormHelpfulJSON204Response is a constructor method for a PostSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSimilarFormHelpfulJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostSimilarFormHelpfulJSON400Response is a constructor method for a PostSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSimilarFormHelpfulJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostSimilarFormHelpfulJSON401Response is a constructor method for a PostSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSimilarFormHelpfulJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostSimilarFormHelpfulJSON404Response is a constructor method for a PostSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSimilarFormHelpfulJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostSimilarFormHelpfulJSON500Response is a constructor method for a PostSimilarFormHelpful response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSimilarFormHelpfulJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetFormSurveyJSON200Response is a constructor method for a GetFormSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormSurveyJSON200Response(body PesquisaSatisfacao) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetFormSurveyJSON401Response is a constructor method for a GetFormSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormSurveyJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetFormSurveyJSON404Response is a constructor method for a GetFormSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormSurveyJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetFormSurveyJSON500Response is a constructor method for a GetFormSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormSurveyJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTimelineJSON200Response is a constructor method for a GetFormTimeline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTimelineJSON200Response(body LinhaDoTempo) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTimelineJSON401Response is a constructor method for a GetFormTimeline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTimelineJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTimelineJSON404Response is a constructor method for a GetFormTimeline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTimelineJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetFormTimelineJSON500Response is a constructor method for a GetFormTimeline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFormTimelineJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListFormWorkLogsJSON200Response is a constructor method for a ListFormWorkLogs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormWorkLogsJSON200Response(body ListaApontamentos) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListFormWorkLogsJSON401Response is a constructor method for a ListFormWorkLogs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormWorkLogsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListFormWorkLogsJSON404Response is a constructor method for a ListFormWorkLogs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormWorkLogsJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListFormWorkLogsJSON500Response is a constructor method for a ListFormWorkLogs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListFormWorkLogsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostFormWorkLogJSON201Response is a constructor method for a PostFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormWorkLogJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostFormWorkLogJSON400Response is a constructor method for a PostFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormWorkLogJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostFormWorkLogJSON401Response is a constructor method for a PostFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormWorkLogJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostFormWorkLogJSON404Response is a constructor method for a PostFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormWorkLogJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostFormWorkLogJSON409Response is a constructor method for a PostFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormWorkLogJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostFormWorkLogJSON500Response is a constructor method for a PostFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostFormWorkLogJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostStartFormWorkLogJSON201Response is a constructor method for a PostStartFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStartFormWorkLogJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostStartFormWorkLogJSON400Response is a constructor method for a PostStartFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStartFormWorkLogJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostStartFormWorkLogJSON401Response is a constructor method for a PostStartFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStartFormWorkLogJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostStartFormWorkLogJSON404Response is a constructor method for a PostStartFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStartFormWorkLogJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostStartFormWorkLogJSON409Response is a constructor method for a PostStartFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStartFormWorkLogJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostStartFormWorkLogJSON500Response is a constructor method for a PostStartFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStartFormWorkLogJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormWorkLogJSON204Response is a constructor method for a DeleteFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormWorkLogJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormWorkLogJSON401Response is a constructor method for a DeleteFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormWorkLogJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormWorkLogJSON404Response is a constructor method for a DeleteFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormWorkLogJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteFormWorkLogJSON500Response is a constructor method for a DeleteFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteFormWorkLogJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostStopFormWorkLogJSON204Response is a constructor method for a PostStopFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStopFormWorkLogJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostStopFormWorkLogJSON400Response is a constructor method for a PostStopFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStopFormWorkLogJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostStopFormWorkLogJSON401Response is a constructor method for a PostStopFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStopFormWorkLogJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostStopFormWorkLogJSON404Response is a constructor method for a PostStopFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStopFormWorkLogJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostStopFormWorkLogJSON409Response is a constructor method for a PostStopFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStopFormWorkLogJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostStopFormWorkLogJSON500Response is a constructor method for a PostStopFormWorkLog response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStopFormWorkLogJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateInvoiceJSON201Response is a constructor method for a PostCreateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateInvoiceJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateInvoiceJSON400Response is a constructor method for a PostCreateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateInvoiceJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateInvoiceJSON401Response is a constructor method for a PostCreateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateInvoiceJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateInvoiceJSON403Response is a constructor method for a PostCreateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateInvoiceJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateInvoiceJSON404Response is a constructor method for a PostCreateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateInvoiceJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateInvoiceJSON409Response is a constructor method for a PostCreateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateInvoiceJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateInvoiceJSON500Response is a constructor method for a PostCreateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateInvoiceJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListInvoicesJSON200Response is a constructor method for a ListInvoices response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListInvoicesJSON200Response(body ListaFaturas) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListInvoicesJSON400Response is a constructor method for a ListInvoices response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListInvoicesJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// ListInvoicesJSON401Response is a constructor method for a ListInvoices response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListInvoicesJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListInvoicesJSON500Response is a constructor method for a ListInvoices response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListInvoicesJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetInvoiceJSON200Response is a constructor method for a GetInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetInvoiceJSON200Response(body Fatura) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetInvoiceJSON401Response is a constructor method for a GetInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetInvoiceJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetInvoiceJSON404Response is a constructor method for a GetInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetInvoiceJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetInvoiceJSON500Response is a constructor method for a GetInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetInvoiceJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateInvoiceJSON204Response is a constructor method for a PutUpdateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateInvoiceJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateInvoiceJSON400Response is a constructor method for a PutUpdateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateInvoiceJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateInvoiceJSON401Response is a constructor method for a PutUpdateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateInvoiceJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateInvoiceJSON403Response is a constructor method for a PutUpdateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateInvoiceJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateInvoiceJSON404Response is a constructor method for a PutUpdateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateInvoiceJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateInvoiceJSON409Response is a constructor method for a PutUpdateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateInvoiceJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateInvoiceJSON500Response is a constructor method for a PutUpdateInvoice response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateInvoiceJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetInvoicePdfJSON401Response is a constructor method for a GetInvoicePdf response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetInvoicePdfJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetInvoicePdfJSON404Response is a constructor method for a GetInvoicePdf response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetInvoicePdfJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetInvoicePdfJSON500Response is a constructor method for a GetInvoicePdf response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetInvoicePdfJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutInvoiceStatusJSON200Response is a constructor method for a PutInvoiceStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutInvoiceStatusJSON200Response(body Fatura) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutInvoiceStatusJSON400Response is a constructor method for a PutInvoiceStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutInvoiceStatusJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutInvoiceStatusJSON401Response is a constructor method for a PutInvoiceStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutInvoiceStatusJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutInvoiceStatusJSON403Response is a constructor method for a PutInvoiceStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutInvoiceStatusJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutInvoiceStatusJSON404Response is a constructor method for a PutInvoiceStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutInvoiceStatusJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutInvoiceStatusJSON409Response is a constructor method for a PutInvoiceStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutInvoiceStatusJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutInvoiceStatusJSON500Response is a constructor method for a PutInvoiceStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutInvoiceStatusJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateMaintenancePlanJSON201Response is a constructor method for a PostCreateMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateMaintenancePlanJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateMaintenancePlanJSON400Response is a constructor method for a PostCreateMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateMaintenancePlanJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateMaintenancePlanJSON401Response is a constructor method for a PostCreateMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateMaintenancePlanJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateMaintenancePlanJSON500Response is a constructor method for a PostCreateMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateMaintenancePlanJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteMaintenancePlanJSON204Response is a constructor method for a DeleteMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteMaintenancePlanJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteMaintenancePlanJSON401Response is a constructor method for a DeleteMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteMaintenancePlanJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteMaintenancePlanJSON404Response is a constructor method for a DeleteMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteMaintenancePlanJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteMaintenancePlanJSON500Response is a constructor method for a DeleteMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteMaintenancePlanJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListMaintenancePlansJSON200Response is a constructor method for a ListMaintenancePlans response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListMaintenancePlansJSON200Response(body ListaPlanosManutencao) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListMaintenancePlansJSON400Response is a constructor method for a ListMaintenancePlans response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListMaintenancePlansJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// ListMaintenancePlansJSON401Response is a constructor method for a ListMaintenancePlans response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListMaintenancePlansJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListMaintenancePlansJSON500Response is a constructor method for a ListMaintenancePlans response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListMaintenancePlansJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutMaintenancePlanJSON204Response is a constructor method for a PutMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMaintenancePlanJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutMaintenancePlanJSON400Response is a constructor method for a PutMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMaintenancePlanJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutMaintenancePlanJSON401Response is a constructor method for a PutMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMaintenancePlanJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutMaintenancePlanJSON404Response is a constructor method for a PutMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMaintenancePlanJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutMaintenancePlanJSON500Response is a constructor method for a PutMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMaintenancePlanJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetMaintenancePlanJSON200Response is a constructor method for a GetMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetMaintenancePlanJSON200Response(body PlanoManutencao) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetMaintenancePlanJSON401Response is a constructor method for a GetMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetMaintenancePlanJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetMaintenancePlanJSON404Response is a constructor method for a GetMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetMaintenancePlanJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetMaintenancePlanJSON500Response is a constructor method for a GetMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetMaintenancePlanJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostPauseMaintenancePlanJSON204Response is a constructor method for a PostPauseMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPauseMaintenancePlanJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostPauseMaintenancePlanJSON401Response is a constructor method for a PostPauseMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPauseMaintenancePlanJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostPauseMaintenancePlanJSON404Response is a constructor method for a PostPauseMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPauseMaintenancePlanJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostPauseMaintenancePlanJSON409Response is a constructor method for a PostPauseMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPauseMaintenancePlanJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostPauseMaintenancePlanJSON500Response is a constructor method for a PostPauseMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPauseMaintenancePlanJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostResumeMaintenancePlanJSON204Response is a constructor method for a PostResumeMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostResumeMaintenancePlanJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostResumeMaintenancePlanJSON401Response is a constructor method for a PostResumeMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostResumeMaintenancePlanJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostResumeMaintenancePlanJSON404Response is a constructor method for a PostResumeMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostResumeMaintenancePlanJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostResumeMaintenancePlanJSON409Response is a constructor method for a PostResumeMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostResumeMaintenancePlanJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostResumeMaintenancePlanJSON500Response is a constructor method for a PostResumeMaintenancePlan response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostResumeMaintenancePlanJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListMembersJSON200Response is a constructor method for a ListMembers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListMembersJSON200Response(body ListaUsuarios) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListMembersJSON400Response is a constructor method for a ListMembers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListMembersJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// ListMembersJSON401Response is a constructor method for a ListMembers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListMembersJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListMembersJSON404Response is a constructor method for a ListMembers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListMembersJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListMembersJSON500Response is a constructor method for a ListMembers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListMembersJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutMemberLocationJSON204Response is a constructor method for a PutMemberLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMemberLocationJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutMemberLocationJSON400Response is a constructor method for a PutMemberLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMemberLocationJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutMemberLocationJSON401Response is a constructor method for a PutMemberLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMemberLocationJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutMemberLocationJSON403Response is a constructor method for a PutMemberLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMemberLocationJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutMemberLocationJSON404Response is a constructor method for a PutMemberLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMemberLocationJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutMemberLocationJSON500Response is a constructor method for a PutMemberLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutMemberLocationJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListNotificationsJSON200Response is a constructor method for a ListNotifications response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListNotificationsJSON200Response(body ListaNotificacoes) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListNotificationsJSON401Response is a constructor method for a ListNotifications response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListNotificationsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListNotificationsJSON500Response is a constructor method for a ListNotifications response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListNotificationsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationsReadAllJSON204Response is a constructor method for a PostNotificationsReadAll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationsReadAllJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationsReadAllJSON401Response is a constructor method for a PostNotificationsReadAll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationsReadAllJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationsReadAllJSON500Response is a constructor method for a PostNotificationsReadAll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationsReadAllJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationReadJSON204Response is a constructor method for a PostNotificationRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationReadJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationReadJSON401Response is a constructor method for a PostNotificationRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationReadJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationReadJSON404Response is a constructor method for a PostNotificationRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationReadJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationReadJSON500Response is a constructor method for a PostNotificationRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationReadJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePartJSON201Response is a constructor method for a PostCreatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePartJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePartJSON400Response is a constructor method for a PostCreatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePartJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePartJSON401Response is a constructor method for a PostCreatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePartJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePartJSON403Response is a constructor method for a PostCreatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePartJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePartJSON409Response is a constructor method for a PostCreatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePartJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePartJSON500Response is a constructor method for a PostCreatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePartJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListPartsJSON200Response is a constructor method for a ListParts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPartsJSON200Response(body ListaPecas) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListPartsJSON401Response is a constructor method for a ListParts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPartsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListPartsJSON500Response is a constructor method for a ListParts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPartsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdatePartJSON204Response is a constructor method for a PutUpdatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdatePartJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdatePartJSON400Response is a constructor method for a PutUpdatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdatePartJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdatePartJSON401Response is a constructor method for a PutUpdatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdatePartJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdatePartJSON403Response is a constructor method for a PutUpdatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdatePartJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdatePartJSON404Response is a constructor method for a PutUpdatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdatePartJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdatePartJSON409Response is a constructor method for a PutUpdatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdatePartJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdatePartJSON500Response is a constructor method for a PutUpdatePart response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdatePartJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalRequestsJSON200Response is a constructor method for a ListPortalRequests response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalRequestsJSON200Response(body ListaSolicitacoesPortal) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalRequestsJSON401Response is a constructor method for a ListPortalRequests response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalRequestsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalRequestsJSON500Response is a constructor method for a ListPortalRequests response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalRequestsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutReviewPortalRequestJSON204Response is a constructor method for a PutReviewPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutReviewPortalRequestJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutReviewPortalRequestJSON400Response is a constructor method for a PutReviewPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutReviewPortalRequestJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutReviewPortalRequestJSON401Response is a constructor method for a PutReviewPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutReviewPortalRequestJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutReviewPortalRequestJSON404Response is a constructor method for a PutReviewPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutReviewPortalRequestJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutReviewPortalRequestJSON409Response is a constructor method for a PutReviewPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutReviewPortalRequestJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutReviewPortalRequestJSON500Response is a constructor method for a PutReviewPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutReviewPortalRequestJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePortalUserJSON201Response is a constructor method for a PostCreatePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePortalUserJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePortalUserJSON400Response is a constructor method for a PostCreatePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePortalUserJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePortalUserJSON401Response is a constructor method for a PostCreatePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePortalUserJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePortalUserJSON403Response is a constructor method for a PostCreatePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePortalUserJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePortalUserJSON404Response is a constructor method for a PostCreatePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePortalUserJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePortalUserJSON409Response is a constructor method for a PostCreatePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePortalUserJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostCreatePortalUserJSON500Response is a constructor method for a PostCreatePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreatePortalUserJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeletePortalUserJSON204Response is a constructor method for a DeletePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeletePortalUserJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeletePortalUserJSON401Response is a constructor method for a DeletePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeletePortalUserJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeletePortalUserJSON403Response is a constructor method for a DeletePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeletePortalUserJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeletePortalUserJSON404Response is a constructor method for a DeletePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeletePortalUserJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeletePortalUserJSON500Response is a constructor method for a DeletePortalUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeletePortalUserJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalUsersJSON200Response is a constructor method for a ListPortalUsers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalUsersJSON200Response(body ListaUsuariosPortal) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalUsersJSON401Response is a constructor method for a ListPortalUsers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalUsersJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalUsersJSON500Response is a constructor method for a ListPortalUsers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalUsersJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalFormsJSON200Response is a constructor method for a ListPortalForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalFormsJSON200Response(body ListaAtendimentosPortal) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalFormsJSON401Response is a constructor method for a ListPortalForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalFormsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalFormsJSON500Response is a constructor method for a ListPortalForms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalFormsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetPortalFormJSON200Response is a constructor method for a GetPortalForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPortalFormJSON200Response(body BuscaAtendimentoPortal) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetPortalFormJSON401Response is a constructor method for a GetPortalForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPortalFormJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetPortalFormJSON404Response is a constructor method for a GetPortalForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPortalFormJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetPortalFormJSON500Response is a constructor method for a GetPortalForm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPortalFormJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalFormCommentsJSON200Response is a constructor method for a ListPortalFormComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalFormCommentsJSON200Response(body ListaComentarios) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalFormCommentsJSON401Response is a constructor method for a ListPortalFormComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalFormCommentsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalFormCommentsJSON404Response is a constructor method for a ListPortalFormComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalFormCommentsJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalFormCommentsJSON500Response is a constructor method for a ListPortalFormComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalFormCommentsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostPortalLoginJSON200Response is a constructor method for a PostPortalLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPortalLoginJSON200Response(body LoginRes) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostPortalLoginJSON400Response is a constructor method for a PostPortalLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPortalLoginJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostPortalLoginJSON401Response is a constructor method for a PostPortalLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPortalLoginJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostPortalLoginJSON500Response is a constructor method for a PostPortalLogin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPortalLoginJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetPortalAccountJSON200Response is a constructor method for a GetPortalAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPortalAccountJSON200Response(body ContaPortal) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetPortalAccountJSON401Response is a constructor method for a GetPortalAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPortalAccountJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetPortalAccountJSON500Response is a constructor method for a GetPortalAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPortalAccountJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetPortalFormsReportJSON401Response is a constructor method for a GetPortalFormsReport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPortalFormsReportJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetPortalFormsReportJSON500Response is a constructor method for a GetPortalFormsReport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPortalFormsReportJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostPortalRequestJSON201Response is a constructor method for a PostPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPortalRequestJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostPortalRequestJSON400Response is a constructor method for a PostPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPortalRequestJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostPortalRequestJSON401Response is a constructor method for a PostPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPortalRequestJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostPortalRequestJSON500Response is a constructor method for a PostPortalRequest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPortalRequestJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalOwnRequestsJSON200Response is a constructor method for a ListPortalOwnRequests response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalOwnRequestsJSON200Response(body ListaSolicitacoesPortal) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalOwnRequestsJSON401Response is a constructor method for a ListPortalOwnRequests response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalOwnRequestsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListPortalOwnRequestsJSON500Response is a constructor method for a ListPortalOwnRequests response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListPortalOwnRequestsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetBillingSettingsJSON200Response is a constructor method for a GetBillingSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetBillingSettingsJSON200Response(body ConfiguracaoCobranca) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetBillingSettingsJSON401Response is a constructor method for a GetBillingSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetBillingSettingsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetBillingSettingsJSON500Response is a constructor method for a GetBillingSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetBillingSettingsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutBillingSettingsJSON200Response is a constructor method for a PutBillingSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutBillingSettingsJSON200Response(body ConfiguracaoCobranca) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutBillingSettingsJSON400Response is a constructor method for a PutBillingSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutBillingSettingsJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutBillingSettingsJSON401Response is a constructor method for a PutBillingSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutBillingSettingsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutBillingSettingsJSON403Response is a constructor method for a PutBillingSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutBillingSettingsJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutBillingSettingsJSON500Response is a constructor method for a PutBillingSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutBillingSettingsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetServiceOrderTemplateJSON200Response is a constructor method for a GetServiceOrderTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetServiceOrderTemplateJSON200Response(body ModeloOrdemServico) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetServiceOrderTemplateJSON401Response is a constructor method for a GetServiceOrderTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetServiceOrderTemplateJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetServiceOrderTemplateJSON500Response is a constructor method for a GetServiceOrderTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetServiceOrderTemplateJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderTemplateJSON200Response is a constructor method for a PutServiceOrderTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderTemplateJSON200Response(body ModeloOrdemServico) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderTemplateJSON400Response is a constructor method for a PutServiceOrderTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderTemplateJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderTemplateJSON401Response is a constructor method for a PutServiceOrderTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderTemplateJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderTemplateJSON403Response is a constructor method for a PutServiceOrderTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderTemplateJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderTemplateJSON500Response is a constructor method for a PutServiceOrderTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderTemplateJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteServiceOrderLogoJSON200Response is a constructor method for a DeleteServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteServiceOrderLogoJSON200Response(body ModeloOrdemServico) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// DeleteServiceOrderLogoJSON401Response is a constructor method for a DeleteServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteServiceOrderLogoJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteServiceOrderLogoJSON403Response is a constructor method for a DeleteServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteServiceOrderLogoJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteServiceOrderLogoJSON500Response is a constructor method for a DeleteServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteServiceOrderLogoJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderLogoJSON200Response is a constructor method for a PutServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderLogoJSON200Response(body ModeloOrdemServico) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderLogoJSON400Response is a constructor method for a PutServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderLogoJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderLogoJSON401Response is a constructor method for a PutServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderLogoJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderLogoJSON403Response is a constructor method for a PutServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderLogoJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderLogoJSON413Response is a constructor method for a PutServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderLogoJSON413Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        413,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderLogoJSON415Response is a constructor method for a PutServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderLogoJSON415Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        415,
+		contentType: "application/json",
+	}
+}
+
+// PutServiceOrderLogoJSON500Response is a constructor method for a PutServiceOrderLogo response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutServiceOrderLogoJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListHolidaysJSON200Response is a constructor method for a ListHolidays response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListHolidaysJSON200Response(body ListaFeriados) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListHolidaysJSON400Response is a constructor method for a ListHolidays response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListHolidaysJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// ListHolidaysJSON401Response is a constructor method for a ListHolidays response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListHolidaysJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListHolidaysJSON500Response is a constructor method for a ListHolidays response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListHolidaysJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostHolidayJSON201Response is a constructor method for a PostHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostHolidayJSON201Response(body Feriado) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostHolidayJSON400Response is a constructor method for a PostHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostHolidayJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostHolidayJSON401Response is a constructor method for a PostHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostHolidayJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostHolidayJSON403Response is a constructor method for a PostHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostHolidayJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostHolidayJSON409Response is a constructor method for a PostHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostHolidayJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostHolidayJSON500Response is a constructor method for a PostHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostHolidayJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteHolidayJSON204Response is a constructor method for a DeleteHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteHolidayJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteHolidayJSON400Response is a constructor method for a DeleteHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteHolidayJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteHolidayJSON401Response is a constructor method for a DeleteHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteHolidayJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteHolidayJSON403Response is a constructor method for a DeleteHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteHolidayJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteHolidayJSON404Response is a constructor method for a DeleteHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteHolidayJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteHolidayJSON500Response is a constructor method for a DeleteHoliday response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteHolidayJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListSLAPoliciesJSON200Response is a constructor method for a ListSLAPolicies response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSLAPoliciesJSON200Response(body ListaPoliticasSLA) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListSLAPoliciesJSON401Response is a constructor method for a ListSLAPolicies response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSLAPoliciesJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListSLAPoliciesJSON500Response is a constructor method for a ListSLAPolicies response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListSLAPoliciesJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutSLAPolicyJSON200Response is a constructor method for a PutSLAPolicy response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutSLAPolicyJSON200Response(body PoliticaSLA) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutSLAPolicyJSON400Response is a constructor method for a PutSLAPolicy response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutSLAPolicyJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutSLAPolicyJSON401Response is a constructor method for a PutSLAPolicy response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutSLAPolicyJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutSLAPolicyJSON403Response is a constructor method for a PutSLAPolicy response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutSLAPolicyJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutSLAPolicyJSON500Response is a constructor method for a PutSLAPolicy response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutSLAPolicyJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateStockLocationJSON201Response is a constructor method for a PostCreateStockLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateStockLocationJSON201Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateStockLocationJSON400Response is a constructor method for a PostCreateStockLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateStockLocationJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateStockLocationJSON401Response is a constructor method for a PostCreateStockLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateStockLocationJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateStockLocationJSON403Response is a constructor method for a PostCreateStockLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateStockLocationJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateStockLocationJSON409Response is a constructor method for a PostCreateStockLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateStockLocationJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateStockLocationJSON500Response is a constructor method for a PostCreateStockLocation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateStockLocationJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListStockLocationsJSON200Response is a constructor method for a ListStockLocations response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockLocationsJSON200Response(body ListaLocaisEstoque) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListStockLocationsJSON401Response is a constructor method for a ListStockLocations response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockLocationsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListStockLocationsJSON500Response is a constructor method for a ListStockLocations response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockLocationsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListStockLevelsJSON200Response is a constructor method for a ListStockLevels response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockLevelsJSON200Response(body ListaSaldosEstoque) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListStockLevelsJSON400Response is a constructor method for a ListStockLevels response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockLevelsJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// ListStockLevelsJSON401Response is a constructor method for a ListStockLevels response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockLevelsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListStockLevelsJSON500Response is a constructor method for a ListStockLevels response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockLevelsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetLowStockReportJSON200Response is a constructor method for a GetLowStockReport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetLowStockReportJSON200Response(body RelatorioEstoqueBaixo) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetLowStockReportJSON401Response is a constructor method for a GetLowStockReport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetLowStockReportJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetLowStockReportJSON500Response is a constructor method for a GetLowStockReport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetLowStockReportJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ListStockMovementsJSON200Response is a constructor method for a ListStockMovements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockMovementsJSON200Response(body ListaMovimentacoesEstoque) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// ListStockMovementsJSON400Response is a constructor method for a ListStockMovements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockMovementsJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// ListStockMovementsJSON401Response is a constructor method for a ListStockMovements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockMovementsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// ListStockMovementsJSON500Response is a constructor method for a ListStockMovements response.
+// A *Response is returned with the configured status code and content type from the spec.
+func ListStockMovementsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostStockMovementJSON201Response is a constructor method for a PostStockMovement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStockMovementJSON201Response(body MovimentacaoLancada) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostStockMovementJSON400Response is a constructor method for a PostStockMovement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStockMovementJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostStockMovementJSON401Response is a constructor method for a PostStockMovement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStockMovementJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostStockMovementJSON403Response is a constructor method for a PostStockMovement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStockMovementJSON403Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostStockMovementJSON404Response is a constructor method for a PostStockMovement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStockMovementJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostStockMovementJSON409Response is a constructor method for a PostStockMovement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStockMovementJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostStockMovementJSON500Response is a constructor method for a PostStockMovement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostStockMovementJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetPublicSurveyJSON200Response is a constructor method for a GetPublicSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPublicSurveyJSON200Response(body PesquisaPublica) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetPublicSurveyJSON404Response is a constructor method for a GetPublicSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPublicSurveyJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetPublicSurveyJSON410Response is a constructor method for a GetPublicSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPublicSurveyJSON410Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        410,
+		contentType: "application/json",
+	}
+}
+
+// GetPublicSurveyJSON500Response is a constructor method for a GetPublicSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetPublicSurveyJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostAnswerSurveyJSON204Response is a constructor method for a PostAnswerSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAnswerSurveyJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostAnswerSurveyJSON400Response is a constructor method for a PostAnswerSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAnswerSurveyJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAnswerSurveyJSON404Response is a constructor method for a PostAnswerSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAnswerSurveyJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostAnswerSurveyJSON409Response is a constructor method for a PostAnswerSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAnswerSurveyJSON409Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostAnswerSurveyJSON410Response is a constructor method for a PostAnswerSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAnswerSurveyJSON410Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        410,
+		contentType: "application/json",
+	}
+}
+
+// PostAnswerSurveyJSON500Response is a constructor method for a PostAnswerSurvey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAnswerSurveyJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetSurveySummaryJSON200Response is a constructor method for a GetSurveySummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSurveySummaryJSON200Response(body ResumoPesquisas) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetSurveySummaryJSON400Response is a constructor method for a GetSurveySummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSurveySummaryJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetSurveySummaryJSON401Response is a constructor method for a GetSurveySummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSurveySummaryJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetSurveySummaryJSON500Response is a constructor method for a GetSurveySummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSurveySummaryJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTeamCalendarJSON200Response is a constructor method for a GetTeamCalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTeamCalendarJSON200Response(body AgendaTecnicos) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTeamCalendarJSON400Response is a constructor method for a GetTeamCalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTeamCalendarJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTeamCalendarJSON401Response is a constructor method for a GetTeamCalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTeamCalendarJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTeamCalendarJSON500Response is a constructor method for a GetTeamCalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTeamCalendarJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianCalendarJSON200Response is a constructor method for a GetTechnicianCalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianCalendarJSON200Response(body AgendaTecnicos) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianCalendarJSON400Response is a constructor method for a GetTechnicianCalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianCalendarJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianCalendarJSON401Response is a constructor method for a GetTechnicianCalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianCalendarJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianCalendarJSON500Response is a constructor method for a GetTechnicianCalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianCalendarJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianNextFreeSlotJSON200Response is a constructor method for a GetTechnicianNextFreeSlot response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianNextFreeSlotJSON200Response(body HorarioLivre) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianNextFreeSlotJSON400Response is a constructor method for a GetTechnicianNextFreeSlot response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianNextFreeSlotJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianNextFreeSlotJSON401Response is a constructor method for a GetTechnicianNextFreeSlot response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianNextFreeSlotJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianNextFreeSlotJSON404Response is a constructor method for a GetTechnicianNextFreeSlot response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianNextFreeSlotJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianNextFreeSlotJSON500Response is a constructor method for a GetTechnicianNextFreeSlot response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianNextFreeSlotJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianRouteJSON200Response is a constructor method for a GetTechnicianRoute response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianRouteJSON200Response(body RotaTecnico) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianRouteJSON400Response is a constructor method for a GetTechnicianRoute response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianRouteJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianRouteJSON401Response is a constructor method for a GetTechnicianRoute response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianRouteJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTechnicianRouteJSON500Response is a constructor method for a GetTechnicianRoute response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTechnicianRouteJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateUserJSON200Response is a constructor method for a PostCreateUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateUserJSON200Response(body Resp200) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateUserJSON400Response is a constructor method for a PostCreateUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateUserJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateUserJSON422Response is a constructor method for a PostCreateUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateUserJSON422Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostCreateUserJSON500Response is a constructor method for a PostCreateUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostCreateUserJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteUserAccountJSON204Response is a constructor method for a DeleteUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteUserAccountJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteUserAccountJSON400Response is a constructor method for a DeleteUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteUserAccountJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteUserAccountJSON401Response is a constructor method for a DeleteUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteUserAccountJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteUserAccountJSON404Response is a constructor method for a DeleteUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteUserAccountJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteUserAccountJSON500Response is a constructor method for a DeleteUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteUserAccountJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetUserAccountJSON200Response is a constructor method for a GetUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetUserAccountJSON200Response(body BuscaUsuario) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetUserAccountJSON400Response is a constructor method for a GetUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetUserAccountJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetUserAccountJSON401Response is a constructor method for a GetUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetUserAccountJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetUserAccountJSON404Response is a constructor method for a GetUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetUserAccountJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetUserAccountJSON500Response is a constructor method for a GetUserAccount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetUserAccountJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostLoginUserJSON200Response is a constructor method for a PostLoginUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostLoginUserJSON200Response(body LoginRes) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostLoginUserJSON400Response is a constructor method for a PostLoginUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostLoginUserJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostLoginUserJSON500Response is a constructor method for a PostLoginUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostLoginUserJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateUserJSON204Response is a constructor method for a PutUpdateUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateUserJSON204Response(body Resp204) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateUserJSON400Response is a constructor method for a PutUpdateUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateUserJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateUserJSON401Response is a constructor method for a PutUpdateUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateUserJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateUserJSON404Response is a constructor method for a PutUpdateUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateUserJSON404Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutUpdateUserJSON500Response is a constructor method for a PutUpdateUser response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutUpdateUserJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetWorkLogTotalsJSON200Response is a constructor method for a GetWorkLogTotals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetWorkLogTotalsJSON200Response(body TotaisApontamentos) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetWorkLogTotalsJSON400Response is a constructor method for a GetWorkLogTotals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetWorkLogTotalsJSON400Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetWorkLogTotalsJSON401Response is a constructor method for a GetWorkLogTotals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetWorkLogTotalsJSON401Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetWorkLogTotalsJSON500Response is a constructor method for a GetWorkLogTotals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetWorkLogTotalsJSON500Response(body ErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// Getter for additional properties for CamposPersonalizados. Returns the specified
+// element and whether it was found
+func (a CamposPersonalizados) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CamposPersonalizados
+func (a *CamposPersonalizados) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CamposPersonalizados to handle AdditionalProperties
+func (a *CamposPersonalizados) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CamposPersonalizados to handle AdditionalProperties
+func (a CamposPersonalizados) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for DadosFormulario. Returns the specified
+// element and whether it was found
+func (a DadosFormulario) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for DadosFormulario
+func (a *DadosFormulario) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for DadosFormulario to handle AdditionalProperties
+func (a *DadosFormulario) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for DadosFormulario to handle AdditionalProperties
+func (a DadosFormulario) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Create checklist template
+	// (POST /v1/checklist-templates/create)
+	PostCreateChecklistTemplate(w http.ResponseWriter, r *http.Request) *Response
+	// Delete checklist template
+	// (DELETE /v1/checklist-templates/delete/{templateID})
+	DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request, templateID string) *Response
+	// List checklist templates
+	// (GET /v1/checklist-templates/list)
+	ListChecklistTemplates(w http.ResponseWriter, r *http.Request) *Response
+	// Update checklist template
+	// (PUT /v1/checklist-templates/update/{templateID})
+	PutChecklistTemplate(w http.ResponseWriter, r *http.Request, templateID string) *Response
+	// Get checklist template
+	// (GET /v1/checklist-templates/{templateID})
+	GetChecklistTemplate(w http.ResponseWriter, r *http.Request, templateID string) *Response
+	// Create client
+	// (POST /v1/clients/create)
+	PostCreateClient(w http.ResponseWriter, r *http.Request) *Response
+	// Delete client
+	// (DELETE /v1/clients/delete/{clientID})
+	DeleteClient(w http.ResponseWriter, r *http.Request, clientID string) *Response
+	// List duplicate clients
+	// (GET /v1/clients/duplicates)
+	ListDuplicateClients(w http.ResponseWriter, r *http.Request) *Response
+	// Get all clients
+	// (GET /v1/clients/list)
+	GetV1clientsList(w http.ResponseWriter, r *http.Request, params GetV1clientsListParams) *Response
+	// Merge clients
+	// (POST /v1/clients/merge)
+	MergeClients(w http.ResponseWriter, r *http.Request) *Response
+	// Purge client
+	// (DELETE /v1/clients/purge/{clientID})
+	PurgeClient(w http.ResponseWriter, r *http.Request, clientID string) *Response
+	// Restore client
+	// (POST /v1/clients/restore/{clientID})
+	RestoreClient(w http.ResponseWriter, r *http.Request, clientID string) *Response
+	// List deleted clients
+	// (GET /v1/clients/trash)
+	ListDeletedClients(w http.ResponseWriter, r *http.Request) *Response
+	// Update client
+	// (PUT /v1/clients/update/{clientID})
 	PutClient(w http.ResponseWriter, r *http.Request, clientID string) *Response
 	// Get client by ID
 	// (GET /v1/clients/{clientID})
 	GetByIDClient(w http.ResponseWriter, r *http.Request, clientID string) *Response
+	// List client merges
+	// (GET /v1/clients/{clientID}/merges)
+	ListClientMerges(w http.ResponseWriter, r *http.Request, clientID string) *Response
+	// List contract alerts
+	// (GET /v1/contracts/alerts)
+	ListContractAlerts(w http.ResponseWriter, r *http.Request) *Response
+	// Create contract
+	// (POST /v1/contracts/create)
+	PostCreateContract(w http.ResponseWriter, r *http.Request) *Response
+	// Delete contract
+	// (DELETE /v1/contracts/delete/{contractID})
+	DeleteContract(w http.ResponseWriter, r *http.Request, contractID string) *Response
+	// List contracts
+	// (GET /v1/contracts/list)
+	ListContracts(w http.ResponseWriter, r *http.Request, params ListContractsParams) *Response
+	// Update contract
+	// (PUT /v1/contracts/update/{contractID})
+	PutContract(w http.ResponseWriter, r *http.Request, contractID string) *Response
+	// Get contract
+	// (GET /v1/contracts/{contractID})
+	GetContractByID(w http.ResponseWriter, r *http.Request, contractID string) *Response
+	// Get contract usage
+	// (GET /v1/contracts/{contractID}/usage)
+	GetContractUsage(w http.ResponseWriter, r *http.Request, contractID string) *Response
+	// Create custom field
+	// (POST /v1/custom-fields/create)
+	PostCreateCustomField(w http.ResponseWriter, r *http.Request) *Response
+	// Delete custom field
+	// (DELETE /v1/custom-fields/delete/{fieldID})
+	DeleteCustomField(w http.ResponseWriter, r *http.Request, fieldID string) *Response
+	// List custom fields
+	// (GET /v1/custom-fields/list)
+	ListCustomFields(w http.ResponseWriter, r *http.Request, params ListCustomFieldsParams) *Response
+	// Update custom field
+	// (PUT /v1/custom-fields/update/{fieldID})
+	PutCustomField(w http.ResponseWriter, r *http.Request, fieldID string) *Response
+	// Dispatch suggestions
+	// (GET /v1/dispatch/suggestions)
+	GetDispatchSuggestions(w http.ResponseWriter, r *http.Request, params GetDispatchSuggestionsParams) *Response
+	// Create form type
+	// (POST /v1/form-types/create)
+	PostCreateFormType(w http.ResponseWriter, r *http.Request) *Response
+	// List form types
+	// (GET /v1/form-types/list)
+	ListFormTypes(w http.ResponseWriter, r *http.Request) *Response
+	// Update form type
+	// (PUT /v1/form-types/update/{formTypeID})
+	PutFormType(w http.ResponseWriter, r *http.Request, formTypeID string) *Response
+	// Get form type
+	// (GET /v1/form-types/{formTypeID})
+	GetFormType(w http.ResponseWriter, r *http.Request, formTypeID string) *Response
+	// List form type versions
+	// (GET /v1/form-types/{formTypeID}/versions)
+	ListFormTypeVersions(w http.ResponseWriter, r *http.Request, formTypeID string) *Response
+	// Publish form type version
+	// (POST /v1/form-types/{formTypeID}/versions)
+	PostFormTypeVersion(w http.ResponseWriter, r *http.Request, formTypeID string) *Response
+	// Get form type version
+	// (GET /v1/form-types/{formTypeID}/versions/{version})
+	GetFormTypeVersion(w http.ResponseWriter, r *http.Request, formTypeID string, version int) *Response
+	// Get form by protocol code
+	// (GET /v1/forms/by-code/{code})
+	GetFormByCode(w http.ResponseWriter, r *http.Request, code string) *Response
 	// Form client
 	// (POST /v1/forms/create)
 	PostCreateForm(w http.ResponseWriter, r *http.Request) *Response
@@ -1321,16 +11489,286 @@ type ServerInterface interface {
 	DeleteForm(w http.ResponseWriter, r *http.Request, formID string) *Response
 	// List forms
 	// (GET /v1/forms/list)
-	ListForms(w http.ResponseWriter, r *http.Request) *Response
+	ListForms(w http.ResponseWriter, r *http.Request, params ListFormsParams) *Response
+	// Purge form
+	// (DELETE /v1/forms/purge/{formID})
+	PurgeForm(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Restore form
+	// (POST /v1/forms/restore/{formID})
+	RestoreForm(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Search forms
+	// (GET /v1/forms/search)
+	SearchForms(w http.ResponseWriter, r *http.Request, params SearchFormsParams) *Response
+	// List similar forms by description
+	// (GET /v1/forms/similar)
+	ListSimilarFormsByDescription(w http.ResponseWriter, r *http.Request, params ListSimilarFormsByDescriptionParams) *Response
+	// List form status history
+	// (GET /v1/forms/status-history/{formID})
+	ListFormStatusHistory(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Change form status
+	// (POST /v1/forms/status/{formID})
+	PostFormStatus(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// List deleted forms
+	// (GET /v1/forms/trash)
+	ListDeletedForms(w http.ResponseWriter, r *http.Request) *Response
 	// Update form
 	// (PUT /v1/forms/update/{formID})
 	PutForm(w http.ResponseWriter, r *http.Request, formID string) *Response
 	// Get forms
 	// (GET /v1/forms/{formID})
 	GetFormByID(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// List form attachments
+	// (GET /v1/forms/{formID}/attachments)
+	ListFormAttachments(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Upload form attachment
+	// (POST /v1/forms/{formID}/attachments)
+	PostFormAttachment(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Delete form attachment
+	// (DELETE /v1/forms/{formID}/attachments/{attachmentID})
+	DeleteFormAttachment(w http.ResponseWriter, r *http.Request, formID string, attachmentID string) *Response
+	// List form checklist
+	// (GET /v1/forms/{formID}/checklist)
+	ListFormChecklist(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Answer checklist item
+	// (PUT /v1/forms/{formID}/checklist/{itemID})
+	PutFormChecklistItem(w http.ResponseWriter, r *http.Request, formID string, itemID string) *Response
+	// List form comments
+	// (GET /v1/forms/{formID}/comments)
+	ListFormComments(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Create form comment
+	// (POST /v1/forms/{formID}/comments)
+	PostFormComment(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Edit form comment
+	// (PUT /v1/forms/{formID}/comments/{commentID})
+	PutFormComment(w http.ResponseWriter, r *http.Request, formID string, commentID string) *Response
+	// List comment edits
+	// (GET /v1/forms/{formID}/comments/{commentID}/edits)
+	ListFormCommentEdits(w http.ResponseWriter, r *http.Request, formID string, commentID string) *Response
+	// List form parts
+	// (GET /v1/forms/{formID}/parts)
+	ListFormParts(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Add form part
+	// (POST /v1/forms/{formID}/parts)
+	PostFormPart(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Remove form part
+	// (DELETE /v1/forms/{formID}/parts/{formPartID})
+	DeleteFormPart(w http.ResponseWriter, r *http.Request, formID string, formPartID string) *Response
+	// Download service order PDF
+	// (GET /v1/forms/{formID}/pdf)
+	GetFormServiceOrderPdf(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Schedule form technicians
+	// (PUT /v1/forms/{formID}/schedule)
+	PutFormSchedule(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Get form signature
+	// (GET /v1/forms/{formID}/signature)
+	GetFormSignature(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Sign form
+	// (POST /v1/forms/{formID}/signature)
+	PostFormSignature(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// List similar forms
+	// (GET /v1/forms/{formID}/similar)
+	ListSimilarForms(w http.ResponseWriter, r *http.Request, formID string, params ListSimilarFormsParams) *Response
+	// Unmark similar form as helpful
+	// (DELETE /v1/forms/{formID}/similar/{similarFormID}/helpful)
+	DeleteSimilarFormHelpful(w http.ResponseWriter, r *http.Request, formID string, similarFormID string) *Response
+	// Mark similar form as helpful
+	// (POST /v1/forms/{formID}/similar/{similarFormID}/helpful)
+	PostSimilarFormHelpful(w http.ResponseWriter, r *http.Request, formID string, similarFormID string) *Response
+	// Get form survey
+	// (GET /v1/forms/{formID}/survey)
+	GetFormSurvey(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Get form timeline
+	// (GET /v1/forms/{formID}/timeline)
+	GetFormTimeline(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// List form work logs
+	// (GET /v1/forms/{formID}/work-logs)
+	ListFormWorkLogs(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Create manual work log
+	// (POST /v1/forms/{formID}/work-logs)
+	PostFormWorkLog(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Start work log timer
+	// (POST /v1/forms/{formID}/work-logs/start)
+	PostStartFormWorkLog(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Delete work log
+	// (DELETE /v1/forms/{formID}/work-logs/{workLogID})
+	DeleteFormWorkLog(w http.ResponseWriter, r *http.Request, formID string, workLogID string) *Response
+	// Stop work log timer
+	// (POST /v1/forms/{formID}/work-logs/{workLogID}/stop)
+	PostStopFormWorkLog(w http.ResponseWriter, r *http.Request, formID string, workLogID string) *Response
+	// Create invoice
+	// (POST /v1/invoices/create)
+	PostCreateInvoice(w http.ResponseWriter, r *http.Request) *Response
+	// List invoices
+	// (GET /v1/invoices/list)
+	ListInvoices(w http.ResponseWriter, r *http.Request, params ListInvoicesParams) *Response
+	// Get invoice
+	// (GET /v1/invoices/{invoiceID})
+	GetInvoice(w http.ResponseWriter, r *http.Request, invoiceID string) *Response
+	// Update invoice
+	// (PUT /v1/invoices/{invoiceID})
+	PutUpdateInvoice(w http.ResponseWriter, r *http.Request, invoiceID string) *Response
+	// Download invoice PDF
+	// (GET /v1/invoices/{invoiceID}/pdf)
+	GetInvoicePdf(w http.ResponseWriter, r *http.Request, invoiceID string) *Response
+	// Change invoice status
+	// (PUT /v1/invoices/{invoiceID}/status)
+	PutInvoiceStatus(w http.ResponseWriter, r *http.Request, invoiceID string) *Response
+	// Create maintenance plan
+	// (POST /v1/maintenance-plans/create)
+	PostCreateMaintenancePlan(w http.ResponseWriter, r *http.Request) *Response
+	// Delete maintenance plan
+	// (DELETE /v1/maintenance-plans/delete/{planID})
+	DeleteMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *Response
+	// List maintenance plans
+	// (GET /v1/maintenance-plans/list)
+	ListMaintenancePlans(w http.ResponseWriter, r *http.Request, params ListMaintenancePlansParams) *Response
+	// Update maintenance plan
+	// (PUT /v1/maintenance-plans/update/{planID})
+	PutMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *Response
+	// Get maintenance plan
+	// (GET /v1/maintenance-plans/{planID})
+	GetMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *Response
+	// Pause maintenance plan
+	// (POST /v1/maintenance-plans/{planID}/pause)
+	PostPauseMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *Response
+	// Resume maintenance plan
+	// (POST /v1/maintenance-plans/{planID}/resume)
+	PostResumeMaintenancePlan(w http.ResponseWriter, r *http.Request, planID string) *Response
 	// Get members
 	// (GET /v1/members/list)
 	ListMembers(w http.ResponseWriter, r *http.Request) *Response
+	// Update member location
+	// (PUT /v1/members/{memberID}/location)
+	PutMemberLocation(w http.ResponseWriter, r *http.Request, memberID string) *Response
+	// List notifications
+	// (GET /v1/notifications)
+	ListNotifications(w http.ResponseWriter, r *http.Request, params ListNotificationsParams) *Response
+	// Mark all notifications as read
+	// (POST /v1/notifications/read-all)
+	PostNotificationsReadAll(w http.ResponseWriter, r *http.Request) *Response
+	// Mark notification as read
+	// (POST /v1/notifications/{notificationID}/read)
+	PostNotificationRead(w http.ResponseWriter, r *http.Request, notificationID string) *Response
+	// Create part
+	// (POST /v1/parts/create)
+	PostCreatePart(w http.ResponseWriter, r *http.Request) *Response
+	// List parts
+	// (GET /v1/parts/list)
+	ListParts(w http.ResponseWriter, r *http.Request, params ListPartsParams) *Response
+	// Update part
+	// (PUT /v1/parts/update/{partID})
+	PutUpdatePart(w http.ResponseWriter, r *http.Request, partID string) *Response
+	// List portal requests
+	// (GET /v1/portal-requests/list)
+	ListPortalRequests(w http.ResponseWriter, r *http.Request, params ListPortalRequestsParams) *Response
+	// Review portal request
+	// (PUT /v1/portal-requests/review/{requestID})
+	PutReviewPortalRequest(w http.ResponseWriter, r *http.Request, requestID string) *Response
+	// Create portal user
+	// (POST /v1/portal-users/create)
+	PostCreatePortalUser(w http.ResponseWriter, r *http.Request) *Response
+	// Delete portal user
+	// (DELETE /v1/portal-users/delete/{portalUserID})
+	DeletePortalUser(w http.ResponseWriter, r *http.Request, portalUserID string) *Response
+	// List portal users
+	// (GET /v1/portal-users/list)
+	ListPortalUsers(w http.ResponseWriter, r *http.Request, params ListPortalUsersParams) *Response
+	// List own forms
+	// (GET /v1/portal/forms/list)
+	ListPortalForms(w http.ResponseWriter, r *http.Request) *Response
+	// Get own form
+	// (GET /v1/portal/forms/{formID})
+	GetPortalForm(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// List own form comments
+	// (GET /v1/portal/forms/{formID}/comments)
+	ListPortalFormComments(w http.ResponseWriter, r *http.Request, formID string) *Response
+	// Portal login
+	// (POST /v1/portal/login)
+	PostPortalLogin(w http.ResponseWriter, r *http.Request) *Response
+	// Portal account
+	// (GET /v1/portal/me)
+	GetPortalAccount(w http.ResponseWriter, r *http.Request) *Response
+	// Download forms report
+	// (GET /v1/portal/reports/forms)
+	GetPortalFormsReport(w http.ResponseWriter, r *http.Request) *Response
+	// Open portal request
+	// (POST /v1/portal/requests/create)
+	PostPortalRequest(w http.ResponseWriter, r *http.Request) *Response
+	// List own portal requests
+	// (GET /v1/portal/requests/list)
+	ListPortalOwnRequests(w http.ResponseWriter, r *http.Request) *Response
+	// Get billing settings
+	// (GET /v1/settings/billing)
+	GetBillingSettings(w http.ResponseWriter, r *http.Request) *Response
+	// Update billing settings
+	// (PUT /v1/settings/billing)
+	PutBillingSettings(w http.ResponseWriter, r *http.Request) *Response
+	// Get service order template
+	// (GET /v1/settings/service-order)
+	GetServiceOrderTemplate(w http.ResponseWriter, r *http.Request) *Response
+	// Update service order template
+	// (PUT /v1/settings/service-order)
+	PutServiceOrderTemplate(w http.ResponseWriter, r *http.Request) *Response
+	// Delete service order logo
+	// (DELETE /v1/settings/service-order/logo)
+	DeleteServiceOrderLogo(w http.ResponseWriter, r *http.Request) *Response
+	// Upload service order logo
+	// (PUT /v1/settings/service-order/logo)
+	PutServiceOrderLogo(w http.ResponseWriter, r *http.Request) *Response
+	// List holidays
+	// (GET /v1/sla/holidays)
+	ListHolidays(w http.ResponseWriter, r *http.Request, params ListHolidaysParams) *Response
+	// Create holiday
+	// (POST /v1/sla/holidays)
+	PostHoliday(w http.ResponseWriter, r *http.Request) *Response
+	// Delete holiday
+	// (DELETE /v1/sla/holidays/{date})
+	DeleteHoliday(w http.ResponseWriter, r *http.Request, date openapi_types.Date) *Response
+	// List SLA policies
+	// (GET /v1/sla/policies)
+	ListSLAPolicies(w http.ResponseWriter, r *http.Request) *Response
+	// Update SLA policy
+	// (PUT /v1/sla/policies)
+	PutSLAPolicy(w http.ResponseWriter, r *http.Request) *Response
+	// Create stock location
+	// (POST /v1/stock-locations/create)
+	PostCreateStockLocation(w http.ResponseWriter, r *http.Request) *Response
+	// List stock locations
+	// (GET /v1/stock-locations/list)
+	ListStockLocations(w http.ResponseWriter, r *http.Request) *Response
+	// List stock levels
+	// (GET /v1/stock/levels)
+	ListStockLevels(w http.ResponseWriter, r *http.Request, params ListStockLevelsParams) *Response
+	// Low stock report
+	// (GET /v1/stock/low)
+	GetLowStockReport(w http.ResponseWriter, r *http.Request) *Response
+	// List stock movements
+	// (GET /v1/stock/movements)
+	ListStockMovements(w http.ResponseWriter, r *http.Request, params ListStockMovementsParams) *Response
+	// Create stock movement
+	// (POST /v1/stock/movements)
+	PostStockMovement(w http.ResponseWriter, r *http.Request) *Response
+	// Get survey
+	// (GET /v1/surveys/respond/{token})
+	GetPublicSurvey(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Answer survey
+	// (POST /v1/surveys/respond/{token})
+	PostAnswerSurvey(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Survey summary
+	// (GET /v1/surveys/summary)
+	GetSurveySummary(w http.ResponseWriter, r *http.Request, params GetSurveySummaryParams) *Response
+	// Get team calendar
+	// (GET /v1/technicians/calendar)
+	GetTeamCalendar(w http.ResponseWriter, r *http.Request, params GetTeamCalendarParams) *Response
+	// Get technician calendar
+	// (GET /v1/technicians/{memberID}/calendar)
+	GetTechnicianCalendar(w http.ResponseWriter, r *http.Request, memberID string, params GetTechnicianCalendarParams) *Response
+	// Find technician next free slot
+	// (GET /v1/technicians/{memberID}/next-free-slot)
+	GetTechnicianNextFreeSlot(w http.ResponseWriter, r *http.Request, memberID string, params GetTechnicianNextFreeSlotParams) *Response
+	// Get technician daily route
+	// (GET /v1/technicians/{memberID}/route)
+	GetTechnicianRoute(w http.ResponseWriter, r *http.Request, memberID string, params GetTechnicianRouteParams) *Response
 	// Create a new user
 	// (POST /v1/users/create)
 	PostCreateUser(w http.ResponseWriter, r *http.Request) *Response
@@ -1346,22 +11784,3458 @@ type ServerInterface interface {
 	// Update user
 	// (PUT /v1/users/update)
 	PutUpdateUser(w http.ResponseWriter, r *http.Request) *Response
+	// Work log totals
+	// (GET /v1/work-logs/totals)
+	GetWorkLogTotals(w http.ResponseWriter, r *http.Request, params GetWorkLogTotalsParams) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// PostCreateChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreateChecklistTemplate(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID string
+
+	if err := runtime.BindStyledParameter("simple", false, "templateID", chi.URLParam(r, "templateID"), &templateID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "templateID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteChecklistTemplate(w, r, templateID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListChecklistTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListChecklistTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListChecklistTemplates(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) PutChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID string
+
+	if err := runtime.BindStyledParameter("simple", false, "templateID", chi.URLParam(r, "templateID"), &templateID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "templateID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutChecklistTemplate(w, r, templateID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID string
+
+	if err := runtime.BindStyledParameter("simple", false, "templateID", chi.URLParam(r, "templateID"), &templateID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "templateID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetChecklistTemplate(w, r, templateID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCreateClient operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreateClient(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteClient operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID string
+
+	if err := runtime.BindStyledParameter("simple", false, "clientID", chi.URLParam(r, "clientID"), &clientID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "clientID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteClient(w, r, clientID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListDuplicateClients operation middleware
+func (siw *ServerInterfaceWrapper) ListDuplicateClients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListDuplicateClients(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetV1clientsList operation middleware
+func (siw *ServerInterfaceWrapper) GetV1clientsList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1clientsListParams
+
+	// ------------- Optional query parameter "campo" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "campo", r.URL.Query(), &params.Campo); err != nil {
+		err = fmt.Errorf("invalid format for parameter campo: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "campo"})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag); err != nil {
+		err = fmt.Errorf("invalid format for parameter tag: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tag"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetV1clientsList(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// MergeClients operation middleware
+func (siw *ServerInterfaceWrapper) MergeClients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.MergeClients(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PurgeClient operation middleware
+func (siw *ServerInterfaceWrapper) PurgeClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID string
+
+	if err := runtime.BindStyledParameter("simple", false, "clientID", chi.URLParam(r, "clientID"), &clientID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "clientID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PurgeClient(w, r, clientID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RestoreClient operation middleware
+func (siw *ServerInterfaceWrapper) RestoreClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID string
+
+	if err := runtime.BindStyledParameter("simple", false, "clientID", chi.URLParam(r, "clientID"), &clientID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "clientID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.RestoreClient(w, r, clientID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListDeletedClients operation middleware
+func (siw *ServerInterfaceWrapper) ListDeletedClients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListDeletedClients(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutClient operation middleware
+func (siw *ServerInterfaceWrapper) PutClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID string
+
+	if err := runtime.BindStyledParameter("simple", false, "clientID", chi.URLParam(r, "clientID"), &clientID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "clientID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutClient(w, r, clientID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetByIDClient operation middleware
+func (siw *ServerInterfaceWrapper) GetByIDClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID string
+
+	if err := runtime.BindStyledParameter("simple", false, "clientID", chi.URLParam(r, "clientID"), &clientID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "clientID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetByIDClient(w, r, clientID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListClientMerges operation middleware
+func (siw *ServerInterfaceWrapper) ListClientMerges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "clientID" -------------
+	var clientID string
+
+	if err := runtime.BindStyledParameter("simple", false, "clientID", chi.URLParam(r, "clientID"), &clientID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "clientID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListClientMerges(w, r, clientID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListContractAlerts operation middleware
+func (siw *ServerInterfaceWrapper) ListContractAlerts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListContractAlerts(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCreateContract operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateContract(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreateContract(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteContract operation middleware
+func (siw *ServerInterfaceWrapper) DeleteContract(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "contractID" -------------
+	var contractID string
+
+	if err := runtime.BindStyledParameter("simple", false, "contractID", chi.URLParam(r, "contractID"), &contractID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "contractID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteContract(w, r, contractID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListContracts operation middleware
+func (siw *ServerInterfaceWrapper) ListContracts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListContractsParams
+
+	// ------------- Optional query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cliente_id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListContracts(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutContract operation middleware
+func (siw *ServerInterfaceWrapper) PutContract(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "contractID" -------------
+	var contractID string
+
+	if err := runtime.BindStyledParameter("simple", false, "contractID", chi.URLParam(r, "contractID"), &contractID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "contractID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutContract(w, r, contractID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetContractByID operation middleware
+func (siw *ServerInterfaceWrapper) GetContractByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "contractID" -------------
+	var contractID string
+
+	if err := runtime.BindStyledParameter("simple", false, "contractID", chi.URLParam(r, "contractID"), &contractID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "contractID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetContractByID(w, r, contractID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetContractUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetContractUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "contractID" -------------
+	var contractID string
+
+	if err := runtime.BindStyledParameter("simple", false, "contractID", chi.URLParam(r, "contractID"), &contractID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "contractID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetContractUsage(w, r, contractID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCreateCustomField operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateCustomField(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreateCustomField(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteCustomField operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomField(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "fieldID" -------------
+	var fieldID string
+
+	if err := runtime.BindStyledParameter("simple", false, "fieldID", chi.URLParam(r, "fieldID"), &fieldID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fieldID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteCustomField(w, r, fieldID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListCustomFields operation middleware
+func (siw *ServerInterfaceWrapper) ListCustomFields(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCustomFieldsParams
+
+	// ------------- Optional query parameter "entidade" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "entidade", r.URL.Query(), &params.Entidade); err != nil {
+		err = fmt.Errorf("invalid format for parameter entidade: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "entidade"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListCustomFields(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutCustomField operation middleware
+func (siw *ServerInterfaceWrapper) PutCustomField(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "fieldID" -------------
+	var fieldID string
+
+	if err := runtime.BindStyledParameter("simple", false, "fieldID", chi.URLParam(r, "fieldID"), &fieldID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fieldID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutCustomField(w, r, fieldID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetDispatchSuggestions operation middleware
+func (siw *ServerInterfaceWrapper) GetDispatchSuggestions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDispatchSuggestionsParams
+
+	// ------------- Required query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "cliente_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "limite" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limite", r.URL.Query(), &params.Limite); err != nil {
+		err = fmt.Errorf("invalid format for parameter limite: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limite"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetDispatchSuggestions(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCreateFormType operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateFormType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreateFormType(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListFormTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListFormTypes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListFormTypes(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutFormType operation middleware
+func (siw *ServerInterfaceWrapper) PutFormType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formTypeID" -------------
+	var formTypeID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formTypeID", chi.URLParam(r, "formTypeID"), &formTypeID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formTypeID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutFormType(w, r, formTypeID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFormType operation middleware
+func (siw *ServerInterfaceWrapper) GetFormType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formTypeID" -------------
+	var formTypeID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formTypeID", chi.URLParam(r, "formTypeID"), &formTypeID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formTypeID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetFormType(w, r, formTypeID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListFormTypeVersions operation middleware
+func (siw *ServerInterfaceWrapper) ListFormTypeVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formTypeID" -------------
+	var formTypeID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formTypeID", chi.URLParam(r, "formTypeID"), &formTypeID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formTypeID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListFormTypeVersions(w, r, formTypeID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostFormTypeVersion operation middleware
+func (siw *ServerInterfaceWrapper) PostFormTypeVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formTypeID" -------------
+	var formTypeID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formTypeID", chi.URLParam(r, "formTypeID"), &formTypeID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formTypeID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostFormTypeVersion(w, r, formTypeID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFormTypeVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetFormTypeVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formTypeID" -------------
+	var formTypeID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formTypeID", chi.URLParam(r, "formTypeID"), &formTypeID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formTypeID"})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int
+
+	if err := runtime.BindStyledParameter("simple", false, "version", chi.URLParam(r, "version"), &version); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "version"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetFormTypeVersion(w, r, formTypeID, version)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFormByCode operation middleware
+func (siw *ServerInterfaceWrapper) GetFormByCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	if err := runtime.BindStyledParameter("simple", false, "code", chi.URLParam(r, "code"), &code); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "code"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetFormByCode(w, r, code)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCreateForm operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreateForm(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteForm operation middleware
+func (siw *ServerInterfaceWrapper) DeleteForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteForm(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListForms operation middleware
+func (siw *ServerInterfaceWrapper) ListForms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFormsParams
+
+	// ------------- Optional query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cliente_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "tecnico_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tecnico_id", r.URL.Query(), &params.TecnicoID); err != nil {
+		err = fmt.Errorf("invalid format for parameter tecnico_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tecnico_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "nivel_dificuldade" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "nivel_dificuldade", r.URL.Query(), &params.NivelDificuldade); err != nil {
+		err = fmt.Errorf("invalid format for parameter nivel_dificuldade: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "nivel_dificuldade"})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status); err != nil {
+		err = fmt.Errorf("invalid format for parameter status: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "status"})
+		return
+	}
+
+	// ------------- Optional query parameter "ocorrido_de" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ocorrido_de", r.URL.Query(), &params.OcorridoDe); err != nil {
+		err = fmt.Errorf("invalid format for parameter ocorrido_de: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ocorrido_de"})
+		return
+	}
+
+	// ------------- Optional query parameter "ocorrido_ate" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ocorrido_ate", r.URL.Query(), &params.OcorridoAte); err != nil {
+		err = fmt.Errorf("invalid format for parameter ocorrido_ate: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ocorrido_ate"})
+		return
+	}
+
+	// ------------- Optional query parameter "protocolo" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "protocolo", r.URL.Query(), &params.Protocolo); err != nil {
+		err = fmt.Errorf("invalid format for parameter protocolo: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "protocolo"})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	// ------------- Optional query parameter "limite" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limite", r.URL.Query(), &params.Limite); err != nil {
+		err = fmt.Errorf("invalid format for parameter limite: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limite"})
+		return
+	}
+
+	// ------------- Optional query parameter "campo" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "campo", r.URL.Query(), &params.Campo); err != nil {
+		err = fmt.Errorf("invalid format for parameter campo: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "campo"})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag); err != nil {
+		err = fmt.Errorf("invalid format for parameter tag: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tag"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListForms(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PurgeForm operation middleware
+func (siw *ServerInterfaceWrapper) PurgeForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PurgeForm(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RestoreForm operation middleware
+func (siw *ServerInterfaceWrapper) RestoreForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.RestoreForm(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SearchForms operation middleware
+func (siw *ServerInterfaceWrapper) SearchForms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchFormsParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q); err != nil {
+		err = fmt.Errorf("invalid format for parameter q: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "q"})
+		return
+	}
+
+	// ------------- Optional query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cliente_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "tecnico_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tecnico_id", r.URL.Query(), &params.TecnicoID); err != nil {
+		err = fmt.Errorf("invalid format for parameter tecnico_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tecnico_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "nivel_dificuldade" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "nivel_dificuldade", r.URL.Query(), &params.NivelDificuldade); err != nil {
+		err = fmt.Errorf("invalid format for parameter nivel_dificuldade: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "nivel_dificuldade"})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status); err != nil {
+		err = fmt.Errorf("invalid format for parameter status: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "status"})
+		return
+	}
+
+	// ------------- Optional query parameter "ocorrido_de" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ocorrido_de", r.URL.Query(), &params.OcorridoDe); err != nil {
+		err = fmt.Errorf("invalid format for parameter ocorrido_de: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ocorrido_de"})
+		return
+	}
+
+	// ------------- Optional query parameter "ocorrido_ate" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ocorrido_ate", r.URL.Query(), &params.OcorridoAte); err != nil {
+		err = fmt.Errorf("invalid format for parameter ocorrido_ate: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ocorrido_ate"})
+		return
+	}
+
+	// ------------- Optional query parameter "protocolo" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "protocolo", r.URL.Query(), &params.Protocolo); err != nil {
+		err = fmt.Errorf("invalid format for parameter protocolo: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "protocolo"})
+		return
+	}
+
+	// ------------- Optional query parameter "pagina" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "pagina", r.URL.Query(), &params.Pagina); err != nil {
+		err = fmt.Errorf("invalid format for parameter pagina: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "pagina"})
+		return
+	}
+
+	// ------------- Optional query parameter "limite" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limite", r.URL.Query(), &params.Limite); err != nil {
+		err = fmt.Errorf("invalid format for parameter limite: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limite"})
+		return
+	}
+
+	// ------------- Optional query parameter "campo" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "campo", r.URL.Query(), &params.Campo); err != nil {
+		err = fmt.Errorf("invalid format for parameter campo: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "campo"})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag); err != nil {
+		err = fmt.Errorf("invalid format for parameter tag: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tag"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.SearchForms(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListSimilarFormsByDescription operation middleware
+func (siw *ServerInterfaceWrapper) ListSimilarFormsByDescription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSimilarFormsByDescriptionParams
+
+	// ------------- Required query parameter "descricao" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "descricao", r.URL.Query(), &params.Descricao); err != nil {
+		err = fmt.Errorf("invalid format for parameter descricao: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "descricao"})
+		return
+	}
+
+	// ------------- Optional query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cliente_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "limite" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limite", r.URL.Query(), &params.Limite); err != nil {
+		err = fmt.Errorf("invalid format for parameter limite: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limite"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListSimilarFormsByDescription(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListFormStatusHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListFormStatusHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListFormStatusHistory(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostFormStatus operation middleware
+func (siw *ServerInterfaceWrapper) PostFormStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostFormStatus(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListDeletedForms operation middleware
+func (siw *ServerInterfaceWrapper) ListDeletedForms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListDeletedForms(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutForm operation middleware
+func (siw *ServerInterfaceWrapper) PutForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutForm(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFormByID operation middleware
+func (siw *ServerInterfaceWrapper) GetFormByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetFormByID(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListFormAttachments operation middleware
+func (siw *ServerInterfaceWrapper) ListFormAttachments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListFormAttachments(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostFormAttachment operation middleware
+func (siw *ServerInterfaceWrapper) PostFormAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostFormAttachment(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteFormAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFormAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	// ------------- Path parameter "attachmentID" -------------
+	var attachmentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "attachmentID", chi.URLParam(r, "attachmentID"), &attachmentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "attachmentID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteFormAttachment(w, r, formID, attachmentID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListFormChecklist operation middleware
+func (siw *ServerInterfaceWrapper) ListFormChecklist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListFormChecklist(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutFormChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) PutFormChecklistItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemID", chi.URLParam(r, "itemID"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutFormChecklistItem(w, r, formID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListFormComments operation middleware
+func (siw *ServerInterfaceWrapper) ListFormComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListFormComments(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostFormComment operation middleware
+func (siw *ServerInterfaceWrapper) PostFormComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostFormComment(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutFormComment operation middleware
+func (siw *ServerInterfaceWrapper) PutFormComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	// ------------- Path parameter "commentID" -------------
+	var commentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "commentID", chi.URLParam(r, "commentID"), &commentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "commentID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutFormComment(w, r, formID, commentID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListFormCommentEdits operation middleware
+func (siw *ServerInterfaceWrapper) ListFormCommentEdits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	// ------------- Path parameter "commentID" -------------
+	var commentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "commentID", chi.URLParam(r, "commentID"), &commentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "commentID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListFormCommentEdits(w, r, formID, commentID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListFormParts operation middleware
+func (siw *ServerInterfaceWrapper) ListFormParts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListFormParts(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostFormPart operation middleware
+func (siw *ServerInterfaceWrapper) PostFormPart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostFormPart(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteFormPart operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFormPart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	// ------------- Path parameter "formPartID" -------------
+	var formPartID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formPartID", chi.URLParam(r, "formPartID"), &formPartID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formPartID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteFormPart(w, r, formID, formPartID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFormServiceOrderPdf operation middleware
+func (siw *ServerInterfaceWrapper) GetFormServiceOrderPdf(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetFormServiceOrderPdf(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutFormSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PutFormSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutFormSchedule(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFormSignature operation middleware
+func (siw *ServerInterfaceWrapper) GetFormSignature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetFormSignature(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostFormSignature operation middleware
+func (siw *ServerInterfaceWrapper) PostFormSignature(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostFormSignature(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListSimilarForms operation middleware
+func (siw *ServerInterfaceWrapper) ListSimilarForms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSimilarFormsParams
+
+	// ------------- Optional query parameter "limite" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limite", r.URL.Query(), &params.Limite); err != nil {
+		err = fmt.Errorf("invalid format for parameter limite: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limite"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListSimilarForms(w, r, formID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteSimilarFormHelpful operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSimilarFormHelpful(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	// ------------- Path parameter "similarFormID" -------------
+	var similarFormID string
+
+	if err := runtime.BindStyledParameter("simple", false, "similarFormID", chi.URLParam(r, "similarFormID"), &similarFormID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "similarFormID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteSimilarFormHelpful(w, r, formID, similarFormID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostSimilarFormHelpful operation middleware
+func (siw *ServerInterfaceWrapper) PostSimilarFormHelpful(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	// ------------- Path parameter "similarFormID" -------------
+	var similarFormID string
+
+	if err := runtime.BindStyledParameter("simple", false, "similarFormID", chi.URLParam(r, "similarFormID"), &similarFormID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "similarFormID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostSimilarFormHelpful(w, r, formID, similarFormID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFormSurvey operation middleware
+func (siw *ServerInterfaceWrapper) GetFormSurvey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetFormSurvey(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFormTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetFormTimeline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetFormTimeline(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListFormWorkLogs operation middleware
+func (siw *ServerInterfaceWrapper) ListFormWorkLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListFormWorkLogs(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostFormWorkLog operation middleware
+func (siw *ServerInterfaceWrapper) PostFormWorkLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostFormWorkLog(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostStartFormWorkLog operation middleware
+func (siw *ServerInterfaceWrapper) PostStartFormWorkLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostStartFormWorkLog(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteFormWorkLog operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFormWorkLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	// ------------- Path parameter "workLogID" -------------
+	var workLogID string
+
+	if err := runtime.BindStyledParameter("simple", false, "workLogID", chi.URLParam(r, "workLogID"), &workLogID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "workLogID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteFormWorkLog(w, r, formID, workLogID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostStopFormWorkLog operation middleware
+func (siw *ServerInterfaceWrapper) PostStopFormWorkLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	// ------------- Path parameter "workLogID" -------------
+	var workLogID string
+
+	if err := runtime.BindStyledParameter("simple", false, "workLogID", chi.URLParam(r, "workLogID"), &workLogID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "workLogID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostStopFormWorkLog(w, r, formID, workLogID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCreateInvoice operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreateInvoice(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListInvoices operation middleware
+func (siw *ServerInterfaceWrapper) ListInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInvoicesParams
+
+	// ------------- Optional query parameter "situacao" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "situacao", r.URL.Query(), &params.Situacao); err != nil {
+		err = fmt.Errorf("invalid format for parameter situacao: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "situacao"})
+		return
+	}
+
+	// ------------- Optional query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cliente_id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListInvoices(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetInvoice operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "invoiceID" -------------
+	var invoiceID string
+
+	if err := runtime.BindStyledParameter("simple", false, "invoiceID", chi.URLParam(r, "invoiceID"), &invoiceID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "invoiceID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetInvoice(w, r, invoiceID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutUpdateInvoice operation middleware
+func (siw *ServerInterfaceWrapper) PutUpdateInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "invoiceID" -------------
+	var invoiceID string
+
+	if err := runtime.BindStyledParameter("simple", false, "invoiceID", chi.URLParam(r, "invoiceID"), &invoiceID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "invoiceID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutUpdateInvoice(w, r, invoiceID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetInvoicePdf operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoicePdf(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "invoiceID" -------------
+	var invoiceID string
+
+	if err := runtime.BindStyledParameter("simple", false, "invoiceID", chi.URLParam(r, "invoiceID"), &invoiceID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "invoiceID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetInvoicePdf(w, r, invoiceID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutInvoiceStatus operation middleware
+func (siw *ServerInterfaceWrapper) PutInvoiceStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "invoiceID" -------------
+	var invoiceID string
+
+	if err := runtime.BindStyledParameter("simple", false, "invoiceID", chi.URLParam(r, "invoiceID"), &invoiceID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "invoiceID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutInvoiceStatus(w, r, invoiceID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCreateMaintenancePlan operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreateMaintenancePlan(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteMaintenancePlan operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "planID" -------------
+	var planID string
+
+	if err := runtime.BindStyledParameter("simple", false, "planID", chi.URLParam(r, "planID"), &planID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "planID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteMaintenancePlan(w, r, planID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListMaintenancePlans operation middleware
+func (siw *ServerInterfaceWrapper) ListMaintenancePlans(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMaintenancePlansParams
+
+	// ------------- Optional query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cliente_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "situacao" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "situacao", r.URL.Query(), &params.Situacao); err != nil {
+		err = fmt.Errorf("invalid format for parameter situacao: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "situacao"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListMaintenancePlans(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutMaintenancePlan operation middleware
+func (siw *ServerInterfaceWrapper) PutMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "planID" -------------
+	var planID string
+
+	if err := runtime.BindStyledParameter("simple", false, "planID", chi.URLParam(r, "planID"), &planID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "planID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutMaintenancePlan(w, r, planID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetMaintenancePlan operation middleware
+func (siw *ServerInterfaceWrapper) GetMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "planID" -------------
+	var planID string
+
+	if err := runtime.BindStyledParameter("simple", false, "planID", chi.URLParam(r, "planID"), &planID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "planID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetMaintenancePlan(w, r, planID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostPauseMaintenancePlan operation middleware
+func (siw *ServerInterfaceWrapper) PostPauseMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "planID" -------------
+	var planID string
+
+	if err := runtime.BindStyledParameter("simple", false, "planID", chi.URLParam(r, "planID"), &planID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "planID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostPauseMaintenancePlan(w, r, planID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostResumeMaintenancePlan operation middleware
+func (siw *ServerInterfaceWrapper) PostResumeMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "planID" -------------
+	var planID string
+
+	if err := runtime.BindStyledParameter("simple", false, "planID", chi.URLParam(r, "planID"), &planID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "planID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostResumeMaintenancePlan(w, r, planID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListMembers(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutMemberLocation operation middleware
+func (siw *ServerInterfaceWrapper) PutMemberLocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID string
+
+	if err := runtime.BindStyledParameter("simple", false, "memberID", chi.URLParam(r, "memberID"), &memberID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "memberID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutMemberLocation(w, r, memberID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListNotifications operation middleware
+func (siw *ServerInterfaceWrapper) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNotificationsParams
+
+	// ------------- Optional query parameter "nao_lidas" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "nao_lidas", r.URL.Query(), &params.NaoLidas); err != nil {
+		err = fmt.Errorf("invalid format for parameter nao_lidas: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "nao_lidas"})
+		return
+	}
+
+	// ------------- Optional query parameter "limite" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limite", r.URL.Query(), &params.Limite); err != nil {
+		err = fmt.Errorf("invalid format for parameter limite: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limite"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListNotifications(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostNotificationsReadAll operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationsReadAll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostNotificationsReadAll(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostNotificationRead operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "notificationID" -------------
+	var notificationID string
+
+	if err := runtime.BindStyledParameter("simple", false, "notificationID", chi.URLParam(r, "notificationID"), &notificationID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "notificationID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostNotificationRead(w, r, notificationID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCreatePart operation middleware
+func (siw *ServerInterfaceWrapper) PostCreatePart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreatePart(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListParts operation middleware
+func (siw *ServerInterfaceWrapper) ListParts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPartsParams
+
+	// ------------- Optional query parameter "busca" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "busca", r.URL.Query(), &params.Busca); err != nil {
+		err = fmt.Errorf("invalid format for parameter busca: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "busca"})
+		return
+	}
+
+	// ------------- Optional query parameter "incluir_inativas" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "incluir_inativas", r.URL.Query(), &params.IncluirInativas); err != nil {
+		err = fmt.Errorf("invalid format for parameter incluir_inativas: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "incluir_inativas"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListParts(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutUpdatePart operation middleware
+func (siw *ServerInterfaceWrapper) PutUpdatePart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "partID" -------------
+	var partID string
+
+	if err := runtime.BindStyledParameter("simple", false, "partID", chi.URLParam(r, "partID"), &partID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "partID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutUpdatePart(w, r, partID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListPortalRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListPortalRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPortalRequestsParams
+
+	// ------------- Optional query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cliente_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status); err != nil {
+		err = fmt.Errorf("invalid format for parameter status: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "status"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListPortalRequests(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutReviewPortalRequest operation middleware
+func (siw *ServerInterfaceWrapper) PutReviewPortalRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "requestID" -------------
+	var requestID string
+
+	if err := runtime.BindStyledParameter("simple", false, "requestID", chi.URLParam(r, "requestID"), &requestID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "requestID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutReviewPortalRequest(w, r, requestID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostCreatePortalUser operation middleware
+func (siw *ServerInterfaceWrapper) PostCreatePortalUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCreatePortalUser(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeletePortalUser operation middleware
+func (siw *ServerInterfaceWrapper) DeletePortalUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "portalUserID" -------------
+	var portalUserID string
+
+	if err := runtime.BindStyledParameter("simple", false, "portalUserID", chi.URLParam(r, "portalUserID"), &portalUserID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "portalUserID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeletePortalUser(w, r, portalUserID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListPortalUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListPortalUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPortalUsersParams
+
+	// ------------- Required query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "cliente_id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListPortalUsers(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListPortalForms operation middleware
+func (siw *ServerInterfaceWrapper) ListPortalForms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListPortalForms(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPortalForm operation middleware
+func (siw *ServerInterfaceWrapper) GetPortalForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetPortalForm(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListPortalFormComments operation middleware
+func (siw *ServerInterfaceWrapper) ListPortalFormComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "formID" -------------
+	var formID string
+
+	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListPortalFormComments(w, r, formID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostPortalLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostPortalLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostPortalLogin(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPortalAccount operation middleware
+func (siw *ServerInterfaceWrapper) GetPortalAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetPortalAccount(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPortalFormsReport operation middleware
+func (siw *ServerInterfaceWrapper) GetPortalFormsReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetPortalFormsReport(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostPortalRequest operation middleware
+func (siw *ServerInterfaceWrapper) PostPortalRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostPortalRequest(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListPortalOwnRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListPortalOwnRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListPortalOwnRequests(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBillingSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetBillingSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetBillingSettings(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutBillingSettings operation middleware
+func (siw *ServerInterfaceWrapper) PutBillingSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutBillingSettings(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetServiceOrderTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetServiceOrderTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetServiceOrderTemplate(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutServiceOrderTemplate operation middleware
+func (siw *ServerInterfaceWrapper) PutServiceOrderTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutServiceOrderTemplate(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteServiceOrderLogo operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceOrderLogo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteServiceOrderLogo(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutServiceOrderLogo operation middleware
+func (siw *ServerInterfaceWrapper) PutServiceOrderLogo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutServiceOrderLogo(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListHolidays operation middleware
+func (siw *ServerInterfaceWrapper) ListHolidays(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListHolidaysParams
+
+	// ------------- Optional query parameter "a_partir_de" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "a_partir_de", r.URL.Query(), &params.APartirDe); err != nil {
+		err = fmt.Errorf("invalid format for parameter a_partir_de: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "a_partir_de"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListHolidays(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostHoliday operation middleware
+func (siw *ServerInterfaceWrapper) PostHoliday(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostHoliday(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteHoliday operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHoliday(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	if err := runtime.BindStyledParameter("simple", false, "date", chi.URLParam(r, "date"), &date); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "date"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteHoliday(w, r, date)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListSLAPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListSLAPolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListSLAPolicies(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// PutSLAPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PutSLAPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutSLAPolicy(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PostCreateClient operation middleware
-func (siw *ServerInterfaceWrapper) PostCreateClient(w http.ResponseWriter, r *http.Request) {
+// PostCreateStockLocation operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateStockLocation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostCreateClient(w, r)
+		resp := siw.Handler.PostCreateStockLocation(w, r)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1374,22 +15248,53 @@ func (siw *ServerInterfaceWrapper) PostCreateClient(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteClient operation middleware
-func (siw *ServerInterfaceWrapper) DeleteClient(w http.ResponseWriter, r *http.Request) {
+// ListStockLocations operation middleware
+func (siw *ServerInterfaceWrapper) ListStockLocations(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "clientID" -------------
-	var clientID string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-	if err := runtime.BindStyledParameter("simple", false, "clientID", chi.URLParam(r, "clientID"), &clientID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "clientID"})
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListStockLocations(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListStockLevels operation middleware
+func (siw *ServerInterfaceWrapper) ListStockLevels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStockLevelsParams
+
+	// ------------- Optional query parameter "local_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "local_id", r.URL.Query(), &params.LocalID); err != nil {
+		err = fmt.Errorf("invalid format for parameter local_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "local_id"})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+	// ------------- Optional query parameter "peca_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "peca_id", r.URL.Query(), &params.PecaID); err != nil {
+		err = fmt.Errorf("invalid format for parameter peca_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "peca_id"})
+		return
+	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.DeleteClient(w, r, clientID)
+		resp := siw.Handler.ListStockLevels(w, r, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1402,14 +15307,14 @@ func (siw *ServerInterfaceWrapper) DeleteClient(w http.ResponseWriter, r *http.R
 	handler(w, r.WithContext(ctx))
 }
 
-// GetV1clientsList operation middleware
-func (siw *ServerInterfaceWrapper) GetV1clientsList(w http.ResponseWriter, r *http.Request) {
+// GetLowStockReport operation middleware
+func (siw *ServerInterfaceWrapper) GetLowStockReport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetV1clientsList(w, r)
+		resp := siw.Handler.GetLowStockReport(w, r)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1422,22 +15327,69 @@ func (siw *ServerInterfaceWrapper) GetV1clientsList(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// PutClient operation middleware
-func (siw *ServerInterfaceWrapper) PutClient(w http.ResponseWriter, r *http.Request) {
+// ListStockMovements operation middleware
+func (siw *ServerInterfaceWrapper) ListStockMovements(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "clientID" -------------
-	var clientID string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-	if err := runtime.BindStyledParameter("simple", false, "clientID", chi.URLParam(r, "clientID"), &clientID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "clientID"})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStockMovementsParams
+
+	// ------------- Optional query parameter "peca_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "peca_id", r.URL.Query(), &params.PecaID); err != nil {
+		err = fmt.Errorf("invalid format for parameter peca_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "peca_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "local_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "local_id", r.URL.Query(), &params.LocalID); err != nil {
+		err = fmt.Errorf("invalid format for parameter local_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "local_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "atendimento_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "atendimento_id", r.URL.Query(), &params.AtendimentoID); err != nil {
+		err = fmt.Errorf("invalid format for parameter atendimento_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "atendimento_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "limite" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limite", r.URL.Query(), &params.Limite); err != nil {
+		err = fmt.Errorf("invalid format for parameter limite: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limite"})
 		return
 	}
 
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.ListStockMovements(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostStockMovement operation middleware
+func (siw *ServerInterfaceWrapper) PostStockMovement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutClient(w, r, clientID)
+		resp := siw.Handler.PostStockMovement(w, r)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1450,22 +15402,22 @@ func (siw *ServerInterfaceWrapper) PutClient(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetByIDClient operation middleware
-func (siw *ServerInterfaceWrapper) GetByIDClient(w http.ResponseWriter, r *http.Request) {
+// GetPublicSurvey operation middleware
+func (siw *ServerInterfaceWrapper) GetPublicSurvey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "clientID" -------------
-	var clientID string
+	// ------------- Path parameter "token" -------------
+	var token string
 
-	if err := runtime.BindStyledParameter("simple", false, "clientID", chi.URLParam(r, "clientID"), &clientID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "clientID"})
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetByIDClient(w, r, clientID)
+		resp := siw.Handler.GetPublicSurvey(w, r, token)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1478,14 +15430,22 @@ func (siw *ServerInterfaceWrapper) GetByIDClient(w http.ResponseWriter, r *http.
 	handler(w, r.WithContext(ctx))
 }
 
-// PostCreateForm operation middleware
-func (siw *ServerInterfaceWrapper) PostCreateForm(w http.ResponseWriter, r *http.Request) {
+// PostAnswerSurvey operation middleware
+func (siw *ServerInterfaceWrapper) PostAnswerSurvey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostCreateForm(w, r)
+		resp := siw.Handler.PostAnswerSurvey(w, r, token)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1498,22 +15458,65 @@ func (siw *ServerInterfaceWrapper) PostCreateForm(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteForm operation middleware
-func (siw *ServerInterfaceWrapper) DeleteForm(w http.ResponseWriter, r *http.Request) {
+// GetSurveySummary operation middleware
+func (siw *ServerInterfaceWrapper) GetSurveySummary(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "formID" -------------
-	var formID string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
-	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSurveySummaryParams
+
+	// ------------- Required query parameter "agrupar_por" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "agrupar_por", r.URL.Query(), &params.AgruparPor); err != nil {
+		err = fmt.Errorf("invalid format for parameter agrupar_por: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "agrupar_por"})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+	// ------------- Optional query parameter "granularidade" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "granularidade", r.URL.Query(), &params.Granularidade); err != nil {
+		err = fmt.Errorf("invalid format for parameter granularidade: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "granularidade"})
+		return
+	}
+
+	// ------------- Optional query parameter "de" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "de", r.URL.Query(), &params.De); err != nil {
+		err = fmt.Errorf("invalid format for parameter de: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "de"})
+		return
+	}
+
+	// ------------- Optional query parameter "ate" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ate", r.URL.Query(), &params.Ate); err != nil {
+		err = fmt.Errorf("invalid format for parameter ate: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ate"})
+		return
+	}
+
+	// ------------- Optional query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cliente_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "tecnico_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tecnico_id", r.URL.Query(), &params.TecnicoID); err != nil {
+		err = fmt.Errorf("invalid format for parameter tecnico_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tecnico_id"})
+		return
+	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.DeleteForm(w, r, formID)
+		resp := siw.Handler.GetSurveySummary(w, r, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1526,14 +15529,33 @@ func (siw *ServerInterfaceWrapper) DeleteForm(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
-// ListForms operation middleware
-func (siw *ServerInterfaceWrapper) ListForms(w http.ResponseWriter, r *http.Request) {
+// GetTeamCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamCalendar(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCalendarParams
+
+	// ------------- Required query parameter "inicio" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "inicio", r.URL.Query(), &params.Inicio); err != nil {
+		err = fmt.Errorf("invalid format for parameter inicio: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "inicio"})
+		return
+	}
+
+	// ------------- Required query parameter "fim" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "fim", r.URL.Query(), &params.Fim); err != nil {
+		err = fmt.Errorf("invalid format for parameter fim: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "fim"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.ListForms(w, r)
+		resp := siw.Handler.GetTeamCalendar(w, r, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1546,22 +15568,41 @@ func (siw *ServerInterfaceWrapper) ListForms(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// PutForm operation middleware
-func (siw *ServerInterfaceWrapper) PutForm(w http.ResponseWriter, r *http.Request) {
+// GetTechnicianCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetTechnicianCalendar(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "formID" -------------
-	var formID string
+	// ------------- Path parameter "memberID" -------------
+	var memberID string
 
-	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+	if err := runtime.BindStyledParameter("simple", false, "memberID", chi.URLParam(r, "memberID"), &memberID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "memberID"})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTechnicianCalendarParams
+
+	// ------------- Required query parameter "inicio" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "inicio", r.URL.Query(), &params.Inicio); err != nil {
+		err = fmt.Errorf("invalid format for parameter inicio: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "inicio"})
+		return
+	}
+
+	// ------------- Required query parameter "fim" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "fim", r.URL.Query(), &params.Fim); err != nil {
+		err = fmt.Errorf("invalid format for parameter fim: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "fim"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutForm(w, r, formID)
+		resp := siw.Handler.GetTechnicianCalendar(w, r, memberID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1574,22 +15615,41 @@ func (siw *ServerInterfaceWrapper) PutForm(w http.ResponseWriter, r *http.Reques
 	handler(w, r.WithContext(ctx))
 }
 
-// GetFormByID operation middleware
-func (siw *ServerInterfaceWrapper) GetFormByID(w http.ResponseWriter, r *http.Request) {
+// GetTechnicianNextFreeSlot operation middleware
+func (siw *ServerInterfaceWrapper) GetTechnicianNextFreeSlot(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "formID" -------------
-	var formID string
+	// ------------- Path parameter "memberID" -------------
+	var memberID string
 
-	if err := runtime.BindStyledParameter("simple", false, "formID", chi.URLParam(r, "formID"), &formID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "formID"})
+	if err := runtime.BindStyledParameter("simple", false, "memberID", chi.URLParam(r, "memberID"), &memberID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "memberID"})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTechnicianNextFreeSlotParams
+
+	// ------------- Required query parameter "duracao_minutos" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "duracao_minutos", r.URL.Query(), &params.DuracaoMinutos); err != nil {
+		err = fmt.Errorf("invalid format for parameter duracao_minutos: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "duracao_minutos"})
+		return
+	}
+
+	// ------------- Optional query parameter "a_partir_de" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "a_partir_de", r.URL.Query(), &params.APartirDe); err != nil {
+		err = fmt.Errorf("invalid format for parameter a_partir_de: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "a_partir_de"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetFormByID(w, r, formID)
+		resp := siw.Handler.GetTechnicianNextFreeSlot(w, r, memberID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1602,14 +15662,73 @@ func (siw *ServerInterfaceWrapper) GetFormByID(w http.ResponseWriter, r *http.Re
 	handler(w, r.WithContext(ctx))
 }
 
-// ListMembers operation middleware
-func (siw *ServerInterfaceWrapper) ListMembers(w http.ResponseWriter, r *http.Request) {
+// GetTechnicianRoute operation middleware
+func (siw *ServerInterfaceWrapper) GetTechnicianRoute(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "memberID" -------------
+	var memberID string
+
+	if err := runtime.BindStyledParameter("simple", false, "memberID", chi.URLParam(r, "memberID"), &memberID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "memberID"})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTechnicianRouteParams
+
+	// ------------- Required query parameter "date" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "date", r.URL.Query(), &params.Date); err != nil {
+		err = fmt.Errorf("invalid format for parameter date: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "date"})
+		return
+	}
+
+	// ------------- Optional query parameter "origem_latitude" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "origem_latitude", r.URL.Query(), &params.OrigemLatitude); err != nil {
+		err = fmt.Errorf("invalid format for parameter origem_latitude: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "origem_latitude"})
+		return
+	}
+
+	// ------------- Optional query parameter "origem_longitude" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "origem_longitude", r.URL.Query(), &params.OrigemLongitude); err != nil {
+		err = fmt.Errorf("invalid format for parameter origem_longitude: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "origem_longitude"})
+		return
+	}
+
+	// ------------- Optional query parameter "saida" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "saida", r.URL.Query(), &params.Saida); err != nil {
+		err = fmt.Errorf("invalid format for parameter saida: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "saida"})
+		return
+	}
+
+	// ------------- Optional query parameter "velocidade_kmh" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "velocidade_kmh", r.URL.Query(), &params.VelocidadeKmh); err != nil {
+		err = fmt.Errorf("invalid format for parameter velocidade_kmh: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "velocidade_kmh"})
+		return
+	}
+
+	// ------------- Optional query parameter "tempo_parada_minutos" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tempo_parada_minutos", r.URL.Query(), &params.TempoParadaMinutos); err != nil {
+		err = fmt.Errorf("invalid format for parameter tempo_parada_minutos: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tempo_parada_minutos"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.ListMembers(w, r)
+		resp := siw.Handler.GetTechnicianRoute(w, r, memberID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1722,6 +15841,69 @@ func (siw *ServerInterfaceWrapper) PutUpdateUser(w http.ResponseWriter, r *http.
 	handler(w, r.WithContext(ctx))
 }
 
+// GetWorkLogTotals operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkLogTotals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkLogTotalsParams
+
+	// ------------- Required query parameter "agrupar_por" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "agrupar_por", r.URL.Query(), &params.AgruparPor); err != nil {
+		err = fmt.Errorf("invalid format for parameter agrupar_por: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "agrupar_por"})
+		return
+	}
+
+	// ------------- Optional query parameter "de" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "de", r.URL.Query(), &params.De); err != nil {
+		err = fmt.Errorf("invalid format for parameter de: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "de"})
+		return
+	}
+
+	// ------------- Optional query parameter "ate" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ate", r.URL.Query(), &params.Ate); err != nil {
+		err = fmt.Errorf("invalid format for parameter ate: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ate"})
+		return
+	}
+
+	// ------------- Optional query parameter "cliente_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cliente_id", r.URL.Query(), &params.ClienteID); err != nil {
+		err = fmt.Errorf("invalid format for parameter cliente_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cliente_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "tecnico_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tecnico_id", r.URL.Query(), &params.TecnicoID); err != nil {
+		err = fmt.Errorf("invalid format for parameter tecnico_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tecnico_id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetWorkLogTotals(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	err       error
 	paramName string
@@ -1837,22 +16019,144 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Post("/v1/checklist-templates/create", wrapper.PostCreateChecklistTemplate)
+		r.Delete("/v1/checklist-templates/delete/{templateID}", wrapper.DeleteChecklistTemplate)
+		r.Get("/v1/checklist-templates/list", wrapper.ListChecklistTemplates)
+		r.Put("/v1/checklist-templates/update/{templateID}", wrapper.PutChecklistTemplate)
+		r.Get("/v1/checklist-templates/{templateID}", wrapper.GetChecklistTemplate)
 		r.Post("/v1/clients/create", wrapper.PostCreateClient)
 		r.Delete("/v1/clients/delete/{clientID}", wrapper.DeleteClient)
+		r.Get("/v1/clients/duplicates", wrapper.ListDuplicateClients)
 		r.Get("/v1/clients/list", wrapper.GetV1clientsList)
+		r.Post("/v1/clients/merge", wrapper.MergeClients)
+		r.Delete("/v1/clients/purge/{clientID}", wrapper.PurgeClient)
+		r.Post("/v1/clients/restore/{clientID}", wrapper.RestoreClient)
+		r.Get("/v1/clients/trash", wrapper.ListDeletedClients)
 		r.Put("/v1/clients/update/{clientID}", wrapper.PutClient)
 		r.Get("/v1/clients/{clientID}", wrapper.GetByIDClient)
+		r.Get("/v1/clients/{clientID}/merges", wrapper.ListClientMerges)
+		r.Get("/v1/contracts/alerts", wrapper.ListContractAlerts)
+		r.Post("/v1/contracts/create", wrapper.PostCreateContract)
+		r.Delete("/v1/contracts/delete/{contractID}", wrapper.DeleteContract)
+		r.Get("/v1/contracts/list", wrapper.ListContracts)
+		r.Put("/v1/contracts/update/{contractID}", wrapper.PutContract)
+		r.Get("/v1/contracts/{contractID}", wrapper.GetContractByID)
+		r.Get("/v1/contracts/{contractID}/usage", wrapper.GetContractUsage)
+		r.Post("/v1/custom-fields/create", wrapper.PostCreateCustomField)
+		r.Delete("/v1/custom-fields/delete/{fieldID}", wrapper.DeleteCustomField)
+		r.Get("/v1/custom-fields/list", wrapper.ListCustomFields)
+		r.Put("/v1/custom-fields/update/{fieldID}", wrapper.PutCustomField)
+		r.Get("/v1/dispatch/suggestions", wrapper.GetDispatchSuggestions)
+		r.Post("/v1/form-types/create", wrapper.PostCreateFormType)
+		r.Get("/v1/form-types/list", wrapper.ListFormTypes)
+		r.Put("/v1/form-types/update/{formTypeID}", wrapper.PutFormType)
+		r.Get("/v1/form-types/{formTypeID}", wrapper.GetFormType)
+		r.Get("/v1/form-types/{formTypeID}/versions", wrapper.ListFormTypeVersions)
+		r.Post("/v1/form-types/{formTypeID}/versions", wrapper.PostFormTypeVersion)
+		r.Get("/v1/form-types/{formTypeID}/versions/{version}", wrapper.GetFormTypeVersion)
+		r.Get("/v1/forms/by-code/{code}", wrapper.GetFormByCode)
 		r.Post("/v1/forms/create", wrapper.PostCreateForm)
 		r.Delete("/v1/forms/delete/{formID}", wrapper.DeleteForm)
 		r.Get("/v1/forms/list", wrapper.ListForms)
+		r.Delete("/v1/forms/purge/{formID}", wrapper.PurgeForm)
+		r.Post("/v1/forms/restore/{formID}", wrapper.RestoreForm)
+		r.Get("/v1/forms/search", wrapper.SearchForms)
+		r.Get("/v1/forms/similar", wrapper.ListSimilarFormsByDescription)
+		r.Get("/v1/forms/status-history/{formID}", wrapper.ListFormStatusHistory)
+		r.Post("/v1/forms/status/{formID}", wrapper.PostFormStatus)
+		r.Get("/v1/forms/trash", wrapper.ListDeletedForms)
 		r.Put("/v1/forms/update/{formID}", wrapper.PutForm)
 		r.Get("/v1/forms/{formID}", wrapper.GetFormByID)
+		r.Get("/v1/forms/{formID}/attachments", wrapper.ListFormAttachments)
+		r.Post("/v1/forms/{formID}/attachments", wrapper.PostFormAttachment)
+		r.Delete("/v1/forms/{formID}/attachments/{attachmentID}", wrapper.DeleteFormAttachment)
+		r.Get("/v1/forms/{formID}/checklist", wrapper.ListFormChecklist)
+		r.Put("/v1/forms/{formID}/checklist/{itemID}", wrapper.PutFormChecklistItem)
+		r.Get("/v1/forms/{formID}/comments", wrapper.ListFormComments)
+		r.Post("/v1/forms/{formID}/comments", wrapper.PostFormComment)
+		r.Put("/v1/forms/{formID}/comments/{commentID}", wrapper.PutFormComment)
+		r.Get("/v1/forms/{formID}/comments/{commentID}/edits", wrapper.ListFormCommentEdits)
+		r.Get("/v1/forms/{formID}/parts", wrapper.ListFormParts)
+		r.Post("/v1/forms/{formID}/parts", wrapper.PostFormPart)
+		r.Delete("/v1/forms/{formID}/parts/{formPartID}", wrapper.DeleteFormPart)
+		r.Get("/v1/forms/{formID}/pdf", wrapper.GetFormServiceOrderPdf)
+		r.Put("/v1/forms/{formID}/schedule", wrapper.PutFormSchedule)
+		r.Get("/v1/forms/{formID}/signature", wrapper.GetFormSignature)
+		r.Post("/v1/forms/{formID}/signature", wrapper.PostFormSignature)
+		r.Get("/v1/forms/{formID}/similar", wrapper.ListSimilarForms)
+		r.Delete("/v1/forms/{formID}/similar/{similarFormID}/helpful", wrapper.DeleteSimilarFormHelpful)
+		r.Post("/v1/forms/{formID}/similar/{similarFormID}/helpful", wrapper.PostSimilarFormHelpful)
+		r.Get("/v1/forms/{formID}/survey", wrapper.GetFormSurvey)
+		r.Get("/v1/forms/{formID}/timeline", wrapper.GetFormTimeline)
+		r.Get("/v1/forms/{formID}/work-logs", wrapper.ListFormWorkLogs)
+		r.Post("/v1/forms/{formID}/work-logs", wrapper.PostFormWorkLog)
+		r.Post("/v1/forms/{formID}/work-logs/start", wrapper.PostStartFormWorkLog)
+		r.Delete("/v1/forms/{formID}/work-logs/{workLogID}", wrapper.DeleteFormWorkLog)
+		r.Post("/v1/forms/{formID}/work-logs/{workLogID}/stop", wrapper.PostStopFormWorkLog)
+		r.Post("/v1/invoices/create", wrapper.PostCreateInvoice)
+		r.Get("/v1/invoices/list", wrapper.ListInvoices)
+		r.Get("/v1/invoices/{invoiceID}", wrapper.GetInvoice)
+		r.Put("/v1/invoices/{invoiceID}", wrapper.PutUpdateInvoice)
+		r.Get("/v1/invoices/{invoiceID}/pdf", wrapper.GetInvoicePdf)
+		r.Put("/v1/invoices/{invoiceID}/status", wrapper.PutInvoiceStatus)
+		r.Post("/v1/maintenance-plans/create", wrapper.PostCreateMaintenancePlan)
+		r.Delete("/v1/maintenance-plans/delete/{planID}", wrapper.DeleteMaintenancePlan)
+		r.Get("/v1/maintenance-plans/list", wrapper.ListMaintenancePlans)
+		r.Put("/v1/maintenance-plans/update/{planID}", wrapper.PutMaintenancePlan)
+		r.Get("/v1/maintenance-plans/{planID}", wrapper.GetMaintenancePlan)
+		r.Post("/v1/maintenance-plans/{planID}/pause", wrapper.PostPauseMaintenancePlan)
+		r.Post("/v1/maintenance-plans/{planID}/resume", wrapper.PostResumeMaintenancePlan)
 		r.Get("/v1/members/list", wrapper.ListMembers)
+		r.Put("/v1/members/{memberID}/location", wrapper.PutMemberLocation)
+		r.Get("/v1/notifications", wrapper.ListNotifications)
+		r.Post("/v1/notifications/read-all", wrapper.PostNotificationsReadAll)
+		r.Post("/v1/notifications/{notificationID}/read", wrapper.PostNotificationRead)
+		r.Post("/v1/parts/create", wrapper.PostCreatePart)
+		r.Get("/v1/parts/list", wrapper.ListParts)
+		r.Put("/v1/parts/update/{partID}", wrapper.PutUpdatePart)
+		r.Get("/v1/portal-requests/list", wrapper.ListPortalRequests)
+		r.Put("/v1/portal-requests/review/{requestID}", wrapper.PutReviewPortalRequest)
+		r.Post("/v1/portal-users/create", wrapper.PostCreatePortalUser)
+		r.Delete("/v1/portal-users/delete/{portalUserID}", wrapper.DeletePortalUser)
+		r.Get("/v1/portal-users/list", wrapper.ListPortalUsers)
+		r.Get("/v1/portal/forms/list", wrapper.ListPortalForms)
+		r.Get("/v1/portal/forms/{formID}", wrapper.GetPortalForm)
+		r.Get("/v1/portal/forms/{formID}/comments", wrapper.ListPortalFormComments)
+		r.Post("/v1/portal/login", wrapper.PostPortalLogin)
+		r.Get("/v1/portal/me", wrapper.GetPortalAccount)
+		r.Get("/v1/portal/reports/forms", wrapper.GetPortalFormsReport)
+		r.Post("/v1/portal/requests/create", wrapper.PostPortalRequest)
+		r.Get("/v1/portal/requests/list", wrapper.ListPortalOwnRequests)
+		r.Get("/v1/settings/billing", wrapper.GetBillingSettings)
+		r.Put("/v1/settings/billing", wrapper.PutBillingSettings)
+		r.Get("/v1/settings/service-order", wrapper.GetServiceOrderTemplate)
+		r.Put("/v1/settings/service-order", wrapper.PutServiceOrderTemplate)
+		r.Delete("/v1/settings/service-order/logo", wrapper.DeleteServiceOrderLogo)
+		r.Put("/v1/settings/service-order/logo", wrapper.PutServiceOrderLogo)
+		r.Get("/v1/sla/holidays", wrapper.ListHolidays)
+		r.Post("/v1/sla/holidays", wrapper.PostHoliday)
+		r.Delete("/v1/sla/holidays/{date}", wrapper.DeleteHoliday)
+		r.Get("/v1/sla/policies", wrapper.ListSLAPolicies)
+		r.Put("/v1/sla/policies", wrapper.PutSLAPolicy)
+		r.Post("/v1/stock-locations/create", wrapper.PostCreateStockLocation)
+		r.Get("/v1/stock-locations/list", wrapper.ListStockLocations)
+		r.Get("/v1/stock/levels", wrapper.ListStockLevels)
+		r.Get("/v1/stock/low", wrapper.GetLowStockReport)
+		r.Get("/v1/stock/movements", wrapper.ListStockMovements)
+		r.Post("/v1/stock/movements", wrapper.PostStockMovement)
+		r.Get("/v1/surveys/respond/{token}", wrapper.GetPublicSurvey)
+		r.Post("/v1/surveys/respond/{token}", wrapper.PostAnswerSurvey)
+		r.Get("/v1/surveys/summary", wrapper.GetSurveySummary)
+		r.Get("/v1/technicians/calendar", wrapper.GetTeamCalendar)
+		r.Get("/v1/technicians/{memberID}/calendar", wrapper.GetTechnicianCalendar)
+		r.Get("/v1/technicians/{memberID}/next-free-slot", wrapper.GetTechnicianNextFreeSlot)
+		r.Get("/v1/technicians/{memberID}/route", wrapper.GetTechnicianRoute)
 		r.Post("/v1/users/create", wrapper.PostCreateUser)
 		r.Delete("/v1/users/delete", wrapper.DeleteUserAccount)
 		r.Get("/v1/users/details", wrapper.GetUserAccount)
 		r.Post("/v1/users/login", wrapper.PostLoginUser)
 		r.Put("/v1/users/update", wrapper.PutUpdateUser)
+		r.Get("/v1/work-logs/totals", wrapper.GetWorkLogTotals)
 	})
 	return r
 }
@@ -1878,61 +16182,482 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xc2W7bSLp+FYKnL5LTlE1qs2Qg6JM4C5yTSRtZugdjp4USWaJKIllULdoMPUxjLhoY",
-	"YF5gbv1ig6qiKJIiZcq21HZHV4lYRdZf//99/8air3Ub+yEOYMCofnqtU7sPfSD/+5Jx4KE5IGceggGD",
-	"4lpIcAgJQ1DOsINw0MG8Y4c98dOB1CYoZAgH+ql+dvFWw1w7+3jxXntmY1/8oNDX/JvfqQ0IeK4bOpwC",
-	"P/Sgfqpb1aNavXHUPGkdm6ZpVdqmbug+mH6Agcv6+qnVMnQfBfFPy9DZLBR3UkZQ4OqGPq24uAKnjIAK",
-	"A64UcAw85AAhuk7giCMCHcMOex0huL5YGDr0AfI6Ng4YYHh9D2/EsOZAbTkjKXJ07f9oCAni/lEAmW7o",
-	"PUx8wPRT9ej0JqqN+rZiYx8x6IdsZqjnSaEDBxJoS3l/ILCnn+r/c7wy43Fkw+M3y3kLQw+wDzv2ypAJ",
-	"qRqmmdJt9c6q9VHwomr4YPqiYZpSVLXsSr17WpZBD/ZwAIst+yWakTCuFmBNWQ9rb46sZl17Bqen2o+N",
-	"hmW1rWqt3mietNKoTY9lENtMI9Y09BAwBolY/rerqx8vrUr729WVc20ZVn3xg34PaFjNuto3CnHSyjDg",
-	"vn56qYMx96hAr9gqEQr5dmdt4wDi3gv1RC1+3kIsv5wjlkwBLiOZkfIcCUBnCZljyQymVvvA3QG0mdwH",
-	"ZTj0kNtnYg/I0U9103dpa+KDenVi+VJVsXd7i4nPPUAQXndwDmCgg21MCAxsBMSlmN9CLxWGfKjfVZVS",
-	"DgVLG+COA3sQ7ZUmq7Up9rgN9rl2gMbQ6zioh2zuOcBJ4dXDE0Em6CDu64beR27/3oj18ERTT9Tk84QQ",
-	"FHvIRgzs1ykyaAfIxrRDIA1xQMEYehKpDPo0hTHOkbMGr4UU7FxNXsVBQAiYbSeWZThoDA25yhqB14Fp",
-	"rPEhz4xpreaBrEADJamMZiczt9eoTk9Mn6Wp/JXyfB6r2PlEwrvwbw+NxtU6WTguymk9oC3SqzoU11mj",
-	"IcV8xakNilPD1cCmFGV5f1kxWrDWcusjEzmMmCsxNvnwXmpskzCJp2TJkHhISZi2q5T3HdQec3MIVpIW",
-	"QpSvBjbJuLy/rMImczOwu4N5w+eWsttfK5uXbsySeLZacn82gYBBpwPYLsL1d1MwCPTcFolKWymKMIcy",
-	"5FCG7LoMMXQeOjtzAJmwIDmxVaWzZXmTqo0Sni21y5IRyauZc9Q9aTvVOnFVKCDo0N05dHcObvXgVp90",
-	"d4eMxuOwzromrjX4yrNtqgoiYTu7SXMOfaP99o3S7P948+8xlI493Ze4pbu0In48dGg47bHhlKCk8Wi7",
-	"T70QsCofMOQOvNrK1RSW9TYgLk4662jxDgpEsEiI04HT5RXg+ChAlBHgYHJvZ55ZUcusp6VXi/Om77ld",
-	"toFLIaB0gomzrp7PMOgDzcEap/zmd4GGxP7j21IqaNZTcrZSScSzn14c/e/ly8o/QGX+7bn8dXXlqP9c",
-	"/qauX105354fXbeM5l1yjNQ2W3KbMtXIEHNpO6lpI4J0QhNlO2KzMJiNB71a32IKZ28SqW6aNl2ACMlJ",
-	"7F7J65oDNAIpcm7+FTmCPRnfhmFOKfTmQusSQJEHEUmTwrRqllmxzEy5095gdZEyNhaVn8S/tYexaVvJ",
-	"jvKj5Zm8HqsU7lmjWKjKhwHLkSwew4JWKo+8+QNrz3BoIxwA73kmKReC3rkxDqYvrEgqSBlwcuD39a0U",
-	"RI6uaWxl9s8XGU/34OqrSjE9wBDjeUb9EI1oLsQuufm9h2yQVtsqH8W860ElMPJFkGorc6sflfZKpwH3",
-	"u5BsoVOXwRfiAR6DL9pKtR4O3CKhl0N3ktpqpcSWP+8lt3iCENxqRZkn92GeT/p48x8xsO6Ukv2P2sss",
-	"VNP14/0a4BF4owCFaBFyxdgG3L76tB/cEg7WJfzEwZ/k1zPhTkgXW9tYhqLYgcbeIVK1igopT5ZgZhLw",
-	"JePkvNuw0Jjj6Ry1VNXwhhBMPqlkNadR50NKgSsHMol7ZmfLiSUFqY9mlDZJOEKQqncrGyvqnb53OVTU",
-	"O1t7R296Duc7CsvtTW3dL+rmnRXd+38/svdSfos3JQtD/4AoW55joIWNQlragPGRhozNCtodtKw3ro2d",
-	"oO864YDygUK3lLzc0Yfy4icPQdyyg+TjS27CPWn1+hNK2n231l5tImqf0MJjEeXljw9I3CJ8/OCyJwhR",
-	"jdkT6p04fKx49AG7KPgER0/ouFHsF1btk++4n7FtA4NMB81qz5yNZifdSRICObgFtg0p7TA8hMG6at//",
-	"+kXgAIg5aRjA2ft+952Nfkbvz7/Oz62P6JyeB58a9tl583wY/v2Xs/fto6OjvB4tnIaIQNpBQd4LMT/E",
-	"Ykk5Cdz8cfNPrEFfo9DlgYNpUoZaM1FGo4BBFxJJJ7GXjrp+nawaICCyutqcfKY0knpa2ffXs7CHwRD4",
-	"o5Oh4rNIiaumua793aQ0hXn2PQPklmn5kFS9Gjbtes/lNKGH+haVwd0lLhR2YejL3GVP9thr57fo5Eeu",
-	"Im5/HXCPOmUPB9yeSu//L4CrP+e80vJdfKKdf8/TRdnuvqi+oM0JYrPPIjVT+Fex4iUXOrzWu/LX2+WO",
-	"3//6RST9crZ+Go2udt9nLFQPRkFPkkjizhYyxCV0HPD6iGqIag62uQ8FPBEOtB4mGutD7WcPOZAOtZcX",
-	"5yKUesiGUWclAHJtGe8QU93cCXBdSDS8ukk39DEkVC1VOzKPTHEDDmEAQhRfktlPX+77eGwdq4SfHitF",
-	"S9+AaV7bW45rQFM36PLBRG7gXGj6AlOm5pwtJwgbQ8peYWe21EvUUQdh6CFb3nw8oDhYfeN3ax2TPBy2",
-	"WKxpWA1pkbSflAR6Em+McCgBqBpXUhFRuH4QCZfhP084BWZhlfoDrphuxOWs+wo4sSoWht7Y59rn8v0u",
-	"8LTPkIwh0eQNepKI+ullmoKX3xbfDJ1y3wdktoJeDDzlXC71s0StOq3Y2IEuDCoR6ipd7MwqEXFIjIPc",
-	"FI6agz4fTNCoP5irPSSZ4UAPMnh8rX6fv14ocoiL6zR5La/HNNG6M+389RpZ1KyYKCEgwIcMEip1kYto",
-	"+RSRREv6CoeptraUag3jRsKCt5ykEOrO8KH+wHyo52HjI9bOoiX+ZErUTWt/a38NAGd9TNBc+YKnxscI",
-	"47fwcZ1ns153PrSHnBGLmOs885CKOy7MCT/vINOA50WL0jVGvYPsF0sJQD+gyOXvyMOne3M5Ov75/5+i",
-	"WddVXNaw/VGjy8Kp13W6U3fdsCptyzjQkOdY+auceZv3vOBsf64z11U+fF6z9mcNinObSEnlc5uDLz/4",
-	"8iLSR2DaWW5VH1W52XWANapNuuuuIe0Tij3/ZofwDrJXs/PXjzmfejhUpL5wLQw+3xMBnzL/BLoz2C4b",
-	"dWF9wIIJnM+r3WCwopbA5hblfAAn8guUDQX9WzW8s3I+9YXzmpLF6Pb1vHWo5x8t5qVF1yLOSwYDB8lz",
-	"lfeOOiNoj33g96zeuNXLUmNZz4tfZat5MXdjLR9xZGPkkfsuijtKmkMV/z0RIYJX5H6LibAOcOq3Zu3B",
-	"qDkZMj7NArxUIS2nroFZlLZvo5Hd1s+bnf4yiTlk8qXBJLQaW3UbLFmNkUWIb1nAq5Eslpa1e8JZbq7c",
-	"N3jKC84ejZvcYQVfIp05lPCHEv6hS/hbo8jd0ynQbg/roDUaOhbqZz1E0jVsKODl5OL6XdBC1PCPL4d6",
-	"4Mq9XNw7UO/JVO93CbmTQd/x6+O2HQ7dRFfMh34XkpIJXDQ5N4X7Wzy22yQuPiF7gHISylpF+4g1YDM0",
-	"hhqFVB7JkALV9ygQhUQLsAAoD54su1YoX/Jrie2il4xmu0EGNdccOtPailqcCmJt1RUTt2zoin1Vwzvr",
-	"iq3+pl5B30kKqIkofjjfkuZ9tfrdtyCyh2dSkF5SSUD4/r02C077Hj5pemQOsoRLNtY2ttgklkOCe0h+",
-	"P5vXYhPSvrRtzIOct/t7KZA+Cc9FsUagj8fIwZqNfY1ydV7+EOwOwe7+vcBihuaUZdNZs8v8JuhPk+fW",
-	"lsxjAHl0YxopSbecmFOUbWTcA9dFG8LdIZM8kOv+meQ2zHJqs3HYGvp+c9BtZ5nlYVd9zJSfQ8qvrzRQ",
-	"nD7KCTvMHuMvAItM6QAG9poyxh+kPUpyP7qUTSFoF7madUJwo8cbNdOdNrK4Vq3+2zr8G2PGBWdq2g7h",
-	"vfZ39QthHgt56K4fotlfKJolmLi9g4juyvMOzKoG06lLWvOGLSu5EkLJTahGPSeefqofgxDpi4K4GjZb",
-	"kLZ5zW1ZPVdfLP4bAAD//36/w+noawAA",
+	"H4sIAAAAAAAC/+z9W3PcOJYvjn4VRM6OGPlsyrr4UuVydOyj8qXaPb5oLLv6zOmqrYBIKBM2SaQBMC25",
+	"wl/k/7Rr5qGjOqKeeu+Xetz5xf6xFkASJEEmmcqUJTknYrqsJEjc1lpYWJff+mUUimQqUpZqNfrul5EK",
+	"Jyyh+M+DMUsj+oaFKQ8F/jKVYsqk5gz/4pql+T8S/Md/k+x09N3oX3bKb+7YD+480ywxXxx9Dkb6fMpG",
+	"342olPR89PlzMJLsQ8Yli0bf/c1++OeilTh5x0INr5kPJCzVwo6rOaxTnsB/IqZCyaeai3T03egpT8hU",
+	"shlXWpCIkhlXXFOyRfX8N7J/l0yEpIpEbCq4IpEgPJ3/HnJxaxSMToVMqB59N4qoZtuaJ2xUjExpydMx",
+	"jIynPOSi2fEz8yFP570/rc1Mj3nU/Pyb+W/4kGwlLDmR4hahWvKTbP57JAgVhGqWRhwXzO0vy3jU6CoY",
+	"nW2PxTY705JuazrG1ZzRmMPoRt8VWxTg25/ru+YMs1iOAHejfSflgTO+xk7ScrdVc+p/FnL+q+SCRIyE",
+	"NKJE27V4SGKuNCUz+olTIlkiZoxQYr5Govqi9KJeD+F9DkYJPXtm3r63W6PpRYuZ0LM/3dsNIj5jSP98",
+	"nApJ5XEo0tOYeyf8g6QzWk4kYSoR5EPGCI3HWVJMn7yb/0o0SyeUiEzLgtZTQaZMzn8XkSBbUxrJ+X8J",
+	"ckpjxW6VpHAiRMxo2mDJylb491NmU9PgYCpS3bptTkMSCUW00BR4jlkepPh2RNUoGLE0S7D3yoZZQhsF",
+	"ozDmLNVs9HOdlKsDOmTqQ8YVXTgaIpnKEuBRRabFS+U4mj0HoymTXETCP4ZYM0lDKg6QKXlIfVRufy0n",
+	"i20j6AaJl7d83ciDbp7+HIxSkbBjXUrL5eSLI1ZERvJxkS2aKVgIohgRJQmeCl62iQRRXGmW0FsLZVDj",
+	"KIhGtRkEZsG8NJgv95GmOlNPhUyymEruW3RsGoljllQWsVMQFy9NhWwu1VuVGXkELHnKPhFKkiyi6fzv",
+	"tLZMWd7SXaYea9N7yxOh+cy/2biW9Yk0WilcwGOaaqBuuUg6mvV2ZXn5jVTMxBLv++igPqpqH8W0fZMM",
+	"KjveTjxyMemYXQ+pOFYizizzVknhSMTZ/O8gX+k05nA4PSTiRPIx1fN/Sk7hZJZMiXjGpCEJR8IRykG8",
+	"p/C65tAiS+gID5vnLB3rCZw2u8Eo4Wn+9/7Qo1wkcOpN9XmQ8PRP+4E5jXZx2UviqU7qBf5OopKsK5NC",
+	"dSOkachiKo2MoCeSS8/Ilx6rM0qz8xcnLPsdL0Wk7Ex0KiihZFSz6JjqClt2ChGWzng/GQItRQbEIT9k",
+	"sPSXLEUMF5m+22VJbT6NRmpC9+/d9/DInw+29+/dh9MhFKlm8z8iQVhCJuyMRizkCY19g9I0oenEQ55v",
+	"zAP4xMm5ZspdCJ7q+3fLr/FUszGT+Dk+FcfYfxb5PsqngvCIpZqfAh+DChW7A46K3RkFI3ZGk2mMPSR0",
+	"zHbeTdnYN4dMxseR+JjGgnqO3LevnxOqFE9Bp51SSckJ5WfAU05X3m+ysymX1B5pdeY1soUlSFruCEjE",
+	"+BkFBWxGYyZ7Xktaz2lnjJW1LbeuoAkPBQUuT9VWqj7JVp59ztMJfSzesGTqYdpVUb9DicsQ2urW07sO",
+	"pQ6+GrEVZahZHSs2ztLIp9g/ziTFQ++hodpQinT+vxOmpVBAdzS/QASgKcLekIiFQkqOV7L5b4QCY6ks",
+	"rt6N29eUJcfFR50lLe4wgd8WcGClKEs/ZBSYQrhjJUzp+a+VAfc3AvQjrdJW0O+zCzV4caKYnOUXieZj",
+	"yccsce8YMF+B08XjOc1o7L1i9LoawPaV5NbnStDrlAJyX3S+g3x2Sb3P9QG/WyyKY6qokJOH4ivrXBFU",
+	"Xg5EEb7AwIFtiltIjZ+opsTcikHpMm11JvEmH3E1FYqDRvYQFtquObDWpLCLCALD5ZGQvSk4EmGGgz02",
+	"/aWawcgcDe7O7tJ2I9Df7hj1zYxm4f4+Nc0Oirkj+8Dh6lmwZ/g7OXz5A6ifRz/+gLoAVez+XbJlO1Rk",
+	"mo4JI2o2rhAhqAy+9Yip5jqLWJVZRXYSO83TLDlhcuE6gKq9/WAXl+GBWYZYpOPVfn/vW9PB3remB3OG",
+	"tOzl/u7FNnPfKuNTyUKuqDg20n4Vk6neUEw3WtLQd/K8gYNHqBqX5FtOzGu3XFNfL5vfoUjrpFc1Wy8w",
+	"Y+c03tgEP5s5xOYSRrtsgUENES/9JEAoYqZbLyiF0ActEpuKjFSXfUWXlKWUFL/0arS7iPSZUDU5ptVl",
+	"73GzqZqdQYQnViWvUK1vVrZHOKP79YVtm4bu5dUVzcbSM9GnNFZACTQFq2BlfrDducWlcKv4J+qoa0Ok",
+	"7VDp6ZWFflWrzgKNVkvIu3434fymv5AmVnv5WPpKumiYjVvpxa6WCyWoK/ECRwD3uIp6ha+PIDwioMKj",
+	"JcMsuL8uViHLLg6F1DRuiviIanocsWN6wiRuQn9ZWZgxI3bKuPbThdfY2ZRQQlJ1jJe3hEe0L0P0lD9T",
+	"KbQIReyRQIf5o7p83Xp1tH1wcHCw/RL/75bvu0rEPOR6sR18KfO3dvzmhbrR5OOaQpFNo4Gnno9byhUL",
+	"mhTSmLiPFnw7XyyHZ8Od+VYmsYCsj1jyXIQ05p+o3zdWNj3uSS3WMze0OfB5Nwd4n7I0YpK1XMt7Ea5i",
+	"HzKWhpzGHh2Bpl5SLg2Nr46293f372/v7u7u7d/xUjnXWYtloO7jrS52lYycZa0tmrMITm/uwi0mAhZP",
+	"8iO5ZqoatpurFGqnhQ/oeIjJ0I7YTxAiLTejRhD4yDiNMgXnbEqJkBFLAjDN7hJK9siWsksFPkX6LlMa",
+	"Gk6FJLbXgEgWzv8B5EQYmQm48EaMzP/QPK7GsrQK5XVJW+Pv4gNvI/mEQ+rRm5zFYKmWjAhFNDvDScP/",
+	"GxJQ5QL2W4ELCH1c8eNMc4+q/u9gbKSKzNgnpjzqckIlehhCkQizYx5dznPFLGm0g2MrpLmEwHf2wSXj",
+	"yoz9bJ6hdJePaDIVh0wqkeIPkUfaW2+isG7X5v1ATEPBBp6oko0lXbibr7GVZ5DwBaGzWNRMJ3sXNJ3s",
+	"oemktp22p6CyEt3LWkqbmuCEqajjqTuZhauA81eH1XfgkEyn745FdhxOT5t0/ejwKdjbHr08/AvZCkUC",
+	"fyiWkGT+qwqppNUDa2//9p27927f/+bbHTizth/sVh3Ee99WPNt7e0uvcjg9PYaBI9OwhPIYbwDUd2V/",
+	"Ao8xkMy2cIdsf/v/qimTPEtup0y7ggQ/XZ3E/r27y3u5zfc+15SLrm17krfznEGrjBooCbgRNGC6LZf3",
+	"krrNW9ddwWNFYj6TTOURnrsByFXjZrq3S4AuQ82gQUgjWrENVobuC64pgv72hwb9VUMZ9k34n52QmQ+L",
+	"2alIWTulvrEtHGIFRTG3dT65vQfWbnb2Hfnv9+7t7T3Y279z9979b76tcmH1WY0D71c5cDcYTanWTEL3",
+	"//Onn/773/a2H/z800/RL3vB3t3P/210AVLfu3/XzBvv5yXVFrFwsyxWeJiJVEuqXWk4lHpEysTpn8wX",
+	"SfG9hgyunZSVkVUlYUXxrQoYz07WeKQh1WEeSotpzMcTnfupR7vJWH37MaF39z/uJaPPFdEv0lM+Nk6q",
+	"R+JE5kpSPdCNf8iEpsccRLs3IvNQyJClGp0nBZPsk5AqDIkOeUK58qpOCT3jSZbY0zDhqflrd6ixf6zZ",
+	"n3aDWLM/7RWMfQa3VhWLsPTvegZwsS6xqxmNhTyGGy2EjNFL6QjNZ82d+BFaDN2D1Y4sYRFf2xrUWK2x",
+	"IJ6RNPfHRxyBh8679aac//0WNRs3ULmjLB0vj0uMn21x/F/sy8YWk7BUAYmA8DwL40zxGXuRb5iWGVst",
+	"7RRSdawtDaGrORRgFQJLU83wVdecu/SBz0ufquY4LbSEXJiomB5LZm81SEmqbji/s+8ux17j4jVgPdif",
+	"9txegRrppXVqmAWJIb4kLnYpOyjZp06Y3gXx701tGp2M/JTmZu8e595lnmAg2cVaD648GkWwOovt7140",
+	"zBa+YAiKpSH3nMA+sdWgjHwJBovnrvjrld5soz4vP4ZGzpBycS5CISWsT1+3y7ATw2fLvKR7lddCekl9",
+	"+5xJjcwvquo2SEoidsI1lbkvXMJ9iJqYdUFKFX6VzFgyTcmWKZ+x+DiCgOEsjmhUudDE4iN0yCKeoYjk",
+	"48mFrzSx+EjMFwl+77Pj27pcK8DNu44bn5Y5tlJFZyyuqDWLM3F4ake3N3BwlWXeM0PzJ156jbk1+eQj",
+	"yyqV+A3A3hXoeXfl59+cj0/v7Z99s5vo6t31hYhYLF7JiCVHRm/0yHkhj6eSJ1Ryz1XpkZAmfXD+O9hM",
+	"VS11gGz9y+vXP/zw/fe3HmIeKDpVMMBYEpv7WDGI/Mve07tPvnnQHVTEkqlkyjcYsH2CDfTwKYRu5O2C",
+	"i8VNOuGSxiZ44eC9mpGxEsPnGhsrw15y3Oarp5kyWp30JUc/zZRJtgRyVYTjusGNd+vZwcuDytYdJAxo",
+	"c+eIiuNDmsXV7fM9bUlXKffwYmvprp3imq32i7n56MLBtw4RoWvsWIqITpnPsHimRb4FaFPElvPfwNKo",
+	"BewSZMXOfx3zFHX06pVud5EyWFl9H1dVzGnF/IPCvo6LXJtFUJUSNXrrVDEPmddcpvnMw+CHbP53INAU",
+	"nyuTnDcVEUuIYpLE6ISEJWKJq5Qob5RbmKl1XguY0uJDxo7xW2vsJ4+YcOng3gWdYvfKeOI1jly9zxZq",
+	"FoPGbYedpTzX97quE29NMyTARjri+8xaikfl93KSyRemsceBpdtugo9pKl7QNNMs9QbbhBMWvo+50p7Q",
+	"es1SDOCMEVkBcsWYxJQ4E7FQHqk+vc0jCy8GnlBPCS0NP3uVM60Su1PLrsC/zOjzRIn53w1OB0ttHrFL",
+	"2vsXvFHv7ZsxtQGFHEqeMF7CNUSUqPlvkrOHEMjAU1ZJ7aDKtlO9UzvAWuoJS8D4K+eGZOUX5Pab0xfj",
+	"PcvHPNWMS3GrtjwXNTjkZpOL354c0VSd6UuRMJjoFBghwCgbG23h6MGoVNZEeE1QuM6xC12e9lzhIVnF",
+	"mlAdOoYnANuZVjbC5/Xrt8+f4EXq6esn/062Hh88e/4fAfnrkyf/Bv998erlmz8//w9QTP/jycHr5/9x",
+	"KyDPXr558vrHg+cB+f4/Hh/8B/wHm+G/H716+/INYeTtyzfPngdmaeDLf7Jfepi//ac7Fe2rvc0FzB9u",
+	"/KQ/B0HloDfKRb1RZP6/XOa4Wnc1K9ndzXYy0Hx3tGIduoW7iLnmIT16ftAU7AlPM23ucG2IBYeSfhKG",
+	"wFQBXgBBHOZViEdiXJGtQvFBvSdhqZCYCpK/i8bdWysya+NqIjE4M8AeWidAyTSXo3nb5jRWPryVCK0L",
+	"+MAbVOajo6oju7GigYdMOkkOci87IVb8MBLwWq5JC6tJ05BxTROSillT+jYV6Mqxvg5wCZ9qu7syyd8W",
+	"/WVlg1m3zqV/qzL/mhd2gusQ3uRZ5TUCm3zuZ7RK1bfydD9S4q6+d88YrWZciUcWlssi2HmMVa2wXT+a",
+	"o8haq/KjC4VmmE1pAgqWNopJDs81ZZHBf7owsh4IHqXomC0O/84bBs5kvFQI6/E4m4LKlMu62mJQUCBp",
+	"3Qfc7Z+xr5gP28jLVcylHItvMt9nKqQ98mxqCX+dUHGNr3UE2vcalAd7MeYJrwSZO9leUwomGv8zyRBf",
+	"IRqwNa/zV+rD8u2QFpq2xT4jtYAUcgU8Yak51yJjw/VZmhYEQTtzygdQLEKQr1TrOrdH0JYPOinXNusr",
+	"YL5ld74d3/2wyyMtzWljhtEakBI6TzoHUoa0VdfHozfU1qDrGD+tPFuULmtbtsep93UePNhX2STiD2bZ",
+	"7ntaLlPryZeprM8Y8/f77tbHT7tpePLu070k2zPHQZ9A9nBCZ0aHK07ZNEuYFMfFXqwo45lZtlocImza",
+	"+SPce+a3XPn4/HK5X/7fP1w/8EXwRfydriZRr9i+wNJMUOYA5DglzqIXK1wsWx0vqTvzzhsFAadbFHGQ",
+	"1DQ+dOjYRI15IhSZUWZMIAapBmIEhKcR+OHwSUwJzotsodk+IOn8D+CDAD0+BHOGXrzYfvw4IGJqLp0i",
+	"I5aixK2Rdw4NTeGCovs1gn6WuIn9j0YDiefqQh4C70r6kuKEnvC4OBmj8lOVrKWCsHdvf3uvRxJT/QQo",
+	"0Uqd1J18tl5K2eSVdF779yzICi71YME9yBD21aSu9DmFhmJUbxJi6hE4iHI85spgf/U/pzepKZefmrKE",
+	"mjFAtLTibPTNfhmY8lJx8LepLUHLCWNJu+ftIb6z+4mffPMg2r8rjeplD7Tn/IxxX7xw7Sjy5IzHrNyI",
+	"dqQ2C7BZ+ssgtdciXBpXGjhRzSBWDCu4MP18hRbmwcTirF+HvmFVscG70zgj17eGHjE4fKkGMpJ3xRA+",
+	"qsX0nmkhvaCNVYBhFYK3GyCGQ/yaebQyBC8hp6It0Ciq9skS8oLK94CVs7KrcYQQJ76SHBEPqZmdOwao",
+	"m4Dcal/0uR4GQGbJtOWWjLSAW+SHYlrJzdLpJN+Jcljl4gy8P64q5fAgnv+ObUBXyJsFQAXTMhkxKNDT",
+	"0aWbCoi8OsV8D9UPycGbRVhXjQz2sduKhDA3i6zRUkGlvdPh+1e+c3Lei7w8KYu+RMKIWvTQmlMN51s6",
+	"BwmtIe62z61vCmPfnvO0v2FdFzmKF+k6mf8W8V59f/GsRbDs0jbPxECEnMJD2LgEXuTI7MDSaz1L26SW",
+	"E/LZDmHStkx+EzqNmdTe8i/gy1Imni3PcyL5BcaUgqkYEYvrUgGkbJ8dT6U444k4Lr/j6FPmVxS3JrvF",
+	"tKbqOBLH1kGRP2JqLEz9m5+Dxbeygbu/FKimkxDbLKqF5azGNiBpi6cm9bRZOKv1y4sKZ12kg0ZqbG38",
+	"kqYfMk7L4kMg8AsvbMTsaZNvZ3FJ3d91O28VWaZ7yRRGlqnjvFRQE8mIxqY/M4pUtA2C5BCDvXvPNMcr",
+	"FO3o3uRTlRlXKxrAqlTu7szihSzSkv1bq4/ANPWEP5nWQTNrt+lZ9af7+nvxxigN6GsZFaOeGVwQ8x7a",
+	"o4aeghUcOC/EVO+04Mb2Lp8q3EH17ewYFOfDQG1Ycio7Cy34YAR6VBDs174K+r+e7N8q/v+qTbOrQPiv",
+	"VB20LrOFxQdx5wY5cmsx00yDxEx4Ov9DhVlMAWNu/vuYY0ADUdlJzNMJjQQG0ULuSArWHyFJDG+Ogk7H",
+	"8MrzElbjJK55fyN2SrNYj77DEoJBpze4unqvwNP3fxh6DhOu8cTZUngRZ3m0OewkUSxmIRW3BtmpL8uf",
+	"vHIsuIt5pGtssdCx3M4WG8/fBlGu4pazlUmj3N+ufOxf20HJ3ZyRslQpO+NKQ8yjFLP5rzPGFXG+G7RZ",
+	"yzb+uw2g3cZreBMA7eSH2Wx6V5/sijv3stHn4tTp8GYs70eopSivOIJ/N0/oK2z9jcDv+e8zFhM6ZSlV",
+	"RrWhBL4yZcNLMJtlaD+32wNIB9iIhivxG7y0DV7aBi9tAF5axWjyRcDTUF4MAk7r4b2rOe8e5iXpCuAV",
+	"6zqM6FqhRGuITBVItmqBA9/EXH+ROVhVQArkegUXkVMWTkyga8RIlljdtjxQB+SbFnpXPufVpJ9aVq6k",
+	"oVbx6Hx+M3pCdV6tPTvBNIrg0qBIfThaaOrncoH/Ft3QhBLt8+OyBLVhfx0s11Jx1ZH06pTbztVMcq8l",
+	"C0TLig/S9WZJuqTsB53M/YXti9GZnQoJ41xijERCNTc0w7xlJogPBgsEgOLjlLpVToE3yAnQnQAyjAQB",
+	"zU9lY6gqCzaviOWvoZPBS46rtbTkqB7HCYJuecOCDB4XCbN3rRAf899IKKa8qAAm2iIjltIrS54pBNa6",
+	"1dYN8GNf4McaPxQOsjw852GJCMBTXI2I2qJ/AaHCHqBMNmjmcjJ/byikZA3cxFw1q0EueKPvRCEoLSjF",
+	"ow0W5bXComzDRqnS8kNi3Tfzf0ou0JreegIO0WEvcvGs+AGPG+W2mrARGIuAzdDY8xDqPaEcJwrEkekJ",
+	"/jS8SigcYfioqIjK1IeMJXT151XnJW8lAJ09DWynU6r3s3eaj9/Fd0oDG8I8PTGwYU1tqE2VW4VTq18J",
+	"+RT+h8zY/Pcwi+vkigpH/gw8rFMp+AkvIurjRJxRyU+tE2Hlqkhfx1xlkRueauOc7lZZjSL2yEViq+nx",
+	"68Y+4Zql/TMgQdzVx9zAdlvpzdaivVVuIH6cL6PvBiQFcoiNe9l6liNWIL55XLmXAvNi1rmVEPzwlAV0",
+	"ZO0SDD+TLOXGCh58gRIiTdTJmj/UPCfJ/HdoQJRIMChCC7SrKALXJa4ekk9MYhJwbj6CCz0f430Do3Qu",
+	"Q926BGTLeiIwm//d2UEbkRrRL7GVFh6zRmLzf0Z8bBC2IKDlIRlLOoMLLjjmKc8DYSD8hSWEqSnW5F9H",
+	"OMtlwWy28+bXh6S55sv4Bqdzg9O5wenc4HReGZzORmTzCkE78Qw5sqQc0vba/r2l4lSKk9hca8vdfmah",
+	"3IEgBTnlKU1DxqUwkIugUzUscBc9nr2W+s4y4LgWi5Ak137nya0CjXX+y9Grl+QIFQqypbKTUKTvstSE",
+	"nUSSnmqyv7u/u723f8tFxMHe1XcEhhaQci4ByRcmICAqA8y50AEpJFVAiukFxAYpBTYWimzBHAJSHB6Q",
+	"L0l5fCsgVuvDt80/kJsCkrMKPrH/4qc7esLSHRYrRiD5L351OvLZFsZi2/74Ton09mv68YWF1fvSEJn5",
+	"frVSVCtMWEjlWLhHVWGeKFJj81/YWf4LjWCBFYLUyQsHbNV6JLX+SLW3SvWPrxXVs8PMO6VKfRQy8vnt",
+	"0gmKvjyD3Z1/8VplCe7frYzz20qg4Nb/+NPt/8/fDrb//3T708+38K+fforMP/72P83vP/0U/Xzr9i/f",
+	"BveXiSOsTPNbnOb9u00myPfOMoMhaWcl+iLsnU/T89m70zuTPS1Hn2uss4p00+EXgfbs1BVZJsu6N3s1",
+	"rN+SdV5QySk5Etkn2m0cWgUd73noeDCVroXMfCpQvhvttPY5GNW9phcFmDPHKYlK253jBMAzFP6Ge5c9",
+	"F0hUWP59CHJPIo74BQsiTo8pCGjegtJgARSOWTIYkeJ4ar7Zgcxxyj5BpGhkVbxVIXIMQ6/wLUA9ML82",
+	"pR4p3rW19fTm+XRlvX/276leKor4pZjBEXzFQ4lfipkxOORggQ+BFCxVgHKX6vlvCaFFeu7SscRP0pBJ",
+	"2S+v0ZMNXiYOV0coSChFOv/fCdMSY0mY6Qe8lGMxAAupmvVYO/GzE6W5zjjgB9uGNtUM+nFmFKwlxuuz",
+	"dz3LjJvqIp5QLqVnFt/j7yDDJFM8MjaEFQdLdChUIZt6rL9PDsmJpIrHjMuqorm7d2dvdxuOscoQH3Ro",
+	"UpBqce/z9v+A/95ZjZ70wIyd+4MjHlmATbuk7JJXVMBSAeF5RlY8Q/lj8i/AvrklpiEemOsy4DHlR0d6",
+	"+xQHgk8bK1Zu+9FhjYlWvnz7OMyYAkf7NvW5fULGTIzl/FewoVeXrSPK+IEbZLz94IJuo+0HJtD4gVna",
+	"WKTjtkHnj5Ya9d63lWHvfXvRce99awa+961VhjEZ2RddhLC9TaHkpmHeOaiT6hpiQa2yzFUb5cKzDrr9",
+	"/vXl0K3MfObhjH4huV6HzM/oqNjtID+KCgFaSAe71OZUqEgyhzNdgu959/x0cm+PzzJx9ol/a4LEOnLP",
+	"XbidIuTOX0Oh1BOeSCnkaxMq5Ql4GVxJoufE7n44V+q+nH7gTBlw4CfgphLPeTqhj8UblviUUNPGeAWt",
+	"0QK4VDzMU8eEuQsRNEDDnCLjArJXohnkXkjG0nBiUIaqk6UpO1tctgIaVYaJGRMYIxbSxa/HmiEu3EH5",
+	"CnxgMRKhmZCCbOAYIMMFYWY53FuPyV6YsLA/+KB7Feium1C0XAzOhxFbfOC9T/ESALzXGh5pqrNa4HHf",
+	"GCQPvbVEIrlzqUzcdy8YlC7UAxxoYS7OYUHPOROk1GAUxcZvniXUIhMtH7H4GQvEhCymER20pZeAu5W/",
+	"0xMT0M3v6bH+zCBvDJp0z8kWEWQr2dBF0WeWMD07a/Dtj+0GF1fZ4QBUi7QixT5kqGnEjn8TZscSrsAK",
+	"VSbDpUIRSVWYpRNRB3u6f9cL9lRLTmqMbkrHdC3i6Mi2K1c4TwzrSWND2i4PaTVQ8FwkDWsh6FWxtM5a",
+	"OazpA3xsTKOsYTQEjOqCiV/tOI4DE6+eYjfiQCmeFkdGzVBkmoC6Q8tmZarCFBVdNYP/1ZJW3ejleLs8",
+	"xqvNoMpRHH1qzKMiLQQstzSu5rigiXbGUwiNg0gdBRB/2YzJfjrMWutKbDKgVpcBZWKwqAnUrFKAYrLM",
+	"IL7lue+1oFb2yFbC5KTIuEYcZKoVQTMuUWrjwjFhK8g2mkqhRShif7kd88g9sWs5X+CATwXZenW0jdWJ",
+	"XuL/VcFmXh1t7+/u398GuKn9O94jNqYLT9fnB7Vael8mT0rhXWPhaLFVbcDrLDbiT3bqpRW+MS+vLVRs",
+	"6YSlOq0NPA7WWQsE9CKpqDiuTc2DFmBTqspkKoxsNEUv6kcfRWgTRFSemQRRdoaJO4urOuIalMy8qiwq",
+	"n4AvmKCF8DwieekCJvm526Y7mWVvL1My7N653Fm9TLmT+s5fkZInazsNmiK/Kr17XCRc4u68VFTJt0Hy",
+	"C0qr/MAEhDE+5yk7MqNpyipJ3zHjgZICw8ZhY+2LJgUlFEJGLEV142+FvTcguRX454bVEd/gKdW1ipBN",
+	"U03HDbJ+Lnj/LpUMZ5ILS9jg06AyTO/ySZoiU5pkEvUh44p6j72EphNBTOFD4wO3B6DKEkxDI6eZMoLz",
+	"bMqifHvzsZu6AYolNKWoKfmvPX/mSgvJw6aR0GObQ3tivSTn8ibIyurXltPpzLeMoLKiYJtJdqlAyXXO",
+	"W4QN/AwaLIh/2EAv+6GXvetZVifvqqrd25JqBWVr1Yn+UfOlGtH4yFqBu3udSXVVsc8JxNvqjKIlr5i3",
+	"Fw2p/MwQwnOa9zMf1Xa8eghWCcr9bm3fK4a2csN7IH8DNXZkV6OnzKvOPxUadAF2hnhS1c3BBJwCyj8S",
+	"GH3fR6OnUylm3iiM1+XXFOEmZ5AR074dOKhC+02izi0p7eWwYOA2rBC9jLylDBZ+apDR2byxOPLRZkOK",
+	"jPRfxlMh6XFEj0/9JYmeM64zSeEmhu53bEZoyLimxuFoO1q63BcGaPT1vJQZ0j0aLyy5PRWqtuGO6wDj",
+	"GvsSl2ncUSjbf78t6H4roTIEngwsneI/je8kMF9H/DyhhVd8OWm9fkt/+86W80izBJIxQ6FGS9a0yBe0",
+	"Kli8xbjLxOHiILFrWKXINkFkc+K/p/zMo2Q0M+p70Mupvx7Yv2fUxleY8GJoZVE1RKypBInGqhn6/QyJ",
+	"rcfwlIW07xHyoRhdW2kcJRKbal7DC+g3SpvRPoDkapSRzyZoyxyvbVZlTvmutJFBq2u9oR21olWaS7jk",
+	"Y54WkrN0OirvBR1Qb8FR2UfCdh8sS21zn9tfTyW15gYe4HU0rrcs5UWkyFIyw0oHV2BU9r/WTT7GNoJY",
+	"GgRmf6Vx6Xnuf3m61UADKceKJkYG2/O0KORRl8e3HuZNFaEhB18/D2lCFLc2sr5FHdsQRl6wdNnhQB0/",
+	"sex4GnVRanAPGJoVckUrpyUK31ZgvOY5P4QXPIA8jqSrKV9pERudL137mtXj5i961e3KFu64Uj6naQhQ",
+	"STNcLxpS0QpshWAKACGreeqXohZXgRHbqI6UZtcC4lW0pKk6ZTatfy1IU2a87eNM8klDgoVnTJBpEecz",
+	"ggOBJRceZtN60GX/uLD5A3vorT4MH3yXtuHoSQXuzp0a7g6gXSqu+YwGcIgyNBMrQt9lSjNTxNaYxFGS",
+	"EG6uV2Blhk/31Kt6I44BK6DUWAA7VmowBYlVlqKdzwBAx3X8tfBYJ2/luiUQZQoXWQMiRBTlGdly8dvg",
+	"ipAjvWEcrAWRuLUGMl4jkYHIFxXFYgHgkyk5XMuaAuh0hLqBtjkkFHr7qZ7/GouxuAQ0rJ78kilagau6",
+	"0weuaihElQ9PfynKroVj125eM4Mo09do7gu5XWAtz7vwD05piuHYqsVCNcCeD83rbvuugdnvt4+rNAj7",
+	"Rld72m+Mro3Z4+PJ9flOWQiNKmNrTMx92KWBm2mWS6baUuEr6EO9J1u+ZL+7cEfcbnoN+IglLJ5gkcvV",
+	"j7r8+IpG7g27a4no6z1gf2W/ztHaHtrHaSzQqtUpP2B05oXFQ8o/3DP75M4sSifjaPpOZe9MNFRl5Iui",
+	"CgZPIP/g8vMohlgkYfjWt/qw3wgrWR3do3M+3zFAE1/nHZ7zqOfgzBs9hpZ/unVgj7F8Hh51nrFNqRyw",
+	"rYdUPs6r8S0cm/l067gAZUEw1ZWSz0yT/udsHbhh4SlrO2gdpLEaedbttHzQa2htWQi1AeWfbR+QCeP2",
+	"jch50m9I5oXFY8o/3D6ojhiDMlRswMg6ogrQI4iV9MNMKp/R/xH+jkCkcv7PM7AkTee/jnlKy4yxlJL5",
+	"H7F2nvWIsKuvizOznvJ3/M23p5OPSj6YjO88KOVvOd92EXyxdewriDvnlA8XIUo7rI/93G3K62/zZrgM",
+	"h5yu2LYaBMRMeqQ6dkxzqg2K1bX2KMSuzW10Ad7jeTJlEUsW2upa4xhxcq2j6uMrwl15jv6OTkMX77+I",
+	"VaTyBVRjv906NGNv7CIZA8Xdf3geC2bnCPPvdwwxNxYK1r6IidtqwGCbhsjFA3Z7ah32S6ERGzgfTx21",
+	"v/q012iLT9LFR0Olg9ZBgoHIp3rkP/dTPRCmeZHKgZ/sHkinqQoBno+LG6UPRR0CHNE4pSBiL6bp/O+0",
+	"UeOo3XA3fNadt/Ng1DJYU7TNWo0giqEY9hKObzNoJ/HMWab21Y5pKlQXDDYC+A5YjBqs9kJqMJ9vH6CI",
+	"ueYhVUfPDzyDy5/2H599Az63cGzF11uHh57tdlmk8HHvweHX+kof++32oeXgtIK1Wj+U06b/KBuotwuH",
+	"6nbTOmAwhXeGwmo+xHpQA6RdNEbz8dbBvU07ZHiWDhfh+Qd78Ij7+fYBGshH3+icJ/2GZl5YPK78wz01",
+	"611+R4cfVfxNlM2iUrPOR95GpMuOvydxts8iHyBkywimFiEcz0yz3sP8EZN1BpJp3ol3sJ3lgZaBLxiQ",
+	"wdGelW+DQL0NliosVDq3819Ur9yrlVQAcmNF8rDWcmVbt4V/AlZ/Uy5FTfN3ELLWiHi1COdqrbBVXrCq",
+	"QXtSXcQWJ2k/SCPclzFPX7MPzd24sljNVQjcrx07eShYsjx7d3//dPf8w/k3Jx9Hn0sS8HlYwpApdazF",
+	"e5Z6IOX/+gbogEKbKhmw879MTn4I+Sv+l2dvPz3be8mfqWfp63vho2f3n72f/v9+fPSXB7dv3/aJJ3Y2",
+	"5ZKpY+7pEB2S0CU2smkALCGKjbPUZCYWY7hzf3e3adEIRjiX4yLbqkRTY1Qi+y6I+ndXpPK1nssfn09P",
+	"BX1Pkw/fvDcH2wsqQyob4fz10HaDDEzLuKs0h9Iq4IRt1PsWxvDmscuRCAhG7TmRy3lywK2HBNtCGK0y",
+	"tRRFpqXTScyTKS0D2AflGLyb/9qSZ7COeCM3A6ElzN//qAgtX1Ox7Dzgu89VtiGeFwZQLqPQrCQGdiXV",
+	"9eomglYdajiojk9jsapKJc/GGjWHINSYibySEUuOmJx5dRmEL0Z3bZ4q0gPoTR5PJU+o9BXkeSQkZgfo",
+	"+e/a1I5MyASYC6NSYrL1L69f//DD99/fekhMFaVMUUJJKKRTiauUd/+y9/Tuk28eeMlDhJmJ1WZYeMU3",
+	"mJeHfwFB8ujwKdjF83aVY/TOYA4CvrmzW4XPX1VIXgmXnwfmMQfPuDLsJcdtvgqJsccTkyLqkYuYNksV",
+	"iaiminBT2IYqsvXs4OVBZesOEgY0unNExfEhzeLq9vme+i8ezh5ebC3dtVNcs9V+UbOYnYqUNXbjAkSE",
+	"kv1YiohOmU+bgNPQbgEmyGPL+W+YQiRgl6jKnW6qCZy+ClwLeEfGxxD/5s9Nevv6uYWWMuFyecsyJQLi",
+	"wyJbCmky/7VosaivY6NEDUh981SwOS45vyk1HBZz9rcsvYBEVNulmhSs8ZNfGGs+E07sgEdaSa4h8Nsk",
+	"l4SCpyGPeEZYqiUjQpEiqsPJYc+n4wzYmUMNNaxc4l6x3EPyYQwiksoS1NwMoLUw1XzWAtbY8/h3Q2X7",
+	"gVsU1QD9j6e2TOwCnPy1pWcd2lBok88ApEEj6zmBgQckZWNaNlB0/nvvxIq2rK2+9/42v5yNmEf4jBZM",
+	"tyRLsDxdlKFbRaZU5QiV1YD7wVEF2MSXTma2shLE6hBAYTeq5BU5m7zQlOSuBgZ2+xLyvUisxZu2loIp",
+	"/ErVQ7M8niwEsmU2mrCcJG5dCKN1MFpzgDPxLkMWUVlDtvT4gUE6NlfilZsZQgWx0KLSgS4lFlh1IdDb",
+	"cpXqlsXurHtV8s/4lsj1EK/k5rQAKcuV25LFFKHw++Ul9hW7JdJtrWurDrAU+UoQSnKft7GToIIA6ZKx",
+	"2dN+E05YCmSYLA9JVIN/cJblotB0Xdnctdk7B7uKYQGPJVeol8CfMy5iGrkk1CXyrPgqVmahvKrEIrbi",
+	"YNGekaqvEYTHBRA5GfymkQpD4kLqOpYvWEmkbagdh1Kc0LzgD2aolZ8K4O9dQsneEu7+cvHc5XDHUk62",
+	"ZWtoRF8LvSJIlwkb29Oodg6bB6C78YQO4L+h6NyDMGWaT7nSFJUJGmYg6CJ6/D7pmYFcvvzeI58ec6Xn",
+	"/wmPwXBhigNIhnDZKmJEENgyQZzSWT26dK/wTVHZ6U1qfGuBC6jRXsiIJX410uLTGE0RRVEORhYQ/FFz",
+	"CVS/540hXBuoDuU+0jyy+o2lTDAbz/+piK3bgAXosLJxb5J1D/ZucWqWMOiG0qngydWwc5z7ZQuMjtcP",
+	"VqPVVrovGTpfPa8MYaFXevAZ9Rucl4LQx3r+/ShzKbiNizq5pzkfrgbEYkHQiEGwC+lyRhffqd6ChmHW",
+	"PZ+eBx3D7PMw+/HCPNQbcW8fcjFvJpn2IKPBKByt13B/YKKTEjpjn5gqE1iLZNeAUClZJNLIgrqELNV0",
+	"JnraBXqjtiy8a5cU23brrly3a+vtBer3U67kIhJF1k+/eorz/yfWPBEk4tStq1g/UUZdCOLQH7UQ4j1W",
+	"1rwnmdJF8mDvtzLNc9iMfq+VsHn145onCEE6eOJdUI++RfEMvbkG/g01MKCH2QlcUFqvJ16+GWxBBpLU",
+	"9DhUVB8n9MwP9eU04WlHk3SqFnwEW7R9o6JmNcZZ4NwPml2BNUfbXM0Gk7ly8VpgM6qH4zZVIwfseVQb",
+	"ubtHleHVl7m+M7UVrC15FyUdUc3VaYvJpZJC5zFXc9pi3vh34+Vogj8V0+2tnxo852Fkm78zpb4yIE+2",
+	"MSYKHhJbO4OSqV0PB0Oatga8DBtNwwq1KutSQQPdTOV/WlLXWmv45HRm+CnVqICpY1hhnzWoaIFxQ+nM",
+	"OoFOaTyhkiY90durK165bTTG4NKxl1FqyQFNLnGjPfpXGli1qWD1kSVjJiEegmrWUnO5zHV8R1MWI86U",
+	"uX0qjCbCD6wcen0o6m2HQuwrGzLkDgX5olQd2zn7NAqTJ6qKZQG1IqapEw6cUnKSqdAWIrNPgUKF33XR",
+	"Odc6kUlWgeC/ODd7MmV8h+RgR8tqboVdxgf7n1y/dq0O7io5UL6+ig4ls1dOcUfANKhi4GXTyfVpuod4",
+	"mmlTI6KtRNChpJ9yeNSiWhBLiH2VzP/QjCuyhf6FqYhMcYwEIewMepQDFnfLxfTZa8jeYaVMcMucGWAP",
+	"rROgZGrUcSe6sTGNlQ/v4oWE8iItjiKev09nWYyRt0XJpJ+DNYTO+ci2MiTPLgQe0upBqmm93lqVXD2B",
+	"sS94zFUeAFwYk3k6/z00ZWo0BAqLfgUKz3pe+M6XwdcEpfq8fd7qMVN8nLY4ULAFRt3TiJKwCGiJKFHZ",
+	"mCltnFx1pBk5pj7wePS33Nl9SD4xKaom6V1Xw8YgQ1P5pp9BozCktvZ619/rLnmfEJFhCHJssxxyz12f",
+	"i3teSqK13/3dhySZ/wp4DJWu71TnG4k8GAih8Iw6IhR5sAuX+J5mnSmdMo89aW/XXBFyIDhFgPRkKlRA",
+	"7CN2Zn5YwhVWLn1gN95dlnxQXvIzd3/pTcXygDljqSRPOsDRq5fkCM93sqWyk1Ck7zIbwRRJeqrJ/u7+",
+	"7vbe/i2MYzUgRQSFqfqOwKgCUnYVkEKaEpB3AUZB6YAUmRkBKcISAmIzNAJilo1sgaAJSCHkAoJBXLcC",
+	"YuU7vm3+gUoGPnhm/0XP7L/46Y6esHSHxYoRRmgcvzod+QL9x2Lb/vhOifT2a/rxhQ3gqO9Tvn6+nXjN",
+	"xpIqf+HwOvD52BTOI+b8QV55SLQt3cLymF94zBJLdxBoF+So2IQV/IBPU1MCtiFE1ocID0P0CbsnZxiS",
+	"CaOXbAx0aBUJDKW3BbiMleGTL2UjGNllOC7H3nGqu+2L4XuAB90LomfnYoOD0Y2EPjxovvK9RcYh83k/",
+	"ZakphNo2R7QW0MjWMKcBhdx8+vjAGvbv5X58R+yGd0/HmcHRM+twd0AZ/eVH3DpYOw6RRkwW5owFxrIF",
+	"wcfDYrD28uSUisGn7hdGDjP6fiiSqu0Nozb2CCX33PTIexdSn019QowRG32umZsWxJNIhqsVUUmKbIQy",
+	"sGS3ksK5G3Rxd59B7tpFbG55uZ7O8Fv2P4s1jcT3mVrgDKyWMOyPRiVZzGYtythreGbDMWqhBLbMnTEh",
+	"bCWIjD7/jSSUK2I/qdmtnti/koWTSunberU2eO5UMAJ9889vXjwPLMWZjDWmQjpFBxsRimgmQSNjqbn1",
+	"RELZQO6fst3dO2FC5Xv8FxzY5qed8jfvaWFG2XoDzkdJSeUCfMnjbMW/GlW2urHojfm1kWMiKjXhqkRI",
+	"xzKbOslMnRCb2NYAgxZfBPOfW39u0UdaitXhZ7JBCBhC07jygc4jtDLRojP/ms24orIJBNLBwQujRtF0",
+	"XDBhDhliaG5L1IN2RTpjUvcpurpEQmU17r4ePozPLC9gIVTM8czvTu5Q8YFkYaaovLU4jaZPfk9xfpUl",
+	"f3ObiF0T4+MyvVbidlrYyX7Iu89C01bIgt416csQI3S19w+rMzFkg5Av85BGj1m0JQjsz0Laur7MZjYg",
+	"CrbAkHgTGoYujv5BYCw5jku0gk6iN3YNm4KDFgCnRGdgwfeKELpR0G8dqkC/DnKCb1m0KRu6UCg1CpA2",
+	"buFUl9Fi3l0vd7S5SuVAfIRYq7PtN3TiYh49P6gd6wjmP8l3ef6HNtgBFVqewvtDTcG9KaL4+mAzbe8u",
+	"6k77Rq2wfNBl9lwRLp+DGg7pzNaV8/ZmwypBaShLFhpT2ip6H+pnQaSvttSJxuYEDVrw0qML1NVZS+Hq",
+	"BJqtLGasq8bbhS3ubTFc/SLAKpFeC0zuzfyh9nqbeWKQk0whqQqzdGKSJPNsoSlF46NNKfKevmXH7v23",
+	"q+sinCIqjD/1zA6Hicp4i0rMi4m1WDSipn++qwap8a9GjCTmlfqo0POKq4InamfX1kfX1V1TtDt9peIY",
+	"GRe3o0hxydNbglGYJVPJ2waxWJEdGmKw8giCAZq0CTZwbP01dXqFSVkLa7f3VqUjSkAMTFlFvQfHiFWg",
+	"h9drd5XkzpMCW3lhDdfgS+8uDO+40u3gh/m8zVw6laWuQsIF6+aeL4pVnyPLVzSNChxVOs6ojGgaud7Q",
+	"SkwaCyeW+aw8bOG+luVfIBarN0QcsK0EOzXv12ZDjbe41xUpGB2ha5GKqmuyvQrHsVmxlgCxSkvJQoMh",
+	"7fBN1w1hoV+u2d+SCUlY88e5ypa1J2uuSbeok83Nty/1NI85aXgND0hcJG42nL7uDWhhHExrsJEpqVa/",
+	"pC2DQNfq8CxwDY2bE9Yo/8k6Or0XBZEXdOmGsK35zLszEI9EYorrmq87tuHdfns1sJZ4hvR57GY2tRM6",
+	"MI0p/psTdsUi65KZjTEtZc0S0rhSnNxGMZkdrCZO2o0I/Cy+iJ+7Ey+NZBFMdYmWJSMac5L2+U2fItDZ",
+	"hzyauJApwGi6anl4SCiJSiGBTRADAPP5aPreWKuaUd4qn1t/MOGmnO1ZiKYamebM3B2GbwNaLVrrcQjm",
+	"YqiOJODgLO5fCChx3wEXaIX+8i4Enwq3bldLQrkgTtktkB6YXeECxjAFy58rBqkwu+E9V+GTfi9//rW8",
+	"/LYpOj4KCsMSi5nhTktworUDTy231rmZIm5YOpVbvExM/3Tm57gjK+GJVEt+klltDREBW0fUFVZCc5yK",
+	"1eRLrgTiboVodAFiFlM4ETIvCD6TyqoUNkDElCFEbScVM6EqcUo9w9Y9eHf5dbQynGHadXUrHzNN4wnb",
+	"BApdOFCoPx6Q6+j14/92RRnVCo23CgWYrtfqk1BwL0L1F2qNUobISvnXJgFaaoL7+ncqTEcF/mg+AgP2",
+	"GdKKiMxl5qnoGEGzzGzr/J2KGWjcqRSAdQZjgYEcs7+poDuqITS1j6kGWd4yHBG2jcAtO4u8DZG6oru/",
+	"Bha3p+Ct76bzHUGZQbY4QtJShH80THoLNPwTqhjZCqmi8Bd+03gsb7kDtmIHGrcOswVKzQ/3kjjQTv8l",
+	"nGV6WMCnsRw6jczm/0jc8ihmp+EHoDr4VISpFQ2hO3jLg5Ht3eaOy7YDW2jK1YKSpMv54CsftdnOfKD/",
+	"vPGNAS50259XEjW+3Zi0K1aOC6Dn737pE869wEQYVG7v+VUASB2GVV78a0G7lkB6wu3neuDQsee6R3X0",
+	"L0XC2s0OD81QXZoNiCCpfclJF/VmwB8blbbJYrgcxn9IlQl5Qby0wWWRi54GLYYHd7J23pTf8y14o9Pq",
+	"fFsJsyMcxuTJssiXcvhSaEqS+W8RbzgrKlFzPRYLu5kyCVdrr9p4WDxzD0yFW5TCOO4ChfTsLWKYRGLL",
+	"bDZJ0jpVWp52Fq+o8hcG1ZT8lQpCS3FFpkIWmfK9WMzkVx1PDTaBDzu9SAbJvxt0ddrbCZuyTMs2a+cy",
+	"DOztZKoW7ftUikTgxpGtBwTSKW5hApYyyVflvpItsHndv4V0uL23O8gCVvbSkf+rdBt9aHpGO9z/T2Vh",
+	"1FaFs0/lidPKJO0KSRNSevTUhbDDCgwh08PInUF9uIHL8U2+NLtUWaKSOiqM5RM2Lp5NEwA4LYJcoXcT",
+	"UoBqi6OOZKkJK4GhnY2C0XuuR8EIU/4NUsD78SgY+W0RTgmmbtt+rtjw/odYbpeKmNI8FUMNecZEPRwX",
+	"Ly81vMyQew7RVqMaiA9RvtUPE77LjWYXx7vIQcfOta5PbVItlAoN5MIC4rUdr4HhmTbI0lMmE5qykKHm",
+	"HbITDJ2tpqCRYsAXDnNsGiary9k53igHcTTlVBWTUOBCfsj4rA/I59CxtRh8y4F6t967baoDsSd0nvSt",
+	"9G2P2iHVIau4QX2rhY+cvvwzy/zmxJDKsbhQMscSdsdBX7+y5ZVqNRNwsDfAKbCE1faCyUq5gmF3xZDk",
+	"AFOrP6fpwfk0PZ+9O70z2dNGqalW+vsSQTsFLTdIcfWmd+NYjcWYp+0oyIWDL6/6RdIsDamtVZURJzxi",
+	"ZeEs7lZ3o6j1S7RdZiNM+kCuXdTWJkp4yhWmopiMyikm/oqMUDIz/oc+162NXX8pu/7xcNQm46PxXac8",
+	"Rv/a94u3yw1bQJcYtR9mkutz3ERDhaYu2kEGwviX0Qn+9TQf+F/++mYUjPCQR59drYbaROupkY1gK86V",
+	"DRoCQX8OatTzZsIV4YrkhTco/A67SfSEkVcxj5h6Tw4On0HZuJiHLFUoIVKKfZtl1nhMHn2k4zGTRJQv",
+	"2fUwXd25vXt7F14QU5bSKS9+wkp/E5z3zmxvp8Bm2YYDMaaaqR2zhMitwue8eAR3SS0pyRJiarnDYQ4f",
+	"MWhGTDqY7AahR1t/B8QB8vnfHSgf6rIsU7duk1eKYJIv1rxjCaEEQXSN5xb6YISBdkATW/7N2p1sdjca",
+	"hmyksFMPDj0muBwSl/1ZZJCM9SOcbeGreWOXYWTIjyn9vYjO841lKa4HnaKaDN/ZAV6A34weuFDFlJzK",
+	"RpWwzw1SeYzJdJGwCzxymUHLjBXR+crQ8P7u3srGmCdSe4ZlFisCwrq7u7uyHp9IKeRrOx9fv9/TiLw2",
+	"22H63ru8vt+mNNMTIfmnfOJ3Lq/zp0Ke8Chiqen5weX1XJAnySUDATlEaCwZjc4hJU5p9JTcu0xKeIaB",
+	"djQmUJaOSYIvVCT76Lu/VWX6337+/HMwUlmSUHleEDEJGxNEmxiowH8z9aAx0ehHV5zBmXK2HYqIjVm6",
+	"bQXE9omIzretkJY5lX4OWgVsxGKm2c4v+S/PHn82UhZ+9mX1JGLGSC4L2mXnQyJy2QlQcqGYchQjJqKu",
+	"ZnOA7eJpRpOGVHyM4/BJRPhQwjSTChd5IcE8ezyCg3H0HR46oyA/ycqJNwRb4BDKItPRzw0heHfFQvCu",
+	"jwRfCvLIdvE1y6K7X1YWCU1ORZZG11ECGQ5bUgJ1SZY82mTMPHobfhHkgJEjqkNrY4lVumh8Sk/mv2ke",
+	"0oacgO81pIQaNZhydXuDMzDak+pUn1792xdnzetGk7C2HopUFyVJY/5pHHbTzAfIkp0ozXXGrUs/IJSY",
+	"FpYsywMuWnwc4lWicubBqWiDu0lCUw0xMsUXi+Oy/LRTqqV2ccj0dTkfr9Md5osc35trzNesOmzuVCs5",
+	"Pd6ilP+Cd6r6+eLVgBCJqo/ZqiHwf2D6Wl6IVkdFPQT+FdC6NteSCzDxD0xf9E6CHqMeJmR8TqiNCrtN",
+	"nvP3LD7P4w80U4RKRiSbCqlZRD5yPSF3dx+QLI2ZUoSPUyGpPC7iFdCuDuzSZePFvtZp2DU9+GkFHxE7",
+	"8VwJWKwS7X5VZt3VncUHM66q5Tw90xbpacxDTbZJXKM/S5jX2r6Zk3vOvUVIU+8D1++ej9Xuu0n27iP/",
+	"MHn3afS5zvu5ddP8vcC2+ULMSjFAtEBPmJZUTQLynrEpT8eEa4UuT0VoGtlIqVCrNrtlPu3us9l02HYe",
+	"52O/CebJr/R+c11tgt1c2+TG89OTT+/D95mWe3LXw43FidqqFcOxP6VcKiJOc7lH9IRqPIOtZAS+VHBX",
+	"wXKRCZMBUaGQLCIn54VD20YgBGQ6ESlDdsX7jeIJB7e9PvcaER/nYzRzXb8J0TkX1MZ8uDrzYfP49NFx",
+	"nUQ7jdZAnDSO8w8GROATGsfn5JTHmlkSNGRJTjmLI3NQYMeee9yPe5bMnnO18Jx4ymMtTe4EBvOQqZvO",
+	"TlJhg3EECSd0xr4z+O1btkgMKLBMc2nKvk9jEbH8GMFD50PG5Llz6lCTj17uan8QFKXPMTAEhjP6HDTS",
+	"VOjYIsxLNuYK5oQg85o1R/uQaAH5CMoin44hD6HnFDQdr2QCP69bBBTk2MH+X+7kvI43R4dLBxxfkw/3",
+	"TvT0LD6JTs7GzeMrYXLccY9E9ZHNmDxHPqwoiHCawalVF0q5lqkyOeMzUDDt7/AyleGEz1jtxS10LRCR",
+	"xue3bpNHtgOFPxB0zmtRarHQ7/m0jOnHDyeZ0mgdEDMmYzr1DuFfVYd6+wKWwj0hV3+JbSQ/tF9kcTRf",
+	"5B7rZPNcSd7dWPUvwfJmiPALWvJL0wFdLHEszytP27oIuI7S38iCRbJ/kIXfiv9pJsd9TQmHJs8q1WDH",
+	"wTalSD6VIilNCxV57vHtFnJ2Y0XYBDl9MZlGeGrI9UsKN8s/E2pNcEGpoASEpzPBQ6aIkAQVtWtpLkV+",
+	"77K71IWSZEoLWRdLfgX1tWm7nCSyL29k0UYWXSVZdN0YPOfBASxu5tplkwIWtq1xcfpxNFo7UTeJLsvW",
+	"mU/yOT9jXNIr67f/Unx1PQ2thoYGmVnzQMzqqeULw7TBPMWZdXJujpVmDOSlnUyXFNp4AFgn/FMfT75d",
+	"pP4WkI3zb+M9WRQ+ty6X/d0P+9nuSUT3Ptz5eNK0slZlQrsfplsg/MD09+fPHl9ldXV1VIGhhB1S4uuz",
+	"Q15n/sPItypt93VgsLvvdPqRffq0f5K+62KtHXtFXqRVYjNi6pqfg5mQlnLBk4CDj16YT99wnkN9Njf/",
+	"i43rfqWZP4Y2kpyQOvTJ3AC0Q2Mm9WKCLl4og0rCWCh0mmG5qvPA/JdFREgiMjSOT0Qmi6tVmEmJzMnj",
+	"GGzlBjHJzxC2twMzuLVfrwrgrg0xro4YcycJzTexIMdiuT302DvqGGEowpIyEYODkp8KUK6fRm0y1wkn",
+	"ti+vNaC4QBPzWmnN4AfHFG+gIi7NqvFlrPbW34jRszmNX+dI5pLTPHJgsG+xkBdFpLL9ZYGD8XHhVMw5",
+	"z38PMu0c8dCtlOUfa1XLitFtbPdfZURwJ/U3qbpfOGXpxWsLqOy4czgxSsMuHPWARxdg7grdMTZK3RqV",
+	"ut7qXGGwrornBSbrbtkMRuurKJjXacruo0NurNlXWo28e6lqpCGJKxFcd1M02dzAvyZNti4ju0z43QIS",
+	"Et1tCzDmX13tddWm/A4p+dXltXtFwLW06Q/UnV0+2skQ27aLm4yNMlMsQnThDCsnUZ6CtAKTkslMqBot",
+	"rUW/HFgr/73F/r8CBnTB+zfsdwPZj2SWlLuYEBP4tk0C30JT7mN2ylPU9528P+S5PChLSJs0vlXigmkh",
+	"VVvUd2nXxS8+hQ+u1bTbLI7rowUzPRzNxtC7Sbv5spjA/8bOa1hVFr2cK8JSDWLhGluZHUniiiqD4V/h",
+	"1GV09Yp4yy3P+Gdvs7Mr6iCnUBpgYK4VmdE4Y8pEl+POgMIhWShk1FcCWrN1Rfp1qx7ueNrUDzvDjel6",
+	"E3beQ/NxKepGIPwOkil+WbHQnm8CJJyVi0A54tCi3bxvxbXXvF+KgIUW/if4GbKVl74U0kVcvdVi94fO",
+	"EZQp6Ltl9gWv0rR+dwBuV223Np6B1XkGHOJVy7JJ7i1wj9QuVwFwTUxPWJyziEENkVnMlL2juzzlPUNv",
+	"E0P/gFV1jq+bxH+agvAKJzQdM68L4qoesut0Qwy/72ycEpsrz0YlWbULYq3XnIirKdXhZEdl4zFTKFdb",
+	"lZdXMmIpViQoS89TzWd5yZIsIamYCVejcCpJmyrtU5HqrKw1Zcs9m+qlIqEAIM+Vnv9nGnKsK4yF0/kn",
+	"+wbF+tJQoJqSE6oYoXr+Gym6IFv4993dW4BED9UNESq4Wrs1sZDytvEdaFwvtlKOmqRCkfkfWOJPkQe7",
+	"JOJU2Vf3d28RRgSZ0inDApn5shCO2y/I1t4uANDQiBKVwfrCJLSkn6DHqTADwkoxsIzwiRDahpLr+W+S",
+	"e3GNH9stO3J2rFdcB1b5rm9Qn0iP5Q+pBq7VS1v0K2L5gvwfqAE+pZGEpbkXkGT+6xlPBLm326aNxjzh",
+	"uqqL2rp5UKwTa3WaP/Y8hfvXqXwe4YwEU4+Z4uN0A3lzM8K6LL8RVWG4XBAfOHKj1HWBMbD64rDyfHnd",
+	"PVM40ZQJBakpiFvJslGqMrB1M2mEzUVeO5PsdVbbILYMI6Eh45pi73ADPZF8TLWQnJmifKbWpWE7lZez",
+	"RMk144qf8NjUgw9FGvEQL6846JZClW12fCjh+AbYdY1G/FqB064iGLAYG5P9Rn+9ZJM9UKe5ld6s6n2n",
+	"+bwc0QnciOj0T0txN1yFdURtv3JaOu/WlbK9K2jlYmr9qUq4PN3SamPBWs6CVVCjWkiOHjIrTFeWFLqs",
+	"VwexZpK21seiRPHyPmROna4z24yRp/balcJr5vQ2d6/qPeYhEcUZr+b/JEkW0VxVSCNBsoTCW26t7Ybt",
+	"yzmWu5F3C8HVavUqlutKG75WryNszFwbM9f61IQvFvZ741QVa3G7FFWlfnh0179qvxfm4j0qhLgxkgUQ",
+	"wwX2HmrMXZlEU1jzjPCZmK6mzF+TrlWV94+ZpvGEbeIaW2XMdQxs7M/SC1h1B5is00hurhlUGXYE62Zu",
+	"m6GFzhAAuyaUKyJZiJoe2s/tbzTVfNx9+fgxH8XXwKC4ojBjwdRi5WzDpTfgWkZmJYEv4Negxax6aLiu",
+	"ccchUXlodpcibp6VZEqVogmhJFPzX7dj+pDUqhQXVfohwg/MomgmZWf8hEf4OCHlSOAvcHydCkkTcPpA",
+	"c6+FtMb3N/0uZvdOwnSpWMzzT0olqHadvTzzbd+xbmy5X/sl7foBbp/EXE2a0vmy7keF0rXzi/3X4iuT",
+	"T96bOxE1yhbKZNnw/JeCPCEspl23o6smjIP2nssN83RfPmzv+4v51PuK1a9X7bPbd4Muab3liys31M7J",
+	"OQqbnV/gfxelVWNnJ+eYjDGVQotQgNc6YmTr1dH2wcHBwfZL/L9bt8lz8ZHJkCoWGMBHrhTmh0p2ys9M",
+	"tSj7U8xoBP/9xKQwJZFpGLIpnPctguT780ciWmhoObQjRGnmid6pp5BGbDg68zqztfvx7gYuYv2dvxSa",
+	"PL32UuLkvMq0veJxBgAOpuwjdnSb/JXrCaFa8pOMy2OaaZFQ8A3be2MakVQQzUw05LHlIjpjsZEVJ0xp",
+	"Imn6nkXQapLykNM0h8mMPKFFBCxACuLHWOS9C5bRMuuMlOnmWHi6SW+9SRUhcUcbeOZVZroYpvkHFs4S",
+	"mpzunc6+PS2Blw1nFgmWQib9SpAbbcEpQN6SIGkZZbGK3qWdbyqLX/R8vcQL/xHKTosmEFJT24WcMJvg",
+	"g+l0NCWVBKFrnLN4agi8nWWbrKiSb88fvPtw/+N7nZ3VWXFhEJVd2SkdMzyI4b9bYSaVkPAHBqeI9NYC",
+	"pMLAOQ8DEvHTUx5mMeRmKU11pgIiQgMjHTILxRL0rRqdu0pUr3LR1JSLxlGx1SIfBm0dsrhueGCJLfhc",
+	"JBFsJSw5keIWmf9GrPiY/zpjccsQrRKyyiGSdP77zKQ2RBz3x2aB+vpP+YzFx9V25TBYCtaDv41i8XEU",
+	"jBIW8QyodsLHk9HPg0ZVD5lanMxgCKp38uoRNnf4yDeeZ+n895DjAKZMzn8XETroRSiknP8Ds1e2eBrG",
+	"meIz1pbJgK15JI4j5t+ziGq2rXnC+m1csqLhUL2K8ZSXVljkmGkBmTtasnAisCISVDKXhJ2xZBoLsr+7",
+	"f397d3d3r214ucYv6qkfz1k6BgG5v9tjVD9ixfeIwQUCEk2OreSSTAuZUlzAmJLp/FeQZISmGmSPbJML",
+	"+PLFGO7fM2rTpRvJSrA++UjKJJndMktmf3epNJn93UV5MsGmsv5XUll/Y5u5idHNaqBKuHfvw56Uyd4e",
+	"je/Iukpoyzr3uJx5izrDe8NLOm8ubhskm8t1p9yM8qnIPN23wip3F/WRHf5eVB15MEvbVzdMvWHqDVMv",
+	"XxN5AFsrRmXYXhT5aRbH25qdaWIaInS3Ne3wlBwKqbNxxhQLcv/FyTmRLGYzmoaMfAS/CNzeUY1gEVEp",
+	"n06ZVoF1eYIbFGSDogmz5h9FqMLfUHqgnakuJo5wLL1sN2+YTIQiJ+BfjISCG0jEThnXIiApJUrEGdoI",
+	"AniiRMxDrmmqGWEkNelYFXAKxRIwcjDJ4LYsCQ1xVW+TA5Mc/dPoVFIFsXxU059GAdnWMIA8mCSMMy4J",
+	"I69et1zHPnTHV7i3WHM3y//eG2Ad2dixNnasjR3rq7BjHVqzEIg+yVQWa5CCkGE6pVJzNHHttY0ArfSj",
+	"3gFeC0xWZfd+g9W+Y7DaW85gtbcxWG0MVjaYqKL0bExW191kZVS+BUarmmrLEx5T2e2llEyJeFY4gj9O",
+	"hGKoIYb4jCUnMTPq6JjPWEqcTwTkhJ0KyUpllStigoei2xiCiJCT5bu5S9Okc9kewT1p/pVQCQo0VWTC",
+	"4ulpFqNKjeozk17v5ZGZIXb1/fljZ3YLtOLHDrxAVGjEWMEigRfbHBnmKyEVfdXUe0uoqQ4OmaPtBCYJ",
+	"hs4ELF8Ti43F69Zmq8fZAmCy/SU9Ll8QmAzt/C5DHbGExROaaraRoDfE6G9lopU4J+euPOsrVvHCsG1L",
+	"2Vfsge1i1tTMMa/aCBsDxwtvB0TEEQOXBJdKt8ZpmJvKn02/V888uDpqMFPkoTAz3qQ0tJvrrncSq+WH",
+	"SUHS/fmvhx2+FgJp4pXzsClTRQLMbx8nooh54zogHycsRa3k4+T8NnnNqBIpwSR0wyvwrZCmIYsJBkSI",
+	"KUsfoiFN82ZLq18F+EUjBYrH4YSF78HAR+AWQZJMaQjAo6n6yGRLXHMpCK6EBFgDwhBCQck+rN/WdIM1",
+	"9NWmsS4b1fqFRfilIhK9kTRVWEgDB0HjWHxkUemnNPGkuhCVFa0lIqFIbYv43EhArzhTJEsLSXYdERmN",
+	"kuacUxeO+K+eY8bLtqgESuFq6ulBhsPVhDxHuX/osgKj1HN+xrikV1ZL+1Lu3GupoJm4pGiQxcmFfOxV",
+	"1zxPU/PXNL8yYRBrxFHskUG2KRiy0W6+rpwdB2JwTWl29MGD93fptx/eR3t8Ug/kXGjSKZPjVXvBcZO3",
+	"vrjY+HW21GzS1m+alTRPHh8aGf3x3SRK7s4ehNP345M2htqhWtNwAl9SixHIacrODIKwWyonFAl5+/o5",
+	"wlxE4mMaCxphKniK2IEMG1hYMdZqSz1wBnKD+dO4NHAdN7bTm2U7pRUKbtHO22D/gCQQMpfKDxnUVNo6",
+	"FVoE5PDxU4zaYWfwly3fRF7w728RWmFDqIuCcLvz3wiPWKohEMymhQnE92PzPyKBZZuO/nywvX/vPjQN",
+	"aRxmsQ0sYemMi1YjZ8mhV/oKkGSx5lMqtYHliqimVYqZSpif5oa57XpXuj3hKZXnno7dQf+teLWMnxMn",
+	"71iovZZRu62wxHa1TXTPT/lnfhpdKhQFyqBqkN0G829jLF3OWLp3mYYcHjMSUzlmkugJTa08NOO4d8nj",
+	"KFASrc32et7tUGOrnWE9DUw+RXLnl/KPBXl3r01peWFUSzyc8gOQyoR+YimNRAdCytU5kxrBOeXQWrt1",
+	"l2mTGrQR0ysdpEN/N6HE/QXFU+EKW3zL5ZqlCmu44i8R4jqiNt2S8+C/0D4qerzp19lnsGDldDfX2ht1",
+	"rQ0dOl6O5XZ+4Zp1up/MZCIGF2Bo25v74GIL/WHiFhabEfiB2+Q54zqTVMEkEGH9lPIzmj8n8MGEKG7z",
+	"KigkzFXwlI39SigsQDYVEUuIYpJQjHDJU0Zq+PttLrOCN55pllw5ReVRNeiprWuzh1fRdfeCypBKWNtO",
+	"IYREpnRBAz2u26vj2YWj++rBZO9csnjmili00i9yOghZjza81ufFAQYW1aa04vic8mQRyWKPhY3ttG3d",
+	"2G5MxMRIU55PXKRM9Qz6fpR3ftPVukdYZAbjiDY63Q3T6UoaHuqniCJCyQsq34Orr+AujOaGTz8seSrv",
+	"BPHkJxh75YQ0YgoUKFGaxq0uB8trNzTkCAGrSy7zWuDL9bUg4Bu46q8TAP/6ix+3onpYMPZaFYSdX+y/",
+	"ui6fTyJuInuhA9DLZlzxEx5zfW60BvuNh8bMBy0NHUDbupkPzYEs4trkl0DjqWQzLjJVVDzhirxnU53H",
+	"EENrJ+PGf4O8GoKweXe08qmtz2L5r6L8hY3vK4CNZVdtYj03BdkuHxAsJ8JrLf5RzH4p4b8DQnbBfdEn",
+	"q1XlBBh2Q3yCXX7FEnudl9MnEQ8FU93C+2u7ot4IOYG8mN8rmeWhQY6HKZW6R6HrKZv/nSpTWxFh+OpB",
+	"rYIYbKVQnICBH6MTsJIDUSLx1rzNJcAhlV+e9dfOg4cspGpBCNnGSnR9rURTKruYr81E9Jym87+bWqbI",
+	"YobD6gzGSDT/lZygUy4VJBYhRaBDprQAXB2Ofj1gu5SShKmEEi1pqowb8DY5Ygmc1vO/i7IpFkolIv8d",
+	"fYdphA6fkOr5r7EYt4e3As/eUEPTc5qGVAK7LuDWw3K/Noamr9k5ZjxjEMdUGmu/iCwNUAoRIYnSInyP",
+	"ckI3ysZearb+I5GexjzUZJvwVGVQAIqb7HwRvr+WvrsoKgX+um5l8G37J0jafnGh+QkSNc4ONgMQF0LJ",
+	"hxIDjXqOkYgRDCxB3FrKs45Y0qtwAPhrYSMLdPVqVnQTQrqRskuOBCnsWqutVmIslmNt8ik67cgulpRQ",
+	"IiREgSFwrZxx1DBrcinBfC1zd0xExKDwNbw3pin/RC2sOzyOMISsxHEnjLA0Yqi3Bi7qe1DgkaugxIkv",
+	"QOIJIzFPJxSTL03Mms4kCsz8PeLixbelRcO685C9khGTh9Hp9bq62q3zfL41kWtzT73299THeaqxMrSL",
+	"7CmBAYeyPow6ymLW6iF8zE55yoggEyGxhL+xEyuN7A3uQhOpGsI9t+C7Olqsyk6U5jrjKTwhdIx307xQ",
+	"38PixTJk1YTA2lBVlhTdw+2X0HicJWVv7+a/Es1hLUWmZTGq1AGkL/rEggahuZaDNHKCbUNUbrVBZE9Y",
+	"KhSi3PJxKiSVx8Vjotg7mmczrjN29ijfnBsKO4M7Ihfcy/9sN14hbH5BKBtv5CaG9UvGsK7tDn4w40o8",
+	"srLGsIgXN6+oxExoLBmNzkkuy6M8usKUYL6WAPN2KhYvtZhrxSRr1uail3MgIlAcWS/0O1I0xxgXjPkF",
+	"kFY9YbJsZNeZKM3jmCRUhxMGcPZUk4+0oNs2fbQY0A12ohwU+vrGieINkC/p7FprqjmCTzmf4U6VI53X",
+	"dTA3uX9VzupsHb78ISBHP/6Ai6aleM9IRDW9RURKaFlRAstsCWVTVwNTDA2+icwo/1XhWwH+VMqbf1Wl",
+	"1RX4nZIJVZO8kITL67dJr8xYN15OtWMqXykRsAbND7l/keZXrALRgigggstFCekrojZumq8Lshi7L5Qu",
+	"w/VC4oBstbFrWtWHj9PVwBy2qlorrQFkf0RhzNFykNQq/WAhy7UW+rlyTpNNXZxNbumXkssvhSZPr3Xs",
+	"T6Uiz2Bbqnl55xdVCgj43cqVHj5fkFkzoVkuv3Lw+0wx2eK9dYTRn20/V00kPXtcdxaBWJI8Ev4xVJbv",
+	"Jjh2N7JnI3u6YchS0EIq0sdRSIbfmF9jPSGiweCEelA2Bj3AKlX4XVMQCFWtUn0iWyK1EmjKJIqdWw8L",
+	"vcjoS452BHbGmGom8w64SP2X2o2U2kipjZS65lLqxVIyqkVXyuSMnbfeBBHKHUPg1IeMK3TPKqq5OvVC",
+	"IQUklJxGJiIuEoTFzFauF/GMRzbwpF69HSF/CTNWQhCktNUkb0Z7g+3xh3ahj8wih3RjjAeLCO77TbHA",
+	"50ScM2q+5xhAdeRwVyvXap6wmKes24KTJyHWinWpwDGsY6DEOHXzi42BvYRSLWFrQM8IJ1KkIhZjDlGu",
+	"GO/Sxq1v8lHe6CSkdEIfizcsmW449UbEdBV8qkvyHXSkfhTy/XYsxj1SfaEpgaZtZX8d/5gWGhjulJzy",
+	"lKsJiwhLteSsPQPwr0K+fw7juPG1LKYi1dRuy4YJb1QCYMEiy9y+x1xpJgkteSb/nOErpanUeN4x44cg",
+	"1DkbW93ClrFuMiSUw1K+Lf5rvowbUKiNE/jL1q1txt9NqCK05HTkapmlKaAtwqEuTVweV9c4MM+QOUlo",
+	"mtG4mOu6HMaFSrODErO9uvqREah2mesC1YQCGYi+yu9FlM6EQiWGlFX3y2/KhK5uvjR+hku0SB6/wfXG",
+	"8ByTd7SRxRtZvJHFlxSvg0KvmGMustYsiX/5aOTegixmW7qCumdEW/bxFZGkDZdQoW22dVosxSb9+PoI",
+	"q2JXb0JpmIUa2BB+3lFaTLuULDFtiNFUfETpagxLBo6GmXstNGhRocR0w/drg/ZMQyZlT9WNpdEmk+7r",
+	"Vdz8svBylTejjSkcgpUt11MbE9N1KWM8nQkeMrVjbV6tIhpsaFBISFIVZukEccBODSwCRWgILuEnWklZ",
+	"nmWxEqr0lisiMnLKwokBasDKRAlTSYGkYNzptlYYIwn60xkBqMAghxZkJGIKcmdshd63STW2xmZHE6Y0",
+	"lYQliJlmh5rCB0OahiymEb1NjhDpki1OmxZKm7vfM7NgozVaKp/iWH3E9NisWz6dza14A5r8dd3HHZSw",
+	"UzdXxoqxKCBZGjGJqXOShjowst9J1cNaGTQVmFZbggNdU2spL6RRfhwY4WF0s+VPgx41HamyUgggdKgi",
+	"CeXKZsIwRaaSJ4xLESDERl7/0evYtRJ1sVOXx1pSqLzuhlu1ZJlgg5CKSp4JS7ME1ig/w0bBiCVcc8Rl",
+	"nNIx/Kc4HEY/N/XqoH1MLhiQb0D26TGPKkP64p5mQy+bBJemRL+WHmZeMpNXIjQ4/Rf7L2t08zL8a6aF",
+	"TEHRs2pUKEqeJgz+WdH7LLSz8oVRlfpTJ7PbZu11E/NRX9k4jnYt7msL3sj38toHUS04bT8HfqCrA0Ro",
+	"glsLzFYEhMbz3z9kQtOAiBPF5AyOMsikRGjl0F5kzPWouG8xOFxpHGYx1lPWQlOuBtxgMv12GlUuMFeF",
+	"AdeAAqAzrEC70qvUxlS1uU1dhmT8crDLZjDWYEZJJOnptbwcGUG3tsuRozL1ATi1OlMrjGkN/vQ2eW1F",
+	"viKKMoNRmM7/SJiEQ4BHLNVYuTtCTSsRxFfrsdS0egCPXkVlawM/+pUqWgUCaS6PqtijvW8zOyZFpL1A",
+	"YcLB3pxQGVLDR2ABAAO1NQEUrHubvAT25UqB9Tj/FW0dJwwLY8z/iaAXOZdGlCj2IZv/Iw05NRaQmIZZ",
+	"CvXwX+Wfd5Q8R0agNYKwMz5mJBGazwwY8QmIkvo1C3ja4pLmAx2gDVpiOTKrdJO1wRdZROWRNQi1a4Qv",
+	"xaxmWLq8QvaLL4sbRXCjCF6SIqglTRUv6oHQOBYfjfG8knUXkVCkFkckPr+WRnScR3HQqFwUrkRdTCiH",
+	"ZQBhvz2NadrDxUojqkCaZwmBN/B0SGiaaZaa1GIAyGap5jNqrQMVtykcSWM0NMDZJKQ0BxDZev367fMn",
+	"twIXkXrGJCqROd69C4BMLJ7TbXKgLOI1/FfSpObeRZhUq4YSA7odssj2Gor0lI8zaaoetblTX5SrdBhj",
+	"gsra3KrwffHCLGdLFvLjvIAALv/Gvboxxl84m6Egb6QpR7i8cBn7sGDsVQgaEzm78wv81a8kUJvEedhQ",
+	"OgERf2wB52E3eJrRpCUCt8ncnUrmi9pitWqbZl6byNjrczNtbO1NiJBdhrnbmbaHy10ow6WqQzHwuthr",
+	"jNjb1S7kerzaQT/fPtmieAMWGZnSTNFI3Bri7r/80q24Od0qxsapfv2c6nVGVxfj9AzN05Xjuctv2HY2",
+	"3yavOs5mjHRMsohiZmIqZvntYEZjZkxHtNTuKU8jGx2JH6Bek9FVP8/X6Ehcx+Vh41DcaDvXx5v2Za4y",
+	"rpDswHNrtZgwrElvDPSlvPO5y67VZWWF8GyLBdvX5ka7WewLgUurvankPLkDSnmHPRMHL0UyZYSiWmE6",
+	"iVjOhsVh2dPMUFocvRbFQxjOxuawsTn0YeNLda40hsJVkbqATHQtJQvy27pki2QqSzqEC8QkJ7RNsFRS",
+	"0sZC0ofubSdyyndaw4K5+6jOC5BQ+jWOaiNjNjLmWskYGkIR2+tZgBwY7mJChiUnTC4wsIKWRKGiomns",
+	"t6IWz9ZrRnyrMiq52OTkVJmbbJOXwpIyUUwpaHLZDP9WMXkD7gMFJRdcZH/BK7vSYhrz8QRnw4H8z3cf",
+	"3JPv7ox330dnd0af66z1i/kHnNt5UcNWq+YPks7g3IaGcV6/n1AwtFUK7G9hUh1c3aeSO7+DM0BIuPFX",
+	"gtxuwQNKTqhiZEv5w+BuBQQOe6pISpVbwQpLWmHlR2tZPfAOz5hOhSR7+2QiJFUPScSmAgQtibjS8//E",
+	"yIcplZqRyAzGa0fF1Xqer9Qi9QFbtyoN+dJfRfPpc7uIIRVvGG6fj6ifV5baoYGNDXUTi3f5It7y242w",
+	"2ZqpxKWk8cr7ISbaVJjsA/ieWpwinje3CW6RIJnKsOo+oaC2aUxk8GaQWxcV/kpTzcfUr5a9rIxogTA1",
+	"AwOMkObg8AoYc3P383mZUyqO8+cNN/OJEDGj6eLKibVey+qJu275xCXrJ37xAor5boSCXVXk8mvpg05r",
+	"VJ4z8ssKNZW3nkr7HbgGbtM4brenvMA8CC2AEXvzrcmbyFmiaS6psOZrRqODOB599RaKa1mdCS7HFZoC",
+	"KgGyGkyLv7h/GlMfjRYRJiTJODT5X2IxSS6kyNdm9J3nhdu+VQWvTmhjv7tWVdPK7b3WGh8yqUuIA/hz",
+	"SqUelJ5ADRYbXLkTqpnkFIQDCame/xqLsa2KdvRvbwGPCRWfgISZ0iIgU8nmfze2egYxR4jTJj5kAPb2",
+	"e8oTsQwo22EJEb6e1AEWdoMI4HJs8gU2d9Uvl7V19G9vC3s/O+NKq2ucNzE1DJ0LridGRAy/rRrJ1ie0",
+	"uhReIJuQn1VATjIVYt1HNEbCEovM/DsVHghi+BbIooXX0DeShRPUYew3o+KLvjsfDqMztrlZdDYN44xj",
+	"9BPOhfAUgqlb77Yc28tjp1nHFXf9odQspJv74wrvj1NLlg2WqrJKEZtMpe4Rmwxsg1qBKE7B2+SwSnDG",
+	"rjIVEWJZSBLTdP53eAsgMdygnyXAjOzB38lr0Kbd5Y7zvNqhx6tSPja28o2tfMU9I29diaT1m6H+WLv9",
+	"ytQfITWN86Z9YV2ViHnIdW77oydMatQjYqHyfDAF9z3zeb8WhI8sN16XfLOWTgtcgH50YhBVjuwihlSY",
+	"tbgMranoVDBV9LpRoValQuGKElkSdVHv2jyJBHlkyffnNhaUbMbZx51f7A9dOtYjkc6YxFJHDkv+lzCY",
+	"9hXQe5R4qDphFIRkYaYMTGuWWBwhnxL1GgdT4dWFSECPSVQbj1+zKiZ4FZUrmLii0sekDfKBELRYg2k5",
+	"gpWdcdUPHWijbt1cozF5+kX1nesZyQmypiZEu2XokupOppjsX9CkcCJFuUJDZjwFmN1IEOqA7LSHlXVZ",
+	"pvGLEDW4Tvu0jRhtl2FFbmpzthur9ebWuBGg18g0bmRUpipVoFYtPQsIoUJ+9QYS8gjU/pLTQKtUpOZC",
+	"dbRFqvmsfc5sNm76jagaKKquKU5Rb4nhlQT9cIlyDlQO11cgCjusRMCPqh+jD7AOXc10+0qCz8ZEsyYT",
+	"Taaq2S0Lad0Wse1H65VE9ZIo2yLSOigfqqmtP5/MrRC5IblVk5z4mJJTu49D6S0vmryw8lLN3LcE0f3A",
+	"HJrrJ2ydHtdWIXmdshahYRziv9K0v9FqhiQw5kx3AZ6D4QNZqMUCP8Tbi9VvMJqB4zSKGrYX5M3qgfAo",
+	"H9dXwaS4yI9wgRcmPW/Y87odiiQsibk/o8ZizNN2y+1Bzkot1obKPcEDE4StnmMf60p7HfP0NfvQYuaM",
+	"WBpyype3x+6ueqQbqIGrq4yWcDuGumNLuCu3/O0kbKEe6iPYSvYNI+XxV7hR2pXRgzAUWarXeQUCgxnd",
+	"XHtWiftkt73Yu/6SXTL4hzKqWDumI+VnWDDt0dGPRJAJQCv8U/IQQ7MvevuuXITUaxzQYvrT7EzvhGp2",
+	"E2qSXduKYEg1ROZbNoTsbPTNIqfwwYk0BbmqATdVsgPKTEXClqG+UgEp423W5hbuFd5ShBHXY3o2XuGN",
+	"JrIUv76asvQS4jx2LhbWeqF7+quPqRPbugnqvN431aUCOxXTmqdjtXPC4xgO+4W6swIAKSEN3hTWyIdE",
+	"GBLVXQp0lsVKKLKFzUE0T4SNjk7nv88YetYiyOTNYpNbq+kZVvWKmIpFaEtLsrLOODzjCRx5wuNz/4Hp",
+	"780cjuyc1qyPm7pgIRWPcBFCuiHolVlGLTUSVW5lTszFyhf5390F61sI9jYxdfEMSHMoOTXgPame/yNx",
+	"X4LwY0jsPhWSQhRyqqHloCwvH12uMdWqL23+6FmWS7XbDGWiTTzdJQepXNPEoyHSY6jWVhyYWOk8ZNtC",
+	"Rkz2MDl1FEu3VdXz+pdvXz8nVCme0gjOVbCVCc2ngnzIMIt7IrIZkw1B8wPTR2ZMr2BIb1gyjSlaj9fG",
+	"wC9wTtBdYroWmzNwZWegJTAkF0l0uZ0XOAmjPII6pCeQbxtPBBRUDYUMiCCnmTK2T7AToW1UiohO57/5",
+	"qXZAJHnWTpprPAj7kWcRVW449FJPwGEMtDn/1t/5qzQ+dwhaC6lISFNbLZroCStZ8fqekMNly2rOSXCK",
+	"ih4B2M6hd0HRY6JHXenzHIawORQ3fLWGIOUqX8WG0gaf10/SGaeLeeDw5Q+QKPuXwyc/BITq+W9kn7zg",
+	"398KiMpOlOY646AuCixpLrmQy5/YBc+0ndZJFms+pVKjU2w7oppWN2gqoQPNDb9R+SGDbN5e3if3QP5b",
+	"8erPRUNx8o6F2o/JbReQwYoiOhEJaTIV5Kf8Oz+NNgf+5sDvL5ju7l3i2IB+iRaCxFSObff3Lrn7JFOa",
+	"nDCC0kaitLmeig86XwcK6FyZienORAAc63mPQMtTBtZEoQK0G2KkJSwTBX+rIlNJP5mQy6PnB17nzJ/z",
+	"nnqhgDsdugWjFHiEOC2Buff+7x/Q6TuaMi7xckdTWxKiDaSbHpvPHUfMD1QSWaXxsiMtn9oJb2TnzXBf",
+	"TUqKz3kRmAMVpEUorjn1PyQOczWcUYD+U2UQAsHQGBXBz2hijOCpppIIwzeD0uQty64zDMLSfKcFwy7F",
+	"pYY9dIxrE/Zw9XQZ4KvLzle33HFzgF0nBbtXxNVgG4mjVuz8Aodpz+R0y+eDzSClmOpULB5z6kgTsnVw",
+	"cHCw/eLF9uPHt/zZGVFpzF2Qm9FbY9jgDm1k1GVmq+Qy6lrD51srVIt4csXOFMOxWI/bTHlfmUqeMC4p",
+	"QVaFx1gJSDIl4gzjLQPwZCY8zUDXmv+hGVdBe8QPYcRYt1gew3abuFm+6A6FtQcqITM+RlGXKZq44xJF",
+	"E+wdK9oVfaeCxNmYYvjRVMTz3zUPqffSdfT84DBfk7XDQouYw0AUEvrGYbqqa8TR8wMyLTexcZXo9o2W",
+	"hJ4lfQkWLx2pmJWvQ3XFxJT9GnAJGWaYtaR6vm7/aU6nLWR6aBesylqXZ0tdMLyNEfUqHurX1GFaiJaL",
+	"K/1ahO+382KGgwrWEBon4oxKfgpquciIIDM2/z3MYmHFVl5z9DaB14o/wYINZ2FemC9LiheXKVRzBFNw",
+	"Cr+uzeYBfcQ5bnSX4QOWc4MBuIlZ/ILQ7ZqFk5SHnKaFnWNC4bCf0fQamzpQXPlqry4N514XgD3hihzR",
+	"pyBITRUizNh7c1nnL69aEVnr1/ChJ646JNdGxV9Oxa+SY2cpGGy6E7MZixdec4micWQUe5rXIoHbJP6F",
+	"hwuJihpvHQRmOutZJwDCgT3f9pbLhWYrrReQl1vx9TZlIR3a2fqrAsAO9WGpjePv2rFzzjaLeFl8XJwg",
+	"aQuF2apNYfYuZ24lEsR2SIgWcGAIhdzHFfDe/FfQj2tFHLEMwQnlZ25p74ipUxqHmC5kLWLe9Pzn4iPK",
+	"hL65+RdRRWOqheTCLhsAD2wC8VdEpeKjJdJGwn4LkYKPpg8iF9CTmKFtqEjmdcqIVqvIt9FZcfK8KHrt",
+	"ffis/gwIruBJ58UWq/XptLhwz9Uq+fUdLqNxdp06+fcG1cm//Gr4L/JZhIJtTuCbeAInjvhoyre2KJzn",
+	"JvU7SyhhqZY0ogFRdP47/Je+y5Qp5KMlTdUpk/N/pCGnjgS4TYxOR9IsDSmBms4JSdkYjm6hHhJaf3Us",
+	"6QwFZ5ThQS9TSN/NUj0oH1fUROa64NNoGlJZsg4Viw1ZtCIwLhm/xB0qDj6iG+vWpi6ikLVL99WolMhT",
+	"lZ2egqsvtULs2hvZklIgXdzIlskZO1c7RlpEO79o8Z6l7RDNiDIMnkmmPmRcmbxjnr4vMieoDWKuYMMG",
+	"RLHEYPlBwVzzqkFUsB1zuCYpE2c5E/EM/wa/fvmczJgEfanlHnWYncQ8PML5LCxIDZP0Dr2KuF+NXsKl",
+	"6QxfukxjR76MZt6deAiXKArM+tdYf2/30gdgNvZsivt0FfEtMXM7J9YCgKdgDQj7p5qrU3vAtytXPxhl",
+	"pwy2aWG7R8ZgadmWhoxro5LN/0h5WL4fkIiltsT1jMb2imJYxaskHaTqI5NXkPHWUUgRhRGT+Ua1VFC0",
+	"G1Gu96aAYlMv+9JSaffBpQ8gd/hRZBm2kY5t0tHIlEECclnNp+i0FZlFZQkzFuO8cyO44CchC4diUCDs",
+	"iQwunfPfRSQMVkuOWFbK2EdHB28IIy8Pj26TV0VrUJ0Uj5gEgR5RA2UGnYkAc9AInc7/qRCIIw3jzOhL",
+	"dctVE+0FZ3pk57lAQh+MZTalBbi/hNm32sOwrTyeCtkpnTuDyMr+is312cve0ISmExxTubbiRPIxRbhc",
+	"zNmlzuBha/KWLeMfS5pmMZV4xvWueP2D+1bnmJ+l899DXhkz2eK4c3zG2kx5XUl025onrJ9hM6l2y84W",
+	"dEv1avotDaqXXdxcFKzY0qVm+PQquSxRulQof2Mtvd7WUnvEqkLc9lHu7ZlUxgapnZDGLI2oXOwemnHF",
+	"NVWEjuGFyHRQ+C5znlAkLeUBhsAXUBHcyKl6VD1NQxajwRFzk+mUShayxHfEvGE0eZQPeFElF49UhHPP",
+	"lB1vYV0OyyL65w4tLSjLkZAtxMq4v08ijms6FaaKhV2uNkF6ypOLj3OdMucA6eSNEYYbkXMzUOA0owkJ",
+	"SxbMpY7ZbL+I+SVhyQnWur2YtCkP3vXKmHzkfSXNC5weefY459SqWSGf/YWqRQUb8bYRbxvxtn7xVkRN",
+	"Ly/kUnamt08lY9sqFnqBj0MU0TRkIqSBy4/5TLKKvDNIrJHBgvkvYDRwVZQ2VAFmFhZZ5H3MvYg42Fw1",
+	"h0LhrMjRVrcIQ3NtiL4rTaX5dilwwVtSCN3b5ICc4DjpzHjXDTfvIjd3i8+X7Ew/lYwdwSJcRRH6uFjO",
+	"iNrpOwmjbddmgw99XLZqH1FCz3iSJaPv9u7e3Q1GCU/tn0EzaqZdwFO7A0WwDh0LSS8EkvMl5OWfhaSS",
+	"i+dA2xtp+aVrIRKQT0TFrqn6ugnspzyNXIkNcrec13C5LUWm26u1AUhe6i1VXUjpPKM2NZg9mHUrWZR9",
+	"4gjj49aR2JrxTxwMjRhZOZXzf2LonWLjjJvI//1tMdXGrhtBra7/TENQoMDTxtMJfFjTW4RZR7axHFNJ",
+	"c4d25Z3iaMHgYp6Y8B5Gwgkb04gakGVJ3zFTh+kHJv5y9OrlAiUaY6wSk/tSpiAre7oIXC4YzQwqGMz/",
+	"F4xOs+4j4zXuwJU8KwwGiRQaj2oXgwTT+hGrunIMt50fF8UmCZphb5rrzHhPpwIN6gwXm0eUbIlpyEVK",
+	"4wAj04wmISQfs+Q4FukY32w7S/J2toOW80RkJ7Ez0DSD9fcONO/PN9IFQ8hfvegY/pzrWBGzsYHOuQrs",
+	"m0laV6eQm9vWSFEz+IveoH5ksQiNFzyZ/1aID5pzrMkfKMZ6d5e8T3YmbaOaFV87fp9MLrpoAJAOeiqb",
+	"cWUkREhLlakY0/3dXHe61WoZT6bi2IgpR4PyaEzf7C9SmNZqLhc6v9ltVJWbdrGLKI/PibRHTZuOkCkm",
+	"e2TD43NCSco+EnilI039rXm8tuz0tyqjkncg3+EACTirLxUX4xqkpu/vf7G+r1xMhI+kcyYBEh4Avg/t",
+	"lBbTmI8nODUOHPFhj51NYvHN/Vh+spFNJcO5YHN+2DkLKoW0PJXilMesBV4ORttaC/lS4qBeg4hSgkiA",
+	"yuOR0b5UFjKlxFd7hJBt8lIQGmo+Y0QxpaDJZd+AgTZuBLhaO4c2OY+end8/0cl9Ojl796nJeZryjtR0",
+	"OEWR6fKGnptcJ8etbnnRctpx3L36tw1zbZjroirjEM6K7pzPpt++T5L7704e1DkLI6Pbdcjn8JjQdvUR",
+	"G6xRe8Tvv2YfWrcyovpyodTsiK6oV+3KqWyGgtahq+19I8W90+zend3x2b06XWeIRYaE7YMytFBlnWfG",
+	"YaZNszWSd4Ef2HFivK0OchNEvznNbtBp5nDicAFh3/JJB723n56djeW3n+6Fzk3uo5AAqDVWO1po2qFS",
+	"HgF2OE+5mrCIwFsQfa7IyTkBI2XgGm2EtA6Gh+h34EyRUAqloK6qnjAyZZKLiCA+gSKogRIBKJD4kEpN",
+	"OIa8O419CuxfhXz/XIzfmHEPimFXBGbL1eUEsR9MsYQL/HMTE76JCV9G8rxBcq0R0uYudb1t3X+1UpTo",
+	"XIQVBm7Hlwtm7h5fxVEYyZfJePTdaIdO+ehzyx1oev9bph5kd8bf7p0C7f6/AwBO0KRgm8MDAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
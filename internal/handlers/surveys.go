@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSurveyNotFound        = "Pesquisa de satisfação não encontrada"
+	ErrSurveyExpired         = "O link da pesquisa expirou"
+	ErrSurveyAlreadyAnswered = "Esta pesquisa já foi respondida"
+	ErrInvalidSurveyAnswer   = "Resposta inválida: informe a satisfação de 1 a 5, a recomendação de 0 a 10 e um comentário de até 1000 caracteres"
+	ErrInvalidSurveySummary  = "Filtro inválido: agrupe por tecnico, cliente ou periodo (com granularidade dia, semana ou mes) e informe um período com fim posterior ao início"
+)
+
+// Get survey
+// (GET /v1/surveys/respond/{token})
+func (api *Handlers) GetPublicSurvey(w http.ResponseWriter, r *http.Request, token string) *spec.Response {
+	survey, err := api.surveysUsecase.GetPublicSurvey(token, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrSurveyNotFound) {
+			return spec.GetPublicSurveyJSON404Response(spec.ErrorResponse{
+				Message: ErrSurveyNotFound,
+			})
+		}
+		if errors.Is(err, domains.ErrSurveyExpired) {
+			return spec.GetPublicSurveyJSON410Response(spec.ErrorResponse{
+				Message: ErrSurveyExpired,
+			})
+		}
+		return spec.GetPublicSurveyJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetPublicSurveyJSON200Response(spec.PesquisaPublica{
+		Protocolo:   survey.FormCode,
+		Cliente:     survey.ClientName,
+		Tecnicos:    survey.Technicians,
+		ResolvidoEm: survey.ResolvedAt,
+		ExpiraEm:    survey.ExpiresAt,
+		Respondida:  survey.Answered,
+		NotaCsatMin: survey.MinCSATScore,
+		NotaCsatMax: survey.MaxCSATScore,
+		NotaNpsMin:  survey.MinNPSScore,
+		NotaNpsMax:  survey.MaxNPSScore,
+	})
+}
+
+// Answer survey
+// (POST /v1/surveys/respond/{token})
+func (api *Handlers) PostAnswerSurvey(w http.ResponseWriter, r *http.Request, token string) *spec.Response {
+	var payload spec.ResponderPesquisa
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostAnswerSurveyJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostAnswerSurveyJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidSurveyAnswer,
+		})
+	}
+
+	err := api.surveysUsecase.AnswerSurvey(token, usecase.AnswerSurveyInput{
+		CSATScore: payload.NotaCsat,
+		NPSScore:  payload.NotaNps,
+		Comment:   stringOrEmpty(payload.Comentario),
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrInvalidSurveyAnswer):
+			return spec.PostAnswerSurveyJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSurveyAnswer,
+			})
+		case errors.Is(err, domains.ErrSurveyNotFound):
+			return spec.PostAnswerSurveyJSON404Response(spec.ErrorResponse{
+				Message: ErrSurveyNotFound,
+			})
+		case errors.Is(err, domains.ErrSurveyAlreadyAnswered):
+			return spec.PostAnswerSurveyJSON409Response(spec.ErrorResponse{
+				Message: ErrSurveyAlreadyAnswered,
+			})
+		case errors.Is(err, domains.ErrSurveyExpired):
+			return spec.PostAnswerSurveyJSON410Response(spec.ErrorResponse{
+				Message: ErrSurveyExpired,
+			})
+		}
+		return spec.PostAnswerSurveyJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostAnswerSurveyJSON204Response(spec.Resp204{
+		Message: "Obrigado pela sua resposta",
+	})
+}
+
+// Get form survey
+// (GET /v1/forms/{formID}/survey)
+func (api *Handlers) GetFormSurvey(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetFormSurveyJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	survey, err := api.surveysUsecase.GetFormSurvey(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrSurveyNotFound) {
+			return spec.GetFormSurveyJSON404Response(spec.ErrorResponse{
+				Message: ErrSurveyNotFound,
+			})
+		}
+		return spec.GetFormSurveyJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.GetFormSurveyJSON200Response(toSpecPesquisaSatisfacao(survey))
+}
+
+// Survey summary
+// (GET /v1/surveys/summary)
+func (api *Handlers) GetSurveySummary(w http.ResponseWriter, r *http.Request, params spec.GetSurveySummaryParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetSurveySummaryJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	clientID, ok := parseOptionalUUID(params.ClienteID)
+	if !ok {
+		return spec.GetSurveySummaryJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidSurveySummary,
+		})
+	}
+	memberID, ok := parseOptionalUUID(params.TecnicoID)
+	if !ok {
+		return spec.GetSurveySummaryJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidSurveySummary,
+		})
+	}
+
+	input := usecase.SurveySummaryInput{
+		GroupBy:  params.AgruparPor.ToValue(),
+		ClientID: clientID,
+		MemberID: memberID,
+	}
+	if params.Granularidade != nil {
+		input.Granularity = params.Granularidade.ToValue()
+	}
+	if params.De != nil {
+		input.From = *params.De
+	}
+	if params.Ate != nil {
+		input.To = *params.Ate
+	}
+
+	summary, err := api.surveysUsecase.SurveySummary(input, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidSurveySummary) {
+			return spec.GetSurveySummaryJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidSurveySummary,
+			})
+		}
+		return spec.GetSurveySummaryJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.ResumoPesquisas{
+		AgrupadoPor: params.AgruparPor,
+		Grupos:      make([]spec.TotalPesquisas, 0, len(summary.Groups)),
+	}
+	if summary.Granularity != "" {
+		resp.Granularidade = params.Granularidade
+	}
+	for _, g := range summary.Groups {
+		resp.Grupos = append(resp.Grupos, toSpecTotalPesquisas(g))
+	}
+
+	return spec.GetSurveySummaryJSON200Response(resp)
+}
+
+func toSpecPesquisaSatisfacao(s *usecase.SurveyOutput) spec.PesquisaSatisfacao {
+	var situacao spec.SituacaoPesquisa
+	_ = situacao.FromValue(s.Status)
+
+	pesquisa := spec.PesquisaSatisfacao{
+		ID:              s.ID.String(),
+		FormularioID:    s.FormID.String(),
+		Situacao:        situacao,
+		EnviadaPara:     optionalString(s.SentTo),
+		TentativasEnvio: s.SendAttempts,
+		EnviadaEm:       optionalTime(s.SentAt),
+		ExpiraEm:        optionalTime(s.ExpiresAt),
+		RespondidaEm:    optionalTime(s.RespondedAt),
+		CriadaEm:        s.CreatedAt,
+	}
+	if !s.RespondedAt.IsZero() {
+		pesquisa.NotaCsat = &s.CSATScore
+		pesquisa.NotaNps = &s.NPSScore
+		pesquisa.Comentario = &s.Comment
+	}
+	return pesquisa
+}
+
+func toSpecTotalPesquisas(t usecase.SurveyTotalOutput) spec.TotalPesquisas {
+	total := spec.TotalPesquisas{
+		Nome:           t.Name,
+		Enviadas:       t.Sent,
+		Respostas:      t.Answered,
+		TaxaResposta:   t.ResponseRate,
+		CsatMedio:      t.CSATAverage,
+		CsatPercentual: t.CSATPercent,
+		Nps:            t.NPS,
+		Promotores:     t.Promoters,
+		Neutros:        t.Passives,
+		Detratores:     t.Detractors,
+		InicioPeriodo:  optionalTime(t.PeriodStart),
+	}
+	if t.ID != uuid.Nil {
+		id := t.ID.String()
+		total.ID = &id
+	}
+	return total
+}
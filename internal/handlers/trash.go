@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+
+	"github.com/google/uuid"
+)
+
+var ErrClientHasHistory = "Cliente possui atendimentos, contratos, faturas ou unificações e não pode ser excluído definitivamente"
+
+// List deleted clients
+// (GET /v1/clients/trash)
+func (api *Handlers) ListDeletedClients(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListDeletedClientsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.ListDeletedClientsJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	clients, err := api.clientsUsecase.ListDeletedClients(r.Context())
+	if err != nil {
+		return spec.ListDeletedClientsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	list := make([]spec.ClienteLixeira, 0, len(clients))
+	for _, c := range clients {
+		var clientType spec.ClienteLixeiraTipoCliente
+		_ = clientType.FromValue(c.ClientType)
+
+		list = append(list, spec.ClienteLixeira{
+			ID:          c.ID.String(),
+			NomeCliente: c.ClientName,
+			TipoCliente: clientType,
+			CnpjOuCpf:   c.CnpjOrCpf,
+			DeletedAt:   c.DeletedAt.UTC(),
+		})
+	}
+
+	return spec.ListDeletedClientsJSON200Response(spec.ListaClientesLixeira{
+		Clientes: list,
+	})
+}
+
+// Restore client
+// (POST /v1/clients/restore/{clientID})
+func (api *Handlers) RestoreClient(w http.ResponseWriter, r *http.Request, clientID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.RestoreClientJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.RestoreClientJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	if err := api.clientsUsecase.RestoreClient(uuid.MustParse(clientID), r.Context()); err != nil {
+		if errors.Is(err, domains.ErrClientNotFound) {
+			return spec.RestoreClientJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.RestoreClientJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.RestoreClientJSON204Response(spec.Resp204{
+		Message: "Cliente restaurado com sucesso",
+	})
+}
+
+// Purge client
+// (DELETE /v1/clients/purge/{clientID})
+func (api *Handlers) PurgeClient(w http.ResponseWriter, r *http.Request, clientID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PurgeClientJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PurgeClientJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	if err := api.clientsUsecase.PurgeClient(uuid.MustParse(clientID), r.Context()); err != nil {
+		switch {
+		case errors.Is(err, domains.ErrClientNotFound):
+			return spec.PurgeClientJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrClientHasHistory):
+			return spec.PurgeClientJSON409Response(spec.ErrorResponse{
+				Message: ErrClientHasHistory,
+			})
+		}
+		return spec.PurgeClientJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PurgeClientJSON204Response(spec.Resp204{
+		Message: "Cliente excluído definitivamente",
+	})
+}
+
+// List deleted forms
+// (GET /v1/forms/trash)
+func (api *Handlers) ListDeletedForms(w http.ResponseWriter, r *http.Request) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListDeletedFormsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.ListDeletedFormsJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	forms, err := api.formsUsecase.ListDeletedForms(r.Context())
+	if err != nil {
+		return spec.ListDeletedFormsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	list := make([]spec.FormularioLixeira, 0, len(forms.Forms))
+	for _, f := range forms.Forms {
+		list = append(list, spec.FormularioLixeira{
+			ID:             f.ID.String(),
+			Protocolo:      f.PublicCode,
+			ClienteID:      f.ClienteId.ID.String(),
+			NomeCliente:    f.ClienteId.ClientName,
+			Solicitante:    f.SolicitedBy,
+			DataOcorrencia: f.DataDeAbertura,
+			DeletedAt:      f.DeletedAt.UTC(),
+		})
+	}
+
+	return spec.ListDeletedFormsJSON200Response(spec.ListaFormulariosLixeira{
+		Formularios: list,
+	})
+}
+
+// Restore form
+// (POST /v1/forms/restore/{formID})
+func (api *Handlers) RestoreForm(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.RestoreFormJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.RestoreFormJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	if err := api.formsUsecase.RestoreForm(uuid.MustParse(formID), r.Context()); err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.RestoreFormJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.RestoreFormJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.RestoreFormJSON204Response(spec.Resp204{
+		Message: "Formulário restaurado com sucesso",
+	})
+}
+
+// Purge form
+// (DELETE /v1/forms/purge/{formID})
+func (api *Handlers) PurgeForm(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PurgeFormJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+	if admin, err := api.isAdmin(r.Context(), userID); err != nil || !admin {
+		return spec.PurgeFormJSON403Response(spec.ErrorResponse{
+			Message: ErrForbidden,
+		})
+	}
+
+	if err := api.formsUsecase.PurgeForm(uuid.MustParse(formID), r.Context()); err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.PurgeFormJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.PurgeFormJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PurgeFormJSON204Response(spec.Resp204{
+		Message: "Formulário excluído definitivamente",
+	})
+}
@@ -0,0 +1,365 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/handlers/spec"
+	"olidesk-api-2/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidWorkLog        = "Apontamento inválido: informe técnico, tipo (deslocamento ou no_local) e observação com até 2000 caracteres"
+	ErrInvalidWorkLogPeriod  = "Período inválido: o fim deve ser posterior ao início, não pode estar no futuro e o apontamento deve ter no máximo 24 horas"
+	ErrWorkLogMemberNotFound = "Técnico não encontrado"
+	ErrWorkLogOverlap        = "O técnico já possui um apontamento neste período ou um cronômetro em andamento"
+	ErrWorkLogNotRunning     = "O cronômetro não está em andamento"
+	ErrInvalidWorkLogTotals  = "Filtro inválido: agrupe por atendimento, tecnico ou cliente e informe um período com fim posterior ao início"
+)
+
+// Create manual work log
+// (POST /v1/forms/{formID}/work-logs)
+func (api *Handlers) PostFormWorkLog(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostFormWorkLogJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.CriarApontamento
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostFormWorkLogJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostFormWorkLogJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidWorkLog,
+		})
+	}
+
+	notes := ""
+	if payload.Observacao != nil {
+		notes = *payload.Observacao
+	}
+
+	id, err := api.workLogsUsecase.CreateWorkLog(uuid.MustParse(formID), usecase.CreateWorkLogInput{
+		MemberID:  uuid.MustParse(payload.TecnicoID),
+		Kind:      payload.Tipo.ToValue(),
+		StartedAt: payload.Inicio,
+		EndedAt:   payload.Fim,
+		Notes:     notes,
+		CreatedBy: userID,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PostFormWorkLogJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrWorkLogOverlap):
+			return spec.PostFormWorkLogJSON409Response(spec.ErrorResponse{
+				Message: ErrWorkLogOverlap,
+			})
+		case errors.Is(err, domains.ErrMemberNotFound):
+			return spec.PostFormWorkLogJSON400Response(spec.ErrorResponse{
+				Message: ErrWorkLogMemberNotFound,
+			})
+		case errors.Is(err, domains.ErrInvalidWorkLogPeriod):
+			return spec.PostFormWorkLogJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidWorkLogPeriod,
+			})
+		case isWorkLogInputError(err):
+			return spec.PostFormWorkLogJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidWorkLog,
+			})
+		}
+		return spec.PostFormWorkLogJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostFormWorkLogJSON201Response(spec.Resp200{
+		Message: "Apontamento criado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// List form work logs
+// (GET /v1/forms/{formID}/work-logs)
+func (api *Handlers) ListFormWorkLogs(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.ListFormWorkLogsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	logs, err := api.workLogsUsecase.ListWorkLogs(uuid.MustParse(formID), r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return spec.ListFormWorkLogsJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.ListFormWorkLogsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.ListaApontamentos{
+		Apontamentos: make([]spec.Apontamento, 0, len(logs.WorkLogs)),
+		Total:        toSpecTotalApontamentos(logs.Total),
+	}
+	for _, l := range logs.WorkLogs {
+		resp.Apontamentos = append(resp.Apontamentos, toSpecApontamento(l))
+	}
+
+	return spec.ListFormWorkLogsJSON200Response(resp)
+}
+
+// Start work log timer
+// (POST /v1/forms/{formID}/work-logs/start)
+func (api *Handlers) PostStartFormWorkLog(w http.ResponseWriter, r *http.Request, formID string) *spec.Response {
+	userID, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostStartFormWorkLogJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.IniciarApontamento
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostStartFormWorkLogJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostStartFormWorkLogJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidWorkLog,
+		})
+	}
+
+	notes := ""
+	if payload.Observacao != nil {
+		notes = *payload.Observacao
+	}
+
+	id, err := api.workLogsUsecase.StartWorkLog(uuid.MustParse(formID), usecase.StartWorkLogInput{
+		MemberID:  uuid.MustParse(payload.TecnicoID),
+		Kind:      payload.Tipo.ToValue(),
+		Notes:     notes,
+		CreatedBy: userID,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrFormNotFound):
+			return spec.PostStartFormWorkLogJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrWorkLogOverlap):
+			return spec.PostStartFormWorkLogJSON409Response(spec.ErrorResponse{
+				Message: ErrWorkLogOverlap,
+			})
+		case errors.Is(err, domains.ErrMemberNotFound):
+			return spec.PostStartFormWorkLogJSON400Response(spec.ErrorResponse{
+				Message: ErrWorkLogMemberNotFound,
+			})
+		case isWorkLogInputError(err):
+			return spec.PostStartFormWorkLogJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidWorkLog,
+			})
+		}
+		return spec.PostStartFormWorkLogJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostStartFormWorkLogJSON201Response(spec.Resp200{
+		Message: "Cronômetro iniciado com sucesso",
+		ID:      id.String(),
+	})
+}
+
+// Stop work log timer
+// (POST /v1/forms/{formID}/work-logs/{workLogID}/stop)
+func (api *Handlers) PostStopFormWorkLog(w http.ResponseWriter, r *http.Request, formID string, workLogID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.PostStopFormWorkLogJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	var payload spec.EncerrarApontamento
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return spec.PostStopFormWorkLogJSON400Response(spec.ErrorResponse{
+			Message: ErrBadRequest,
+		})
+	}
+	if err := api.validator.Struct(payload); err != nil {
+		return spec.PostStopFormWorkLogJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidWorkLog,
+		})
+	}
+
+	err = api.workLogsUsecase.StopWorkLog(uuid.MustParse(formID), uuid.MustParse(workLogID), usecase.StopWorkLogInput{
+		EndedAt: payload.Fim,
+		Notes:   payload.Observacao,
+	}, r.Context())
+	if err != nil {
+		switch {
+		case errors.Is(err, domains.ErrWorkLogNotFound):
+			return spec.PostStopFormWorkLogJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		case errors.Is(err, domains.ErrWorkLogNotRunning):
+			return spec.PostStopFormWorkLogJSON409Response(spec.ErrorResponse{
+				Message: ErrWorkLogNotRunning,
+			})
+		case errors.Is(err, domains.ErrInvalidWorkLogPeriod):
+			return spec.PostStopFormWorkLogJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidWorkLogPeriod,
+			})
+		case isWorkLogInputError(err):
+			return spec.PostStopFormWorkLogJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidWorkLog,
+			})
+		}
+		return spec.PostStopFormWorkLogJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.PostStopFormWorkLogJSON204Response(spec.Resp204{})
+}
+
+// Delete work log
+// (DELETE /v1/forms/{formID}/work-logs/{workLogID})
+func (api *Handlers) DeleteFormWorkLog(w http.ResponseWriter, r *http.Request, formID string, workLogID string) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.DeleteFormWorkLogJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	if err := api.workLogsUsecase.DeleteWorkLog(uuid.MustParse(formID), uuid.MustParse(workLogID), r.Context()); err != nil {
+		if errors.Is(err, domains.ErrWorkLogNotFound) {
+			return spec.DeleteFormWorkLogJSON404Response(spec.ErrorResponse{
+				Message: ErrNotFound,
+			})
+		}
+		return spec.DeleteFormWorkLogJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	return spec.DeleteFormWorkLogJSON204Response(spec.Resp204{})
+}
+
+// Work log totals
+// (GET /v1/work-logs/totals)
+func (api *Handlers) GetWorkLogTotals(w http.ResponseWriter, r *http.Request, params spec.GetWorkLogTotalsParams) *spec.Response {
+	_, err := GetUserIDFromContext(r.Context())
+	if err != nil {
+		return spec.GetWorkLogTotalsJSON401Response(spec.ErrorResponse{
+			Message: ErrNotAuthorized,
+		})
+	}
+
+	clientID, ok := parseOptionalUUID(params.ClienteID)
+	if !ok {
+		return spec.GetWorkLogTotalsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidWorkLogTotals,
+		})
+	}
+	memberID, ok := parseOptionalUUID(params.TecnicoID)
+	if !ok {
+		return spec.GetWorkLogTotalsJSON400Response(spec.ErrorResponse{
+			Message: ErrInvalidWorkLogTotals,
+		})
+	}
+
+	input := usecase.WorkLogTotalsInput{
+		GroupBy:  params.AgruparPor.ToValue(),
+		ClientID: clientID,
+		MemberID: memberID,
+	}
+	if params.De != nil {
+		input.From = *params.De
+	}
+	if params.Ate != nil {
+		input.To = *params.Ate
+	}
+
+	totals, err := api.workLogsUsecase.WorkLogTotals(input, r.Context())
+	if err != nil {
+		if errors.Is(err, domains.ErrInvalidWorkLogGroup) || errors.Is(err, domains.ErrInvalidWorkLogRange) {
+			return spec.GetWorkLogTotalsJSON400Response(spec.ErrorResponse{
+				Message: ErrInvalidWorkLogTotals,
+			})
+		}
+		return spec.GetWorkLogTotalsJSON500Response(spec.ErrorResponse{
+			Message: ErrInternalError,
+		})
+	}
+
+	resp := spec.TotaisApontamentos{
+		AgrupadoPor: params.AgruparPor,
+		Totais:      make([]spec.TotalApontamentos, 0, len(totals.Totals)),
+	}
+	for _, t := range totals.Totals {
+		resp.Totais = append(resp.Totais, toSpecTotalApontamentos(t))
+	}
+
+	return spec.GetWorkLogTotalsJSON200Response(resp)
+}
+
+func isWorkLogInputError(err error) bool {
+	return errors.Is(err, domains.ErrWorkLogMemberRequired) ||
+		errors.Is(err, domains.ErrInvalidWorkLogKind) ||
+		errors.Is(err, domains.ErrInvalidWorkLogNotes)
+}
+
+func toSpecApontamento(l usecase.WorkLogOutput) spec.Apontamento {
+	a := spec.Apontamento{
+		ID:              l.ID.String(),
+		NomeTecnico:     l.MemberName,
+		Inicio:          l.StartedAt,
+		EmAndamento:     l.Running,
+		DuracaoSegundos: l.DurationSeconds,
+		Observacao:      l.Notes,
+		CreatedAt:       l.CreatedAt,
+	}
+	_ = a.Tipo.FromValue(l.Kind)
+	_ = a.Origem.FromValue(l.Source)
+	if l.MemberID != uuid.Nil {
+		id := l.MemberID.String()
+		a.TecnicoID = &id
+	}
+	if !l.Running {
+		end := l.EndedAt
+		a.Fim = &end
+	}
+	return a
+}
+
+func toSpecTotalApontamentos(t usecase.WorkLogTotalOutput) spec.TotalApontamentos {
+	total := spec.TotalApontamentos{
+		Nome:                 t.Name,
+		DeslocamentoSegundos: t.TravelSeconds,
+		NoLocalSegundos:      t.OnSiteSeconds,
+		TotalSegundos:        t.TotalSeconds,
+		TotalHoras:           t.TotalHours.InexactFloat64(),
+	}
+	if t.ID != uuid.Nil {
+		id := t.ID.String()
+		total.ID = &id
+	}
+	return total
+}
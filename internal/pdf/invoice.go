@@ -0,0 +1,170 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"olidesk-api-2/internal/domains"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Invoice reúne os dados da fatura. Client pode ser nil quando o cliente foi
+// excluído; Logo é opcional.
+type Invoice struct {
+	Invoice     *domains.Invoice
+	Client      *domains.Client
+	Template    domains.ServiceOrderTemplate
+	Logo        []byte
+	GeneratedAt time.Time
+}
+
+const itemLineHeight = 4.5
+
+// Larguras das colunas da tabela de itens: descrição, quantidade, valor
+// unitário e total.
+var itemColumns = [4]float64{95, 20, 32.5, 32.5}
+
+var invoiceStatusLabels = map[string]string{
+	domains.InvoiceStatusDraft:    "Rascunho",
+	domains.InvoiceStatusIssued:   "Emitida",
+	domains.InvoiceStatusPaid:     "Paga",
+	domains.InvoiceStatusCanceled: "Cancelada",
+}
+
+// RenderInvoice escreve a fatura em w com o modelo da organização. Rascunhos
+// saem sem número, identificados como tal no cabeçalho.
+func RenderInvoice(w io.Writer, doc Invoice) error {
+	inv := doc.Invoice
+	reference := "Nº " + domains.InvoiceNumberLabel(inv.Number)
+	if inv.Number == 0 {
+		reference = "RASCUNHO - sem valor fiscal"
+	}
+
+	r := newRenderer(doc.Template, doc.Logo, doc.GeneratedAt, "Fatura "+strings.TrimPrefix(reference, "Nº "))
+	dateLine := "Gerada em " + r.formatTime(doc.GeneratedAt)
+	if !inv.IssuedAt.IsZero() {
+		dateLine = "Emitida em " + r.formatTime(inv.IssuedAt)
+	}
+	r.header("FATURA", reference, dateLine)
+	r.clientSection(doc.Client, inv.ClientName)
+	r.invoiceSection(inv)
+	r.itemsTable(inv.Items)
+	r.totals(inv)
+	if strings.TrimSpace(inv.Notes) != "" {
+		r.textSection("Observações", inv.Notes)
+	}
+
+	return r.pdf.Output(w)
+}
+
+func (r *renderer) invoiceSection(inv *domains.Invoice) {
+	r.sectionTitle("Fatura")
+	r.field("Situação", label(invoiceStatusLabels, inv.Status))
+	if !inv.DueDate.IsZero() {
+		r.field("Vencimento", inv.DueDate.Format("02/01/2006"))
+	}
+	if !inv.PaidAt.IsZero() {
+		r.field("Pagamento", r.formatTime(inv.PaidAt))
+	}
+	if !inv.CanceledAt.IsZero() {
+		r.field("Cancelamento", joinNonEmpty(" - ", r.formatTime(inv.CanceledAt), inv.CancelReason))
+	}
+}
+
+// itemsTable desenha os itens com a descrição quebrada em linhas; o
+// cabeçalho da tabela se repete quando a tabela continua na página seguinte.
+func (r *renderer) itemsTable(items []*domains.InvoiceItem) {
+	r.sectionTitle("Itens")
+	r.itemsHeader()
+
+	_, pageHeight := r.pdf.GetPageSize()
+	r.pdf.SetFont("Helvetica", "", 8)
+	for _, item := range items {
+		lines := r.pdf.SplitLines([]byte(r.tr(item.Description)), itemColumns[0]-2)
+		height := float64(max(len(lines), 1)) * itemLineHeight
+		if r.pdf.GetY()+height > pageHeight-25 {
+			r.pdf.AddPage()
+			r.itemsHeader()
+			r.pdf.SetFont("Helvetica", "", 8)
+		}
+
+		x, y := r.pdf.GetX(), r.pdf.GetY()
+		r.pdf.MultiCell(itemColumns[0], itemLineHeight, string(bytes.Join(lines, []byte("\n"))), "", "L", false)
+		r.pdf.SetXY(x+itemColumns[0], y)
+		r.pdf.CellFormat(itemColumns[1], itemLineHeight, formatQuantity(item.Quantity), "", 0, "R", false, 0, "")
+		r.pdf.CellFormat(itemColumns[2], itemLineHeight, formatMoney(item.UnitPrice), "", 0, "R", false, 0, "")
+		r.pdf.CellFormat(itemColumns[3], itemLineHeight, formatMoney(item.Total), "", 0, "R", false, 0, "")
+
+		r.pdf.SetY(y + height)
+		r.pdf.SetDrawColor(200, 200, 200)
+		r.pdf.SetLineWidth(0.1)
+		r.pdf.Line(pageMargin, r.pdf.GetY(), 210-pageMargin, r.pdf.GetY())
+	}
+}
+
+func (r *renderer) itemsHeader() {
+	r.pdf.SetFont("Helvetica", "B", 8)
+	r.pdf.SetFillColor(230, 230, 230)
+	for i, title := range []string{"Descrição", "Qtd.", "Valor unitário", "Total"} {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		r.pdf.CellFormat(itemColumns[i], 6, r.tr(title), "", 0, align, true, 0, "")
+	}
+	r.pdf.Ln(6)
+}
+
+func (r *renderer) totals(inv *domains.Invoice) {
+	r.pdf.Ln(2)
+	rows := [][2]string{{"Subtotal", formatMoney(inv.Subtotal)}}
+	if inv.Discount.IsPositive() {
+		rows = append(rows, [2]string{"Desconto", "- " + formatMoney(inv.Discount)})
+	}
+	if inv.TaxRate.IsPositive() {
+		rows = append(rows, [2]string{"Impostos (" + formatQuantity(inv.TaxRate) + "%)", formatMoney(inv.TaxAmount)})
+	}
+
+	labelX := 210 - pageMargin - 90
+	for _, row := range rows {
+		r.pdf.SetX(labelX)
+		r.pdf.SetFont("Helvetica", "", 9)
+		r.pdf.CellFormat(55, lineHeight, r.tr(row[0]), "", 0, "R", false, 0, "")
+		r.pdf.CellFormat(35, lineHeight, row[1], "", 1, "R", false, 0, "")
+	}
+
+	r.pdf.SetX(labelX)
+	r.pdf.SetFillColor(r.color[0], r.color[1], r.color[2])
+	r.pdf.SetTextColor(255, 255, 255)
+	r.pdf.SetFont("Helvetica", "B", 10)
+	r.pdf.CellFormat(55, 7, "TOTAL", "", 0, "R", true, 0, "")
+	r.pdf.CellFormat(35, 7, formatMoney(inv.Total), "", 1, "R", true, 0, "")
+	r.pdf.SetTextColor(0, 0, 0)
+}
+
+// formatMoney formata o valor em reais, como em "R$ 1.234,56".
+func formatMoney(d decimal.Decimal) string {
+	s := d.StringFixed(2)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	integer, cents := s[:len(s)-3], s[len(s)-2:]
+
+	var b strings.Builder
+	for i, c := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(c)
+	}
+	return fmt.Sprintf("R$ %s%s,%s", sign, b.String(), cents)
+}
+
+// formatQuantity usa vírgula decimal e só as casas necessárias.
+func formatQuantity(d decimal.Decimal) string {
+	return strings.Replace(d.String(), ".", ",", 1)
+}
@@ -0,0 +1,107 @@
+package pdf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"olidesk-api-2/internal/domains"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testInvoice() Invoice {
+	template := domains.DefaultServiceOrderTemplate()
+	template.CompanyName = "Olidesk Informática"
+
+	inv := &domains.Invoice{
+		ID:         uuid.MustParse("0191c2b4-6a8e-7c3e-9d4a-1b2c3d4e5f61"),
+		Number:     123,
+		ClientName: "Padaria Pão Quente",
+		Status:     domains.InvoiceStatusIssued,
+		Items: []*domains.InvoiceItem{
+			{Kind: domains.InvoiceItemLabor, Description: "Mão de obra - atendimento 0191C2B4", Quantity: decimal.RequireFromString("1.5"), UnitPrice: decimal.NewFromInt(120)},
+			{Kind: domains.InvoiceItemPart, Description: "Cabo serial DB9", Quantity: decimal.NewFromInt(2), UnitPrice: decimal.RequireFromString("35.90")},
+			{Kind: domains.InvoiceItemTravel, Description: "Deslocamento", Quantity: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(50)},
+		},
+		Discount: decimal.NewFromInt(10),
+		TaxRate:  decimal.RequireFromString("5.5"),
+		Notes:    "Pagamento via PIX.",
+		DueDate:  time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC),
+		IssuedAt: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC),
+	}
+	for _, item := range inv.Items {
+		item.Total = item.Quantity.Mul(item.UnitPrice)
+	}
+	inv.Recalculate()
+
+	return Invoice{
+		Invoice: inv,
+		Client: &domains.Client{
+			ClientName: "Padaria Pão Quente",
+			CnpjOrCpf:  "12.345.678/0001-90",
+		},
+		Template:    *template,
+		GeneratedAt: time.Date(2026, 3, 11, 9, 30, 0, 0, time.UTC),
+	}
+}
+
+func renderInvoice(t *testing.T, doc Invoice) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, RenderInvoice(&buf, doc))
+	require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+	return buf.Bytes()
+}
+
+func TestRenderInvoice(t *testing.T) {
+	t.Run("deterministic", func(t *testing.T) {
+		assert.Equal(t, renderInvoice(t, testInvoice()), renderInvoice(t, testInvoice()))
+	})
+
+	t.Run("draft without client", func(t *testing.T) {
+		doc := testInvoice()
+		doc.Client = nil
+		doc.Invoice.Number = 0
+		doc.Invoice.Status = domains.InvoiceStatusDraft
+		doc.Invoice.IssuedAt = time.Time{}
+		doc.Invoice.Notes = ""
+		renderInvoice(t, doc)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		doc := testInvoice()
+		doc.Invoice.Status = domains.InvoiceStatusCanceled
+		doc.Invoice.CanceledAt = time.Date(2026, 3, 12, 10, 0, 0, 0, time.UTC)
+		doc.Invoice.CancelReason = "Emitida em duplicidade."
+		renderInvoice(t, doc)
+	})
+
+	t.Run("many items span pages", func(t *testing.T) {
+		doc := testInvoice()
+		for i := 0; i < 120; i++ {
+			doc.Invoice.Items = append(doc.Invoice.Items, &domains.InvoiceItem{
+				Kind:        domains.InvoiceItemPart,
+				Description: strings.Repeat("Peça de reposição ", 8),
+				Quantity:    decimal.NewFromInt(1),
+				UnitPrice:   decimal.NewFromInt(10),
+				Total:       decimal.NewFromInt(10),
+			})
+		}
+		doc.Invoice.Recalculate()
+		assert.Contains(t, string(renderInvoice(t, doc)), "/Count 8")
+	})
+}
+
+func TestFormatMoney(t *testing.T) {
+	assert.Equal(t, "R$ 0,00", formatMoney(decimal.Zero))
+	assert.Equal(t, "R$ 12,50", formatMoney(decimal.RequireFromString("12.5")))
+	assert.Equal(t, "R$ 1.234,56", formatMoney(decimal.RequireFromString("1234.56")))
+	assert.Equal(t, "R$ 1.000.000,00", formatMoney(decimal.NewFromInt(1000000)))
+	assert.Equal(t, "R$ -999,99", formatMoney(decimal.RequireFromString("-999.99")))
+	assert.Equal(t, "1,5", formatQuantity(decimal.RequireFromString("1.5")))
+}
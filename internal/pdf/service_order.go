@@ -0,0 +1,442 @@
+// Package pdf gera os documentos em PDF da API (ordem de serviço e fatura) em
+// Go puro, sem binários externos, usando as fontes padrão do PDF.
+package pdf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"olidesk-api-2/internal/domains"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// ServiceOrder reúne os dados da ordem de serviço. Client pode ser nil quando
+// o cliente foi excluído; Logo e Signature são opcionais. SignatureContent é o
+// arquivo da assinatura como guardado no storage.
+type ServiceOrder struct {
+	Form             *domains.Atendimentos
+	Client           *domains.Client
+	Template         domains.ServiceOrderTemplate
+	Logo             []byte
+	Signature        *domains.FormSignature
+	SignatureContent []byte
+	GeneratedAt      time.Time
+}
+
+const (
+	pageMargin     = 15.0
+	lineHeight     = 5.0
+	signatureWidth = 80.0
+	signatureBox   = 22.0
+)
+
+var difficultyLabels = map[string]string{
+	"low":    "Baixa",
+	"medium": "Média",
+	"high":   "Alta",
+}
+
+var statusLabels = map[string]string{
+	domains.FormStatusOpen:            "Aberto",
+	domains.FormStatusScheduled:       "Agendado",
+	domains.FormStatusInProgress:      "Em andamento",
+	domains.FormStatusWaitingCustomer: "Aguardando cliente",
+	domains.FormStatusResolved:        "Resolvido",
+	domains.FormStatusClosed:          "Fechado",
+	domains.FormStatusCanceled:        "Cancelado",
+}
+
+// RenderServiceOrder escreve a ordem de serviço em w. A saída não depende do
+// horário da geração além da data impressa, o que facilita comparar arquivos.
+func RenderServiceOrder(w io.Writer, so ServiceOrder) error {
+	// Atendimentos anteriores ao protocolo continuam identificados pelo ID.
+	number := so.Form.PublicCode
+	if number == "" {
+		number = so.Form.ID.String()
+	}
+	r := newRenderer(so.Template, so.Logo, so.GeneratedAt, "Ordem de serviço "+number)
+	r.so = so
+
+	r.header("ORDEM DE SERVIÇO", number, "Emitida em "+r.formatTime(so.GeneratedAt))
+	r.clientSection(so.Client, so.Form.Cliente.ClientName)
+	r.formSection()
+	r.techniciansSection()
+	r.textSection("Defeito relatado", so.Form.DefectDescription)
+	r.textSection("Solução aplicada", so.Form.SolutionDescription)
+	r.signatures()
+
+	return r.pdf.Output(w)
+}
+
+// newRenderer prepara o documento A4 com o modelo da organização, o rodapé e
+// a primeira página.
+func newRenderer(template domains.ServiceOrderTemplate, logo []byte, generatedAt time.Time, title string) *renderer {
+	r := &renderer{
+		pdf:      fpdf.New("P", "mm", "A4", ""),
+		template: template,
+		logo:     logo,
+		loc:      template.Location(),
+		color:    parseColor(template.PrimaryColor),
+	}
+	r.tr = r.pdf.UnicodeTranslatorFromDescriptor("")
+
+	r.pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	r.pdf.SetAutoPageBreak(true, 25)
+	r.pdf.SetCreationDate(generatedAt)
+	r.pdf.SetModificationDate(generatedAt)
+	r.pdf.SetCatalogSort(true)
+	r.pdf.SetTitle(title, true)
+	if template.CompanyName != "" {
+		r.pdf.SetAuthor(template.CompanyName, true)
+	}
+	r.pdf.AliasNbPages("{nb}")
+	r.pdf.SetFooterFunc(r.footer)
+	r.pdf.AddPage()
+	return r
+}
+
+type renderer struct {
+	pdf      *fpdf.Fpdf
+	tr       func(string) string
+	template domains.ServiceOrderTemplate
+	logo     []byte
+	so       ServiceOrder
+	loc      *time.Location
+	color    [3]int
+}
+
+// header desenha os dados da empresa, o logotipo e, à direita, o título do
+// documento com a referência e a data.
+func (r *renderer) header(title, reference, dateLine string) {
+	t := r.template
+	top := r.pdf.GetY()
+	textX := pageMargin
+
+	if len(r.logo) > 0 {
+		if imageType := logoImageType(r.logo); imageType != "" {
+			info := r.pdf.RegisterImageOptionsReader("logo", fpdf.ImageOptions{ImageType: imageType}, bytes.NewReader(r.logo))
+			if r.pdf.Ok() && info != nil {
+				height := 18.0
+				width := info.Width() * height / info.Height()
+				if width > 45 {
+					width, height = 45, info.Height()*45/info.Width()
+				}
+				r.pdf.ImageOptions("logo", pageMargin, top, width, height, false, fpdf.ImageOptions{ImageType: imageType}, 0, "")
+				textX += width + 4
+			} else {
+				// Um logotipo corrompido não impede a emissão do documento.
+				r.pdf.ClearError()
+			}
+		}
+	}
+
+	r.pdf.SetXY(textX, top)
+	if t.CompanyName != "" {
+		r.pdf.SetFont("Helvetica", "B", 13)
+		r.pdf.CellFormat(110-textX+pageMargin, 6, r.tr(t.CompanyName), "", 2, "L", false, 0, "")
+	}
+	r.pdf.SetFont("Helvetica", "", 8)
+	for _, line := range []string{
+		joinNonEmpty(" | ", prefixed("CNPJ/CPF: ", t.CompanyDocument), t.Address),
+		joinNonEmpty(" | ", t.Phone, t.Email, t.Website),
+	} {
+		if line != "" {
+			r.pdf.SetX(textX)
+			r.pdf.MultiCell(110-textX+pageMargin, 4, r.tr(line), "", "L", false)
+		}
+	}
+	leftBottom := r.pdf.GetY()
+
+	boxX := 130.0
+	r.pdf.SetXY(boxX, top)
+	r.pdf.SetFillColor(r.color[0], r.color[1], r.color[2])
+	r.pdf.SetTextColor(255, 255, 255)
+	r.pdf.SetFont("Helvetica", "B", 11)
+	r.pdf.CellFormat(65, 8, r.tr(title), "", 2, "C", true, 0, "")
+	r.pdf.SetTextColor(0, 0, 0)
+	r.pdf.SetFont("Helvetica", "", 7)
+	r.pdf.CellFormat(65, 5, r.tr(reference), "LR", 2, "C", false, 0, "")
+	r.pdf.SetFont("Helvetica", "", 8)
+	r.pdf.CellFormat(65, 5, r.tr(dateLine), "LRB", 2, "C", false, 0, "")
+
+	r.pdf.SetY(max(leftBottom, r.pdf.GetY()) + 4)
+}
+
+// clientSection mostra o cadastro do cliente; sem cadastro (cliente
+// excluído), só o nome guardado no documento.
+func (r *renderer) clientSection(c *domains.Client, name string) {
+	r.sectionTitle("Cliente")
+	if c == nil {
+		r.field("Nome", name)
+		return
+	}
+
+	r.field("Nome", c.ClientName)
+	r.field("CPF/CNPJ", c.CnpjOrCpf)
+	a := c.Address
+	r.field("Endereço", joinNonEmpty(", ",
+		joinNonEmpty(", ", a.Street, a.Number),
+		a.Complement,
+		a.Neighborhood,
+		joinNonEmpty(" - ", a.City, a.State),
+		prefixed("CEP ", a.PostalCode),
+	))
+	r.field("Contato", joinNonEmpty(" | ", c.Contact.ResposableName, c.Contact.Phone, c.Contact.Email))
+}
+
+func (r *renderer) formSection() {
+	f := r.so.Form
+	r.sectionTitle("Atendimento")
+	r.field("Abertura", r.formatTime(f.DataDeAbertura))
+	r.field("Situação", label(statusLabels, f.Status))
+	r.field("Solicitante", f.SolicitedBy)
+	r.field("Dificuldade", label(difficultyLabels, f.DifficultyLevel))
+	r.field("Horas", strings.Replace(f.HoursConsumed.StringFixed(2), ".", ",", 1))
+	if len(f.Tags) > 0 {
+		r.field("Tags", strings.Join(f.Tags, ", "))
+	}
+}
+
+func (r *renderer) techniciansSection() {
+	r.sectionTitle("Técnicos responsáveis")
+	if len(r.so.Form.TecnicoResponsavelId) == 0 {
+		r.paragraph("Nenhum técnico atribuído.")
+		return
+	}
+	for _, m := range r.so.Form.TecnicoResponsavelId {
+		r.paragraph("- " + joinNonEmpty(" | ", m.Name, m.Email))
+	}
+}
+
+func (r *renderer) textSection(title, text string) {
+	r.sectionTitle(title)
+	if strings.TrimSpace(text) == "" {
+		text = "Não informado."
+	}
+	r.paragraph(text)
+}
+
+// signatures desenha as linhas de assinatura do técnico e do cliente. Quando
+// o cliente assinou pela API, a assinatura aparece sobre a linha dele.
+func (r *renderer) signatures() {
+	_, pageHeight := r.pdf.GetPageSize()
+	_, _, _, bottom := r.pdf.GetMargins()
+	if r.pdf.GetY()+signatureBox+28 > pageHeight-bottom {
+		r.pdf.AddPage()
+	}
+
+	r.sectionTitle("Assinaturas")
+	top := r.pdf.GetY() + 2
+	lineY := top + signatureBox
+	clientX := 210 - pageMargin - signatureWidth
+
+	if s := r.so.Signature; s != nil {
+		r.drawSignature(s, clientX, top, signatureWidth, signatureBox-1)
+	}
+
+	r.pdf.SetDrawColor(0, 0, 0)
+	r.pdf.SetLineWidth(0.3)
+	r.pdf.Line(pageMargin, lineY, pageMargin+signatureWidth, lineY)
+	r.pdf.Line(clientX, lineY, clientX+signatureWidth, lineY)
+
+	names := make([]string, 0, len(r.so.Form.TecnicoResponsavelId))
+	for _, m := range r.so.Form.TecnicoResponsavelId {
+		names = append(names, m.Name)
+	}
+
+	r.pdf.SetFont("Helvetica", "B", 8)
+	r.pdf.SetXY(pageMargin, lineY+1)
+	r.pdf.CellFormat(signatureWidth, 4, r.tr("Técnico responsável"), "", 0, "C", false, 0, "")
+	r.pdf.SetXY(clientX, lineY+1)
+	r.pdf.CellFormat(signatureWidth, 4, "Cliente", "", 0, "C", false, 0, "")
+
+	r.pdf.SetFont("Helvetica", "", 8)
+	r.pdf.SetXY(pageMargin, lineY+5)
+	r.pdf.MultiCell(signatureWidth, 4, r.tr(strings.Join(names, ", ")), "", "C", false)
+	techBottom := r.pdf.GetY()
+
+	r.pdf.SetXY(clientX, lineY+5)
+	if s := r.so.Signature; s != nil {
+		r.pdf.MultiCell(signatureWidth, 4, r.tr(joinNonEmpty(" - ", s.SignerName, s.SignerDocument)), "", "C", false)
+		r.pdf.SetX(clientX)
+		r.pdf.SetFont("Helvetica", "", 7)
+		r.pdf.MultiCell(signatureWidth, 3.5, r.tr(fmt.Sprintf("Assinado em %s | %s, %s", r.formatTime(s.SignedAt),
+			strconv.FormatFloat(s.Latitude, 'f', 5, 64), strconv.FormatFloat(s.Longitude, 'f', 5, 64))), "", "C", false)
+		r.pdf.SetX(clientX)
+		r.pdf.MultiCell(signatureWidth, 3.5, "SHA-256 "+s.SnapshotHash, "", "C", false)
+	} else {
+		r.pdf.MultiCell(signatureWidth, 4, r.tr("Nome e documento"), "", "C", false)
+	}
+
+	r.pdf.SetY(max(techBottom, r.pdf.GetY()))
+}
+
+// drawSignature desenha a assinatura na área indicada. Imagens PNG e traços
+// são desenhados; SVGs e conteúdos que não puderem ser lidos viram uma
+// indicação de assinatura eletrônica, sem impedir a emissão.
+func (r *renderer) drawSignature(s *domains.FormSignature, x, y, w, h float64) {
+	switch s.Format {
+	case domains.SignatureFormatPNG:
+		info := r.pdf.RegisterImageOptionsReader("signature", fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(r.so.SignatureContent))
+		if r.pdf.Ok() && info != nil && info.Width() > 0 && info.Height() > 0 {
+			iw, ih := fit(info.Width(), info.Height(), w, h)
+			r.pdf.ImageOptions("signature", x+(w-iw)/2, y+(h-ih), iw, ih, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+			return
+		}
+		r.pdf.ClearError()
+	case domains.SignatureFormatStrokes:
+		var strokes []domains.SignatureStroke
+		if err := json.Unmarshal(r.so.SignatureContent, &strokes); err == nil && len(strokes) > 0 {
+			r.drawStrokes(strokes, x, y, w, h)
+			return
+		}
+	}
+
+	r.pdf.SetFont("Helvetica", "I", 8)
+	r.pdf.SetXY(x, y+h-6)
+	r.pdf.CellFormat(w, 5, r.tr("Assinado eletronicamente"), "", 0, "C", false, 0, "")
+}
+
+func (r *renderer) drawStrokes(strokes []domains.SignatureStroke, x, y, w, h float64) {
+	minX, minY := strokes[0][0].X, strokes[0][0].Y
+	maxX, maxY := minX, minY
+	for _, stroke := range strokes {
+		for _, p := range stroke {
+			minX, maxX = min(minX, p.X), max(maxX, p.X)
+			minY, maxY = min(minY, p.Y), max(maxY, p.Y)
+		}
+	}
+	sw, sh := max(maxX-minX, 1), max(maxY-minY, 1)
+	dw, dh := fit(sw, sh, w, h)
+	scale := dw / sw
+	offsetX := x + (w-dw)/2
+	offsetY := y + (h - dh)
+
+	r.pdf.SetDrawColor(20, 20, 60)
+	r.pdf.SetLineWidth(0.4)
+	r.pdf.SetLineCapStyle("round")
+	r.pdf.SetLineJoinStyle("round")
+	for _, stroke := range strokes {
+		px, py := offsetX+(stroke[0].X-minX)*scale, offsetY+(stroke[0].Y-minY)*scale
+		if len(stroke) == 1 {
+			r.pdf.Circle(px, py, 0.2, "F")
+			continue
+		}
+		for _, p := range stroke[1:] {
+			nx, ny := offsetX+(p.X-minX)*scale, offsetY+(p.Y-minY)*scale
+			r.pdf.Line(px, py, nx, ny)
+			px, py = nx, ny
+		}
+	}
+	r.pdf.SetLineCapStyle("butt")
+	r.pdf.SetLineJoinStyle("miter")
+}
+
+func (r *renderer) footer() {
+	r.pdf.SetY(-20)
+	r.pdf.SetDrawColor(r.color[0], r.color[1], r.color[2])
+	r.pdf.SetLineWidth(0.3)
+	r.pdf.Line(pageMargin, r.pdf.GetY(), 210-pageMargin, r.pdf.GetY())
+	r.pdf.SetTextColor(90, 90, 90)
+	r.pdf.SetFont("Helvetica", "", 7)
+	r.pdf.SetY(r.pdf.GetY() + 1)
+	if footer := r.template.FooterText; footer != "" {
+		r.pdf.MultiCell(0, 3.2, r.tr(footer), "", "C", false)
+	}
+	r.pdf.SetY(-8)
+	r.pdf.CellFormat(0, 4, r.tr(fmt.Sprintf("Página %d de {nb}", r.pdf.PageNo())), "", 0, "R", false, 0, "")
+	r.pdf.SetTextColor(0, 0, 0)
+}
+
+func (r *renderer) sectionTitle(title string) {
+	r.pdf.Ln(2)
+	r.pdf.SetFillColor(r.color[0], r.color[1], r.color[2])
+	r.pdf.SetTextColor(255, 255, 255)
+	r.pdf.SetFont("Helvetica", "B", 9)
+	r.pdf.CellFormat(0, 6, r.tr(strings.ToUpper(title)), "", 1, "L", true, 0, "")
+	r.pdf.SetTextColor(0, 0, 0)
+	r.pdf.Ln(1)
+}
+
+func (r *renderer) field(name, value string) {
+	if value == "" {
+		value = "-"
+	}
+	r.pdf.SetFont("Helvetica", "B", 9)
+	r.pdf.CellFormat(28, lineHeight, r.tr(name+":"), "", 0, "L", false, 0, "")
+	r.pdf.SetFont("Helvetica", "", 9)
+	r.pdf.MultiCell(0, lineHeight, r.tr(value), "", "L", false)
+}
+
+func (r *renderer) paragraph(text string) {
+	r.pdf.SetFont("Helvetica", "", 9)
+	r.pdf.MultiCell(0, lineHeight, r.tr(text), "", "L", false)
+}
+
+func (r *renderer) formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(r.loc).Format("02/01/2006 15:04")
+}
+
+// logoImageType identifica PNG e JPEG pelos primeiros bytes.
+func logoImageType(content []byte) string {
+	switch {
+	case bytes.HasPrefix(content, []byte("\x89PNG\r\n\x1a\n")):
+		return "PNG"
+	case bytes.HasPrefix(content, []byte{0xFF, 0xD8, 0xFF}):
+		return "JPG"
+	}
+	return ""
+}
+
+// fit reduz (w, h) proporcionalmente para caber em (maxW, maxH).
+func fit(w, h, maxW, maxH float64) (float64, float64) {
+	scale := min(maxW/w, maxH/h)
+	return w * scale, h * scale
+}
+
+func parseColor(hex string) [3]int {
+	if len(hex) != 7 || hex[0] != '#' {
+		hex = domains.DefaultServiceOrderColor
+	}
+	var c [3]int
+	for i := range c {
+		v, err := strconv.ParseUint(hex[1+2*i:3+2*i], 16, 8)
+		if err != nil {
+			return parseColor(domains.DefaultServiceOrderColor)
+		}
+		c[i] = int(v)
+	}
+	return c
+}
+
+func label(labels map[string]string, value string) string {
+	if l, ok := labels[value]; ok {
+		return l
+	}
+	return value
+}
+
+func prefixed(prefix, value string) string {
+	if value == "" {
+		return ""
+	}
+	return prefix + value
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, sep)
+}
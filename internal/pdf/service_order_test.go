@@ -0,0 +1,155 @@
+package pdf
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"olidesk-api-2/internal/domains"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testServiceOrder() ServiceOrder {
+	template := domains.DefaultServiceOrderTemplate()
+	template.CompanyName = "Olidesk Informática"
+	template.FooterText = "Garantia de 90 dias para o serviço prestado."
+
+	return ServiceOrder{
+		Form: &domains.Atendimentos{
+			ID:             uuid.MustParse("0191c2b4-6a8e-7c3e-9d4a-1b2c3d4e5f60"),
+			PublicCode:     "OS-2026-000123",
+			DataDeAbertura: time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC),
+			TecnicoResponsavelId: []domains.Member{
+				{ID: uuid.New(), Name: "João Técnico", Email: "joao@olidesk.com"},
+			},
+			Cliente:             domains.ClientForm{ID: uuid.New(), ClientName: "Padaria Pão Quente"},
+			SolicitedBy:         "Maria",
+			DifficultyLevel:     "medium",
+			DefectDescription:   "Impressora fiscal não comunica com o caixa.",
+			SolutionDescription: "Cabo serial substituído e driver reinstalado.",
+			Status:              domains.FormStatusResolved,
+			HoursConsumed:       decimal.RequireFromString("1.5"),
+			Tags:                []string{"impressora"},
+		},
+		Client: &domains.Client{
+			ClientName: "Padaria Pão Quente",
+			CnpjOrCpf:  "12.345.678/0001-90",
+			Address:    domains.Address{Street: "Rua das Flores", Number: "10", City: "Curitiba", State: "PR"},
+		},
+		Template:    *template,
+		GeneratedAt: time.Date(2026, 3, 11, 9, 30, 0, 0, time.UTC),
+	}
+}
+
+func render(t *testing.T, so ServiceOrder) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, RenderServiceOrder(&buf, so))
+	require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+	return buf.Bytes()
+}
+
+// utf16Title codifica s como o fpdf grava o título nos metadados do arquivo.
+func utf16Title(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		b.WriteByte(0)
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+func pngImage(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		img.Set(x, h/2, color.Black)
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestRenderServiceOrder(t *testing.T) {
+	t.Run("deterministic", func(t *testing.T) {
+		assert.Equal(t, render(t, testServiceOrder()), render(t, testServiceOrder()))
+	})
+
+	t.Run("without client, technicians or descriptions", func(t *testing.T) {
+		so := testServiceOrder()
+		so.Client = nil
+		so.Form.TecnicoResponsavelId = nil
+		so.Form.DefectDescription = ""
+		so.Form.SolutionDescription = ""
+		so.Template = *domains.DefaultServiceOrderTemplate()
+		render(t, so)
+	})
+
+	t.Run("numbered by protocol code", func(t *testing.T) {
+		assert.Contains(t, string(render(t, testServiceOrder())), utf16Title("OS-2026-000123"))
+
+		so := testServiceOrder()
+		so.Form.PublicCode = ""
+		assert.Contains(t, string(render(t, so)), utf16Title("0191c2b4-6a8e-7c3e-9d4a-1b2c3d4e5f60"))
+	})
+
+	t.Run("with logo", func(t *testing.T) {
+		so := testServiceOrder()
+		so.Logo = pngImage(t, 120, 40)
+		assert.Greater(t, len(render(t, so)), len(render(t, testServiceOrder())))
+	})
+
+	t.Run("ignores unreadable logo", func(t *testing.T) {
+		so := testServiceOrder()
+		so.Logo = []byte("\x89PNG\r\n\x1a\ncorrompido")
+		render(t, so)
+	})
+
+	signature := func(format string, content []byte) ServiceOrder {
+		so := testServiceOrder()
+		so.Signature = &domains.FormSignature{
+			Format:         format,
+			SignerName:     "Maria Souza",
+			SignerDocument: "123.456.789-09",
+			SignedAt:       time.Date(2026, 3, 10, 16, 0, 0, 0, time.UTC),
+			SnapshotHash:   strings.Repeat("b", 64),
+		}
+		so.SignatureContent = content
+		return so
+	}
+
+	t.Run("with png signature", func(t *testing.T) {
+		render(t, signature(domains.SignatureFormatPNG, pngImage(t, 300, 100)))
+	})
+
+	t.Run("with strokes signature", func(t *testing.T) {
+		render(t, signature(domains.SignatureFormatStrokes, []byte(`[[{"x":0,"y":0},{"x":50,"y":20},{"x":100,"y":5}],[{"x":60,"y":10}]]`)))
+	})
+
+	t.Run("with svg or unreadable signature", func(t *testing.T) {
+		render(t, signature(domains.SignatureFormatSVG, []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`)))
+		render(t, signature(domains.SignatureFormatStrokes, []byte("{")))
+		render(t, signature(domains.SignatureFormatPNG, []byte("nope")))
+	})
+
+	t.Run("long descriptions span pages", func(t *testing.T) {
+		so := testServiceOrder()
+		so.Form.SolutionDescription = strings.Repeat("Troca de componentes e testes de bancada. ", 300)
+		assert.Contains(t, string(render(t, so)), "/Count 3")
+	})
+}
+
+func TestParseColor(t *testing.T) {
+	assert.Equal(t, [3]int{0x1F, 0x4E, 0x79}, parseColor("#1F4E79"))
+	assert.Equal(t, [3]int{255, 0, 16}, parseColor("#ff0010"))
+	assert.Equal(t, parseColor(domains.DefaultServiceOrderColor), parseColor("azul"))
+	assert.Equal(t, parseColor(domains.DefaultServiceOrderColor), parseColor("#GG0000"))
+}
@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"olidesk-api-2/internal/domains"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type UserRepository interface {
@@ -19,15 +21,200 @@ type UserRepository interface {
 type ClientRepository interface {
 	SaveClient(*domains.Client, context.Context) (uuid.UUID, error)
 	FindClientByID(uuid.UUID, context.Context) (*domains.Client, error)
-	ListClients(context.Context) ([]*domains.Client, error)
+	ListClients(domains.ListFilter, context.Context) ([]*domains.Client, error)
 	UpdateClient(*domains.Client, context.Context) error
 	DeleteClient(uuid.UUID, context.Context) error
+	ListDeletedClients(context.Context) ([]*domains.Client, error)
+	RestoreClient(uuid.UUID, context.Context) error
+	PurgeClient(uuid.UUID, context.Context) error
+	MergeClients(*domains.ClientMerge, context.Context) (uuid.UUID, error)
+	ListClientMerges(uuid.UUID, context.Context) ([]*domains.ClientMerge, error)
 }
 
 type FormRepository interface {
-	SaveForm(*domains.Atendimentos, context.Context) (uuid.UUID, error)
+	SaveForm(*domains.Atendimentos, []*domains.FormAssignmentChange, context.Context) (uuid.UUID, error)
 	FindFormByID(uuid.UUID, context.Context) (*domains.Atendimentos, error)
-	ListForms(context.Context) ([]*domains.Atendimentos, error)
-	UpdateForm(*domains.Atendimentos, context.Context) error
+	FindFormByCode(string, context.Context) (*domains.Atendimentos, error)
+	ListForms(domains.FormListFilter, context.Context) ([]*domains.Atendimentos, error)
+	SearchForms(domains.FormSearchFilter, context.Context) ([]*domains.FormSearchResult, int, error)
+	UpdateForm(*domains.Atendimentos, []*domains.FormAssignmentChange, context.Context) error
 	DeleteForm(uuid.UUID, context.Context) error
+	ListDeletedForms(context.Context) ([]*domains.Atendimentos, error)
+	RestoreForm(uuid.UUID, context.Context) error
+	PurgeForm(uuid.UUID, context.Context) error
+	TransitionFormStatus(*domains.Atendimentos, *domains.FormStatusChange, context.Context) error
+	ListFormStatusHistory(uuid.UUID, context.Context) ([]*domains.FormStatusChange, error)
+	ListFormAssignmentHistory(uuid.UUID, context.Context) ([]*domains.FormAssignmentChange, error)
+}
+
+type CommentRepository interface {
+	SaveComment(*domains.FormComment, context.Context) error
+	ListComments(uuid.UUID, bool, context.Context) ([]*domains.FormComment, error)
+	FindComment(uuid.UUID, uuid.UUID, context.Context) (*domains.FormComment, error)
+	UpdateComment(*domains.FormComment, *domains.FormCommentEdit, context.Context) error
+	ListCommentEdits(uuid.UUID, context.Context) ([]*domains.FormCommentEdit, error)
+}
+
+type AttachmentRepository interface {
+	SaveAttachment(*domains.Attachment, context.Context) error
+	ListAttachments(uuid.UUID, context.Context) ([]*domains.Attachment, error)
+	FindAttachment(uuid.UUID, uuid.UUID, context.Context) (*domains.Attachment, error)
+	DeleteAttachment(uuid.UUID, uuid.UUID, context.Context) error
+}
+
+type WorkLogRepository interface {
+	SaveWorkLog(*domains.WorkLog, context.Context) error
+	FindWorkLog(uuid.UUID, uuid.UUID, context.Context) (*domains.WorkLog, error)
+	ListWorkLogs(uuid.UUID, context.Context) ([]*domains.WorkLog, error)
+	StopWorkLog(*domains.WorkLog, context.Context) error
+	DeleteWorkLog(uuid.UUID, uuid.UUID, context.Context) error
+	WorkLogTotals(domains.WorkLogTotalsFilter, context.Context) ([]domains.WorkLogTotal, error)
+}
+
+type SignatureRepository interface {
+	SaveSignature(*domains.FormSignature, context.Context) error
+	FindSignature(uuid.UUID, context.Context) (*domains.FormSignature, error)
+}
+
+type ServiceOrderTemplateRepository interface {
+	GetServiceOrderTemplate(context.Context) (*domains.ServiceOrderTemplate, error)
+	SaveServiceOrderTemplate(*domains.ServiceOrderTemplate, context.Context) error
+}
+
+type SLARepository interface {
+	FindSLAPolicy(string, string, context.Context) (*domains.SLAPolicy, error)
+	ListSLAPolicies(context.Context) ([]*domains.SLAPolicy, error)
+	SaveSLAPolicy(*domains.SLAPolicy, context.Context) error
+	ListHolidays(time.Time, context.Context) ([]domains.Holiday, error)
+	SaveHoliday(*domains.Holiday, context.Context) error
+	DeleteHoliday(time.Time, context.Context) error
+	ListSLAFlags(time.Time, context.Context) ([]domains.SLAFlag, error)
+	FlagFormSLA(domains.SLAFlag, string, context.Context) (bool, error)
+}
+
+type NotificationRepository interface {
+	ListNotifications(uuid.UUID, bool, int, context.Context) ([]*domains.Notification, error)
+	MarkNotificationRead(uuid.UUID, uuid.UUID, context.Context) error
+	MarkAllNotificationsRead(uuid.UUID, context.Context) (int64, error)
+}
+
+type MaintenanceRepository interface {
+	SaveMaintenancePlan(*domains.MaintenancePlan, context.Context) error
+	FindMaintenancePlanByID(uuid.UUID, context.Context) (*domains.MaintenancePlan, error)
+	ListMaintenancePlans(uuid.UUID, string, context.Context) ([]*domains.MaintenancePlan, error)
+	ListDueMaintenancePlans(time.Time, context.Context) ([]*domains.MaintenancePlan, error)
+	UpdateMaintenancePlan(*domains.MaintenancePlan, context.Context) error
+	DeleteMaintenancePlan(uuid.UUID, context.Context) error
+	SaveMaintenanceForm(*domains.MaintenancePlan, *domains.Atendimentos, []*domains.FormAssignmentChange, context.Context) (uuid.UUID, bool, error)
+	AdvanceMaintenancePlan(uuid.UUID, time.Time, context.Context) error
+}
+
+type ChecklistRepository interface {
+	SaveChecklistTemplate(*domains.ChecklistTemplate, context.Context) (uuid.UUID, error)
+	FindChecklistTemplateByID(uuid.UUID, context.Context) (*domains.ChecklistTemplate, error)
+	ListChecklistTemplates(context.Context) ([]*domains.ChecklistTemplate, error)
+	UpdateChecklistTemplate(*domains.ChecklistTemplate, context.Context) error
+	DeleteChecklistTemplate(uuid.UUID, context.Context) error
+	ListChecklistItems(uuid.UUID, context.Context) ([]*domains.ChecklistItem, error)
+	UpdateChecklistItem(*domains.ChecklistItem, context.Context) error
+}
+
+type FormTypeRepository interface {
+	SaveFormType(*domains.FormType, *domains.FormTypeVersion, context.Context) (uuid.UUID, error)
+	FindFormTypeByID(uuid.UUID, context.Context) (*domains.FormType, error)
+	ListFormTypes(context.Context) ([]*domains.FormType, error)
+	UpdateFormType(*domains.FormType, context.Context) error
+	SaveFormTypeVersion(*domains.FormTypeVersion, context.Context) (int, error)
+	FindFormTypeVersion(uuid.UUID, int, context.Context) (*domains.FormTypeVersion, error)
+	ListFormTypeVersions(uuid.UUID, context.Context) ([]*domains.FormTypeVersion, error)
+}
+
+type ScheduleRepository interface {
+	ListCalendar(domains.CalendarFilter, context.Context) ([]*domains.CalendarEntry, error)
+	SaveFormSchedule(uuid.UUID, []domains.AssignmentSchedule, bool, context.Context) ([]*domains.CalendarEntry, error)
+	ListRouteStops(uuid.UUID, time.Time, time.Time, context.Context) ([]domains.RouteStop, error)
+}
+
+type PartRepository interface {
+	SavePart(*domains.Part, context.Context) error
+	FindPartByID(uuid.UUID, context.Context) (*domains.Part, error)
+	ListParts(string, bool, context.Context) ([]*domains.Part, error)
+	UpdatePart(*domains.Part, context.Context) error
+	SaveStockLocation(*domains.StockLocation, context.Context) error
+	ListStockLocations(context.Context) ([]*domains.StockLocation, error)
+	ListStockLevels(domains.StockLevelFilter, context.Context) ([]*domains.StockLevel, error)
+	SaveStockMovements([]*domains.StockMovement, context.Context) error
+	ListStockMovements(domains.StockMovementFilter, context.Context) ([]*domains.StockMovement, error)
+	ListLowStock(context.Context) ([]domains.LowStockItem, error)
+	SaveFormPart(*domains.FormPart, context.Context) error
+	ListFormParts(uuid.UUID, context.Context) ([]*domains.FormPart, error)
+	DeleteFormPart(uuid.UUID, uuid.UUID, uuid.UUID, context.Context) (*domains.FormPart, error)
+}
+
+type InvoiceRepository interface {
+	GetBillingSettings(context.Context) (*domains.BillingSettings, error)
+	SaveBillingSettings(*domains.BillingSettings, context.Context) error
+	SaveInvoice(*domains.Invoice, context.Context) error
+	FindInvoiceByID(uuid.UUID, context.Context) (*domains.Invoice, error)
+	ListInvoices(domains.InvoiceFilter, context.Context) ([]*domains.Invoice, error)
+	UpdateInvoiceDraft(*domains.Invoice, context.Context) error
+	UpdateInvoiceStatus(*domains.Invoice, string, context.Context) error
+}
+
+type SimilarFormRepository interface {
+	ListSimilarFormCandidates(domains.SimilarFormsQuery, context.Context) ([]domains.SimilarFormCandidate, error)
+	SaveSimilarFormFeedback(*domains.SimilarFormFeedback, context.Context) error
+	DeleteSimilarFormFeedback(*domains.SimilarFormFeedback, context.Context) error
+}
+
+type ContractRepository interface {
+	SaveContract(*domains.Contract, context.Context) (uuid.UUID, error)
+	FindContractByID(uuid.UUID, context.Context) (*domains.Contract, error)
+	FindActiveContractByClient(uuid.UUID, time.Time, context.Context) (*domains.Contract, error)
+	ListContracts(context.Context) ([]*domains.Contract, error)
+	ListContractsByClient(uuid.UUID, context.Context) ([]*domains.Contract, error)
+	CountOverlappingContracts(*domains.Contract, context.Context) (int64, error)
+	UpdateContract(*domains.Contract, context.Context) error
+	DeleteContract(uuid.UUID, context.Context) error
+	ListContractConsumption(uuid.UUID, context.Context) ([]domains.ContractConsumption, error)
+	ConsumedHoursByContract(time.Time, time.Time, context.Context) (map[uuid.UUID]decimal.Decimal, error)
+}
+
+type CustomFieldRepository interface {
+	SaveCustomField(*domains.CustomFieldDefinition, context.Context) (uuid.UUID, error)
+	FindCustomFieldByID(uuid.UUID, context.Context) (*domains.CustomFieldDefinition, error)
+	ListCustomFields(string, context.Context) ([]*domains.CustomFieldDefinition, error)
+	UpdateCustomField(*domains.CustomFieldDefinition, context.Context) error
+	DeleteCustomField(uuid.UUID, context.Context) error
+}
+
+type PortalRepository interface {
+	SaveClientUser(*domains.ClientUser, context.Context) (uuid.UUID, error)
+	FindClientUserByID(uuid.UUID, context.Context) (*domains.ClientUser, error)
+	FindClientUserByEmail(string, context.Context) (*domains.ClientUser, error)
+	ListClientUsers(uuid.UUID, context.Context) ([]*domains.ClientUser, error)
+	DeleteClientUser(uuid.UUID, context.Context) error
+	TouchClientUserLogin(uuid.UUID, context.Context) error
+	SavePortalRequest(*domains.PortalRequest, context.Context) (uuid.UUID, error)
+	FindPortalRequestByID(uuid.UUID, context.Context) (*domains.PortalRequest, error)
+	ListPortalRequests(uuid.UUID, string, context.Context) ([]*domains.PortalRequest, error)
+	UpdatePortalRequestReview(*domains.PortalRequest, context.Context) error
+}
+
+type SurveyRepository interface {
+	ListPendingSurveys(time.Time, int, context.Context) ([]*domains.SatisfactionSurvey, error)
+	ClaimSurvey(*domains.SatisfactionSurvey, context.Context) error
+	ReleaseSurvey(*domains.SatisfactionSurvey, context.Context) error
+	FindSurveyByToken(string, context.Context) (*domains.SatisfactionSurvey, error)
+	FindSurveyByForm(uuid.UUID, context.Context) (*domains.SatisfactionSurvey, error)
+	AnswerSurvey(uuid.UUID, domains.SurveyAnswer, time.Time, context.Context) error
+	SurveySummary(domains.SurveySummaryFilter, string, context.Context) ([]domains.SurveyTotals, error)
+}
+
+type DispatchRepository interface {
+	ListDispatchCandidates(uuid.UUID, time.Time, context.Context) ([]domains.DispatchCandidate, error)
+	FindClientLocation(uuid.UUID, context.Context) (*domains.GeoPoint, error)
+	FindMemberUserID(uuid.UUID, context.Context) (uuid.UUID, error)
+	UpdateMemberHomeLocation(uuid.UUID, domains.GeoPoint, context.Context) error
+	UpdateMemberCurrentLocation(uuid.UUID, domains.GeoPoint, time.Time, context.Context) error
 }
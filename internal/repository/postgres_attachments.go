@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresAttachmentRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresAttachmentRepository(db *pgxpool.Pool) AttachmentRepository {
+	return &postgresAttachmentRepository{db: pgstore.New(db), pool: db}
+}
+
+func (p *postgresAttachmentRepository) SaveAttachment(a *domains.Attachment, ctx context.Context) error {
+	createdAt, err := p.db.CreateFormAttachmentQuery(ctx, pgstore.CreateFormAttachmentQueryParams{
+		ID:             a.ID,
+		FormID:         a.FormID,
+		FileName:       a.FileName,
+		ContentType:    a.ContentType,
+		SizeBytes:      a.Size,
+		ChecksumSha256: a.Checksum,
+		StorageKey:     a.StorageKey,
+		UploadedBy:     pgtype.UUID{Bytes: a.UploadedBy, Valid: a.UploadedBy != uuid.Nil},
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domains.ErrFormNotFound
+		}
+		return err
+	}
+
+	a.CreatedAt = createdAt.UTC()
+	return nil
+}
+func (p *postgresAttachmentRepository) ListAttachments(formID uuid.UUID, ctx context.Context) ([]*domains.Attachment, error) {
+	rows, err := p.db.GetFormAttachmentsQuery(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]*domains.Attachment, 0, len(rows))
+	for _, row := range rows {
+		attachments = append(attachments, &domains.Attachment{
+			ID:             row.ID,
+			FormID:         row.FormID,
+			FileName:       row.FileName,
+			ContentType:    row.ContentType,
+			Size:           row.SizeBytes,
+			Checksum:       row.ChecksumSha256,
+			StorageKey:     row.StorageKey,
+			UploadedBy:     uuid.UUID(row.UploadedBy.Bytes),
+			UploadedByName: row.UploadedByName.String,
+			CreatedAt:      row.CreatedAt.UTC(),
+		})
+	}
+
+	return attachments, nil
+}
+func (p *postgresAttachmentRepository) FindAttachment(formID, id uuid.UUID, ctx context.Context) (*domains.Attachment, error) {
+	row, err := p.db.GetFormAttachmentByIdQuery(ctx, pgstore.GetFormAttachmentByIdQueryParams{
+		ID:     id,
+		FormID: formID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return &domains.Attachment{
+		ID:             row.ID,
+		FormID:         row.FormID,
+		FileName:       row.FileName,
+		ContentType:    row.ContentType,
+		Size:           row.SizeBytes,
+		Checksum:       row.ChecksumSha256,
+		StorageKey:     row.StorageKey,
+		UploadedBy:     uuid.UUID(row.UploadedBy.Bytes),
+		UploadedByName: row.UploadedByName.String,
+		CreatedAt:      row.CreatedAt.UTC(),
+	}, nil
+}
+func (p *postgresAttachmentRepository) DeleteAttachment(formID, id uuid.UUID, ctx context.Context) error {
+	affected, err := p.db.DeleteFormAttachmentQuery(ctx, pgstore.DeleteFormAttachmentQueryParams{
+		ID:     id,
+		FormID: formID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrAttachmentNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresChecklistRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresChecklistRepository(db *pgxpool.Pool) ChecklistRepository {
+	return &postgresChecklistRepository{db: pgstore.New(db), pool: db}
+}
+
+func (p *postgresChecklistRepository) SaveChecklistTemplate(t *domains.ChecklistTemplate, ctx context.Context) (uuid.UUID, error) {
+	items, err := json.Marshal(t.Items)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := p.db.CreateChecklistTemplateQuery(ctx, pgstore.CreateChecklistTemplateQueryParams{
+		Name:        t.Name,
+		Description: t.Description,
+		Items:       items,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return uuid.Nil, domains.ErrChecklistTemplateNameTaken
+		}
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+func (p *postgresChecklistRepository) FindChecklistTemplateByID(id uuid.UUID, ctx context.Context) (*domains.ChecklistTemplate, error) {
+	row, err := p.db.GetChecklistTemplateByIdQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrChecklistTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return checklistTemplateFromRow(row)
+}
+func (p *postgresChecklistRepository) ListChecklistTemplates(ctx context.Context) ([]*domains.ChecklistTemplate, error) {
+	rows, err := p.db.GetChecklistTemplatesQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*domains.ChecklistTemplate, 0, len(rows))
+	for _, row := range rows {
+		t, err := checklistTemplateFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+
+	return templates, nil
+}
+func (p *postgresChecklistRepository) UpdateChecklistTemplate(t *domains.ChecklistTemplate, ctx context.Context) error {
+	items, err := json.Marshal(t.Items)
+	if err != nil {
+		return err
+	}
+
+	affected, err := p.db.UpdateChecklistTemplateQuery(ctx, pgstore.UpdateChecklistTemplateQueryParams{
+		Name:        t.Name,
+		Description: t.Description,
+		Items:       items,
+		ID:          t.ID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domains.ErrChecklistTemplateNameTaken
+		}
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrChecklistTemplateNotFound
+	}
+
+	return nil
+}
+
+// DeleteChecklistTemplate remove o modelo; os itens já copiados para os
+// atendimentos continuam, sem a referência ao modelo.
+func (p *postgresChecklistRepository) DeleteChecklistTemplate(id uuid.UUID, ctx context.Context) error {
+	affected, err := p.db.DeleteChecklistTemplateQuery(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrChecklistTemplateNotFound
+	}
+
+	return nil
+}
+
+func (p *postgresChecklistRepository) ListChecklistItems(formID uuid.UUID, ctx context.Context) ([]*domains.ChecklistItem, error) {
+	rows, err := p.db.GetFormChecklistItemsQuery(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*domains.ChecklistItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &domains.ChecklistItem{
+			ID:           row.ID,
+			FormID:       row.FormID,
+			TemplateID:   uuid.UUID(row.TemplateID.Bytes),
+			Position:     int(row.Position),
+			Label:        row.Label,
+			Kind:         row.Kind,
+			Required:     row.Required,
+			Unit:         row.Unit,
+			Min:          floatOrNil(row.MinValue),
+			Max:          floatOrNil(row.MaxValue),
+			Done:         row.Done,
+			Passed:       boolOrNil(row.Passed),
+			Number:       floatOrNil(row.NumberValue),
+			Text:         row.TextValue,
+			AttachmentID: uuid.UUID(row.AttachmentID.Bytes),
+			OutOfRange:   row.OutOfRange,
+			DoneBy:       uuid.UUID(row.DoneBy.Bytes),
+			DoneAt:       timeOrZero(row.DoneAt),
+		})
+	}
+	return items, nil
+}
+
+// UpdateChecklistItem grava a resposta do item. Uma foto excluída entre a
+// conferência e a gravação viola a chave estrangeira e vira
+// ErrAttachmentNotFound.
+func (p *postgresChecklistRepository) UpdateChecklistItem(item *domains.ChecklistItem, ctx context.Context) error {
+	rows, err := p.db.UpdateFormChecklistItemQuery(ctx, pgstore.UpdateFormChecklistItemQueryParams{
+		ID:           item.ID,
+		FormID:       item.FormID,
+		Done:         item.Done,
+		DoneBy:       pgtype.UUID{Bytes: item.DoneBy, Valid: item.DoneBy != uuid.Nil},
+		DoneAt:       nullTimestamptz(item.DoneAt),
+		Passed:       nullBool(item.Passed),
+		NumberValue:  nullFloat(item.Number),
+		TextValue:    item.Text,
+		AttachmentID: pgtype.UUID{Bytes: item.AttachmentID, Valid: item.AttachmentID != uuid.Nil},
+		OutOfRange:   item.OutOfRange,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domains.ErrAttachmentNotFound
+		}
+		return err
+	}
+	if rows == 0 {
+		return domains.ErrChecklistItemNotFound
+	}
+	return nil
+}
+
+// createChecklistItems grava os itens da lista de verificação de um
+// atendimento dentro da transação que o cria.
+func createChecklistItems(qtx *pgstore.Queries, items []*domains.ChecklistItem, ctx context.Context) error {
+	for _, item := range items {
+		if err := qtx.CreateFormChecklistItemQuery(ctx, pgstore.CreateFormChecklistItemQueryParams{
+			FormID:     item.FormID,
+			Position:   int32(item.Position),
+			Label:      item.Label,
+			TemplateID: pgtype.UUID{Bytes: item.TemplateID, Valid: item.TemplateID != uuid.Nil},
+			Kind:       item.Kind,
+			Required:   item.Required,
+			Unit:       item.Unit,
+			MinValue:   nullFloat(item.Min),
+			MaxValue:   nullFloat(item.Max),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checklistTemplateFromRow(row pgstore.ChecklistTemplate) (*domains.ChecklistTemplate, error) {
+	t := &domains.ChecklistTemplate{
+		ID:          row.ID,
+		Name:        row.Name,
+		Description: row.Description,
+		CreatedAt:   row.CreatedAt.UTC(),
+		UpdatedAt:   row.UpdatedAt.UTC(),
+	}
+	if err := json.Unmarshal(row.Items, &t.Items); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func nullFloat(v *float64) pgtype.Float8 {
+	if v == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: *v, Valid: true}
+}
+
+func floatOrNil(v pgtype.Float8) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float64
+}
+
+func nullBool(v *bool) pgtype.Bool {
+	if v == nil {
+		return pgtype.Bool{}
+	}
+	return pgtype.Bool{Bool: *v, Valid: true}
+}
+
+func boolOrNil(v pgtype.Bool) *bool {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Bool
+}
@@ -2,10 +2,15 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"olidesk-api-2/internal/domains"
 	"olidesk-api-2/internal/store/pgstore"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -20,6 +25,11 @@ func NewPostgresClientsRepository(db *pgxpool.Pool) ClientRepository {
 }
 
 func (u *postgresClientsRepository) SaveClient(c *domains.Client, ctx context.Context) (uuid.UUID, error) {
+	customFields, err := encodeCustomFields(c.CustomFields)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
 	arg := pgstore.CreateClientQueryParams{
 		Name: c.ClientName,
 
@@ -41,6 +51,9 @@ func (u *postgresClientsRepository) SaveClient(c *domains.Client, ctx context.Co
 
 		Latitude:  pgtype.Float8{Float64: c.Address.Latitude, Valid: true},
 		Longitude: pgtype.Float8{Float64: c.Address.Longitude, Valid: true},
+
+		CustomFields: customFields,
+		Tags:         tagsOrEmpty(c.Tags),
 	}
 
 	id, err := u.db.CreateClientQuery(ctx, arg)
@@ -52,6 +65,14 @@ func (u *postgresClientsRepository) SaveClient(c *domains.Client, ctx context.Co
 }
 func (u *postgresClientsRepository) FindClientByID(id uuid.UUID, ctx context.Context) (*domains.Client, error) {
 	client, err := u.db.GetClientByIdQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrClientNotFound
+		}
+		return nil, err
+	}
+
+	customFields, err := decodeCustomFields(client.CustomFields)
 	if err != nil {
 		return nil, err
 	}
@@ -78,17 +99,32 @@ func (u *postgresClientsRepository) FindClientByID(id uuid.UUID, ctx context.Con
 			Latitude:     client.Latitude.Float64,
 			Longitude:    client.Longitude.Float64,
 		},
-		CreatedAt: client.CreatedAt.UTC(),
-		UpdatedAt: client.UpdatedAt.UTC(),
+		CustomFields: customFields,
+		Tags:         client.Tags,
+		CreatedAt:    client.CreatedAt.UTC(),
+		UpdatedAt:    client.UpdatedAt.UTC(),
 	}, nil
 }
-func (u *postgresClientsRepository) ListClients(ctx context.Context) ([]*domains.Client, error) {
-	cData, err := u.db.GetAllClientsQuery(ctx)
+func (u *postgresClientsRepository) ListClients(filter domains.ListFilter, ctx context.Context) ([]*domains.Client, error) {
+	customFilter, err := encodeCustomFields(filter.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	cData, err := u.db.GetAllClientsQuery(ctx, pgstore.GetAllClientsQueryParams{
+		CustomFields: customFilter,
+		Tags:         tagsOrEmpty(filter.Tags),
+	})
 	if err != nil {
 		return nil, err
 	}
 	clients := make([]*domains.Client, 0, len(cData))
 	for _, client := range cData {
+		customFields, err := decodeCustomFields(client.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+
 		clients = append(clients, &domains.Client{
 			ID:         client.ID,
 			CnpjOrCpf:  client.CnpjCpf.String,
@@ -111,14 +147,21 @@ func (u *postgresClientsRepository) ListClients(ctx context.Context) ([]*domains
 				Latitude:     client.Latitude.Float64,
 				Longitude:    client.Longitude.Float64,
 			},
-			CreatedAt: client.CreatedAt.UTC(),
-			UpdatedAt: client.UpdatedAt.UTC(),
+			CustomFields: customFields,
+			Tags:         client.Tags,
+			CreatedAt:    client.CreatedAt.UTC(),
+			UpdatedAt:    client.UpdatedAt.UTC(),
 		})
 	}
 
 	return clients, nil
 }
 func (u *postgresClientsRepository) UpdateClient(c *domains.Client, ctx context.Context) error {
+	customFields, err := encodeCustomFields(c.CustomFields)
+	if err != nil {
+		return err
+	}
+
 	arg := pgstore.UpdateClientQueryParams{
 		ID:   c.ID,
 		Name: c.ClientName,
@@ -141,6 +184,9 @@ func (u *postgresClientsRepository) UpdateClient(c *domains.Client, ctx context.
 
 		Latitude:  pgtype.Float8{Float64: c.Address.Latitude, Valid: true},
 		Longitude: pgtype.Float8{Float64: c.Address.Longitude, Valid: true},
+
+		CustomFields: customFields,
+		Tags:         tagsOrEmpty(c.Tags),
 	}
 
 	if err := u.db.UpdateClientQuery(ctx, arg); err != nil {
@@ -156,3 +202,207 @@ func (u *postgresClientsRepository) DeleteClient(id uuid.UUID, ctx context.Conte
 
 	return nil
 }
+func (u *postgresClientsRepository) ListDeletedClients(ctx context.Context) ([]*domains.Client, error) {
+	rows, err := u.db.GetDeletedClientsQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make([]*domains.Client, 0, len(rows))
+	for _, row := range rows {
+		clients = append(clients, &domains.Client{
+			ID:         row.ID,
+			ClientName: row.Name,
+			ClientType: string(row.ClientType),
+			CnpjOrCpf:  row.CnpjCpf.String,
+			DeletedAt:  row.DeletedAt.Time.UTC(),
+		})
+	}
+
+	return clients, nil
+}
+func (u *postgresClientsRepository) RestoreClient(id uuid.UUID, ctx context.Context) error {
+	affected, err := u.db.RestoreClientQuery(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrClientNotFound
+	}
+
+	return nil
+}
+
+// PurgeClient apaga de vez o cliente na lixeira. Atendimentos, contratos,
+// faturas e unificações impedem a exclusão (ErrClientHasHistory), para que o
+// histórico nunca seja apagado junto.
+func (u *postgresClientsRepository) PurgeClient(id uuid.UUID, ctx context.Context) error {
+	affected, err := u.db.PurgeClientQuery(ctx, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domains.ErrClientHasHistory
+		}
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrClientNotFound
+	}
+
+	return nil
+}
+func (u *postgresClientsRepository) MergeClients(m *domains.ClientMerge, ctx context.Context) (uuid.UUID, error) {
+	snapshot, err := json.Marshal(m.SourceSnapshot)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	tx, err := u.pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to begin tx for MergeClients: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := u.db.WithTx(tx)
+
+	if err := checkMergeContracts(qtx, m, ctx); err != nil {
+		return uuid.Nil, err
+	}
+
+	formsMoved, contractsMoved, err := reassignClientRecords(qtx, m, ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := qtx.DeleteClientQuery(ctx, m.SourceClientID); err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := qtx.CreateClientMergeQuery(ctx, pgstore.CreateClientMergeQueryParams{
+		SourceClientID: m.SourceClientID,
+		TargetClientID: m.TargetClientID,
+		SourceSnapshot: snapshot,
+		FormsMoved:     int32(formsMoved),
+		ContractsMoved: int32(contractsMoved),
+		MergedBy:       pgtype.UUID{Bytes: m.MergedBy, Valid: m.MergedBy != uuid.Nil},
+		MergedAt:       m.MergedAt.UTC(),
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, err
+	}
+
+	m.ID = id
+	m.FormsMoved = formsMoved
+	m.ContractsMoved = contractsMoved
+	return id, nil
+}
+
+// checkMergeContracts trava os dois clientes até o fim da transação e confere
+// se os contratos da origem podem passar ao destino (ClientMerge.CheckContracts).
+func checkMergeContracts(qtx *pgstore.Queries, m *domains.ClientMerge, ctx context.Context) error {
+	clients, err := qtx.LockMergeClientsQuery(ctx, []uuid.UUID{m.SourceClientID, m.TargetClientID})
+	if err != nil {
+		return err
+	}
+	if len(clients) != 2 {
+		return domains.ErrClientNotFound
+	}
+	targetType := ""
+	for _, c := range clients {
+		if c.ID == m.TargetClientID {
+			targetType = string(c.ClientType)
+		}
+	}
+
+	counts, err := qtx.CountMergeContractsQuery(ctx, pgstore.CountMergeContractsQueryParams{
+		TargetClientID: m.TargetClientID,
+		SourceClientID: m.SourceClientID,
+	})
+	if err != nil {
+		return err
+	}
+	return m.CheckContracts(targetType, counts.SourceContracts, counts.OverlappingContracts)
+}
+
+// reassignClientRecords passa para o cliente de destino tudo o que aponta para
+// o cliente de origem e devolve quantos atendimentos e contratos mudaram.
+func reassignClientRecords(qtx *pgstore.Queries, m *domains.ClientMerge, ctx context.Context) (int64, int64, error) {
+	formsMoved, err := qtx.ReassignClientFormsQuery(ctx, pgstore.ReassignClientFormsQueryParams{
+		TargetClientID: m.TargetClientID,
+		SourceClientID: m.SourceClientID,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	contractsMoved, err := qtx.ReassignClientContractsQuery(ctx, pgstore.ReassignClientContractsQueryParams{
+		TargetClientID: m.TargetClientID,
+		SourceClientID: m.SourceClientID,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Usuários e solicitações do portal acompanham o cliente que permanece.
+	if _, err := qtx.ReassignClientUsersQuery(ctx, pgstore.ReassignClientUsersQueryParams{
+		TargetClientID: m.TargetClientID,
+		SourceClientID: m.SourceClientID,
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	if _, err := qtx.ReassignClientPortalRequestsQuery(ctx, pgstore.ReassignClientPortalRequestsQueryParams{
+		TargetClientID: m.TargetClientID,
+		SourceClientID: m.SourceClientID,
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	// Faturas ficam com o cliente que permanece, que é quem responde por elas.
+	if _, err := qtx.ReassignClientInvoicesQuery(ctx, pgstore.ReassignClientInvoicesQueryParams{
+		TargetClientID: m.TargetClientID,
+		SourceClientID: m.SourceClientID,
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	// Os planos de manutenção continuam gerando visitas para o cliente que
+	// permanece; a geração ignora clientes excluídos.
+	if _, err := qtx.ReassignClientMaintenancePlansQuery(ctx, pgstore.ReassignClientMaintenancePlansQueryParams{
+		TargetClientID: m.TargetClientID,
+		SourceClientID: m.SourceClientID,
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	return formsMoved, contractsMoved, nil
+}
+func (u *postgresClientsRepository) ListClientMerges(clientID uuid.UUID, ctx context.Context) ([]*domains.ClientMerge, error) {
+	rows, err := u.db.GetClientMergesQuery(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	merges := make([]*domains.ClientMerge, 0, len(rows))
+	for _, row := range rows {
+		merge := &domains.ClientMerge{
+			ID:             row.ID,
+			SourceClientID: row.SourceClientID,
+			TargetClientID: row.TargetClientID,
+			FormsMoved:     int64(row.FormsMoved),
+			ContractsMoved: int64(row.ContractsMoved),
+			MergedBy:       uuid.UUID(row.MergedBy.Bytes),
+			MergedAt:       row.MergedAt.UTC(),
+		}
+		if err := json.Unmarshal(row.SourceSnapshot, &merge.SourceSnapshot); err != nil {
+			return nil, err
+		}
+		merges = append(merges, merge)
+	}
+
+	return merges, nil
+}
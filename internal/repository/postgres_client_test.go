@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updatedTable = regexp.MustCompile(`(?m)^UPDATE (\w+)$`)
+
+// execRecorder é um pgstore.DBTX em memória que guarda, por tabela, os
+// argumentos dos UPDATEs e responde com duas linhas afetadas.
+type execRecorder struct {
+	updates map[string][]any
+}
+
+func (db *execRecorder) Exec(_ context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	match := updatedTable.FindStringSubmatch(sql)
+	if match == nil {
+		return pgconn.CommandTag{}, fmt.Errorf("unexpected exec: %s", sql)
+	}
+	db.updates[match[1]] = args
+	return pgconn.NewCommandTag("UPDATE 2"), nil
+}
+
+func (db *execRecorder) Query(_ context.Context, sql string, _ ...interface{}) (pgx.Rows, error) {
+	return nil, fmt.Errorf("unexpected query: %s", sql)
+}
+
+func (db *execRecorder) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	return &fakeRows{}
+}
+
+func (db *execRecorder) CopyFrom(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) (int64, error) {
+	return 0, nil
+}
+
+func TestReassignClientRecords(t *testing.T) {
+	db := &execRecorder{updates: map[string][]any{}}
+	merge := &domains.ClientMerge{SourceClientID: uuid.New(), TargetClientID: uuid.New()}
+
+	formsMoved, contractsMoved, err := reassignClientRecords(pgstore.New(db), merge, context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), formsMoved)
+	assert.Equal(t, int64(2), contractsMoved)
+
+	for _, table := range []string{"forms", "contracts", "client_users", "portal_requests", "invoices", "maintenance_plans"} {
+		assert.Equal(t, []any{merge.TargetClientID, merge.SourceClientID}, db.updates[table], table)
+	}
+}
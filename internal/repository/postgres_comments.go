@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresCommentRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresCommentRepository(db *pgxpool.Pool) CommentRepository {
+	return &postgresCommentRepository{db: pgstore.New(db), pool: db}
+}
+
+func (p *postgresCommentRepository) SaveComment(c *domains.FormComment, ctx context.Context) error {
+	row, err := p.db.CreateFormCommentQuery(ctx, pgstore.CreateFormCommentQueryParams{
+		FormID:   c.FormID,
+		AuthorID: pgtype.UUID{Bytes: c.AuthorID, Valid: c.AuthorID != uuid.Nil},
+		Body:     c.Body,
+		Internal: c.Internal,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domains.ErrFormNotFound
+		}
+		return err
+	}
+
+	c.ID = row.ID
+	c.CreatedAt = row.CreatedAt.UTC()
+	c.UpdatedAt = row.UpdatedAt.UTC()
+	return nil
+}
+
+// ListComments devolve os comentários do atendimento em ordem cronológica;
+// com includeInternal falso, os internos ficam de fora.
+func (p *postgresCommentRepository) ListComments(formID uuid.UUID, includeInternal bool, ctx context.Context) ([]*domains.FormComment, error) {
+	rows, err := p.db.GetFormCommentsQuery(ctx, pgstore.GetFormCommentsQueryParams{
+		FormID:          formID,
+		IncludeInternal: includeInternal,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*domains.FormComment, 0, len(rows))
+	for _, row := range rows {
+		comments = append(comments, &domains.FormComment{
+			ID:         row.ID,
+			FormID:     row.FormID,
+			AuthorID:   uuid.UUID(row.AuthorID.Bytes),
+			AuthorName: row.AuthorName.String,
+			Body:       row.Body,
+			Internal:   row.Internal,
+			EditCount:  row.EditCount,
+			CreatedAt:  row.CreatedAt.UTC(),
+			UpdatedAt:  row.UpdatedAt.UTC(),
+		})
+	}
+
+	return comments, nil
+}
+func (p *postgresCommentRepository) FindComment(formID, id uuid.UUID, ctx context.Context) (*domains.FormComment, error) {
+	row, err := p.db.GetFormCommentByIdQuery(ctx, pgstore.GetFormCommentByIdQueryParams{
+		ID:     id,
+		FormID: formID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrCommentNotFound
+		}
+		return nil, err
+	}
+
+	return &domains.FormComment{
+		ID:         row.ID,
+		FormID:     row.FormID,
+		AuthorID:   uuid.UUID(row.AuthorID.Bytes),
+		AuthorName: row.AuthorName.String,
+		Body:       row.Body,
+		Internal:   row.Internal,
+		EditCount:  row.EditCount,
+		CreatedAt:  row.CreatedAt.UTC(),
+		UpdatedAt:  row.UpdatedAt.UTC(),
+	}, nil
+}
+
+// UpdateComment grava o comentário editado e a versão anterior na mesma
+// transação.
+func (p *postgresCommentRepository) UpdateComment(c *domains.FormComment, edit *domains.FormCommentEdit, ctx context.Context) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for UpdateComment: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	affected, err := qtx.UpdateFormCommentQuery(ctx, pgstore.UpdateFormCommentQueryParams{
+		Body:      c.Body,
+		Internal:  c.Internal,
+		UpdatedAt: c.UpdatedAt.UTC(),
+		ID:        c.ID,
+		FormID:    c.FormID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrCommentNotFound
+	}
+
+	if err := qtx.CreateFormCommentEditQuery(ctx, pgstore.CreateFormCommentEditQueryParams{
+		CommentID:        edit.CommentID,
+		PreviousBody:     edit.PreviousBody,
+		PreviousInternal: edit.PreviousInternal,
+		EditedBy:         pgtype.UUID{Bytes: edit.EditedBy, Valid: edit.EditedBy != uuid.Nil},
+		EditedAt:         edit.EditedAt.UTC(),
+	}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+func (p *postgresCommentRepository) ListCommentEdits(commentID uuid.UUID, ctx context.Context) ([]*domains.FormCommentEdit, error) {
+	rows, err := p.db.GetFormCommentEditsQuery(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	edits := make([]*domains.FormCommentEdit, 0, len(rows))
+	for _, row := range rows {
+		edits = append(edits, &domains.FormCommentEdit{
+			ID:               row.ID,
+			CommentID:        row.CommentID,
+			PreviousBody:     row.PreviousBody,
+			PreviousInternal: row.PreviousInternal,
+			EditedBy:         uuid.UUID(row.EditedBy.Bytes),
+			EditedByName:     row.EditedByName.String,
+			EditedAt:         row.EditedAt.UTC(),
+		})
+	}
+
+	return edits, nil
+}
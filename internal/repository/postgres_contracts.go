@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+type postgresContractsRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresContractsRepository(db *pgxpool.Pool) ContractRepository {
+	return &postgresContractsRepository{db: pgstore.New(db), pool: db}
+}
+
+func (p *postgresContractsRepository) SaveContract(c *domains.Contract, ctx context.Context) (uuid.UUID, error) {
+	id, err := p.db.CreateContractQuery(ctx, pgstore.CreateContractQueryParams{
+		ClientID:           c.Cliente.ID,
+		StartDate:          pgtype.Date{Time: c.StartDate, Valid: true},
+		EndDate:            pgtype.Date{Time: c.EndDate, Valid: true},
+		MonthlyHours:       c.MonthlyHours,
+		CoveredServices:    c.CoveredServices,
+		ResponseSlaHours:   c.ResponseSLAHours,
+		ResolutionSlaHours: c.ResolutionSLAHours,
+		MonthlyPrice:       c.MonthlyPrice,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+func (p *postgresContractsRepository) FindContractByID(id uuid.UUID, ctx context.Context) (*domains.Contract, error) {
+	row, err := p.db.GetContractByIdQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrContractNotFound
+		}
+		return nil, err
+	}
+
+	return contractFromRow(pgstore.GetContractsQueryRow(row)), nil
+}
+func (p *postgresContractsRepository) FindActiveContractByClient(clientID uuid.UUID, at time.Time, ctx context.Context) (*domains.Contract, error) {
+	row, err := p.db.GetActiveContractByClientQuery(ctx, pgstore.GetActiveContractByClientQueryParams{
+		ClientID:      clientID,
+		ReferenceDate: pgtype.Date{Time: at.UTC(), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrContractNotFound
+		}
+		return nil, err
+	}
+
+	return contractFromRow(pgstore.GetContractsQueryRow(row)), nil
+}
+func (p *postgresContractsRepository) ListContracts(ctx context.Context) ([]*domains.Contract, error) {
+	rows, err := p.db.GetContractsQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make([]*domains.Contract, 0, len(rows))
+	for _, row := range rows {
+		contracts = append(contracts, contractFromRow(row))
+	}
+
+	return contracts, nil
+}
+func (p *postgresContractsRepository) ListContractsByClient(clientID uuid.UUID, ctx context.Context) ([]*domains.Contract, error) {
+	rows, err := p.db.GetContractsByClientQuery(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make([]*domains.Contract, 0, len(rows))
+	for _, row := range rows {
+		contracts = append(contracts, contractFromRow(pgstore.GetContractsQueryRow(row)))
+	}
+
+	return contracts, nil
+}
+func (p *postgresContractsRepository) CountOverlappingContracts(c *domains.Contract, ctx context.Context) (int64, error) {
+	return p.db.CountOverlappingContractsQuery(ctx, pgstore.CountOverlappingContractsQueryParams{
+		ClientID:     c.Cliente.ID,
+		ID:           c.ID,
+		NewEndDate:   pgtype.Date{Time: c.EndDate, Valid: true},
+		NewStartDate: pgtype.Date{Time: c.StartDate, Valid: true},
+	})
+}
+func (p *postgresContractsRepository) UpdateContract(c *domains.Contract, ctx context.Context) error {
+	if err := p.db.UpdateContractQuery(ctx, pgstore.UpdateContractQueryParams{
+		StartDate:          pgtype.Date{Time: c.StartDate, Valid: true},
+		EndDate:            pgtype.Date{Time: c.EndDate, Valid: true},
+		MonthlyHours:       c.MonthlyHours,
+		CoveredServices:    c.CoveredServices,
+		ResponseSlaHours:   c.ResponseSLAHours,
+		ResolutionSlaHours: c.ResolutionSLAHours,
+		MonthlyPrice:       c.MonthlyPrice,
+		ID:                 c.ID,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (p *postgresContractsRepository) DeleteContract(id uuid.UUID, ctx context.Context) error {
+	if err := p.db.DeleteContractQuery(ctx, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (p *postgresContractsRepository) ListContractConsumption(id uuid.UUID, ctx context.Context) ([]domains.ContractConsumption, error) {
+	rows, err := p.db.GetContractConsumptionQuery(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	consumption := make([]domains.ContractConsumption, 0, len(rows))
+	for _, row := range rows {
+		consumption = append(consumption, domains.ContractConsumption{
+			FormID:     row.ID,
+			OccurredAt: row.OccurredAt.UTC(),
+			Hours:      row.HoursConsumed,
+		})
+	}
+
+	return consumption, nil
+}
+func (p *postgresContractsRepository) ConsumedHoursByContract(start, end time.Time, ctx context.Context) (map[uuid.UUID]decimal.Decimal, error) {
+	rows, err := p.db.GetContractsConsumedHoursQuery(ctx, pgstore.GetContractsConsumedHoursQueryParams{
+		PeriodStart: start.UTC(),
+		PeriodEnd:   end.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	consumed := make(map[uuid.UUID]decimal.Decimal, len(rows))
+	for _, row := range rows {
+		consumed[row.ContractID] = row.ConsumedHours
+	}
+
+	return consumed, nil
+}
+
+func contractFromRow(row pgstore.GetContractsQueryRow) *domains.Contract {
+	return &domains.Contract{
+		ID: row.ID,
+		Cliente: domains.ClientForm{
+			ID:         row.ClientID,
+			ClientName: row.ClientName,
+		},
+		StartDate:          row.StartDate.Time,
+		EndDate:            row.EndDate.Time,
+		MonthlyHours:       row.MonthlyHours,
+		CoveredServices:    row.CoveredServices,
+		ResponseSLAHours:   row.ResponseSlaHours,
+		ResolutionSLAHours: row.ResolutionSlaHours,
+		MonthlyPrice:       row.MonthlyPrice,
+		CreatedAt:          row.CreatedAt.UTC(),
+		UpdatedAt:          row.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresCustomFieldsRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresCustomFieldsRepository(db *pgxpool.Pool) CustomFieldRepository {
+	return &postgresCustomFieldsRepository{db: pgstore.New(db), pool: db}
+}
+
+func (p *postgresCustomFieldsRepository) SaveCustomField(d *domains.CustomFieldDefinition, ctx context.Context) (uuid.UUID, error) {
+	rules, err := json.Marshal(d.Rules)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := p.db.CreateCustomFieldQuery(ctx, pgstore.CreateCustomFieldQueryParams{
+		Entity:    d.Entity,
+		FieldKey:  d.Key,
+		Label:     d.Label,
+		FieldType: d.Type,
+		Required:  d.Required,
+		Options:   tagsOrEmpty(d.Options),
+		Rules:     rules,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return uuid.Nil, domains.ErrCustomFieldKeyTaken
+		}
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+func (p *postgresCustomFieldsRepository) FindCustomFieldByID(id uuid.UUID, ctx context.Context) (*domains.CustomFieldDefinition, error) {
+	row, err := p.db.GetCustomFieldByIdQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrCustomFieldNotFound
+		}
+		return nil, err
+	}
+
+	return customFieldFromRow(row)
+}
+func (p *postgresCustomFieldsRepository) ListCustomFields(entity string, ctx context.Context) ([]*domains.CustomFieldDefinition, error) {
+	rows, err := p.db.GetCustomFieldsQuery(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	defs := make([]*domains.CustomFieldDefinition, 0, len(rows))
+	for _, row := range rows {
+		d, err := customFieldFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, d)
+	}
+
+	return defs, nil
+}
+func (p *postgresCustomFieldsRepository) UpdateCustomField(d *domains.CustomFieldDefinition, ctx context.Context) error {
+	rules, err := json.Marshal(d.Rules)
+	if err != nil {
+		return err
+	}
+
+	affected, err := p.db.UpdateCustomFieldQuery(ctx, pgstore.UpdateCustomFieldQueryParams{
+		Label:    d.Label,
+		Required: d.Required,
+		Options:  tagsOrEmpty(d.Options),
+		Rules:    rules,
+		ID:       d.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrCustomFieldNotFound
+	}
+
+	return nil
+}
+
+// DeleteCustomField remove a definição e a chave correspondente dos registros
+// da entidade, para que valores órfãos não bloqueiem atualizações futuras.
+func (p *postgresCustomFieldsRepository) DeleteCustomField(id uuid.UUID, ctx context.Context) error {
+	d, err := p.FindCustomFieldByID(id, ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for DeleteCustomField: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	affected, err := qtx.DeleteCustomFieldQuery(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrCustomFieldNotFound
+	}
+
+	switch d.Entity {
+	case domains.CustomFieldEntityClient:
+		err = qtx.RemoveClientsCustomFieldQuery(ctx, d.Key)
+	case domains.CustomFieldEntityForm:
+		err = qtx.RemoveFormsCustomFieldQuery(ctx, d.Key)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func customFieldFromRow(row pgstore.CustomFieldDefinition) (*domains.CustomFieldDefinition, error) {
+	d := &domains.CustomFieldDefinition{
+		ID:        row.ID,
+		Entity:    row.Entity,
+		Key:       row.FieldKey,
+		Label:     row.Label,
+		Type:      row.FieldType,
+		Required:  row.Required,
+		Options:   row.Options,
+		CreatedAt: row.CreatedAt.UTC(),
+		UpdatedAt: row.UpdatedAt.UTC(),
+	}
+	if err := json.Unmarshal(row.Rules, &d.Rules); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// encodeCustomFields serializa os valores para a coluna JSONB. Mapas vazios
+// viram '{}', que também é o filtro neutro do operador @> nas listagens.
+func encodeCustomFields(fields domains.CustomFields) ([]byte, error) {
+	if fields == nil {
+		fields = domains.CustomFields{}
+	}
+	return json.Marshal(fields)
+}
+
+func decodeCustomFields(raw []byte) (domains.CustomFields, error) {
+	fields := domains.CustomFields{}
+	if len(raw) == 0 {
+		return fields, nil
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// tagsOrEmpty evita enviar NULL para colunas TEXT[] NOT NULL e para o filtro
+// de tags, onde NULL não casaria com nenhum registro.
+func tagsOrEmpty(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
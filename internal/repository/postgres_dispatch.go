@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresDispatchRepository struct {
+	db *pgstore.Queries
+}
+
+func NewPostgresDispatchRepository(db *pgxpool.Pool) DispatchRepository {
+	return &postgresDispatchRepository{db: pgstore.New(db)}
+}
+
+// ListDispatchCandidates devolve os técnicos ativos com a carga atual e os
+// atendimentos do cliente abertos desde historySince.
+func (p *postgresDispatchRepository) ListDispatchCandidates(clientID uuid.UUID, historySince time.Time, ctx context.Context) ([]domains.DispatchCandidate, error) {
+	rows, err := p.db.GetDispatchCandidatesQuery(ctx, pgstore.GetDispatchCandidatesQueryParams{
+		ClientID:     clientID,
+		HistorySince: historySince.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]domains.DispatchCandidate, 0, len(rows))
+	for _, row := range rows {
+		candidates = append(candidates, domains.DispatchCandidate{
+			MemberID:          row.ID,
+			Name:              row.Username,
+			Role:              string(row.Role),
+			HomeLocation:      nullGeoPoint(row.HomeLatitude, row.HomeLongitude),
+			CurrentLocation:   nullGeoPoint(row.CurrentLatitude, row.CurrentLongitude),
+			LocatedAt:         row.CurrentLocatedAt.Time,
+			OpenForms:         int(row.OpenForms),
+			RecentClientForms: int(row.RecentClientForms),
+			LastClientFormAt:  row.LastClientFormAt.Time,
+		})
+	}
+
+	return candidates, nil
+}
+
+// FindClientLocation devolve as coordenadas do cliente, ou nil quando ele não
+// as tem.
+func (p *postgresDispatchRepository) FindClientLocation(clientID uuid.UUID, ctx context.Context) (*domains.GeoPoint, error) {
+	row, err := p.db.GetClientLocationQuery(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrClientNotFound
+		}
+		return nil, err
+	}
+
+	return nullGeoPoint(row.Latitude, row.Longitude), nil
+}
+
+func (p *postgresDispatchRepository) FindMemberUserID(memberID uuid.UUID, ctx context.Context) (uuid.UUID, error) {
+	row, err := p.db.GetMemberByIdQuery(ctx, memberID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, domains.ErrMemberNotFound
+		}
+		return uuid.Nil, err
+	}
+
+	return row.UserID, nil
+}
+
+func (p *postgresDispatchRepository) UpdateMemberHomeLocation(memberID uuid.UUID, location domains.GeoPoint, ctx context.Context) error {
+	affected, err := p.db.UpdateMemberHomeLocationQuery(ctx, pgstore.UpdateMemberHomeLocationQueryParams{
+		HomeLatitude:  pgtype.Float8{Float64: location.Latitude, Valid: true},
+		HomeLongitude: pgtype.Float8{Float64: location.Longitude, Valid: true},
+		ID:            memberID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrMemberNotFound
+	}
+
+	return nil
+}
+
+func (p *postgresDispatchRepository) UpdateMemberCurrentLocation(memberID uuid.UUID, location domains.GeoPoint, at time.Time, ctx context.Context) error {
+	affected, err := p.db.UpdateMemberCurrentLocationQuery(ctx, pgstore.UpdateMemberCurrentLocationQueryParams{
+		CurrentLatitude:  pgtype.Float8{Float64: location.Latitude, Valid: true},
+		CurrentLongitude: pgtype.Float8{Float64: location.Longitude, Valid: true},
+		CurrentLocatedAt: pgtype.Timestamptz{Time: at.UTC(), Valid: true},
+		ID:               memberID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrMemberNotFound
+	}
+
+	return nil
+}
+
+func nullGeoPoint(latitude, longitude pgtype.Float8) *domains.GeoPoint {
+	if !latitude.Valid || !longitude.Valid {
+		return nil
+	}
+	return &domains.GeoPoint{Latitude: latitude.Float64, Longitude: longitude.Float64}
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresFormTypeRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresFormTypeRepository(db *pgxpool.Pool) FormTypeRepository {
+	return &postgresFormTypeRepository{db: pgstore.New(db), pool: db}
+}
+
+// SaveFormType grava o tipo e a primeira versão do esquema na mesma transação.
+func (p *postgresFormTypeRepository) SaveFormType(t *domains.FormType, v *domains.FormTypeVersion, ctx context.Context) (uuid.UUID, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to begin tx for SaveFormType: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	id, err := qtx.CreateFormTypeQuery(ctx, pgstore.CreateFormTypeQueryParams{
+		Name:        t.Name,
+		Description: t.Description,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return uuid.Nil, domains.ErrFormTypeNameTaken
+		}
+		return uuid.Nil, err
+	}
+
+	if err := qtx.CreateFormTypeVersionQuery(ctx, pgstore.CreateFormTypeVersionQueryParams{
+		FormTypeID: id,
+		Version:    1,
+		Schema:     v.Schema,
+		CreatedBy:  pgtype.UUID{Bytes: v.CreatedBy, Valid: v.CreatedBy != uuid.Nil},
+	}); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+
+func (p *postgresFormTypeRepository) FindFormTypeByID(id uuid.UUID, ctx context.Context) (*domains.FormType, error) {
+	row, err := p.db.GetFormTypeByIdQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrFormTypeNotFound
+		}
+		return nil, err
+	}
+
+	return formTypeFromRow(row), nil
+}
+
+func (p *postgresFormTypeRepository) ListFormTypes(ctx context.Context) ([]*domains.FormType, error) {
+	rows, err := p.db.GetFormTypesQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]*domains.FormType, 0, len(rows))
+	for _, row := range rows {
+		types = append(types, formTypeFromRow(row))
+	}
+
+	return types, nil
+}
+
+func (p *postgresFormTypeRepository) UpdateFormType(t *domains.FormType, ctx context.Context) error {
+	affected, err := p.db.UpdateFormTypeQuery(ctx, pgstore.UpdateFormTypeQueryParams{
+		Name:        t.Name,
+		Description: t.Description,
+		Active:      t.Active,
+		ID:          t.ID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domains.ErrFormTypeNameTaken
+		}
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrFormTypeNotFound
+	}
+
+	return nil
+}
+
+// SaveFormTypeVersion publica a próxima versão do tipo e a torna a versão
+// atual; devolve o número da versão.
+func (p *postgresFormTypeRepository) SaveFormTypeVersion(v *domains.FormTypeVersion, ctx context.Context) (int, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to begin tx for SaveFormTypeVersion: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	version, err := qtx.NextFormTypeVersionQuery(ctx, v.FormTypeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domains.ErrFormTypeNotFound
+		}
+		return 0, err
+	}
+
+	if err := qtx.CreateFormTypeVersionQuery(ctx, pgstore.CreateFormTypeVersionQueryParams{
+		FormTypeID: v.FormTypeID,
+		Version:    version,
+		Schema:     v.Schema,
+		CreatedBy:  pgtype.UUID{Bytes: v.CreatedBy, Valid: v.CreatedBy != uuid.Nil},
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	v.Version = int(version)
+
+	return v.Version, nil
+}
+
+func (p *postgresFormTypeRepository) FindFormTypeVersion(formTypeID uuid.UUID, version int, ctx context.Context) (*domains.FormTypeVersion, error) {
+	row, err := p.db.GetFormTypeVersionQuery(ctx, pgstore.GetFormTypeVersionQueryParams{
+		FormTypeID: formTypeID,
+		Version:    int32(version),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrFormTypeVersionNotFound
+		}
+		return nil, err
+	}
+
+	return formTypeVersionFromRow(row), nil
+}
+
+func (p *postgresFormTypeRepository) ListFormTypeVersions(formTypeID uuid.UUID, ctx context.Context) ([]*domains.FormTypeVersion, error) {
+	rows, err := p.db.GetFormTypeVersionsQuery(ctx, formTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*domains.FormTypeVersion, 0, len(rows))
+	for _, row := range rows {
+		versions = append(versions, formTypeVersionFromRow(row))
+	}
+
+	return versions, nil
+}
+
+func formTypeFromRow(row pgstore.FormType) *domains.FormType {
+	return &domains.FormType{
+		ID:             row.ID,
+		Name:           row.Name,
+		Description:    row.Description,
+		Active:         row.Active,
+		CurrentVersion: int(row.CurrentVersion),
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
+
+func formTypeVersionFromRow(row pgstore.FormTypeVersion) *domains.FormTypeVersion {
+	return &domains.FormTypeVersion{
+		FormTypeID: row.FormTypeID,
+		Version:    int(row.Version),
+		Schema:     json.RawMessage(row.Schema),
+		CreatedBy:  uuid.UUID(row.CreatedBy.Bytes),
+		CreatedAt:  row.CreatedAt,
+	}
+}
+
+func encodeFormData(data domains.FormData) ([]byte, error) {
+	if data == nil {
+		data = domains.FormData{}
+	}
+	return json.Marshal(data)
+}
+
+func decodeFormData(raw []byte) (domains.FormData, error) {
+	data := domains.FormData{}
+	if len(raw) == 0 {
+		return data, nil
+	}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -2,11 +2,14 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"olidesk-api-2/internal/domains"
 	"olidesk-api-2/internal/store/pgstore"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -20,7 +23,18 @@ func NewPostgresFormRepository(db *pgxpool.Pool) FormRepository {
 	return &postgresFormRepository{db: pgstore.New(db), pool: db}
 }
 
-func (p *postgresFormRepository) SaveForm(input *domains.Atendimentos, ctx context.Context) (uuid.UUID, error) {
+// SaveForm grava o atendimento, os técnicos e o histórico da atribuição
+// inicial na mesma transação.
+func (p *postgresFormRepository) SaveForm(input *domains.Atendimentos, assignments []*domains.FormAssignmentChange, ctx context.Context) (uuid.UUID, error) {
+	customFields, err := encodeCustomFields(input.CustomFields)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	formData, err := encodeFormData(input.FormData)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
 	tx, err := p.pool.Begin(ctx)
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for RegisterTeam: %w", err)
@@ -29,14 +43,35 @@ func (p *postgresFormRepository) SaveForm(input *domains.Atendimentos, ctx conte
 
 	qtx := p.db.WithTx(tx)
 
+	publicCode, err := nextFormCode(qtx, input.PublicCodeYear, ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
 	result, err := qtx.CreateFormQuery(ctx, pgstore.CreateFormQueryParams{
 		ClientID:            input.Cliente.ID,
 		SolicitedName:       input.SolicitedBy,
 		OccurredAt:          input.DataDeAbertura.UTC(),
 		DifficultyLevel:     pgstore.DifficultyLevel(input.DifficultyLevel),
 		DefectDescription:   pgtype.Text{String: input.DefectDescription, Valid: true},
-		SolutionDescription: pgtype.Text{String: input.SolutionDescription, Valid: true},
+		SolutionDescription: pgtype.Text{String: input.SolutionDescription, Valid: input.SolutionDescription != ""},
+		ContractID:          pgtype.UUID{Bytes: input.ContractID, Valid: input.ContractID != uuid.Nil},
+		HoursConsumed:       input.HoursConsumed,
+		CustomFields:        customFields,
+		Tags:                tagsOrEmpty(input.Tags),
+		Status:              input.Status,
+		ResponseDueAt:       nullTimestamptz(input.SLA.ResponseDueAt),
+		ResponseRiskAt:      nullTimestamptz(input.SLA.ResponseRiskAt),
+		ResolutionDueAt:     nullTimestamptz(input.SLA.ResolutionDueAt),
+		ResolutionRiskAt:    nullTimestamptz(input.SLA.ResolutionRiskAt),
+		PublicCode:          publicCode,
+		FormTypeID:          pgtype.UUID{Bytes: input.FormTypeID, Valid: input.FormTypeID != uuid.Nil},
+		FormTypeVersion:     pgtype.Int4{Int32: int32(input.FormTypeVersion), Valid: input.FormTypeID != uuid.Nil},
+		FormData:            formData,
 	})
+	if err != nil {
+		return uuid.Nil, err
+	}
 
 	tecnicos := make([]pgstore.CreateFormTecnicoQueryParams, len(input.TecnicoResponsavelId))
 	for i, item := range input.TecnicoResponsavelId {
@@ -50,15 +85,34 @@ func (p *postgresFormRepository) SaveForm(input *domains.Atendimentos, ctx conte
 		return uuid.Nil, err
 	}
 
+	for _, change := range assignments {
+		change.FormID = result
+	}
+	if err := createAssignmentChanges(qtx, assignments, ctx); err != nil {
+		return uuid.Nil, err
+	}
+
+	for _, item := range input.Checklist {
+		item.FormID = result
+	}
+	if err := createChecklistItems(qtx, input.Checklist, ctx); err != nil {
+		return uuid.Nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return uuid.Nil, err
 	}
+	input.PublicCode = publicCode
 
 	return result, nil
 }
+
 func (p *postgresFormRepository) FindFormByID(id uuid.UUID, ctx context.Context) (*domains.Atendimentos, error) {
 	formDetails, err := p.db.GetFormByIdQuery(ctx, id)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrFormNotFound
+		}
 		return nil, err
 	}
 
@@ -67,10 +121,19 @@ func (p *postgresFormRepository) FindFormByID(id uuid.UUID, ctx context.Context)
 		return nil, err
 	}
 
+	customFields, err := decodeCustomFields(formDetails.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	formData, err := decodeFormData(formDetails.FormData)
+	if err != nil {
+		return nil, err
+	}
+
 	tecnicosList := make([]domains.Member, 0, len(tecnicosRaw))
 	for _, i := range tecnicosRaw {
 		tecnicosList = append(tecnicosList, domains.Member{
-			ID:    i.ID,
+			ID:    i.MemberID,
 			Name:  i.UserName,
 			Email: i.UserEmail,
 		})
@@ -78,53 +141,138 @@ func (p *postgresFormRepository) FindFormByID(id uuid.UUID, ctx context.Context)
 
 	return &domains.Atendimentos{
 		ID:             formDetails.ID,
+		PublicCode:     formDetails.PublicCode,
 		DataDeAbertura: formDetails.OccurredAt.UTC(),
 		Cliente: domains.ClientForm{
 			ID:         formDetails.ClientID,
 			ClientName: formDetails.ClientName,
 		},
-		SolicitedBy:          formDetails.SolicitedName,
-		DifficultyLevel:      string(formDetails.DifficultyLevel),
-		DefectDescription:    formDetails.DefectDescription.String,
-		SolutionDescription:  formDetails.SolutionDescription.String,
+		SolicitedBy:         formDetails.SolicitedName,
+		DifficultyLevel:     string(formDetails.DifficultyLevel),
+		DefectDescription:   formDetails.DefectDescription.String,
+		SolutionDescription: formDetails.SolutionDescription.String,
+		Status:              formDetails.Status,
+		ContractID:          uuid.UUID(formDetails.ContractID.Bytes),
+		HoursConsumed:       formDetails.HoursConsumed,
+		CustomFields:        customFields,
+		Tags:                formDetails.Tags,
+		FormTypeID:          uuid.UUID(formDetails.FormTypeID.Bytes),
+		FormTypeVersion:     int(formDetails.FormTypeVersion.Int32),
+		FormData:            formData,
+		Signed:              formDetails.Signed,
+		SLA: domains.FormSLA{
+			ResponseDueAt:    timeOrZero(formDetails.ResponseDueAt),
+			ResponseRiskAt:   timeOrZero(formDetails.ResponseRiskAt),
+			ResolutionDueAt:  timeOrZero(formDetails.ResolutionDueAt),
+			ResolutionRiskAt: timeOrZero(formDetails.ResolutionRiskAt),
+			RespondedAt:      timeOrZero(formDetails.RespondedAt),
+			ResolvedAt:       timeOrZero(formDetails.ResolvedAt),
+		},
 		CreatedAt:            formDetails.CreatedAt.Time,
 		UpdatedAt:            formDetails.UpdatedAt.Time,
 		TecnicoResponsavelId: tecnicosList,
 	}, nil
 }
-func (p *postgresFormRepository) ListForms(ctx context.Context) ([]*domains.Atendimentos, error) {
-	formDetails, err := p.db.GetFormsQuery(ctx)
+
+// FindFormByCode busca o atendimento não excluído pelo protocolo completo.
+func (p *postgresFormRepository) FindFormByCode(code string, ctx context.Context) (*domains.Atendimentos, error) {
+	id, err := p.db.GetFormIdByPublicCodeQuery(ctx, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrFormNotFound
+		}
+		return nil, err
+	}
+
+	return p.FindFormByID(id, ctx)
+}
+
+// ListForms busca a página de atendimentos e, em uma segunda consulta, os
+// técnicos de todos eles; o número de consultas não depende do tamanho da
+// página.
+func (p *postgresFormRepository) ListForms(filter domains.FormListFilter, ctx context.Context) ([]*domains.Atendimentos, error) {
+	customFilter, err := encodeCustomFields(filter.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	formDetails, err := p.db.GetFormsQuery(ctx, pgstore.GetFormsQueryParams{
+		CustomFields: customFilter,
+		Tags:         tagsOrEmpty(filter.Tags),
+		ClientID:     pgtype.UUID{Bytes: filter.ClientID, Valid: filter.ClientID != uuid.Nil},
+		TecnicoID:    pgtype.UUID{Bytes: filter.TecnicoID, Valid: filter.TecnicoID != uuid.Nil},
+		DifficultyLevel: pgstore.NullDifficultyLevel{
+			DifficultyLevel: pgstore.DifficultyLevel(filter.DifficultyLevel),
+			Valid:           filter.DifficultyLevel != "",
+		},
+		Status:       pgtype.Text{String: filter.Status, Valid: filter.Status != ""},
+		OccurredFrom: pgtype.Timestamptz{Time: filter.OccurredFrom.UTC(), Valid: !filter.OccurredFrom.IsZero()},
+		OccurredTo:   pgtype.Timestamptz{Time: filter.OccurredTo.UTC(), Valid: !filter.OccurredTo.IsZero()},
+		PublicCode:   pgtype.Text{String: filter.PublicCode, Valid: filter.PublicCode != ""},
+		AfterID:      pgtype.UUID{Bytes: filter.After, Valid: filter.After != uuid.Nil},
+		PageSize:     pgtype.Int4{Int32: int32(filter.Limit), Valid: filter.Limit > 0},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(formDetails) == 0 {
+		return []*domains.Atendimentos{}, nil
+	}
+
+	formIDs := make([]uuid.UUID, 0, len(formDetails))
+	for _, i := range formDetails {
+		formIDs = append(formIDs, i.ID)
+	}
+
+	tecnicosByForm, err := p.listTecnicosByForm(formIDs, ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	forms := make([]*domains.Atendimentos, 0, len(formDetails))
 	for _, i := range formDetails {
-		tecnicosRaw, err := p.db.GetFormTecnicosByFormID(ctx, i.ID)
+		customFields, err := decodeCustomFields(i.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+		formData, err := decodeFormData(i.FormData)
 		if err != nil {
 			return nil, err
 		}
 
-		tecnicosList := make([]domains.Member, 0, len(tecnicosRaw))
-		for _, t := range tecnicosRaw {
-			tecnicosList = append(tecnicosList, domains.Member{
-				ID:    t.ID,
-				Name:  t.UserName,
-				Email: t.UserEmail,
-			})
+		tecnicosList := tecnicosByForm[i.ID]
+		if tecnicosList == nil {
+			tecnicosList = []domains.Member{}
 		}
 
 		forms = append(forms, &domains.Atendimentos{
 			ID:             i.ID,
+			PublicCode:     i.PublicCode,
 			DataDeAbertura: i.OccurredAt.UTC(),
 			Cliente: domains.ClientForm{
 				ID:         i.ClientID,
 				ClientName: i.ClientName,
 			},
-			SolicitedBy:          i.SolicitedName,
-			DifficultyLevel:      string(i.DifficultyLevel),
-			DefectDescription:    i.DefectDescription.String,
-			SolutionDescription:  i.SolutionDescription.String,
+			SolicitedBy:         i.SolicitedName,
+			DifficultyLevel:     string(i.DifficultyLevel),
+			DefectDescription:   i.DefectDescription.String,
+			SolutionDescription: i.SolutionDescription.String,
+			Status:              i.Status,
+			ContractID:          uuid.UUID(i.ContractID.Bytes),
+			HoursConsumed:       i.HoursConsumed,
+			CustomFields:        customFields,
+			Tags:                i.Tags,
+			FormTypeID:          uuid.UUID(i.FormTypeID.Bytes),
+			FormTypeVersion:     int(i.FormTypeVersion.Int32),
+			FormData:            formData,
+			SLA: domains.FormSLA{
+				ResponseDueAt:    timeOrZero(i.ResponseDueAt),
+				ResponseRiskAt:   timeOrZero(i.ResponseRiskAt),
+				ResolutionDueAt:  timeOrZero(i.ResolutionDueAt),
+				ResolutionRiskAt: timeOrZero(i.ResolutionRiskAt),
+				RespondedAt:      timeOrZero(i.RespondedAt),
+				ResolvedAt:       timeOrZero(i.ResolvedAt),
+			},
 			CreatedAt:            i.CreatedAt.Time,
 			UpdatedAt:            i.UpdatedAt.Time,
 			TecnicoResponsavelId: tecnicosList,
@@ -133,10 +281,140 @@ func (p *postgresFormRepository) ListForms(ctx context.Context) ([]*domains.Aten
 
 	return forms, nil
 }
-func (p *postgresFormRepository) UpdateForm(input *domains.Atendimentos, ctx context.Context) error {
+
+// SearchForms faz a busca textual e devolve a página pedida junto com o total
+// de atendimentos encontrados.
+func (p *postgresFormRepository) SearchForms(filter domains.FormSearchFilter, ctx context.Context) ([]*domains.FormSearchResult, int, error) {
+	customFilter, err := encodeCustomFields(filter.CustomFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := p.db.SearchFormsQuery(ctx, pgstore.SearchFormsQueryParams{
+		Query:        filter.Query,
+		CustomFields: customFilter,
+		Tags:         tagsOrEmpty(filter.Tags),
+		ClientID:     pgtype.UUID{Bytes: filter.ClientID, Valid: filter.ClientID != uuid.Nil},
+		TecnicoID:    pgtype.UUID{Bytes: filter.TecnicoID, Valid: filter.TecnicoID != uuid.Nil},
+		DifficultyLevel: pgstore.NullDifficultyLevel{
+			DifficultyLevel: pgstore.DifficultyLevel(filter.DifficultyLevel),
+			Valid:           filter.DifficultyLevel != "",
+		},
+		Status:       pgtype.Text{String: filter.Status, Valid: filter.Status != ""},
+		OccurredFrom: pgtype.Timestamptz{Time: filter.OccurredFrom.UTC(), Valid: !filter.OccurredFrom.IsZero()},
+		OccurredTo:   pgtype.Timestamptz{Time: filter.OccurredTo.UTC(), Valid: !filter.OccurredTo.IsZero()},
+		PublicCode:   pgtype.Text{String: filter.PublicCode, Valid: filter.PublicCode != ""},
+		PageSize:     int32(filter.Limit),
+		PageOffset:   int32(filter.Offset),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return []*domains.FormSearchResult{}, 0, nil
+	}
+
+	formIDs := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		formIDs = append(formIDs, row.ID)
+	}
+	tecnicosByForm, err := p.listTecnicosByForm(formIDs, ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]*domains.FormSearchResult, 0, len(rows))
+	for _, row := range rows {
+		customFields, err := decodeCustomFields(row.CustomFields)
+		if err != nil {
+			return nil, 0, err
+		}
+		formData, err := decodeFormData(row.FormData)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		tecnicosList := tecnicosByForm[row.ID]
+		if tecnicosList == nil {
+			tecnicosList = []domains.Member{}
+		}
+
+		results = append(results, &domains.FormSearchResult{
+			Form: &domains.Atendimentos{
+				ID:             row.ID,
+				PublicCode:     row.PublicCode,
+				DataDeAbertura: row.OccurredAt.UTC(),
+				Cliente: domains.ClientForm{
+					ID:         row.ClientID,
+					ClientName: row.ClientName,
+				},
+				SolicitedBy:         row.SolicitedName,
+				DifficultyLevel:     string(row.DifficultyLevel),
+				DefectDescription:   row.DefectDescription.String,
+				SolutionDescription: row.SolutionDescription.String,
+				Status:              row.Status,
+				ContractID:          uuid.UUID(row.ContractID.Bytes),
+				HoursConsumed:       row.HoursConsumed,
+				CustomFields:        customFields,
+				Tags:                row.Tags,
+				FormTypeID:          uuid.UUID(row.FormTypeID.Bytes),
+				FormTypeVersion:     int(row.FormTypeVersion.Int32),
+				FormData:            formData,
+				SLA: domains.FormSLA{
+					ResponseDueAt:    timeOrZero(row.ResponseDueAt),
+					ResponseRiskAt:   timeOrZero(row.ResponseRiskAt),
+					ResolutionDueAt:  timeOrZero(row.ResolutionDueAt),
+					ResolutionRiskAt: timeOrZero(row.ResolutionRiskAt),
+					RespondedAt:      timeOrZero(row.RespondedAt),
+					ResolvedAt:       timeOrZero(row.ResolvedAt),
+				},
+				CreatedAt:            row.CreatedAt.Time,
+				UpdatedAt:            row.UpdatedAt.Time,
+				TecnicoResponsavelId: tecnicosList,
+			},
+			Rank:            row.Rank,
+			DefectSnippet:   domains.HighlightSnippet(row.DefectSnippet),
+			SolutionSnippet: domains.HighlightSnippet(row.SolutionSnippet),
+		})
+	}
+
+	return results, int(rows[0].Total), nil
+}
+
+// listTecnicosByForm busca de uma vez os técnicos dos atendimentos.
+func (p *postgresFormRepository) listTecnicosByForm(formIDs []uuid.UUID, ctx context.Context) (map[uuid.UUID][]domains.Member, error) {
+	tecnicosRaw, err := p.db.GetFormTecnicosByFormIDs(ctx, formIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tecnicosByForm := make(map[uuid.UUID][]domains.Member, len(formIDs))
+	for _, t := range tecnicosRaw {
+		tecnicosByForm[t.FormID] = append(tecnicosByForm[t.FormID], domains.Member{
+			ID:    t.MemberID,
+			Name:  t.UserName,
+			Email: t.UserEmail,
+		})
+	}
+
+	return tecnicosByForm, nil
+}
+
+// UpdateForm grava o atendimento e aplica as mudanças de técnicos, removendo
+// e incluindo apenas os que mudaram e registrando cada uma no histórico.
+func (p *postgresFormRepository) UpdateForm(input *domains.Atendimentos, assignments []*domains.FormAssignmentChange, ctx context.Context) error {
+	customFields, err := encodeCustomFields(input.CustomFields)
+	if err != nil {
+		return err
+	}
+	formData, err := encodeFormData(input.FormData)
+	if err != nil {
+		return err
+	}
+
 	tx, err := p.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("pgstore: failed to begin tx for RegisterTeam: %w", err)
+		return fmt.Errorf("pgstore: failed to begin tx for UpdateForm: %w", err)
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
@@ -147,21 +425,49 @@ func (p *postgresFormRepository) UpdateForm(input *domains.Atendimentos, ctx con
 		SolicitedName:       input.SolicitedBy,
 		DifficultyLevel:     pgstore.DifficultyLevel(input.DifficultyLevel),
 		DefectDescription:   pgtype.Text{String: input.DefectDescription, Valid: true},
-		SolutionDescription: pgtype.Text{String: input.SolutionDescription, Valid: true},
+		SolutionDescription: pgtype.Text{String: input.SolutionDescription, Valid: input.SolutionDescription != ""},
+		HoursConsumed:       input.HoursConsumed,
+		CustomFields:        customFields,
+		Tags:                tagsOrEmpty(input.Tags),
 		ID:                  input.ID,
+		ResponseDueAt:       nullTimestamptz(input.SLA.ResponseDueAt),
+		ResponseRiskAt:      nullTimestamptz(input.SLA.ResponseRiskAt),
+		ResolutionDueAt:     nullTimestamptz(input.SLA.ResolutionDueAt),
+		ResolutionRiskAt:    nullTimestamptz(input.SLA.ResolutionRiskAt),
+		FormData:            formData,
+		ContractID:          pgtype.UUID{Bytes: input.ContractID, Valid: input.ContractID != uuid.Nil},
 	}); err != nil {
 		return err
 	}
 
-	tecnicos := make([]pgstore.CreateFormTecnicoQueryParams, len(input.TecnicoResponsavelId))
-	for i, item := range input.TecnicoResponsavelId {
-		tecnicos[i] = pgstore.CreateFormTecnicoQueryParams{
-			FormID:   input.ID,
-			MemberID: item.ID,
+	removed := make([]uuid.UUID, 0, len(assignments))
+	added := make([]pgstore.CreateFormTecnicoQueryParams, 0, len(assignments))
+	for _, change := range assignments {
+		switch change.Action {
+		case domains.AssignmentRemoved:
+			removed = append(removed, change.MemberID)
+		case domains.AssignmentAdded:
+			added = append(added, pgstore.CreateFormTecnicoQueryParams{
+				FormID:   input.ID,
+				MemberID: change.MemberID,
+			})
 		}
 	}
 
-	if _, err := qtx.CreateFormTecnicoQuery(ctx, tecnicos); err != nil {
+	if len(removed) > 0 {
+		if err := qtx.DeleteFormTecnicosQuery(ctx, pgstore.DeleteFormTecnicosQueryParams{
+			FormID:    input.ID,
+			MemberIds: removed,
+		}); err != nil {
+			return err
+		}
+	}
+	if len(added) > 0 {
+		if _, err := qtx.CreateFormTecnicoQuery(ctx, added); err != nil {
+			return err
+		}
+	}
+	if err := createAssignmentChanges(qtx, assignments, ctx); err != nil {
 		return err
 	}
 
@@ -178,3 +484,189 @@ func (p *postgresFormRepository) DeleteForm(id uuid.UUID, ctx context.Context) e
 
 	return nil
 }
+func (p *postgresFormRepository) ListDeletedForms(ctx context.Context) ([]*domains.Atendimentos, error) {
+	rows, err := p.db.GetDeletedFormsQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	forms := make([]*domains.Atendimentos, 0, len(rows))
+	for _, row := range rows {
+		forms = append(forms, &domains.Atendimentos{
+			ID:             row.ID,
+			PublicCode:     row.PublicCode,
+			DataDeAbertura: row.OccurredAt.UTC(),
+			Cliente: domains.ClientForm{
+				ID:         row.ClientID,
+				ClientName: row.ClientName,
+			},
+			SolicitedBy: row.SolicitedName,
+			DeletedAt:   row.DeletedAt.Time.UTC(),
+		})
+	}
+
+	return forms, nil
+}
+func (p *postgresFormRepository) RestoreForm(id uuid.UUID, ctx context.Context) error {
+	affected, err := p.db.RestoreFormQuery(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrFormNotFound
+	}
+
+	return nil
+}
+func (p *postgresFormRepository) PurgeForm(id uuid.UUID, ctx context.Context) error {
+	affected, err := p.db.PurgeFormQuery(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrFormNotFound
+	}
+
+	return nil
+}
+
+// TransitionFormStatus grava a nova situação e o registro no histórico na
+// mesma transação. A atualização só acontece se a situação no banco ainda for
+// a de origem; caso contrário outra mudança chegou antes e o resultado é
+// ErrFormStatusConflict. Ao resolver o atendimento a pesquisa de satisfação é
+// enfileirada na mesma transação.
+func (p *postgresFormRepository) TransitionFormStatus(form *domains.Atendimentos, change *domains.FormStatusChange, ctx context.Context) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for TransitionFormStatus: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	affected, err := qtx.UpdateFormStatusQuery(ctx, pgstore.UpdateFormStatusQueryParams{
+		Status:              change.ToStatus,
+		SolutionDescription: pgtype.Text{String: form.SolutionDescription, Valid: form.SolutionDescription != ""},
+		RespondedAt:         nullTimestamptz(form.SLA.RespondedAt),
+		ResolvedAt:          nullTimestamptz(form.SLA.ResolvedAt),
+		ID:                  form.ID,
+		FromStatus:          change.FromStatus,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrFormStatusConflict
+	}
+
+	id, err := qtx.CreateFormStatusChangeQuery(ctx, pgstore.CreateFormStatusChangeQueryParams{
+		FormID:     change.FormID,
+		FromStatus: change.FromStatus,
+		ToStatus:   change.ToStatus,
+		Reason:     pgtype.Text{String: change.Reason, Valid: change.Reason != ""},
+		ChangedBy:  pgtype.UUID{Bytes: change.ChangedBy, Valid: change.ChangedBy != uuid.Nil},
+		ChangedAt:  change.ChangedAt.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if change.ToStatus == domains.FormStatusResolved {
+		if err := qtx.CreateSatisfactionSurveyQuery(ctx, form.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	change.ID = id
+	return nil
+}
+func (p *postgresFormRepository) ListFormStatusHistory(formID uuid.UUID, ctx context.Context) ([]*domains.FormStatusChange, error) {
+	rows, err := p.db.GetFormStatusHistoryQuery(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*domains.FormStatusChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, &domains.FormStatusChange{
+			ID:            row.ID,
+			FormID:        row.FormID,
+			FromStatus:    row.FromStatus,
+			ToStatus:      row.ToStatus,
+			Reason:        row.Reason.String,
+			ChangedBy:     uuid.UUID(row.ChangedBy.Bytes),
+			ChangedByName: row.ChangedByName.String,
+			ChangedAt:     row.ChangedAt.UTC(),
+		})
+	}
+
+	return changes, nil
+}
+func (p *postgresFormRepository) ListFormAssignmentHistory(formID uuid.UUID, ctx context.Context) ([]*domains.FormAssignmentChange, error) {
+	rows, err := p.db.GetFormAssignmentHistoryQuery(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*domains.FormAssignmentChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, &domains.FormAssignmentChange{
+			ID:            row.ID,
+			FormID:        row.FormID,
+			MemberID:      uuid.UUID(row.MemberID.Bytes),
+			MemberName:    row.MemberName.String,
+			Action:        row.Action,
+			ChangedBy:     uuid.UUID(row.ChangedBy.Bytes),
+			ChangedByName: row.ChangedByName.String,
+			ChangedAt:     row.ChangedAt.UTC(),
+		})
+	}
+
+	return changes, nil
+}
+
+func createAssignmentChanges(qtx *pgstore.Queries, changes []*domains.FormAssignmentChange, ctx context.Context) error {
+	for _, change := range changes {
+		if err := qtx.CreateFormAssignmentChangeQuery(ctx, pgstore.CreateFormAssignmentChangeQueryParams{
+			FormID:    change.FormID,
+			MemberID:  pgtype.UUID{Bytes: change.MemberID, Valid: change.MemberID != uuid.Nil},
+			Action:    change.Action,
+			ChangedBy: pgtype.UUID{Bytes: change.ChangedBy, Valid: change.ChangedBy != uuid.Nil},
+			ChangedAt: change.ChangedAt.UTC(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextFormCode reserva o próximo protocolo do ano informado dentro de qtx. O
+// número só fica consumido se a transação for confirmada, por isso a sequência
+// do ano não tem buracos.
+func nextFormCode(qtx *pgstore.Queries, year int, ctx context.Context) (string, error) {
+	if year <= 0 {
+		return "", fmt.Errorf("pgstore: form code year not set")
+	}
+	number, err := qtx.NextFormCodeQuery(ctx, int32(year))
+	if err != nil {
+		return "", err
+	}
+	return domains.FormCode(year, number), nil
+}
+
+// nullTimestamptz grava o instante zero como NULL.
+func nullTimestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t.UTC(), Valid: !t.IsZero()}
+}
+
+// timeOrZero lê NULL como o instante zero.
+func timeOrZero(t pgtype.Timestamptz) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time.UTC()
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+// countingDB é um pgstore.DBTX em memória que responde às consultas da
+// listagem de atendimentos e conta quantas foram executadas.
+type countingDB struct {
+	forms   [][]any
+	queries int
+}
+
+func newCountingDB(n int) *countingDB {
+	db := &countingDB{forms: make([][]any, 0, n)}
+	now := time.Now().UTC()
+	for i := 0; i < n; i++ {
+		db.forms = append(db.forms, []any{
+			uuid.Must(uuid.NewV7()),
+			domains.FormCode(now.Year(), int64(i+1)),
+			uuid.New(),
+			"Cliente",
+			now,
+			"Solicitante",
+			pgstore.DifficultyLevelMedium,
+			pgtype.Text{String: "Impressora não liga", Valid: true},
+			pgtype.Text{},
+			pgtype.UUID{},
+			decimal.Zero,
+			[]byte(`{}`),
+			[]string{},
+			pgtype.UUID{},
+			pgtype.Int4{},
+			[]byte(`{}`),
+			domains.FormStatusOpen,
+			pgtype.Timestamptz{}, pgtype.Timestamptz{}, pgtype.Timestamptz{},
+			pgtype.Timestamptz{}, pgtype.Timestamptz{}, pgtype.Timestamptz{},
+			pgtype.Timestamptz{Time: now, Valid: true},
+			pgtype.Timestamptz{Time: now, Valid: true},
+		})
+	}
+	return db
+}
+
+func (db *countingDB) Query(_ context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	db.queries++
+	switch {
+	case strings.Contains(sql, "name: GetFormsQuery "):
+		return &fakeRows{rows: db.forms}, nil
+	case strings.Contains(sql, "name: GetFormTecnicosByFormIDs "):
+		return &fakeRows{rows: tecnicoRows(args[0].([]uuid.UUID)...)}, nil
+	case strings.Contains(sql, "name: GetFormTecnicosByFormID "):
+		return &fakeRows{rows: tecnicoRows(args[0].(uuid.UUID))}, nil
+	}
+	return nil, fmt.Errorf("unexpected query: %s", sql)
+}
+
+func (db *countingDB) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	db.queries++
+	return pgconn.CommandTag{}, nil
+}
+
+func (db *countingDB) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	db.queries++
+	return &fakeRows{}
+}
+
+func (db *countingDB) CopyFrom(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) (int64, error) {
+	db.queries++
+	return 0, nil
+}
+
+// tecnicoRows devolve dois técnicos por atendimento.
+func tecnicoRows(formIDs ...uuid.UUID) [][]any {
+	now := pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}
+	rows := make([][]any, 0, 2*len(formIDs))
+	for _, formID := range formIDs {
+		for _, name := range []string{"Ana", "Bruno"} {
+			rows = append(rows, []any{uuid.New(), uuid.New(), formID, now, now, name, strings.ToLower(name) + "@sperium.net"})
+		}
+	}
+	return rows
+}
+
+type fakeRows struct {
+	rows [][]any
+	pos  int
+}
+
+func (r *fakeRows) Close()                                       {}
+func (r *fakeRows) Err() error                                   { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *fakeRows) RawValues() [][]byte                          { return nil }
+func (r *fakeRows) Conn() *pgx.Conn                              { return nil }
+
+func (r *fakeRows) Next() bool {
+	if r.pos >= len(r.rows) {
+		return false
+	}
+	r.pos++
+	return true
+}
+
+func (r *fakeRows) Values() ([]any, error) {
+	return r.rows[r.pos-1], nil
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	if r.pos == 0 {
+		if len(r.rows) == 0 {
+			return pgx.ErrNoRows
+		}
+		r.pos = 1
+	}
+	row := r.rows[r.pos-1]
+	if len(dest) != len(row) {
+		return fmt.Errorf("scan: %d destinations for %d columns", len(dest), len(row))
+	}
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(row[i]))
+	}
+	return nil
+}
+
+// BenchmarkListForms mostra que a listagem executa sempre duas consultas
+// (atendimentos e técnicos), qualquer que seja o número de atendimentos.
+func BenchmarkListForms(b *testing.B) {
+	for _, n := range []int{10, 200, 2000} {
+		b.Run(fmt.Sprintf("forms=%d", n), func(b *testing.B) {
+			db := newCountingDB(n)
+			repo := &postgresFormRepository{db: pgstore.New(db)}
+			ctx := context.Background()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				db.queries = 0
+				forms, err := repo.ListForms(domains.FormListFilter{}, ctx)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(forms) != n || len(forms[n-1].TecnicoResponsavelId) != 2 {
+					b.Fatalf("got %d forms, want %d with 2 technicians each", len(forms), n)
+				}
+				if db.queries != 2 {
+					b.Fatalf("ListForms ran %d queries for %d forms, want 2", db.queries, n)
+				}
+			}
+			b.ReportMetric(float64(db.queries), "queries/op")
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresInvoiceRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresInvoiceRepository(db *pgxpool.Pool) InvoiceRepository {
+	return &postgresInvoiceRepository{db: pgstore.New(db), pool: db}
+}
+
+// GetBillingSettings devolve os valores zerados enquanto a organização não
+// salvou os seus.
+func (p *postgresInvoiceRepository) GetBillingSettings(ctx context.Context) (*domains.BillingSettings, error) {
+	row, err := p.db.GetBillingSettingsQuery(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domains.DefaultBillingSettings(), nil
+		}
+		return nil, err
+	}
+
+	return &domains.BillingSettings{
+		HourRateLow:    row.HourRateLow,
+		HourRateMedium: row.HourRateMedium,
+		HourRateHigh:   row.HourRateHigh,
+		TravelFee:      row.TravelFee,
+		TaxRate:        row.TaxRate,
+		UpdatedBy:      uuid.UUID(row.UpdatedBy.Bytes),
+		UpdatedAt:      row.UpdatedAt.UTC(),
+	}, nil
+}
+
+func (p *postgresInvoiceRepository) SaveBillingSettings(s *domains.BillingSettings, ctx context.Context) error {
+	updatedAt, err := p.db.UpsertBillingSettingsQuery(ctx, pgstore.UpsertBillingSettingsQueryParams{
+		HourRateLow:    s.HourRateLow,
+		HourRateMedium: s.HourRateMedium,
+		HourRateHigh:   s.HourRateHigh,
+		TravelFee:      s.TravelFee,
+		TaxRate:        s.TaxRate,
+		UpdatedBy:      pgtype.UUID{Bytes: s.UpdatedBy, Valid: s.UpdatedBy != uuid.Nil},
+	})
+	if err != nil {
+		return err
+	}
+
+	s.UpdatedAt = updatedAt.UTC()
+	return nil
+}
+
+// SaveInvoice grava a fatura, os itens e o vínculo dos atendimentos na mesma
+// transação. Um atendimento já cobrado em outra fatura não cancelada viola a
+// chave de invoice_forms e devolve ErrFormAlreadyInvoiced.
+func (p *postgresInvoiceRepository) SaveInvoice(inv *domains.Invoice, ctx context.Context) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for invoice: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := p.db.WithTx(tx)
+
+	row, err := qtx.CreateInvoiceQuery(ctx, pgstore.CreateInvoiceQueryParams{
+		ClientID:  inv.ClientID,
+		Status:    inv.Status,
+		Subtotal:  inv.Subtotal,
+		Discount:  inv.Discount,
+		TaxRate:   inv.TaxRate,
+		TaxAmount: inv.TaxAmount,
+		Total:     inv.Total,
+		Notes:     inv.Notes,
+		DueDate:   pgtype.Date{Time: inv.DueDate, Valid: !inv.DueDate.IsZero()},
+		CreatedBy: pgtype.UUID{Bytes: inv.CreatedBy, Valid: inv.CreatedBy != uuid.Nil},
+	})
+	if err != nil {
+		return invoiceError(err)
+	}
+
+	for i, item := range inv.Items {
+		id, err := qtx.CreateInvoiceItemQuery(ctx, pgstore.CreateInvoiceItemQueryParams{
+			InvoiceID:   row.ID,
+			FormID:      pgtype.UUID{Bytes: item.FormID, Valid: item.FormID != uuid.Nil},
+			Position:    int32(i),
+			Kind:        item.Kind,
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Total:       item.Total,
+		})
+		if err != nil {
+			return invoiceError(err)
+		}
+		item.ID = id
+	}
+
+	for _, formID := range inv.FormIDs {
+		if err := qtx.CreateInvoiceFormQuery(ctx, pgstore.CreateInvoiceFormQueryParams{
+			FormID:    formID,
+			InvoiceID: row.ID,
+		}); err != nil {
+			return invoiceError(err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	inv.ID = row.ID
+	inv.CreatedAt = row.CreatedAt.UTC()
+	inv.UpdatedAt = row.UpdatedAt.UTC()
+	return nil
+}
+
+// FindInvoiceByID devolve a fatura com os itens. Os atendimentos da fatura
+// são os dos itens, o que vale também para faturas canceladas.
+func (p *postgresInvoiceRepository) FindInvoiceByID(id uuid.UUID, ctx context.Context) (*domains.Invoice, error) {
+	row, err := p.db.GetInvoiceByIdQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrInvoiceNotFound
+		}
+		return nil, err
+	}
+
+	items, err := p.db.ListInvoiceItemsQuery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	inv := invoiceFromRow(pgstore.ListInvoicesQueryRow(row))
+	inv.Items = make([]*domains.InvoiceItem, 0, len(items))
+	seen := make(map[uuid.UUID]bool)
+	for _, item := range items {
+		formID := uuid.UUID(item.FormID.Bytes)
+		inv.Items = append(inv.Items, &domains.InvoiceItem{
+			ID:          item.ID,
+			FormID:      formID,
+			Kind:        item.Kind,
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Total:       item.Total,
+		})
+		if item.FormID.Valid && !seen[formID] {
+			seen[formID] = true
+			inv.FormIDs = append(inv.FormIDs, formID)
+		}
+	}
+	return inv, nil
+}
+
+// ListInvoices devolve as faturas sem os itens, das mais recentes primeiro.
+func (p *postgresInvoiceRepository) ListInvoices(filter domains.InvoiceFilter, ctx context.Context) ([]*domains.Invoice, error) {
+	rows, err := p.db.ListInvoicesQuery(ctx, pgstore.ListInvoicesQueryParams{
+		Status:   pgtype.Text{String: filter.Status, Valid: filter.Status != ""},
+		ClientID: pgtype.UUID{Bytes: filter.ClientID, Valid: filter.ClientID != uuid.Nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invoices := make([]*domains.Invoice, 0, len(rows))
+	for _, row := range rows {
+		invoices = append(invoices, invoiceFromRow(row))
+	}
+	return invoices, nil
+}
+
+// UpdateInvoiceDraft grava desconto, impostos, observações e vencimento de
+// um rascunho; faturas em outra situação devolvem ErrInvoiceNotDraft.
+func (p *postgresInvoiceRepository) UpdateInvoiceDraft(inv *domains.Invoice, ctx context.Context) error {
+	affected, err := p.db.UpdateInvoiceDraftQuery(ctx, pgstore.UpdateInvoiceDraftQueryParams{
+		ID:        inv.ID,
+		Discount:  inv.Discount,
+		TaxRate:   inv.TaxRate,
+		TaxAmount: inv.TaxAmount,
+		Total:     inv.Total,
+		Notes:     inv.Notes,
+		DueDate:   pgtype.Date{Time: inv.DueDate, Valid: !inv.DueDate.IsZero()},
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrInvoiceNotDraft
+	}
+	return nil
+}
+
+// UpdateInvoiceStatus grava a nova situação se a fatura ainda estiver em
+// from. Na emissão o número é tirado da sequência na mesma transação, então
+// uma emissão desfeita não deixa lacuna; no cancelamento os atendimentos são
+// liberados para outra fatura.
+func (p *postgresInvoiceRepository) UpdateInvoiceStatus(inv *domains.Invoice, from string, ctx context.Context) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for invoice status: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := p.db.WithTx(tx)
+
+	number := inv.Number
+	if inv.Status == domains.InvoiceStatusIssued && number == 0 {
+		if number, err = qtx.NextInvoiceNumberQuery(ctx); err != nil {
+			return err
+		}
+	}
+
+	affected, err := qtx.UpdateInvoiceStatusQuery(ctx, pgstore.UpdateInvoiceStatusQueryParams{
+		Status:       inv.Status,
+		Number:       pgtype.Int8{Int64: number, Valid: number != 0},
+		IssuedAt:     nullTimestamptz(inv.IssuedAt),
+		PaidAt:       nullTimestamptz(inv.PaidAt),
+		CanceledAt:   nullTimestamptz(inv.CanceledAt),
+		CancelReason: inv.CancelReason,
+		ID:           inv.ID,
+		FromStatus:   from,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrInvoiceStatusConflict
+	}
+
+	if inv.Status == domains.InvoiceStatusCanceled {
+		if err := qtx.DeleteInvoiceFormsQuery(ctx, inv.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	inv.Number = number
+	return nil
+}
+
+func invoiceError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case "23505":
+		if pgErr.ConstraintName == "invoice_forms_pkey" {
+			return domains.ErrFormAlreadyInvoiced
+		}
+	case "23503":
+		switch pgErr.ConstraintName {
+		case "invoices_client_id_fkey":
+			return domains.ErrClientNotFound
+		case "invoice_items_form_id_fkey", "invoice_forms_form_id_fkey":
+			return domains.ErrFormNotFound
+		}
+	}
+	return err
+}
+
+func invoiceFromRow(row pgstore.ListInvoicesQueryRow) *domains.Invoice {
+	inv := &domains.Invoice{
+		ID:           row.ID,
+		Number:       row.Number.Int64,
+		ClientID:     row.ClientID,
+		ClientName:   row.ClientName,
+		Status:       row.Status,
+		Subtotal:     row.Subtotal,
+		Discount:     row.Discount,
+		TaxRate:      row.TaxRate,
+		TaxAmount:    row.TaxAmount,
+		Total:        row.Total,
+		Notes:        row.Notes,
+		CancelReason: row.CancelReason,
+		CreatedBy:    uuid.UUID(row.CreatedBy.Bytes),
+		CreatedAt:    row.CreatedAt.UTC(),
+		UpdatedAt:    row.UpdatedAt.UTC(),
+	}
+	if row.DueDate.Valid {
+		inv.DueDate = row.DueDate.Time
+	}
+	if row.IssuedAt.Valid {
+		inv.IssuedAt = row.IssuedAt.Time.UTC()
+	}
+	if row.PaidAt.Valid {
+		inv.PaidAt = row.PaidAt.Time.UTC()
+	}
+	if row.CanceledAt.Valid {
+		inv.CanceledAt = row.CanceledAt.Time.UTC()
+	}
+	return inv
+}
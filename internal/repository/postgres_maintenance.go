@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresMaintenanceRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresMaintenanceRepository(db *pgxpool.Pool) MaintenanceRepository {
+	return &postgresMaintenanceRepository{db: pgstore.New(db), pool: db}
+}
+
+// SaveMaintenancePlan grava o plano e os técnicos padrão na mesma transação.
+func (p *postgresMaintenanceRepository) SaveMaintenancePlan(plan *domains.MaintenancePlan, ctx context.Context) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for SaveMaintenancePlan: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	row, err := qtx.CreateMaintenancePlanQuery(ctx, pgstore.CreateMaintenancePlanQueryParams{
+		ClientID:        plan.ClientID,
+		Name:            plan.Name,
+		Site:            plan.Site,
+		Description:     plan.Description,
+		Rrule:           plan.Recurrence,
+		StartsAt:        plan.StartsAt.UTC(),
+		DifficultyLevel: pgstore.DifficultyLevel(plan.DifficultyLevel),
+		Checklist:       tagsOrEmpty(plan.Checklist),
+		Status:          plan.Status,
+		CreatedBy:       pgtype.UUID{Bytes: plan.CreatedBy, Valid: plan.CreatedBy != uuid.Nil},
+	})
+	if err != nil {
+		return maintenancePlanError(err)
+	}
+	if err := createMaintenancePlanTecnicos(qtx, row.ID, plan.TechnicianIDs, ctx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	plan.ID = row.ID
+	plan.CreatedAt = row.CreatedAt.UTC()
+	plan.UpdatedAt = row.UpdatedAt.UTC()
+	return nil
+}
+func (p *postgresMaintenanceRepository) FindMaintenancePlanByID(id uuid.UUID, ctx context.Context) (*domains.MaintenancePlan, error) {
+	row, err := p.db.GetMaintenancePlanQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrMaintenancePlanNotFound
+		}
+		return nil, err
+	}
+
+	plans := []*domains.MaintenancePlan{maintenancePlanFromRow(pgstore.ListMaintenancePlansQueryRow(row))}
+	if err := p.loadTechnicians(plans, ctx); err != nil {
+		return nil, err
+	}
+	return plans[0], nil
+}
+
+// ListMaintenancePlans filtra pelo cliente e pela situação quando informados.
+func (p *postgresMaintenanceRepository) ListMaintenancePlans(clientID uuid.UUID, status string, ctx context.Context) ([]*domains.MaintenancePlan, error) {
+	rows, err := p.db.ListMaintenancePlansQuery(ctx, pgstore.ListMaintenancePlansQueryParams{
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: clientID != uuid.Nil},
+		Status:   pgtype.Text{String: status, Valid: status != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]*domains.MaintenancePlan, 0, len(rows))
+	for _, row := range rows {
+		plans = append(plans, maintenancePlanFromRow(row))
+	}
+	if err := p.loadTechnicians(plans, ctx); err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// ListDueMaintenancePlans devolve os planos ativos cuja última janela gerada
+// termina antes de until.
+func (p *postgresMaintenanceRepository) ListDueMaintenancePlans(until time.Time, ctx context.Context) ([]*domains.MaintenancePlan, error) {
+	rows, err := p.db.ListDueMaintenancePlansQuery(ctx, until.UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]*domains.MaintenancePlan, 0, len(rows))
+	for _, row := range rows {
+		plans = append(plans, maintenancePlanFromRow(pgstore.ListMaintenancePlansQueryRow(row)))
+	}
+	if err := p.loadTechnicians(plans, ctx); err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// UpdateMaintenancePlan grava o plano e substitui os técnicos padrão.
+func (p *postgresMaintenanceRepository) UpdateMaintenancePlan(plan *domains.MaintenancePlan, ctx context.Context) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for UpdateMaintenancePlan: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	rows, err := qtx.UpdateMaintenancePlanQuery(ctx, pgstore.UpdateMaintenancePlanQueryParams{
+		ID:              plan.ID,
+		Name:            plan.Name,
+		Site:            plan.Site,
+		Description:     plan.Description,
+		Rrule:           plan.Recurrence,
+		StartsAt:        plan.StartsAt.UTC(),
+		DifficultyLevel: pgstore.DifficultyLevel(plan.DifficultyLevel),
+		Checklist:       tagsOrEmpty(plan.Checklist),
+		Status:          plan.Status,
+		GeneratedUntil:  nullTimestamptz(plan.GeneratedUntil),
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domains.ErrMaintenancePlanNotFound
+	}
+	if err := qtx.DeleteMaintenancePlanTecnicosQuery(ctx, plan.ID); err != nil {
+		return err
+	}
+	if err := createMaintenancePlanTecnicos(qtx, plan.ID, plan.TechnicianIDs, ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// DeleteMaintenancePlan remove o plano; os atendimentos já gerados continuam,
+// sem o vínculo com o plano.
+func (p *postgresMaintenanceRepository) DeleteMaintenancePlan(id uuid.UUID, ctx context.Context) error {
+	rows, err := p.db.DeleteMaintenancePlanQuery(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domains.ErrMaintenancePlanNotFound
+	}
+	return nil
+}
+
+// SaveMaintenanceForm grava o atendimento da visita do plano em
+// form.DataDeAbertura com os técnicos, o histórico da atribuição e a lista de
+// verificação. A visita que já tem atendimento não é gravada de novo e devolve
+// false; o índice único de (plano, visita) garante isso também entre
+// instâncias concorrentes.
+func (p *postgresMaintenanceRepository) SaveMaintenanceForm(plan *domains.MaintenancePlan, form *domains.Atendimentos, assignments []*domains.FormAssignmentChange, ctx context.Context) (uuid.UUID, bool, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("pgstore: failed to begin tx for SaveMaintenanceForm: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	publicCode, err := nextFormCode(qtx, form.PublicCodeYear, ctx)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+
+	id, err := qtx.CreateMaintenanceFormQuery(ctx, pgstore.CreateMaintenanceFormQueryParams{
+		ClientID:          form.Cliente.ID,
+		SolicitedName:     form.SolicitedBy,
+		DifficultyLevel:   pgstore.DifficultyLevel(form.DifficultyLevel),
+		DefectDescription: pgtype.Text{String: form.DefectDescription, Valid: true},
+		OccurredAt:        form.DataDeAbertura.UTC(),
+		ContractID:        pgtype.UUID{Bytes: form.ContractID, Valid: form.ContractID != uuid.Nil},
+		Tags:              tagsOrEmpty(form.Tags),
+		Status:            form.Status,
+		MaintenancePlanID: pgtype.UUID{Bytes: plan.ID, Valid: true},
+		ScheduledFor:      nullTimestamptz(form.DataDeAbertura),
+		PublicCode:        publicCode,
+		ResponseDueAt:     nullTimestamptz(form.SLA.ResponseDueAt),
+		ResponseRiskAt:    nullTimestamptz(form.SLA.ResponseRiskAt),
+		ResolutionDueAt:   nullTimestamptz(form.SLA.ResolutionDueAt),
+		ResolutionRiskAt:  nullTimestamptz(form.SLA.ResolutionRiskAt),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, false, nil
+		}
+		return uuid.Nil, false, err
+	}
+
+	tecnicos := make([]pgstore.CreateFormTecnicoQueryParams, len(form.TecnicoResponsavelId))
+	for i, item := range form.TecnicoResponsavelId {
+		tecnicos[i] = pgstore.CreateFormTecnicoQueryParams{
+			FormID:   id,
+			MemberID: item.ID,
+		}
+	}
+	if _, err := qtx.CreateFormTecnicoQuery(ctx, tecnicos); err != nil {
+		return uuid.Nil, false, err
+	}
+
+	for _, change := range assignments {
+		change.FormID = id
+	}
+	if err := createAssignmentChanges(qtx, assignments, ctx); err != nil {
+		return uuid.Nil, false, err
+	}
+
+	if err := createChecklistItems(qtx, domains.NewChecklist(id, plan.Checklist), ctx); err != nil {
+		return uuid.Nil, false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, false, err
+	}
+	form.PublicCode = publicCode
+
+	return id, true, nil
+}
+
+// AdvanceMaintenancePlan registra until como o fim da janela gerada. A janela
+// nunca recua e planos pausados não avançam.
+func (p *postgresMaintenanceRepository) AdvanceMaintenancePlan(id uuid.UUID, until time.Time, ctx context.Context) error {
+	_, err := p.db.AdvanceMaintenancePlanQuery(ctx, pgstore.AdvanceMaintenancePlanQueryParams{
+		GeneratedUntil: until.UTC(),
+		ID:             id,
+	})
+	return err
+}
+
+// loadTechnicians busca os técnicos de todos os planos em uma consulta.
+func (p *postgresMaintenanceRepository) loadTechnicians(plans []*domains.MaintenancePlan, ctx context.Context) error {
+	if len(plans) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(plans))
+	byID := make(map[uuid.UUID]*domains.MaintenancePlan, len(plans))
+	for _, plan := range plans {
+		ids = append(ids, plan.ID)
+		byID[plan.ID] = plan
+		plan.TechnicianIDs = []uuid.UUID{}
+	}
+
+	rows, err := p.db.GetMaintenancePlanTecnicosQuery(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		plan := byID[row.PlanID]
+		plan.TechnicianIDs = append(plan.TechnicianIDs, row.MemberID)
+	}
+	return nil
+}
+
+func createMaintenancePlanTecnicos(qtx *pgstore.Queries, planID uuid.UUID, memberIDs []uuid.UUID, ctx context.Context) error {
+	for _, memberID := range memberIDs {
+		if err := qtx.CreateMaintenancePlanTecnicoQuery(ctx, pgstore.CreateMaintenancePlanTecnicoQueryParams{
+			PlanID:   planID,
+			MemberID: memberID,
+		}); err != nil {
+			return maintenancePlanError(err)
+		}
+	}
+	return nil
+}
+
+// maintenancePlanError traduz as chaves estrangeiras do plano: o cliente ou
+// um dos técnicos não existe.
+func maintenancePlanError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		if pgErr.ConstraintName == "maintenance_plan_tecnicos_member_id_fkey" {
+			return domains.ErrMemberNotFound
+		}
+		return domains.ErrClientNotFound
+	}
+	return err
+}
+
+func maintenancePlanFromRow(row pgstore.ListMaintenancePlansQueryRow) *domains.MaintenancePlan {
+	return &domains.MaintenancePlan{
+		ID:              row.ID,
+		ClientID:        row.ClientID,
+		ClientName:      row.ClientName,
+		Name:            row.Name,
+		Site:            row.Site,
+		Description:     row.Description,
+		Recurrence:      row.Rrule,
+		StartsAt:        row.StartsAt.UTC(),
+		DifficultyLevel: string(row.DifficultyLevel),
+		Checklist:       row.Checklist,
+		Status:          row.Status,
+		GeneratedUntil:  timeOrZero(row.GeneratedUntil),
+		CreatedBy:       uuid.UUID(row.CreatedBy.Bytes),
+		CreatedAt:       row.CreatedAt.UTC(),
+		UpdatedAt:       row.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresNotificationRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresNotificationRepository(db *pgxpool.Pool) NotificationRepository {
+	return &postgresNotificationRepository{db: pgstore.New(db), pool: db}
+}
+
+// ListNotifications devolve as notificações mais recentes do usuário,
+// opcionalmente só as não lidas.
+func (p *postgresNotificationRepository) ListNotifications(userID uuid.UUID, unreadOnly bool, limit int, ctx context.Context) ([]*domains.Notification, error) {
+	rows, err := p.db.ListNotificationsQuery(ctx, pgstore.ListNotificationsQueryParams{
+		UserID:     userID,
+		UnreadOnly: unreadOnly,
+		PageSize:   int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*domains.Notification, 0, len(rows))
+	for _, row := range rows {
+		notifications = append(notifications, &domains.Notification{
+			ID:         row.ID,
+			UserID:     row.UserID,
+			FormID:     uuid.UUID(row.FormID.Bytes),
+			PublicCode: row.PublicCode,
+			Kind:       row.Kind,
+			Message:    row.Message,
+			CreatedAt:  row.CreatedAt.UTC(),
+			ReadAt:     timeOrZero(row.ReadAt),
+		})
+	}
+
+	return notifications, nil
+}
+func (p *postgresNotificationRepository) MarkNotificationRead(id, userID uuid.UUID, ctx context.Context) error {
+	affected, err := p.db.MarkNotificationReadQuery(ctx, pgstore.MarkNotificationReadQueryParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrNotificationNotFound
+	}
+
+	return nil
+}
+func (p *postgresNotificationRepository) MarkAllNotificationsRead(userID uuid.UUID, ctx context.Context) (int64, error) {
+	return p.db.MarkAllNotificationsReadQuery(ctx, userID)
+}
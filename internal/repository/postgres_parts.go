@@ -0,0 +1,408 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresPartRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresPartRepository(db *pgxpool.Pool) PartRepository {
+	return &postgresPartRepository{db: pgstore.New(db), pool: db}
+}
+func (p *postgresPartRepository) SavePart(part *domains.Part, ctx context.Context) error {
+	row, err := p.db.CreatePartQuery(ctx, pgstore.CreatePartQueryParams{
+		Sku:      part.SKU,
+		Name:     part.Name,
+		Unit:     part.Unit,
+		Cost:     part.Cost,
+		Price:    part.Price,
+		MinStock: part.MinStock,
+		Active:   part.Active,
+	})
+	if err != nil {
+		return stockError(err)
+	}
+
+	part.ID = row.ID
+	part.CreatedAt = row.CreatedAt.UTC()
+	part.UpdatedAt = row.UpdatedAt.UTC()
+	return nil
+}
+func (p *postgresPartRepository) FindPartByID(id uuid.UUID, ctx context.Context) (*domains.Part, error) {
+	row, err := p.db.GetPartByIdQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrPartNotFound
+		}
+		return nil, err
+	}
+	return partFromRow(row), nil
+}
+
+// ListParts busca pelo SKU ou pelo nome quando search não é vazio; as peças
+// inativas só aparecem com includeInactive.
+func (p *postgresPartRepository) ListParts(search string, includeInactive bool, ctx context.Context) ([]*domains.Part, error) {
+	rows, err := p.db.ListPartsQuery(ctx, pgstore.ListPartsQueryParams{
+		IncludeInactive: includeInactive,
+		Search:          pgtype.Text{String: search, Valid: search != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]*domains.Part, 0, len(rows))
+	for _, row := range rows {
+		parts = append(parts, partFromRow(row))
+	}
+	return parts, nil
+}
+func (p *postgresPartRepository) UpdatePart(part *domains.Part, ctx context.Context) error {
+	affected, err := p.db.UpdatePartQuery(ctx, pgstore.UpdatePartQueryParams{
+		ID:       part.ID,
+		Sku:      part.SKU,
+		Name:     part.Name,
+		Unit:     part.Unit,
+		Cost:     part.Cost,
+		Price:    part.Price,
+		MinStock: part.MinStock,
+		Active:   part.Active,
+	})
+	if err != nil {
+		return stockError(err)
+	}
+	if affected == 0 {
+		return domains.ErrPartNotFound
+	}
+	return nil
+}
+func (p *postgresPartRepository) SaveStockLocation(l *domains.StockLocation, ctx context.Context) error {
+	row, err := p.db.CreateStockLocationQuery(ctx, pgstore.CreateStockLocationQueryParams{
+		Kind:     l.Kind,
+		Name:     l.Name,
+		MemberID: pgtype.UUID{Bytes: l.MemberID, Valid: l.MemberID != uuid.Nil},
+	})
+	if err != nil {
+		return stockError(err)
+	}
+
+	l.ID = row.ID
+	l.CreatedAt = row.CreatedAt.UTC()
+	return nil
+}
+func (p *postgresPartRepository) ListStockLocations(ctx context.Context) ([]*domains.StockLocation, error) {
+	rows, err := p.db.ListStockLocationsQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]*domains.StockLocation, 0, len(rows))
+	for _, row := range rows {
+		locations = append(locations, stockLocationFromRow(row))
+	}
+	return locations, nil
+}
+func (p *postgresPartRepository) ListStockLevels(filter domains.StockLevelFilter, ctx context.Context) ([]*domains.StockLevel, error) {
+	rows, err := p.db.ListStockLevelsQuery(ctx, pgstore.ListStockLevelsQueryParams{
+		LocationID: pgtype.UUID{Bytes: filter.LocationID, Valid: filter.LocationID != uuid.Nil},
+		PartID:     pgtype.UUID{Bytes: filter.PartID, Valid: filter.PartID != uuid.Nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	levels := make([]*domains.StockLevel, 0, len(rows))
+	for _, row := range rows {
+		levels = append(levels, &domains.StockLevel{
+			LocationID:   row.LocationID,
+			LocationName: row.LocationName,
+			PartID:       row.PartID,
+			SKU:          row.Sku,
+			PartName:     row.PartName,
+			Unit:         row.Unit,
+			Quantity:     row.Quantity,
+			UpdatedAt:    row.UpdatedAt.UTC(),
+		})
+	}
+	return levels, nil
+}
+
+// SaveStockMovements aplica as movimentações aos saldos e grava o histórico
+// na mesma transação: as duas pernas de uma transferência entram juntas ou
+// nenhuma entra. Um saldo que ficaria negativo vira ErrInsufficientStock.
+func (p *postgresPartRepository) SaveStockMovements(movements []*domains.StockMovement, ctx context.Context) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for SaveStockMovements: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	for _, m := range movements {
+		if err := applyStockMovement(qtx, m, ctx); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+func (p *postgresPartRepository) ListStockMovements(filter domains.StockMovementFilter, ctx context.Context) ([]*domains.StockMovement, error) {
+	rows, err := p.db.ListStockMovementsQuery(ctx, pgstore.ListStockMovementsQueryParams{
+		PartID:     pgtype.UUID{Bytes: filter.PartID, Valid: filter.PartID != uuid.Nil},
+		LocationID: pgtype.UUID{Bytes: filter.LocationID, Valid: filter.LocationID != uuid.Nil},
+		FormID:     pgtype.UUID{Bytes: filter.FormID, Valid: filter.FormID != uuid.Nil},
+		RowLimit:   int32(filter.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	movements := make([]*domains.StockMovement, 0, len(rows))
+	for _, row := range rows {
+		movements = append(movements, &domains.StockMovement{
+			ID:           row.ID,
+			PartID:       row.PartID,
+			SKU:          row.Sku,
+			PartName:     row.PartName,
+			LocationID:   row.LocationID,
+			LocationName: row.LocationName,
+			Kind:         row.Kind,
+			Quantity:     row.Quantity,
+			FormID:       uuid.UUID(row.FormID.Bytes),
+			FormPartID:   uuid.UUID(row.FormPartID.Bytes),
+			TransferID:   uuid.UUID(row.TransferID.Bytes),
+			Notes:        row.Notes,
+			CreatedBy:    uuid.UUID(row.CreatedBy.Bytes),
+			CreatedAt:    row.CreatedAt.UTC(),
+		})
+	}
+	return movements, nil
+}
+
+// ListLowStock devolve as peças ativas com estoque mínimo cujo saldo somado em
+// todos os locais está no mínimo ou abaixo, das mais desfalcadas primeiro.
+func (p *postgresPartRepository) ListLowStock(ctx context.Context) ([]domains.LowStockItem, error) {
+	rows, err := p.db.ListLowStockQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domains.LowStockItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, domains.LowStockItem{
+			PartID:   row.ID,
+			SKU:      row.Sku,
+			Name:     row.Name,
+			Unit:     row.Unit,
+			MinStock: row.MinStock,
+			Quantity: row.Quantity,
+		})
+	}
+	return items, nil
+}
+
+// SaveFormPart grava a peça no atendimento e dá baixa no estoque do local na
+// mesma transação; sem saldo suficiente nada é gravado.
+func (p *postgresPartRepository) SaveFormPart(f *domains.FormPart, ctx context.Context) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for SaveFormPart: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	row, err := qtx.CreateFormPartQuery(ctx, pgstore.CreateFormPartQueryParams{
+		FormID:     f.FormID,
+		PartID:     f.PartID,
+		LocationID: f.LocationID,
+		Quantity:   f.Quantity,
+		UnitPrice:  f.UnitPrice,
+		UnitCost:   f.UnitCost,
+		CreatedBy:  pgtype.UUID{Bytes: f.CreatedBy, Valid: f.CreatedBy != uuid.Nil},
+	})
+	if err != nil {
+		return stockError(err)
+	}
+	f.ID = row.ID
+	f.CreatedAt = row.CreatedAt.UTC()
+
+	if err := applyStockMovement(qtx, f.ConsumeMovement(), ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+func (p *postgresPartRepository) ListFormParts(formID uuid.UUID, ctx context.Context) ([]*domains.FormPart, error) {
+	rows, err := p.db.ListFormPartsQuery(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]*domains.FormPart, 0, len(rows))
+	for _, row := range rows {
+		parts = append(parts, &domains.FormPart{
+			ID:           row.ID,
+			FormID:       row.FormID,
+			PartID:       row.PartID,
+			SKU:          row.Sku,
+			PartName:     row.PartName,
+			Unit:         row.Unit,
+			LocationID:   row.LocationID,
+			LocationName: row.LocationName,
+			Quantity:     row.Quantity,
+			UnitPrice:    row.UnitPrice,
+			UnitCost:     row.UnitCost,
+			CreatedBy:    uuid.UUID(row.CreatedBy.Bytes),
+			CreatedAt:    row.CreatedAt.UTC(),
+		})
+	}
+	return parts, nil
+}
+
+// DeleteFormPart remove a peça do atendimento e devolve a quantidade ao local
+// de onde ela saiu, registrando o estorno em nome de by.
+func (p *postgresPartRepository) DeleteFormPart(formID, id, by uuid.UUID, ctx context.Context) (*domains.FormPart, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for DeleteFormPart: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	row, err := qtx.DeleteFormPartQuery(ctx, pgstore.DeleteFormPartQueryParams{
+		ID:     id,
+		FormID: formID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrFormPartNotFound
+		}
+		return nil, err
+	}
+
+	part := &domains.FormPart{
+		ID:         row.ID,
+		FormID:     row.FormID,
+		PartID:     row.PartID,
+		LocationID: row.LocationID,
+		Quantity:   row.Quantity,
+		UnitPrice:  row.UnitPrice,
+		UnitCost:   row.UnitCost,
+		CreatedBy:  uuid.UUID(row.CreatedBy.Bytes),
+		CreatedAt:  row.CreatedAt.UTC(),
+	}
+	if err := applyStockMovement(qtx, part.ReturnMovement(by), ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return part, nil
+}
+
+// applyStockMovement atualiza o saldo e grava a movimentação. O saldo vem
+// primeiro para que a falta de estoque seja detectada antes do histórico.
+func applyStockMovement(qtx *pgstore.Queries, m *domains.StockMovement, ctx context.Context) error {
+	if _, err := qtx.ApplyStockMovementQuery(ctx, pgstore.ApplyStockMovementQueryParams{
+		LocationID: m.LocationID,
+		PartID:     m.PartID,
+		Quantity:   m.Quantity,
+	}); err != nil {
+		return stockError(err)
+	}
+
+	row, err := qtx.CreateStockMovementQuery(ctx, pgstore.CreateStockMovementQueryParams{
+		PartID:     m.PartID,
+		LocationID: m.LocationID,
+		Kind:       m.Kind,
+		Quantity:   m.Quantity,
+		FormID:     pgtype.UUID{Bytes: m.FormID, Valid: m.FormID != uuid.Nil},
+		FormPartID: pgtype.UUID{Bytes: m.FormPartID, Valid: m.FormPartID != uuid.Nil},
+		TransferID: pgtype.UUID{Bytes: m.TransferID, Valid: m.TransferID != uuid.Nil},
+		Notes:      m.Notes,
+		CreatedBy:  pgtype.UUID{Bytes: m.CreatedBy, Valid: m.CreatedBy != uuid.Nil},
+	})
+	if err != nil {
+		return stockError(err)
+	}
+
+	m.ID = row.ID
+	m.CreatedAt = row.CreatedAt.UTC()
+	return nil
+}
+
+// stockError traduz as violações de restrição do estoque nos erros de domínio.
+func stockError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case "23514":
+		if pgErr.ConstraintName == "stock_levels_quantity_check" {
+			return domains.ErrInsufficientStock
+		}
+	case "23505":
+		switch pgErr.ConstraintName {
+		case "idx_parts_sku":
+			return domains.ErrPartSKUAlreadyExists
+		case "idx_stock_locations_member":
+			return domains.ErrStockLocationExists
+		}
+	case "23503":
+		switch {
+		case strings.HasSuffix(pgErr.ConstraintName, "_part_id_fkey"):
+			return domains.ErrPartNotFound
+		case strings.HasSuffix(pgErr.ConstraintName, "_location_id_fkey"):
+			return domains.ErrStockLocationNotFound
+		case strings.HasSuffix(pgErr.ConstraintName, "_form_id_fkey"):
+			return domains.ErrFormNotFound
+		case pgErr.ConstraintName == "stock_locations_member_id_fkey":
+			return domains.ErrMemberNotFound
+		}
+	}
+	return err
+}
+
+func partFromRow(row pgstore.Part) *domains.Part {
+	return &domains.Part{
+		ID:        row.ID,
+		SKU:       row.Sku,
+		Name:      row.Name,
+		Unit:      row.Unit,
+		Cost:      row.Cost,
+		Price:     row.Price,
+		MinStock:  row.MinStock,
+		Active:    row.Active,
+		CreatedAt: row.CreatedAt.UTC(),
+		UpdatedAt: row.UpdatedAt.UTC(),
+	}
+}
+
+func stockLocationFromRow(row pgstore.ListStockLocationsQueryRow) *domains.StockLocation {
+	return &domains.StockLocation{
+		ID:         row.ID,
+		Kind:       row.Kind,
+		Name:       row.Name,
+		MemberID:   uuid.UUID(row.MemberID.Bytes),
+		MemberName: row.MemberName.String,
+		CreatedAt:  row.CreatedAt.UTC(),
+	}
+}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresPortalRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresPortalRepository(db *pgxpool.Pool) PortalRepository {
+	return &postgresPortalRepository{db: pgstore.New(db), pool: db}
+}
+
+func (p *postgresPortalRepository) SaveClientUser(u *domains.ClientUser, ctx context.Context) (uuid.UUID, error) {
+	id, err := p.db.CreateClientUserQuery(ctx, pgstore.CreateClientUserQueryParams{
+		ClientID:     u.ClientID,
+		Name:         u.Name,
+		Email:        u.Email,
+		PasswordHash: u.Password,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return uuid.Nil, domains.ErrClientUserEmailTaken
+			case "23503":
+				return uuid.Nil, domains.ErrClientNotFound
+			}
+		}
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+func (p *postgresPortalRepository) FindClientUserByID(id uuid.UUID, ctx context.Context) (*domains.ClientUser, error) {
+	row, err := p.db.GetClientUserByIdQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrClientUserNotFound
+		}
+		return nil, err
+	}
+
+	return clientUserFromRow(row), nil
+}
+func (p *postgresPortalRepository) FindClientUserByEmail(email string, ctx context.Context) (*domains.ClientUser, error) {
+	row, err := p.db.GetClientUserByEmailQuery(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrClientUserNotFound
+		}
+		return nil, err
+	}
+
+	return clientUserFromRow(row), nil
+}
+func (p *postgresPortalRepository) ListClientUsers(clientID uuid.UUID, ctx context.Context) ([]*domains.ClientUser, error) {
+	rows, err := p.db.GetClientUsersQuery(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*domains.ClientUser, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, clientUserFromRow(row))
+	}
+
+	return users, nil
+}
+func (p *postgresPortalRepository) DeleteClientUser(id uuid.UUID, ctx context.Context) error {
+	affected, err := p.db.DeleteClientUserQuery(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrClientUserNotFound
+	}
+
+	return nil
+}
+func (p *postgresPortalRepository) TouchClientUserLogin(id uuid.UUID, ctx context.Context) error {
+	return p.db.UpdateClientUserLastLoginQuery(ctx, id)
+}
+func (p *postgresPortalRepository) SavePortalRequest(r *domains.PortalRequest, ctx context.Context) (uuid.UUID, error) {
+	id, err := p.db.CreatePortalRequestQuery(ctx, pgstore.CreatePortalRequestQueryParams{
+		ClientID:      r.ClientID,
+		ClientUserID:  pgtype.UUID{Bytes: r.ClientUserID, Valid: r.ClientUserID != uuid.Nil},
+		RequesterName: r.RequesterName,
+		Description:   r.Description,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+func (p *postgresPortalRepository) FindPortalRequestByID(id uuid.UUID, ctx context.Context) (*domains.PortalRequest, error) {
+	row, err := p.db.GetPortalRequestByIdQuery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrPortalRequestNotFound
+		}
+		return nil, err
+	}
+
+	return portalRequestFromRow(pgstore.GetPortalRequestsQueryRow(row)), nil
+}
+func (p *postgresPortalRepository) ListPortalRequests(clientID uuid.UUID, status string, ctx context.Context) ([]*domains.PortalRequest, error) {
+	rows, err := p.db.GetPortalRequestsQuery(ctx, pgstore.GetPortalRequestsQueryParams{
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: clientID != uuid.Nil},
+		Status:   status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*domains.PortalRequest, 0, len(rows))
+	for _, row := range rows {
+		requests = append(requests, portalRequestFromRow(row))
+	}
+
+	return requests, nil
+}
+func (p *postgresPortalRepository) UpdatePortalRequestReview(r *domains.PortalRequest, ctx context.Context) error {
+	affected, err := p.db.UpdatePortalRequestReviewQuery(ctx, pgstore.UpdatePortalRequestReviewQueryParams{
+		Status:     r.Status,
+		FormID:     pgtype.UUID{Bytes: r.FormID, Valid: r.FormID != uuid.Nil},
+		ReviewNote: pgtype.Text{String: r.ReviewNote, Valid: r.ReviewNote != ""},
+		ID:         r.ID,
+	})
+	if err != nil {
+		return err
+	}
+	// A atualização só vale para solicitações ainda abertas; zero linhas
+	// significa que outra pessoa revisou a solicitação antes.
+	if affected == 0 {
+		return domains.ErrPortalRequestClosed
+	}
+
+	return nil
+}
+
+func clientUserFromRow(row pgstore.ClientUser) *domains.ClientUser {
+	return &domains.ClientUser{
+		ID:          row.ID,
+		ClientID:    row.ClientID,
+		Name:        row.Name,
+		Email:       row.Email,
+		Password:    row.PasswordHash,
+		LastLoginAt: row.LastLoginAt.Time.UTC(),
+		CreatedAt:   row.CreatedAt.UTC(),
+		UpdatedAt:   row.UpdatedAt.UTC(),
+	}
+}
+
+func portalRequestFromRow(row pgstore.GetPortalRequestsQueryRow) *domains.PortalRequest {
+	return &domains.PortalRequest{
+		ID:            row.ID,
+		ClientID:      row.ClientID,
+		ClientName:    row.ClientName,
+		ClientUserID:  uuid.UUID(row.ClientUserID.Bytes),
+		RequesterName: row.RequesterName,
+		Description:   row.Description,
+		Status:        row.Status,
+		FormID:        uuid.UUID(row.FormID.Bytes),
+		ReviewNote:    row.ReviewNote.String,
+		CreatedAt:     row.CreatedAt.UTC(),
+		UpdatedAt:     row.UpdatedAt.UTC(),
+	}
+}
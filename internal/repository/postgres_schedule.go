@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresScheduleRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresScheduleRepository(db *pgxpool.Pool) ScheduleRepository {
+	return &postgresScheduleRepository{db: pgstore.New(db), pool: db}
+}
+func (p *postgresScheduleRepository) ListCalendar(filter domains.CalendarFilter, ctx context.Context) ([]*domains.CalendarEntry, error) {
+	var members []uuid.UUID
+	if filter.MemberID != uuid.Nil {
+		members = []uuid.UUID{filter.MemberID}
+	}
+	return listCalendar(p.db, members, filter, ctx)
+}
+
+// SaveFormSchedule substitui a agenda dos técnicos do atendimento. Os técnicos
+// envolvidos ficam bloqueados até o fim da transação, de modo que duas
+// agendas simultâneas para o mesmo técnico não deixam de ver uma à outra.
+// Com conflitos e sem allowConflicts, nada é gravado e o erro é
+// ErrScheduleConflict; em ambos os casos os conflitos são devolvidos.
+func (p *postgresScheduleRepository) SaveFormSchedule(formID uuid.UUID, schedules []domains.AssignmentSchedule, allowConflicts bool, ctx context.Context) ([]*domains.CalendarEntry, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for SaveFormSchedule: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	conflicts := []*domains.CalendarEntry{}
+	if len(schedules) > 0 {
+		members := make([]uuid.UUID, 0, len(schedules))
+		filter := domains.CalendarFilter{From: schedules[0].Start, To: schedules[0].End}
+		for _, s := range schedules {
+			members = append(members, s.MemberID)
+			if s.Start.Before(filter.From) {
+				filter.From = s.Start
+			}
+			if s.End.After(filter.To) {
+				filter.To = s.End
+			}
+		}
+		if _, err := qtx.LockMembersQuery(ctx, members); err != nil {
+			return nil, err
+		}
+
+		busy, err := listCalendar(qtx, members, filter, ctx)
+		if err != nil {
+			return nil, err
+		}
+		conflicts = domains.ScheduleConflicts(schedules, busy)
+		if len(conflicts) > 0 && !allowConflicts {
+			return conflicts, domains.ErrScheduleConflict
+		}
+	}
+
+	if err := qtx.ClearFormTecnicoScheduleQuery(ctx, formID); err != nil {
+		return nil, err
+	}
+	for _, s := range schedules {
+		updated, err := qtx.UpdateFormTecnicoScheduleQuery(ctx, pgstore.UpdateFormTecnicoScheduleQueryParams{
+			FormID:         formID,
+			MemberID:       s.MemberID,
+			ScheduledStart: pgtype.Timestamptz{Time: s.Start.UTC(), Valid: true},
+			ScheduledEnd:   pgtype.Timestamptz{Time: s.End.UTC(), Valid: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if updated == 0 {
+			return nil, domains.ErrMemberNotAssigned
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return conflicts, nil
+}
+
+// ListRouteStops busca os atendimentos do técnico abertos em [from, to), com
+// o endereço e as coordenadas do cliente.
+func (p *postgresScheduleRepository) ListRouteStops(memberID uuid.UUID, from, to time.Time, ctx context.Context) ([]domains.RouteStop, error) {
+	rows, err := p.db.GetRouteStopsQuery(ctx, pgstore.GetRouteStopsQueryParams{
+		MemberID: memberID,
+		DayStart: from.UTC(),
+		DayEnd:   to.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stops := make([]domains.RouteStop, 0, len(rows))
+	for _, row := range rows {
+		stops = append(stops, domains.RouteStop{
+			FormID:            row.ID,
+			PublicCode:        row.PublicCode,
+			ClientID:          row.ClientID,
+			ClientName:        row.ClientName,
+			Address:           formatAddress(row.Street, row.Number, row.Neighborhood, row.City, row.State),
+			Status:            row.Status,
+			DefectDescription: row.DefectDescription.String,
+			OccurredAt:        row.OccurredAt.UTC(),
+			Location:          domains.GeoPoint{Latitude: row.Latitude.Float64, Longitude: row.Longitude.Float64},
+			Located:           row.Latitude.Valid && row.Longitude.Valid,
+		})
+	}
+
+	return stops, nil
+}
+
+// formatAddress monta o endereço em uma linha ("Rua, 10 - Bairro, Cidade/UF"),
+// omitindo as partes vazias.
+func formatAddress(street, number, neighborhood, city, state pgtype.Text) string {
+	address := street.String
+	if number.String != "" {
+		address = strings.TrimPrefix(address+", "+number.String, ", ")
+	}
+	if neighborhood.String != "" {
+		address = strings.TrimPrefix(address+" - "+neighborhood.String, " - ")
+	}
+	locality := city.String
+	if state.String != "" {
+		locality = strings.TrimPrefix(locality+"/"+state.String, "/")
+	}
+	if locality != "" {
+		address = strings.TrimPrefix(address+", "+locality, ", ")
+	}
+	return address
+}
+
+func listCalendar(db *pgstore.Queries, members []uuid.UUID, filter domains.CalendarFilter, ctx context.Context) ([]*domains.CalendarEntry, error) {
+	rows, err := db.GetCalendarQuery(ctx, pgstore.GetCalendarQueryParams{
+		MemberIds:  members,
+		RangeStart: filter.From.UTC(),
+		RangeEnd:   filter.To.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*domains.CalendarEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, &domains.CalendarEntry{
+			AssignmentSchedule: domains.AssignmentSchedule{
+				FormID:   row.FormID,
+				MemberID: row.MemberID,
+				Start:    row.ScheduledStart.UTC(),
+				End:      row.ScheduledEnd.UTC(),
+			},
+			PublicCode:        row.PublicCode,
+			MemberName:        row.MemberName,
+			ClientName:        row.ClientName,
+			Status:            row.Status,
+			DefectDescription: row.DefectDescription.String,
+		})
+	}
+
+	return entries, nil
+}
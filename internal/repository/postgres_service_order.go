@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresServiceOrderTemplateRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresServiceOrderTemplateRepository(db *pgxpool.Pool) ServiceOrderTemplateRepository {
+	return &postgresServiceOrderTemplateRepository{db: pgstore.New(db), pool: db}
+}
+
+// GetServiceOrderTemplate devolve o modelo padrão enquanto a organização não
+// salvou o seu.
+func (p *postgresServiceOrderTemplateRepository) GetServiceOrderTemplate(ctx context.Context) (*domains.ServiceOrderTemplate, error) {
+	row, err := p.db.GetServiceOrderTemplateQuery(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domains.DefaultServiceOrderTemplate(), nil
+		}
+		return nil, err
+	}
+
+	return &domains.ServiceOrderTemplate{
+		CompanyName:     row.CompanyName,
+		CompanyDocument: row.CompanyDocument,
+		Address:         row.Address,
+		Phone:           row.Phone,
+		Email:           row.Email,
+		Website:         row.Website,
+		FooterText:      row.FooterText,
+		PrimaryColor:    row.PrimaryColor,
+		Timezone:        row.Timezone,
+		LogoKey:         row.LogoKey,
+		LogoContentType: row.LogoContentType,
+		UpdatedBy:       uuid.UUID(row.UpdatedBy.Bytes),
+		UpdatedAt:       row.UpdatedAt.UTC(),
+	}, nil
+}
+
+func (p *postgresServiceOrderTemplateRepository) SaveServiceOrderTemplate(t *domains.ServiceOrderTemplate, ctx context.Context) error {
+	updatedAt, err := p.db.UpsertServiceOrderTemplateQuery(ctx, pgstore.UpsertServiceOrderTemplateQueryParams{
+		CompanyName:     t.CompanyName,
+		CompanyDocument: t.CompanyDocument,
+		Address:         t.Address,
+		Phone:           t.Phone,
+		Email:           t.Email,
+		Website:         t.Website,
+		FooterText:      t.FooterText,
+		PrimaryColor:    t.PrimaryColor,
+		Timezone:        t.Timezone,
+		LogoKey:         t.LogoKey,
+		LogoContentType: t.LogoContentType,
+		UpdatedBy:       pgtype.UUID{Bytes: t.UpdatedBy, Valid: t.UpdatedBy != uuid.Nil},
+	})
+	if err != nil {
+		return err
+	}
+
+	t.UpdatedAt = updatedAt.UTC()
+	return nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresSignatureRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresSignatureRepository(db *pgxpool.Pool) SignatureRepository {
+	return &postgresSignatureRepository{db: pgstore.New(db), pool: db}
+}
+
+func (p *postgresSignatureRepository) SaveSignature(s *domains.FormSignature, ctx context.Context) error {
+	createdAt, err := p.db.CreateFormSignatureQuery(ctx, pgstore.CreateFormSignatureQueryParams{
+		ID:              s.ID,
+		FormID:          s.FormID,
+		SignerName:      s.SignerName,
+		SignerDocument:  s.SignerDocument,
+		SignedAt:        s.SignedAt,
+		Format:          s.Format,
+		ContentType:     s.ContentType,
+		SizeBytes:       s.Size,
+		ChecksumSha256:  s.Checksum,
+		StorageKey:      s.StorageKey,
+		Latitude:        s.Latitude,
+		Longitude:       s.Longitude,
+		AccuracyMeters:  pgtype.Float8{Float64: s.AccuracyMeters, Valid: s.AccuracyMeters > 0},
+		SnapshotSha256:  s.SnapshotHash,
+		SnapshotVersion: int16(s.SnapshotVersion),
+		CapturedBy:      pgtype.UUID{Bytes: s.CapturedBy, Valid: s.CapturedBy != uuid.Nil},
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return domains.ErrFormAlreadySigned
+			case "23503":
+				return domains.ErrFormNotFound
+			}
+		}
+		return err
+	}
+
+	s.CreatedAt = createdAt.UTC()
+	return nil
+}
+func (p *postgresSignatureRepository) FindSignature(formID uuid.UUID, ctx context.Context) (*domains.FormSignature, error) {
+	row, err := p.db.GetFormSignatureQuery(ctx, formID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrSignatureNotFound
+		}
+		return nil, err
+	}
+
+	return &domains.FormSignature{
+		ID:              row.ID,
+		FormID:          row.FormID,
+		SignerName:      row.SignerName,
+		SignerDocument:  row.SignerDocument,
+		SignedAt:        row.SignedAt.UTC(),
+		Format:          row.Format,
+		ContentType:     row.ContentType,
+		Size:            row.SizeBytes,
+		Checksum:        row.ChecksumSha256,
+		StorageKey:      row.StorageKey,
+		Latitude:        row.Latitude,
+		Longitude:       row.Longitude,
+		AccuracyMeters:  row.AccuracyMeters.Float64,
+		SnapshotHash:    row.SnapshotSha256,
+		SnapshotVersion: int(row.SnapshotVersion),
+		CapturedBy:      uuid.UUID(row.CapturedBy.Bytes),
+		CapturedByName:  row.CapturedByName.String,
+		CreatedAt:       row.CreatedAt.UTC(),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresSimilarFormRepository struct {
+	db *pgstore.Queries
+}
+
+func NewPostgresSimilarFormRepository(db *pgxpool.Pool) SimilarFormRepository {
+	return &postgresSimilarFormRepository{db: pgstore.New(db)}
+}
+
+// ListSimilarFormCandidates busca os atendimentos resolvidos de defeito mais
+// parecido com a descrição, até domains.SimilarFormCandidates.
+func (p *postgresSimilarFormRepository) ListSimilarFormCandidates(q domains.SimilarFormsQuery, ctx context.Context) ([]domains.SimilarFormCandidate, error) {
+	rows, err := p.db.GetSimilarFormCandidatesQuery(ctx, pgstore.GetSimilarFormCandidatesQueryParams{
+		Description: q.Description,
+		ExcludeID:   pgtype.UUID{Bytes: q.FormID, Valid: q.FormID != uuid.Nil},
+		Candidates:  domains.SimilarFormCandidates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]domains.SimilarFormCandidate, 0, len(rows))
+	for _, row := range rows {
+		candidates = append(candidates, domains.SimilarFormCandidate{
+			Form: &domains.Atendimentos{
+				ID:             row.ID,
+				PublicCode:     row.PublicCode,
+				DataDeAbertura: row.OccurredAt.UTC(),
+				Cliente: domains.ClientForm{
+					ID:         row.ClientID,
+					ClientName: row.ClientName,
+				},
+				DefectDescription:   row.DefectDescription.String,
+				SolutionDescription: row.SolutionDescription.String,
+				Status:              row.Status,
+				SLA:                 domains.FormSLA{ResolvedAt: timeOrZero(row.ResolvedAt)},
+			},
+			Similarity:   row.Similarity,
+			HelpfulCount: int(row.HelpfulCount),
+		})
+	}
+
+	return candidates, nil
+}
+
+// SaveSimilarFormFeedback registra o voto; votar de novo não muda nada.
+func (p *postgresSimilarFormRepository) SaveSimilarFormFeedback(f *domains.SimilarFormFeedback, ctx context.Context) error {
+	_, err := p.db.CreateSimilarFormFeedbackQuery(ctx, pgstore.CreateSimilarFormFeedbackQueryParams{
+		FormID:        f.FormID,
+		SimilarFormID: f.SimilarFormID,
+		UserID:        f.UserID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domains.ErrFormNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteSimilarFormFeedback desfaz o voto do usuário, se houver.
+func (p *postgresSimilarFormRepository) DeleteSimilarFormFeedback(f *domains.SimilarFormFeedback, ctx context.Context) error {
+	_, err := p.db.DeleteSimilarFormFeedbackQuery(ctx, pgstore.DeleteSimilarFormFeedbackQueryParams{
+		FormID:        f.FormID,
+		SimilarFormID: f.SimilarFormID,
+		UserID:        f.UserID,
+	})
+	return err
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresSLARepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresSLARepository(db *pgxpool.Pool) SLARepository {
+	return &postgresSLARepository{db: pgstore.New(db), pool: db}
+}
+
+func (p *postgresSLARepository) FindSLAPolicy(difficultyLevel, clientType string, ctx context.Context) (*domains.SLAPolicy, error) {
+	row, err := p.db.GetSLAPolicyQuery(ctx, pgstore.GetSLAPolicyQueryParams{
+		DifficultyLevel: pgstore.DifficultyLevel(difficultyLevel),
+		ClientType:      pgstore.ClientType(clientType),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrSLAPolicyNotFound
+		}
+		return nil, err
+	}
+
+	return slaPolicyFromRow(row), nil
+}
+func (p *postgresSLARepository) ListSLAPolicies(ctx context.Context) ([]*domains.SLAPolicy, error) {
+	rows, err := p.db.ListSLAPoliciesQuery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*domains.SLAPolicy, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, slaPolicyFromRow(row))
+	}
+
+	return policies, nil
+}
+func (p *postgresSLARepository) SaveSLAPolicy(policy *domains.SLAPolicy, ctx context.Context) error {
+	updatedAt, err := p.db.UpsertSLAPolicyQuery(ctx, pgstore.UpsertSLAPolicyQueryParams{
+		DifficultyLevel:   pgstore.DifficultyLevel(policy.DifficultyLevel),
+		ClientType:        pgstore.ClientType(policy.ClientType),
+		ResponseMinutes:   int32(policy.ResponseMinutes),
+		ResolutionMinutes: int32(policy.ResolutionMinutes),
+	})
+	if err != nil {
+		return err
+	}
+
+	policy.UpdatedAt = updatedAt.UTC()
+	return nil
+}
+
+// ListHolidays devolve os feriados a partir do dia de from.
+func (p *postgresSLARepository) ListHolidays(from time.Time, ctx context.Context) ([]domains.Holiday, error) {
+	rows, err := p.db.ListHolidaysQuery(ctx, pgtype.Date{Time: from.UTC(), Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := make([]domains.Holiday, 0, len(rows))
+	for _, row := range rows {
+		holidays = append(holidays, domains.Holiday{
+			Date: row.HolidayDate.Time,
+			Name: row.Name,
+		})
+	}
+
+	return holidays, nil
+}
+func (p *postgresSLARepository) SaveHoliday(h *domains.Holiday, ctx context.Context) error {
+	if err := p.db.CreateHolidayQuery(ctx, pgstore.CreateHolidayQueryParams{
+		HolidayDate: pgtype.Date{Time: h.Date, Valid: true},
+		Name:        h.Name,
+	}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domains.ErrHolidayAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+func (p *postgresSLARepository) DeleteHoliday(date time.Time, ctx context.Context) error {
+	affected, err := p.db.DeleteHolidayQuery(ctx, pgtype.Date{Time: date, Valid: true})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrHolidayNotFound
+	}
+
+	return nil
+}
+
+// ListSLAFlags devolve os atendimentos pendentes que já passaram do instante
+// de risco em now, com a situação do SLA avaliada em now.
+func (p *postgresSLARepository) ListSLAFlags(now time.Time, ctx context.Context) ([]domains.SLAFlag, error) {
+	rows, err := p.db.ListSLAPendingFormsQuery(ctx, now.UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	flags := make([]domains.SLAFlag, 0, len(rows))
+	for _, row := range rows {
+		sla := domains.FormSLA{
+			ResponseDueAt:    timeOrZero(row.ResponseDueAt),
+			ResponseRiskAt:   timeOrZero(row.ResponseRiskAt),
+			ResolutionDueAt:  timeOrZero(row.ResolutionDueAt),
+			ResolutionRiskAt: timeOrZero(row.ResolutionRiskAt),
+			RespondedAt:      timeOrZero(row.RespondedAt),
+			ResolvedAt:       timeOrZero(row.ResolvedAt),
+		}
+		flags = append(flags, domains.SLAFlag{
+			FormID:        row.ID,
+			ClientName:    row.ClientName,
+			State:         sla.State(now),
+			NotifiedState: row.SlaNotifiedState.String,
+		})
+	}
+
+	return flags, nil
+}
+
+// FlagFormSLA grava a nova situação notificada e cria as notificações dos
+// técnicos do atendimento na mesma transação. A gravação só acontece se a
+// situação notificada no banco ainda for flag.NotifiedState; caso contrário
+// outra verificação já notificou e o resultado é false.
+func (p *postgresSLARepository) FlagFormSLA(flag domains.SLAFlag, message string, ctx context.Context) (bool, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to begin tx for FlagFormSLA: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	affected, err := qtx.UpdateFormSLANotifiedStateQuery(ctx, pgstore.UpdateFormSLANotifiedStateQueryParams{
+		State:         flag.State,
+		ID:            flag.FormID,
+		PreviousState: pgtype.Text{String: flag.NotifiedState, Valid: flag.NotifiedState != ""},
+	})
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	if _, err := qtx.CreateFormTechniciansNotificationQuery(ctx, pgstore.CreateFormTechniciansNotificationQueryParams{
+		Kind:    domains.SLANotificationKind(flag.State),
+		Message: message,
+		FormID:  flag.FormID,
+	}); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func slaPolicyFromRow(row pgstore.SlaPolicy) *domains.SLAPolicy {
+	return &domains.SLAPolicy{
+		DifficultyLevel:   string(row.DifficultyLevel),
+		ClientType:        string(row.ClientType),
+		ResponseMinutes:   int(row.ResponseMinutes),
+		ResolutionMinutes: int(row.ResolutionMinutes),
+		UpdatedAt:         row.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// surveyGranularities traduz a granularidade do resumo para o date_trunc.
+var surveyGranularities = map[string]string{
+	domains.SurveyPeriodDay:   "day",
+	domains.SurveyPeriodWeek:  "week",
+	domains.SurveyPeriodMonth: "month",
+}
+
+type postgresSurveyRepository struct {
+	db *pgstore.Queries
+}
+
+func NewPostgresSurveyRepository(db *pgxpool.Pool) SurveyRepository {
+	return &postgresSurveyRepository{db: pgstore.New(db)}
+}
+
+// ListPendingSurveys devolve as pesquisas ainda não enviadas, enfileiradas
+// depois de queuedAfter e com tentativas disponíveis, das mais antigas para as
+// mais novas.
+func (p *postgresSurveyRepository) ListPendingSurveys(queuedAfter time.Time, limit int, ctx context.Context) ([]*domains.SatisfactionSurvey, error) {
+	rows, err := p.db.GetPendingSatisfactionSurveysQuery(ctx, pgstore.GetPendingSatisfactionSurveysQueryParams{
+		MaxAttempts: domains.MaxSurveySendAttempts,
+		QueuedAfter: queuedAfter.UTC(),
+		RowLimit:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	surveys := make([]*domains.SatisfactionSurvey, 0, len(rows))
+	for _, row := range rows {
+		surveys = append(surveys, &domains.SatisfactionSurvey{
+			ID:           row.ID,
+			FormID:       row.FormID,
+			FormCode:     row.PublicCode,
+			ClientID:     row.ClientID,
+			ClientName:   row.ClientName,
+			ContactName:  row.ContactName.String,
+			ContactEmail: row.Email.String,
+			SendAttempts: int(row.SendAttempts),
+			CreatedAt:    row.CreatedAt,
+		})
+	}
+
+	return surveys, nil
+}
+
+// ClaimSurvey reserva a pesquisa para envio gravando o hash do token, o
+// destinatário e a validade antes do e-mail sair. Só uma rodada consegue
+// reservar cada pesquisa, mesmo com várias instâncias; as demais recebem
+// ErrSurveyNotFound.
+func (p *postgresSurveyRepository) ClaimSurvey(s *domains.SatisfactionSurvey, ctx context.Context) error {
+	affected, err := p.db.ClaimSatisfactionSurveyQuery(ctx, pgstore.ClaimSatisfactionSurveyQueryParams{
+		TokenHash: pgtype.Text{String: s.TokenHash, Valid: true},
+		SentTo:    pgtype.Text{String: s.SentTo, Valid: true},
+		SentAt:    pgtype.Timestamptz{Time: s.SentAt.UTC(), Valid: true},
+		ExpiresAt: pgtype.Timestamptz{Time: s.ExpiresAt.UTC(), Valid: true},
+		ID:        s.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrSurveyNotFound
+	}
+
+	return nil
+}
+
+// ReleaseSurvey devolve à fila a pesquisa reservada com s.TokenHash cujo
+// e-mail falhou e conta a tentativa.
+func (p *postgresSurveyRepository) ReleaseSurvey(s *domains.SatisfactionSurvey, ctx context.Context) error {
+	return p.db.ReleaseSatisfactionSurveyQuery(ctx, pgstore.ReleaseSatisfactionSurveyQueryParams{
+		ID:        s.ID,
+		TokenHash: pgtype.Text{String: s.TokenHash, Valid: true},
+	})
+}
+
+func (p *postgresSurveyRepository) FindSurveyByToken(token string, ctx context.Context) (*domains.SatisfactionSurvey, error) {
+	row, err := p.db.GetSatisfactionSurveyByTokenQuery(ctx, pgtype.Text{String: domains.HashSurveyToken(token), Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrSurveyNotFound
+		}
+		return nil, err
+	}
+
+	return surveyFromRow(pgstore.GetSatisfactionSurveyByFormQueryRow(row)), nil
+}
+
+func (p *postgresSurveyRepository) FindSurveyByForm(formID uuid.UUID, ctx context.Context) (*domains.SatisfactionSurvey, error) {
+	row, err := p.db.GetSatisfactionSurveyByFormQuery(ctx, formID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrSurveyNotFound
+		}
+		return nil, err
+	}
+
+	return surveyFromRow(row), nil
+}
+
+// AnswerSurvey grava a resposta se a pesquisa ainda aceitar uma em now. A
+// condição fica no banco para que duas respostas simultâneas não se
+// sobrescrevam; a segunda resulta em ErrSurveyAlreadyAnswered.
+func (p *postgresSurveyRepository) AnswerSurvey(id uuid.UUID, answer domains.SurveyAnswer, now time.Time, ctx context.Context) error {
+	affected, err := p.db.AnswerSatisfactionSurveyQuery(ctx, pgstore.AnswerSatisfactionSurveyQueryParams{
+		CsatScore:   pgtype.Int2{Int16: int16(answer.CSATScore), Valid: true},
+		NpsScore:    pgtype.Int2{Int16: int16(answer.NPSScore), Valid: true},
+		Comment:     answer.Comment,
+		RespondedAt: pgtype.Timestamptz{Time: now.UTC(), Valid: true},
+		ID:          id,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrSurveyAlreadyAnswered
+	}
+
+	return nil
+}
+
+// SurveySummary agrupa as pesquisas enviadas conforme o filtro; timezone é o
+// fuso usado para o início dos períodos.
+func (p *postgresSurveyRepository) SurveySummary(filter domains.SurveySummaryFilter, timezone string, ctx context.Context) ([]domains.SurveyTotals, error) {
+	rows, err := p.db.GetSatisfactionSurveySummaryQuery(ctx, pgstore.GetSatisfactionSurveySummaryQueryParams{
+		GroupBy:     filter.GroupBy,
+		Granularity: surveyGranularities[filter.Granularity],
+		Timezone:    timezone,
+		PeriodStart: pgtype.Timestamptz{Time: filter.From.UTC(), Valid: !filter.From.IsZero()},
+		PeriodEnd:   pgtype.Timestamptz{Time: filter.To.UTC(), Valid: !filter.To.IsZero()},
+		ClientID:    pgtype.UUID{Bytes: filter.ClientID, Valid: filter.ClientID != uuid.Nil},
+		MemberID:    pgtype.UUID{Bytes: filter.MemberID, Valid: filter.MemberID != uuid.Nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make([]domains.SurveyTotals, 0, len(rows))
+	for _, row := range rows {
+		totals = append(totals, domains.SurveyTotals{
+			ID:          uuid.UUID(row.GroupID.Bytes),
+			Name:        row.GroupName.String,
+			PeriodStart: row.PeriodStart.Time,
+			Sent:        int(row.Sent),
+			Answered:    int(row.Answered),
+			CSATSum:     int(row.CsatSum),
+			Satisfied:   int(row.Satisfied),
+			Promoters:   int(row.Promoters),
+			Passives:    int(row.Passives),
+			Detractors:  int(row.Detractors),
+		})
+	}
+
+	return totals, nil
+}
+
+func surveyFromRow(row pgstore.GetSatisfactionSurveyByFormQueryRow) *domains.SatisfactionSurvey {
+	return &domains.SatisfactionSurvey{
+		ID:           row.ID,
+		FormID:       row.FormID,
+		FormCode:     row.PublicCode,
+		ClientID:     row.ClientID,
+		ClientName:   row.ClientName,
+		SentTo:       row.SentTo.String,
+		SendAttempts: int(row.SendAttempts),
+		SentAt:       row.SentAt.Time,
+		ExpiresAt:    row.ExpiresAt.Time,
+		CSATScore:    int(row.CsatScore.Int16),
+		NPSScore:     int(row.NpsScore.Int16),
+		Comment:      row.Comment,
+		RespondedAt:  row.RespondedAt.Time,
+		CreatedAt:    row.CreatedAt,
+	}
+}
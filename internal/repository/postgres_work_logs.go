@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/store/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresWorkLogRepository struct {
+	db   *pgstore.Queries
+	pool *pgxpool.Pool
+}
+
+func NewPostgresWorkLogRepository(db *pgxpool.Pool) WorkLogRepository {
+	return &postgresWorkLogRepository{db: pgstore.New(db), pool: db}
+}
+
+// SaveWorkLog grava o apontamento se ele não se sobrepuser a outro do mesmo
+// técnico; a sobreposição vira ErrWorkLogOverlap. Os intervalos são fechados
+// no início e abertos no fim, então um apontamento pode começar exatamente
+// quando o anterior termina; cronômetros em andamento não têm fim. A linha do
+// técnico fica travada entre a conferência e o insert, então requisições
+// simultâneas não gravam períodos sobrepostos.
+func (p *postgresWorkLogRepository) SaveWorkLog(w *domains.WorkLog, ctx context.Context) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for SaveWorkLog: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := p.db.WithTx(tx)
+
+	if _, err := qtx.LockMembersQuery(ctx, []uuid.UUID{w.MemberID}); err != nil {
+		return err
+	}
+	overlapping, err := qtx.CountOverlappingWorkLogsQuery(ctx, pgstore.CountOverlappingWorkLogsQueryParams{
+		MemberID: pgtype.UUID{Bytes: w.MemberID, Valid: true},
+		ID:       w.ID,
+		NewEnd:   pgtype.Timestamptz{Time: w.EndedAt.UTC(), Valid: !w.EndedAt.IsZero()},
+		NewStart: w.StartedAt.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	if overlapping > 0 {
+		return domains.ErrWorkLogOverlap
+	}
+
+	row, err := qtx.CreateWorkLogQuery(ctx, pgstore.CreateWorkLogQueryParams{
+		FormID:    w.FormID,
+		MemberID:  pgtype.UUID{Bytes: w.MemberID, Valid: w.MemberID != uuid.Nil},
+		Kind:      w.Kind,
+		Source:    w.Source,
+		StartedAt: w.StartedAt.UTC(),
+		EndedAt:   pgtype.Timestamptz{Time: w.EndedAt.UTC(), Valid: !w.EndedAt.IsZero()},
+		Notes:     w.Notes,
+		CreatedBy: pgtype.UUID{Bytes: w.CreatedBy, Valid: w.CreatedBy != uuid.Nil},
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return domains.ErrWorkLogOverlap
+			case "23503":
+				if pgErr.ConstraintName == "form_work_logs_member_id_fkey" {
+					return domains.ErrMemberNotFound
+				}
+				return domains.ErrFormNotFound
+			}
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	w.ID = row.ID
+	w.CreatedAt = row.CreatedAt.UTC()
+	w.UpdatedAt = row.UpdatedAt.UTC()
+	return nil
+}
+func (p *postgresWorkLogRepository) FindWorkLog(formID, id uuid.UUID, ctx context.Context) (*domains.WorkLog, error) {
+	row, err := p.db.GetWorkLogByIdQuery(ctx, pgstore.GetWorkLogByIdQueryParams{
+		ID:     id,
+		FormID: formID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domains.ErrWorkLogNotFound
+		}
+		return nil, err
+	}
+
+	return &domains.WorkLog{
+		ID:         row.ID,
+		FormID:     row.FormID,
+		MemberID:   uuid.UUID(row.MemberID.Bytes),
+		MemberName: row.MemberName.String,
+		Kind:       row.Kind,
+		Source:     row.Source,
+		StartedAt:  row.StartedAt.UTC(),
+		EndedAt:    row.EndedAt.Time.UTC(),
+		Notes:      row.Notes,
+		CreatedBy:  uuid.UUID(row.CreatedBy.Bytes),
+		CreatedAt:  row.CreatedAt.UTC(),
+		UpdatedAt:  row.UpdatedAt.UTC(),
+	}, nil
+}
+func (p *postgresWorkLogRepository) ListWorkLogs(formID uuid.UUID, ctx context.Context) ([]*domains.WorkLog, error) {
+	rows, err := p.db.GetFormWorkLogsQuery(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*domains.WorkLog, 0, len(rows))
+	for _, row := range rows {
+		logs = append(logs, &domains.WorkLog{
+			ID:         row.ID,
+			FormID:     row.FormID,
+			MemberID:   uuid.UUID(row.MemberID.Bytes),
+			MemberName: row.MemberName.String,
+			Kind:       row.Kind,
+			Source:     row.Source,
+			StartedAt:  row.StartedAt.UTC(),
+			EndedAt:    row.EndedAt.Time.UTC(),
+			Notes:      row.Notes,
+			CreatedBy:  uuid.UUID(row.CreatedBy.Bytes),
+			CreatedAt:  row.CreatedAt.UTC(),
+			UpdatedAt:  row.UpdatedAt.UTC(),
+		})
+	}
+
+	return logs, nil
+}
+
+// StopWorkLog grava o fim do cronômetro; se ele já tiver sido encerrado por
+// outra requisição, o resultado é ErrWorkLogNotRunning.
+func (p *postgresWorkLogRepository) StopWorkLog(w *domains.WorkLog, ctx context.Context) error {
+	affected, err := p.db.StopWorkLogQuery(ctx, pgstore.StopWorkLogQueryParams{
+		EndedAt:   pgtype.Timestamptz{Time: w.EndedAt.UTC(), Valid: true},
+		Notes:     w.Notes,
+		UpdatedAt: w.UpdatedAt.UTC(),
+		ID:        w.ID,
+		FormID:    w.FormID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrWorkLogNotRunning
+	}
+	return nil
+}
+func (p *postgresWorkLogRepository) DeleteWorkLog(formID, id uuid.UUID, ctx context.Context) error {
+	affected, err := p.db.DeleteWorkLogQuery(ctx, pgstore.DeleteWorkLogQueryParams{
+		ID:     id,
+		FormID: formID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domains.ErrWorkLogNotFound
+	}
+	return nil
+}
+func (p *postgresWorkLogRepository) WorkLogTotals(filter domains.WorkLogTotalsFilter, ctx context.Context) ([]domains.WorkLogTotal, error) {
+	rows, err := p.db.GetWorkLogTotalsQuery(ctx, pgstore.GetWorkLogTotalsQueryParams{
+		GroupBy:     filter.GroupBy,
+		PeriodEnd:   pgtype.Timestamptz{Time: filter.To.UTC(), Valid: !filter.To.IsZero()},
+		PeriodStart: pgtype.Timestamptz{Time: filter.From.UTC(), Valid: !filter.From.IsZero()},
+		ClientID:    pgtype.UUID{Bytes: filter.ClientID, Valid: filter.ClientID != uuid.Nil},
+		MemberID:    pgtype.UUID{Bytes: filter.MemberID, Valid: filter.MemberID != uuid.Nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make([]domains.WorkLogTotal, 0, len(rows))
+	for _, row := range rows {
+		totals = append(totals, domains.WorkLogTotal{
+			ID:            uuid.UUID(row.GroupID.Bytes),
+			Name:          row.GroupName.String,
+			TravelSeconds: row.TravelSeconds,
+			OnSiteSeconds: row.OnSiteSeconds,
+		})
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal_RoundTrip(t *testing.T) {
+	l, err := NewLocal(t.TempDir(), "http://api.test", "segredo")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, l.Put(ctx, "forms/1/laudo.pdf", strings.NewReader("%PDF-1.4"), 8, "application/pdf"))
+
+	rc, err := l.Open(ctx, "forms/1/laudo.pdf")
+	require.NoError(t, err)
+	got, _ := io.ReadAll(rc)
+	rc.Close()
+	assert.Equal(t, "%PDF-1.4", string(got))
+
+	require.NoError(t, l.Delete(ctx, "forms/1/laudo.pdf"))
+	_, err = l.Open(ctx, "forms/1/laudo.pdf")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+	assert.NoError(t, l.Delete(ctx, "forms/1/laudo.pdf"))
+
+	for _, key := range []string{"../fora", "/abs", "forms//x", "forms/./x", `forms\x`} {
+		assert.ErrorIs(t, l.Put(ctx, key, strings.NewReader(""), 0, ""), ErrInvalidKey, key)
+	}
+}
+
+func TestLocal_SignedURL(t *testing.T) {
+	l, err := NewLocal(t.TempDir(), "http://api.test/", "segredo")
+	require.NoError(t, err)
+	require.NoError(t, l.Put(context.Background(), "forms/1/foto.png", strings.NewReader("png"), 3, "image/png"))
+
+	raw, err := l.SignedURL("forms/1/foto.png", "foto antes.png", time.Minute)
+	require.NoError(t, err)
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	assert.Equal(t, LocalRoutePrefix+"forms/1/foto.png", u.Path)
+
+	q := u.Query()
+	assert.NoError(t, l.Verify("forms/1/foto.png", q.Get("expires"), q.Get("name"), q.Get("signature"), time.Now()))
+	assert.ErrorIs(t, l.Verify("forms/1/foto.png", q.Get("expires"), q.Get("name"), q.Get("signature"), time.Now().Add(2*time.Minute)), ErrInvalidSignature)
+	assert.ErrorIs(t, l.Verify("forms/2/foto.png", q.Get("expires"), q.Get("name"), q.Get("signature"), time.Now()), ErrInvalidSignature)
+	assert.ErrorIs(t, l.Verify("forms/1/foto.png", q.Get("expires"), "outro.png", q.Get("signature"), time.Now()), ErrInvalidSignature)
+
+	rec := httptest.NewRecorder()
+	l.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "png", rec.Body.String())
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), "foto antes.png")
+
+	rec = httptest.NewRecorder()
+	l.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.Path+"?expires="+q.Get("expires"), nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -0,0 +1,81 @@
+// Package storage guarda os arquivos enviados pela API (anexos dos
+// atendimentos) fora do banco, em disco local ou em um bucket compatível com
+// S3, e gera URLs de download assinadas com validade.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	ErrObjectNotFound   = errors.New("storage: object not found")
+	ErrInvalidKey       = errors.New("storage: invalid object key")
+	ErrInvalidSignature = errors.New("storage: invalid or expired signature")
+)
+
+// Storage é implementado pelos backends de armazenamento. As chaves usam "/"
+// como separador e nunca começam com "/".
+type Storage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// SignedURL devolve uma URL de download válida por ttl; fileName é o nome
+	// sugerido ao navegador no Content-Disposition.
+	SignedURL(key, fileName string, ttl time.Duration) (string, error)
+}
+
+// Config seleciona e configura o backend.
+type Config struct {
+	Backend string // "local" ou "s3"
+
+	LocalDir   string
+	PublicURL  string // URL base da API, usada nas URLs assinadas do backend local
+	SigningKey string
+
+	S3Endpoint     string
+	S3Region       string
+	S3Bucket       string
+	S3AccessKey    string
+	S3SecretKey    string
+	S3UsePathStyle bool
+}
+
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// New cria o backend configurado.
+func New(cfg Config) (Storage, error) {
+	switch cfg.Backend {
+	case BackendLocal, "":
+		return NewLocal(cfg.LocalDir, cfg.PublicURL, cfg.SigningKey)
+	case BackendS3:
+		return NewS3(S3Options{
+			Endpoint:     cfg.S3Endpoint,
+			Region:       cfg.S3Region,
+			Bucket:       cfg.S3Bucket,
+			AccessKey:    cfg.S3AccessKey,
+			SecretKey:    cfg.S3SecretKey,
+			UsePathStyle: cfg.S3UsePathStyle,
+		})
+	}
+	return nil, errors.New("storage: unknown backend " + cfg.Backend)
+}
+
+// validKey rejeita chaves que poderiam escapar do diretório ou do bucket.
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return false
+		}
+	}
+	return true
+}
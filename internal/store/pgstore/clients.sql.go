@@ -32,9 +32,12 @@ INSERT INTO clients (
   number,
   complement,
   latitude,
-  longitude
+  longitude,
+
+  custom_fields,
+  tags
    )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 RETURNING id
 `
 
@@ -55,6 +58,8 @@ type CreateClientQueryParams struct {
 	Complement   pgtype.Text   `json:"complement"`
 	Latitude     pgtype.Float8 `json:"latitude"`
 	Longitude    pgtype.Float8 `json:"longitude"`
+	CustomFields []byte        `json:"custom_fields"`
+	Tags         []string      `json:"tags"`
 }
 
 func (q *Queries) CreateClientQuery(ctx context.Context, arg CreateClientQueryParams) (uuid.UUID, error) {
@@ -75,6 +80,8 @@ func (q *Queries) CreateClientQuery(ctx context.Context, arg CreateClientQueryPa
 		arg.Complement,
 		arg.Latitude,
 		arg.Longitude,
+		arg.CustomFields,
+		arg.Tags,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -82,8 +89,9 @@ func (q *Queries) CreateClientQuery(ctx context.Context, arg CreateClientQueryPa
 }
 
 const deleteClientQuery = `-- name: DeleteClientQuery :exec
-DELETE FROM clients
-WHERE id = $1
+UPDATE clients
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) DeleteClientQuery(ctx context.Context, id uuid.UUID) error {
@@ -113,12 +121,23 @@ SELECT
   latitude,
   longitude,
 
+  custom_fields,
+  tags,
+
   created_at,
   updated_at
 FROM clients
+WHERE deleted_at IS NULL
+  AND custom_fields @> $1::jsonb
+  AND tags @> $2::text[]
 ORDER BY id DESC
 `
 
+type GetAllClientsQueryParams struct {
+	CustomFields []byte   `json:"custom_fields"`
+	Tags         []string `json:"tags"`
+}
+
 type GetAllClientsQueryRow struct {
 	ID           uuid.UUID     `json:"id"`
 	Name         string        `json:"name"`
@@ -137,12 +156,14 @@ type GetAllClientsQueryRow struct {
 	Complement   pgtype.Text   `json:"complement"`
 	Latitude     pgtype.Float8 `json:"latitude"`
 	Longitude    pgtype.Float8 `json:"longitude"`
+	CustomFields []byte        `json:"custom_fields"`
+	Tags         []string      `json:"tags"`
 	CreatedAt    time.Time     `json:"created_at"`
 	UpdatedAt    time.Time     `json:"updated_at"`
 }
 
-func (q *Queries) GetAllClientsQuery(ctx context.Context) ([]GetAllClientsQueryRow, error) {
-	rows, err := q.db.Query(ctx, getAllClientsQuery)
+func (q *Queries) GetAllClientsQuery(ctx context.Context, arg GetAllClientsQueryParams) ([]GetAllClientsQueryRow, error) {
+	rows, err := q.db.Query(ctx, getAllClientsQuery, arg.CustomFields, arg.Tags)
 	if err != nil {
 		return nil, err
 	}
@@ -168,6 +189,8 @@ func (q *Queries) GetAllClientsQuery(ctx context.Context) ([]GetAllClientsQueryR
 			&i.Complement,
 			&i.Latitude,
 			&i.Longitude,
+			&i.CustomFields,
+			&i.Tags,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -202,10 +225,13 @@ SELECT
   latitude,
   longitude,
 
+  custom_fields,
+  tags,
+
   created_at,
   updated_at
 FROM clients
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 type GetClientByIdQueryRow struct {
@@ -226,6 +252,8 @@ type GetClientByIdQueryRow struct {
 	Complement   pgtype.Text   `json:"complement"`
 	Latitude     pgtype.Float8 `json:"latitude"`
 	Longitude    pgtype.Float8 `json:"longitude"`
+	CustomFields []byte        `json:"custom_fields"`
+	Tags         []string      `json:"tags"`
 	CreatedAt    time.Time     `json:"created_at"`
 	UpdatedAt    time.Time     `json:"updated_at"`
 }
@@ -251,12 +279,87 @@ func (q *Queries) GetClientByIdQuery(ctx context.Context, id uuid.UUID) (GetClie
 		&i.Complement,
 		&i.Latitude,
 		&i.Longitude,
+		&i.CustomFields,
+		&i.Tags,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const getDeletedClientsQuery = `-- name: GetDeletedClientsQuery :many
+SELECT
+  id,
+  name,
+  client_type,
+  cnpj_cpf,
+  deleted_at
+FROM clients
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC
+`
+
+type GetDeletedClientsQueryRow struct {
+	ID         uuid.UUID          `json:"id"`
+	Name       string             `json:"name"`
+	ClientType ClientType         `json:"client_type"`
+	CnpjCpf    pgtype.Text        `json:"cnpj_cpf"`
+	DeletedAt  pgtype.Timestamptz `json:"deleted_at"`
+}
+
+func (q *Queries) GetDeletedClientsQuery(ctx context.Context) ([]GetDeletedClientsQueryRow, error) {
+	rows, err := q.db.Query(ctx, getDeletedClientsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeletedClientsQueryRow
+	for rows.Next() {
+		var i GetDeletedClientsQueryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ClientType,
+			&i.CnpjCpf,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeClientQuery = `-- name: PurgeClientQuery :execrows
+DELETE FROM clients
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) PurgeClientQuery(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeClientQuery, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreClientQuery = `-- name: RestoreClientQuery :execrows
+UPDATE clients
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreClientQuery(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreClientQuery, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateClientQuery = `-- name: UpdateClientQuery :exec
 UPDATE clients
 SET
@@ -276,8 +379,10 @@ SET
   number = $13,
   complement = $14,
   latitude = $15,
-  longitude = $16
-WHERE id = $17
+  longitude = $16,
+  custom_fields = $17,
+  tags = $18
+WHERE id = $19
 `
 
 type UpdateClientQueryParams struct {
@@ -297,6 +402,8 @@ type UpdateClientQueryParams struct {
 	Complement   pgtype.Text   `json:"complement"`
 	Latitude     pgtype.Float8 `json:"latitude"`
 	Longitude    pgtype.Float8 `json:"longitude"`
+	CustomFields []byte        `json:"custom_fields"`
+	Tags         []string      `json:"tags"`
 	ID           uuid.UUID     `json:"id"`
 }
 
@@ -318,6 +425,8 @@ func (q *Queries) UpdateClientQuery(ctx context.Context, arg UpdateClientQueryPa
 		arg.Complement,
 		arg.Latitude,
 		arg.Longitude,
+		arg.CustomFields,
+		arg.Tags,
 		arg.ID,
 	)
 	return err
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
 )
 
 const createFormQuery = `-- name: CreateFormQuery :one
@@ -20,19 +21,45 @@ INSERT INTO forms (
     difficulty_level,
     defect_description,
     solution_description,
-    occurred_at
+    occurred_at,
+    contract_id,
+    hours_consumed,
+    custom_fields,
+    tags,
+    status,
+    response_due_at,
+    response_risk_at,
+    resolution_due_at,
+    resolution_risk_at,
+    public_code,
+    form_type_id,
+    form_type_version,
+    form_data
 )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 RETURNING id
 `
 
 type CreateFormQueryParams struct {
-	ClientID            uuid.UUID       `json:"client_id"`
-	SolicitedName       string          `json:"solicited_name"`
-	DifficultyLevel     DifficultyLevel `json:"difficulty_level"`
-	DefectDescription   pgtype.Text     `json:"defect_description"`
-	SolutionDescription pgtype.Text     `json:"solution_description"`
-	OccurredAt          time.Time       `json:"occurred_at"`
+	ClientID            uuid.UUID          `json:"client_id"`
+	SolicitedName       string             `json:"solicited_name"`
+	DifficultyLevel     DifficultyLevel    `json:"difficulty_level"`
+	DefectDescription   pgtype.Text        `json:"defect_description"`
+	SolutionDescription pgtype.Text        `json:"solution_description"`
+	OccurredAt          time.Time          `json:"occurred_at"`
+	ContractID          pgtype.UUID        `json:"contract_id"`
+	HoursConsumed       decimal.Decimal    `json:"hours_consumed"`
+	CustomFields        []byte             `json:"custom_fields"`
+	Tags                []string           `json:"tags"`
+	Status              string             `json:"status"`
+	ResponseDueAt       pgtype.Timestamptz `json:"response_due_at"`
+	ResponseRiskAt      pgtype.Timestamptz `json:"response_risk_at"`
+	ResolutionDueAt     pgtype.Timestamptz `json:"resolution_due_at"`
+	ResolutionRiskAt    pgtype.Timestamptz `json:"resolution_risk_at"`
+	PublicCode          string             `json:"public_code"`
+	FormTypeID          pgtype.UUID        `json:"form_type_id"`
+	FormTypeVersion     pgtype.Int4        `json:"form_type_version"`
+	FormData            []byte             `json:"form_data"`
 }
 
 func (q *Queries) CreateFormQuery(ctx context.Context, arg CreateFormQueryParams) (uuid.UUID, error) {
@@ -43,6 +70,19 @@ func (q *Queries) CreateFormQuery(ctx context.Context, arg CreateFormQueryParams
 		arg.DefectDescription,
 		arg.SolutionDescription,
 		arg.OccurredAt,
+		arg.ContractID,
+		arg.HoursConsumed,
+		arg.CustomFields,
+		arg.Tags,
+		arg.Status,
+		arg.ResponseDueAt,
+		arg.ResponseRiskAt,
+		arg.ResolutionDueAt,
+		arg.ResolutionRiskAt,
+		arg.PublicCode,
+		arg.FormTypeID,
+		arg.FormTypeVersion,
+		arg.FormData,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -55,8 +95,9 @@ type CreateFormTecnicoQueryParams struct {
 }
 
 const deleteFormQuery = `-- name: DeleteFormQuery :exec
-DELETE FROM forms
-WHERE id = $1
+UPDATE forms
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) DeleteFormQuery(ctx context.Context, id uuid.UUID) error {
@@ -64,9 +105,79 @@ func (q *Queries) DeleteFormQuery(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteFormTecnicosQuery = `-- name: DeleteFormTecnicosQuery :exec
+DELETE FROM form_tecnico
+WHERE form_id = $1
+  AND member_id = ANY($2::uuid[])
+`
+
+type DeleteFormTecnicosQueryParams struct {
+	FormID    uuid.UUID   `json:"form_id"`
+	MemberIds []uuid.UUID `json:"member_ids"`
+}
+
+func (q *Queries) DeleteFormTecnicosQuery(ctx context.Context, arg DeleteFormTecnicosQueryParams) error {
+	_, err := q.db.Exec(ctx, deleteFormTecnicosQuery, arg.FormID, arg.MemberIds)
+	return err
+}
+
+const getDeletedFormsQuery = `-- name: GetDeletedFormsQuery :many
+SELECT
+    f.id,
+    f.public_code,
+    f.client_id,
+    c.name as client_name,
+    f.occurred_at,
+    f.solicited_name,
+    f.deleted_at
+FROM forms f
+JOIN clients c ON f.client_id = c.id
+WHERE f.deleted_at IS NOT NULL
+ORDER BY f.deleted_at DESC
+`
+
+type GetDeletedFormsQueryRow struct {
+	ID            uuid.UUID          `json:"id"`
+	PublicCode    string             `json:"public_code"`
+	ClientID      uuid.UUID          `json:"client_id"`
+	ClientName    string             `json:"client_name"`
+	OccurredAt    time.Time          `json:"occurred_at"`
+	SolicitedName string             `json:"solicited_name"`
+	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
+}
+
+func (q *Queries) GetDeletedFormsQuery(ctx context.Context) ([]GetDeletedFormsQueryRow, error) {
+	rows, err := q.db.Query(ctx, getDeletedFormsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeletedFormsQueryRow
+	for rows.Next() {
+		var i GetDeletedFormsQueryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PublicCode,
+			&i.ClientID,
+			&i.ClientName,
+			&i.OccurredAt,
+			&i.SolicitedName,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getFormByIdQuery = `-- name: GetFormByIdQuery :one
 SELECT
     f.id,
+    f.public_code,
     f.client_id,
     c.name as client_name,
     f.occurred_at,
@@ -74,15 +185,31 @@ SELECT
     f.difficulty_level,
     f.defect_description,
     f.solution_description,
+    f.contract_id,
+    f.hours_consumed,
+    f.custom_fields,
+    f.tags,
+    f.form_type_id,
+    f.form_type_version,
+    f.form_data,
+    f.status,
+    f.response_due_at,
+    f.response_risk_at,
+    f.resolution_due_at,
+    f.resolution_risk_at,
+    f.responded_at,
+    f.resolved_at,
+    EXISTS (SELECT 1 FROM form_signatures s WHERE s.form_id = f.id) AS signed,
     f.created_at,
     f.updated_at
 FROM forms f
 JOIN clients c ON f.client_id = c.id
-WHERE f.id = $1
+WHERE f.id = $1 AND f.deleted_at IS NULL
 `
 
 type GetFormByIdQueryRow struct {
 	ID                  uuid.UUID          `json:"id"`
+	PublicCode          string             `json:"public_code"`
 	ClientID            uuid.UUID          `json:"client_id"`
 	ClientName          string             `json:"client_name"`
 	OccurredAt          time.Time          `json:"occurred_at"`
@@ -90,6 +217,21 @@ type GetFormByIdQueryRow struct {
 	DifficultyLevel     DifficultyLevel    `json:"difficulty_level"`
 	DefectDescription   pgtype.Text        `json:"defect_description"`
 	SolutionDescription pgtype.Text        `json:"solution_description"`
+	ContractID          pgtype.UUID        `json:"contract_id"`
+	HoursConsumed       decimal.Decimal    `json:"hours_consumed"`
+	CustomFields        []byte             `json:"custom_fields"`
+	Tags                []string           `json:"tags"`
+	FormTypeID          pgtype.UUID        `json:"form_type_id"`
+	FormTypeVersion     pgtype.Int4        `json:"form_type_version"`
+	FormData            []byte             `json:"form_data"`
+	Status              string             `json:"status"`
+	ResponseDueAt       pgtype.Timestamptz `json:"response_due_at"`
+	ResponseRiskAt      pgtype.Timestamptz `json:"response_risk_at"`
+	ResolutionDueAt     pgtype.Timestamptz `json:"resolution_due_at"`
+	ResolutionRiskAt    pgtype.Timestamptz `json:"resolution_risk_at"`
+	RespondedAt         pgtype.Timestamptz `json:"responded_at"`
+	ResolvedAt          pgtype.Timestamptz `json:"resolved_at"`
+	Signed              bool               `json:"signed"`
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
 }
@@ -99,6 +241,7 @@ func (q *Queries) GetFormByIdQuery(ctx context.Context, id uuid.UUID) (GetFormBy
 	var i GetFormByIdQueryRow
 	err := row.Scan(
 		&i.ID,
+		&i.PublicCode,
 		&i.ClientID,
 		&i.ClientName,
 		&i.OccurredAt,
@@ -106,12 +249,40 @@ func (q *Queries) GetFormByIdQuery(ctx context.Context, id uuid.UUID) (GetFormBy
 		&i.DifficultyLevel,
 		&i.DefectDescription,
 		&i.SolutionDescription,
+		&i.ContractID,
+		&i.HoursConsumed,
+		&i.CustomFields,
+		&i.Tags,
+		&i.FormTypeID,
+		&i.FormTypeVersion,
+		&i.FormData,
+		&i.Status,
+		&i.ResponseDueAt,
+		&i.ResponseRiskAt,
+		&i.ResolutionDueAt,
+		&i.ResolutionRiskAt,
+		&i.RespondedAt,
+		&i.ResolvedAt,
+		&i.Signed,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const getFormIdByPublicCodeQuery = `-- name: GetFormIdByPublicCodeQuery :one
+SELECT id
+FROM forms
+WHERE public_code = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetFormIdByPublicCodeQuery(ctx context.Context, publicCode string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getFormIdByPublicCodeQuery, publicCode)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getFormTecnicosByFormID = `-- name: GetFormTecnicosByFormID :many
 SELECT
     form_tecnico.id,
@@ -166,9 +337,64 @@ func (q *Queries) GetFormTecnicosByFormID(ctx context.Context, formID uuid.UUID)
 	return items, nil
 }
 
+const getFormTecnicosByFormIDs = `-- name: GetFormTecnicosByFormIDs :many
+SELECT
+    form_tecnico.id,
+    form_tecnico.member_id,
+    form_tecnico.form_id,
+    form_tecnico.created_at,
+    form_tecnico.updated_at,
+    users.username AS user_name,
+    users.email AS user_email
+FROM form_tecnico
+JOIN members ON form_tecnico.member_id = members.id
+JOIN users ON members.user_id = users.id
+WHERE form_tecnico.form_id = ANY($1::uuid[])
+ORDER BY form_tecnico.form_id ASC, form_tecnico.id ASC
+`
+
+type GetFormTecnicosByFormIDsRow struct {
+	ID        uuid.UUID          `json:"id"`
+	MemberID  uuid.UUID          `json:"member_id"`
+	FormID    uuid.UUID          `json:"form_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	UserName  string             `json:"user_name"`
+	UserEmail string             `json:"user_email"`
+}
+
+func (q *Queries) GetFormTecnicosByFormIDs(ctx context.Context, formIds []uuid.UUID) ([]GetFormTecnicosByFormIDsRow, error) {
+	rows, err := q.db.Query(ctx, getFormTecnicosByFormIDs, formIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFormTecnicosByFormIDsRow
+	for rows.Next() {
+		var i GetFormTecnicosByFormIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MemberID,
+			&i.FormID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserName,
+			&i.UserEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getFormsQuery = `-- name: GetFormsQuery :many
 SELECT
     f.id,
+    f.public_code,
     f.client_id,
     c.name as client_name,
     f.occurred_at,
@@ -176,15 +402,59 @@ SELECT
     f.difficulty_level,
     f.defect_description,
     f.solution_description,
+    f.contract_id,
+    f.hours_consumed,
+    f.custom_fields,
+    f.tags,
+    f.form_type_id,
+    f.form_type_version,
+    f.form_data,
+    f.status,
+    f.response_due_at,
+    f.response_risk_at,
+    f.resolution_due_at,
+    f.resolution_risk_at,
+    f.responded_at,
+    f.resolved_at,
     f.created_at,
     f.updated_at
 FROM forms f
 JOIN clients c ON f.client_id = c.id
+WHERE f.deleted_at IS NULL
+  AND f.custom_fields @> $1::jsonb
+  AND f.tags @> $2::text[]
+  AND ($3::uuid IS NULL OR f.client_id = $3::uuid)
+  AND ($4::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM form_tecnico ft
+        WHERE ft.form_id = f.id AND ft.member_id = $4::uuid
+      ))
+  AND ($5::difficulty_level IS NULL OR f.difficulty_level = $5::difficulty_level)
+  AND ($6::text IS NULL OR f.status = $6::text)
+  AND ($7::timestamptz IS NULL OR f.occurred_at >= $7::timestamptz)
+  AND ($8::timestamptz IS NULL OR f.occurred_at <= $8::timestamptz)
+  AND ($9::text IS NULL OR f.public_code LIKE '%' || $9::text || '%')
+  AND ($10::uuid IS NULL OR f.id > $10::uuid)
 ORDER BY f.id ASC
+LIMIT $11
 `
 
+type GetFormsQueryParams struct {
+	CustomFields    []byte              `json:"custom_fields"`
+	Tags            []string            `json:"tags"`
+	ClientID        pgtype.UUID         `json:"client_id"`
+	TecnicoID       pgtype.UUID         `json:"tecnico_id"`
+	DifficultyLevel NullDifficultyLevel `json:"difficulty_level"`
+	Status          pgtype.Text         `json:"status"`
+	OccurredFrom    pgtype.Timestamptz  `json:"occurred_from"`
+	OccurredTo      pgtype.Timestamptz  `json:"occurred_to"`
+	PublicCode      pgtype.Text         `json:"public_code"`
+	AfterID         pgtype.UUID         `json:"after_id"`
+	PageSize        pgtype.Int4         `json:"page_size"`
+}
+
 type GetFormsQueryRow struct {
 	ID                  uuid.UUID          `json:"id"`
+	PublicCode          string             `json:"public_code"`
 	ClientID            uuid.UUID          `json:"client_id"`
 	ClientName          string             `json:"client_name"`
 	OccurredAt          time.Time          `json:"occurred_at"`
@@ -192,12 +462,38 @@ type GetFormsQueryRow struct {
 	DifficultyLevel     DifficultyLevel    `json:"difficulty_level"`
 	DefectDescription   pgtype.Text        `json:"defect_description"`
 	SolutionDescription pgtype.Text        `json:"solution_description"`
+	ContractID          pgtype.UUID        `json:"contract_id"`
+	HoursConsumed       decimal.Decimal    `json:"hours_consumed"`
+	CustomFields        []byte             `json:"custom_fields"`
+	Tags                []string           `json:"tags"`
+	FormTypeID          pgtype.UUID        `json:"form_type_id"`
+	FormTypeVersion     pgtype.Int4        `json:"form_type_version"`
+	FormData            []byte             `json:"form_data"`
+	Status              string             `json:"status"`
+	ResponseDueAt       pgtype.Timestamptz `json:"response_due_at"`
+	ResponseRiskAt      pgtype.Timestamptz `json:"response_risk_at"`
+	ResolutionDueAt     pgtype.Timestamptz `json:"resolution_due_at"`
+	ResolutionRiskAt    pgtype.Timestamptz `json:"resolution_risk_at"`
+	RespondedAt         pgtype.Timestamptz `json:"responded_at"`
+	ResolvedAt          pgtype.Timestamptz `json:"resolved_at"`
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
 }
 
-func (q *Queries) GetFormsQuery(ctx context.Context) ([]GetFormsQueryRow, error) {
-	rows, err := q.db.Query(ctx, getFormsQuery)
+func (q *Queries) GetFormsQuery(ctx context.Context, arg GetFormsQueryParams) ([]GetFormsQueryRow, error) {
+	rows, err := q.db.Query(ctx, getFormsQuery,
+		arg.CustomFields,
+		arg.Tags,
+		arg.ClientID,
+		arg.TecnicoID,
+		arg.DifficultyLevel,
+		arg.Status,
+		arg.OccurredFrom,
+		arg.OccurredTo,
+		arg.PublicCode,
+		arg.AfterID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +503,212 @@ func (q *Queries) GetFormsQuery(ctx context.Context) ([]GetFormsQueryRow, error)
 		var i GetFormsQueryRow
 		if err := rows.Scan(
 			&i.ID,
+			&i.PublicCode,
+			&i.ClientID,
+			&i.ClientName,
+			&i.OccurredAt,
+			&i.SolicitedName,
+			&i.DifficultyLevel,
+			&i.DefectDescription,
+			&i.SolutionDescription,
+			&i.ContractID,
+			&i.HoursConsumed,
+			&i.CustomFields,
+			&i.Tags,
+			&i.FormTypeID,
+			&i.FormTypeVersion,
+			&i.FormData,
+			&i.Status,
+			&i.ResponseDueAt,
+			&i.ResponseRiskAt,
+			&i.ResolutionDueAt,
+			&i.ResolutionRiskAt,
+			&i.RespondedAt,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nextFormCodeQuery = `-- name: NextFormCodeQuery :one
+INSERT INTO form_code_sequences (year, last_number)
+VALUES ($1, 1)
+ON CONFLICT (year) DO UPDATE SET last_number = form_code_sequences.last_number + 1
+RETURNING last_number
+`
+
+// Incrementa e devolve o próximo número de protocolo do ano, criando a linha
+// do ano no primeiro atendimento. A linha fica travada até o fim da
+// transação, serializando as gravações do mesmo ano.
+func (q *Queries) NextFormCodeQuery(ctx context.Context, year int32) (int64, error) {
+	row := q.db.QueryRow(ctx, nextFormCodeQuery, year)
+	var last_number int64
+	err := row.Scan(&last_number)
+	return last_number, err
+}
+
+const purgeFormQuery = `-- name: PurgeFormQuery :execrows
+DELETE FROM forms
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) PurgeFormQuery(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeFormQuery, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreFormQuery = `-- name: RestoreFormQuery :execrows
+UPDATE forms
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreFormQuery(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreFormQuery, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const searchFormsQuery = `-- name: SearchFormsQuery :many
+SELECT
+    f.id,
+    f.public_code,
+    f.client_id,
+    c.name as client_name,
+    f.occurred_at,
+    f.solicited_name,
+    f.difficulty_level,
+    f.defect_description,
+    f.solution_description,
+    f.contract_id,
+    f.hours_consumed,
+    f.custom_fields,
+    f.tags,
+    f.form_type_id,
+    f.form_type_version,
+    f.form_data,
+    f.status,
+    f.response_due_at,
+    f.response_risk_at,
+    f.resolution_due_at,
+    f.resolution_risk_at,
+    f.responded_at,
+    f.resolved_at,
+    f.created_at,
+    f.updated_at,
+    ts_rank_cd(f.search_vector, query)::float8 AS rank,
+    ts_headline('portuguese_unaccent', translate(COALESCE(f.defect_description, ''), E'\uE000\uE001', ''), query, E'StartSel=\uE000, StopSel=\uE001, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" … "')::text AS defect_snippet,
+    ts_headline('portuguese_unaccent', translate(COALESCE(f.solution_description, ''), E'\uE000\uE001', ''), query, E'StartSel=\uE000, StopSel=\uE001, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" … "')::text AS solution_snippet,
+    COUNT(*) OVER ()::bigint AS total
+FROM forms f
+JOIN clients c ON f.client_id = c.id
+CROSS JOIN websearch_to_tsquery('portuguese_unaccent', $1::text) AS query
+WHERE f.deleted_at IS NULL
+  AND f.search_vector @@ query
+  AND f.custom_fields @> $2::jsonb
+  AND f.tags @> $3::text[]
+  AND ($4::uuid IS NULL OR f.client_id = $4::uuid)
+  AND ($5::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM form_tecnico ft
+        WHERE ft.form_id = f.id AND ft.member_id = $5::uuid
+      ))
+  AND ($6::difficulty_level IS NULL OR f.difficulty_level = $6::difficulty_level)
+  AND ($7::text IS NULL OR f.status = $7::text)
+  AND ($8::timestamptz IS NULL OR f.occurred_at >= $8::timestamptz)
+  AND ($9::timestamptz IS NULL OR f.occurred_at <= $9::timestamptz)
+  AND ($10::text IS NULL OR f.public_code LIKE '%' || $10::text || '%')
+ORDER BY rank DESC, f.occurred_at DESC, f.id ASC
+LIMIT $11
+OFFSET $12
+`
+
+type SearchFormsQueryParams struct {
+	Query           string              `json:"query"`
+	CustomFields    []byte              `json:"custom_fields"`
+	Tags            []string            `json:"tags"`
+	ClientID        pgtype.UUID         `json:"client_id"`
+	TecnicoID       pgtype.UUID         `json:"tecnico_id"`
+	DifficultyLevel NullDifficultyLevel `json:"difficulty_level"`
+	Status          pgtype.Text         `json:"status"`
+	OccurredFrom    pgtype.Timestamptz  `json:"occurred_from"`
+	OccurredTo      pgtype.Timestamptz  `json:"occurred_to"`
+	PublicCode      pgtype.Text         `json:"public_code"`
+	PageSize        int32               `json:"page_size"`
+	PageOffset      int32               `json:"page_offset"`
+}
+
+type SearchFormsQueryRow struct {
+	ID                  uuid.UUID          `json:"id"`
+	PublicCode          string             `json:"public_code"`
+	ClientID            uuid.UUID          `json:"client_id"`
+	ClientName          string             `json:"client_name"`
+	OccurredAt          time.Time          `json:"occurred_at"`
+	SolicitedName       string             `json:"solicited_name"`
+	DifficultyLevel     DifficultyLevel    `json:"difficulty_level"`
+	DefectDescription   pgtype.Text        `json:"defect_description"`
+	SolutionDescription pgtype.Text        `json:"solution_description"`
+	ContractID          pgtype.UUID        `json:"contract_id"`
+	HoursConsumed       decimal.Decimal    `json:"hours_consumed"`
+	CustomFields        []byte             `json:"custom_fields"`
+	Tags                []string           `json:"tags"`
+	FormTypeID          pgtype.UUID        `json:"form_type_id"`
+	FormTypeVersion     pgtype.Int4        `json:"form_type_version"`
+	FormData            []byte             `json:"form_data"`
+	Status              string             `json:"status"`
+	ResponseDueAt       pgtype.Timestamptz `json:"response_due_at"`
+	ResponseRiskAt      pgtype.Timestamptz `json:"response_risk_at"`
+	ResolutionDueAt     pgtype.Timestamptz `json:"resolution_due_at"`
+	ResolutionRiskAt    pgtype.Timestamptz `json:"resolution_risk_at"`
+	RespondedAt         pgtype.Timestamptz `json:"responded_at"`
+	ResolvedAt          pgtype.Timestamptz `json:"resolved_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	Rank                float64            `json:"rank"`
+	DefectSnippet       string             `json:"defect_snippet"`
+	SolutionSnippet     string             `json:"solution_snippet"`
+	Total               int64              `json:"total"`
+}
+
+// Busca textual ordenada pela relevância. Os trechos destacam os termos
+// encontrados e total conta todos os resultados, não só os da página.
+func (q *Queries) SearchFormsQuery(ctx context.Context, arg SearchFormsQueryParams) ([]SearchFormsQueryRow, error) {
+	rows, err := q.db.Query(ctx, searchFormsQuery,
+		arg.Query,
+		arg.CustomFields,
+		arg.Tags,
+		arg.ClientID,
+		arg.TecnicoID,
+		arg.DifficultyLevel,
+		arg.Status,
+		arg.OccurredFrom,
+		arg.OccurredTo,
+		arg.PublicCode,
+		arg.PageSize,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchFormsQueryRow
+	for rows.Next() {
+		var i SearchFormsQueryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PublicCode,
 			&i.ClientID,
 			&i.ClientName,
 			&i.OccurredAt,
@@ -214,8 +716,26 @@ func (q *Queries) GetFormsQuery(ctx context.Context) ([]GetFormsQueryRow, error)
 			&i.DifficultyLevel,
 			&i.DefectDescription,
 			&i.SolutionDescription,
+			&i.ContractID,
+			&i.HoursConsumed,
+			&i.CustomFields,
+			&i.Tags,
+			&i.FormTypeID,
+			&i.FormTypeVersion,
+			&i.FormData,
+			&i.Status,
+			&i.ResponseDueAt,
+			&i.ResponseRiskAt,
+			&i.ResolutionDueAt,
+			&i.ResolutionRiskAt,
+			&i.RespondedAt,
+			&i.ResolvedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Rank,
+			&i.DefectSnippet,
+			&i.SolutionSnippet,
+			&i.Total,
 		); err != nil {
 			return nil, err
 		}
@@ -234,17 +754,35 @@ SET client_id = $1,
     difficulty_level = $3,
     defect_description = $4,
     solution_description = $5,
+    hours_consumed = $6,
+    custom_fields = $7,
+    tags = $8,
+    response_due_at = $10,
+    response_risk_at = $11,
+    resolution_due_at = $12,
+    resolution_risk_at = $13,
+    form_data = $14,
+    contract_id = $15,
     updated_at = NOW()
-WHERE id = $6
+WHERE id = $9 AND deleted_at IS NULL
 `
 
 type UpdateFormQueryParams struct {
-	ClientID            uuid.UUID       `json:"client_id"`
-	SolicitedName       string          `json:"solicited_name"`
-	DifficultyLevel     DifficultyLevel `json:"difficulty_level"`
-	DefectDescription   pgtype.Text     `json:"defect_description"`
-	SolutionDescription pgtype.Text     `json:"solution_description"`
-	ID                  uuid.UUID       `json:"id"`
+	ClientID            uuid.UUID          `json:"client_id"`
+	SolicitedName       string             `json:"solicited_name"`
+	DifficultyLevel     DifficultyLevel    `json:"difficulty_level"`
+	DefectDescription   pgtype.Text        `json:"defect_description"`
+	SolutionDescription pgtype.Text        `json:"solution_description"`
+	HoursConsumed       decimal.Decimal    `json:"hours_consumed"`
+	CustomFields        []byte             `json:"custom_fields"`
+	Tags                []string           `json:"tags"`
+	ID                  uuid.UUID          `json:"id"`
+	ResponseDueAt       pgtype.Timestamptz `json:"response_due_at"`
+	ResponseRiskAt      pgtype.Timestamptz `json:"response_risk_at"`
+	ResolutionDueAt     pgtype.Timestamptz `json:"resolution_due_at"`
+	ResolutionRiskAt    pgtype.Timestamptz `json:"resolution_risk_at"`
+	FormData            []byte             `json:"form_data"`
+	ContractID          pgtype.UUID        `json:"contract_id"`
 }
 
 func (q *Queries) UpdateFormQuery(ctx context.Context, arg UpdateFormQueryParams) error {
@@ -254,7 +792,52 @@ func (q *Queries) UpdateFormQuery(ctx context.Context, arg UpdateFormQueryParams
 		arg.DifficultyLevel,
 		arg.DefectDescription,
 		arg.SolutionDescription,
+		arg.HoursConsumed,
+		arg.CustomFields,
+		arg.Tags,
 		arg.ID,
+		arg.ResponseDueAt,
+		arg.ResponseRiskAt,
+		arg.ResolutionDueAt,
+		arg.ResolutionRiskAt,
+		arg.FormData,
+		arg.ContractID,
 	)
 	return err
 }
+
+const updateFormStatusQuery = `-- name: UpdateFormStatusQuery :execrows
+UPDATE forms
+SET status = $1,
+    solution_description = $2,
+    responded_at = $3,
+    resolved_at = $4,
+    updated_at = NOW()
+WHERE id = $5
+  AND status = $6
+  AND deleted_at IS NULL
+`
+
+type UpdateFormStatusQueryParams struct {
+	Status              string             `json:"status"`
+	SolutionDescription pgtype.Text        `json:"solution_description"`
+	RespondedAt         pgtype.Timestamptz `json:"responded_at"`
+	ResolvedAt          pgtype.Timestamptz `json:"resolved_at"`
+	ID                  uuid.UUID          `json:"id"`
+	FromStatus          string             `json:"from_status"`
+}
+
+func (q *Queries) UpdateFormStatusQuery(ctx context.Context, arg UpdateFormStatusQueryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateFormStatusQuery,
+		arg.Status,
+		arg.SolutionDescription,
+		arg.RespondedAt,
+		arg.ResolvedAt,
+		arg.ID,
+		arg.FromStatus,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
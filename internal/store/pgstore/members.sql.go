@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createMemberQuery = `-- name: CreateMemberQuery :exec
@@ -26,6 +27,35 @@ func (q *Queries) CreateMemberQuery(ctx context.Context, arg CreateMemberQueryPa
 	return err
 }
 
+const getMemberByIdQuery = `-- name: GetMemberByIdQuery :one
+SELECT
+    id,
+    user_id,
+    role,
+    is_active
+FROM members
+WHERE id = $1
+`
+
+type GetMemberByIdQueryRow struct {
+	ID       uuid.UUID  `json:"id"`
+	UserID   uuid.UUID  `json:"user_id"`
+	Role     MemberRole `json:"role"`
+	IsActive bool       `json:"is_active"`
+}
+
+func (q *Queries) GetMemberByIdQuery(ctx context.Context, id uuid.UUID) (GetMemberByIdQueryRow, error) {
+	row := q.db.QueryRow(ctx, getMemberByIdQuery, id)
+	var i GetMemberByIdQueryRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Role,
+		&i.IsActive,
+	)
+	return i, err
+}
+
 const getMemberQuery = `-- name: GetMemberQuery :many
 SELECT
     m.id,
@@ -67,3 +97,53 @@ func (q *Queries) GetMemberQuery(ctx context.Context) ([]GetMemberQueryRow, erro
 	}
 	return items, nil
 }
+
+const updateMemberCurrentLocationQuery = `-- name: UpdateMemberCurrentLocationQuery :execrows
+UPDATE members
+SET current_latitude = $1,
+    current_longitude = $2,
+    current_located_at = $3
+WHERE id = $4
+`
+
+type UpdateMemberCurrentLocationQueryParams struct {
+	CurrentLatitude  pgtype.Float8      `json:"current_latitude"`
+	CurrentLongitude pgtype.Float8      `json:"current_longitude"`
+	CurrentLocatedAt pgtype.Timestamptz `json:"current_located_at"`
+	ID               uuid.UUID          `json:"id"`
+}
+
+func (q *Queries) UpdateMemberCurrentLocationQuery(ctx context.Context, arg UpdateMemberCurrentLocationQueryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateMemberCurrentLocationQuery,
+		arg.CurrentLatitude,
+		arg.CurrentLongitude,
+		arg.CurrentLocatedAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateMemberHomeLocationQuery = `-- name: UpdateMemberHomeLocationQuery :execrows
+UPDATE members
+SET home_latitude = $1,
+    home_longitude = $2,
+    updated_at = NOW()
+WHERE id = $3
+`
+
+type UpdateMemberHomeLocationQueryParams struct {
+	HomeLatitude  pgtype.Float8 `json:"home_latitude"`
+	HomeLongitude pgtype.Float8 `json:"home_longitude"`
+	ID            uuid.UUID     `json:"id"`
+}
+
+func (q *Queries) UpdateMemberHomeLocationQuery(ctx context.Context, arg UpdateMemberHomeLocationQueryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateMemberHomeLocationQuery, arg.HomeLatitude, arg.HomeLongitude, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: contracts
+-- Descrição: Contratos de serviço dos clientes do tipo "contrato" (horas e SLA)
+-- Relacionamento: N:1 com clients
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS contracts (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    client_id UUID NOT NULL REFERENCES clients(id),
+
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+
+    monthly_hours NUMERIC(6, 2) NOT NULL,
+    covered_services TEXT[] NOT NULL DEFAULT '{}',
+
+    response_sla_hours INTEGER NOT NULL,
+    resolution_sla_hours INTEGER NOT NULL,
+
+    monthly_price NUMERIC(12, 2) NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT contracts_dates_order CHECK (end_date >= start_date),
+    CONSTRAINT contracts_monthly_hours_positive CHECK (monthly_hours > 0),
+    CONSTRAINT contracts_response_sla_positive CHECK (response_sla_hours > 0),
+    CONSTRAINT contracts_resolution_sla_order CHECK (resolution_sla_hours >= response_sla_hours),
+    CONSTRAINT contracts_monthly_price_positive CHECK (monthly_price >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_contracts_client_id ON contracts(client_id);
+CREATE INDEX IF NOT EXISTS idx_contracts_period ON contracts(client_id, start_date, end_date);
+CREATE INDEX IF NOT EXISTS idx_contracts_end_date ON contracts(end_date);
+
+COMMENT ON TABLE contracts IS 'Contratos de serviço dos clientes do tipo contrato';
+COMMENT ON COLUMN contracts.id IS 'Identificador único do contrato (UUID)';
+COMMENT ON COLUMN contracts.client_id IS 'Referência ao cliente contratante';
+COMMENT ON COLUMN contracts.start_date IS 'Data de início da vigência (inclusiva)';
+COMMENT ON COLUMN contracts.end_date IS 'Data de término da vigência (inclusiva)';
+COMMENT ON COLUMN contracts.monthly_hours IS 'Franquia de horas por período de faturamento (mês)';
+COMMENT ON COLUMN contracts.covered_services IS 'Serviços cobertos pelo contrato';
+COMMENT ON COLUMN contracts.response_sla_hours IS 'Meta de SLA para primeira resposta (horas)';
+COMMENT ON COLUMN contracts.resolution_sla_hours IS 'Meta de SLA para resolução (horas)';
+COMMENT ON COLUMN contracts.monthly_price IS 'Valor mensal do contrato';
+COMMENT ON COLUMN contracts.created_at IS 'Data e hora de criação do registro';
+COMMENT ON COLUMN contracts.updated_at IS 'Data e hora da última atualização';
+
+ALTER TABLE forms
+    ADD COLUMN IF NOT EXISTS contract_id UUID REFERENCES contracts(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS hours_consumed NUMERIC(6, 2) NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_forms_contract_id ON forms(contract_id, occurred_at) WHERE contract_id IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_forms_contract_id;
+ALTER TABLE forms
+    DROP COLUMN IF EXISTS hours_consumed,
+    DROP COLUMN IF EXISTS contract_id;
+DROP TABLE IF EXISTS contracts;
+-- +goose StatementEnd
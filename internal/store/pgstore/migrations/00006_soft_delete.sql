@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Alteração: clients, forms
+-- Descrição: Exclusão lógica (lixeira) de clientes e atendimentos
+-- Versão: 1.0
+-- ============================================================================
+
+ALTER TABLE clients ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE forms ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_clients_deleted_at ON clients(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_forms_deleted_at ON forms(deleted_at) WHERE deleted_at IS NOT NULL;
+
+COMMENT ON COLUMN clients.deleted_at IS 'Data e hora da exclusão lógica (NULL = ativo)';
+COMMENT ON COLUMN forms.deleted_at IS 'Data e hora da exclusão lógica (NULL = ativo)';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_forms_deleted_at;
+DROP INDEX IF EXISTS idx_clients_deleted_at;
+ALTER TABLE forms DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE clients DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: client_merges
+-- Descrição: Histórico de unificação de clientes duplicados
+-- Relacionamento: N:1 com clients (origem e destino), N:1 com users
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS client_merges (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    source_client_id UUID NOT NULL REFERENCES clients(id) ON DELETE CASCADE,
+    target_client_id UUID NOT NULL REFERENCES clients(id) ON DELETE CASCADE,
+    source_snapshot JSONB NOT NULL,
+
+    forms_moved INT NOT NULL DEFAULT 0,
+    contracts_moved INT NOT NULL DEFAULT 0,
+
+    merged_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    merged_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT client_merges_distinct_clients CHECK (source_client_id <> target_client_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_client_merges_target ON client_merges(target_client_id);
+CREATE INDEX IF NOT EXISTS idx_client_merges_source ON client_merges(source_client_id);
+
+COMMENT ON TABLE client_merges IS 'Histórico de unificação de clientes duplicados';
+COMMENT ON COLUMN client_merges.source_client_id IS 'Cliente duplicado, arquivado após a unificação';
+COMMENT ON COLUMN client_merges.target_client_id IS 'Cliente que permanece e recebe os registros';
+COMMENT ON COLUMN client_merges.source_snapshot IS 'Dados do cliente duplicado no momento da unificação';
+COMMENT ON COLUMN client_merges.forms_moved IS 'Quantidade de atendimentos transferidos';
+COMMENT ON COLUMN client_merges.contracts_moved IS 'Quantidade de contratos transferidos';
+COMMENT ON COLUMN client_merges.merged_by IS 'Usuário que realizou a unificação';
+COMMENT ON COLUMN client_merges.merged_at IS 'Data e hora da unificação';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS client_merges;
+-- +goose StatementEnd
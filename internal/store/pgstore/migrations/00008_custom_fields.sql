@@ -0,0 +1,75 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: custom_field_definitions
+-- Descrição: Campos personalizados definidos pelo administrador para clientes
+--            e atendimentos; valores ficam em JSONB nas próprias tabelas
+-- Alteração: clients, forms (custom_fields, tags)
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS custom_field_definitions (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    entity TEXT NOT NULL,
+    field_key TEXT NOT NULL,
+    label TEXT NOT NULL,
+    field_type TEXT NOT NULL,
+
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    rules JSONB NOT NULL DEFAULT '{}',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT custom_field_definitions_entity_check CHECK (entity IN ('cliente', 'atendimento')),
+    CONSTRAINT custom_field_definitions_type_check CHECK (field_type IN ('texto', 'numero', 'data', 'selecao', 'booleano')),
+    CONSTRAINT custom_field_definitions_key_unique UNIQUE (entity, field_key)
+);
+
+COMMENT ON TABLE custom_field_definitions IS 'Definições de campos personalizados de clientes e atendimentos';
+COMMENT ON COLUMN custom_field_definitions.id IS 'Identificador único da definição (UUID)';
+COMMENT ON COLUMN custom_field_definitions.entity IS 'Entidade do campo: cliente ou atendimento';
+COMMENT ON COLUMN custom_field_definitions.field_key IS 'Chave do campo no JSONB custom_fields';
+COMMENT ON COLUMN custom_field_definitions.label IS 'Rótulo exibido ao usuário';
+COMMENT ON COLUMN custom_field_definitions.field_type IS 'Tipo do campo: texto, numero, data, selecao ou booleano';
+COMMENT ON COLUMN custom_field_definitions.required IS 'Indica se o campo é obrigatório';
+COMMENT ON COLUMN custom_field_definitions.options IS 'Opções permitidas para campos do tipo selecao';
+COMMENT ON COLUMN custom_field_definitions.rules IS 'Regras de validação (tamanho, padrão, mínimo e máximo)';
+COMMENT ON COLUMN custom_field_definitions.created_at IS 'Data e hora de criação do registro';
+COMMENT ON COLUMN custom_field_definitions.updated_at IS 'Data e hora da última atualização';
+
+ALTER TABLE clients
+    ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE forms
+    ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_clients_custom_fields ON clients USING GIN (custom_fields jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS idx_clients_tags ON clients USING GIN (tags);
+CREATE INDEX IF NOT EXISTS idx_forms_custom_fields ON forms USING GIN (custom_fields jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS idx_forms_tags ON forms USING GIN (tags);
+
+COMMENT ON COLUMN clients.custom_fields IS 'Valores dos campos personalizados (chave => valor tipado)';
+COMMENT ON COLUMN clients.tags IS 'Tags livres do cliente (minúsculas)';
+COMMENT ON COLUMN forms.custom_fields IS 'Valores dos campos personalizados (chave => valor tipado)';
+COMMENT ON COLUMN forms.tags IS 'Tags livres do atendimento (minúsculas)';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_forms_tags;
+DROP INDEX IF EXISTS idx_forms_custom_fields;
+DROP INDEX IF EXISTS idx_clients_tags;
+DROP INDEX IF EXISTS idx_clients_custom_fields;
+ALTER TABLE forms
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE clients
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS custom_fields;
+DROP TABLE IF EXISTS custom_field_definitions;
+-- +goose StatementEnd
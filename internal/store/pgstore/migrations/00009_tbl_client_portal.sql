@@ -0,0 +1,84 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: client_users
+-- Descrição: Usuários do portal do cliente, vinculados a um registro de clients
+-- Relacionamento: N:1 com clients
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS client_users (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    client_id UUID NOT NULL REFERENCES clients(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    email VARCHAR(100) NOT NULL,
+    password_hash BYTEA NOT NULL,
+
+    last_login_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT client_users_email_unique UNIQUE (email),
+    CONSTRAINT client_users_email_format CHECK (email ~* '^[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}$')
+);
+
+CREATE INDEX IF NOT EXISTS idx_client_users_client_id ON client_users(client_id);
+
+COMMENT ON TABLE client_users IS 'Usuários do portal do cliente';
+COMMENT ON COLUMN client_users.id IS 'Identificador único do usuário do portal (UUID)';
+COMMENT ON COLUMN client_users.client_id IS 'Cliente ao qual o usuário pertence; limita os dados visíveis no portal';
+COMMENT ON COLUMN client_users.name IS 'Nome do usuário do portal';
+COMMENT ON COLUMN client_users.email IS 'E-mail de login, único entre usuários do portal';
+COMMENT ON COLUMN client_users.password_hash IS 'Hash da senha (bcrypt)';
+COMMENT ON COLUMN client_users.last_login_at IS 'Data e hora do último login no portal';
+COMMENT ON COLUMN client_users.created_at IS 'Data e hora de criação do registro';
+COMMENT ON COLUMN client_users.updated_at IS 'Data e hora da última atualização';
+
+-- ============================================================================
+-- Tabela: portal_requests
+-- Descrição: Solicitações de atendimento abertas pelo cliente no portal
+-- Relacionamento: N:1 com clients, N:1 com client_users, 1:1 com forms
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS portal_requests (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    client_id UUID NOT NULL REFERENCES clients(id) ON DELETE CASCADE,
+    client_user_id UUID REFERENCES client_users(id) ON DELETE SET NULL,
+    requester_name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL,
+
+    status TEXT NOT NULL DEFAULT 'aberta',
+    form_id UUID REFERENCES forms(id) ON DELETE SET NULL,
+    review_note TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT portal_requests_status_check CHECK (status IN ('aberta', 'convertida', 'recusada'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_portal_requests_client_id ON portal_requests(client_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_portal_requests_status ON portal_requests(status);
+
+COMMENT ON TABLE portal_requests IS 'Solicitações de atendimento abertas pelo portal do cliente';
+COMMENT ON COLUMN portal_requests.id IS 'Identificador único da solicitação (UUID)';
+COMMENT ON COLUMN portal_requests.client_id IS 'Cliente que abriu a solicitação';
+COMMENT ON COLUMN portal_requests.client_user_id IS 'Usuário do portal que abriu a solicitação';
+COMMENT ON COLUMN portal_requests.requester_name IS 'Nome do solicitante no momento da abertura';
+COMMENT ON COLUMN portal_requests.description IS 'Descrição do problema informada pelo cliente';
+COMMENT ON COLUMN portal_requests.status IS 'Situação: aberta, convertida (virou atendimento) ou recusada';
+COMMENT ON COLUMN portal_requests.form_id IS 'Atendimento gerado a partir da solicitação';
+COMMENT ON COLUMN portal_requests.review_note IS 'Observação da equipe ao converter ou recusar';
+COMMENT ON COLUMN portal_requests.created_at IS 'Data e hora de abertura da solicitação';
+COMMENT ON COLUMN portal_requests.updated_at IS 'Data e hora da última atualização';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS portal_requests;
+DROP TABLE IF EXISTS client_users;
+-- +goose StatementEnd
@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: form_status_history
+-- Descrição: Ciclo de vida dos atendimentos e histórico de mudanças de situação
+-- Alteração: forms (status)
+-- Relacionamento: N:1 com forms, N:1 com users
+-- Versão: 1.0
+-- ============================================================================
+
+ALTER TABLE forms
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'aberto',
+    ADD CONSTRAINT forms_status_check CHECK (status IN (
+        'aberto', 'agendado', 'em_andamento', 'aguardando_cliente', 'resolvido', 'fechado', 'cancelado'
+    ));
+
+-- Atendimentos anteriores sempre foram cadastrados com a solução preenchida.
+UPDATE forms
+SET status = 'resolvido'
+WHERE solution_description IS NOT NULL AND solution_description <> '';
+
+CREATE INDEX IF NOT EXISTS idx_forms_status ON forms(status);
+
+COMMENT ON COLUMN forms.status IS 'Situação do atendimento: aberto, agendado, em_andamento, aguardando_cliente, resolvido, fechado ou cancelado';
+
+CREATE TABLE IF NOT EXISTS form_status_history (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    form_id UUID NOT NULL REFERENCES forms(id) ON DELETE CASCADE,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    reason TEXT,
+
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_status_history_form_id ON form_status_history(form_id, changed_at);
+
+COMMENT ON TABLE form_status_history IS 'Histórico de mudanças de situação dos atendimentos';
+COMMENT ON COLUMN form_status_history.form_id IS 'Atendimento alterado';
+COMMENT ON COLUMN form_status_history.from_status IS 'Situação anterior';
+COMMENT ON COLUMN form_status_history.to_status IS 'Nova situação';
+COMMENT ON COLUMN form_status_history.reason IS 'Motivo informado na mudança';
+COMMENT ON COLUMN form_status_history.changed_by IS 'Usuário que fez a mudança';
+COMMENT ON COLUMN form_status_history.changed_at IS 'Data e hora da mudança';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS form_status_history;
+DROP INDEX IF EXISTS idx_forms_status;
+ALTER TABLE forms
+    DROP CONSTRAINT IF EXISTS forms_status_check,
+    DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
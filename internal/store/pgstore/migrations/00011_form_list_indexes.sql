@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Índices: forms, form_tecnico
+-- Descrição: Suporte aos filtros da listagem de atendimentos e à busca dos
+--            técnicos de vários atendimentos em uma única consulta
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_form_tecnico_form_id ON form_tecnico(form_id);
+CREATE INDEX IF NOT EXISTS idx_form_tecnico_member_id ON form_tecnico(member_id);
+CREATE INDEX IF NOT EXISTS idx_forms_client_id ON forms(client_id);
+CREATE INDEX IF NOT EXISTS idx_forms_occurred_at ON forms(occurred_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_forms_occurred_at;
+DROP INDEX IF EXISTS idx_forms_client_id;
+DROP INDEX IF EXISTS idx_form_tecnico_member_id;
+DROP INDEX IF EXISTS idx_form_tecnico_form_id;
+-- +goose StatementEnd
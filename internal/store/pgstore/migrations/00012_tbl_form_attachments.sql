@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: form_attachments
+-- Descrição: Arquivos (fotos, laudos) anexados aos atendimentos; o conteúdo
+--            fica no storage configurado (disco local ou S3)
+-- Relacionamento: N:1 com forms, N:1 com users
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS form_attachments (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    form_id UUID NOT NULL REFERENCES forms(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    checksum_sha256 CHAR(64) NOT NULL,
+    storage_key TEXT NOT NULL,
+
+    uploaded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT form_attachments_storage_key_unique UNIQUE (storage_key),
+    CONSTRAINT form_attachments_size_check CHECK (size_bytes > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_attachments_form_id ON form_attachments(form_id, created_at);
+
+COMMENT ON TABLE form_attachments IS 'Arquivos anexados aos atendimentos';
+COMMENT ON COLUMN form_attachments.id IS 'Identificador único do anexo (UUID)';
+COMMENT ON COLUMN form_attachments.form_id IS 'Atendimento ao qual o arquivo pertence';
+COMMENT ON COLUMN form_attachments.file_name IS 'Nome original do arquivo (sem diretórios)';
+COMMENT ON COLUMN form_attachments.content_type IS 'Tipo identificado pelo conteúdo do arquivo';
+COMMENT ON COLUMN form_attachments.size_bytes IS 'Tamanho em bytes';
+COMMENT ON COLUMN form_attachments.checksum_sha256 IS 'SHA-256 do conteúdo em hexadecimal';
+COMMENT ON COLUMN form_attachments.storage_key IS 'Chave do arquivo no storage';
+COMMENT ON COLUMN form_attachments.uploaded_by IS 'Usuário que enviou o arquivo';
+COMMENT ON COLUMN form_attachments.created_at IS 'Data e hora do envio';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS form_attachments;
+-- +goose StatementEnd
@@ -0,0 +1,101 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: form_comments
+-- Descrição: Comentários em Markdown nos atendimentos; os internos não são
+--            exibidos no portal do cliente
+-- Relacionamento: N:1 com forms, N:1 com users
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS form_comments (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    form_id UUID NOT NULL REFERENCES forms(id) ON DELETE CASCADE,
+    author_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    internal BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT form_comments_body_check CHECK (char_length(btrim(body)) BETWEEN 1 AND 10000)
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_comments_form_id ON form_comments(form_id, created_at);
+
+COMMENT ON TABLE form_comments IS 'Comentários dos atendimentos';
+COMMENT ON COLUMN form_comments.id IS 'Identificador único do comentário (UUID)';
+COMMENT ON COLUMN form_comments.form_id IS 'Atendimento comentado';
+COMMENT ON COLUMN form_comments.author_id IS 'Usuário que escreveu o comentário';
+COMMENT ON COLUMN form_comments.body IS 'Texto do comentário em Markdown';
+COMMENT ON COLUMN form_comments.internal IS 'Indica se o comentário é visível apenas para a equipe';
+COMMENT ON COLUMN form_comments.created_at IS 'Data e hora de criação do comentário';
+COMMENT ON COLUMN form_comments.updated_at IS 'Data e hora da última edição';
+
+-- ============================================================================
+-- Tabela: form_comment_edits
+-- Descrição: Versões anteriores dos comentários, gravadas a cada edição
+-- Relacionamento: N:1 com form_comments, N:1 com users
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS form_comment_edits (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    comment_id UUID NOT NULL REFERENCES form_comments(id) ON DELETE CASCADE,
+    previous_body TEXT NOT NULL,
+    previous_internal BOOLEAN NOT NULL,
+
+    edited_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    edited_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_comment_edits_comment_id ON form_comment_edits(comment_id, edited_at);
+
+COMMENT ON TABLE form_comment_edits IS 'Histórico de edições dos comentários';
+COMMENT ON COLUMN form_comment_edits.id IS 'Identificador único da edição (UUID)';
+COMMENT ON COLUMN form_comment_edits.comment_id IS 'Comentário editado';
+COMMENT ON COLUMN form_comment_edits.previous_body IS 'Texto do comentário antes da edição';
+COMMENT ON COLUMN form_comment_edits.previous_internal IS 'Visibilidade do comentário antes da edição';
+COMMENT ON COLUMN form_comment_edits.edited_by IS 'Usuário que fez a edição';
+COMMENT ON COLUMN form_comment_edits.edited_at IS 'Data e hora da edição';
+
+-- ============================================================================
+-- Tabela: form_assignment_history
+-- Descrição: Entradas e saídas de técnicos dos atendimentos
+-- Relacionamento: N:1 com forms, N:1 com members, N:1 com users
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS form_assignment_history (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    form_id UUID NOT NULL REFERENCES forms(id) ON DELETE CASCADE,
+    member_id UUID REFERENCES members(id) ON DELETE SET NULL,
+    action TEXT NOT NULL,
+
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT form_assignment_history_action_check CHECK (action IN ('atribuido', 'removido'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_assignment_history_form_id ON form_assignment_history(form_id, changed_at);
+
+COMMENT ON TABLE form_assignment_history IS 'Histórico de atribuição de técnicos aos atendimentos';
+COMMENT ON COLUMN form_assignment_history.id IS 'Identificador único do registro (UUID)';
+COMMENT ON COLUMN form_assignment_history.form_id IS 'Atendimento alterado';
+COMMENT ON COLUMN form_assignment_history.member_id IS 'Técnico atribuído ou removido';
+COMMENT ON COLUMN form_assignment_history.action IS 'Ação: atribuido ou removido';
+COMMENT ON COLUMN form_assignment_history.changed_by IS 'Usuário que fez a alteração';
+COMMENT ON COLUMN form_assignment_history.changed_at IS 'Data e hora da alteração';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS form_assignment_history;
+DROP TABLE IF EXISTS form_comment_edits;
+DROP TABLE IF EXISTS form_comments;
+-- +goose StatementEnd
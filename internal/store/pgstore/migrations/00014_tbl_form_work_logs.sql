@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: form_work_logs
+-- Descrição: Apontamentos de horas dos técnicos nos atendimentos, separando
+--            deslocamento e trabalho no local
+-- Relacionamento: N:1 com forms, N:1 com members, N:1 com users
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS form_work_logs (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    form_id UUID NOT NULL REFERENCES forms(id) ON DELETE CASCADE,
+    member_id UUID REFERENCES members(id) ON DELETE SET NULL,
+    kind TEXT NOT NULL,
+    source TEXT NOT NULL,
+
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+    notes TEXT NOT NULL DEFAULT '',
+
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT form_work_logs_kind_check CHECK (kind IN ('deslocamento', 'no_local')),
+    CONSTRAINT form_work_logs_source_check CHECK (source IN ('cronometro', 'manual')),
+    CONSTRAINT form_work_logs_period_check CHECK (ended_at IS NULL OR ended_at > started_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_work_logs_form_id ON form_work_logs(form_id, started_at);
+CREATE INDEX IF NOT EXISTS idx_form_work_logs_member_period ON form_work_logs(member_id, started_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_form_work_logs_running ON form_work_logs(member_id) WHERE ended_at IS NULL;
+
+COMMENT ON TABLE form_work_logs IS 'Apontamentos de horas dos técnicos nos atendimentos';
+COMMENT ON COLUMN form_work_logs.id IS 'Identificador único do apontamento (UUID)';
+COMMENT ON COLUMN form_work_logs.form_id IS 'Atendimento em que o tempo foi gasto';
+COMMENT ON COLUMN form_work_logs.member_id IS 'Técnico que trabalhou no período';
+COMMENT ON COLUMN form_work_logs.kind IS 'Tipo: deslocamento ou no_local';
+COMMENT ON COLUMN form_work_logs.source IS 'Origem: cronometro ou manual';
+COMMENT ON COLUMN form_work_logs.started_at IS 'Início do período';
+COMMENT ON COLUMN form_work_logs.ended_at IS 'Fim do período; nulo enquanto o cronômetro está em andamento';
+COMMENT ON COLUMN form_work_logs.notes IS 'Observações do técnico';
+COMMENT ON COLUMN form_work_logs.created_by IS 'Usuário que registrou o apontamento';
+COMMENT ON COLUMN form_work_logs.created_at IS 'Data e hora de criação do registro';
+COMMENT ON COLUMN form_work_logs.updated_at IS 'Data e hora da última atualização';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS form_work_logs;
+-- +goose StatementEnd
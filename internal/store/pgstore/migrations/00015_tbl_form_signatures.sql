@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: form_signatures
+-- Descrição: Assinatura do cliente na conclusão do atendimento, com os dados
+--            de quem assinou, a posição do técnico e o hash do atendimento no
+--            momento da assinatura; o arquivo fica no storage configurado
+-- Relacionamento: 1:1 com forms, N:1 com users
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS form_signatures (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    form_id UUID NOT NULL REFERENCES forms(id) ON DELETE CASCADE,
+    signer_name VARCHAR(200) NOT NULL,
+    signer_document VARCHAR(30) NOT NULL,
+    signed_at TIMESTAMPTZ NOT NULL,
+
+    format TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    checksum_sha256 CHAR(64) NOT NULL,
+    storage_key TEXT NOT NULL,
+
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    accuracy_meters DOUBLE PRECISION,
+
+    snapshot_sha256 CHAR(64) NOT NULL,
+    captured_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT form_signatures_form_unique UNIQUE (form_id),
+    CONSTRAINT form_signatures_storage_key_unique UNIQUE (storage_key),
+    CONSTRAINT form_signatures_format_check CHECK (format IN ('png', 'svg', 'tracos')),
+    CONSTRAINT form_signatures_size_check CHECK (size_bytes > 0),
+    CONSTRAINT form_signatures_location_check CHECK (
+        latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180
+    )
+);
+
+COMMENT ON TABLE form_signatures IS 'Assinaturas dos clientes na conclusão dos atendimentos';
+COMMENT ON COLUMN form_signatures.id IS 'Identificador único da assinatura (UUID)';
+COMMENT ON COLUMN form_signatures.form_id IS 'Atendimento assinado (no máximo uma assinatura por atendimento)';
+COMMENT ON COLUMN form_signatures.signer_name IS 'Nome de quem assinou';
+COMMENT ON COLUMN form_signatures.signer_document IS 'Documento de quem assinou (CPF, RG ou outro)';
+COMMENT ON COLUMN form_signatures.signed_at IS 'Data e hora da assinatura informadas pelo dispositivo';
+COMMENT ON COLUMN form_signatures.format IS 'Formato da assinatura: png, svg ou tracos (JSON com os traços)';
+COMMENT ON COLUMN form_signatures.content_type IS 'Tipo do arquivo gravado no storage';
+COMMENT ON COLUMN form_signatures.size_bytes IS 'Tamanho do arquivo em bytes';
+COMMENT ON COLUMN form_signatures.checksum_sha256 IS 'SHA-256 do arquivo em hexadecimal';
+COMMENT ON COLUMN form_signatures.storage_key IS 'Chave do arquivo no storage';
+COMMENT ON COLUMN form_signatures.latitude IS 'Latitude do técnico no momento da coleta';
+COMMENT ON COLUMN form_signatures.longitude IS 'Longitude do técnico no momento da coleta';
+COMMENT ON COLUMN form_signatures.accuracy_meters IS 'Precisão da posição em metros, quando informada';
+COMMENT ON COLUMN form_signatures.snapshot_sha256 IS 'SHA-256 do conteúdo do atendimento no momento da assinatura';
+COMMENT ON COLUMN form_signatures.captured_by IS 'Técnico que coletou a assinatura';
+COMMENT ON COLUMN form_signatures.created_at IS 'Data e hora do registro';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS form_signatures;
+-- +goose StatementEnd
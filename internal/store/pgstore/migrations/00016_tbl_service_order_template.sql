@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: service_order_templates
+-- Descrição: Modelo da ordem de serviço em PDF da organização (dados do
+--            cabeçalho, cor, fuso, rodapé e logotipo); linha única, o
+--            logotipo fica no storage configurado
+-- Relacionamento: N:1 com users
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS service_order_templates (
+    id SMALLINT PRIMARY KEY DEFAULT 1,
+
+    company_name VARCHAR(200) NOT NULL DEFAULT '',
+    company_document VARCHAR(30) NOT NULL DEFAULT '',
+    address VARCHAR(300) NOT NULL DEFAULT '',
+    phone VARCHAR(30) NOT NULL DEFAULT '',
+    email VARCHAR(200) NOT NULL DEFAULT '',
+    website VARCHAR(200) NOT NULL DEFAULT '',
+    footer_text VARCHAR(1000) NOT NULL DEFAULT '',
+    primary_color CHAR(7) NOT NULL DEFAULT '#1F4E79',
+    timezone TEXT NOT NULL DEFAULT 'America/Sao_Paulo',
+
+    logo_key TEXT NOT NULL DEFAULT '',
+    logo_content_type TEXT NOT NULL DEFAULT '',
+
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT service_order_templates_single_row CHECK (id = 1),
+    CONSTRAINT service_order_templates_color_check CHECK (primary_color ~ '^#[0-9A-F]{6}$')
+);
+
+COMMENT ON TABLE service_order_templates IS 'Modelo da ordem de serviço em PDF (linha única)';
+COMMENT ON COLUMN service_order_templates.id IS 'Sempre 1: a organização tem um único modelo';
+COMMENT ON COLUMN service_order_templates.company_name IS 'Nome da empresa no cabeçalho';
+COMMENT ON COLUMN service_order_templates.company_document IS 'CNPJ ou CPF da empresa';
+COMMENT ON COLUMN service_order_templates.address IS 'Endereço da empresa';
+COMMENT ON COLUMN service_order_templates.phone IS 'Telefone da empresa';
+COMMENT ON COLUMN service_order_templates.email IS 'E-mail da empresa';
+COMMENT ON COLUMN service_order_templates.website IS 'Site da empresa';
+COMMENT ON COLUMN service_order_templates.footer_text IS 'Texto do rodapé de todas as páginas';
+COMMENT ON COLUMN service_order_templates.primary_color IS 'Cor dos títulos em hexadecimal (#RRGGBB)';
+COMMENT ON COLUMN service_order_templates.timezone IS 'Fuso das datas impressas (IANA)';
+COMMENT ON COLUMN service_order_templates.logo_key IS 'Chave do logotipo no storage (vazio quando não há logotipo)';
+COMMENT ON COLUMN service_order_templates.logo_content_type IS 'Tipo do logotipo (image/png ou image/jpeg)';
+COMMENT ON COLUMN service_order_templates.updated_by IS 'Usuário que alterou o modelo por último';
+COMMENT ON COLUMN service_order_templates.updated_at IS 'Data e hora da última alteração';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS service_order_templates;
+-- +goose StatementEnd
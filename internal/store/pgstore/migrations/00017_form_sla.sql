@@ -0,0 +1,116 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabelas: sla_policies, holidays, notifications
+-- Descrição: Prazos de resposta e resolução por nível de dificuldade e tipo
+--            de cliente, calendário de feriados e notificações dos usuários
+-- Alteração: forms (prazos do SLA, resposta, resolução e último aviso)
+-- Relacionamento: notifications N:1 com users e forms
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS sla_policies (
+    difficulty_level difficulty_level NOT NULL,
+    client_type client_type NOT NULL,
+    response_minutes INTEGER NOT NULL,
+    resolution_minutes INTEGER NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (difficulty_level, client_type),
+    CONSTRAINT sla_policies_minutes_check CHECK (
+        response_minutes > 0 AND resolution_minutes >= response_minutes
+    )
+);
+
+-- A primeira resposta depende do tipo de cliente; a resolução, da dificuldade.
+INSERT INTO sla_policies (difficulty_level, client_type, response_minutes, resolution_minutes)
+VALUES
+    ('low', 'contrato', 120, 480),
+    ('low', 'avulso', 240, 960),
+    ('medium', 'contrato', 120, 1200),
+    ('medium', 'avulso', 240, 1800),
+    ('high', 'contrato', 120, 2400),
+    ('high', 'avulso', 240, 3000)
+ON CONFLICT DO NOTHING;
+
+COMMENT ON TABLE sla_policies IS 'Prazos de SLA por nível de dificuldade e tipo de cliente';
+COMMENT ON COLUMN sla_policies.difficulty_level IS 'Nível de dificuldade do atendimento';
+COMMENT ON COLUMN sla_policies.client_type IS 'Tipo de cliente';
+COMMENT ON COLUMN sla_policies.response_minutes IS 'Prazo da primeira resposta em minutos úteis';
+COMMENT ON COLUMN sla_policies.resolution_minutes IS 'Prazo de resolução em minutos úteis';
+COMMENT ON COLUMN sla_policies.updated_at IS 'Data e hora da última alteração';
+
+CREATE TABLE IF NOT EXISTS holidays (
+    holiday_date DATE PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE holidays IS 'Feriados, que não contam nos prazos de SLA';
+COMMENT ON COLUMN holidays.holiday_date IS 'Dia do feriado';
+COMMENT ON COLUMN holidays.name IS 'Nome do feriado';
+COMMENT ON COLUMN holidays.created_at IS 'Data e hora do cadastro';
+
+ALTER TABLE forms
+    ADD COLUMN IF NOT EXISTS response_due_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS response_risk_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS resolution_due_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS resolution_risk_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS responded_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS sla_notified_state TEXT;
+
+-- O monitor de SLA só olha atendimentos pendentes com prazo.
+CREATE INDEX IF NOT EXISTS idx_forms_sla_pending ON forms(response_risk_at, resolution_risk_at)
+    WHERE deleted_at IS NULL
+      AND resolution_due_at IS NOT NULL
+      AND status NOT IN ('resolvido', 'fechado', 'cancelado');
+
+COMMENT ON COLUMN forms.response_due_at IS 'Prazo da primeira resposta (NULL = sem SLA)';
+COMMENT ON COLUMN forms.response_risk_at IS 'Momento em que a primeira resposta pendente passa a estar em risco';
+COMMENT ON COLUMN forms.resolution_due_at IS 'Prazo de resolução (NULL = sem SLA)';
+COMMENT ON COLUMN forms.resolution_risk_at IS 'Momento em que a resolução pendente passa a estar em risco';
+COMMENT ON COLUMN forms.responded_at IS 'Data e hora da primeira resposta (saída da situação aberto)';
+COMMENT ON COLUMN forms.resolved_at IS 'Data e hora da resolução (NULL enquanto pendente)';
+COMMENT ON COLUMN forms.sla_notified_state IS 'Última situação do SLA notificada: em_risco ou violado';
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    form_id UUID REFERENCES forms(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    message TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    read_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications(user_id, id DESC);
+
+COMMENT ON TABLE notifications IS 'Notificações dos usuários';
+COMMENT ON COLUMN notifications.id IS 'Identificador único da notificação (UUID)';
+COMMENT ON COLUMN notifications.user_id IS 'Usuário notificado';
+COMMENT ON COLUMN notifications.form_id IS 'Atendimento relacionado';
+COMMENT ON COLUMN notifications.kind IS 'Tipo da notificação: sla_em_risco ou sla_violado';
+COMMENT ON COLUMN notifications.message IS 'Texto da notificação';
+COMMENT ON COLUMN notifications.created_at IS 'Data e hora da notificação';
+COMMENT ON COLUMN notifications.read_at IS 'Data e hora da leitura (NULL = não lida)';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notifications;
+DROP INDEX IF EXISTS idx_forms_sla_pending;
+ALTER TABLE forms
+    DROP COLUMN IF EXISTS response_due_at,
+    DROP COLUMN IF EXISTS response_risk_at,
+    DROP COLUMN IF EXISTS resolution_due_at,
+    DROP COLUMN IF EXISTS resolution_risk_at,
+    DROP COLUMN IF EXISTS responded_at,
+    DROP COLUMN IF EXISTS resolved_at,
+    DROP COLUMN IF EXISTS sla_notified_state;
+DROP TABLE IF EXISTS holidays;
+DROP TABLE IF EXISTS sla_policies;
+-- +goose StatementEnd
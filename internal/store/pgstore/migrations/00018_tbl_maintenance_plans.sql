@@ -0,0 +1,111 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabelas: maintenance_plans, maintenance_plan_tecnicos, form_checklist_items
+-- Descrição: Planos de manutenção preventiva com regra de recorrência, lista de
+--            verificação e técnicos padrão, e os itens de verificação dos
+--            atendimentos gerados
+-- Alteração: forms (plano de origem e visita agendada)
+-- Relacionamento: maintenance_plans N:1 com clients; maintenance_plan_tecnicos
+--                 N:1 com maintenance_plans e members; form_checklist_items N:1
+--                 com forms
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS maintenance_plans (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    client_id UUID NOT NULL REFERENCES clients(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    site VARCHAR(100) NOT NULL DEFAULT '',
+    description VARCHAR(120) NOT NULL DEFAULT '',
+    rrule TEXT NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    difficulty_level difficulty_level NOT NULL DEFAULT 'low',
+    checklist TEXT[] NOT NULL DEFAULT '{}',
+    status TEXT NOT NULL DEFAULT 'ativo',
+    generated_until TIMESTAMPTZ,
+
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT maintenance_plans_status_check CHECK (status IN ('ativo', 'pausado'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_maintenance_plans_client_id ON maintenance_plans(client_id);
+CREATE INDEX IF NOT EXISTS idx_maintenance_plans_active ON maintenance_plans(generated_until)
+    WHERE status = 'ativo';
+
+COMMENT ON TABLE maintenance_plans IS 'Planos de manutenção preventiva dos clientes';
+COMMENT ON COLUMN maintenance_plans.id IS 'Identificador único do plano (UUID)';
+COMMENT ON COLUMN maintenance_plans.client_id IS 'Cliente atendido pelo plano';
+COMMENT ON COLUMN maintenance_plans.name IS 'Nome do plano, usado como solicitante dos atendimentos';
+COMMENT ON COLUMN maintenance_plans.site IS 'Local do cliente atendido (vazio = cliente inteiro)';
+COMMENT ON COLUMN maintenance_plans.description IS 'Descrição do serviço preventivo';
+COMMENT ON COLUMN maintenance_plans.rrule IS 'Regra de recorrência no formato RRULE (RFC 5545)';
+COMMENT ON COLUMN maintenance_plans.starts_at IS 'Primeira visita da série; define o horário das visitas';
+COMMENT ON COLUMN maintenance_plans.difficulty_level IS 'Nível de dificuldade dos atendimentos gerados';
+COMMENT ON COLUMN maintenance_plans.checklist IS 'Itens da lista de verificação padrão';
+COMMENT ON COLUMN maintenance_plans.status IS 'Situação: ativo ou pausado';
+COMMENT ON COLUMN maintenance_plans.generated_until IS 'Fim da última janela de visitas já gerada (NULL = nenhuma)';
+COMMENT ON COLUMN maintenance_plans.created_by IS 'Usuário que cadastrou o plano';
+COMMENT ON COLUMN maintenance_plans.created_at IS 'Data e hora do cadastro';
+COMMENT ON COLUMN maintenance_plans.updated_at IS 'Data e hora da última alteração';
+
+CREATE TABLE IF NOT EXISTS maintenance_plan_tecnicos (
+    plan_id UUID NOT NULL REFERENCES maintenance_plans(id) ON DELETE CASCADE,
+    member_id UUID NOT NULL REFERENCES members(id) ON DELETE CASCADE,
+
+    PRIMARY KEY (plan_id, member_id)
+);
+
+COMMENT ON TABLE maintenance_plan_tecnicos IS 'Técnicos padrão dos planos de manutenção';
+COMMENT ON COLUMN maintenance_plan_tecnicos.plan_id IS 'Plano de manutenção';
+COMMENT ON COLUMN maintenance_plan_tecnicos.member_id IS 'Técnico atribuído às visitas do plano';
+
+ALTER TABLE forms
+    ADD COLUMN IF NOT EXISTS maintenance_plan_id UUID REFERENCES maintenance_plans(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS scheduled_for TIMESTAMPTZ;
+
+-- Uma visita de um plano gera um único atendimento, mesmo com várias
+-- instâncias do agendador ou após reinícios.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_forms_maintenance_visit ON forms(maintenance_plan_id, scheduled_for);
+
+COMMENT ON COLUMN forms.maintenance_plan_id IS 'Plano de manutenção que gerou o atendimento (NULL = avulso)';
+COMMENT ON COLUMN forms.scheduled_for IS 'Visita do plano de manutenção que gerou o atendimento';
+
+CREATE TABLE IF NOT EXISTS form_checklist_items (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    form_id UUID NOT NULL REFERENCES forms(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    label VARCHAR(200) NOT NULL,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    done_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    done_at TIMESTAMPTZ,
+
+    CONSTRAINT form_checklist_items_position_unique UNIQUE (form_id, position)
+);
+
+COMMENT ON TABLE form_checklist_items IS 'Itens da lista de verificação dos atendimentos';
+COMMENT ON COLUMN form_checklist_items.id IS 'Identificador único do item (UUID)';
+COMMENT ON COLUMN form_checklist_items.form_id IS 'Atendimento verificado';
+COMMENT ON COLUMN form_checklist_items.position IS 'Ordem do item na lista, a partir de 1';
+COMMENT ON COLUMN form_checklist_items.label IS 'Texto do item';
+COMMENT ON COLUMN form_checklist_items.done IS 'Indica se o item foi verificado';
+COMMENT ON COLUMN form_checklist_items.done_by IS 'Usuário que verificou o item';
+COMMENT ON COLUMN form_checklist_items.done_at IS 'Data e hora da verificação (NULL = pendente)';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS form_checklist_items;
+DROP INDEX IF EXISTS idx_forms_maintenance_visit;
+ALTER TABLE forms
+    DROP COLUMN IF EXISTS maintenance_plan_id,
+    DROP COLUMN IF EXISTS scheduled_for;
+DROP TABLE IF EXISTS maintenance_plan_tecnicos;
+DROP TABLE IF EXISTS maintenance_plans;
+-- +goose StatementEnd
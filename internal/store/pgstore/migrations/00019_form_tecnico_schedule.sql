@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: form_tecnico
+-- Descrição: Horário previsto da visita de cada técnico no atendimento, usado
+--            na agenda, na detecção de conflitos e na busca de horário livre
+-- Alteração: form_tecnico (início e fim previstos)
+-- Versão: 1.0
+-- ============================================================================
+
+ALTER TABLE form_tecnico
+    ADD COLUMN IF NOT EXISTS scheduled_start TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS scheduled_end TIMESTAMPTZ,
+    ADD CONSTRAINT form_tecnico_schedule_check CHECK (
+        (scheduled_start IS NULL AND scheduled_end IS NULL)
+        OR (scheduled_start IS NOT NULL AND scheduled_end > scheduled_start)
+    );
+
+-- A agenda e a detecção de conflitos buscam as visitas de um técnico por período.
+CREATE INDEX IF NOT EXISTS idx_form_tecnico_schedule ON form_tecnico(member_id, scheduled_start, scheduled_end)
+    WHERE scheduled_start IS NOT NULL;
+
+COMMENT ON COLUMN form_tecnico.scheduled_start IS 'Início previsto da visita do técnico (NULL = sem agendamento)';
+COMMENT ON COLUMN form_tecnico.scheduled_end IS 'Fim previsto da visita do técnico';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_form_tecnico_schedule;
+ALTER TABLE form_tecnico
+    DROP CONSTRAINT IF EXISTS form_tecnico_schedule_check,
+    DROP COLUMN IF EXISTS scheduled_start,
+    DROP COLUMN IF EXISTS scheduled_end;
+-- +goose StatementEnd
@@ -0,0 +1,164 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabelas: parts, stock_locations, stock_levels, form_parts, stock_movements
+-- Descrição: Catálogo de peças e materiais, locais de estoque (almoxarifados e
+--            veículos dos técnicos), saldos por local, peças usadas nos
+--            atendimentos e histórico das movimentações de estoque
+-- Relacionamento: stock_locations N:1 com members; stock_levels N:1 com
+--                 stock_locations e parts; form_parts N:1 com forms, parts e
+--                 stock_locations; stock_movements N:1 com parts e
+--                 stock_locations
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS parts (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    sku VARCHAR(50) NOT NULL,
+    name VARCHAR(150) NOT NULL,
+    unit VARCHAR(10) NOT NULL DEFAULT 'un',
+    cost NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    price NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    min_stock NUMERIC(12, 3) NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT parts_values_check CHECK (cost >= 0 AND price >= 0 AND min_stock >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_parts_sku ON parts(sku);
+
+COMMENT ON TABLE parts IS 'Catálogo de peças e materiais';
+COMMENT ON COLUMN parts.id IS 'Identificador único da peça (UUID)';
+COMMENT ON COLUMN parts.sku IS 'Código da peça (SKU), único e em maiúsculas';
+COMMENT ON COLUMN parts.name IS 'Nome da peça';
+COMMENT ON COLUMN parts.unit IS 'Unidade de medida: un, par, cx, kit, m, rolo, kg ou l';
+COMMENT ON COLUMN parts.cost IS 'Custo unitário';
+COMMENT ON COLUMN parts.price IS 'Preço unitário de venda';
+COMMENT ON COLUMN parts.min_stock IS 'Estoque mínimo somando todos os locais (0 = sem alerta)';
+COMMENT ON COLUMN parts.active IS 'Peças inativas não podem ser lançadas em atendimentos';
+COMMENT ON COLUMN parts.created_at IS 'Data e hora do cadastro';
+COMMENT ON COLUMN parts.updated_at IS 'Data e hora da última alteração';
+
+CREATE TABLE IF NOT EXISTS stock_locations (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    kind TEXT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    member_id UUID REFERENCES members(id) ON DELETE RESTRICT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT stock_locations_kind_check CHECK (
+        (kind = 'almoxarifado' AND member_id IS NULL)
+        OR (kind = 'veiculo' AND member_id IS NOT NULL)
+    )
+);
+
+-- Cada técnico tem no máximo um veículo.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_locations_member ON stock_locations(member_id)
+    WHERE member_id IS NOT NULL;
+
+COMMENT ON TABLE stock_locations IS 'Locais de estoque: almoxarifados e veículos dos técnicos';
+COMMENT ON COLUMN stock_locations.id IS 'Identificador único do local (UUID)';
+COMMENT ON COLUMN stock_locations.kind IS 'Tipo do local: almoxarifado ou veiculo';
+COMMENT ON COLUMN stock_locations.name IS 'Nome do local';
+COMMENT ON COLUMN stock_locations.member_id IS 'Técnico dono do veículo (somente veículos)';
+COMMENT ON COLUMN stock_locations.created_at IS 'Data e hora do cadastro';
+
+CREATE TABLE IF NOT EXISTS stock_levels (
+    location_id UUID NOT NULL REFERENCES stock_locations(id) ON DELETE RESTRICT,
+    part_id UUID NOT NULL REFERENCES parts(id) ON DELETE RESTRICT,
+    quantity NUMERIC(12, 3) NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (location_id, part_id),
+    CONSTRAINT stock_levels_quantity_check CHECK (quantity >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_levels_part_id ON stock_levels(part_id);
+
+COMMENT ON TABLE stock_levels IS 'Saldo de cada peça em cada local de estoque';
+COMMENT ON COLUMN stock_levels.location_id IS 'Local de estoque';
+COMMENT ON COLUMN stock_levels.part_id IS 'Peça';
+COMMENT ON COLUMN stock_levels.quantity IS 'Quantidade disponível, nunca negativa';
+COMMENT ON COLUMN stock_levels.updated_at IS 'Data e hora da última movimentação';
+
+CREATE TABLE IF NOT EXISTS form_parts (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    form_id UUID NOT NULL REFERENCES forms(id) ON DELETE CASCADE,
+    part_id UUID NOT NULL REFERENCES parts(id) ON DELETE RESTRICT,
+    location_id UUID NOT NULL REFERENCES stock_locations(id) ON DELETE RESTRICT,
+    quantity NUMERIC(12, 3) NOT NULL,
+    unit_price NUMERIC(12, 2) NOT NULL,
+    unit_cost NUMERIC(12, 2) NOT NULL,
+
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT form_parts_values_check CHECK (quantity > 0 AND unit_price >= 0 AND unit_cost >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_parts_form_id ON form_parts(form_id, created_at);
+
+COMMENT ON TABLE form_parts IS 'Peças e materiais usados nos atendimentos';
+COMMENT ON COLUMN form_parts.id IS 'Identificador único do item (UUID)';
+COMMENT ON COLUMN form_parts.form_id IS 'Atendimento em que a peça foi usada';
+COMMENT ON COLUMN form_parts.part_id IS 'Peça usada';
+COMMENT ON COLUMN form_parts.location_id IS 'Local de estoque de onde a peça saiu';
+COMMENT ON COLUMN form_parts.quantity IS 'Quantidade usada';
+COMMENT ON COLUMN form_parts.unit_price IS 'Preço unitário cobrado';
+COMMENT ON COLUMN form_parts.unit_cost IS 'Custo unitário da peça no lançamento';
+COMMENT ON COLUMN form_parts.created_by IS 'Usuário que lançou a peça';
+COMMENT ON COLUMN form_parts.created_at IS 'Data e hora do lançamento';
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    part_id UUID NOT NULL REFERENCES parts(id) ON DELETE RESTRICT,
+    location_id UUID NOT NULL REFERENCES stock_locations(id) ON DELETE RESTRICT,
+    kind TEXT NOT NULL,
+    quantity NUMERIC(12, 3) NOT NULL,
+    form_id UUID REFERENCES forms(id) ON DELETE SET NULL,
+    form_part_id UUID,
+    transfer_id UUID,
+    notes VARCHAR(200) NOT NULL DEFAULT '',
+
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT stock_movements_quantity_check CHECK (quantity <> 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_part_id ON stock_movements(part_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_stock_movements_location_id ON stock_movements(location_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_stock_movements_form_id ON stock_movements(form_id)
+    WHERE form_id IS NOT NULL;
+
+COMMENT ON TABLE stock_movements IS 'Histórico das movimentações de estoque';
+COMMENT ON COLUMN stock_movements.id IS 'Identificador único da movimentação (UUID)';
+COMMENT ON COLUMN stock_movements.part_id IS 'Peça movimentada';
+COMMENT ON COLUMN stock_movements.location_id IS 'Local de estoque movimentado';
+COMMENT ON COLUMN stock_movements.kind IS 'Tipo: entrada, saida, ajuste, transferencia, consumo ou estorno';
+COMMENT ON COLUMN stock_movements.quantity IS 'Quantidade com sinal: positiva entra, negativa sai';
+COMMENT ON COLUMN stock_movements.form_id IS 'Atendimento do consumo ou estorno';
+COMMENT ON COLUMN stock_movements.form_part_id IS 'Item do atendimento do consumo ou estorno (mantido após a remoção do item)';
+COMMENT ON COLUMN stock_movements.transfer_id IS 'Identificador comum às duas pernas de uma transferência';
+COMMENT ON COLUMN stock_movements.notes IS 'Observação do lançamento';
+COMMENT ON COLUMN stock_movements.created_by IS 'Usuário que lançou a movimentação';
+COMMENT ON COLUMN stock_movements.created_at IS 'Data e hora da movimentação';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS stock_movements;
+DROP TABLE IF EXISTS form_parts;
+DROP TABLE IF EXISTS stock_levels;
+DROP TABLE IF EXISTS stock_locations;
+DROP TABLE IF EXISTS parts;
+-- +goose StatementEnd
@@ -0,0 +1,165 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabelas: billing_settings, invoice_sequence, invoices, invoice_items,
+--          invoice_forms
+-- Descrição: Valores de cobrança dos atendimentos avulsos, numeração
+--            sequencial das faturas, faturas com seus itens e vínculo dos
+--            atendimentos cobrados
+-- Relacionamento: invoices N:1 com clients; invoice_items N:1 com invoices e
+--                 forms; invoice_forms 1:1 com forms e N:1 com invoices
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS billing_settings (
+    id SMALLINT PRIMARY KEY DEFAULT 1,
+
+    hour_rate_low NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    hour_rate_medium NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    hour_rate_high NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    travel_fee NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    tax_rate NUMERIC(5, 2) NOT NULL DEFAULT 0,
+
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT billing_settings_single_row CHECK (id = 1),
+    CONSTRAINT billing_settings_values_check CHECK (
+        hour_rate_low >= 0 AND hour_rate_medium >= 0 AND hour_rate_high >= 0
+        AND travel_fee >= 0 AND tax_rate BETWEEN 0 AND 100
+    )
+);
+
+COMMENT ON TABLE billing_settings IS 'Valores de cobrança dos atendimentos avulsos (linha única)';
+COMMENT ON COLUMN billing_settings.id IS 'Sempre 1: a organização tem uma única configuração';
+COMMENT ON COLUMN billing_settings.hour_rate_low IS 'Valor da hora de atendimentos de dificuldade baixa';
+COMMENT ON COLUMN billing_settings.hour_rate_medium IS 'Valor da hora de atendimentos de dificuldade média';
+COMMENT ON COLUMN billing_settings.hour_rate_high IS 'Valor da hora de atendimentos de dificuldade alta';
+COMMENT ON COLUMN billing_settings.travel_fee IS 'Taxa de deslocamento cobrada por atendimento';
+COMMENT ON COLUMN billing_settings.tax_rate IS 'Alíquota de impostos padrão das novas faturas, em porcentagem';
+COMMENT ON COLUMN billing_settings.updated_by IS 'Usuário que alterou os valores por último';
+COMMENT ON COLUMN billing_settings.updated_at IS 'Data e hora da última alteração';
+
+-- A numeração fica em uma linha única atualizada na mesma transação da
+-- emissão: o bloqueio da linha serializa emissões concorrentes e um rollback
+-- devolve o número, então a sequência não tem lacunas.
+CREATE TABLE IF NOT EXISTS invoice_sequence (
+    id SMALLINT PRIMARY KEY DEFAULT 1,
+    last_number BIGINT NOT NULL DEFAULT 0,
+
+    CONSTRAINT invoice_sequence_single_row CHECK (id = 1)
+);
+
+INSERT INTO invoice_sequence (id, last_number) VALUES (1, 0) ON CONFLICT (id) DO NOTHING;
+
+COMMENT ON TABLE invoice_sequence IS 'Último número de fatura emitido (linha única)';
+COMMENT ON COLUMN invoice_sequence.last_number IS 'Número da última fatura emitida';
+
+CREATE TABLE IF NOT EXISTS invoices (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    number BIGINT,
+    client_id UUID NOT NULL REFERENCES clients(id) ON DELETE RESTRICT,
+    status TEXT NOT NULL DEFAULT 'rascunho',
+
+    subtotal NUMERIC(12, 2) NOT NULL,
+    discount NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    tax_rate NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    tax_amount NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    total NUMERIC(12, 2) NOT NULL,
+    notes VARCHAR(2000) NOT NULL DEFAULT '',
+    due_date DATE,
+
+    issued_at TIMESTAMPTZ,
+    paid_at TIMESTAMPTZ,
+    canceled_at TIMESTAMPTZ,
+    cancel_reason VARCHAR(500) NOT NULL DEFAULT '',
+
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT invoices_status_check CHECK (status IN ('rascunho', 'emitida', 'paga', 'cancelada')),
+    CONSTRAINT invoices_number_check CHECK (status NOT IN ('emitida', 'paga') OR number IS NOT NULL),
+    CONSTRAINT invoices_values_check CHECK (
+        subtotal >= 0 AND discount >= 0 AND discount <= subtotal
+        AND tax_rate BETWEEN 0 AND 100 AND tax_amount >= 0 AND total >= 0
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invoices_number ON invoices(number) WHERE number IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_invoices_client_id ON invoices(client_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_invoices_status ON invoices(status);
+
+COMMENT ON TABLE invoices IS 'Faturas de atendimentos avulsos';
+COMMENT ON COLUMN invoices.id IS 'Identificador único da fatura (UUID)';
+COMMENT ON COLUMN invoices.number IS 'Número sequencial atribuído na emissão (nulo em rascunhos)';
+COMMENT ON COLUMN invoices.client_id IS 'Cliente faturado';
+COMMENT ON COLUMN invoices.status IS 'Situação da fatura: rascunho, emitida, paga ou cancelada';
+COMMENT ON COLUMN invoices.subtotal IS 'Soma dos itens';
+COMMENT ON COLUMN invoices.discount IS 'Desconto abatido do subtotal';
+COMMENT ON COLUMN invoices.tax_rate IS 'Alíquota de impostos, em porcentagem, sobre o subtotal com desconto';
+COMMENT ON COLUMN invoices.tax_amount IS 'Valor dos impostos';
+COMMENT ON COLUMN invoices.total IS 'Valor total a pagar';
+COMMENT ON COLUMN invoices.notes IS 'Observações impressas na fatura';
+COMMENT ON COLUMN invoices.due_date IS 'Data de vencimento';
+COMMENT ON COLUMN invoices.issued_at IS 'Data e hora da emissão';
+COMMENT ON COLUMN invoices.paid_at IS 'Data e hora em que o pagamento foi registrado';
+COMMENT ON COLUMN invoices.canceled_at IS 'Data e hora do cancelamento';
+COMMENT ON COLUMN invoices.cancel_reason IS 'Motivo do cancelamento';
+COMMENT ON COLUMN invoices.created_by IS 'Usuário que gerou a fatura';
+COMMENT ON COLUMN invoices.created_at IS 'Data e hora da geração';
+COMMENT ON COLUMN invoices.updated_at IS 'Data e hora da última alteração';
+
+CREATE TABLE IF NOT EXISTS invoice_items (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    form_id UUID REFERENCES forms(id) ON DELETE SET NULL,
+    position INTEGER NOT NULL,
+    kind TEXT NOT NULL,
+    description VARCHAR(300) NOT NULL,
+    quantity NUMERIC(12, 3) NOT NULL,
+    unit_price NUMERIC(12, 2) NOT NULL,
+    total NUMERIC(12, 2) NOT NULL,
+
+    CONSTRAINT invoice_items_kind_check CHECK (kind IN ('mao_de_obra', 'peca', 'deslocamento')),
+    CONSTRAINT invoice_items_values_check CHECK (quantity > 0 AND unit_price >= 0 AND total >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_items_invoice_id ON invoice_items(invoice_id, position);
+
+COMMENT ON TABLE invoice_items IS 'Itens das faturas: mão de obra, peças e deslocamento';
+COMMENT ON COLUMN invoice_items.id IS 'Identificador único do item (UUID)';
+COMMENT ON COLUMN invoice_items.invoice_id IS 'Fatura do item';
+COMMENT ON COLUMN invoice_items.form_id IS 'Atendimento que originou o item';
+COMMENT ON COLUMN invoice_items.position IS 'Ordem do item na fatura';
+COMMENT ON COLUMN invoice_items.kind IS 'Tipo do item: mao_de_obra, peca ou deslocamento';
+COMMENT ON COLUMN invoice_items.description IS 'Descrição impressa';
+COMMENT ON COLUMN invoice_items.quantity IS 'Horas, quantidade de peças ou visitas';
+COMMENT ON COLUMN invoice_items.unit_price IS 'Valor unitário';
+COMMENT ON COLUMN invoice_items.total IS 'Quantidade vezes valor unitário, em centavos';
+
+-- Um atendimento só pode estar em uma fatura não cancelada; o vínculo é
+-- removido quando a fatura é cancelada, liberando o atendimento.
+CREATE TABLE IF NOT EXISTS invoice_forms (
+    form_id UUID PRIMARY KEY REFERENCES forms(id) ON DELETE CASCADE,
+    invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_forms_invoice_id ON invoice_forms(invoice_id);
+
+COMMENT ON TABLE invoice_forms IS 'Atendimentos cobrados em faturas não canceladas';
+COMMENT ON COLUMN invoice_forms.form_id IS 'Atendimento cobrado';
+COMMENT ON COLUMN invoice_forms.invoice_id IS 'Fatura que cobra o atendimento';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS invoice_forms;
+DROP TABLE IF EXISTS invoice_items;
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS invoice_sequence;
+DROP TABLE IF EXISTS billing_settings;
+-- +goose StatementEnd
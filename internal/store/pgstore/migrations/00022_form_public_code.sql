@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: form_code_sequences
+-- Descrição: Numeração anual dos protocolos dos atendimentos
+-- Alteração: forms (protocolo legível, como OS-2026-000123)
+-- Versão: 1.0
+-- ============================================================================
+
+-- Cada ano tem uma linha, criada ou incrementada na mesma transação que grava
+-- o atendimento: o bloqueio da linha serializa gravações concorrentes do mesmo
+-- ano e um rollback devolve o número, então a sequência não tem lacunas.
+CREATE TABLE IF NOT EXISTS form_code_sequences (
+    year INTEGER PRIMARY KEY,
+    last_number BIGINT NOT NULL DEFAULT 0,
+
+    CONSTRAINT form_code_sequences_last_number_check CHECK (last_number >= 0)
+);
+
+COMMENT ON TABLE form_code_sequences IS 'Último número de protocolo usado em cada ano';
+COMMENT ON COLUMN form_code_sequences.year IS 'Ano do protocolo';
+COMMENT ON COLUMN form_code_sequences.last_number IS 'Número do último protocolo do ano';
+
+ALTER TABLE forms
+    ADD COLUMN IF NOT EXISTS public_code VARCHAR(20);
+
+-- Os atendimentos existentes, inclusive os da lixeira, são numerados pela
+-- ordem de criação dentro do ano, no fuso padrão da organização.
+WITH numbered AS (
+    SELECT
+        id,
+        EXTRACT(YEAR FROM created_at AT TIME ZONE 'America/Sao_Paulo')::INTEGER AS year,
+        ROW_NUMBER() OVER (
+            PARTITION BY EXTRACT(YEAR FROM created_at AT TIME ZONE 'America/Sao_Paulo')
+            ORDER BY created_at, id
+        ) AS number
+    FROM forms
+    WHERE public_code IS NULL
+)
+UPDATE forms f
+SET public_code = 'OS-' || n.year || '-' || LPAD(n.number::TEXT, 6, '0')
+FROM numbered n
+WHERE f.id = n.id;
+
+INSERT INTO form_code_sequences (year, last_number)
+SELECT
+    SPLIT_PART(public_code, '-', 2)::INTEGER,
+    MAX(SPLIT_PART(public_code, '-', 3)::BIGINT)
+FROM forms
+GROUP BY SPLIT_PART(public_code, '-', 2)
+ON CONFLICT (year) DO UPDATE SET last_number = GREATEST(form_code_sequences.last_number, EXCLUDED.last_number);
+
+ALTER TABLE forms
+    ALTER COLUMN public_code SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_forms_public_code ON forms(public_code);
+
+COMMENT ON COLUMN forms.public_code IS 'Protocolo do atendimento (OS-ano-número), sequencial por ano e sem lacunas';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_forms_public_code;
+ALTER TABLE forms DROP COLUMN IF EXISTS public_code;
+DROP TABLE IF EXISTS form_code_sequences;
+-- +goose StatementEnd
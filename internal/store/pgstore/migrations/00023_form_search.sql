@@ -0,0 +1,105 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Busca textual: forms
+-- Descrição: Vetor de busca em português, sem acentos, sobre o defeito, a
+--            solução, o solicitante e o nome do cliente do atendimento
+-- Alteração: forms (search_vector), clients (atualiza os vetores ao renomear)
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- Configuração portuguesa que remove os acentos antes da redução ao radical,
+-- para que "impressora", "IMPRESSÓRA" e "impressoras" se encontrem.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'portuguese_unaccent') THEN
+        CREATE TEXT SEARCH CONFIGURATION portuguese_unaccent (COPY = pg_catalog.portuguese);
+        ALTER TEXT SEARCH CONFIGURATION portuguese_unaccent
+            ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+    END IF;
+END
+$$;
+
+-- O defeito pesa mais que a solução, que pesa mais que os nomes do cliente e
+-- do solicitante.
+CREATE OR REPLACE FUNCTION forms_search_vector(
+    defect TEXT,
+    solution TEXT,
+    requester TEXT,
+    client_name TEXT
+) RETURNS tsvector
+LANGUAGE sql STABLE AS $$
+    SELECT
+        setweight(to_tsvector('portuguese_unaccent', COALESCE(defect, '')), 'A') ||
+        setweight(to_tsvector('portuguese_unaccent', COALESCE(solution, '')), 'B') ||
+        setweight(to_tsvector('portuguese_unaccent', COALESCE(client_name, '')), 'C') ||
+        setweight(to_tsvector('portuguese_unaccent', COALESCE(requester, '')), 'D')
+$$;
+
+ALTER TABLE forms
+    ADD COLUMN IF NOT EXISTS search_vector tsvector NOT NULL DEFAULT ''::tsvector;
+
+CREATE OR REPLACE FUNCTION forms_search_vector_update() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    NEW.search_vector := forms_search_vector(
+        NEW.defect_description,
+        NEW.solution_description,
+        NEW.solicited_name,
+        (SELECT name FROM clients WHERE id = NEW.client_id)
+    );
+    RETURN NEW;
+END
+$$;
+
+DROP TRIGGER IF EXISTS forms_search_vector_trigger ON forms;
+CREATE TRIGGER forms_search_vector_trigger
+    BEFORE INSERT OR UPDATE OF defect_description, solution_description, solicited_name, client_id
+    ON forms
+    FOR EACH ROW
+    EXECUTE FUNCTION forms_search_vector_update();
+
+-- Renomear o cliente refaz o vetor dos atendimentos dele; a mesclagem de
+-- clientes já passa pelo gatilho de forms ao trocar o client_id.
+CREATE OR REPLACE FUNCTION clients_search_vector_update() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    UPDATE forms
+    SET search_vector = forms_search_vector(defect_description, solution_description, solicited_name, NEW.name)
+    WHERE client_id = NEW.id;
+    RETURN NULL;
+END
+$$;
+
+DROP TRIGGER IF EXISTS clients_search_vector_trigger ON clients;
+CREATE TRIGGER clients_search_vector_trigger
+    AFTER UPDATE OF name
+    ON clients
+    FOR EACH ROW
+    WHEN (OLD.name IS DISTINCT FROM NEW.name)
+    EXECUTE FUNCTION clients_search_vector_update();
+
+UPDATE forms f
+SET search_vector = forms_search_vector(f.defect_description, f.solution_description, f.solicited_name, c.name)
+FROM clients c
+WHERE c.id = f.client_id;
+
+CREATE INDEX IF NOT EXISTS idx_forms_search_vector ON forms USING GIN (search_vector);
+
+COMMENT ON COLUMN forms.search_vector IS 'Vetor da busca textual (defeito, solução, cliente e solicitante), mantido por gatilho';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS clients_search_vector_trigger ON clients;
+DROP FUNCTION IF EXISTS clients_search_vector_update();
+DROP TRIGGER IF EXISTS forms_search_vector_trigger ON forms;
+DROP FUNCTION IF EXISTS forms_search_vector_update();
+DROP INDEX IF EXISTS idx_forms_search_vector;
+ALTER TABLE forms DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS forms_search_vector(TEXT, TEXT, TEXT, TEXT);
+DROP TEXT SEARCH CONFIGURATION IF EXISTS portuguese_unaccent;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: form_similarity_feedback
+-- Descrição: Atendimentos resolvidos semelhantes pelo defeito e o retorno
+--            "isto ajudou" dos usuários, usado para melhorar a ordem
+-- Alteração: forms (índice de trigramas do defeito)
+-- Relacionamento: form_similarity_feedback N:1 com forms e users
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- unaccent não é IMMUTABLE porque depende do dicionário configurado; fixar o
+-- dicionário permite usá-lo no índice.
+CREATE OR REPLACE FUNCTION immutable_unaccent(value TEXT) RETURNS TEXT
+LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT AS $$
+    SELECT public.unaccent('public.unaccent'::regdictionary, value)
+$$;
+
+CREATE INDEX IF NOT EXISTS idx_forms_defect_trgm
+    ON forms USING GIN (lower(immutable_unaccent(defect_description)) gin_trgm_ops);
+
+CREATE TABLE IF NOT EXISTS form_similarity_feedback (
+    form_id UUID NOT NULL,
+    similar_form_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (form_id, similar_form_id, user_id),
+    CONSTRAINT fk_form_similarity_feedback_form FOREIGN KEY (form_id) REFERENCES forms(id) ON DELETE CASCADE,
+    CONSTRAINT fk_form_similarity_feedback_similar_form FOREIGN KEY (similar_form_id) REFERENCES forms(id) ON DELETE CASCADE,
+    CONSTRAINT fk_form_similarity_feedback_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT form_similarity_feedback_distinct_check CHECK (form_id <> similar_form_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_similarity_feedback_similar_form_id ON form_similarity_feedback(similar_form_id);
+
+COMMENT ON TABLE form_similarity_feedback IS 'Atendimentos semelhantes que ajudaram a resolver outro atendimento';
+COMMENT ON COLUMN form_similarity_feedback.form_id IS 'Atendimento em que a sugestão foi exibida';
+COMMENT ON COLUMN form_similarity_feedback.similar_form_id IS 'Atendimento sugerido que ajudou';
+COMMENT ON COLUMN form_similarity_feedback.user_id IS 'Usuário que marcou a sugestão (um voto por usuário)';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS form_similarity_feedback;
+DROP INDEX IF EXISTS idx_forms_defect_trgm;
+DROP FUNCTION IF EXISTS immutable_unaccent(TEXT);
+-- +goose StatementEnd
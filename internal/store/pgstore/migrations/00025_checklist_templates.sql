@@ -0,0 +1,85 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: checklist_templates
+-- Descrição: Modelos de lista de verificação por tipo de serviço, com itens
+--            ordenados de aprovação, número com unidade, texto ou foto
+-- Alteração: form_checklist_items (tipo, obrigatoriedade, faixa e respostas)
+-- Relacionamento: form_checklist_items N:1 com checklist_templates e
+--                 form_attachments
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS checklist_templates (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    items JSONB NOT NULL DEFAULT '[]'::jsonb,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT checklist_templates_name_unique UNIQUE (name)
+);
+
+COMMENT ON TABLE checklist_templates IS 'Modelos de lista de verificação por tipo de serviço';
+COMMENT ON COLUMN checklist_templates.id IS 'Identificador único do modelo (UUID)';
+COMMENT ON COLUMN checklist_templates.name IS 'Nome do modelo, normalmente o tipo de serviço';
+COMMENT ON COLUMN checklist_templates.description IS 'Descrição do modelo';
+COMMENT ON COLUMN checklist_templates.items IS 'Itens na ordem da lista (descrição, tipo, obrigatoriedade, unidade e faixa)';
+COMMENT ON COLUMN checklist_templates.created_at IS 'Data e hora do cadastro';
+COMMENT ON COLUMN checklist_templates.updated_at IS 'Data e hora da última alteração';
+
+-- Os itens existentes, criados pelos planos de manutenção, continuam como
+-- marcações simples e opcionais.
+ALTER TABLE form_checklist_items
+    ADD COLUMN IF NOT EXISTS template_id UUID REFERENCES checklist_templates(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'marcacao',
+    ADD COLUMN IF NOT EXISTS required BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS unit VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS min_value DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS max_value DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS passed BOOLEAN,
+    ADD COLUMN IF NOT EXISTS number_value DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS text_value TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS attachment_id UUID REFERENCES form_attachments(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS out_of_range BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT form_checklist_items_kind_check
+        CHECK (kind IN ('marcacao', 'aprovacao', 'numero', 'texto', 'foto'));
+
+CREATE INDEX IF NOT EXISTS idx_form_checklist_items_attachment_id ON form_checklist_items(attachment_id)
+    WHERE attachment_id IS NOT NULL;
+
+COMMENT ON COLUMN form_checklist_items.template_id IS 'Modelo de onde o item foi copiado (NULL = plano de manutenção ou modelo excluído)';
+COMMENT ON COLUMN form_checklist_items.kind IS 'Tipo de resposta: marcacao, aprovacao, numero, texto ou foto';
+COMMENT ON COLUMN form_checklist_items.required IS 'Indica se o item precisa de resposta para resolver o atendimento';
+COMMENT ON COLUMN form_checklist_items.unit IS 'Unidade da leitura dos itens numéricos';
+COMMENT ON COLUMN form_checklist_items.min_value IS 'Menor leitura aceita (NULL = sem limite)';
+COMMENT ON COLUMN form_checklist_items.max_value IS 'Maior leitura aceita (NULL = sem limite)';
+COMMENT ON COLUMN form_checklist_items.passed IS 'Resposta dos itens de aprovação';
+COMMENT ON COLUMN form_checklist_items.number_value IS 'Leitura dos itens numéricos';
+COMMENT ON COLUMN form_checklist_items.text_value IS 'Resposta dos itens de texto';
+COMMENT ON COLUMN form_checklist_items.attachment_id IS 'Foto anexada ao atendimento como resposta do item';
+COMMENT ON COLUMN form_checklist_items.out_of_range IS 'Indica leitura fora da faixa aceita';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_form_checklist_items_attachment_id;
+ALTER TABLE form_checklist_items
+    DROP CONSTRAINT IF EXISTS form_checklist_items_kind_check,
+    DROP COLUMN IF EXISTS template_id,
+    DROP COLUMN IF EXISTS kind,
+    DROP COLUMN IF EXISTS required,
+    DROP COLUMN IF EXISTS unit,
+    DROP COLUMN IF EXISTS min_value,
+    DROP COLUMN IF EXISTS max_value,
+    DROP COLUMN IF EXISTS passed,
+    DROP COLUMN IF EXISTS number_value,
+    DROP COLUMN IF EXISTS text_value,
+    DROP COLUMN IF EXISTS attachment_id,
+    DROP COLUMN IF EXISTS out_of_range;
+DROP TABLE IF EXISTS checklist_templates;
+-- +goose StatementEnd
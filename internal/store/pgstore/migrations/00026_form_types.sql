@@ -0,0 +1,86 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabelas: form_types, form_type_versions
+-- Descrição: Tipos de formulário definidos pelo administrador, com as versões
+--            do JSON Schema dos campos extras
+-- Alteração: forms (tipo, versão do esquema e dados dos campos extras)
+-- Relacionamento: form_type_versions N:1 com form_types e users;
+--                 forms N:1 com form_type_versions
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS form_types (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    current_version INTEGER NOT NULL DEFAULT 1,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT form_types_name_unique UNIQUE (name),
+    CONSTRAINT form_types_current_version_check CHECK (current_version > 0)
+);
+
+COMMENT ON TABLE form_types IS 'Tipos de formulário com campos extras definidos por JSON Schema';
+COMMENT ON COLUMN form_types.id IS 'Identificador único do tipo (UUID)';
+COMMENT ON COLUMN form_types.name IS 'Nome do tipo de formulário';
+COMMENT ON COLUMN form_types.description IS 'Descrição do tipo de formulário';
+COMMENT ON COLUMN form_types.active IS 'Tipos inativos não aceitam novos atendimentos';
+COMMENT ON COLUMN form_types.current_version IS 'Versão do esquema usada nos novos atendimentos';
+COMMENT ON COLUMN form_types.created_at IS 'Data e hora do cadastro';
+COMMENT ON COLUMN form_types.updated_at IS 'Data e hora da última alteração';
+
+-- O esquema é JSON, e não JSONB, para guardar as propriedades na ordem em que
+-- o administrador as escreveu.
+CREATE TABLE IF NOT EXISTS form_type_versions (
+    form_type_id UUID NOT NULL,
+    version INTEGER NOT NULL,
+    schema JSON NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (form_type_id, version),
+    CONSTRAINT fk_form_type_versions_form_type FOREIGN KEY (form_type_id) REFERENCES form_types(id) ON DELETE RESTRICT
+);
+
+COMMENT ON TABLE form_type_versions IS 'Versões publicadas do esquema de cada tipo de formulário; não mudam depois de publicadas';
+COMMENT ON COLUMN form_type_versions.form_type_id IS 'Tipo de formulário da versão';
+COMMENT ON COLUMN form_type_versions.version IS 'Número sequencial da versão no tipo, a partir de 1';
+COMMENT ON COLUMN form_type_versions.schema IS 'JSON Schema dos campos extras, como enviado pelo administrador';
+COMMENT ON COLUMN form_type_versions.created_by IS 'Usuário que publicou a versão';
+COMMENT ON COLUMN form_type_versions.created_at IS 'Data e hora da publicação';
+
+ALTER TABLE forms
+    ADD COLUMN IF NOT EXISTS form_type_id UUID,
+    ADD COLUMN IF NOT EXISTS form_type_version INTEGER,
+    ADD COLUMN IF NOT EXISTS form_data JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ADD CONSTRAINT fk_forms_form_type_version FOREIGN KEY (form_type_id, form_type_version)
+        REFERENCES form_type_versions(form_type_id, version) ON DELETE RESTRICT,
+    ADD CONSTRAINT forms_form_type_check
+        CHECK ((form_type_id IS NULL) = (form_type_version IS NULL));
+
+CREATE INDEX IF NOT EXISTS idx_forms_form_type_id ON forms(form_type_id)
+    WHERE form_type_id IS NOT NULL;
+
+COMMENT ON COLUMN forms.form_type_id IS 'Tipo de formulário do atendimento (NULL = sem campos extras)';
+COMMENT ON COLUMN forms.form_type_version IS 'Versão do esquema com que o atendimento foi aberto e é validado';
+COMMENT ON COLUMN forms.form_data IS 'Valores dos campos extras conforme o esquema da versão';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_forms_form_type_id;
+ALTER TABLE forms
+    DROP CONSTRAINT IF EXISTS forms_form_type_check,
+    DROP CONSTRAINT IF EXISTS fk_forms_form_type_version,
+    DROP COLUMN IF EXISTS form_type_id,
+    DROP COLUMN IF EXISTS form_type_version,
+    DROP COLUMN IF EXISTS form_data;
+DROP TABLE IF EXISTS form_type_versions;
+DROP TABLE IF EXISTS form_types;
+-- +goose StatementEnd
@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: satisfaction_surveys
+-- Descrição: Pesquisas de satisfação (CSAT e NPS) enviadas ao contato do
+--            cliente quando o atendimento é resolvido, respondidas por um
+--            link com token, sem login
+-- Relacionamento: satisfaction_surveys 1:1 com forms
+-- Versão: 1.0
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS satisfaction_surveys (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    form_id UUID NOT NULL,
+
+    token_hash CHAR(64),
+    sent_to VARCHAR(254),
+    sent_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    send_attempts INTEGER NOT NULL DEFAULT 0,
+
+    csat_score SMALLINT,
+    nps_score SMALLINT,
+    comment VARCHAR(1000) NOT NULL DEFAULT '',
+    responded_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_satisfaction_surveys_form FOREIGN KEY (form_id) REFERENCES forms(id) ON DELETE CASCADE,
+    CONSTRAINT satisfaction_surveys_form_unique UNIQUE (form_id),
+    CONSTRAINT satisfaction_surveys_token_hash_unique UNIQUE (token_hash),
+    CONSTRAINT satisfaction_surveys_sent_check
+        CHECK ((sent_at IS NULL) = (token_hash IS NULL) AND (sent_at IS NULL) = (expires_at IS NULL)),
+    CONSTRAINT satisfaction_surveys_answer_check
+        CHECK ((responded_at IS NULL) = (csat_score IS NULL) AND (responded_at IS NULL) = (nps_score IS NULL)),
+    CONSTRAINT satisfaction_surveys_answered_after_sent_check
+        CHECK (responded_at IS NULL OR sent_at IS NOT NULL),
+    CONSTRAINT satisfaction_surveys_csat_range CHECK (csat_score IS NULL OR csat_score BETWEEN 1 AND 5),
+    CONSTRAINT satisfaction_surveys_nps_range CHECK (nps_score IS NULL OR nps_score BETWEEN 0 AND 10)
+);
+
+CREATE INDEX IF NOT EXISTS idx_satisfaction_surveys_pending ON satisfaction_surveys(created_at)
+    WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_satisfaction_surveys_sent_at ON satisfaction_surveys(sent_at)
+    WHERE sent_at IS NOT NULL;
+
+COMMENT ON TABLE satisfaction_surveys IS 'Pesquisa de satisfação de cada atendimento resolvido; uma resposta por atendimento';
+COMMENT ON COLUMN satisfaction_surveys.id IS 'Identificador único da pesquisa (UUID)';
+COMMENT ON COLUMN satisfaction_surveys.form_id IS 'Atendimento avaliado';
+COMMENT ON COLUMN satisfaction_surveys.token_hash IS 'SHA-256 do token do link enviado; o token não é gravado';
+COMMENT ON COLUMN satisfaction_surveys.sent_to IS 'E-mail para o qual a pesquisa foi enviada';
+COMMENT ON COLUMN satisfaction_surveys.sent_at IS 'Data e hora do envio (NULL = aguardando envio)';
+COMMENT ON COLUMN satisfaction_surveys.expires_at IS 'Validade do link';
+COMMENT ON COLUMN satisfaction_surveys.send_attempts IS 'Tentativas de envio que falharam';
+COMMENT ON COLUMN satisfaction_surveys.csat_score IS 'Satisfação com o atendimento, de 1 a 5';
+COMMENT ON COLUMN satisfaction_surveys.nps_score IS 'Probabilidade de recomendar a empresa, de 0 a 10';
+COMMENT ON COLUMN satisfaction_surveys.comment IS 'Comentário livre do cliente';
+COMMENT ON COLUMN satisfaction_surveys.responded_at IS 'Data e hora da resposta';
+COMMENT ON COLUMN satisfaction_surveys.created_at IS 'Data e hora em que o atendimento foi resolvido';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS satisfaction_surveys;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: members
+-- Descrição: Localização da base e última localização informada pelo técnico,
+--            usadas nas sugestões de designação de atendimentos
+-- Alteração: members (coordenadas da base e localização atual)
+-- Versão: 1.0
+-- ============================================================================
+
+ALTER TABLE members
+    ADD COLUMN IF NOT EXISTS home_latitude DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS home_longitude DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS current_latitude DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS current_longitude DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS current_located_at TIMESTAMPTZ,
+    ADD CONSTRAINT members_home_location_check CHECK (
+        (home_latitude IS NULL) = (home_longitude IS NULL)
+        AND (home_latitude IS NULL OR home_latitude BETWEEN -90 AND 90)
+        AND (home_longitude IS NULL OR home_longitude BETWEEN -180 AND 180)
+    ),
+    ADD CONSTRAINT members_current_location_check CHECK (
+        (current_latitude IS NULL) = (current_longitude IS NULL)
+        AND (current_latitude IS NULL) = (current_located_at IS NULL)
+        AND (current_latitude IS NULL OR current_latitude BETWEEN -90 AND 90)
+        AND (current_longitude IS NULL OR current_longitude BETWEEN -180 AND 180)
+    );
+
+COMMENT ON COLUMN members.home_latitude IS 'Latitude da base do técnico (casa ou escritório)';
+COMMENT ON COLUMN members.home_longitude IS 'Longitude da base do técnico';
+COMMENT ON COLUMN members.current_latitude IS 'Última latitude informada pelo técnico';
+COMMENT ON COLUMN members.current_longitude IS 'Última longitude informada pelo técnico';
+COMMENT ON COLUMN members.current_located_at IS 'Data e hora da última localização informada';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE members
+    DROP CONSTRAINT IF EXISTS members_current_location_check,
+    DROP CONSTRAINT IF EXISTS members_home_location_check,
+    DROP COLUMN IF EXISTS current_located_at,
+    DROP COLUMN IF EXISTS current_longitude,
+    DROP COLUMN IF EXISTS current_latitude,
+    DROP COLUMN IF EXISTS home_longitude,
+    DROP COLUMN IF EXISTS home_latitude;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: client_merges
+-- Descrição: O histórico de unificação deixa de ser apagado junto com o
+--            cliente; a exclusão definitiva de um cliente unificado passa a
+--            ser recusada
+-- Alteração: client_merges (ON DELETE RESTRICT nos clientes de origem e destino)
+-- Versão: 1.0
+-- ============================================================================
+
+ALTER TABLE client_merges
+    DROP CONSTRAINT IF EXISTS client_merges_source_client_id_fkey,
+    DROP CONSTRAINT IF EXISTS client_merges_target_client_id_fkey,
+    ADD CONSTRAINT client_merges_source_client_id_fkey
+        FOREIGN KEY (source_client_id) REFERENCES clients(id) ON DELETE RESTRICT,
+    ADD CONSTRAINT client_merges_target_client_id_fkey
+        FOREIGN KEY (target_client_id) REFERENCES clients(id) ON DELETE RESTRICT;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE client_merges
+    DROP CONSTRAINT IF EXISTS client_merges_source_client_id_fkey,
+    DROP CONSTRAINT IF EXISTS client_merges_target_client_id_fkey,
+    ADD CONSTRAINT client_merges_source_client_id_fkey
+        FOREIGN KEY (source_client_id) REFERENCES clients(id) ON DELETE CASCADE,
+    ADD CONSTRAINT client_merges_target_client_id_fkey
+        FOREIGN KEY (target_client_id) REFERENCES clients(id) ON DELETE CASCADE;
+-- +goose StatementEnd
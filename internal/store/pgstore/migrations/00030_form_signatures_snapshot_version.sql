@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ============================================================================
+-- Tabela: form_signatures
+-- Descrição: Guarda a versão do conjunto de campos coberto pelo hash do
+--            atendimento; as assinaturas existentes ficam na versão 1, sem o
+--            tipo de formulário e os dados estruturados
+-- Alteração: form_signatures (snapshot_version)
+-- Versão: 1.0
+-- ============================================================================
+
+ALTER TABLE form_signatures
+    ADD COLUMN IF NOT EXISTS snapshot_version SMALLINT NOT NULL DEFAULT 1,
+    ADD CONSTRAINT form_signatures_snapshot_version_check CHECK (snapshot_version >= 1);
+
+COMMENT ON COLUMN form_signatures.snapshot_version IS 'Versão dos campos do atendimento cobertos por snapshot_sha256';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE form_signatures
+    DROP CONSTRAINT IF EXISTS form_signatures_snapshot_version_check,
+    DROP COLUMN IF EXISTS snapshot_version;
+-- +goose StatementEnd
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
 )
 
 type ClientType string
@@ -141,6 +142,42 @@ func (ns NullMemberRole) Value() (driver.Value, error) {
 	return string(ns.MemberRole), nil
 }
 
+// Valores de cobrança dos atendimentos avulsos (linha única)
+type BillingSetting struct {
+	// Sempre 1: a organização tem uma única configuração
+	ID int16 `json:"id"`
+	// Valor da hora de atendimentos de dificuldade baixa
+	HourRateLow decimal.Decimal `json:"hour_rate_low"`
+	// Valor da hora de atendimentos de dificuldade média
+	HourRateMedium decimal.Decimal `json:"hour_rate_medium"`
+	// Valor da hora de atendimentos de dificuldade alta
+	HourRateHigh decimal.Decimal `json:"hour_rate_high"`
+	// Taxa de deslocamento cobrada por atendimento
+	TravelFee decimal.Decimal `json:"travel_fee"`
+	// Alíquota de impostos padrão das novas faturas, em porcentagem
+	TaxRate decimal.Decimal `json:"tax_rate"`
+	// Usuário que alterou os valores por último
+	UpdatedBy pgtype.UUID `json:"updated_by"`
+	// Data e hora da última alteração
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Modelos de lista de verificação por tipo de serviço
+type ChecklistTemplate struct {
+	// Identificador único do modelo (UUID)
+	ID uuid.UUID `json:"id"`
+	// Nome do modelo, normalmente o tipo de serviço
+	Name string `json:"name"`
+	// Descrição do modelo
+	Description string `json:"description"`
+	// Itens na ordem da lista (descrição, tipo, obrigatoriedade, unidade e faixa)
+	Items []byte `json:"items"`
+	// Data e hora do cadastro
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última alteração
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 // Clientes do sistema (empresas e pessoas físicas) - avulso ou contrato
 type Client struct {
 	// Identificador único do cliente (UUID)
@@ -181,6 +218,101 @@ type Client struct {
 	CreatedAt time.Time `json:"created_at"`
 	// Data e hora da última atualização (trigger automático)
 	UpdatedAt time.Time `json:"updated_at"`
+	// Data e hora da exclusão lógica (NULL = ativo)
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	// Valores dos campos personalizados (chave => valor tipado)
+	CustomFields []byte `json:"custom_fields"`
+	// Tags livres do cliente (minúsculas)
+	Tags []string `json:"tags"`
+}
+
+// Histórico de unificação de clientes duplicados
+type ClientMerge struct {
+	ID uuid.UUID `json:"id"`
+	// Cliente duplicado, arquivado após a unificação
+	SourceClientID uuid.UUID `json:"source_client_id"`
+	// Cliente que permanece e recebe os registros
+	TargetClientID uuid.UUID `json:"target_client_id"`
+	// Dados do cliente duplicado no momento da unificação
+	SourceSnapshot []byte `json:"source_snapshot"`
+	// Quantidade de atendimentos transferidos
+	FormsMoved int32 `json:"forms_moved"`
+	// Quantidade de contratos transferidos
+	ContractsMoved int32 `json:"contracts_moved"`
+	// Usuário que realizou a unificação
+	MergedBy pgtype.UUID `json:"merged_by"`
+	// Data e hora da unificação
+	MergedAt time.Time `json:"merged_at"`
+}
+
+// Usuários do portal do cliente
+type ClientUser struct {
+	// Identificador único do usuário do portal (UUID)
+	ID uuid.UUID `json:"id"`
+	// Cliente ao qual o usuário pertence; limita os dados visíveis no portal
+	ClientID uuid.UUID `json:"client_id"`
+	// Nome do usuário do portal
+	Name string `json:"name"`
+	// E-mail de login, único entre usuários do portal
+	Email string `json:"email"`
+	// Hash da senha (bcrypt)
+	PasswordHash []byte `json:"password_hash"`
+	// Data e hora do último login no portal
+	LastLoginAt pgtype.Timestamptz `json:"last_login_at"`
+	// Data e hora de criação do registro
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última atualização
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Contratos de serviço dos clientes do tipo contrato
+type Contract struct {
+	// Identificador único do contrato (UUID)
+	ID uuid.UUID `json:"id"`
+	// Referência ao cliente contratante
+	ClientID uuid.UUID `json:"client_id"`
+	// Data de início da vigência (inclusiva)
+	StartDate pgtype.Date `json:"start_date"`
+	// Data de término da vigência (inclusiva)
+	EndDate pgtype.Date `json:"end_date"`
+	// Franquia de horas por período de faturamento (mês)
+	MonthlyHours decimal.Decimal `json:"monthly_hours"`
+	// Serviços cobertos pelo contrato
+	CoveredServices []string `json:"covered_services"`
+	// Meta de SLA para primeira resposta (horas)
+	ResponseSlaHours int32 `json:"response_sla_hours"`
+	// Meta de SLA para resolução (horas)
+	ResolutionSlaHours int32 `json:"resolution_sla_hours"`
+	// Valor mensal do contrato
+	MonthlyPrice decimal.Decimal `json:"monthly_price"`
+	// Data e hora de criação do registro
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última atualização
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Definições de campos personalizados de clientes e atendimentos
+type CustomFieldDefinition struct {
+	// Identificador único da definição (UUID)
+	ID uuid.UUID `json:"id"`
+	// Entidade do campo: cliente ou atendimento
+	Entity string `json:"entity"`
+	// Chave do campo no JSONB custom_fields
+	FieldKey string `json:"field_key"`
+	// Rótulo exibido ao usuário
+	Label string `json:"label"`
+	// Tipo do campo: texto, numero, data, selecao ou booleano
+	FieldType string `json:"field_type"`
+	// Indica se o campo é obrigatório
+	Required bool `json:"required"`
+	// Opções permitidas para campos do tipo selecao
+	Options []string `json:"options"`
+	// Regras de validação (tamanho, padrão, mínimo e máximo)
+	Rules []byte `json:"rules"`
+	// Data e hora de criação do registro
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última atualização
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type Form struct {
@@ -193,6 +325,252 @@ type Form struct {
 	OccurredAt          time.Time          `json:"occurred_at"`
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	ContractID          pgtype.UUID        `json:"contract_id"`
+	HoursConsumed       decimal.Decimal    `json:"hours_consumed"`
+	// Data e hora da exclusão lógica (NULL = ativo)
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	// Valores dos campos personalizados (chave => valor tipado)
+	CustomFields []byte `json:"custom_fields"`
+	// Tags livres do atendimento (minúsculas)
+	Tags []string `json:"tags"`
+	// Situação do atendimento: aberto, agendado, em_andamento, aguardando_cliente, resolvido, fechado ou cancelado
+	Status string `json:"status"`
+	// Prazo da primeira resposta (NULL = sem SLA)
+	ResponseDueAt pgtype.Timestamptz `json:"response_due_at"`
+	// Momento em que a primeira resposta pendente passa a estar em risco
+	ResponseRiskAt pgtype.Timestamptz `json:"response_risk_at"`
+	// Prazo de resolução (NULL = sem SLA)
+	ResolutionDueAt pgtype.Timestamptz `json:"resolution_due_at"`
+	// Momento em que a resolução pendente passa a estar em risco
+	ResolutionRiskAt pgtype.Timestamptz `json:"resolution_risk_at"`
+	// Data e hora da primeira resposta (saída da situação aberto)
+	RespondedAt pgtype.Timestamptz `json:"responded_at"`
+	// Data e hora da resolução (NULL enquanto pendente)
+	ResolvedAt pgtype.Timestamptz `json:"resolved_at"`
+	// Última situação do SLA notificada: em_risco ou violado
+	SlaNotifiedState pgtype.Text `json:"sla_notified_state"`
+	// Plano de manutenção que gerou o atendimento (NULL = avulso)
+	MaintenancePlanID pgtype.UUID `json:"maintenance_plan_id"`
+	// Visita do plano de manutenção que gerou o atendimento
+	ScheduledFor pgtype.Timestamptz `json:"scheduled_for"`
+	// Protocolo do atendimento (OS-ano-número), sequencial por ano e sem lacunas
+	PublicCode string `json:"public_code"`
+	// Vetor da busca textual (defeito, solução, cliente e solicitante), mantido por gatilho
+	SearchVector interface{} `json:"search_vector"`
+	// Tipo de formulário do atendimento (NULL = sem campos extras)
+	FormTypeID pgtype.UUID `json:"form_type_id"`
+	// Versão do esquema com que o atendimento foi aberto e é validado
+	FormTypeVersion pgtype.Int4 `json:"form_type_version"`
+	// Valores dos campos extras conforme o esquema da versão
+	FormData []byte `json:"form_data"`
+}
+
+// Histórico de atribuição de técnicos aos atendimentos
+type FormAssignmentHistory struct {
+	// Identificador único do registro (UUID)
+	ID uuid.UUID `json:"id"`
+	// Atendimento alterado
+	FormID uuid.UUID `json:"form_id"`
+	// Técnico atribuído ou removido
+	MemberID pgtype.UUID `json:"member_id"`
+	// Ação: atribuido ou removido
+	Action string `json:"action"`
+	// Usuário que fez a alteração
+	ChangedBy pgtype.UUID `json:"changed_by"`
+	// Data e hora da alteração
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// Arquivos anexados aos atendimentos
+type FormAttachment struct {
+	// Identificador único do anexo (UUID)
+	ID uuid.UUID `json:"id"`
+	// Atendimento ao qual o arquivo pertence
+	FormID uuid.UUID `json:"form_id"`
+	// Nome original do arquivo (sem diretórios)
+	FileName string `json:"file_name"`
+	// Tipo identificado pelo conteúdo do arquivo
+	ContentType string `json:"content_type"`
+	// Tamanho em bytes
+	SizeBytes int64 `json:"size_bytes"`
+	// SHA-256 do conteúdo em hexadecimal
+	ChecksumSha256 string `json:"checksum_sha256"`
+	// Chave do arquivo no storage
+	StorageKey string `json:"storage_key"`
+	// Usuário que enviou o arquivo
+	UploadedBy pgtype.UUID `json:"uploaded_by"`
+	// Data e hora do envio
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Itens da lista de verificação dos atendimentos
+type FormChecklistItem struct {
+	// Identificador único do item (UUID)
+	ID uuid.UUID `json:"id"`
+	// Atendimento verificado
+	FormID uuid.UUID `json:"form_id"`
+	// Ordem do item na lista, a partir de 1
+	Position int32 `json:"position"`
+	// Texto do item
+	Label string `json:"label"`
+	// Indica se o item foi verificado
+	Done bool `json:"done"`
+	// Usuário que verificou o item
+	DoneBy pgtype.UUID `json:"done_by"`
+	// Data e hora da verificação (NULL = pendente)
+	DoneAt pgtype.Timestamptz `json:"done_at"`
+	// Modelo de onde o item foi copiado (NULL = plano de manutenção ou modelo excluído)
+	TemplateID pgtype.UUID `json:"template_id"`
+	// Tipo de resposta: marcacao, aprovacao, numero, texto ou foto
+	Kind string `json:"kind"`
+	// Indica se o item precisa de resposta para resolver o atendimento
+	Required bool `json:"required"`
+	// Unidade da leitura dos itens numéricos
+	Unit string `json:"unit"`
+	// Menor leitura aceita (NULL = sem limite)
+	MinValue pgtype.Float8 `json:"min_value"`
+	// Maior leitura aceita (NULL = sem limite)
+	MaxValue pgtype.Float8 `json:"max_value"`
+	// Resposta dos itens de aprovação
+	Passed pgtype.Bool `json:"passed"`
+	// Leitura dos itens numéricos
+	NumberValue pgtype.Float8 `json:"number_value"`
+	// Resposta dos itens de texto
+	TextValue string `json:"text_value"`
+	// Foto anexada ao atendimento como resposta do item
+	AttachmentID pgtype.UUID `json:"attachment_id"`
+	// Indica leitura fora da faixa aceita
+	OutOfRange bool `json:"out_of_range"`
+}
+
+// Último número de protocolo usado em cada ano
+type FormCodeSequence struct {
+	// Ano do protocolo
+	Year int32 `json:"year"`
+	// Número do último protocolo do ano
+	LastNumber int64 `json:"last_number"`
+}
+
+// Comentários dos atendimentos
+type FormComment struct {
+	// Identificador único do comentário (UUID)
+	ID uuid.UUID `json:"id"`
+	// Atendimento comentado
+	FormID uuid.UUID `json:"form_id"`
+	// Usuário que escreveu o comentário
+	AuthorID pgtype.UUID `json:"author_id"`
+	// Texto do comentário em Markdown
+	Body string `json:"body"`
+	// Indica se o comentário é visível apenas para a equipe
+	Internal bool `json:"internal"`
+	// Data e hora de criação do comentário
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última edição
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Histórico de edições dos comentários
+type FormCommentEdit struct {
+	// Identificador único da edição (UUID)
+	ID uuid.UUID `json:"id"`
+	// Comentário editado
+	CommentID uuid.UUID `json:"comment_id"`
+	// Texto do comentário antes da edição
+	PreviousBody string `json:"previous_body"`
+	// Visibilidade do comentário antes da edição
+	PreviousInternal bool `json:"previous_internal"`
+	// Usuário que fez a edição
+	EditedBy pgtype.UUID `json:"edited_by"`
+	// Data e hora da edição
+	EditedAt time.Time `json:"edited_at"`
+}
+
+// Peças e materiais usados nos atendimentos
+type FormPart struct {
+	// Identificador único do item (UUID)
+	ID uuid.UUID `json:"id"`
+	// Atendimento em que a peça foi usada
+	FormID uuid.UUID `json:"form_id"`
+	// Peça usada
+	PartID uuid.UUID `json:"part_id"`
+	// Local de estoque de onde a peça saiu
+	LocationID uuid.UUID `json:"location_id"`
+	// Quantidade usada
+	Quantity decimal.Decimal `json:"quantity"`
+	// Preço unitário cobrado
+	UnitPrice decimal.Decimal `json:"unit_price"`
+	// Custo unitário da peça no lançamento
+	UnitCost decimal.Decimal `json:"unit_cost"`
+	// Usuário que lançou a peça
+	CreatedBy pgtype.UUID `json:"created_by"`
+	// Data e hora do lançamento
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Assinaturas dos clientes na conclusão dos atendimentos
+type FormSignature struct {
+	// Identificador único da assinatura (UUID)
+	ID uuid.UUID `json:"id"`
+	// Atendimento assinado (no máximo uma assinatura por atendimento)
+	FormID uuid.UUID `json:"form_id"`
+	// Nome de quem assinou
+	SignerName string `json:"signer_name"`
+	// Documento de quem assinou (CPF, RG ou outro)
+	SignerDocument string `json:"signer_document"`
+	// Data e hora da assinatura informadas pelo dispositivo
+	SignedAt time.Time `json:"signed_at"`
+	// Formato da assinatura: png, svg ou tracos (JSON com os traços)
+	Format string `json:"format"`
+	// Tipo do arquivo gravado no storage
+	ContentType string `json:"content_type"`
+	// Tamanho do arquivo em bytes
+	SizeBytes int64 `json:"size_bytes"`
+	// SHA-256 do arquivo em hexadecimal
+	ChecksumSha256 string `json:"checksum_sha256"`
+	// Chave do arquivo no storage
+	StorageKey string `json:"storage_key"`
+	// Latitude do técnico no momento da coleta
+	Latitude float64 `json:"latitude"`
+	// Longitude do técnico no momento da coleta
+	Longitude float64 `json:"longitude"`
+	// Precisão da posição em metros, quando informada
+	AccuracyMeters pgtype.Float8 `json:"accuracy_meters"`
+	// SHA-256 do conteúdo do atendimento no momento da assinatura
+	SnapshotSha256 string `json:"snapshot_sha256"`
+	// Versão dos campos do atendimento cobertos por snapshot_sha256
+	SnapshotVersion int16 `json:"snapshot_version"`
+	// Técnico que coletou a assinatura
+	CapturedBy pgtype.UUID `json:"captured_by"`
+	// Data e hora do registro
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Atendimentos semelhantes que ajudaram a resolver outro atendimento
+type FormSimilarityFeedback struct {
+	// Atendimento em que a sugestão foi exibida
+	FormID uuid.UUID `json:"form_id"`
+	// Atendimento sugerido que ajudou
+	SimilarFormID uuid.UUID `json:"similar_form_id"`
+	// Usuário que marcou a sugestão (um voto por usuário)
+	UserID    uuid.UUID `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Histórico de mudanças de situação dos atendimentos
+type FormStatusHistory struct {
+	ID uuid.UUID `json:"id"`
+	// Atendimento alterado
+	FormID uuid.UUID `json:"form_id"`
+	// Situação anterior
+	FromStatus string `json:"from_status"`
+	// Nova situação
+	ToStatus string `json:"to_status"`
+	// Motivo informado na mudança
+	Reason pgtype.Text `json:"reason"`
+	// Usuário que fez a mudança
+	ChangedBy pgtype.UUID `json:"changed_by"`
+	// Data e hora da mudança
+	ChangedAt time.Time `json:"changed_at"`
 }
 
 type FormTecnico struct {
@@ -201,6 +579,195 @@ type FormTecnico struct {
 	FormID    uuid.UUID          `json:"form_id"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	// Início previsto da visita do técnico (NULL = sem agendamento)
+	ScheduledStart pgtype.Timestamptz `json:"scheduled_start"`
+	// Fim previsto da visita do técnico
+	ScheduledEnd pgtype.Timestamptz `json:"scheduled_end"`
+}
+
+// Tipos de formulário com campos extras definidos por JSON Schema
+type FormType struct {
+	// Identificador único do tipo (UUID)
+	ID uuid.UUID `json:"id"`
+	// Nome do tipo de formulário
+	Name string `json:"name"`
+	// Descrição do tipo de formulário
+	Description string `json:"description"`
+	// Tipos inativos não aceitam novos atendimentos
+	Active bool `json:"active"`
+	// Versão do esquema usada nos novos atendimentos
+	CurrentVersion int32 `json:"current_version"`
+	// Data e hora do cadastro
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última alteração
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Versões publicadas do esquema de cada tipo de formulário; não mudam depois de publicadas
+type FormTypeVersion struct {
+	// Tipo de formulário da versão
+	FormTypeID uuid.UUID `json:"form_type_id"`
+	// Número sequencial da versão no tipo, a partir de 1
+	Version int32 `json:"version"`
+	// JSON Schema dos campos extras, como enviado pelo administrador
+	Schema []byte `json:"schema"`
+	// Usuário que publicou a versão
+	CreatedBy pgtype.UUID `json:"created_by"`
+	// Data e hora da publicação
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Apontamentos de horas dos técnicos nos atendimentos
+type FormWorkLog struct {
+	// Identificador único do apontamento (UUID)
+	ID uuid.UUID `json:"id"`
+	// Atendimento em que o tempo foi gasto
+	FormID uuid.UUID `json:"form_id"`
+	// Técnico que trabalhou no período
+	MemberID pgtype.UUID `json:"member_id"`
+	// Tipo: deslocamento ou no_local
+	Kind string `json:"kind"`
+	// Origem: cronometro ou manual
+	Source string `json:"source"`
+	// Início do período
+	StartedAt time.Time `json:"started_at"`
+	// Fim do período; nulo enquanto o cronômetro está em andamento
+	EndedAt pgtype.Timestamptz `json:"ended_at"`
+	// Observações do técnico
+	Notes string `json:"notes"`
+	// Usuário que registrou o apontamento
+	CreatedBy pgtype.UUID `json:"created_by"`
+	// Data e hora de criação do registro
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última atualização
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Feriados, que não contam nos prazos de SLA
+type Holiday struct {
+	// Dia do feriado
+	HolidayDate pgtype.Date `json:"holiday_date"`
+	// Nome do feriado
+	Name string `json:"name"`
+	// Data e hora do cadastro
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Faturas de atendimentos avulsos
+type Invoice struct {
+	// Identificador único da fatura (UUID)
+	ID uuid.UUID `json:"id"`
+	// Número sequencial atribuído na emissão (nulo em rascunhos)
+	Number pgtype.Int8 `json:"number"`
+	// Cliente faturado
+	ClientID uuid.UUID `json:"client_id"`
+	// Situação da fatura: rascunho, emitida, paga ou cancelada
+	Status string `json:"status"`
+	// Soma dos itens
+	Subtotal decimal.Decimal `json:"subtotal"`
+	// Desconto abatido do subtotal
+	Discount decimal.Decimal `json:"discount"`
+	// Alíquota de impostos, em porcentagem, sobre o subtotal com desconto
+	TaxRate decimal.Decimal `json:"tax_rate"`
+	// Valor dos impostos
+	TaxAmount decimal.Decimal `json:"tax_amount"`
+	// Valor total a pagar
+	Total decimal.Decimal `json:"total"`
+	// Observações impressas na fatura
+	Notes string `json:"notes"`
+	// Data de vencimento
+	DueDate pgtype.Date `json:"due_date"`
+	// Data e hora da emissão
+	IssuedAt pgtype.Timestamptz `json:"issued_at"`
+	// Data e hora em que o pagamento foi registrado
+	PaidAt pgtype.Timestamptz `json:"paid_at"`
+	// Data e hora do cancelamento
+	CanceledAt pgtype.Timestamptz `json:"canceled_at"`
+	// Motivo do cancelamento
+	CancelReason string `json:"cancel_reason"`
+	// Usuário que gerou a fatura
+	CreatedBy pgtype.UUID `json:"created_by"`
+	// Data e hora da geração
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última alteração
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Atendimentos cobrados em faturas não canceladas
+type InvoiceForm struct {
+	// Atendimento cobrado
+	FormID uuid.UUID `json:"form_id"`
+	// Fatura que cobra o atendimento
+	InvoiceID uuid.UUID `json:"invoice_id"`
+}
+
+// Itens das faturas: mão de obra, peças e deslocamento
+type InvoiceItem struct {
+	// Identificador único do item (UUID)
+	ID uuid.UUID `json:"id"`
+	// Fatura do item
+	InvoiceID uuid.UUID `json:"invoice_id"`
+	// Atendimento que originou o item
+	FormID pgtype.UUID `json:"form_id"`
+	// Ordem do item na fatura
+	Position int32 `json:"position"`
+	// Tipo do item: mao_de_obra, peca ou deslocamento
+	Kind string `json:"kind"`
+	// Descrição impressa
+	Description string `json:"description"`
+	// Horas, quantidade de peças ou visitas
+	Quantity decimal.Decimal `json:"quantity"`
+	// Valor unitário
+	UnitPrice decimal.Decimal `json:"unit_price"`
+	// Quantidade vezes valor unitário, em centavos
+	Total decimal.Decimal `json:"total"`
+}
+
+// Último número de fatura emitido (linha única)
+type InvoiceSequence struct {
+	ID int16 `json:"id"`
+	// Número da última fatura emitida
+	LastNumber int64 `json:"last_number"`
+}
+
+// Planos de manutenção preventiva dos clientes
+type MaintenancePlan struct {
+	// Identificador único do plano (UUID)
+	ID uuid.UUID `json:"id"`
+	// Cliente atendido pelo plano
+	ClientID uuid.UUID `json:"client_id"`
+	// Nome do plano, usado como solicitante dos atendimentos
+	Name string `json:"name"`
+	// Local do cliente atendido (vazio = cliente inteiro)
+	Site string `json:"site"`
+	// Descrição do serviço preventivo
+	Description string `json:"description"`
+	// Regra de recorrência no formato RRULE (RFC 5545)
+	Rrule string `json:"rrule"`
+	// Primeira visita da série; define o horário das visitas
+	StartsAt time.Time `json:"starts_at"`
+	// Nível de dificuldade dos atendimentos gerados
+	DifficultyLevel DifficultyLevel `json:"difficulty_level"`
+	// Itens da lista de verificação padrão
+	Checklist []string `json:"checklist"`
+	// Situação: ativo ou pausado
+	Status string `json:"status"`
+	// Fim da última janela de visitas já gerada (NULL = nenhuma)
+	GeneratedUntil pgtype.Timestamptz `json:"generated_until"`
+	// Usuário que cadastrou o plano
+	CreatedBy pgtype.UUID `json:"created_by"`
+	// Data e hora do cadastro
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última alteração
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Técnicos padrão dos planos de manutenção
+type MaintenancePlanTecnico struct {
+	// Plano de manutenção
+	PlanID uuid.UUID `json:"plan_id"`
+	// Técnico atribuído às visitas do plano
+	MemberID uuid.UUID `json:"member_id"`
 }
 
 // Membros operacionais do sistema (técnicos, auxiliares, estagiários e admins)
@@ -217,6 +784,208 @@ type Member struct {
 	CreatedAt time.Time `json:"created_at"`
 	// Data e hora da última atualização (trigger automático)
 	UpdatedAt time.Time `json:"updated_at"`
+	// Latitude da base do técnico (casa ou escritório)
+	HomeLatitude pgtype.Float8 `json:"home_latitude"`
+	// Longitude da base do técnico
+	HomeLongitude pgtype.Float8 `json:"home_longitude"`
+	// Última latitude informada pelo técnico
+	CurrentLatitude pgtype.Float8 `json:"current_latitude"`
+	// Última longitude informada pelo técnico
+	CurrentLongitude pgtype.Float8 `json:"current_longitude"`
+	// Data e hora da última localização informada
+	CurrentLocatedAt pgtype.Timestamptz `json:"current_located_at"`
+}
+
+// Notificações dos usuários
+type Notification struct {
+	// Identificador único da notificação (UUID)
+	ID uuid.UUID `json:"id"`
+	// Usuário notificado
+	UserID uuid.UUID `json:"user_id"`
+	// Atendimento relacionado
+	FormID pgtype.UUID `json:"form_id"`
+	// Tipo da notificação: sla_em_risco ou sla_violado
+	Kind string `json:"kind"`
+	// Texto da notificação
+	Message string `json:"message"`
+	// Data e hora da notificação
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da leitura (NULL = não lida)
+	ReadAt pgtype.Timestamptz `json:"read_at"`
+}
+
+// Catálogo de peças e materiais
+type Part struct {
+	// Identificador único da peça (UUID)
+	ID uuid.UUID `json:"id"`
+	// Código da peça (SKU), único e em maiúsculas
+	Sku string `json:"sku"`
+	// Nome da peça
+	Name string `json:"name"`
+	// Unidade de medida: un, par, cx, kit, m, rolo, kg ou l
+	Unit string `json:"unit"`
+	// Custo unitário
+	Cost decimal.Decimal `json:"cost"`
+	// Preço unitário de venda
+	Price decimal.Decimal `json:"price"`
+	// Estoque mínimo somando todos os locais (0 = sem alerta)
+	MinStock decimal.Decimal `json:"min_stock"`
+	// Peças inativas não podem ser lançadas em atendimentos
+	Active bool `json:"active"`
+	// Data e hora do cadastro
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última alteração
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Solicitações de atendimento abertas pelo portal do cliente
+type PortalRequest struct {
+	// Identificador único da solicitação (UUID)
+	ID uuid.UUID `json:"id"`
+	// Cliente que abriu a solicitação
+	ClientID uuid.UUID `json:"client_id"`
+	// Usuário do portal que abriu a solicitação
+	ClientUserID pgtype.UUID `json:"client_user_id"`
+	// Nome do solicitante no momento da abertura
+	RequesterName string `json:"requester_name"`
+	// Descrição do problema informada pelo cliente
+	Description string `json:"description"`
+	// Situação: aberta, convertida (virou atendimento) ou recusada
+	Status string `json:"status"`
+	// Atendimento gerado a partir da solicitação
+	FormID pgtype.UUID `json:"form_id"`
+	// Observação da equipe ao converter ou recusar
+	ReviewNote pgtype.Text `json:"review_note"`
+	// Data e hora de abertura da solicitação
+	CreatedAt time.Time `json:"created_at"`
+	// Data e hora da última atualização
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Pesquisa de satisfação de cada atendimento resolvido; uma resposta por atendimento
+type SatisfactionSurvey struct {
+	// Identificador único da pesquisa (UUID)
+	ID uuid.UUID `json:"id"`
+	// Atendimento avaliado
+	FormID uuid.UUID `json:"form_id"`
+	// SHA-256 do token do link enviado; o token não é gravado
+	TokenHash pgtype.Text `json:"token_hash"`
+	// E-mail para o qual a pesquisa foi enviada
+	SentTo pgtype.Text `json:"sent_to"`
+	// Data e hora do envio (NULL = aguardando envio)
+	SentAt pgtype.Timestamptz `json:"sent_at"`
+	// Validade do link
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	// Tentativas de envio que falharam
+	SendAttempts int32 `json:"send_attempts"`
+	// Satisfação com o atendimento, de 1 a 5
+	CsatScore pgtype.Int2 `json:"csat_score"`
+	// Probabilidade de recomendar a empresa, de 0 a 10
+	NpsScore pgtype.Int2 `json:"nps_score"`
+	// Comentário livre do cliente
+	Comment string `json:"comment"`
+	// Data e hora da resposta
+	RespondedAt pgtype.Timestamptz `json:"responded_at"`
+	// Data e hora em que o atendimento foi resolvido
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Modelo da ordem de serviço em PDF (linha única)
+type ServiceOrderTemplate struct {
+	// Sempre 1: a organização tem um único modelo
+	ID int16 `json:"id"`
+	// Nome da empresa no cabeçalho
+	CompanyName string `json:"company_name"`
+	// CNPJ ou CPF da empresa
+	CompanyDocument string `json:"company_document"`
+	// Endereço da empresa
+	Address string `json:"address"`
+	// Telefone da empresa
+	Phone string `json:"phone"`
+	// E-mail da empresa
+	Email string `json:"email"`
+	// Site da empresa
+	Website string `json:"website"`
+	// Texto do rodapé de todas as páginas
+	FooterText string `json:"footer_text"`
+	// Cor dos títulos em hexadecimal (#RRGGBB)
+	PrimaryColor string `json:"primary_color"`
+	// Fuso das datas impressas (IANA)
+	Timezone string `json:"timezone"`
+	// Chave do logotipo no storage (vazio quando não há logotipo)
+	LogoKey string `json:"logo_key"`
+	// Tipo do logotipo (image/png ou image/jpeg)
+	LogoContentType string `json:"logo_content_type"`
+	// Usuário que alterou o modelo por último
+	UpdatedBy pgtype.UUID `json:"updated_by"`
+	// Data e hora da última alteração
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Prazos de SLA por nível de dificuldade e tipo de cliente
+type SlaPolicy struct {
+	// Nível de dificuldade do atendimento
+	DifficultyLevel DifficultyLevel `json:"difficulty_level"`
+	// Tipo de cliente
+	ClientType ClientType `json:"client_type"`
+	// Prazo da primeira resposta em minutos úteis
+	ResponseMinutes int32 `json:"response_minutes"`
+	// Prazo de resolução em minutos úteis
+	ResolutionMinutes int32 `json:"resolution_minutes"`
+	// Data e hora da última alteração
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Saldo de cada peça em cada local de estoque
+type StockLevel struct {
+	// Local de estoque
+	LocationID uuid.UUID `json:"location_id"`
+	// Peça
+	PartID uuid.UUID `json:"part_id"`
+	// Quantidade disponível, nunca negativa
+	Quantity decimal.Decimal `json:"quantity"`
+	// Data e hora da última movimentação
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Locais de estoque: almoxarifados e veículos dos técnicos
+type StockLocation struct {
+	// Identificador único do local (UUID)
+	ID uuid.UUID `json:"id"`
+	// Tipo do local: almoxarifado ou veiculo
+	Kind string `json:"kind"`
+	// Nome do local
+	Name string `json:"name"`
+	// Técnico dono do veículo (somente veículos)
+	MemberID pgtype.UUID `json:"member_id"`
+	// Data e hora do cadastro
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Histórico das movimentações de estoque
+type StockMovement struct {
+	// Identificador único da movimentação (UUID)
+	ID uuid.UUID `json:"id"`
+	// Peça movimentada
+	PartID uuid.UUID `json:"part_id"`
+	// Local de estoque movimentado
+	LocationID uuid.UUID `json:"location_id"`
+	// Tipo: entrada, saida, ajuste, transferencia, consumo ou estorno
+	Kind string `json:"kind"`
+	// Quantidade com sinal: positiva entra, negativa sai
+	Quantity decimal.Decimal `json:"quantity"`
+	// Atendimento do consumo ou estorno
+	FormID pgtype.UUID `json:"form_id"`
+	// Item do atendimento do consumo ou estorno (mantido após a remoção do item)
+	FormPartID pgtype.UUID `json:"form_part_id"`
+	// Identificador comum às duas pernas de uma transferência
+	TransferID pgtype.UUID `json:"transfer_id"`
+	// Observação do lançamento
+	Notes string `json:"notes"`
+	// Usuário que lançou a movimentação
+	CreatedBy pgtype.UUID `json:"created_by"`
+	// Data e hora da movimentação
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // Usuários do sistema com credenciais de autenticação
@@ -0,0 +1,42 @@
+-- name: CreateChecklistTemplateQuery :one
+INSERT INTO checklist_templates (
+    name,
+    description,
+    items
+)
+VALUES ($1, $2, $3)
+RETURNING id;
+
+-- name: DeleteChecklistTemplateQuery :execrows
+DELETE FROM checklist_templates
+WHERE id = $1;
+
+-- name: GetChecklistTemplateByIdQuery :one
+SELECT
+    id,
+    name,
+    description,
+    items,
+    created_at,
+    updated_at
+FROM checklist_templates
+WHERE id = $1;
+
+-- name: GetChecklistTemplatesQuery :many
+SELECT
+    id,
+    name,
+    description,
+    items,
+    created_at,
+    updated_at
+FROM checklist_templates
+ORDER BY name;
+
+-- name: UpdateChecklistTemplateQuery :execrows
+UPDATE checklist_templates
+SET name = $1,
+    description = $2,
+    items = $3,
+    updated_at = NOW()
+WHERE id = $4;
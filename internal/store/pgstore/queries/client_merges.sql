@@ -0,0 +1,81 @@
+-- name: ReassignClientFormsQuery :execrows
+UPDATE forms
+SET client_id = sqlc.arg(target_client_id),
+    updated_at = NOW()
+WHERE client_id = sqlc.arg(source_client_id);
+
+-- name: ReassignClientContractsQuery :execrows
+UPDATE contracts
+SET client_id = sqlc.arg(target_client_id),
+    updated_at = NOW()
+WHERE client_id = sqlc.arg(source_client_id);
+
+-- name: CreateClientMergeQuery :one
+INSERT INTO client_merges (
+    source_client_id,
+    target_client_id,
+    source_snapshot,
+    forms_moved,
+    contracts_moved,
+    merged_by,
+    merged_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id;
+
+-- name: GetClientMergesQuery :many
+SELECT
+    id,
+    source_client_id,
+    target_client_id,
+    source_snapshot,
+    forms_moved,
+    contracts_moved,
+    merged_by,
+    merged_at
+FROM client_merges
+WHERE target_client_id = $1 OR source_client_id = $1
+ORDER BY merged_at DESC;
+
+-- name: ReassignClientUsersQuery :execrows
+UPDATE client_users
+SET client_id = sqlc.arg(target_client_id),
+    updated_at = NOW()
+WHERE client_id = sqlc.arg(source_client_id);
+
+-- name: ReassignClientPortalRequestsQuery :execrows
+UPDATE portal_requests
+SET client_id = sqlc.arg(target_client_id),
+    updated_at = NOW()
+WHERE client_id = sqlc.arg(source_client_id);
+
+-- name: ReassignClientMaintenancePlansQuery :execrows
+UPDATE maintenance_plans
+SET client_id = sqlc.arg(target_client_id),
+    updated_at = NOW()
+WHERE client_id = sqlc.arg(source_client_id);
+
+-- name: ReassignClientInvoicesQuery :execrows
+UPDATE invoices
+SET client_id = sqlc.arg(target_client_id),
+    updated_at = NOW()
+WHERE client_id = sqlc.arg(source_client_id);
+
+-- name: LockMergeClientsQuery :many
+SELECT id, client_type
+FROM clients
+WHERE id = ANY(sqlc.arg(client_ids)::uuid[]) AND deleted_at IS NULL
+ORDER BY id
+FOR UPDATE;
+
+-- name: CountMergeContractsQuery :one
+SELECT
+    COUNT(*)::bigint AS source_contracts,
+    COUNT(*) FILTER (WHERE EXISTS (
+        SELECT 1 FROM contracts t
+        WHERE t.client_id = sqlc.arg(target_client_id)
+          AND t.start_date <= s.end_date
+          AND t.end_date >= s.start_date
+    ))::bigint AS overlapping_contracts
+FROM contracts s
+WHERE s.client_id = sqlc.arg(source_client_id);
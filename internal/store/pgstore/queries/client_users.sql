@@ -0,0 +1,60 @@
+-- name: CreateClientUserQuery :one
+INSERT INTO client_users (
+    client_id,
+    name,
+    email,
+    password_hash
+)
+VALUES ($1, $2, $3, $4)
+RETURNING id;
+
+-- name: GetClientUserByIdQuery :one
+SELECT
+    cu.id,
+    cu.client_id,
+    cu.name,
+    cu.email,
+    cu.password_hash,
+    cu.last_login_at,
+    cu.created_at,
+    cu.updated_at
+FROM client_users cu
+JOIN clients c ON cu.client_id = c.id
+WHERE cu.id = $1 AND c.deleted_at IS NULL;
+
+-- name: GetClientUserByEmailQuery :one
+SELECT
+    cu.id,
+    cu.client_id,
+    cu.name,
+    cu.email,
+    cu.password_hash,
+    cu.last_login_at,
+    cu.created_at,
+    cu.updated_at
+FROM client_users cu
+JOIN clients c ON cu.client_id = c.id
+WHERE cu.email = $1 AND c.deleted_at IS NULL;
+
+-- name: GetClientUsersQuery :many
+SELECT
+    id,
+    client_id,
+    name,
+    email,
+    password_hash,
+    last_login_at,
+    created_at,
+    updated_at
+FROM client_users
+WHERE client_id = $1
+ORDER BY name ASC;
+
+-- name: UpdateClientUserLastLoginQuery :exec
+UPDATE client_users
+SET last_login_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteClientUserQuery :execrows
+DELETE FROM client_users
+WHERE id = $1;
@@ -17,9 +17,12 @@ INSERT INTO clients (
   number,
   complement,
   latitude,
-  longitude
+  longitude,
+
+  custom_fields,
+  tags
    )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 RETURNING id;
 
 -- name: UpdateClientQuery :exec
@@ -41,8 +44,10 @@ SET
   number = $13,
   complement = $14,
   latitude = $15,
-  longitude = $16
-WHERE id = $17;
+  longitude = $16,
+  custom_fields = $17,
+  tags = $18
+WHERE id = $19;
 
 -- name: GetAllClientsQuery :many
 SELECT
@@ -66,9 +71,15 @@ SELECT
   latitude,
   longitude,
 
+  custom_fields,
+  tags,
+
   created_at,
   updated_at
 FROM clients
+WHERE deleted_at IS NULL
+  AND custom_fields @> sqlc.arg(custom_fields)::jsonb
+  AND tags @> sqlc.arg(tags)::text[]
 ORDER BY id DESC;
 
 -- name: GetClientByIdQuery :one
@@ -92,14 +103,38 @@ SELECT
   latitude,
   longitude,
 
+  custom_fields,
+  tags,
+
   created_at,
   updated_at
 FROM clients
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: DeleteClientQuery :exec
+UPDATE clients
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetDeletedClientsQuery :many
+SELECT
+  id,
+  name,
+  client_type,
+  cnpj_cpf,
+  deleted_at
+FROM clients
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC;
+
+-- name: RestoreClientQuery :execrows
+UPDATE clients
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: PurgeClientQuery :execrows
 DELETE FROM clients
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NOT NULL;
 
 -- -- name: GetCalledForClienteQuery :many
 -- SELECT
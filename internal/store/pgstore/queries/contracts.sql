@@ -0,0 +1,134 @@
+-- name: CreateContractQuery :one
+INSERT INTO contracts (
+    client_id,
+    start_date,
+    end_date,
+    monthly_hours,
+    covered_services,
+    response_sla_hours,
+    resolution_sla_hours,
+    monthly_price
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id;
+
+-- name: GetContractByIdQuery :one
+SELECT
+    ct.id,
+    ct.client_id,
+    c.name AS client_name,
+    ct.start_date,
+    ct.end_date,
+    ct.monthly_hours,
+    ct.covered_services,
+    ct.response_sla_hours,
+    ct.resolution_sla_hours,
+    ct.monthly_price,
+    ct.created_at,
+    ct.updated_at
+FROM contracts ct
+JOIN clients c ON ct.client_id = c.id
+WHERE ct.id = $1;
+
+-- name: GetContractsQuery :many
+SELECT
+    ct.id,
+    ct.client_id,
+    c.name AS client_name,
+    ct.start_date,
+    ct.end_date,
+    ct.monthly_hours,
+    ct.covered_services,
+    ct.response_sla_hours,
+    ct.resolution_sla_hours,
+    ct.monthly_price,
+    ct.created_at,
+    ct.updated_at
+FROM contracts ct
+JOIN clients c ON ct.client_id = c.id
+ORDER BY ct.end_date ASC;
+
+-- name: GetContractsByClientQuery :many
+SELECT
+    ct.id,
+    ct.client_id,
+    c.name AS client_name,
+    ct.start_date,
+    ct.end_date,
+    ct.monthly_hours,
+    ct.covered_services,
+    ct.response_sla_hours,
+    ct.resolution_sla_hours,
+    ct.monthly_price,
+    ct.created_at,
+    ct.updated_at
+FROM contracts ct
+JOIN clients c ON ct.client_id = c.id
+WHERE ct.client_id = $1
+ORDER BY ct.start_date DESC;
+
+-- name: GetActiveContractByClientQuery :one
+SELECT
+    ct.id,
+    ct.client_id,
+    c.name AS client_name,
+    ct.start_date,
+    ct.end_date,
+    ct.monthly_hours,
+    ct.covered_services,
+    ct.response_sla_hours,
+    ct.resolution_sla_hours,
+    ct.monthly_price,
+    ct.created_at,
+    ct.updated_at
+FROM contracts ct
+JOIN clients c ON ct.client_id = c.id
+WHERE ct.client_id = sqlc.arg(client_id)
+  AND ct.start_date <= sqlc.arg(reference_date)::date
+  AND ct.end_date >= sqlc.arg(reference_date)::date
+ORDER BY ct.start_date DESC
+LIMIT 1;
+
+-- name: CountOverlappingContractsQuery :one
+SELECT COUNT(*)
+FROM contracts
+WHERE client_id = sqlc.arg(client_id)
+  AND id <> sqlc.arg(id)
+  AND start_date <= sqlc.arg(new_end_date)
+  AND end_date >= sqlc.arg(new_start_date);
+
+-- name: UpdateContractQuery :exec
+UPDATE contracts
+SET start_date = $1,
+    end_date = $2,
+    monthly_hours = $3,
+    covered_services = $4,
+    response_sla_hours = $5,
+    resolution_sla_hours = $6,
+    monthly_price = $7,
+    updated_at = NOW()
+WHERE id = $8;
+
+-- name: DeleteContractQuery :exec
+DELETE FROM contracts
+WHERE id = $1;
+
+-- name: GetContractConsumptionQuery :many
+SELECT
+    f.id,
+    f.occurred_at,
+    f.hours_consumed
+FROM forms f
+WHERE f.contract_id = $1 AND f.deleted_at IS NULL
+ORDER BY f.occurred_at ASC;
+
+-- name: GetContractsConsumedHoursQuery :many
+SELECT
+    ct.id AS contract_id,
+    COALESCE(SUM(f.hours_consumed), 0)::numeric AS consumed_hours
+FROM contracts ct
+LEFT JOIN forms f ON f.contract_id = ct.id
+    AND f.deleted_at IS NULL
+    AND f.occurred_at >= sqlc.arg(period_start)::timestamptz
+    AND f.occurred_at < sqlc.arg(period_end)::timestamptz
+GROUP BY ct.id;
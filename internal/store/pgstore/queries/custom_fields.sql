@@ -0,0 +1,66 @@
+-- name: CreateCustomFieldQuery :one
+INSERT INTO custom_field_definitions (
+    entity,
+    field_key,
+    label,
+    field_type,
+    required,
+    options,
+    rules
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id;
+
+-- name: GetCustomFieldByIdQuery :one
+SELECT
+    id,
+    entity,
+    field_key,
+    label,
+    field_type,
+    required,
+    options,
+    rules,
+    created_at,
+    updated_at
+FROM custom_field_definitions
+WHERE id = $1;
+
+-- name: GetCustomFieldsQuery :many
+SELECT
+    id,
+    entity,
+    field_key,
+    label,
+    field_type,
+    required,
+    options,
+    rules,
+    created_at,
+    updated_at
+FROM custom_field_definitions
+WHERE sqlc.arg(entity)::text = '' OR entity = sqlc.arg(entity)::text
+ORDER BY entity, field_key;
+
+-- name: UpdateCustomFieldQuery :execrows
+UPDATE custom_field_definitions
+SET label = $1,
+    required = $2,
+    options = $3,
+    rules = $4,
+    updated_at = NOW()
+WHERE id = $5;
+
+-- name: DeleteCustomFieldQuery :execrows
+DELETE FROM custom_field_definitions
+WHERE id = $1;
+
+-- name: RemoveClientsCustomFieldQuery :exec
+UPDATE clients
+SET custom_fields = custom_fields - sqlc.arg(field_key)::text
+WHERE custom_fields ? sqlc.arg(field_key)::text;
+
+-- name: RemoveFormsCustomFieldQuery :exec
+UPDATE forms
+SET custom_fields = custom_fields - sqlc.arg(field_key)::text
+WHERE custom_fields ? sqlc.arg(field_key)::text;
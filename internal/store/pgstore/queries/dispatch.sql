@@ -0,0 +1,49 @@
+-- name: GetDispatchCandidatesQuery :many
+-- Técnicos ativos com a localização, os atendimentos em aberto e os
+-- atendimentos do cliente abertos desde history_since.
+SELECT
+    m.id,
+    u.username,
+    m.role,
+    m.home_latitude,
+    m.home_longitude,
+    m.current_latitude,
+    m.current_longitude,
+    m.current_located_at,
+    (
+        SELECT COUNT(*)
+        FROM form_tecnico ft
+        JOIN forms f ON ft.form_id = f.id
+        WHERE ft.member_id = m.id
+          AND f.deleted_at IS NULL
+          AND f.status NOT IN ('resolvido', 'fechado', 'cancelado')
+    )::int AS open_forms,
+    (
+        SELECT COUNT(*)
+        FROM form_tecnico ft
+        JOIN forms f ON ft.form_id = f.id
+        WHERE ft.member_id = m.id
+          AND f.deleted_at IS NULL
+          AND f.client_id = sqlc.arg(client_id)
+          AND f.occurred_at >= sqlc.arg(history_since)
+    )::int AS recent_client_forms,
+    (
+        SELECT MAX(f.occurred_at)
+        FROM form_tecnico ft
+        JOIN forms f ON ft.form_id = f.id
+        WHERE ft.member_id = m.id
+          AND f.deleted_at IS NULL
+          AND f.client_id = sqlc.arg(client_id)
+    )::timestamptz AS last_client_form_at
+FROM members m
+JOIN users u ON m.user_id = u.id
+WHERE m.is_active = TRUE
+  AND m.role IN ('tecnico_interno', 'tecnico_externo')
+ORDER BY u.username;
+
+-- name: GetClientLocationQuery :one
+SELECT
+    latitude,
+    longitude
+FROM clients
+WHERE id = $1 AND deleted_at IS NULL;
@@ -0,0 +1,26 @@
+-- name: CreateFormAssignmentChangeQuery :exec
+INSERT INTO form_assignment_history (
+    form_id,
+    member_id,
+    action,
+    changed_by,
+    changed_at
+)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetFormAssignmentHistoryQuery :many
+SELECT
+    h.id,
+    h.form_id,
+    h.member_id,
+    mu.username AS member_name,
+    h.action,
+    h.changed_by,
+    u.username AS changed_by_name,
+    h.changed_at
+FROM form_assignment_history h
+LEFT JOIN members m ON h.member_id = m.id
+LEFT JOIN users mu ON m.user_id = mu.id
+LEFT JOIN users u ON h.changed_by = u.id
+WHERE h.form_id = $1
+ORDER BY h.changed_at ASC, h.id ASC;
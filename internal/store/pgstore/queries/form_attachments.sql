@@ -0,0 +1,50 @@
+-- name: CreateFormAttachmentQuery :one
+INSERT INTO form_attachments (
+    id,
+    form_id,
+    file_name,
+    content_type,
+    size_bytes,
+    checksum_sha256,
+    storage_key,
+    uploaded_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING created_at;
+
+-- name: GetFormAttachmentsQuery :many
+SELECT
+    a.id,
+    a.form_id,
+    a.file_name,
+    a.content_type,
+    a.size_bytes,
+    a.checksum_sha256,
+    a.storage_key,
+    a.uploaded_by,
+    u.username AS uploaded_by_name,
+    a.created_at
+FROM form_attachments a
+LEFT JOIN users u ON a.uploaded_by = u.id
+WHERE a.form_id = $1
+ORDER BY a.created_at ASC, a.id ASC;
+
+-- name: GetFormAttachmentByIdQuery :one
+SELECT
+    a.id,
+    a.form_id,
+    a.file_name,
+    a.content_type,
+    a.size_bytes,
+    a.checksum_sha256,
+    a.storage_key,
+    a.uploaded_by,
+    u.username AS uploaded_by_name,
+    a.created_at
+FROM form_attachments a
+LEFT JOIN users u ON a.uploaded_by = u.id
+WHERE a.id = $1 AND a.form_id = $2;
+
+-- name: DeleteFormAttachmentQuery :execrows
+DELETE FROM form_attachments
+WHERE id = $1 AND form_id = $2;
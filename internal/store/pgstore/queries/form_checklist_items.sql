@@ -0,0 +1,50 @@
+-- name: CreateFormChecklistItemQuery :exec
+INSERT INTO form_checklist_items (
+    form_id,
+    position,
+    label,
+    template_id,
+    kind,
+    required,
+    unit,
+    min_value,
+    max_value
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: GetFormChecklistItemsQuery :many
+SELECT
+    id,
+    form_id,
+    position,
+    label,
+    done,
+    done_by,
+    done_at,
+    template_id,
+    kind,
+    required,
+    unit,
+    min_value,
+    max_value,
+    passed,
+    number_value,
+    text_value,
+    attachment_id,
+    out_of_range
+FROM form_checklist_items
+WHERE form_id = $1
+ORDER BY position ASC;
+
+-- name: UpdateFormChecklistItemQuery :execrows
+UPDATE form_checklist_items
+SET
+    done = $3,
+    done_by = $4,
+    done_at = $5,
+    passed = $6,
+    number_value = $7,
+    text_value = $8,
+    attachment_id = $9,
+    out_of_range = $10
+WHERE id = $1 AND form_id = $2;
@@ -0,0 +1,72 @@
+-- name: CreateFormCommentQuery :one
+INSERT INTO form_comments (
+    form_id,
+    author_id,
+    body,
+    internal
+)
+VALUES ($1, $2, $3, $4)
+RETURNING id, created_at, updated_at;
+
+-- name: GetFormCommentsQuery :many
+SELECT
+    c.id,
+    c.form_id,
+    c.author_id,
+    u.username AS author_name,
+    c.body,
+    c.internal,
+    (SELECT COUNT(*) FROM form_comment_edits e WHERE e.comment_id = c.id) AS edit_count,
+    c.created_at,
+    c.updated_at
+FROM form_comments c
+LEFT JOIN users u ON c.author_id = u.id
+WHERE c.form_id = $1
+  AND (sqlc.arg(include_internal)::boolean OR NOT c.internal)
+ORDER BY c.created_at ASC, c.id ASC;
+
+-- name: GetFormCommentByIdQuery :one
+SELECT
+    c.id,
+    c.form_id,
+    c.author_id,
+    u.username AS author_name,
+    c.body,
+    c.internal,
+    (SELECT COUNT(*) FROM form_comment_edits e WHERE e.comment_id = c.id) AS edit_count,
+    c.created_at,
+    c.updated_at
+FROM form_comments c
+LEFT JOIN users u ON c.author_id = u.id
+WHERE c.id = $1 AND c.form_id = $2;
+
+-- name: UpdateFormCommentQuery :execrows
+UPDATE form_comments
+SET body = $1,
+    internal = $2,
+    updated_at = $3
+WHERE id = $4 AND form_id = $5;
+
+-- name: CreateFormCommentEditQuery :exec
+INSERT INTO form_comment_edits (
+    comment_id,
+    previous_body,
+    previous_internal,
+    edited_by,
+    edited_at
+)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetFormCommentEditsQuery :many
+SELECT
+    e.id,
+    e.comment_id,
+    e.previous_body,
+    e.previous_internal,
+    e.edited_by,
+    u.username AS edited_by_name,
+    e.edited_at
+FROM form_comment_edits e
+LEFT JOIN users u ON e.edited_by = u.id
+WHERE e.comment_id = $1
+ORDER BY e.edited_at ASC, e.id ASC;
@@ -0,0 +1,38 @@
+-- name: CreateFormPartQuery :one
+INSERT INTO form_parts (
+    form_id,
+    part_id,
+    location_id,
+    quantity,
+    unit_price,
+    unit_cost,
+    created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, created_at;
+
+-- name: ListFormPartsQuery :many
+SELECT
+    fp.id,
+    fp.form_id,
+    fp.part_id,
+    p.sku,
+    p.name AS part_name,
+    p.unit,
+    fp.location_id,
+    l.name AS location_name,
+    fp.quantity,
+    fp.unit_price,
+    fp.unit_cost,
+    fp.created_by,
+    fp.created_at
+FROM form_parts fp
+JOIN parts p ON fp.part_id = p.id
+JOIN stock_locations l ON fp.location_id = l.id
+WHERE fp.form_id = $1
+ORDER BY fp.created_at ASC, fp.id ASC;
+
+-- name: DeleteFormPartQuery :one
+DELETE FROM form_parts
+WHERE id = $1 AND form_id = $2
+RETURNING id, form_id, part_id, location_id, quantity, unit_price, unit_cost, created_by, created_at;
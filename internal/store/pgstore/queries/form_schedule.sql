@@ -0,0 +1,73 @@
+-- name: ClearFormTecnicoScheduleQuery :exec
+UPDATE form_tecnico
+SET
+    scheduled_start = NULL,
+    scheduled_end = NULL,
+    updated_at = NOW()
+WHERE form_id = $1
+  AND scheduled_start IS NOT NULL;
+
+-- name: GetCalendarQuery :many
+SELECT
+    ft.form_id,
+    f.public_code,
+    ft.member_id,
+    u.username AS member_name,
+    c.name AS client_name,
+    f.status,
+    f.defect_description,
+    ft.scheduled_start::timestamptz AS scheduled_start,
+    ft.scheduled_end::timestamptz AS scheduled_end
+FROM form_tecnico ft
+JOIN forms f ON ft.form_id = f.id
+JOIN clients c ON f.client_id = c.id
+JOIN members m ON ft.member_id = m.id
+JOIN users u ON m.user_id = u.id
+WHERE ft.scheduled_start IS NOT NULL
+  AND f.deleted_at IS NULL
+  AND f.status <> 'cancelado'
+  AND (sqlc.narg(member_ids)::uuid[] IS NULL OR ft.member_id = ANY(sqlc.narg(member_ids)::uuid[]))
+  AND ft.scheduled_start < sqlc.arg(range_end)
+  AND ft.scheduled_end > sqlc.arg(range_start)
+ORDER BY ft.scheduled_start ASC, ft.member_id ASC;
+
+-- name: GetRouteStopsQuery :many
+SELECT
+    f.id,
+    f.public_code,
+    f.client_id,
+    c.name AS client_name,
+    c.street,
+    c.number,
+    c.neighborhood,
+    c.city,
+    c.state,
+    c.latitude,
+    c.longitude,
+    f.status,
+    f.defect_description,
+    f.occurred_at
+FROM forms f
+JOIN form_tecnico ft ON ft.form_id = f.id
+JOIN clients c ON f.client_id = c.id
+WHERE ft.member_id = sqlc.arg(member_id)
+  AND f.deleted_at IS NULL
+  AND f.status <> 'cancelado'
+  AND f.occurred_at >= sqlc.arg(day_start)
+  AND f.occurred_at < sqlc.arg(day_end)
+ORDER BY f.occurred_at ASC, f.id ASC;
+
+-- name: LockMembersQuery :many
+SELECT id
+FROM members
+WHERE id = ANY(sqlc.arg(member_ids)::uuid[])
+ORDER BY id
+FOR NO KEY UPDATE;
+
+-- name: UpdateFormTecnicoScheduleQuery :execrows
+UPDATE form_tecnico
+SET
+    scheduled_start = $3,
+    scheduled_end = $4,
+    updated_at = NOW()
+WHERE form_id = $1 AND member_id = $2;
@@ -0,0 +1,45 @@
+-- name: CreateFormSignatureQuery :one
+INSERT INTO form_signatures (
+    id,
+    form_id,
+    signer_name,
+    signer_document,
+    signed_at,
+    format,
+    content_type,
+    size_bytes,
+    checksum_sha256,
+    storage_key,
+    latitude,
+    longitude,
+    accuracy_meters,
+    snapshot_sha256,
+    snapshot_version,
+    captured_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+RETURNING created_at;
+
+-- name: GetFormSignatureQuery :one
+SELECT
+    s.id,
+    s.form_id,
+    s.signer_name,
+    s.signer_document,
+    s.signed_at,
+    s.format,
+    s.content_type,
+    s.size_bytes,
+    s.checksum_sha256,
+    s.storage_key,
+    s.latitude,
+    s.longitude,
+    s.accuracy_meters,
+    s.snapshot_sha256,
+    s.snapshot_version,
+    s.captured_by,
+    u.username AS captured_by_name,
+    s.created_at
+FROM form_signatures s
+LEFT JOIN users u ON s.captured_by = u.id
+WHERE s.form_id = $1;
@@ -0,0 +1,26 @@
+-- name: CreateFormStatusChangeQuery :one
+INSERT INTO form_status_history (
+    form_id,
+    from_status,
+    to_status,
+    reason,
+    changed_by,
+    changed_at
+)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: GetFormStatusHistoryQuery :many
+SELECT
+    h.id,
+    h.form_id,
+    h.from_status,
+    h.to_status,
+    h.reason,
+    h.changed_by,
+    u.username AS changed_by_name,
+    h.changed_at
+FROM form_status_history h
+LEFT JOIN users u ON h.changed_by = u.id
+WHERE h.form_id = $1
+ORDER BY h.changed_at ASC, h.id ASC;
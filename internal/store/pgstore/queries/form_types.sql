@@ -0,0 +1,78 @@
+-- name: CreateFormTypeQuery :one
+INSERT INTO form_types (
+    name,
+    description
+)
+VALUES ($1, $2)
+RETURNING id;
+
+-- name: CreateFormTypeVersionQuery :exec
+INSERT INTO form_type_versions (
+    form_type_id,
+    version,
+    schema,
+    created_by
+)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetFormTypeByIdQuery :one
+SELECT
+    id,
+    name,
+    description,
+    active,
+    current_version,
+    created_at,
+    updated_at
+FROM form_types
+WHERE id = $1;
+
+-- name: GetFormTypeVersionQuery :one
+SELECT
+    form_type_id,
+    version,
+    schema,
+    created_by,
+    created_at
+FROM form_type_versions
+WHERE form_type_id = $1 AND version = $2;
+
+-- name: GetFormTypeVersionsQuery :many
+SELECT
+    form_type_id,
+    version,
+    schema,
+    created_by,
+    created_at
+FROM form_type_versions
+WHERE form_type_id = $1
+ORDER BY version DESC;
+
+-- name: GetFormTypesQuery :many
+SELECT
+    id,
+    name,
+    description,
+    active,
+    current_version,
+    created_at,
+    updated_at
+FROM form_types
+ORDER BY name;
+
+-- name: NextFormTypeVersionQuery :one
+-- Avança a versão atual do tipo e devolve o novo número. A linha fica travada
+-- até o fim da transação, serializando as publicações do mesmo tipo.
+UPDATE form_types
+SET current_version = current_version + 1,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING current_version;
+
+-- name: UpdateFormTypeQuery :execrows
+UPDATE form_types
+SET name = $1,
+    description = $2,
+    active = $3,
+    updated_at = NOW()
+WHERE id = $4;
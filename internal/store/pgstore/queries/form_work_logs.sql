@@ -0,0 +1,104 @@
+-- name: CreateWorkLogQuery :one
+INSERT INTO form_work_logs (
+    form_id,
+    member_id,
+    kind,
+    source,
+    started_at,
+    ended_at,
+    notes,
+    created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, created_at, updated_at;
+
+-- name: GetFormWorkLogsQuery :many
+SELECT
+    w.id,
+    w.form_id,
+    w.member_id,
+    u.username AS member_name,
+    w.kind,
+    w.source,
+    w.started_at,
+    w.ended_at,
+    w.notes,
+    w.created_by,
+    w.created_at,
+    w.updated_at
+FROM form_work_logs w
+LEFT JOIN members m ON w.member_id = m.id
+LEFT JOIN users u ON m.user_id = u.id
+WHERE w.form_id = $1
+ORDER BY w.started_at ASC, w.id ASC;
+
+-- name: GetWorkLogByIdQuery :one
+SELECT
+    w.id,
+    w.form_id,
+    w.member_id,
+    u.username AS member_name,
+    w.kind,
+    w.source,
+    w.started_at,
+    w.ended_at,
+    w.notes,
+    w.created_by,
+    w.created_at,
+    w.updated_at
+FROM form_work_logs w
+LEFT JOIN members m ON w.member_id = m.id
+LEFT JOIN users u ON m.user_id = u.id
+WHERE w.id = $1 AND w.form_id = $2;
+
+-- name: CountOverlappingWorkLogsQuery :one
+SELECT COUNT(*)
+FROM form_work_logs
+WHERE member_id = sqlc.arg(member_id)
+  AND id <> sqlc.arg(id)
+  AND started_at < COALESCE(sqlc.narg(new_end)::timestamptz, 'infinity')
+  AND COALESCE(ended_at, 'infinity') > sqlc.arg(new_start);
+
+-- name: StopWorkLogQuery :execrows
+UPDATE form_work_logs
+SET ended_at = $1,
+    notes = $2,
+    updated_at = $3
+WHERE id = $4 AND form_id = $5 AND ended_at IS NULL;
+
+-- name: DeleteWorkLogQuery :execrows
+DELETE FROM form_work_logs
+WHERE id = $1 AND form_id = $2;
+
+-- name: GetWorkLogTotalsQuery :many
+SELECT
+    (CASE sqlc.arg(group_by)::text
+        WHEN 'tecnico' THEN w.member_id
+        WHEN 'cliente' THEN f.client_id
+        ELSE w.form_id
+    END)::uuid AS group_id,
+    (CASE sqlc.arg(group_by)::text
+        WHEN 'tecnico' THEN u.username
+        ELSE c.name
+    END)::text AS group_name,
+    COALESCE(SUM(EXTRACT(EPOCH FROM
+        LEAST(w.ended_at, COALESCE(sqlc.narg(period_end)::timestamptz, w.ended_at))
+        - GREATEST(w.started_at, COALESCE(sqlc.narg(period_start)::timestamptz, w.started_at))
+    )) FILTER (WHERE w.kind = 'deslocamento'), 0)::bigint AS travel_seconds,
+    COALESCE(SUM(EXTRACT(EPOCH FROM
+        LEAST(w.ended_at, COALESCE(sqlc.narg(period_end)::timestamptz, w.ended_at))
+        - GREATEST(w.started_at, COALESCE(sqlc.narg(period_start)::timestamptz, w.started_at))
+    )) FILTER (WHERE w.kind = 'no_local'), 0)::bigint AS on_site_seconds
+FROM form_work_logs w
+JOIN forms f ON w.form_id = f.id
+JOIN clients c ON f.client_id = c.id
+LEFT JOIN members m ON w.member_id = m.id
+LEFT JOIN users u ON m.user_id = u.id
+WHERE w.ended_at IS NOT NULL
+  AND f.deleted_at IS NULL
+  AND (sqlc.narg(period_start)::timestamptz IS NULL OR w.ended_at > sqlc.narg(period_start)::timestamptz)
+  AND (sqlc.narg(period_end)::timestamptz IS NULL OR w.started_at < sqlc.narg(period_end)::timestamptz)
+  AND (sqlc.narg(client_id)::uuid IS NULL OR f.client_id = sqlc.narg(client_id)::uuid)
+  AND (sqlc.narg(member_id)::uuid IS NULL OR w.member_id = sqlc.narg(member_id)::uuid)
+GROUP BY 1, 2
+ORDER BY 2 ASC, 1 ASC;
@@ -15,14 +15,37 @@ INSERT INTO forms (
     difficulty_level,
     defect_description,
     solution_description,
-    occurred_at
+    occurred_at,
+    contract_id,
+    hours_consumed,
+    custom_fields,
+    tags,
+    status,
+    response_due_at,
+    response_risk_at,
+    resolution_due_at,
+    resolution_risk_at,
+    public_code,
+    form_type_id,
+    form_type_version,
+    form_data
 )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 RETURNING id;
 
+-- name: NextFormCodeQuery :one
+-- Incrementa e devolve o próximo número de protocolo do ano, criando a linha
+-- do ano no primeiro atendimento. A linha fica travada até o fim da
+-- transação, serializando as gravações do mesmo ano.
+INSERT INTO form_code_sequences (year, last_number)
+VALUES ($1, 1)
+ON CONFLICT (year) DO UPDATE SET last_number = form_code_sequences.last_number + 1
+RETURNING last_number;
+
 -- name: GetFormByIdQuery :one
 SELECT
     f.id,
+    f.public_code,
     f.client_id,
     c.name as client_name,
     f.occurred_at,
@@ -30,15 +53,36 @@ SELECT
     f.difficulty_level,
     f.defect_description,
     f.solution_description,
+    f.contract_id,
+    f.hours_consumed,
+    f.custom_fields,
+    f.tags,
+    f.form_type_id,
+    f.form_type_version,
+    f.form_data,
+    f.status,
+    f.response_due_at,
+    f.response_risk_at,
+    f.resolution_due_at,
+    f.resolution_risk_at,
+    f.responded_at,
+    f.resolved_at,
+    EXISTS (SELECT 1 FROM form_signatures s WHERE s.form_id = f.id) AS signed,
     f.created_at,
     f.updated_at
 FROM forms f
 JOIN clients c ON f.client_id = c.id
-WHERE f.id = $1;
+WHERE f.id = $1 AND f.deleted_at IS NULL;
+
+-- name: GetFormIdByPublicCodeQuery :one
+SELECT id
+FROM forms
+WHERE public_code = $1 AND deleted_at IS NULL;
 
 -- name: GetFormsQuery :many
 SELECT
     f.id,
+    f.public_code,
     f.client_id,
     c.name as client_name,
     f.occurred_at,
@@ -46,11 +90,45 @@ SELECT
     f.difficulty_level,
     f.defect_description,
     f.solution_description,
+    f.contract_id,
+    f.hours_consumed,
+    f.custom_fields,
+    f.tags,
+    f.form_type_id,
+    f.form_type_version,
+    f.form_data,
+    f.status,
+    f.response_due_at,
+    f.response_risk_at,
+    f.resolution_due_at,
+    f.resolution_risk_at,
+    f.responded_at,
+    f.resolved_at,
     f.created_at,
     f.updated_at
 FROM forms f
 JOIN clients c ON f.client_id = c.id
-ORDER BY f.id ASC;
+WHERE f.deleted_at IS NULL
+  AND f.custom_fields @> sqlc.arg(custom_fields)::jsonb
+  AND f.tags @> sqlc.arg(tags)::text[]
+  AND (sqlc.narg(client_id)::uuid IS NULL OR f.client_id = sqlc.narg(client_id)::uuid)
+  AND (sqlc.narg(tecnico_id)::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM form_tecnico ft
+        WHERE ft.form_id = f.id AND ft.member_id = sqlc.narg(tecnico_id)::uuid
+      ))
+  AND (sqlc.narg(difficulty_level)::difficulty_level IS NULL OR f.difficulty_level = sqlc.narg(difficulty_level)::difficulty_level)
+  AND (sqlc.narg(status)::text IS NULL OR f.status = sqlc.narg(status)::text)
+  AND (sqlc.narg(occurred_from)::timestamptz IS NULL OR f.occurred_at >= sqlc.narg(occurred_from)::timestamptz)
+  AND (sqlc.narg(occurred_to)::timestamptz IS NULL OR f.occurred_at <= sqlc.narg(occurred_to)::timestamptz)
+  AND (sqlc.narg(public_code)::text IS NULL OR f.public_code LIKE '%' || sqlc.narg(public_code)::text || '%')
+  AND (sqlc.narg(after_id)::uuid IS NULL OR f.id > sqlc.narg(after_id)::uuid)
+ORDER BY f.id ASC
+LIMIT sqlc.narg(page_size);
+
+-- name: DeleteFormTecnicosQuery :exec
+DELETE FROM form_tecnico
+WHERE form_id = $1
+  AND member_id = ANY(sqlc.arg(member_ids)::uuid[]);
 
 -- name: GetFormTecnicosByFormID :many
 SELECT
@@ -67,6 +145,21 @@ JOIN users ON members.user_id = users.id
 WHERE form_tecnico.form_id = $1
 ORDER BY form_tecnico.id ASC;
 
+-- name: GetFormTecnicosByFormIDs :many
+SELECT
+    form_tecnico.id,
+    form_tecnico.member_id,
+    form_tecnico.form_id,
+    form_tecnico.created_at,
+    form_tecnico.updated_at,
+    users.username AS user_name,
+    users.email AS user_email
+FROM form_tecnico
+JOIN members ON form_tecnico.member_id = members.id
+JOIN users ON members.user_id = users.id
+WHERE form_tecnico.form_id = ANY(sqlc.arg(form_ids)::uuid[])
+ORDER BY form_tecnico.form_id ASC, form_tecnico.id ASC;
+
 
 -- name: UpdateFormQuery :exec
 UPDATE forms
@@ -75,9 +168,107 @@ SET client_id = $1,
     difficulty_level = $3,
     defect_description = $4,
     solution_description = $5,
+    hours_consumed = $6,
+    custom_fields = $7,
+    tags = $8,
+    response_due_at = $10,
+    response_risk_at = $11,
+    resolution_due_at = $12,
+    resolution_risk_at = $13,
+    form_data = $14,
+    contract_id = $15,
     updated_at = NOW()
-WHERE id = $6;
+WHERE id = $9 AND deleted_at IS NULL;
 
 -- name: DeleteFormQuery :exec
+UPDATE forms
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetDeletedFormsQuery :many
+SELECT
+    f.id,
+    f.public_code,
+    f.client_id,
+    c.name as client_name,
+    f.occurred_at,
+    f.solicited_name,
+    f.deleted_at
+FROM forms f
+JOIN clients c ON f.client_id = c.id
+WHERE f.deleted_at IS NOT NULL
+ORDER BY f.deleted_at DESC;
+
+-- name: RestoreFormQuery :execrows
+UPDATE forms
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: PurgeFormQuery :execrows
 DELETE FROM forms
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: UpdateFormStatusQuery :execrows
+UPDATE forms
+SET status = sqlc.arg(status),
+    solution_description = sqlc.arg(solution_description),
+    responded_at = sqlc.narg(responded_at),
+    resolved_at = sqlc.narg(resolved_at),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND status = sqlc.arg(from_status)
+  AND deleted_at IS NULL;
+
+-- name: SearchFormsQuery :many
+-- Busca textual ordenada pela relevância. Os trechos destacam os termos
+-- encontrados e total conta todos os resultados, não só os da página.
+SELECT
+    f.id,
+    f.public_code,
+    f.client_id,
+    c.name as client_name,
+    f.occurred_at,
+    f.solicited_name,
+    f.difficulty_level,
+    f.defect_description,
+    f.solution_description,
+    f.contract_id,
+    f.hours_consumed,
+    f.custom_fields,
+    f.tags,
+    f.form_type_id,
+    f.form_type_version,
+    f.form_data,
+    f.status,
+    f.response_due_at,
+    f.response_risk_at,
+    f.resolution_due_at,
+    f.resolution_risk_at,
+    f.responded_at,
+    f.resolved_at,
+    f.created_at,
+    f.updated_at,
+    ts_rank_cd(f.search_vector, query)::float8 AS rank,
+    ts_headline('portuguese_unaccent', translate(COALESCE(f.defect_description, ''), E'\uE000\uE001', ''), query, E'StartSel=\uE000, StopSel=\uE001, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" … "')::text AS defect_snippet,
+    ts_headline('portuguese_unaccent', translate(COALESCE(f.solution_description, ''), E'\uE000\uE001', ''), query, E'StartSel=\uE000, StopSel=\uE001, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" … "')::text AS solution_snippet,
+    COUNT(*) OVER ()::bigint AS total
+FROM forms f
+JOIN clients c ON f.client_id = c.id
+CROSS JOIN websearch_to_tsquery('portuguese_unaccent', sqlc.arg(query)::text) AS query
+WHERE f.deleted_at IS NULL
+  AND f.search_vector @@ query
+  AND f.custom_fields @> sqlc.arg(custom_fields)::jsonb
+  AND f.tags @> sqlc.arg(tags)::text[]
+  AND (sqlc.narg(client_id)::uuid IS NULL OR f.client_id = sqlc.narg(client_id)::uuid)
+  AND (sqlc.narg(tecnico_id)::uuid IS NULL OR EXISTS (
+        SELECT 1 FROM form_tecnico ft
+        WHERE ft.form_id = f.id AND ft.member_id = sqlc.narg(tecnico_id)::uuid
+      ))
+  AND (sqlc.narg(difficulty_level)::difficulty_level IS NULL OR f.difficulty_level = sqlc.narg(difficulty_level)::difficulty_level)
+  AND (sqlc.narg(status)::text IS NULL OR f.status = sqlc.narg(status)::text)
+  AND (sqlc.narg(occurred_from)::timestamptz IS NULL OR f.occurred_at >= sqlc.narg(occurred_from)::timestamptz)
+  AND (sqlc.narg(occurred_to)::timestamptz IS NULL OR f.occurred_at <= sqlc.narg(occurred_to)::timestamptz)
+  AND (sqlc.narg(public_code)::text IS NULL OR f.public_code LIKE '%' || sqlc.narg(public_code)::text || '%')
+ORDER BY rank DESC, f.occurred_at DESC, f.id ASC
+LIMIT sqlc.arg(page_size)
+OFFSET sqlc.arg(page_offset);
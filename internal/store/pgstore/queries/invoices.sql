@@ -0,0 +1,174 @@
+-- name: GetBillingSettingsQuery :one
+SELECT
+    hour_rate_low,
+    hour_rate_medium,
+    hour_rate_high,
+    travel_fee,
+    tax_rate,
+    updated_by,
+    updated_at
+FROM billing_settings
+WHERE id = 1;
+
+-- name: UpsertBillingSettingsQuery :one
+INSERT INTO billing_settings (
+    id,
+    hour_rate_low,
+    hour_rate_medium,
+    hour_rate_high,
+    travel_fee,
+    tax_rate,
+    updated_by,
+    updated_at
+)
+VALUES (1, $1, $2, $3, $4, $5, $6, NOW())
+ON CONFLICT (id) DO UPDATE SET
+    hour_rate_low = EXCLUDED.hour_rate_low,
+    hour_rate_medium = EXCLUDED.hour_rate_medium,
+    hour_rate_high = EXCLUDED.hour_rate_high,
+    travel_fee = EXCLUDED.travel_fee,
+    tax_rate = EXCLUDED.tax_rate,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING updated_at;
+
+-- name: CreateInvoiceQuery :one
+INSERT INTO invoices (
+    client_id,
+    status,
+    subtotal,
+    discount,
+    tax_rate,
+    tax_amount,
+    total,
+    notes,
+    due_date,
+    created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, created_at, updated_at;
+
+-- name: CreateInvoiceItemQuery :one
+INSERT INTO invoice_items (
+    invoice_id,
+    form_id,
+    position,
+    kind,
+    description,
+    quantity,
+    unit_price,
+    total
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id;
+
+-- name: CreateInvoiceFormQuery :exec
+INSERT INTO invoice_forms (form_id, invoice_id)
+VALUES ($1, $2);
+
+-- name: DeleteInvoiceFormsQuery :exec
+DELETE FROM invoice_forms
+WHERE invoice_id = $1;
+
+-- name: GetInvoiceByIdQuery :one
+SELECT
+    i.id,
+    i.number,
+    i.client_id,
+    c.client_name,
+    i.status,
+    i.subtotal,
+    i.discount,
+    i.tax_rate,
+    i.tax_amount,
+    i.total,
+    i.notes,
+    i.due_date,
+    i.issued_at,
+    i.paid_at,
+    i.canceled_at,
+    i.cancel_reason,
+    i.created_by,
+    i.created_at,
+    i.updated_at
+FROM invoices i
+JOIN clients c ON i.client_id = c.id
+WHERE i.id = $1;
+
+-- name: ListInvoiceItemsQuery :many
+SELECT
+    id,
+    invoice_id,
+    form_id,
+    position,
+    kind,
+    description,
+    quantity,
+    unit_price,
+    total
+FROM invoice_items
+WHERE invoice_id = $1
+ORDER BY position;
+
+-- name: ListInvoicesQuery :many
+SELECT
+    i.id,
+    i.number,
+    i.client_id,
+    c.client_name,
+    i.status,
+    i.subtotal,
+    i.discount,
+    i.tax_rate,
+    i.tax_amount,
+    i.total,
+    i.notes,
+    i.due_date,
+    i.issued_at,
+    i.paid_at,
+    i.canceled_at,
+    i.cancel_reason,
+    i.created_by,
+    i.created_at,
+    i.updated_at
+FROM invoices i
+JOIN clients c ON i.client_id = c.id
+WHERE (sqlc.narg(status)::text IS NULL OR i.status = sqlc.narg(status)::text)
+  AND (sqlc.narg(client_id)::uuid IS NULL OR i.client_id = sqlc.narg(client_id)::uuid)
+ORDER BY i.created_at DESC;
+
+-- name: NextInvoiceNumberQuery :one
+-- Incrementa e devolve o próximo número de fatura. O UPDATE trava a linha
+-- até o fim da transação, serializando as emissões.
+UPDATE invoice_sequence
+SET last_number = last_number + 1
+WHERE id = 1
+RETURNING last_number;
+
+-- name: UpdateInvoiceStatusQuery :execrows
+-- A mudança só é aplicada se a fatura ainda estiver na situação lida,
+-- evitando que duas mudanças concorrentes se sobreponham.
+UPDATE invoices
+SET
+    status = sqlc.arg(status),
+    number = COALESCE(number, sqlc.narg(number)::bigint),
+    issued_at = sqlc.narg(issued_at),
+    paid_at = sqlc.narg(paid_at),
+    canceled_at = sqlc.narg(canceled_at),
+    cancel_reason = sqlc.arg(cancel_reason),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND status = sqlc.arg(from_status);
+
+-- name: UpdateInvoiceDraftQuery :execrows
+UPDATE invoices
+SET
+    discount = $2,
+    tax_rate = $3,
+    tax_amount = $4,
+    total = $5,
+    notes = $6,
+    due_date = $7,
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'rascunho';
@@ -0,0 +1,150 @@
+-- name: CreateMaintenancePlanQuery :one
+INSERT INTO maintenance_plans (
+    client_id,
+    name,
+    site,
+    description,
+    rrule,
+    starts_at,
+    difficulty_level,
+    checklist,
+    status,
+    created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, created_at, updated_at;
+
+-- name: CreateMaintenancePlanTecnicoQuery :exec
+INSERT INTO maintenance_plan_tecnicos (
+    plan_id,
+    member_id
+)
+VALUES ($1, $2);
+
+-- name: DeleteMaintenancePlanTecnicosQuery :exec
+DELETE FROM maintenance_plan_tecnicos
+WHERE plan_id = $1;
+
+-- name: GetMaintenancePlanQuery :one
+SELECT
+    p.id,
+    p.client_id,
+    c.name AS client_name,
+    p.name,
+    p.site,
+    p.description,
+    p.rrule,
+    p.starts_at,
+    p.difficulty_level,
+    p.checklist,
+    p.status,
+    p.generated_until,
+    p.created_by,
+    p.created_at,
+    p.updated_at
+FROM maintenance_plans p
+JOIN clients c ON p.client_id = c.id
+WHERE p.id = $1;
+
+-- name: ListMaintenancePlansQuery :many
+SELECT
+    p.id,
+    p.client_id,
+    c.name AS client_name,
+    p.name,
+    p.site,
+    p.description,
+    p.rrule,
+    p.starts_at,
+    p.difficulty_level,
+    p.checklist,
+    p.status,
+    p.generated_until,
+    p.created_by,
+    p.created_at,
+    p.updated_at
+FROM maintenance_plans p
+JOIN clients c ON p.client_id = c.id
+WHERE (sqlc.narg(client_id)::uuid IS NULL OR p.client_id = sqlc.narg(client_id)::uuid)
+  AND (sqlc.narg(status)::text IS NULL OR p.status = sqlc.narg(status)::text)
+ORDER BY c.name ASC, p.name ASC, p.id ASC;
+
+-- name: ListDueMaintenancePlansQuery :many
+SELECT
+    p.id,
+    p.client_id,
+    c.name AS client_name,
+    p.name,
+    p.site,
+    p.description,
+    p.rrule,
+    p.starts_at,
+    p.difficulty_level,
+    p.checklist,
+    p.status,
+    p.generated_until,
+    p.created_by,
+    p.created_at,
+    p.updated_at
+FROM maintenance_plans p
+JOIN clients c ON p.client_id = c.id
+WHERE p.status = 'ativo'
+  AND c.deleted_at IS NULL
+  AND (p.generated_until IS NULL OR p.generated_until < sqlc.arg(generate_until)::timestamptz)
+ORDER BY p.id ASC;
+
+-- name: GetMaintenancePlanTecnicosQuery :many
+SELECT
+    plan_id,
+    member_id
+FROM maintenance_plan_tecnicos
+WHERE plan_id = ANY(sqlc.arg(plan_ids)::uuid[])
+ORDER BY plan_id ASC, member_id ASC;
+
+-- name: UpdateMaintenancePlanQuery :execrows
+UPDATE maintenance_plans
+SET
+    name = $2,
+    site = $3,
+    description = $4,
+    rrule = $5,
+    starts_at = $6,
+    difficulty_level = $7,
+    checklist = $8,
+    status = $9,
+    generated_until = $10,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: AdvanceMaintenancePlanQuery :execrows
+UPDATE maintenance_plans
+SET generated_until = sqlc.arg(generated_until)::timestamptz
+WHERE id = sqlc.arg(id)
+  AND status = 'ativo'
+  AND (generated_until IS NULL OR generated_until < sqlc.arg(generated_until)::timestamptz);
+
+-- name: DeleteMaintenancePlanQuery :execrows
+DELETE FROM maintenance_plans
+WHERE id = $1;
+
+-- name: CreateMaintenanceFormQuery :one
+INSERT INTO forms (
+    client_id,
+    solicited_name,
+    difficulty_level,
+    defect_description,
+    occurred_at,
+    contract_id,
+    tags,
+    status,
+    maintenance_plan_id,
+    scheduled_for,
+    public_code,
+    response_due_at,
+    response_risk_at,
+    resolution_due_at,
+    resolution_risk_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+ON CONFLICT (maintenance_plan_id, scheduled_for) DO NOTHING
+RETURNING id;
@@ -10,3 +10,26 @@ SELECT
     u.username
 FROM members m
 JOIN users u ON m.user_id = u.id;
+
+-- name: GetMemberByIdQuery :one
+SELECT
+    id,
+    user_id,
+    role,
+    is_active
+FROM members
+WHERE id = $1;
+
+-- name: UpdateMemberHomeLocationQuery :execrows
+UPDATE members
+SET home_latitude = $1,
+    home_longitude = $2,
+    updated_at = NOW()
+WHERE id = $3;
+
+-- name: UpdateMemberCurrentLocationQuery :execrows
+UPDATE members
+SET current_latitude = $1,
+    current_longitude = $2,
+    current_located_at = $3
+WHERE id = $4;
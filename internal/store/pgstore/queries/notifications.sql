@@ -0,0 +1,42 @@
+-- name: CreateFormTechniciansNotificationQuery :execrows
+INSERT INTO notifications (
+    user_id,
+    form_id,
+    kind,
+    message
+)
+SELECT DISTINCT
+    m.user_id,
+    ft.form_id,
+    sqlc.arg(kind)::text,
+    sqlc.arg(message)::text
+FROM form_tecnico ft
+JOIN members m ON ft.member_id = m.id
+WHERE ft.form_id = sqlc.arg(form_id);
+
+-- name: ListNotificationsQuery :many
+SELECT
+    n.id,
+    n.user_id,
+    n.form_id,
+    COALESCE(f.public_code, '')::text AS public_code,
+    n.kind,
+    n.message,
+    n.created_at,
+    n.read_at
+FROM notifications n
+LEFT JOIN forms f ON f.id = n.form_id
+WHERE n.user_id = sqlc.arg(user_id)
+  AND (NOT sqlc.arg(unread_only)::boolean OR n.read_at IS NULL)
+ORDER BY n.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: MarkNotificationReadQuery :execrows
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = $1 AND user_id = $2;
+
+-- name: MarkAllNotificationsReadQuery :execrows
+UPDATE notifications
+SET read_at = NOW()
+WHERE user_id = $1 AND read_at IS NULL;
@@ -0,0 +1,61 @@
+-- name: CreatePartQuery :one
+INSERT INTO parts (
+    sku,
+    name,
+    unit,
+    cost,
+    price,
+    min_stock,
+    active
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, created_at, updated_at;
+
+-- name: GetPartByIdQuery :one
+SELECT
+    id,
+    sku,
+    name,
+    unit,
+    cost,
+    price,
+    min_stock,
+    active,
+    created_at,
+    updated_at
+FROM parts
+WHERE id = $1;
+
+-- name: ListPartsQuery :many
+SELECT
+    id,
+    sku,
+    name,
+    unit,
+    cost,
+    price,
+    min_stock,
+    active,
+    created_at,
+    updated_at
+FROM parts
+WHERE (sqlc.arg(include_inactive)::boolean OR active)
+  AND (
+      sqlc.narg(search)::text IS NULL
+      OR sku ILIKE '%' || sqlc.narg(search)::text || '%'
+      OR name ILIKE '%' || sqlc.narg(search)::text || '%'
+  )
+ORDER BY name ASC, sku ASC;
+
+-- name: UpdatePartQuery :execrows
+UPDATE parts
+SET
+    sku = $2,
+    name = $3,
+    unit = $4,
+    cost = $5,
+    price = $6,
+    min_stock = $7,
+    active = $8,
+    updated_at = NOW()
+WHERE id = $1;
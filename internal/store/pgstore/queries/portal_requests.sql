@@ -0,0 +1,53 @@
+-- name: CreatePortalRequestQuery :one
+INSERT INTO portal_requests (
+    client_id,
+    client_user_id,
+    requester_name,
+    description
+)
+VALUES ($1, $2, $3, $4)
+RETURNING id;
+
+-- name: GetPortalRequestByIdQuery :one
+SELECT
+    pr.id,
+    pr.client_id,
+    c.name AS client_name,
+    pr.client_user_id,
+    pr.requester_name,
+    pr.description,
+    pr.status,
+    pr.form_id,
+    pr.review_note,
+    pr.created_at,
+    pr.updated_at
+FROM portal_requests pr
+JOIN clients c ON pr.client_id = c.id
+WHERE pr.id = $1;
+
+-- name: GetPortalRequestsQuery :many
+SELECT
+    pr.id,
+    pr.client_id,
+    c.name AS client_name,
+    pr.client_user_id,
+    pr.requester_name,
+    pr.description,
+    pr.status,
+    pr.form_id,
+    pr.review_note,
+    pr.created_at,
+    pr.updated_at
+FROM portal_requests pr
+JOIN clients c ON pr.client_id = c.id
+WHERE (sqlc.narg(client_id)::uuid IS NULL OR pr.client_id = sqlc.narg(client_id)::uuid)
+  AND (sqlc.arg(status)::text = '' OR pr.status = sqlc.arg(status)::text)
+ORDER BY pr.created_at DESC;
+
+-- name: UpdatePortalRequestReviewQuery :execrows
+UPDATE portal_requests
+SET status = $1,
+    form_id = $2,
+    review_note = $3,
+    updated_at = NOW()
+WHERE id = $4 AND status = 'aberta';
@@ -0,0 +1,146 @@
+-- name: CreateSatisfactionSurveyQuery :exec
+-- Enfileira a pesquisa do atendimento resolvido; reaberturas não geram outra.
+INSERT INTO satisfaction_surveys (form_id)
+VALUES ($1)
+ON CONFLICT (form_id) DO NOTHING;
+
+-- name: GetPendingSatisfactionSurveysQuery :many
+SELECT
+    s.id,
+    s.form_id,
+    f.public_code,
+    f.client_id,
+    c.name AS client_name,
+    c.contact_name,
+    c.email,
+    s.send_attempts,
+    s.created_at
+FROM satisfaction_surveys s
+JOIN forms f ON s.form_id = f.id
+JOIN clients c ON f.client_id = c.id
+WHERE s.sent_at IS NULL
+  AND s.send_attempts < sqlc.arg(max_attempts)::int
+  AND s.created_at > sqlc.arg(queued_after)::timestamptz
+  AND f.status IN ('resolvido', 'fechado')
+  AND f.deleted_at IS NULL
+  AND c.deleted_at IS NULL
+  AND c.email IS NOT NULL
+  AND c.email <> ''
+ORDER BY s.created_at ASC
+LIMIT sqlc.arg(row_limit)::int;
+
+-- name: ClaimSatisfactionSurveyQuery :execrows
+-- Reserva a pesquisa para envio gravando o token; só uma rodada consegue.
+UPDATE satisfaction_surveys
+SET token_hash = $1,
+    sent_to = $2,
+    sent_at = $3,
+    expires_at = $4
+WHERE id = $5 AND sent_at IS NULL;
+
+-- name: ReleaseSatisfactionSurveyQuery :exec
+-- Devolve à fila a pesquisa reservada cujo e-mail falhou, contando a tentativa.
+UPDATE satisfaction_surveys
+SET token_hash = NULL,
+    sent_to = NULL,
+    sent_at = NULL,
+    expires_at = NULL,
+    send_attempts = send_attempts + 1
+WHERE id = $1 AND token_hash = $2 AND responded_at IS NULL;
+
+-- name: GetSatisfactionSurveyByTokenQuery :one
+SELECT
+    s.id,
+    s.form_id,
+    f.public_code,
+    f.client_id,
+    c.name AS client_name,
+    s.sent_to,
+    s.sent_at,
+    s.expires_at,
+    s.send_attempts,
+    s.csat_score,
+    s.nps_score,
+    s.comment,
+    s.responded_at,
+    s.created_at
+FROM satisfaction_surveys s
+JOIN forms f ON s.form_id = f.id
+JOIN clients c ON f.client_id = c.id
+WHERE s.token_hash = $1 AND f.deleted_at IS NULL;
+
+-- name: GetSatisfactionSurveyByFormQuery :one
+SELECT
+    s.id,
+    s.form_id,
+    f.public_code,
+    f.client_id,
+    c.name AS client_name,
+    s.sent_to,
+    s.sent_at,
+    s.expires_at,
+    s.send_attempts,
+    s.csat_score,
+    s.nps_score,
+    s.comment,
+    s.responded_at,
+    s.created_at
+FROM satisfaction_surveys s
+JOIN forms f ON s.form_id = f.id
+JOIN clients c ON f.client_id = c.id
+WHERE s.form_id = $1;
+
+-- name: AnswerSatisfactionSurveyQuery :execrows
+-- A condição garante uma única resposta, dentro da validade do link, mesmo com
+-- envios simultâneos.
+UPDATE satisfaction_surveys
+SET csat_score = $1,
+    nps_score = $2,
+    comment = $3,
+    responded_at = $4
+WHERE id = $5
+  AND responded_at IS NULL
+  AND sent_at IS NOT NULL
+  AND expires_at > $4;
+
+-- name: GetSatisfactionSurveySummaryQuery :many
+-- Os limites de satisfeito (4 e 5), promotor (9 e 10) e neutro (7 e 8) são os
+-- de domains. No agrupamento por técnico a pesquisa conta para cada técnico do
+-- atendimento; no agrupamento por período o início segue o fuso informado.
+SELECT
+    (CASE sqlc.arg(group_by)::text
+        WHEN 'tecnico' THEN ft.member_id
+        WHEN 'cliente' THEN f.client_id
+    END)::uuid AS group_id,
+    (CASE sqlc.arg(group_by)::text
+        WHEN 'tecnico' THEN u.username
+        WHEN 'cliente' THEN c.name
+    END)::text AS group_name,
+    (CASE sqlc.arg(group_by)::text
+        WHEN 'periodo' THEN date_trunc(sqlc.arg(granularity)::text, s.sent_at, sqlc.arg(timezone)::text)
+    END)::timestamptz AS period_start,
+    COUNT(*)::int AS sent,
+    COUNT(s.responded_at)::int AS answered,
+    COALESCE(SUM(s.csat_score), 0)::int AS csat_sum,
+    COUNT(*) FILTER (WHERE s.csat_score >= 4)::int AS satisfied,
+    COUNT(*) FILTER (WHERE s.nps_score >= 9)::int AS promoters,
+    COUNT(*) FILTER (WHERE s.nps_score BETWEEN 7 AND 8)::int AS passives,
+    COUNT(*) FILTER (WHERE s.nps_score <= 6)::int AS detractors
+FROM satisfaction_surveys s
+JOIN forms f ON s.form_id = f.id
+JOIN clients c ON f.client_id = c.id
+LEFT JOIN form_tecnico ft ON sqlc.arg(group_by)::text = 'tecnico' AND ft.form_id = f.id
+LEFT JOIN members m ON ft.member_id = m.id
+LEFT JOIN users u ON m.user_id = u.id
+WHERE s.sent_at IS NOT NULL
+  AND f.deleted_at IS NULL
+  AND (sqlc.narg(period_start)::timestamptz IS NULL OR s.sent_at >= sqlc.narg(period_start)::timestamptz)
+  AND (sqlc.narg(period_end)::timestamptz IS NULL OR s.sent_at < sqlc.narg(period_end)::timestamptz)
+  AND (sqlc.narg(client_id)::uuid IS NULL OR f.client_id = sqlc.narg(client_id)::uuid)
+  AND (sqlc.narg(member_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM form_tecnico x
+      WHERE x.form_id = f.id AND x.member_id = sqlc.narg(member_id)::uuid
+  ))
+  AND (sqlc.narg(member_id)::uuid IS NULL OR sqlc.arg(group_by)::text <> 'tecnico' OR ft.member_id = sqlc.narg(member_id)::uuid)
+GROUP BY 1, 2, 3
+ORDER BY 3 ASC, 2 ASC, 1 ASC;
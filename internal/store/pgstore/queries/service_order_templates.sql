@@ -0,0 +1,51 @@
+-- name: GetServiceOrderTemplateQuery :one
+SELECT
+    company_name,
+    company_document,
+    address,
+    phone,
+    email,
+    website,
+    footer_text,
+    primary_color,
+    timezone,
+    logo_key,
+    logo_content_type,
+    updated_by,
+    updated_at
+FROM service_order_templates
+WHERE id = 1;
+
+-- name: UpsertServiceOrderTemplateQuery :one
+INSERT INTO service_order_templates (
+    id,
+    company_name,
+    company_document,
+    address,
+    phone,
+    email,
+    website,
+    footer_text,
+    primary_color,
+    timezone,
+    logo_key,
+    logo_content_type,
+    updated_by,
+    updated_at
+)
+VALUES (1, $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW())
+ON CONFLICT (id) DO UPDATE SET
+    company_name = EXCLUDED.company_name,
+    company_document = EXCLUDED.company_document,
+    address = EXCLUDED.address,
+    phone = EXCLUDED.phone,
+    email = EXCLUDED.email,
+    website = EXCLUDED.website,
+    footer_text = EXCLUDED.footer_text,
+    primary_color = EXCLUDED.primary_color,
+    timezone = EXCLUDED.timezone,
+    logo_key = EXCLUDED.logo_key,
+    logo_content_type = EXCLUDED.logo_content_type,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+RETURNING updated_at;
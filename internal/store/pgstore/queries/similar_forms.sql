@@ -0,0 +1,40 @@
+-- name: GetSimilarFormCandidatesQuery :many
+-- Atendimentos resolvidos com solução cujo defeito tem trigramas em comum
+-- com a descrição, sem diferenciar acentos e maiúsculas. O operador % usa o
+-- índice idx_forms_defect_trgm e o limite padrão de semelhança do pg_trgm.
+SELECT
+    f.id,
+    f.public_code,
+    f.client_id,
+    c.name AS client_name,
+    f.occurred_at,
+    f.defect_description,
+    f.solution_description,
+    f.status,
+    f.resolved_at,
+    similarity(lower(immutable_unaccent(f.defect_description)), lower(immutable_unaccent(sqlc.arg(description)::text)))::float8 AS similarity,
+    (SELECT COUNT(*) FROM form_similarity_feedback fb WHERE fb.similar_form_id = f.id)::int AS helpful_count
+FROM forms f
+JOIN clients c ON f.client_id = c.id
+WHERE f.deleted_at IS NULL
+  AND f.status IN ('resolvido', 'fechado')
+  AND COALESCE(f.solution_description, '') <> ''
+  AND (sqlc.narg(exclude_id)::uuid IS NULL OR f.id <> sqlc.narg(exclude_id)::uuid)
+  AND lower(immutable_unaccent(f.defect_description)) % lower(immutable_unaccent(sqlc.arg(description)::text))
+ORDER BY similarity DESC, f.id ASC
+LIMIT sqlc.arg(candidates);
+
+-- name: CreateSimilarFormFeedbackQuery :execrows
+INSERT INTO form_similarity_feedback (
+    form_id,
+    similar_form_id,
+    user_id
+)
+VALUES ($1, $2, $3)
+ON CONFLICT (form_id, similar_form_id, user_id) DO NOTHING;
+
+-- name: DeleteSimilarFormFeedbackQuery :execrows
+DELETE FROM form_similarity_feedback
+WHERE form_id = $1
+  AND similar_form_id = $2
+  AND user_id = $3;
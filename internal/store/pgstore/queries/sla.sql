@@ -0,0 +1,82 @@
+-- name: GetSLAPolicyQuery :one
+SELECT
+    difficulty_level,
+    client_type,
+    response_minutes,
+    resolution_minutes,
+    updated_at
+FROM sla_policies
+WHERE difficulty_level = $1 AND client_type = $2;
+
+-- name: ListSLAPoliciesQuery :many
+SELECT
+    difficulty_level,
+    client_type,
+    response_minutes,
+    resolution_minutes,
+    updated_at
+FROM sla_policies
+ORDER BY difficulty_level ASC, client_type ASC;
+
+-- name: UpsertSLAPolicyQuery :one
+INSERT INTO sla_policies (
+    difficulty_level,
+    client_type,
+    response_minutes,
+    resolution_minutes,
+    updated_at
+)
+VALUES ($1, $2, $3, $4, NOW())
+ON CONFLICT (difficulty_level, client_type) DO UPDATE SET
+    response_minutes = EXCLUDED.response_minutes,
+    resolution_minutes = EXCLUDED.resolution_minutes,
+    updated_at = EXCLUDED.updated_at
+RETURNING updated_at;
+
+-- name: ListHolidaysQuery :many
+SELECT
+    holiday_date,
+    name
+FROM holidays
+WHERE holiday_date >= sqlc.arg(from_date)::date
+ORDER BY holiday_date ASC;
+
+-- name: CreateHolidayQuery :exec
+INSERT INTO holidays (
+    holiday_date,
+    name
+)
+VALUES ($1, $2);
+
+-- name: DeleteHolidayQuery :execrows
+DELETE FROM holidays
+WHERE holiday_date = $1;
+
+-- name: ListSLAPendingFormsQuery :many
+SELECT
+    f.id,
+    c.name AS client_name,
+    f.response_due_at,
+    f.response_risk_at,
+    f.resolution_due_at,
+    f.resolution_risk_at,
+    f.responded_at,
+    f.resolved_at,
+    f.sla_notified_state
+FROM forms f
+JOIN clients c ON f.client_id = c.id
+WHERE f.deleted_at IS NULL
+  AND f.resolution_due_at IS NOT NULL
+  AND f.status NOT IN ('resolvido', 'fechado', 'cancelado')
+  AND f.sla_notified_state IS DISTINCT FROM 'violado'
+  AND (
+        (f.responded_at IS NULL AND f.response_risk_at <= sqlc.arg(now)::timestamptz)
+        OR f.resolution_risk_at <= sqlc.arg(now)::timestamptz
+      )
+ORDER BY f.resolution_due_at ASC;
+
+-- name: UpdateFormSLANotifiedStateQuery :execrows
+UPDATE forms
+SET sla_notified_state = sqlc.arg(state)::text
+WHERE id = sqlc.arg(id)
+  AND sla_notified_state IS NOT DISTINCT FROM sqlc.narg(previous_state)::text;
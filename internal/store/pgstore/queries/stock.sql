@@ -0,0 +1,105 @@
+-- name: CreateStockLocationQuery :one
+INSERT INTO stock_locations (
+    kind,
+    name,
+    member_id
+)
+VALUES ($1, $2, $3)
+RETURNING id, created_at;
+
+-- name: ListStockLocationsQuery :many
+SELECT
+    l.id,
+    l.kind,
+    l.name,
+    l.member_id,
+    u.username AS member_name,
+    l.created_at
+FROM stock_locations l
+LEFT JOIN members m ON l.member_id = m.id
+LEFT JOIN users u ON m.user_id = u.id
+ORDER BY l.kind ASC, l.name ASC;
+
+-- name: ApplyStockMovementQuery :one
+-- Soma a quantidade ao saldo do local, criando o saldo na primeira
+-- movimentação. A restrição stock_levels_quantity_check recusa saldos
+-- negativos; o UPSERT trava a linha até o fim da transação.
+INSERT INTO stock_levels (location_id, part_id, quantity, updated_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (location_id, part_id) DO UPDATE
+SET
+    quantity = stock_levels.quantity + EXCLUDED.quantity,
+    updated_at = NOW()
+RETURNING quantity;
+
+-- name: CreateStockMovementQuery :one
+INSERT INTO stock_movements (
+    part_id,
+    location_id,
+    kind,
+    quantity,
+    form_id,
+    form_part_id,
+    transfer_id,
+    notes,
+    created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, created_at;
+
+-- name: ListStockLevelsQuery :many
+SELECT
+    s.location_id,
+    l.name AS location_name,
+    s.part_id,
+    p.sku,
+    p.name AS part_name,
+    p.unit,
+    s.quantity,
+    s.updated_at
+FROM stock_levels s
+JOIN stock_locations l ON s.location_id = l.id
+JOIN parts p ON s.part_id = p.id
+WHERE (sqlc.narg(location_id)::uuid IS NULL OR s.location_id = sqlc.narg(location_id)::uuid)
+  AND (sqlc.narg(part_id)::uuid IS NULL OR s.part_id = sqlc.narg(part_id)::uuid)
+ORDER BY l.name ASC, p.name ASC, p.sku ASC;
+
+-- name: ListStockMovementsQuery :many
+SELECT
+    sm.id,
+    sm.part_id,
+    p.sku,
+    p.name AS part_name,
+    sm.location_id,
+    l.name AS location_name,
+    sm.kind,
+    sm.quantity,
+    sm.form_id,
+    sm.form_part_id,
+    sm.transfer_id,
+    sm.notes,
+    sm.created_by,
+    sm.created_at
+FROM stock_movements sm
+JOIN parts p ON sm.part_id = p.id
+JOIN stock_locations l ON sm.location_id = l.id
+WHERE (sqlc.narg(part_id)::uuid IS NULL OR sm.part_id = sqlc.narg(part_id)::uuid)
+  AND (sqlc.narg(location_id)::uuid IS NULL OR sm.location_id = sqlc.narg(location_id)::uuid)
+  AND (sqlc.narg(form_id)::uuid IS NULL OR sm.form_id = sqlc.narg(form_id)::uuid)
+ORDER BY sm.id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListLowStockQuery :many
+SELECT
+    p.id,
+    p.sku,
+    p.name,
+    p.unit,
+    p.min_stock,
+    COALESCE(SUM(s.quantity), 0)::numeric AS quantity
+FROM parts p
+LEFT JOIN stock_levels s ON s.part_id = p.id
+WHERE p.active AND p.min_stock > 0
+GROUP BY p.id
+HAVING COALESCE(SUM(s.quantity), 0) <= p.min_stock
+ORDER BY p.min_stock - COALESCE(SUM(s.quantity), 0) DESC, p.sku ASC;
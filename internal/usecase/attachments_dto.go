@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UploadAttachmentInput traz o arquivo enviado; Content é lido até o limite de
+// domains.MaxAttachmentSize mais um byte, para detectar arquivos grandes demais.
+type UploadAttachmentInput struct {
+	FormID     uuid.UUID `json:"form_id"`
+	FileName   string    `json:"file_name"`
+	Content    io.Reader `json:"-"`
+	UploadedBy uuid.UUID `json:"uploaded_by"`
+	// Admin permite anexar arquivos a atendimentos já assinados.
+	Admin bool `json:"admin"`
+}
+
+type AttachmentOutput struct {
+	ID             uuid.UUID `json:"id"`
+	FileName       string    `json:"file_name"`
+	ContentType    string    `json:"content_type"`
+	Size           int64     `json:"size"`
+	Checksum       string    `json:"checksum"`
+	UploadedBy     uuid.UUID `json:"uploaded_by"`
+	UploadedByName string    `json:"uploaded_by_name"`
+	CreatedAt      time.Time `json:"created_at"`
+
+	// DownloadURL é assinada e expira em URLExpiresAt.
+	DownloadURL  string    `json:"download_url"`
+	URLExpiresAt time.Time `json:"url_expires_at"`
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"olidesk-api-2/internal/storage"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type AttachmentsUseCase interface {
+	UploadAttachment(UploadAttachmentInput, context.Context) (*AttachmentOutput, error)
+	ListAttachments(uuid.UUID, context.Context) ([]AttachmentOutput, error)
+	DeleteAttachment(uuid.UUID, uuid.UUID, bool, context.Context) error
+}
+
+type attachmentService struct {
+	repo     repository.AttachmentRepository
+	formRepo repository.FormRepository
+	store    storage.Storage
+	urlTTL   time.Duration
+	l        *zap.Logger
+}
+
+func NewAttachmentService(repo repository.AttachmentRepository, formRepo repository.FormRepository, store storage.Storage, urlTTL time.Duration, l *zap.Logger) AttachmentsUseCase {
+	return &attachmentService{
+		repo:     repo,
+		formRepo: formRepo,
+		store:    store,
+		urlTTL:   urlTTL,
+		l:        l,
+	}
+}
+
+// UploadAttachment identifica o tipo pelo conteúdo, calcula o SHA-256, grava
+// o arquivo no storage e só então registra o anexo; se o registro falhar, o
+// arquivo é removido do storage.
+func (a *attachmentService) UploadAttachment(input UploadAttachmentInput, ctx context.Context) (*AttachmentOutput, error) {
+	form, err := a.formRepo.FindFormByID(input.FormID, ctx)
+	if err != nil {
+		a.l.Error("error getting form", zap.Error(err))
+		return nil, err
+	}
+	if err := form.CheckEditable(input.Admin); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, io.LimitReader(input.Content, domains.MaxAttachmentSize+1)); err != nil {
+		return nil, err
+	}
+	content := buf.Bytes()
+	sum := sha256.Sum256(content)
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	attachment := &domains.Attachment{
+		ID:          id,
+		FormID:      input.FormID,
+		FileName:    domains.SanitizeFileName(input.FileName),
+		ContentType: sniffContentType(content),
+		Size:        int64(len(content)),
+		Checksum:    hex.EncodeToString(sum[:]),
+		StorageKey:  domains.AttachmentStorageKey(input.FormID, id),
+		UploadedBy:  input.UploadedBy,
+	}
+	if err := attachment.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := a.store.Put(ctx, attachment.StorageKey, bytes.NewReader(content), attachment.Size, attachment.ContentType); err != nil {
+		a.l.Error("error storing attachment", zap.Error(err))
+		return nil, err
+	}
+	if err := a.repo.SaveAttachment(attachment, ctx); err != nil {
+		a.l.Error("error saving attachment", zap.Error(err))
+		if delErr := a.store.Delete(context.WithoutCancel(ctx), attachment.StorageKey); delErr != nil {
+			a.l.Error("error removing orphan attachment", zap.String("key", attachment.StorageKey), zap.Error(delErr))
+		}
+		return nil, err
+	}
+
+	out, err := a.toOutput(attachment)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+func (a *attachmentService) ListAttachments(formID uuid.UUID, ctx context.Context) ([]AttachmentOutput, error) {
+	if _, err := a.formRepo.FindFormByID(formID, ctx); err != nil {
+		a.l.Error("error getting form", zap.Error(err))
+		return nil, err
+	}
+
+	attachments, err := a.repo.ListAttachments(formID, ctx)
+	if err != nil {
+		a.l.Error("error listing attachments", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]AttachmentOutput, 0, len(attachments))
+	for _, attachment := range attachments {
+		o, err := a.toOutput(attachment)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+// DeleteAttachment remove o registro antes do arquivo: um arquivo órfão no
+// storage não aparece para ninguém, já um registro sem arquivo quebraria o
+// download. Em atendimentos assinados, só administradores removem anexos.
+func (a *attachmentService) DeleteAttachment(formID, id uuid.UUID, admin bool, ctx context.Context) error {
+	form, err := a.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return domains.ErrAttachmentNotFound
+		}
+		a.l.Error("error getting form", zap.Error(err))
+		return err
+	}
+	if err := form.CheckEditable(admin); err != nil {
+		return err
+	}
+
+	attachment, err := a.repo.FindAttachment(formID, id, ctx)
+	if err != nil {
+		return err
+	}
+	if err := a.repo.DeleteAttachment(formID, id, ctx); err != nil {
+		a.l.Error("error deleting attachment", zap.Error(err))
+		return err
+	}
+	if err := a.store.Delete(ctx, attachment.StorageKey); err != nil {
+		a.l.Error("error removing attachment from storage", zap.String("key", attachment.StorageKey), zap.Error(err))
+	}
+	return nil
+}
+
+func (a *attachmentService) toOutput(attachment *domains.Attachment) (AttachmentOutput, error) {
+	expiresAt := time.Now().Add(a.urlTTL).UTC()
+	url, err := a.store.SignedURL(attachment.StorageKey, attachment.FileName, a.urlTTL)
+	if err != nil {
+		a.l.Error("error signing attachment url", zap.Error(err))
+		return AttachmentOutput{}, err
+	}
+
+	return AttachmentOutput{
+		ID:             attachment.ID,
+		FileName:       attachment.FileName,
+		ContentType:    attachment.ContentType,
+		Size:           attachment.Size,
+		Checksum:       attachment.Checksum,
+		UploadedBy:     attachment.UploadedBy,
+		UploadedByName: attachment.UploadedByName,
+		CreatedAt:      attachment.CreatedAt,
+		DownloadURL:    url,
+		URLExpiresAt:   expiresAt.Truncate(time.Second),
+	}, nil
+}
+
+// sniffContentType usa a detecção do net/http, que olha os primeiros 512
+// bytes, e descarta parâmetros como "; charset=utf-8".
+func sniffContentType(content []byte) string {
+	contentType := http.DetectContentType(content)
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	return strings.TrimSpace(contentType)
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChecklistTemplateItemInput struct {
+	Label    string   `json:"label"`
+	Kind     string   `json:"kind"`
+	Required bool     `json:"required"`
+	Unit     string   `json:"unit"`
+	Min      *float64 `json:"min"`
+	Max      *float64 `json:"max"`
+}
+
+// ChecklistTemplateInput cria ou substitui um modelo; os itens seguem a ordem
+// da lista.
+type ChecklistTemplateInput struct {
+	Name        string                       `json:"name"`
+	Description string                       `json:"description"`
+	Items       []ChecklistTemplateItemInput `json:"items"`
+}
+
+type ChecklistTemplateOutput struct {
+	ID          uuid.UUID                    `json:"id"`
+	Name        string                       `json:"name"`
+	Description string                       `json:"description"`
+	Items       []ChecklistTemplateItemInput `json:"items"`
+	CreatedAt   time.Time                    `json:"created_at"`
+	UpdatedAt   time.Time                    `json:"updated_at"`
+}
+
+type ListChecklistTemplatesOutput struct {
+	Templates []ChecklistTemplateOutput `json:"templates"`
+}
+
+// AnswerChecklistItemInput traz a resposta no campo do tipo do item; Done
+// falso sem outra resposta limpa o item.
+type AnswerChecklistItemInput struct {
+	FormID       uuid.UUID `json:"form_id"`
+	ItemID       uuid.UUID `json:"item_id"`
+	Done         *bool     `json:"done"`
+	Passed       *bool     `json:"passed"`
+	Number       *float64  `json:"number"`
+	Text         *string   `json:"text"`
+	AttachmentID uuid.UUID `json:"attachment_id"`
+	UserID       uuid.UUID `json:"user_id"`
+	Admin        bool      `json:"admin"`
+}
+
+type ChecklistItemOutput struct {
+	ID           uuid.UUID `json:"id"`
+	Position     int       `json:"position"`
+	Label        string    `json:"label"`
+	Kind         string    `json:"kind"`
+	Required     bool      `json:"required"`
+	Unit         string    `json:"unit"`
+	Min          *float64  `json:"min"`
+	Max          *float64  `json:"max"`
+	Done         bool      `json:"done"`
+	Passed       *bool     `json:"passed"`
+	Number       *float64  `json:"number"`
+	Text         string    `json:"text"`
+	AttachmentID uuid.UUID `json:"attachment_id"`
+	OutOfRange   bool      `json:"out_of_range"`
+	DoneBy       uuid.UUID `json:"done_by"`
+	DoneAt       time.Time `json:"done_at"`
+}
+
+// ListChecklistOutput traz quantos itens obrigatórios ainda impedem resolver
+// o atendimento e quantas leituras ficaram fora da faixa.
+type ListChecklistOutput struct {
+	Items           []ChecklistItemOutput `json:"items"`
+	PendingRequired int                   `json:"pending_required"`
+	OutOfRange      int                   `json:"out_of_range"`
+}
@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ChecklistsUseCase interface {
+	CreateTemplate(ChecklistTemplateInput, context.Context) (uuid.UUID, error)
+	GetTemplate(uuid.UUID, context.Context) (*ChecklistTemplateOutput, error)
+	ListTemplates(context.Context) (*ListChecklistTemplatesOutput, error)
+	UpdateTemplate(uuid.UUID, ChecklistTemplateInput, context.Context) error
+	DeleteTemplate(uuid.UUID, context.Context) error
+	ListChecklist(uuid.UUID, context.Context) (*ListChecklistOutput, error)
+	AnswerChecklistItem(AnswerChecklistItemInput, context.Context) (*ChecklistItemOutput, error)
+}
+
+type checklistService struct {
+	repo           repository.ChecklistRepository
+	formRepo       repository.FormRepository
+	attachmentRepo repository.AttachmentRepository
+	l              *zap.Logger
+}
+
+func NewChecklistService(repo repository.ChecklistRepository, formRepo repository.FormRepository, attachmentRepo repository.AttachmentRepository, l *zap.Logger) ChecklistsUseCase {
+	return &checklistService{
+		repo:           repo,
+		formRepo:       formRepo,
+		attachmentRepo: attachmentRepo,
+		l:              l,
+	}
+}
+
+func (c *checklistService) CreateTemplate(input ChecklistTemplateInput, ctx context.Context) (uuid.UUID, error) {
+	template := toChecklistTemplate(input)
+	if err := template.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := c.repo.SaveChecklistTemplate(template, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrChecklistTemplateNameTaken) {
+			c.l.Error("error creating checklist template", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (c *checklistService) GetTemplate(id uuid.UUID, ctx context.Context) (*ChecklistTemplateOutput, error) {
+	template, err := c.repo.FindChecklistTemplateByID(id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrChecklistTemplateNotFound) {
+			c.l.Error("error getting checklist template", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	output := toChecklistTemplateOutput(template)
+	return &output, nil
+}
+
+func (c *checklistService) ListTemplates(ctx context.Context) (*ListChecklistTemplatesOutput, error) {
+	templates, err := c.repo.ListChecklistTemplates(ctx)
+	if err != nil {
+		c.l.Error("error listing checklist templates", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]ChecklistTemplateOutput, 0, len(templates))
+	for _, template := range templates {
+		output = append(output, toChecklistTemplateOutput(template))
+	}
+	return &ListChecklistTemplatesOutput{Templates: output}, nil
+}
+
+// UpdateTemplate substitui o modelo. Os atendimentos já abertos mantêm os
+// itens copiados do modelo anterior.
+func (c *checklistService) UpdateTemplate(id uuid.UUID, input ChecklistTemplateInput, ctx context.Context) error {
+	template := toChecklistTemplate(input)
+	template.ID = id
+	if err := template.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.repo.UpdateChecklistTemplate(template, ctx); err != nil {
+		if !errors.Is(err, domains.ErrChecklistTemplateNotFound) && !errors.Is(err, domains.ErrChecklistTemplateNameTaken) {
+			c.l.Error("error updating checklist template", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *checklistService) DeleteTemplate(id uuid.UUID, ctx context.Context) error {
+	if err := c.repo.DeleteChecklistTemplate(id, ctx); err != nil {
+		if !errors.Is(err, domains.ErrChecklistTemplateNotFound) {
+			c.l.Error("error deleting checklist template", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *checklistService) ListChecklist(formID uuid.UUID, ctx context.Context) (*ListChecklistOutput, error) {
+	if _, err := c.formRepo.FindFormByID(formID, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			c.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	items, err := c.repo.ListChecklistItems(formID, ctx)
+	if err != nil {
+		c.l.Error("error listing checklist items", zap.Error(err))
+		return nil, err
+	}
+
+	output := &ListChecklistOutput{
+		Items:           make([]ChecklistItemOutput, 0, len(items)),
+		PendingRequired: len(domains.PendingRequiredItems(items)),
+	}
+	for _, item := range items {
+		output.Items = append(output.Items, toChecklistItemOutput(item))
+		if item.OutOfRange {
+			output.OutOfRange++
+		}
+	}
+	return output, nil
+}
+
+// AnswerChecklistItem grava a resposta de um item da lista de verificação. A
+// foto precisa ser uma imagem já anexada ao atendimento. Atendimentos
+// assinados só podem ser alterados por administradores.
+func (c *checklistService) AnswerChecklistItem(input AnswerChecklistItemInput, ctx context.Context) (*ChecklistItemOutput, error) {
+	form, err := c.formRepo.FindFormByID(input.FormID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			c.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+	if err := form.CheckEditable(input.Admin); err != nil {
+		return nil, err
+	}
+
+	items, err := c.repo.ListChecklistItems(input.FormID, ctx)
+	if err != nil {
+		c.l.Error("error listing checklist items", zap.Error(err))
+		return nil, err
+	}
+	var item *domains.ChecklistItem
+	for _, i := range items {
+		if i.ID == input.ItemID {
+			item = i
+		}
+	}
+	if item == nil {
+		return nil, domains.ErrChecklistItemNotFound
+	}
+
+	if input.AttachmentID != uuid.Nil {
+		attachment, err := c.attachmentRepo.FindAttachment(input.FormID, input.AttachmentID, ctx)
+		if err != nil {
+			if !errors.Is(err, domains.ErrAttachmentNotFound) {
+				c.l.Error("error getting attachment", zap.Error(err))
+			}
+			return nil, err
+		}
+		if !strings.HasPrefix(attachment.ContentType, "image/") {
+			return nil, domains.ErrChecklistPhotoNotImage
+		}
+	}
+
+	err = item.Answer(domains.ChecklistAnswer{
+		Done:         input.Done,
+		Passed:       input.Passed,
+		Number:       input.Number,
+		Text:         input.Text,
+		AttachmentID: input.AttachmentID,
+	}, input.UserID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.repo.UpdateChecklistItem(item, ctx); err != nil {
+		if !errors.Is(err, domains.ErrChecklistItemNotFound) && !errors.Is(err, domains.ErrAttachmentNotFound) {
+			c.l.Error("error updating checklist item", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	output := toChecklistItemOutput(item)
+	return &output, nil
+}
+
+func toChecklistTemplate(input ChecklistTemplateInput) *domains.ChecklistTemplate {
+	template := &domains.ChecklistTemplate{
+		Name:        input.Name,
+		Description: input.Description,
+		Items:       make([]domains.ChecklistTemplateItem, 0, len(input.Items)),
+	}
+	for _, item := range input.Items {
+		template.Items = append(template.Items, domains.ChecklistTemplateItem(item))
+	}
+	template.Normalize()
+	return template
+}
+
+func toChecklistTemplateOutput(template *domains.ChecklistTemplate) ChecklistTemplateOutput {
+	output := ChecklistTemplateOutput{
+		ID:          template.ID,
+		Name:        template.Name,
+		Description: template.Description,
+		Items:       make([]ChecklistTemplateItemInput, 0, len(template.Items)),
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+	for _, item := range template.Items {
+		output.Items = append(output.Items, ChecklistTemplateItemInput(item))
+	}
+	return output
+}
+
+func toChecklistItemOutput(item *domains.ChecklistItem) ChecklistItemOutput {
+	return ChecklistItemOutput{
+		ID:           item.ID,
+		Position:     item.Position,
+		Label:        item.Label,
+		Kind:         item.Kind,
+		Required:     item.Required,
+		Unit:         item.Unit,
+		Min:          item.Min,
+		Max:          item.Max,
+		Done:         item.Answered(),
+		Passed:       item.Passed,
+		Number:       item.Number,
+		Text:         item.Text,
+		AttachmentID: item.AttachmentID,
+		OutOfRange:   item.OutOfRange,
+		DoneBy:       item.DoneBy,
+		DoneAt:       item.DoneAt,
+	}
+}
@@ -12,13 +12,22 @@ type CreateClientInput struct {
 	CnpjOrCpf  string        `json:"cnpj_or_cpf"`
 	Address    Address       `json:"address"`
 	ClientType string        `json:"client_type"`
+
+	CustomFields map[string]any `json:"custom_fields"`
+	Tags         []string       `json:"tags"`
 }
+
+// UpdateClientInput mantém os campos personalizados e as tags atuais quando
+// CustomFields ou Tags são nil; quando informados, substituem os anteriores.
 type UpdateClientInput struct {
 	ClientName string        `json:"client_name"`
 	Contact    ContactPerson `json:"contact_person"`
 	CnpjOrCpf  string        `json:"cnpj_or_cpf"`
 	Address    Address       `json:"address"`
 	ClientType string        `json:"client_type"`
+
+	CustomFields map[string]any `json:"custom_fields"`
+	Tags         []string       `json:"tags"`
 }
 
 type GetClientOutput struct {
@@ -36,8 +45,13 @@ type ClientOutput struct {
 	CnpjOrCpf  string        `json:"cnpj_or_cpf"`
 	Address    Address       `json:"address"`
 	ClientType string        `json:"client_type"`
-	CreatedAt  time.Time     `json:"created_at"`
-	UpdatedAt  time.Time     `json:"updated_at"`
+
+	CustomFields map[string]any `json:"custom_fields"`
+	Tags         []string       `json:"tags"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt time.Time `json:"deleted_at"`
 }
 
 type Address struct {
@@ -57,3 +71,40 @@ type ContactPerson struct {
 	Phone          string `json:"phone"`
 	Email          string `json:"email"`
 }
+
+type ClientSummary struct {
+	ID         uuid.UUID `json:"id"`
+	ClientName string    `json:"client_name"`
+	CnpjOrCpf  string    `json:"cnpj_or_cpf"`
+	Email      string    `json:"email"`
+	Phone      string    `json:"phone"`
+}
+
+type DuplicateCandidateOutput struct {
+	Client  ClientSummary `json:"client"`
+	Score   float64       `json:"score"`
+	Reasons []string      `json:"reasons"`
+}
+
+type DuplicatePairOutput struct {
+	First   ClientSummary `json:"first"`
+	Second  ClientSummary `json:"second"`
+	Score   float64       `json:"score"`
+	Reasons []string      `json:"reasons"`
+}
+
+type MergeClientsInput struct {
+	SourceID uuid.UUID `json:"source_id"`
+	TargetID uuid.UUID `json:"target_id"`
+	MergedBy uuid.UUID `json:"merged_by"`
+}
+
+type ClientMergeOutput struct {
+	ID             uuid.UUID     `json:"id"`
+	Source         ClientSummary `json:"source"`
+	TargetID       uuid.UUID     `json:"target_id"`
+	FormsMoved     int64         `json:"forms_moved"`
+	ContractsMoved int64         `json:"contracts_moved"`
+	MergedBy       uuid.UUID     `json:"merged_by"`
+	MergedAt       time.Time     `json:"merged_at"`
+}
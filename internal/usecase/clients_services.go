@@ -2,9 +2,11 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"olidesk-api-2/internal/domains"
 	"olidesk-api-2/internal/repository"
 	"olidesk-api-2/internal/utils/location"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -13,21 +15,38 @@ import (
 type ClientUseCase interface {
 	CreateClient(CreateClientInput, context.Context) (uuid.UUID, error)
 	GetClient(uuid.UUID, context.Context) (*ClientOutput, error)
-	ListClient(context.Context) ([]*ClientOutput, error)
+	ListClient(ListFilterInput, context.Context) ([]*ClientOutput, error)
 	UpdateClient(uuid.UUID, UpdateClientInput, context.Context) error
 	DeleteClient(uuid.UUID, context.Context) error
+	ListDeletedClients(context.Context) ([]*ClientOutput, error)
+	RestoreClient(uuid.UUID, context.Context) error
+	PurgeClient(uuid.UUID, context.Context) error
+	FindDuplicates(CreateClientInput, context.Context) ([]DuplicateCandidateOutput, error)
+	ListDuplicatePairs(context.Context) ([]DuplicatePairOutput, error)
+	MergeClients(MergeClientsInput, context.Context) (*ClientMergeOutput, error)
+	ListClientMerges(uuid.UUID, context.Context) ([]ClientMergeOutput, error)
 }
 
 type clientService struct {
-	repo repository.ClientRepository
-	l    *zap.Logger
+	repo      repository.ClientRepository
+	fieldRepo repository.CustomFieldRepository
+	l         *zap.Logger
 }
 
-func NewClientService(repo repository.ClientRepository, l *zap.Logger) ClientUseCase {
-	return &clientService{repo: repo, l: l}
+func NewClientService(repo repository.ClientRepository, fieldRepo repository.CustomFieldRepository, l *zap.Logger) ClientUseCase {
+	return &clientService{repo: repo, fieldRepo: fieldRepo, l: l}
 }
 
 func (c *clientService) CreateClient(p CreateClientInput, ctx context.Context) (uuid.UUID, error) {
+	customFields, err := validateCustomFields(c.fieldRepo, domains.CustomFieldEntityClient, p.CustomFields, ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	tags, err := domains.NormalizeTags(p.Tags)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
 	lat, lng, err := location.GeocodeAddress(
 		ctx,
 		p.Address.Street,
@@ -66,6 +85,8 @@ func (c *clientService) CreateClient(p CreateClientInput, ctx context.Context) (
 			Latitude:     lat,
 			Longitude:    lng,
 		},
+		CustomFields: customFields,
+		Tags:         tags,
 	}, ctx)
 
 	if err != nil {
@@ -108,10 +129,17 @@ func (c *clientService) GetClient(id uuid.UUID, ctx context.Context) (*ClientOut
 			Latitude:     client.Address.Latitude,
 			Longitude:    client.Address.Longitude,
 		},
+		CustomFields: client.CustomFields,
+		Tags:         client.Tags,
 	}, nil
 }
-func (c *clientService) ListClient(ctx context.Context) ([]*ClientOutput, error) {
-	clientData, err := c.repo.ListClients(ctx)
+func (c *clientService) ListClient(input ListFilterInput, ctx context.Context) ([]*ClientOutput, error) {
+	filter, err := buildListFilter(c.fieldRepo, domains.CustomFieldEntityClient, input, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clientData, err := c.repo.ListClients(filter, ctx)
 	if err != nil {
 		c.l.Error("error listing clients", zap.Error(err))
 		return nil, err
@@ -141,8 +169,10 @@ func (c *clientService) ListClient(ctx context.Context) ([]*ClientOutput, error)
 				Latitude:     cl.Address.Latitude,
 				Longitude:    cl.Address.Longitude,
 			},
-			CreatedAt: cl.CreatedAt.UTC(),
-			UpdatedAt: cl.UpdatedAt.UTC(),
+			CustomFields: cl.CustomFields,
+			Tags:         cl.Tags,
+			CreatedAt:    cl.CreatedAt.UTC(),
+			UpdatedAt:    cl.UpdatedAt.UTC(),
 		})
 	}
 
@@ -199,6 +229,21 @@ func (c *clientService) UpdateClient(id uuid.UUID, cl UpdateClientInput, ctx con
 		client.Address.Complement = cl.Address.Complement
 	}
 
+	if cl.CustomFields != nil {
+		customFields, err := validateCustomFields(c.fieldRepo, domains.CustomFieldEntityClient, cl.CustomFields, ctx)
+		if err != nil {
+			return err
+		}
+		client.CustomFields = customFields
+	}
+	if cl.Tags != nil {
+		tags, err := domains.NormalizeTags(cl.Tags)
+		if err != nil {
+			return err
+		}
+		client.Tags = tags
+	}
+
 	if cl.Address.Street != "" &&
 		cl.Address.Number != "" &&
 		cl.Address.City != "" &&
@@ -230,3 +275,149 @@ func (c *clientService) UpdateClient(id uuid.UUID, cl UpdateClientInput, ctx con
 	}
 	return nil
 }
+func (c *clientService) ListDeletedClients(ctx context.Context) ([]*ClientOutput, error) {
+	clientData, err := c.repo.ListDeletedClients(ctx)
+	if err != nil {
+		c.l.Error("error listing deleted clients", zap.Error(err))
+		return nil, err
+	}
+
+	clientList := make([]*ClientOutput, 0, len(clientData))
+	for _, cl := range clientData {
+		clientList = append(clientList, &ClientOutput{
+			ID:         cl.ID,
+			ClientName: cl.ClientName,
+			CnpjOrCpf:  cl.CnpjOrCpf,
+			ClientType: cl.ClientType,
+			DeletedAt:  cl.DeletedAt,
+		})
+	}
+
+	return clientList, nil
+}
+func (c *clientService) RestoreClient(id uuid.UUID, ctx context.Context) error {
+	if err := c.repo.RestoreClient(id, ctx); err != nil {
+		c.l.Error("error restoring client", zap.Error(err))
+		return err
+	}
+	return nil
+}
+func (c *clientService) PurgeClient(id uuid.UUID, ctx context.Context) error {
+	if err := c.repo.PurgeClient(id, ctx); err != nil {
+		c.l.Error("error purging client", zap.Error(err))
+		return err
+	}
+	return nil
+}
+func (c *clientService) FindDuplicates(p CreateClientInput, ctx context.Context) ([]DuplicateCandidateOutput, error) {
+	existing, err := c.repo.ListClients(domains.ListFilter{}, ctx)
+	if err != nil {
+		c.l.Error("error listing clients", zap.Error(err))
+		return nil, err
+	}
+
+	candidates := domains.FindDuplicates(&domains.Client{
+		ClientName: p.ClientName,
+		CnpjOrCpf:  p.CnpjOrCpf,
+		Contact: domains.ContactPerson{
+			Email: p.Contact.Email,
+			Phone: p.Contact.Phone,
+		},
+	}, existing)
+
+	output := make([]DuplicateCandidateOutput, 0, len(candidates))
+	for _, cd := range candidates {
+		output = append(output, DuplicateCandidateOutput{
+			Client:  toClientSummary(&cd.Client),
+			Score:   cd.Score,
+			Reasons: cd.Reasons,
+		})
+	}
+	return output, nil
+}
+func (c *clientService) ListDuplicatePairs(ctx context.Context) ([]DuplicatePairOutput, error) {
+	clients, err := c.repo.ListClients(domains.ListFilter{}, ctx)
+	if err != nil {
+		c.l.Error("error listing clients", zap.Error(err))
+		return nil, err
+	}
+
+	pairs := domains.FindDuplicatePairs(clients)
+	output := make([]DuplicatePairOutput, 0, len(pairs))
+	for _, p := range pairs {
+		output = append(output, DuplicatePairOutput{
+			First:   toClientSummary(&p.First),
+			Second:  toClientSummary(&p.Second),
+			Score:   p.Score,
+			Reasons: p.Reasons,
+		})
+	}
+	return output, nil
+}
+func (c *clientService) MergeClients(p MergeClientsInput, ctx context.Context) (*ClientMergeOutput, error) {
+	merge := &domains.ClientMerge{
+		SourceClientID: p.SourceID,
+		TargetClientID: p.TargetID,
+		MergedBy:       p.MergedBy,
+		MergedAt:       time.Now().UTC(),
+	}
+	if err := merge.Validate(); err != nil {
+		return nil, err
+	}
+
+	source, err := c.repo.FindClientByID(p.SourceID, ctx)
+	if err != nil {
+		c.l.Error("error getting source client", zap.Error(err))
+		return nil, err
+	}
+	if _, err := c.repo.FindClientByID(p.TargetID, ctx); err != nil {
+		c.l.Error("error getting target client", zap.Error(err))
+		return nil, err
+	}
+	merge.SourceSnapshot = *source
+
+	if _, err := c.repo.MergeClients(merge, ctx); err != nil {
+		if !errors.Is(err, domains.ErrContractClientType) && !errors.Is(err, domains.ErrContractOverlap) && !errors.Is(err, domains.ErrClientNotFound) {
+			c.l.Error("error merging clients", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	output := toClientMergeOutput(merge)
+	return &output, nil
+}
+func (c *clientService) ListClientMerges(id uuid.UUID, ctx context.Context) ([]ClientMergeOutput, error) {
+	merges, err := c.repo.ListClientMerges(id, ctx)
+	if err != nil {
+		c.l.Error("error listing client merges", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]ClientMergeOutput, 0, len(merges))
+	for _, m := range merges {
+		output = append(output, toClientMergeOutput(m))
+	}
+	return output, nil
+}
+
+func toClientSummary(cl *domains.Client) ClientSummary {
+	return ClientSummary{
+		ID:         cl.ID,
+		ClientName: cl.ClientName,
+		CnpjOrCpf:  cl.CnpjOrCpf,
+		Email:      cl.Contact.Email,
+		Phone:      cl.Contact.Phone,
+	}
+}
+
+func toClientMergeOutput(m *domains.ClientMerge) ClientMergeOutput {
+	return ClientMergeOutput{
+		ID:             m.ID,
+		Source:         toClientSummary(&m.SourceSnapshot),
+		TargetID:       m.TargetClientID,
+		FormsMoved:     m.FormsMoved,
+		ContractsMoved: m.ContractsMoved,
+		MergedBy:       m.MergedBy,
+		MergedAt:       m.MergedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateCommentInput struct {
+	AuthorID uuid.UUID `json:"author_id"`
+	Body     string    `json:"body"`
+	Internal bool      `json:"internal"`
+}
+
+// EditCommentInput mantém a visibilidade atual quando Internal é nil. Admin
+// indica se quem edita é administrador, o que libera editar comentários de
+// outros autores.
+type EditCommentInput struct {
+	Body     string    `json:"body"`
+	Internal *bool     `json:"internal"`
+	EditedBy uuid.UUID `json:"edited_by"`
+	Admin    bool      `json:"admin"`
+}
+
+type CommentOutput struct {
+	ID         uuid.UUID `json:"id"`
+	AuthorID   uuid.UUID `json:"author_id"`
+	AuthorName string    `json:"author_name"`
+	Body       string    `json:"body"`
+	Internal   bool      `json:"internal"`
+	Edited     bool      `json:"edited"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type ListCommentsOutput struct {
+	Comments []CommentOutput `json:"comments"`
+}
+
+type CommentEditOutput struct {
+	ID               uuid.UUID `json:"id"`
+	PreviousBody     string    `json:"previous_body"`
+	PreviousInternal bool      `json:"previous_internal"`
+	EditedBy         uuid.UUID `json:"edited_by"`
+	EditedByName     string    `json:"edited_by_name"`
+	EditedAt         time.Time `json:"edited_at"`
+}
+
+type ListCommentEditsOutput struct {
+	Edits []CommentEditOutput `json:"edits"`
+}
+
+type AssignmentChangeOutput struct {
+	ID         uuid.UUID `json:"id"`
+	MemberID   uuid.UUID `json:"member_id"`
+	MemberName string    `json:"member_name"`
+	Action     string    `json:"action"`
+}
+
+type TimelineAttachmentOutput struct {
+	ID          uuid.UUID `json:"id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+}
+
+// TimelineEventOutput é um evento da linha do tempo; só o campo do tipo do
+// evento vem preenchido.
+type TimelineEventOutput struct {
+	Type       string                    `json:"type"`
+	At         time.Time                 `json:"at"`
+	ActorID    uuid.UUID                 `json:"actor_id"`
+	ActorName  string                    `json:"actor_name"`
+	Comment    *CommentOutput            `json:"comment,omitempty"`
+	Status     *FormStatusChangeOutput   `json:"status,omitempty"`
+	Assignment *AssignmentChangeOutput   `json:"assignment,omitempty"`
+	Attachment *TimelineAttachmentOutput `json:"attachment,omitempty"`
+}
+
+type TimelineOutput struct {
+	Events []TimelineEventOutput `json:"events"`
+}
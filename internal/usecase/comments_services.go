@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type CommentsUseCase interface {
+	CreateComment(uuid.UUID, CreateCommentInput, context.Context) (uuid.UUID, error)
+	ListComments(uuid.UUID, context.Context) (*ListCommentsOutput, error)
+	ListClientComments(uuid.UUID, uuid.UUID, context.Context) (*ListCommentsOutput, error)
+	EditComment(uuid.UUID, uuid.UUID, EditCommentInput, context.Context) error
+	ListCommentEdits(uuid.UUID, uuid.UUID, context.Context) (*ListCommentEditsOutput, error)
+	GetTimeline(uuid.UUID, context.Context) (*TimelineOutput, error)
+}
+
+type commentService struct {
+	repo           repository.CommentRepository
+	formRepo       repository.FormRepository
+	attachmentRepo repository.AttachmentRepository
+	l              *zap.Logger
+}
+
+func NewCommentService(repo repository.CommentRepository, formRepo repository.FormRepository, attachmentRepo repository.AttachmentRepository, l *zap.Logger) CommentsUseCase {
+	return &commentService{
+		repo:           repo,
+		formRepo:       formRepo,
+		attachmentRepo: attachmentRepo,
+		l:              l,
+	}
+}
+
+func (c *commentService) CreateComment(formID uuid.UUID, input CreateCommentInput, ctx context.Context) (uuid.UUID, error) {
+	if _, err := c.formRepo.FindFormByID(formID, ctx); err != nil {
+		c.l.Error("error getting form", zap.Error(err))
+		return uuid.Nil, err
+	}
+
+	comment := &domains.FormComment{
+		FormID:   formID,
+		AuthorID: input.AuthorID,
+		Body:     input.Body,
+		Internal: input.Internal,
+	}
+	if err := comment.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := c.repo.SaveComment(comment, ctx); err != nil {
+		c.l.Error("error creating comment", zap.Error(err))
+		return uuid.Nil, err
+	}
+	return comment.ID, nil
+}
+func (c *commentService) ListComments(formID uuid.UUID, ctx context.Context) (*ListCommentsOutput, error) {
+	if _, err := c.formRepo.FindFormByID(formID, ctx); err != nil {
+		c.l.Error("error getting form", zap.Error(err))
+		return nil, err
+	}
+
+	comments, err := c.repo.ListComments(formID, true, ctx)
+	if err != nil {
+		c.l.Error("error listing comments", zap.Error(err))
+		return nil, err
+	}
+
+	return &ListCommentsOutput{Comments: toCommentOutputs(comments)}, nil
+}
+
+// ListClientComments devolve apenas os comentários não internos, e só se o
+// atendimento for do cliente informado.
+func (c *commentService) ListClientComments(clientID, formID uuid.UUID, ctx context.Context) (*ListCommentsOutput, error) {
+	form, err := c.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			c.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+	if form.Cliente.ID != clientID {
+		return nil, domains.ErrFormNotFound
+	}
+
+	comments, err := c.repo.ListComments(formID, false, ctx)
+	if err != nil {
+		c.l.Error("error listing comments", zap.Error(err))
+		return nil, err
+	}
+
+	return &ListCommentsOutput{Comments: toCommentOutputs(comments)}, nil
+}
+
+// EditComment altera o comentário guardando a versão anterior. Edições que
+// não mudam nada não geram histórico.
+func (c *commentService) EditComment(formID, commentID uuid.UUID, input EditCommentInput, ctx context.Context) error {
+	comment, err := c.repo.FindComment(formID, commentID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrCommentNotFound) {
+			c.l.Error("error getting comment", zap.Error(err))
+		}
+		return err
+	}
+	if !comment.CanEdit(input.EditedBy, input.Admin) {
+		return domains.ErrCommentForbidden
+	}
+
+	internal := comment.Internal
+	if input.Internal != nil {
+		internal = *input.Internal
+	}
+
+	edit, err := comment.Edit(input.Body, internal, input.EditedBy, time.Now().UTC())
+	if err != nil || edit == nil {
+		return err
+	}
+
+	if err := c.repo.UpdateComment(comment, edit, ctx); err != nil {
+		c.l.Error("error updating comment", zap.Error(err))
+		return err
+	}
+	return nil
+}
+func (c *commentService) ListCommentEdits(formID, commentID uuid.UUID, ctx context.Context) (*ListCommentEditsOutput, error) {
+	if _, err := c.repo.FindComment(formID, commentID, ctx); err != nil {
+		if !errors.Is(err, domains.ErrCommentNotFound) {
+			c.l.Error("error getting comment", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	edits, err := c.repo.ListCommentEdits(commentID, ctx)
+	if err != nil {
+		c.l.Error("error listing comment edits", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]CommentEditOutput, 0, len(edits))
+	for _, e := range edits {
+		out = append(out, CommentEditOutput{
+			ID:               e.ID,
+			PreviousBody:     e.PreviousBody,
+			PreviousInternal: e.PreviousInternal,
+			EditedBy:         e.EditedBy,
+			EditedByName:     e.EditedByName,
+			EditedAt:         e.EditedAt,
+		})
+	}
+
+	return &ListCommentEditsOutput{Edits: out}, nil
+}
+
+// GetTimeline junta comentários, mudanças de situação, mudanças de técnicos e
+// anexos do atendimento em ordem cronológica.
+func (c *commentService) GetTimeline(formID uuid.UUID, ctx context.Context) (*TimelineOutput, error) {
+	if _, err := c.formRepo.FindFormByID(formID, ctx); err != nil {
+		c.l.Error("error getting form", zap.Error(err))
+		return nil, err
+	}
+
+	comments, err := c.repo.ListComments(formID, true, ctx)
+	if err != nil {
+		c.l.Error("error listing comments", zap.Error(err))
+		return nil, err
+	}
+	statuses, err := c.formRepo.ListFormStatusHistory(formID, ctx)
+	if err != nil {
+		c.l.Error("error listing form status history", zap.Error(err))
+		return nil, err
+	}
+	assignments, err := c.formRepo.ListFormAssignmentHistory(formID, ctx)
+	if err != nil {
+		c.l.Error("error listing form assignment history", zap.Error(err))
+		return nil, err
+	}
+	attachments, err := c.attachmentRepo.ListAttachments(formID, ctx)
+	if err != nil {
+		c.l.Error("error listing attachments", zap.Error(err))
+		return nil, err
+	}
+
+	events := domains.BuildTimeline(comments, statuses, assignments, attachments)
+	out := make([]TimelineEventOutput, 0, len(events))
+	for _, e := range events {
+		event := TimelineEventOutput{
+			Type:      e.Type,
+			At:        e.At,
+			ActorID:   e.ActorID,
+			ActorName: e.ActorName,
+		}
+		switch {
+		case e.Comment != nil:
+			comment := toCommentOutput(e.Comment)
+			event.Comment = &comment
+		case e.Status != nil:
+			event.Status = &FormStatusChangeOutput{
+				ID:            e.Status.ID,
+				FromStatus:    e.Status.FromStatus,
+				ToStatus:      e.Status.ToStatus,
+				Reason:        e.Status.Reason,
+				ChangedBy:     e.Status.ChangedBy,
+				ChangedByName: e.Status.ChangedByName,
+				ChangedAt:     e.Status.ChangedAt,
+			}
+		case e.Assignment != nil:
+			event.Assignment = &AssignmentChangeOutput{
+				ID:         e.Assignment.ID,
+				MemberID:   e.Assignment.MemberID,
+				MemberName: e.Assignment.MemberName,
+				Action:     e.Assignment.Action,
+			}
+		case e.Attachment != nil:
+			event.Attachment = &TimelineAttachmentOutput{
+				ID:          e.Attachment.ID,
+				FileName:    e.Attachment.FileName,
+				ContentType: e.Attachment.ContentType,
+				Size:        e.Attachment.Size,
+			}
+		}
+		out = append(out, event)
+	}
+
+	return &TimelineOutput{Events: out}, nil
+}
+
+func toCommentOutputs(comments []*domains.FormComment) []CommentOutput {
+	out := make([]CommentOutput, 0, len(comments))
+	for _, c := range comments {
+		out = append(out, toCommentOutput(c))
+	}
+	return out
+}
+
+func toCommentOutput(c *domains.FormComment) CommentOutput {
+	return CommentOutput{
+		ID:         c.ID,
+		AuthorID:   c.AuthorID,
+		AuthorName: c.AuthorName,
+		Body:       c.Body,
+		Internal:   c.Internal,
+		Edited:     c.EditCount > 0,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
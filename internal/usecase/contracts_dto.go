@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CreateContractInput struct {
+	ClienteId          uuid.UUID       `json:"cliente_id"`
+	StartDate          time.Time       `json:"start_date"`
+	EndDate            time.Time       `json:"end_date"`
+	MonthlyHours       decimal.Decimal `json:"monthly_hours"`
+	CoveredServices    []string        `json:"covered_services"`
+	ResponseSLAHours   int32           `json:"response_sla_hours"`
+	ResolutionSLAHours int32           `json:"resolution_sla_hours"`
+	MonthlyPrice       decimal.Decimal `json:"monthly_price"`
+}
+
+type UpdateContractInput struct {
+	StartDate          time.Time       `json:"start_date"`
+	EndDate            time.Time       `json:"end_date"`
+	MonthlyHours       decimal.Decimal `json:"monthly_hours"`
+	CoveredServices    []string        `json:"covered_services"`
+	ResponseSLAHours   int32           `json:"response_sla_hours"`
+	ResolutionSLAHours int32           `json:"resolution_sla_hours"`
+	MonthlyPrice       decimal.Decimal `json:"monthly_price"`
+}
+
+type ListContractsOutput struct {
+	Contracts []ContractOutput `json:"contracts"`
+}
+
+type GetContractOutput struct {
+	Contract ContractOutput `json:"contract"`
+}
+
+type ContractOutput struct {
+	ID uuid.UUID `json:"id"`
+
+	Cliente            Client          `json:"cliente"`
+	StartDate          time.Time       `json:"start_date"`
+	EndDate            time.Time       `json:"end_date"`
+	MonthlyHours       decimal.Decimal `json:"monthly_hours"`
+	CoveredServices    []string        `json:"covered_services"`
+	ResponseSLAHours   int32           `json:"response_sla_hours"`
+	ResolutionSLAHours int32           `json:"resolution_sla_hours"`
+	MonthlyPrice       decimal.Decimal `json:"monthly_price"`
+
+	UsedHoursInPeriod      decimal.Decimal `json:"used_hours_in_period"`
+	RemainingHoursInPeriod decimal.Decimal `json:"remaining_hours_in_period"`
+	Alerts                 []string        `json:"alerts"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ContractUsageOutput struct {
+	Contract ContractOutput        `json:"contract"`
+	Periods  []ContractPeriodUsage `json:"periods"`
+}
+
+type ContractPeriodUsage struct {
+	Start           time.Time       `json:"start"`
+	End             time.Time       `json:"end"`
+	ContractedHours decimal.Decimal `json:"contracted_hours"`
+	UsedHours       decimal.Decimal `json:"used_hours"`
+	RemainingHours  decimal.Decimal `json:"remaining_hours"`
+}
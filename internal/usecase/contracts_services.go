@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+type ContractUseCase interface {
+	CreateContract(CreateContractInput, context.Context) (uuid.UUID, error)
+	GetContract(uuid.UUID, context.Context) (*GetContractOutput, error)
+	ListContracts(uuid.UUID, context.Context) (*ListContractsOutput, error)
+	UpdateContract(uuid.UUID, UpdateContractInput, context.Context) error
+	DeleteContract(uuid.UUID, context.Context) error
+	GetContractUsage(uuid.UUID, context.Context) (*ContractUsageOutput, error)
+	ListContractAlerts(context.Context) (*ListContractsOutput, error)
+}
+
+type contractService struct {
+	repo       repository.ContractRepository
+	clientRepo repository.ClientRepository
+	l          *zap.Logger
+}
+
+func NewContractService(repo repository.ContractRepository, clientRepo repository.ClientRepository, l *zap.Logger) ContractUseCase {
+	return &contractService{
+		repo:       repo,
+		clientRepo: clientRepo,
+		l:          l,
+	}
+}
+
+func (c *contractService) CreateContract(p CreateContractInput, ctx context.Context) (uuid.UUID, error) {
+	contract := &domains.Contract{
+		Cliente:            domains.ClientForm{ID: p.ClienteId},
+		StartDate:          p.StartDate,
+		EndDate:            p.EndDate,
+		MonthlyHours:       p.MonthlyHours,
+		CoveredServices:    p.CoveredServices,
+		ResponseSLAHours:   p.ResponseSLAHours,
+		ResolutionSLAHours: p.ResolutionSLAHours,
+		MonthlyPrice:       p.MonthlyPrice,
+	}
+	if contract.CoveredServices == nil {
+		contract.CoveredServices = []string{}
+	}
+	if err := contract.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	client, err := c.clientRepo.FindClientByID(p.ClienteId, ctx)
+	if err != nil {
+		c.l.Error("error getting client", zap.Error(err))
+		return uuid.Nil, err
+	}
+	if client.ClientType != "contrato" {
+		return uuid.Nil, domains.ErrContractClientType
+	}
+
+	if err := c.checkOverlap(contract, ctx); err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := c.repo.SaveContract(contract, ctx)
+	if err != nil {
+		c.l.Error("error saving contract", zap.Error(err))
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+func (c *contractService) GetContract(id uuid.UUID, ctx context.Context) (*GetContractOutput, error) {
+	contract, err := c.repo.FindContractByID(id, ctx)
+	if err != nil {
+		c.l.Error("error getting contract", zap.Error(err))
+		return nil, err
+	}
+
+	consumption, err := c.repo.ListContractConsumption(id, ctx)
+	if err != nil {
+		c.l.Error("error getting contract consumption", zap.Error(err))
+		return nil, err
+	}
+
+	now := time.Now()
+	return &GetContractOutput{
+		Contract: toContractOutput(contract, usedInPeriod(contract, consumption, now), now),
+	}, nil
+}
+func (c *contractService) ListContracts(clientID uuid.UUID, ctx context.Context) (*ListContractsOutput, error) {
+	var (
+		contracts []*domains.Contract
+		err       error
+	)
+	if clientID != uuid.Nil {
+		contracts, err = c.repo.ListContractsByClient(clientID, ctx)
+	} else {
+		contracts, err = c.repo.ListContracts(ctx)
+	}
+	if err != nil {
+		c.l.Error("error listing contracts", zap.Error(err))
+		return nil, err
+	}
+
+	now := time.Now()
+	consumed, err := c.consumedInCurrentMonth(now, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]ContractOutput, 0, len(contracts))
+	for _, contract := range contracts {
+		list = append(list, toContractOutput(contract, consumed[contract.ID], now))
+	}
+
+	return &ListContractsOutput{Contracts: list}, nil
+}
+func (c *contractService) UpdateContract(id uuid.UUID, input UpdateContractInput, ctx context.Context) error {
+	contract, err := c.repo.FindContractByID(id, ctx)
+	if err != nil {
+		c.l.Error("error getting contract", zap.Error(err))
+		return err
+	}
+
+	contract.StartDate = input.StartDate
+	contract.EndDate = input.EndDate
+	contract.MonthlyHours = input.MonthlyHours
+	contract.ResponseSLAHours = input.ResponseSLAHours
+	contract.ResolutionSLAHours = input.ResolutionSLAHours
+	contract.MonthlyPrice = input.MonthlyPrice
+	if input.CoveredServices != nil {
+		contract.CoveredServices = input.CoveredServices
+	}
+	if err := contract.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.checkOverlap(contract, ctx); err != nil {
+		return err
+	}
+
+	if err := c.repo.UpdateContract(contract, ctx); err != nil {
+		c.l.Error("error updating contract", zap.Error(err))
+		return err
+	}
+	return nil
+}
+func (c *contractService) DeleteContract(id uuid.UUID, ctx context.Context) error {
+	if err := c.repo.DeleteContract(id, ctx); err != nil {
+		c.l.Error("error deleting contract", zap.Error(err))
+		return err
+	}
+	return nil
+}
+func (c *contractService) GetContractUsage(id uuid.UUID, ctx context.Context) (*ContractUsageOutput, error) {
+	contract, err := c.repo.FindContractByID(id, ctx)
+	if err != nil {
+		c.l.Error("error getting contract", zap.Error(err))
+		return nil, err
+	}
+
+	consumption, err := c.repo.ListContractConsumption(id, ctx)
+	if err != nil {
+		c.l.Error("error getting contract consumption", zap.Error(err))
+		return nil, err
+	}
+
+	now := time.Now()
+	usage := contract.Usage(consumption, now)
+	periods := make([]ContractPeriodUsage, 0, len(usage))
+	for _, u := range usage {
+		periods = append(periods, ContractPeriodUsage{
+			Start:           u.Period.Start,
+			End:             u.Period.End,
+			ContractedHours: contract.MonthlyHours,
+			UsedHours:       u.UsedHours,
+			RemainingHours:  u.RemainingHours,
+		})
+	}
+
+	return &ContractUsageOutput{
+		Contract: toContractOutput(contract, usedInPeriod(contract, consumption, now), now),
+		Periods:  periods,
+	}, nil
+}
+func (c *contractService) ListContractAlerts(ctx context.Context) (*ListContractsOutput, error) {
+	contracts, err := c.repo.ListContracts(ctx)
+	if err != nil {
+		c.l.Error("error listing contracts", zap.Error(err))
+		return nil, err
+	}
+
+	now := time.Now()
+	consumed, err := c.consumedInCurrentMonth(now, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]ContractOutput, 0)
+	for _, contract := range contracts {
+		output := toContractOutput(contract, consumed[contract.ID], now)
+		if len(output.Alerts) > 0 {
+			list = append(list, output)
+		}
+	}
+
+	return &ListContractsOutput{Contracts: list}, nil
+}
+
+func (c *contractService) checkOverlap(contract *domains.Contract, ctx context.Context) error {
+	overlapping, err := c.repo.CountOverlappingContracts(contract, ctx)
+	if err != nil {
+		c.l.Error("error checking overlapping contracts", zap.Error(err))
+		return err
+	}
+	if overlapping > 0 {
+		return domains.ErrContractOverlap
+	}
+	return nil
+}
+
+// consumedInCurrentMonth retorna as horas consumidas por contrato no mês civil
+// corrente, que coincide com o período de faturamento de contratos vigentes.
+func (c *contractService) consumedInCurrentMonth(now time.Time, ctx context.Context) (map[uuid.UUID]decimal.Decimal, error) {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	consumed, err := c.repo.ConsumedHoursByContract(start, start.AddDate(0, 1, 0), ctx)
+	if err != nil {
+		c.l.Error("error getting consumed hours", zap.Error(err))
+		return nil, err
+	}
+	return consumed, nil
+}
+
+func usedInPeriod(contract *domains.Contract, consumption []domains.ContractConsumption, now time.Time) decimal.Decimal {
+	period := contract.BillingPeriodAt(now)
+	used := decimal.Zero
+	for _, entry := range consumption {
+		at := entry.OccurredAt.UTC()
+		if !at.Before(period.Start) && at.Before(period.End) {
+			used = used.Add(entry.Hours)
+		}
+	}
+	return used
+}
+
+func toContractOutput(contract *domains.Contract, used decimal.Decimal, now time.Time) ContractOutput {
+	return ContractOutput{
+		ID: contract.ID,
+		Cliente: Client{
+			ID:         contract.Cliente.ID,
+			ClientName: contract.Cliente.ClientName,
+		},
+		StartDate:              contract.StartDate,
+		EndDate:                contract.EndDate,
+		MonthlyHours:           contract.MonthlyHours,
+		CoveredServices:        contract.CoveredServices,
+		ResponseSLAHours:       contract.ResponseSLAHours,
+		ResolutionSLAHours:     contract.ResolutionSLAHours,
+		MonthlyPrice:           contract.MonthlyPrice,
+		UsedHoursInPeriod:      used,
+		RemainingHoursInPeriod: contract.RemainingHours(used),
+		Alerts:                 contract.Alerts(now, used),
+		CreatedAt:              contract.CreatedAt,
+		UpdatedAt:              contract.UpdatedAt,
+	}
+}
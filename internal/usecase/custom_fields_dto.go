@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CustomFieldRules struct {
+	MinLength *int     `json:"min_length"`
+	MaxLength *int     `json:"max_length"`
+	Pattern   string   `json:"pattern"`
+	Min       *float64 `json:"min"`
+	Max       *float64 `json:"max"`
+}
+
+type CreateCustomFieldInput struct {
+	Entity   string           `json:"entity"`
+	Key      string           `json:"key"`
+	Label    string           `json:"label"`
+	Type     string           `json:"type"`
+	Required bool             `json:"required"`
+	Options  []string         `json:"options"`
+	Rules    CustomFieldRules `json:"rules"`
+}
+
+// UpdateCustomFieldInput não permite trocar entidade, chave ou tipo, pois os
+// valores já gravados dependem deles.
+type UpdateCustomFieldInput struct {
+	Label    string           `json:"label"`
+	Required bool             `json:"required"`
+	Options  []string         `json:"options"`
+	Rules    CustomFieldRules `json:"rules"`
+}
+
+type CustomFieldOutput struct {
+	ID       uuid.UUID        `json:"id"`
+	Entity   string           `json:"entity"`
+	Key      string           `json:"key"`
+	Label    string           `json:"label"`
+	Type     string           `json:"type"`
+	Required bool             `json:"required"`
+	Options  []string         `json:"options"`
+	Rules    CustomFieldRules `json:"rules"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListFilterInput são os filtros de listagem recebidos da API: valores de
+// campos personalizados ainda em texto e tags que o registro deve ter.
+type ListFilterInput struct {
+	CustomFields map[string]string `json:"custom_fields"`
+	Tags         []string          `json:"tags"`
+}
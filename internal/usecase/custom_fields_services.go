@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type CustomFieldUseCase interface {
+	CreateCustomField(CreateCustomFieldInput, context.Context) (uuid.UUID, error)
+	ListCustomFields(string, context.Context) ([]CustomFieldOutput, error)
+	UpdateCustomField(uuid.UUID, UpdateCustomFieldInput, context.Context) error
+	DeleteCustomField(uuid.UUID, context.Context) error
+}
+
+type customFieldService struct {
+	repo repository.CustomFieldRepository
+	l    *zap.Logger
+}
+
+func NewCustomFieldService(repo repository.CustomFieldRepository, l *zap.Logger) CustomFieldUseCase {
+	return &customFieldService{repo: repo, l: l}
+}
+
+func (c *customFieldService) CreateCustomField(p CreateCustomFieldInput, ctx context.Context) (uuid.UUID, error) {
+	def := &domains.CustomFieldDefinition{
+		Entity:   p.Entity,
+		Key:      p.Key,
+		Label:    p.Label,
+		Type:     p.Type,
+		Required: p.Required,
+		Options:  p.Options,
+		Rules:    toDomainRules(p.Rules),
+	}
+	if err := def.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := c.repo.SaveCustomField(def, ctx)
+	if err != nil {
+		c.l.Error("error saving custom field", zap.Error(err))
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+func (c *customFieldService) ListCustomFields(entity string, ctx context.Context) ([]CustomFieldOutput, error) {
+	defs, err := c.repo.ListCustomFields(entity, ctx)
+	if err != nil {
+		c.l.Error("error listing custom fields", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]CustomFieldOutput, 0, len(defs))
+	for _, d := range defs {
+		output = append(output, CustomFieldOutput{
+			ID:       d.ID,
+			Entity:   d.Entity,
+			Key:      d.Key,
+			Label:    d.Label,
+			Type:     d.Type,
+			Required: d.Required,
+			Options:  d.Options,
+			Rules: CustomFieldRules{
+				MinLength: d.Rules.MinLength,
+				MaxLength: d.Rules.MaxLength,
+				Pattern:   d.Rules.Pattern,
+				Min:       d.Rules.Min,
+				Max:       d.Rules.Max,
+			},
+			CreatedAt: d.CreatedAt,
+			UpdatedAt: d.UpdatedAt,
+		})
+	}
+	return output, nil
+}
+func (c *customFieldService) UpdateCustomField(id uuid.UUID, p UpdateCustomFieldInput, ctx context.Context) error {
+	def, err := c.repo.FindCustomFieldByID(id, ctx)
+	if err != nil {
+		c.l.Error("error getting custom field", zap.Error(err))
+		return err
+	}
+
+	def.Label = p.Label
+	def.Required = p.Required
+	def.Options = p.Options
+	def.Rules = toDomainRules(p.Rules)
+	if err := def.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.repo.UpdateCustomField(def, ctx); err != nil {
+		c.l.Error("error updating custom field", zap.Error(err))
+		return err
+	}
+	return nil
+}
+func (c *customFieldService) DeleteCustomField(id uuid.UUID, ctx context.Context) error {
+	if err := c.repo.DeleteCustomField(id, ctx); err != nil {
+		c.l.Error("error deleting custom field", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func toDomainRules(r CustomFieldRules) domains.CustomFieldRules {
+	return domains.CustomFieldRules{
+		MinLength: r.MinLength,
+		MaxLength: r.MaxLength,
+		Pattern:   r.Pattern,
+		Min:       r.Min,
+		Max:       r.Max,
+	}
+}
+
+// validateCustomFields confere os valores de um cliente ou atendimento contra
+// as definições atuais da entidade.
+func validateCustomFields(repo repository.CustomFieldRepository, entity string, values map[string]any, ctx context.Context) (domains.CustomFields, error) {
+	defs, err := repo.ListCustomFields(entity, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return domains.ValidateCustomFields(defs, values)
+}
+
+// buildListFilter converte os filtros da API em um domains.ListFilter tipado.
+func buildListFilter(repo repository.CustomFieldRepository, entity string, input ListFilterInput, ctx context.Context) (domains.ListFilter, error) {
+	tags, err := domains.NormalizeTags(input.Tags)
+	if err != nil {
+		return domains.ListFilter{}, err
+	}
+	if len(input.CustomFields) == 0 {
+		return domains.ListFilter{Tags: tags}, nil
+	}
+
+	defs, err := repo.ListCustomFields(entity, ctx)
+	if err != nil {
+		return domains.ListFilter{}, err
+	}
+	fields, err := domains.BuildCustomFieldFilter(defs, input.CustomFields)
+	if err != nil {
+		return domains.ListFilter{}, err
+	}
+	return domains.ListFilter{CustomFields: fields, Tags: tags}, nil
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SuggestTechniciansInput struct {
+	ClientID uuid.UUID `json:"client_id"`
+	Limit    int       `json:"limit"`
+}
+
+// MemberLocationInput grava a localização atual (Kind "atual"), informada
+// pelo próprio técnico ou por um administrador, ou a base (Kind "base"),
+// alterada só por administradores.
+type MemberLocationInput struct {
+	Kind        string    `json:"kind"`
+	Latitude    float64   `json:"latitude"`
+	Longitude   float64   `json:"longitude"`
+	RequestedBy uuid.UUID `json:"requested_by"`
+	IsAdmin     bool      `json:"is_admin"`
+}
+
+type DispatchScoresOutput struct {
+	Distance float64 `json:"distance"`
+	Workload float64 `json:"workload"`
+	History  float64 `json:"history"`
+	Role     float64 `json:"role"`
+}
+
+// DispatchSuggestionOutput traz DistanceKm e LocationSource só com Located.
+type DispatchSuggestionOutput struct {
+	MemberID          uuid.UUID            `json:"member_id"`
+	Name              string               `json:"name"`
+	Role              string               `json:"role"`
+	Score             float64              `json:"score"`
+	Scores            DispatchScoresOutput `json:"scores"`
+	Located           bool                 `json:"located"`
+	LocationSource    string               `json:"location_source"`
+	DistanceKm        float64              `json:"distance_km"`
+	OpenForms         int                  `json:"open_forms"`
+	RecentClientForms int                  `json:"recent_client_forms"`
+	LastClientFormAt  time.Time            `json:"last_client_form_at"`
+	Reasons           []string             `json:"reasons"`
+}
+
+// DispatchSuggestionsOutput traz ClientLocated falso quando o cliente não tem
+// coordenadas; nesse caso a distância não entra no ranking.
+type DispatchSuggestionsOutput struct {
+	ClientID      uuid.UUID                  `json:"client_id"`
+	ClientLocated bool                       `json:"client_located"`
+	Suggestions   []DispatchSuggestionOutput `json:"suggestions"`
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type DispatchUseCase interface {
+	SuggestTechnicians(SuggestTechniciansInput, context.Context) (*DispatchSuggestionsOutput, error)
+	UpdateMemberLocation(uuid.UUID, MemberLocationInput, context.Context) error
+}
+
+type dispatchService struct {
+	repo repository.DispatchRepository
+	l    *zap.Logger
+}
+
+func NewDispatchService(repo repository.DispatchRepository, l *zap.Logger) DispatchUseCase {
+	return &dispatchService{
+		repo: repo,
+		l:    l,
+	}
+}
+
+// SuggestTechnicians ordena os técnicos ativos para um novo atendimento do
+// cliente, do mais indicado para o menos, com os pontos e o motivo de cada
+// critério.
+func (d *dispatchService) SuggestTechnicians(input SuggestTechniciansInput, ctx context.Context) (*DispatchSuggestionsOutput, error) {
+	location, suggestions, err := rankTechnicians(d.repo, input.ClientID, time.Now(), ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrClientNotFound) {
+			d.l.Error("error ranking technicians", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	limit := domains.NormalizeDispatchLimit(input.Limit)
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	out := &DispatchSuggestionsOutput{
+		ClientID:      input.ClientID,
+		ClientLocated: location != nil,
+		Suggestions:   make([]DispatchSuggestionOutput, 0, len(suggestions)),
+	}
+	for _, s := range suggestions {
+		out.Suggestions = append(out.Suggestions, toDispatchSuggestionOutput(s))
+	}
+
+	return out, nil
+}
+
+// UpdateMemberLocation grava a localização atual ou a base do técnico. A
+// localização atual só pode ser informada pelo próprio técnico ou por um
+// administrador; a base, só por administradores.
+func (d *dispatchService) UpdateMemberLocation(memberID uuid.UUID, input MemberLocationInput, ctx context.Context) error {
+	location := domains.GeoPoint{Latitude: input.Latitude, Longitude: input.Longitude}
+	if err := domains.ValidateMemberLocation(location); err != nil {
+		return err
+	}
+
+	switch input.Kind {
+	case domains.DispatchLocationHome:
+		if !input.IsAdmin {
+			return domains.ErrMemberLocationForbidden
+		}
+		if err := d.repo.UpdateMemberHomeLocation(memberID, location, ctx); err != nil {
+			if !errors.Is(err, domains.ErrMemberNotFound) {
+				d.l.Error("error updating member home location", zap.Error(err))
+			}
+			return err
+		}
+	case domains.DispatchLocationCurrent:
+		if !input.IsAdmin {
+			userID, err := d.repo.FindMemberUserID(memberID, ctx)
+			if err != nil {
+				if !errors.Is(err, domains.ErrMemberNotFound) {
+					d.l.Error("error getting member", zap.Error(err))
+				}
+				return err
+			}
+			if userID != input.RequestedBy {
+				return domains.ErrMemberLocationForbidden
+			}
+		}
+		if err := d.repo.UpdateMemberCurrentLocation(memberID, location, time.Now(), ctx); err != nil {
+			if !errors.Is(err, domains.ErrMemberNotFound) {
+				d.l.Error("error updating member current location", zap.Error(err))
+			}
+			return err
+		}
+	default:
+		return domains.ErrInvalidMemberLocation
+	}
+
+	return nil
+}
+
+// rankTechnicians devolve as coordenadas do cliente (nil quando ele não as
+// tem) e os técnicos ativos ordenados para um atendimento dele em now.
+func rankTechnicians(repo repository.DispatchRepository, clientID uuid.UUID, now time.Time, ctx context.Context) (*domains.GeoPoint, []domains.DispatchSuggestion, error) {
+	location, err := repo.FindClientLocation(clientID, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candidates, err := repo.ListDispatchCandidates(clientID, now.Add(-domains.DispatchHistoryWindow), ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return location, domains.RankDispatchCandidates(candidates, location, now), nil
+}
+
+func toDispatchSuggestionOutput(s domains.DispatchSuggestion) DispatchSuggestionOutput {
+	return DispatchSuggestionOutput{
+		MemberID: s.MemberID,
+		Name:     s.Name,
+		Role:     s.Role,
+		Score:    s.Score,
+		Scores: DispatchScoresOutput{
+			Distance: s.Scores.Distance,
+			Workload: s.Scores.Workload,
+			History:  s.Scores.History,
+			Role:     s.Scores.Role,
+		},
+		Located:           s.Located,
+		LocationSource:    s.LocationSource,
+		DistanceKm:        s.DistanceKm,
+		OpenForms:         s.OpenForms,
+		RecentClientForms: s.RecentClientForms,
+		LastClientFormAt:  s.LastClientFormAt,
+		Reasons:           s.Reasons,
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateFormTypeInput cria o tipo já com a versão 1 do esquema.
+type CreateFormTypeInput struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Schema      json.RawMessage `json:"schema"`
+	CreatedBy   uuid.UUID       `json:"created_by"`
+}
+
+// UpdateFormTypeInput substitui o nome, a descrição e a situação do tipo; o
+// esquema só muda publicando uma nova versão.
+type UpdateFormTypeInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Active      bool   `json:"active"`
+}
+
+type PublishFormTypeVersionInput struct {
+	FormTypeID uuid.UUID       `json:"form_type_id"`
+	Schema     json.RawMessage `json:"schema"`
+	CreatedBy  uuid.UUID       `json:"created_by"`
+}
+
+type FormTypeOutput struct {
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Active         bool      `json:"active"`
+	CurrentVersion int       `json:"current_version"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// FormTypeDetailOutput traz o tipo com o esquema da versão atual, usado na
+// abertura de novos atendimentos.
+type FormTypeDetailOutput struct {
+	FormTypeOutput
+	Schema json.RawMessage `json:"schema"`
+}
+
+type ListFormTypesOutput struct {
+	FormTypes []FormTypeOutput `json:"form_types"`
+}
+
+type FormTypeVersionOutput struct {
+	FormTypeID uuid.UUID       `json:"form_type_id"`
+	Version    int             `json:"version"`
+	Schema     json.RawMessage `json:"schema"`
+	CreatedBy  uuid.UUID       `json:"created_by"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type ListFormTypeVersionsOutput struct {
+	Versions []FormTypeVersionOutput `json:"versions"`
+}
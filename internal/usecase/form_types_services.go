@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type FormTypesUseCase interface {
+	CreateFormType(CreateFormTypeInput, context.Context) (uuid.UUID, error)
+	GetFormType(uuid.UUID, context.Context) (*FormTypeDetailOutput, error)
+	ListFormTypes(context.Context) (*ListFormTypesOutput, error)
+	UpdateFormType(uuid.UUID, UpdateFormTypeInput, context.Context) error
+	PublishFormTypeVersion(PublishFormTypeVersionInput, context.Context) (*FormTypeVersionOutput, error)
+	GetFormTypeVersion(uuid.UUID, int, context.Context) (*FormTypeVersionOutput, error)
+	ListFormTypeVersions(uuid.UUID, context.Context) (*ListFormTypeVersionsOutput, error)
+}
+
+type formTypeService struct {
+	repo repository.FormTypeRepository
+	l    *zap.Logger
+}
+
+func NewFormTypeService(repo repository.FormTypeRepository, l *zap.Logger) FormTypesUseCase {
+	return &formTypeService{
+		repo: repo,
+		l:    l,
+	}
+}
+
+func (f *formTypeService) CreateFormType(input CreateFormTypeInput, ctx context.Context) (uuid.UUID, error) {
+	formType := &domains.FormType{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	formType.Normalize()
+	if err := formType.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	schema, err := compactFormSchema(input.Schema)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := f.repo.SaveFormType(formType, &domains.FormTypeVersion{
+		Schema:    schema,
+		CreatedBy: input.CreatedBy,
+	}, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormTypeNameTaken) {
+			f.l.Error("error creating form type", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (f *formTypeService) GetFormType(id uuid.UUID, ctx context.Context) (*FormTypeDetailOutput, error) {
+	formType, err := f.repo.FindFormTypeByID(id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormTypeNotFound) {
+			f.l.Error("error getting form type", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	version, err := f.repo.FindFormTypeVersion(id, formType.CurrentVersion, ctx)
+	if err != nil {
+		f.l.Error("error getting form type version", zap.Error(err))
+		return nil, err
+	}
+
+	return &FormTypeDetailOutput{
+		FormTypeOutput: toFormTypeOutput(formType),
+		Schema:         version.Schema,
+	}, nil
+}
+
+func (f *formTypeService) ListFormTypes(ctx context.Context) (*ListFormTypesOutput, error) {
+	types, err := f.repo.ListFormTypes(ctx)
+	if err != nil {
+		f.l.Error("error listing form types", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]FormTypeOutput, 0, len(types))
+	for _, formType := range types {
+		output = append(output, toFormTypeOutput(formType))
+	}
+	return &ListFormTypesOutput{FormTypes: output}, nil
+}
+
+// UpdateFormType altera os dados cadastrais do tipo. Desativar o tipo impede
+// novos atendimentos, mas os já abertos continuam editáveis.
+func (f *formTypeService) UpdateFormType(id uuid.UUID, input UpdateFormTypeInput, ctx context.Context) error {
+	formType := &domains.FormType{
+		ID:          id,
+		Name:        input.Name,
+		Description: input.Description,
+		Active:      input.Active,
+	}
+	formType.Normalize()
+	if err := formType.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.repo.UpdateFormType(formType, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormTypeNotFound) && !errors.Is(err, domains.ErrFormTypeNameTaken) {
+			f.l.Error("error updating form type", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
+// PublishFormTypeVersion publica um novo esquema para o tipo. Os novos
+// atendimentos passam a usá-lo; os já abertos continuam na versão com que
+// foram criados.
+func (f *formTypeService) PublishFormTypeVersion(input PublishFormTypeVersionInput, ctx context.Context) (*FormTypeVersionOutput, error) {
+	schema, err := compactFormSchema(input.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &domains.FormTypeVersion{
+		FormTypeID: input.FormTypeID,
+		Schema:     schema,
+		CreatedBy:  input.CreatedBy,
+	}
+	if _, err := f.repo.SaveFormTypeVersion(version, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormTypeNotFound) {
+			f.l.Error("error publishing form type version", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	saved, err := f.repo.FindFormTypeVersion(input.FormTypeID, version.Version, ctx)
+	if err != nil {
+		f.l.Error("error getting form type version", zap.Error(err))
+		return nil, err
+	}
+
+	output := toFormTypeVersionOutput(saved)
+	return &output, nil
+}
+
+func (f *formTypeService) GetFormTypeVersion(id uuid.UUID, version int, ctx context.Context) (*FormTypeVersionOutput, error) {
+	saved, err := f.repo.FindFormTypeVersion(id, version, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormTypeVersionNotFound) {
+			f.l.Error("error getting form type version", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	output := toFormTypeVersionOutput(saved)
+	return &output, nil
+}
+
+func (f *formTypeService) ListFormTypeVersions(id uuid.UUID, ctx context.Context) (*ListFormTypeVersionsOutput, error) {
+	if _, err := f.repo.FindFormTypeByID(id, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormTypeNotFound) {
+			f.l.Error("error getting form type", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	versions, err := f.repo.ListFormTypeVersions(id, ctx)
+	if err != nil {
+		f.l.Error("error listing form type versions", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]FormTypeVersionOutput, 0, len(versions))
+	for _, version := range versions {
+		output = append(output, toFormTypeVersionOutput(version))
+	}
+	return &ListFormTypeVersionsOutput{Versions: output}, nil
+}
+
+// compactFormSchema valida o esquema e o grava sem espaços, preservando a
+// ordem das propriedades escrita pelo administrador.
+func compactFormSchema(raw json.RawMessage) (json.RawMessage, error) {
+	if _, err := domains.ParseFormSchema(raw); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func toFormTypeOutput(t *domains.FormType) FormTypeOutput {
+	return FormTypeOutput{
+		ID:             t.ID,
+		Name:           t.Name,
+		Description:    t.Description,
+		Active:         t.Active,
+		CurrentVersion: t.CurrentVersion,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+}
+
+func toFormTypeVersionOutput(v *domains.FormTypeVersion) FormTypeVersionOutput {
+	return FormTypeVersionOutput{
+		FormTypeID: v.FormTypeID,
+		Version:    v.Version,
+		Schema:     v.Schema,
+		CreatedBy:  v.CreatedBy,
+		CreatedAt:  v.CreatedAt,
+	}
+}
@@ -4,31 +4,109 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type CreateFormInput struct {
-	TecnicoResponsavelId []uuid.UUID `json:"tecnico_responsavel"`
-	DataDeAbertura       time.Time   `json:"data_de_abertura"`
-	ClienteId            uuid.UUID   `json:"cliente_id"`
-	SolicitedBy          string      `json:"solicited_by"`
-	DifficultyLevel      string      `json:"difficulty_level"`
-	DefectDescription    string      `json:"defect_description"`
-	SolutionDescription  string      `json:"solution_description"`
+	TecnicoResponsavelId []uuid.UUID     `json:"tecnico_responsavel"`
+	DataDeAbertura       time.Time       `json:"data_de_abertura"`
+	ClienteId            uuid.UUID       `json:"cliente_id"`
+	SolicitedBy          string          `json:"solicited_by"`
+	DifficultyLevel      string          `json:"difficulty_level"`
+	DefectDescription    string          `json:"defect_description"`
+	SolutionDescription  string          `json:"solution_description"`
+	HoursConsumed        decimal.Decimal `json:"hours_consumed"`
+
+	CustomFields map[string]any `json:"custom_fields"`
+	Tags         []string       `json:"tags"`
+
+	// ChecklistTemplateID copia os itens do modelo para o atendimento
+	// (uuid.Nil = sem lista de verificação).
+	ChecklistTemplateID uuid.UUID `json:"checklist_template_id"`
+
+	// FormTypeID escolhe o tipo de formulário; FormData é validado contra a
+	// versão atual do tipo (uuid.Nil = sem campos extras).
+	FormTypeID uuid.UUID      `json:"form_type_id"`
+	FormData   map[string]any `json:"form_data"`
+
+	// AutoAssign designa o técnico mais bem colocado nas sugestões de
+	// designação quando TecnicoResponsavelId está vazio.
+	AutoAssign bool `json:"auto_assign"`
+
+	CreatedBy uuid.UUID `json:"created_by"`
 }
 
+// UpdateFormInput mantém os campos personalizados, as tags e os dados do tipo
+// de formulário atuais quando CustomFields, Tags ou FormData são nil; quando
+// informados, substituem os anteriores. FormData é validado contra a versão
+// do esquema com que o atendimento foi aberto.
 type UpdateFormInput struct {
-	ID                   uuid.UUID   `json:"id"`
-	DataDeAbertura       time.Time   `json:"data_de_abertura"`
-	TecnicoResponsavelId []uuid.UUID `json:"tecnico_responsavel"`
-	ClienteId            uuid.UUID   `json:"cliente_id"`
-	SolicitedBy          string      `json:"solicited_by"`
-	DifficultyLevel      string      `json:"difficulty_level"`
-	DefectDescription    string      `json:"defect_description"`
-	SolutionDescription  string      `json:"solution_description"`
+	ID                   uuid.UUID        `json:"id"`
+	DataDeAbertura       time.Time        `json:"data_de_abertura"`
+	TecnicoResponsavelId []uuid.UUID      `json:"tecnico_responsavel"`
+	ClienteId            uuid.UUID        `json:"cliente_id"`
+	SolicitedBy          string           `json:"solicited_by"`
+	DifficultyLevel      string           `json:"difficulty_level"`
+	DefectDescription    string           `json:"defect_description"`
+	SolutionDescription  string           `json:"solution_description"`
+	HoursConsumed        *decimal.Decimal `json:"hours_consumed"`
+
+	CustomFields map[string]any `json:"custom_fields"`
+	Tags         []string       `json:"tags"`
+	FormData     map[string]any `json:"form_data"`
+
+	UpdatedBy uuid.UUID `json:"updated_by"`
+	// Admin permite alterar atendimentos já assinados pelo cliente.
+	Admin bool `json:"admin"`
 }
 
+// ListFormsInput filtra e pagina a listagem de atendimentos. Cursor é o ID
+// do último atendimento da página anterior; Limit zero usa o tamanho padrão.
+type ListFormsInput struct {
+	ListFilterInput
+
+	ClientID        uuid.UUID `json:"client_id"`
+	TecnicoID       uuid.UUID `json:"tecnico_id"`
+	DifficultyLevel string    `json:"difficulty_level"`
+	Status          string    `json:"status"`
+	OccurredFrom    time.Time `json:"occurred_from"`
+	OccurredTo      time.Time `json:"occurred_to"`
+	// PublicCode busca pelo protocolo completo ou por um trecho dele.
+	PublicCode string `json:"public_code"`
+
+	Cursor uuid.UUID `json:"cursor"`
+	Limit  int       `json:"limit"`
+}
+
+// ListFormsOutput traz NextCursor igual a uuid.Nil na última página.
 type ListFormsOutput struct {
-	Forms []FormsOutput `json:"forms"`
+	Forms      []FormsOutput `json:"forms"`
+	NextCursor uuid.UUID     `json:"next_cursor"`
+}
+
+// SearchFormsInput usa os filtros de ListFormsInput, exceto o cursor; a
+// paginação é por página, a partir de 1.
+type SearchFormsInput struct {
+	ListFormsInput
+
+	Query string `json:"query"`
+	Page  int    `json:"page"`
+}
+
+// FormSearchResultOutput traz os trechos com os termos encontrados entre
+// <mark> e </mark>.
+type FormSearchResultOutput struct {
+	Form            FormsOutput `json:"form"`
+	Rank            float64     `json:"rank"`
+	DefectSnippet   string      `json:"defect_snippet"`
+	SolutionSnippet string      `json:"solution_snippet"`
+}
+
+type SearchFormsOutput struct {
+	Results []FormSearchResultOutput `json:"results"`
+	Total   int                      `json:"total"`
+	Page    int                      `json:"page"`
+	Limit   int                      `json:"limit"`
 }
 
 type GetFormsOutput struct {
@@ -36,18 +114,57 @@ type GetFormsOutput struct {
 }
 
 type FormsOutput struct {
-	ID uuid.UUID `json:"id"`
+	ID         uuid.UUID `json:"id"`
+	PublicCode string    `json:"public_code"`
+
+	DataDeAbertura       time.Time       `json:"data_de_abertura"`
+	TecnicoResponsavelId []Tecnicos      `json:"tecnicos_responsaveis"`
+	ClienteId            Client          `json:"cliente_id"`
+	SolicitedBy          string          `json:"solicited_by"`
+	DifficultyLevel      string          `json:"difficulty_level"`
+	DefectDescription    string          `json:"defect_description"`
+	SolutionDescription  string          `json:"solution_description"`
+	Status               string          `json:"status"`
+	ContractID           uuid.UUID       `json:"contract_id"`
+	HoursConsumed        decimal.Decimal `json:"hours_consumed"`
+
+	CustomFields map[string]any `json:"custom_fields"`
+	Tags         []string       `json:"tags"`
+	SLA          FormSLAOutput  `json:"sla"`
 
-	DataDeAbertura       time.Time  `json:"data_de_abertura"`
-	TecnicoResponsavelId []Tecnicos `json:"tecnicos_responsaveis"`
-	ClienteId            Client     `json:"cliente_id"`
-	SolicitedBy          string     `json:"solicited_by"`
-	DifficultyLevel      string     `json:"difficulty_level"`
-	DefectDescription    string     `json:"defect_description"`
-	SolutionDescription  string     `json:"solution_description"`
+	FormTypeID      uuid.UUID      `json:"form_type_id"`
+	FormTypeVersion int            `json:"form_type_version"`
+	FormData        map[string]any `json:"form_data"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// TransitionFormStatusInput pede a mudança de situação de um atendimento.
+// SolutionDescription, quando informada, substitui a solução atual. Em
+// atendimentos assinados, só administradores podem ir para outra situação que
+// não fechado.
+type TransitionFormStatusInput struct {
+	Status              string    `json:"status"`
+	Reason              string    `json:"reason"`
+	SolutionDescription string    `json:"solution_description"`
+	ChangedBy           uuid.UUID `json:"changed_by"`
+	Admin               bool      `json:"admin"`
+}
+
+type ListFormStatusHistoryOutput struct {
+	Changes []FormStatusChangeOutput `json:"changes"`
+}
+
+type FormStatusChangeOutput struct {
+	ID            uuid.UUID `json:"id"`
+	FromStatus    string    `json:"from_status"`
+	ToStatus      string    `json:"to_status"`
+	Reason        string    `json:"reason"`
+	ChangedBy     uuid.UUID `json:"changed_by"`
+	ChangedByName string    `json:"changed_by_name"`
+	ChangedAt     time.Time `json:"changed_at"`
 }
 
 type Tecnicos struct {
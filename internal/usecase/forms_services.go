@@ -2,43 +2,85 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"olidesk-api-2/internal/domains"
 	"olidesk-api-2/internal/repository"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 type formService struct {
-	repo repository.FormRepository
-	l    *zap.Logger
+	repo          repository.FormRepository
+	contractRepo  repository.ContractRepository
+	fieldRepo     repository.CustomFieldRepository
+	slaRepo       repository.SLARepository
+	clientRepo    repository.ClientRepository
+	checklistRepo repository.ChecklistRepository
+	formTypeRepo  repository.FormTypeRepository
+	dispatchRepo  repository.DispatchRepository
+	hours         domains.BusinessHours
+	l             *zap.Logger
 }
 
 type FormsUseCase interface {
 	CreateForm(CreateFormInput, context.Context) (uuid.UUID, error)
 	GetForm(uuid.UUID, context.Context) (*GetFormsOutput, error)
+	GetFormByCode(string, context.Context) (*GetFormsOutput, error)
 	UpdateForm(uuid.UUID, UpdateFormInput, context.Context) error
-	DeleteForm(uuid.UUID, context.Context) error
-	ListForms(context.Context) (*ListFormsOutput, error)
+	DeleteForm(uuid.UUID, bool, context.Context) error
+	ListForms(ListFormsInput, context.Context) (*ListFormsOutput, error)
+	SearchForms(SearchFormsInput, context.Context) (*SearchFormsOutput, error)
+	ListDeletedForms(context.Context) (*ListFormsOutput, error)
+	RestoreForm(uuid.UUID, context.Context) error
+	PurgeForm(uuid.UUID, context.Context) error
+	TransitionFormStatus(uuid.UUID, TransitionFormStatusInput, context.Context) error
+	ListFormStatusHistory(uuid.UUID, context.Context) (*ListFormStatusHistoryOutput, error)
 }
 
-func NewFormService(repo repository.FormRepository, l *zap.Logger) FormsUseCase {
+// NewFormService recebe o expediente usado no cálculo dos prazos de SLA.
+func NewFormService(repo repository.FormRepository, contractRepo repository.ContractRepository, fieldRepo repository.CustomFieldRepository, slaRepo repository.SLARepository, clientRepo repository.ClientRepository, checklistRepo repository.ChecklistRepository, formTypeRepo repository.FormTypeRepository, dispatchRepo repository.DispatchRepository, hours domains.BusinessHours, l *zap.Logger) FormsUseCase {
 	return &formService{
-		repo: repo,
-		l:    l,
+		repo:          repo,
+		contractRepo:  contractRepo,
+		fieldRepo:     fieldRepo,
+		slaRepo:       slaRepo,
+		clientRepo:    clientRepo,
+		checklistRepo: checklistRepo,
+		formTypeRepo:  formTypeRepo,
+		dispatchRepo:  dispatchRepo,
+		hours:         hours,
+		l:             l,
 	}
 }
 
 func (f *formService) CreateForm(p CreateFormInput, ctx context.Context) (uuid.UUID, error) {
-	tecnicos := make([]domains.Member, 0, len(p.TecnicoResponsavelId))
-	for _, tecID := range p.TecnicoResponsavelId {
-		tecnicos = append(tecnicos, domains.Member{
-			ID: tecID,
-		})
+	customFields, err := validateCustomFields(f.fieldRepo, domains.CustomFieldEntityForm, p.CustomFields, ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	tags, err := domains.NormalizeTags(p.Tags)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	formTypeVersion, formData, err := f.validateNewFormData(p.FormTypeID, p.FormData, ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Atendimentos de clientes com contrato vigente consomem a franquia do contrato.
+	contractID := uuid.Nil
+	contract, err := f.contractRepo.FindActiveContractByClient(p.ClienteId, p.DataDeAbertura, ctx)
+	switch {
+	case err == nil:
+		contractID = contract.ID
+	case !errors.Is(err, domains.ErrContractNotFound):
+		f.l.Error("error finding active contract", zap.Error(err))
+		return uuid.Nil, err
 	}
 
-	id, err := f.repo.SaveForm(&domains.Atendimentos{
-		TecnicoResponsavelId: tecnicos,
+	form := &domains.Atendimentos{
 		Cliente: domains.ClientForm{
 			ID: p.ClienteId,
 		},
@@ -47,13 +89,70 @@ func (f *formService) CreateForm(p CreateFormInput, ctx context.Context) (uuid.U
 		DifficultyLevel:     p.DifficultyLevel,
 		DefectDescription:   p.DefectDescription,
 		SolutionDescription: p.SolutionDescription,
-	}, ctx)
+		Status:              domains.FormStatusOpen,
+		ContractID:          contractID,
+		HoursConsumed:       p.HoursConsumed,
+		CustomFields:        customFields,
+		Tags:                tags,
+		FormTypeID:          p.FormTypeID,
+		FormTypeVersion:     formTypeVersion,
+		FormData:            formData,
+		PublicCodeYear:      domains.FormCodeYear(time.Now(), f.hours.Location),
+	}
+	if err := computeFormSLA(f.slaRepo, f.clientRepo, f.contractRepo, f.hours, form, ctx); err != nil {
+		if !errors.Is(err, domains.ErrClientNotFound) {
+			f.l.Error("error computing form sla", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	if p.ChecklistTemplateID != uuid.Nil {
+		template, err := f.checklistRepo.FindChecklistTemplateByID(p.ChecklistTemplateID, ctx)
+		if err != nil {
+			if !errors.Is(err, domains.ErrChecklistTemplateNotFound) {
+				f.l.Error("error getting checklist template", zap.Error(err))
+			}
+			return uuid.Nil, err
+		}
+		form.Checklist = template.NewChecklist(uuid.Nil)
+	}
+	technicians, err := f.formTechnicians(p, ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	assignments := form.AssignTo(technicians, p.CreatedBy, time.Now().UTC())
+
+	id, err := f.repo.SaveForm(form, assignments, ctx)
 	if err != nil {
 		f.l.Error("error creating form", zap.Error(err))
 		return uuid.Nil, err
 	}
 	return id, nil
 }
+
+// formTechnicians devolve os técnicos informados ou, sem eles e com
+// AutoAssign, o primeiro das sugestões de designação.
+func (f *formService) formTechnicians(p CreateFormInput, ctx context.Context) ([]uuid.UUID, error) {
+	if len(p.TecnicoResponsavelId) > 0 {
+		return p.TecnicoResponsavelId, nil
+	}
+	if !p.AutoAssign {
+		return nil, domains.ErrFormTechniciansRequired
+	}
+
+	_, suggestions, err := rankTechnicians(f.dispatchRepo, p.ClienteId, time.Now(), ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrClientNotFound) {
+			f.l.Error("error ranking technicians", zap.Error(err))
+		}
+		return nil, err
+	}
+	if len(suggestions) == 0 {
+		return nil, domains.ErrNoDispatchCandidates
+	}
+
+	return []uuid.UUID{suggestions[0].MemberID}, nil
+}
+
 func (f *formService) GetForm(id uuid.UUID, ctx context.Context) (*GetFormsOutput, error) {
 	form, err := f.repo.FindFormByID(id, ctx)
 	if err != nil {
@@ -61,31 +160,26 @@ func (f *formService) GetForm(id uuid.UUID, ctx context.Context) (*GetFormsOutpu
 		return nil, err
 	}
 
-	tecnicos := make([]Tecnicos, 0, len(form.TecnicoResponsavelId))
-	for _, tec := range form.TecnicoResponsavelId {
-		tecnicos = append(tecnicos, Tecnicos{
-			ID:   tec.ID,
-			Name: tec.Name,
-		})
+	return toGetFormsOutput(form), nil
+}
+
+// GetFormByCode busca o atendimento pelo protocolo informado pelo cliente,
+// aceitando minúsculas, sem o prefixo e sem os zeros à esquerda.
+func (f *formService) GetFormByCode(code string, ctx context.Context) (*GetFormsOutput, error) {
+	code, err := domains.ParseFormCode(code)
+	if err != nil {
+		return nil, err
 	}
 
-	return &GetFormsOutput{
-		Form: FormsOutput{
-			ID:                   form.ID,
-			TecnicoResponsavelId: tecnicos,
-			ClienteId: Client{
-				ID:         form.Cliente.ID,
-				ClientName: form.Cliente.ClientName,
-			},
-			SolicitedBy:         form.SolicitedBy,
-			DifficultyLevel:     form.DifficultyLevel,
-			DefectDescription:   form.DefectDescription,
-			SolutionDescription: form.SolutionDescription,
-			DataDeAbertura:      form.DataDeAbertura,
-			UpdatedAt:           form.UpdatedAt,
-			CreatedAt:           form.CreatedAt,
-		},
-	}, nil
+	form, err := f.repo.FindFormByCode(code, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			f.l.Error("error getting form by code", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return toGetFormsOutput(form), nil
 }
 func (f *formService) UpdateForm(id uuid.UUID, input UpdateFormInput, ctx context.Context) error {
 	form, err := f.repo.FindFormByID(id, ctx)
@@ -93,17 +187,35 @@ func (f *formService) UpdateForm(id uuid.UUID, input UpdateFormInput, ctx contex
 		f.l.Error("error getting form", zap.Error(err))
 		return err
 	}
+	if err := form.CheckEditable(input.Admin); err != nil {
+		return err
+	}
+
+	// Mudanças de cliente ou de nível recalculam os prazos a partir da abertura.
+	clientChanged := input.ClienteId != uuid.Nil && input.ClienteId != form.Cliente.ID
+	recomputeSLA := clientChanged ||
+		(input.DifficultyLevel != "" && input.DifficultyLevel != form.DifficultyLevel)
 
+	var assignments []*domains.FormAssignmentChange
 	if len(input.TecnicoResponsavelId) > 0 {
-		tecnicos := make([]domains.Member, 0, len(input.TecnicoResponsavelId))
-		for _, tecID := range input.TecnicoResponsavelId {
-			tecnicos = append(tecnicos, domains.Member{ID: tecID})
-		}
-		form.TecnicoResponsavelId = tecnicos
+		assignments = form.AssignTo(input.TecnicoResponsavelId, input.UpdatedBy, time.Now().UTC())
 	}
 	if input.ClienteId != uuid.Nil {
 		form.Cliente.ID = input.ClienteId
 	}
+	// O contrato acompanha o cliente: o novo cliente pode não ter contrato
+	// vigente na abertura, e aí o atendimento deixa de consumir franquia.
+	if clientChanged {
+		form.ContractID = uuid.Nil
+		contract, err := f.contractRepo.FindActiveContractByClient(form.Cliente.ID, form.DataDeAbertura, ctx)
+		switch {
+		case err == nil:
+			form.ContractID = contract.ID
+		case !errors.Is(err, domains.ErrContractNotFound):
+			f.l.Error("error finding active contract", zap.Error(err))
+			return err
+		}
+	}
 	if input.SolicitedBy != "" {
 		form.SolicitedBy = input.SolicitedBy
 	}
@@ -116,14 +228,60 @@ func (f *formService) UpdateForm(id uuid.UUID, input UpdateFormInput, ctx contex
 	if input.SolutionDescription != "" {
 		form.SolutionDescription = input.SolutionDescription
 	}
-	if err := f.repo.UpdateForm(form, ctx); err != nil {
+	if input.HoursConsumed != nil {
+		form.HoursConsumed = *input.HoursConsumed
+	}
+	if input.CustomFields != nil {
+		customFields, err := validateCustomFields(f.fieldRepo, domains.CustomFieldEntityForm, input.CustomFields, ctx)
+		if err != nil {
+			return err
+		}
+		form.CustomFields = customFields
+	}
+	if input.Tags != nil {
+		tags, err := domains.NormalizeTags(input.Tags)
+		if err != nil {
+			return err
+		}
+		form.Tags = tags
+	}
+	if input.FormData != nil {
+		formData, err := f.validateFormData(form, input.FormData, ctx)
+		if err != nil {
+			return err
+		}
+		form.FormData = formData
+	}
+	if recomputeSLA {
+		if err := computeFormSLA(f.slaRepo, f.clientRepo, f.contractRepo, f.hours, form, ctx); err != nil {
+			if !errors.Is(err, domains.ErrClientNotFound) {
+				f.l.Error("error computing form sla", zap.Error(err))
+			}
+			return err
+		}
+	}
+	if err := f.repo.UpdateForm(form, assignments, ctx); err != nil {
 		f.l.Error("error updating form", zap.Error(err))
 		return err
 	}
 
 	return nil
 }
-func (f *formService) DeleteForm(id uuid.UUID, ctx context.Context) error {
+
+// DeleteForm envia o atendimento para a lixeira. Atendimentos assinados só
+// podem ser excluídos por administradores.
+func (f *formService) DeleteForm(id uuid.UUID, admin bool, ctx context.Context) error {
+	form, err := f.repo.FindFormByID(id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			f.l.Error("error getting form", zap.Error(err))
+		}
+		return err
+	}
+	if err := form.CheckEditable(admin); err != nil {
+		return err
+	}
+
 	if err := f.repo.DeleteForm(id, ctx); err != nil {
 		f.l.Error("error deleting form", zap.Error(err))
 		return err
@@ -131,13 +289,32 @@ func (f *formService) DeleteForm(id uuid.UUID, ctx context.Context) error {
 
 	return nil
 }
-func (f *formService) ListForms(ctx context.Context) (*ListFormsOutput, error) {
-	formData, err := f.repo.ListForms(ctx)
+func (f *formService) ListForms(input ListFormsInput, ctx context.Context) (*ListFormsOutput, error) {
+	filter, err := f.formListFilter(input, domains.DefaultFormPageSize, ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit := filter.Limit
+	filter.After = input.Cursor
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Um registro a mais indica se existe uma próxima página.
+	filter.Limit++
+	formData, err := f.repo.ListForms(filter, ctx)
 	if err != nil {
 		f.l.Error("error listing forms", zap.Error(err))
 		return nil, err
 	}
 
+	nextCursor := uuid.Nil
+	if len(formData) > limit {
+		formData = formData[:limit]
+		nextCursor = formData[limit-1].ID
+	}
+
+	now := time.Now().UTC()
 	formList := make([]FormsOutput, 0, len(formData))
 	for _, fl := range formData {
 		tecnicos := make([]Tecnicos, 0, len(fl.TecnicoResponsavelId))
@@ -150,6 +327,7 @@ func (f *formService) ListForms(ctx context.Context) (*ListFormsOutput, error) {
 
 		formList = append(formList, FormsOutput{
 			ID:                   fl.ID,
+			PublicCode:           fl.PublicCode,
 			TecnicoResponsavelId: tecnicos,
 			ClienteId: Client{
 				ID:         fl.Cliente.ID,
@@ -159,11 +337,287 @@ func (f *formService) ListForms(ctx context.Context) (*ListFormsOutput, error) {
 			DifficultyLevel:     fl.DifficultyLevel,
 			DefectDescription:   fl.DefectDescription,
 			SolutionDescription: fl.SolutionDescription,
+			Status:              fl.Status,
+			ContractID:          fl.ContractID,
+			HoursConsumed:       fl.HoursConsumed,
+			CustomFields:        fl.CustomFields,
+			FormTypeID:          fl.FormTypeID,
+			FormTypeVersion:     fl.FormTypeVersion,
+			FormData:            fl.FormData,
+			Tags:                fl.Tags,
+			SLA:                 toFormSLAOutput(fl, now),
 			DataDeAbertura:      fl.DataDeAbertura,
 			UpdatedAt:           fl.UpdatedAt.UTC(),
 			CreatedAt:           fl.CreatedAt.UTC(),
 		})
 	}
 
+	return &ListFormsOutput{Forms: formList, NextCursor: nextCursor}, nil
+}
+
+// SearchForms faz a busca textual com os mesmos filtros da listagem, do
+// atendimento mais relevante para o menos relevante.
+func (f *formService) SearchForms(input SearchFormsInput, ctx context.Context) (*SearchFormsOutput, error) {
+	listFilter, err := f.formListFilter(input.ListFormsInput, domains.DefaultFormSearchPageSize, ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := input.Page
+	if page == 0 {
+		page = 1
+	}
+	if page < 0 {
+		return nil, domains.ErrInvalidPageSize
+	}
+
+	filter := domains.FormSearchFilter{
+		FormListFilter: listFilter,
+		Query:          input.Query,
+		Offset:         (page - 1) * listFilter.Limit,
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	results, total, err := f.repo.SearchForms(filter, ctx)
+	if err != nil {
+		f.l.Error("error searching forms", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]FormSearchResultOutput, 0, len(results))
+	for _, r := range results {
+		output = append(output, FormSearchResultOutput{
+			Form:            toGetFormsOutput(r.Form).Form,
+			Rank:            r.Rank,
+			DefectSnippet:   r.DefectSnippet,
+			SolutionSnippet: r.SolutionSnippet,
+		})
+	}
+
+	return &SearchFormsOutput{
+		Results: output,
+		Total:   total,
+		Page:    page,
+		Limit:   filter.Limit,
+	}, nil
+}
+
+func (f *formService) ListDeletedForms(ctx context.Context) (*ListFormsOutput, error) {
+	formData, err := f.repo.ListDeletedForms(ctx)
+	if err != nil {
+		f.l.Error("error listing deleted forms", zap.Error(err))
+		return nil, err
+	}
+
+	formList := make([]FormsOutput, 0, len(formData))
+	for _, fl := range formData {
+		formList = append(formList, FormsOutput{
+			ID:         fl.ID,
+			PublicCode: fl.PublicCode,
+			ClienteId: Client{
+				ID:         fl.Cliente.ID,
+				ClientName: fl.Cliente.ClientName,
+			},
+			SolicitedBy:    fl.SolicitedBy,
+			DataDeAbertura: fl.DataDeAbertura,
+			DeletedAt:      fl.DeletedAt,
+		})
+	}
+
 	return &ListFormsOutput{Forms: formList}, nil
 }
+func (f *formService) RestoreForm(id uuid.UUID, ctx context.Context) error {
+	if err := f.repo.RestoreForm(id, ctx); err != nil {
+		f.l.Error("error restoring form", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+func (f *formService) PurgeForm(id uuid.UUID, ctx context.Context) error {
+	if err := f.repo.PurgeForm(id, ctx); err != nil {
+		f.l.Error("error purging form", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+func (f *formService) TransitionFormStatus(id uuid.UUID, input TransitionFormStatusInput, ctx context.Context) error {
+	form, err := f.repo.FindFormByID(id, ctx)
+	if err != nil {
+		f.l.Error("error getting form", zap.Error(err))
+		return err
+	}
+	change, err := form.ChangeStatus(input.Status, input.Reason, input.SolutionDescription, input.Admin, input.ChangedBy, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	// Itens obrigatórios da lista de verificação sem resposta impedem resolver.
+	if input.Status == domains.FormStatusResolved {
+		items, err := f.checklistRepo.ListChecklistItems(id, ctx)
+		if err != nil {
+			f.l.Error("error listing checklist items", zap.Error(err))
+			return err
+		}
+		if err := domains.CheckChecklistComplete(items); err != nil {
+			return err
+		}
+	}
+
+	if err := f.repo.TransitionFormStatus(form, change, ctx); err != nil {
+		f.l.Error("error changing form status", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+func (f *formService) ListFormStatusHistory(id uuid.UUID, ctx context.Context) (*ListFormStatusHistoryOutput, error) {
+	if _, err := f.repo.FindFormByID(id, ctx); err != nil {
+		f.l.Error("error getting form", zap.Error(err))
+		return nil, err
+	}
+
+	changes, err := f.repo.ListFormStatusHistory(id, ctx)
+	if err != nil {
+		f.l.Error("error listing form status history", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]FormStatusChangeOutput, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, FormStatusChangeOutput{
+			ID:            c.ID,
+			FromStatus:    c.FromStatus,
+			ToStatus:      c.ToStatus,
+			Reason:        c.Reason,
+			ChangedBy:     c.ChangedBy,
+			ChangedByName: c.ChangedByName,
+			ChangedAt:     c.ChangedAt,
+		})
+	}
+
+	return &ListFormStatusHistoryOutput{Changes: out}, nil
+}
+
+// formListFilter monta os filtros comuns à listagem e à busca; o cursor fica
+// por conta de quem chama.
+func (f *formService) formListFilter(input ListFormsInput, defaultLimit int, ctx context.Context) (domains.FormListFilter, error) {
+	listFilter, err := buildListFilter(f.fieldRepo, domains.CustomFieldEntityForm, input.ListFilterInput, ctx)
+	if err != nil {
+		return domains.FormListFilter{}, err
+	}
+	listFilter.ClientID = input.ClientID
+
+	publicCode := ""
+	if input.PublicCode != "" {
+		if publicCode, err = domains.FormCodeSearchTerm(input.PublicCode); err != nil {
+			return domains.FormListFilter{}, err
+		}
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	return domains.FormListFilter{
+		ListFilter:      listFilter,
+		TecnicoID:       input.TecnicoID,
+		DifficultyLevel: input.DifficultyLevel,
+		Status:          input.Status,
+		OccurredFrom:    input.OccurredFrom,
+		OccurredTo:      input.OccurredTo,
+		PublicCode:      publicCode,
+		Limit:           limit,
+	}, nil
+}
+
+func toGetFormsOutput(form *domains.Atendimentos) *GetFormsOutput {
+	tecnicos := make([]Tecnicos, 0, len(form.TecnicoResponsavelId))
+	for _, tec := range form.TecnicoResponsavelId {
+		tecnicos = append(tecnicos, Tecnicos{
+			ID:   tec.ID,
+			Name: tec.Name,
+		})
+	}
+
+	return &GetFormsOutput{
+		Form: FormsOutput{
+			ID:                   form.ID,
+			PublicCode:           form.PublicCode,
+			TecnicoResponsavelId: tecnicos,
+			ClienteId: Client{
+				ID:         form.Cliente.ID,
+				ClientName: form.Cliente.ClientName,
+			},
+			SolicitedBy:         form.SolicitedBy,
+			DifficultyLevel:     form.DifficultyLevel,
+			DefectDescription:   form.DefectDescription,
+			SolutionDescription: form.SolutionDescription,
+			Status:              form.Status,
+			ContractID:          form.ContractID,
+			HoursConsumed:       form.HoursConsumed,
+			CustomFields:        form.CustomFields,
+			FormTypeID:          form.FormTypeID,
+			FormTypeVersion:     form.FormTypeVersion,
+			FormData:            form.FormData,
+			Tags:                form.Tags,
+			SLA:                 toFormSLAOutput(form, time.Now().UTC()),
+			DataDeAbertura:      form.DataDeAbertura,
+			UpdatedAt:           form.UpdatedAt,
+			CreatedAt:           form.CreatedAt,
+		},
+	}
+}
+
+// validateNewFormData valida os dados de um novo atendimento contra a versão
+// atual do tipo e devolve o número dessa versão, gravado no atendimento.
+func (f *formService) validateNewFormData(formTypeID uuid.UUID, data map[string]any, ctx context.Context) (int, domains.FormData, error) {
+	if formTypeID == uuid.Nil {
+		if len(data) > 0 {
+			return 0, nil, domains.ErrFormDataWithoutType
+		}
+		return 0, domains.FormData{}, nil
+	}
+
+	formType, err := f.formTypeRepo.FindFormTypeByID(formTypeID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormTypeNotFound) {
+			f.l.Error("error getting form type", zap.Error(err))
+		}
+		return 0, nil, err
+	}
+	if !formType.Active {
+		return 0, nil, domains.ErrFormTypeInactive
+	}
+
+	version, err := f.formTypeRepo.FindFormTypeVersion(formType.ID, formType.CurrentVersion, ctx)
+	if err != nil {
+		f.l.Error("error getting form type version", zap.Error(err))
+		return 0, nil, err
+	}
+	formData, err := version.ValidateData(data)
+	if err != nil {
+		return 0, nil, err
+	}
+	return version.Version, formData, nil
+}
+
+// validateFormData valida os dados de um atendimento existente contra a versão
+// com que ele foi aberto, mesmo que o tipo tenha versões mais novas.
+func (f *formService) validateFormData(form *domains.Atendimentos, data map[string]any, ctx context.Context) (domains.FormData, error) {
+	if form.FormTypeID == uuid.Nil {
+		if len(data) > 0 {
+			return nil, domains.ErrFormDataWithoutType
+		}
+		return domains.FormData{}, nil
+	}
+
+	version, err := f.formTypeRepo.FindFormTypeVersion(form.FormTypeID, form.FormTypeVersion, ctx)
+	if err != nil {
+		f.l.Error("error getting form type version", zap.Error(err))
+		return nil, err
+	}
+	return version.ValidateData(data)
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type UpdateBillingSettingsInput struct {
+	HourRateLow    decimal.Decimal `json:"hour_rate_low"`
+	HourRateMedium decimal.Decimal `json:"hour_rate_medium"`
+	HourRateHigh   decimal.Decimal `json:"hour_rate_high"`
+	TravelFee      decimal.Decimal `json:"travel_fee"`
+	TaxRate        decimal.Decimal `json:"tax_rate"`
+	UpdatedBy      uuid.UUID       `json:"updated_by"`
+}
+
+type BillingSettingsOutput struct {
+	HourRateLow    decimal.Decimal `json:"hour_rate_low"`
+	HourRateMedium decimal.Decimal `json:"hour_rate_medium"`
+	HourRateHigh   decimal.Decimal `json:"hour_rate_high"`
+	TravelFee      decimal.Decimal `json:"travel_fee"`
+	TaxRate        decimal.Decimal `json:"tax_rate"`
+	UpdatedBy      uuid.UUID       `json:"updated_by"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// CreateInvoiceInput traz os atendimentos a faturar. TaxRate nil usa a
+// alíquota configurada; IncludeTravel cobra a taxa de deslocamento em cada
+// atendimento.
+type CreateInvoiceInput struct {
+	FormIDs       []uuid.UUID      `json:"form_ids"`
+	Discount      decimal.Decimal  `json:"discount"`
+	TaxRate       *decimal.Decimal `json:"tax_rate"`
+	IncludeTravel bool             `json:"include_travel"`
+	Notes         string           `json:"notes"`
+	DueDate       time.Time        `json:"due_date"`
+	CreatedBy     uuid.UUID        `json:"created_by"`
+}
+
+// UpdateInvoiceInput altera um rascunho; os itens não mudam.
+type UpdateInvoiceInput struct {
+	Discount decimal.Decimal `json:"discount"`
+	TaxRate  decimal.Decimal `json:"tax_rate"`
+	Notes    string          `json:"notes"`
+	DueDate  time.Time       `json:"due_date"`
+}
+
+type ListInvoicesInput struct {
+	Status   string    `json:"status"`
+	ClientID uuid.UUID `json:"client_id"`
+}
+
+type ChangeInvoiceStatusInput struct {
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
+type InvoiceItemOutput struct {
+	ID          uuid.UUID       `json:"id"`
+	FormID      uuid.UUID       `json:"form_id"`
+	Kind        string          `json:"kind"`
+	Description string          `json:"description"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+	Total       decimal.Decimal `json:"total"`
+}
+
+// InvoiceOutput é a fatura; Items e FormIDs só vêm preenchidos na consulta
+// de uma fatura.
+type InvoiceOutput struct {
+	ID           uuid.UUID           `json:"id"`
+	Number       int64               `json:"number"`
+	ClientID     uuid.UUID           `json:"client_id"`
+	ClientName   string              `json:"client_name"`
+	Status       string              `json:"status"`
+	FormIDs      []uuid.UUID         `json:"form_ids"`
+	Items        []InvoiceItemOutput `json:"items"`
+	Subtotal     decimal.Decimal     `json:"subtotal"`
+	Discount     decimal.Decimal     `json:"discount"`
+	TaxRate      decimal.Decimal     `json:"tax_rate"`
+	TaxAmount    decimal.Decimal     `json:"tax_amount"`
+	Total        decimal.Decimal     `json:"total"`
+	Notes        string              `json:"notes"`
+	DueDate      time.Time           `json:"due_date"`
+	IssuedAt     time.Time           `json:"issued_at"`
+	PaidAt       time.Time           `json:"paid_at"`
+	CanceledAt   time.Time           `json:"canceled_at"`
+	CancelReason string              `json:"cancel_reason"`
+	CreatedBy    uuid.UUID           `json:"created_by"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+// InvoicePDFOutput é o PDF da fatura e o nome sugerido para o arquivo.
+type InvoicePDFOutput struct {
+	FileName string `json:"file_name"`
+	Content  []byte `json:"-"`
+}
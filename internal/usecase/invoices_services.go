@@ -0,0 +1,357 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/pdf"
+	"olidesk-api-2/internal/repository"
+	"olidesk-api-2/internal/storage"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type InvoicesUseCase interface {
+	GetBillingSettings(context.Context) (*BillingSettingsOutput, error)
+	UpdateBillingSettings(UpdateBillingSettingsInput, context.Context) (*BillingSettingsOutput, error)
+	CreateInvoice(CreateInvoiceInput, context.Context) (uuid.UUID, error)
+	ListInvoices(ListInvoicesInput, context.Context) ([]InvoiceOutput, error)
+	GetInvoice(uuid.UUID, context.Context) (*InvoiceOutput, error)
+	UpdateInvoice(uuid.UUID, UpdateInvoiceInput, context.Context) error
+	ChangeInvoiceStatus(uuid.UUID, ChangeInvoiceStatusInput, context.Context) (*InvoiceOutput, error)
+	RenderInvoice(uuid.UUID, context.Context) (*InvoicePDFOutput, error)
+}
+
+type invoiceService struct {
+	repo         repository.InvoiceRepository
+	formRepo     repository.FormRepository
+	partRepo     repository.PartRepository
+	clientRepo   repository.ClientRepository
+	templateRepo repository.ServiceOrderTemplateRepository
+	store        storage.Storage
+	l            *zap.Logger
+}
+
+func NewInvoiceService(repo repository.InvoiceRepository, formRepo repository.FormRepository, partRepo repository.PartRepository, clientRepo repository.ClientRepository, templateRepo repository.ServiceOrderTemplateRepository, store storage.Storage, l *zap.Logger) InvoicesUseCase {
+	return &invoiceService{
+		repo:         repo,
+		formRepo:     formRepo,
+		partRepo:     partRepo,
+		clientRepo:   clientRepo,
+		templateRepo: templateRepo,
+		store:        store,
+		l:            l,
+	}
+}
+
+func (s *invoiceService) GetBillingSettings(ctx context.Context) (*BillingSettingsOutput, error) {
+	settings, err := s.repo.GetBillingSettings(ctx)
+	if err != nil {
+		s.l.Error("error getting billing settings", zap.Error(err))
+		return nil, err
+	}
+	return toBillingSettingsOutput(settings), nil
+}
+
+func (s *invoiceService) UpdateBillingSettings(input UpdateBillingSettingsInput, ctx context.Context) (*BillingSettingsOutput, error) {
+	settings := &domains.BillingSettings{
+		HourRateLow:    input.HourRateLow,
+		HourRateMedium: input.HourRateMedium,
+		HourRateHigh:   input.HourRateHigh,
+		TravelFee:      input.TravelFee,
+		TaxRate:        input.TaxRate,
+		UpdatedBy:      input.UpdatedBy,
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SaveBillingSettings(settings, ctx); err != nil {
+		s.l.Error("error saving billing settings", zap.Error(err))
+		return nil, err
+	}
+	return toBillingSettingsOutput(settings), nil
+}
+
+// CreateInvoice monta um rascunho com os itens dos atendimentos informados,
+// que precisam ser avulsos, resolvidos e do mesmo cliente. Os valores vêm da
+// configuração de cobrança vigente e ficam gravados nos itens, então mudanças
+// posteriores na configuração não alteram a fatura.
+func (s *invoiceService) CreateInvoice(input CreateInvoiceInput, ctx context.Context) (uuid.UUID, error) {
+	formIDs := uniqueUUIDs(input.FormIDs)
+	if len(formIDs) == 0 || len(formIDs) > domains.MaxInvoiceForms {
+		return uuid.Nil, domains.ErrInvalidInvoice
+	}
+
+	settings, err := s.repo.GetBillingSettings(ctx)
+	if err != nil {
+		s.l.Error("error getting billing settings", zap.Error(err))
+		return uuid.Nil, err
+	}
+
+	inv := &domains.Invoice{
+		Status:    domains.InvoiceStatusDraft,
+		FormIDs:   formIDs,
+		Discount:  input.Discount,
+		TaxRate:   settings.TaxRate,
+		Notes:     strings.TrimSpace(input.Notes),
+		DueDate:   input.DueDate,
+		CreatedBy: input.CreatedBy,
+	}
+	if input.TaxRate != nil {
+		inv.TaxRate = *input.TaxRate
+	}
+
+	for _, formID := range formIDs {
+		form, err := s.formRepo.FindFormByID(formID, ctx)
+		if err != nil {
+			if !errors.Is(err, domains.ErrFormNotFound) {
+				s.l.Error("error getting form", zap.Error(err))
+			}
+			return uuid.Nil, err
+		}
+		if err := domains.CheckInvoiceableForm(form); err != nil {
+			return uuid.Nil, err
+		}
+		if inv.ClientID == uuid.Nil {
+			inv.ClientID = form.Cliente.ID
+			inv.ClientName = form.Cliente.ClientName
+		} else if inv.ClientID != form.Cliente.ID {
+			return uuid.Nil, domains.ErrInvoiceClientMismatch
+		}
+
+		parts, err := s.partRepo.ListFormParts(formID, ctx)
+		if err != nil {
+			s.l.Error("error listing form parts", zap.Error(err))
+			return uuid.Nil, err
+		}
+		items := domains.BuildInvoiceItems(form, parts, settings, input.IncludeTravel)
+		if len(items) == 0 {
+			return uuid.Nil, domains.ErrFormNothingToInvoice
+		}
+		inv.Items = append(inv.Items, items...)
+	}
+
+	inv.Recalculate()
+	if err := inv.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.repo.SaveInvoice(inv, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormAlreadyInvoiced) && !errors.Is(err, domains.ErrClientNotFound) &&
+			!errors.Is(err, domains.ErrFormNotFound) {
+			s.l.Error("error saving invoice", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	return inv.ID, nil
+}
+
+func (s *invoiceService) ListInvoices(input ListInvoicesInput, ctx context.Context) ([]InvoiceOutput, error) {
+	if input.Status != "" && !domains.IsValidInvoiceStatus(input.Status) {
+		return nil, domains.ErrInvalidInvoiceStatus
+	}
+
+	invoices, err := s.repo.ListInvoices(domains.InvoiceFilter{
+		Status:   input.Status,
+		ClientID: input.ClientID,
+	}, ctx)
+	if err != nil {
+		s.l.Error("error listing invoices", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]InvoiceOutput, 0, len(invoices))
+	for _, inv := range invoices {
+		out = append(out, *toInvoiceOutput(inv))
+	}
+	return out, nil
+}
+
+func (s *invoiceService) GetInvoice(id uuid.UUID, ctx context.Context) (*InvoiceOutput, error) {
+	inv, err := s.findInvoice(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toInvoiceOutput(inv), nil
+}
+
+// UpdateInvoice altera desconto, alíquota, observações e vencimento de um
+// rascunho e recalcula os totais; os itens não mudam.
+func (s *invoiceService) UpdateInvoice(id uuid.UUID, input UpdateInvoiceInput, ctx context.Context) error {
+	inv, err := s.findInvoice(id, ctx)
+	if err != nil {
+		return err
+	}
+	if inv.Status != domains.InvoiceStatusDraft {
+		return domains.ErrInvoiceNotDraft
+	}
+
+	inv.Discount = input.Discount
+	inv.TaxRate = input.TaxRate
+	inv.Notes = strings.TrimSpace(input.Notes)
+	inv.DueDate = input.DueDate
+	inv.Recalculate()
+	if err := inv.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateInvoiceDraft(inv, ctx); err != nil {
+		if !errors.Is(err, domains.ErrInvoiceNotDraft) {
+			s.l.Error("error updating invoice", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
+// ChangeInvoiceStatus emite, marca como paga ou cancela a fatura. A mudança só
+// é gravada se ninguém alterou a situação nesse meio tempo; caso contrário
+// devolve ErrInvoiceStatusConflict.
+func (s *invoiceService) ChangeInvoiceStatus(id uuid.UUID, input ChangeInvoiceStatusInput, ctx context.Context) (*InvoiceOutput, error) {
+	inv, err := s.findInvoice(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from := inv.Status
+	if err := inv.TransitionTo(input.Status, input.Reason, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateInvoiceStatus(inv, from, ctx); err != nil {
+		if !errors.Is(err, domains.ErrInvoiceStatusConflict) {
+			s.l.Error("error updating invoice status", zap.Error(err))
+		}
+		return nil, err
+	}
+	return toInvoiceOutput(inv), nil
+}
+
+// RenderInvoice gera o PDF da fatura com o modelo da ordem de serviço. Como
+// na ordem de serviço, cliente excluído ou logotipo indisponível não impedem
+// a geração.
+func (s *invoiceService) RenderInvoice(id uuid.UUID, ctx context.Context) (*InvoicePDFOutput, error) {
+	inv, err := s.findInvoice(id, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.templateRepo.GetServiceOrderTemplate(ctx)
+	if err != nil {
+		s.l.Error("error getting service order template", zap.Error(err))
+		return nil, err
+	}
+
+	doc := pdf.Invoice{
+		Invoice:     inv,
+		Template:    *template,
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	client, err := s.clientRepo.FindClientByID(inv.ClientID, ctx)
+	switch {
+	case err == nil:
+		doc.Client = client
+	case !errors.Is(err, domains.ErrClientNotFound):
+		s.l.Error("error getting client", zap.Error(err))
+		return nil, err
+	}
+
+	if template.LogoKey != "" {
+		doc.Logo = readObject(s.store, template.LogoKey, domains.MaxServiceOrderLogoSize, s.l, ctx)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.RenderInvoice(&buf, doc); err != nil {
+		s.l.Error("error rendering invoice", zap.Error(err))
+		return nil, err
+	}
+
+	name := "fatura-rascunho-" + inv.ID.String() + ".pdf"
+	if inv.Number != 0 {
+		name = "fatura-" + domains.InvoiceNumberLabel(inv.Number) + ".pdf"
+	}
+	return &InvoicePDFOutput{
+		FileName: name,
+		Content:  buf.Bytes(),
+	}, nil
+}
+
+func (s *invoiceService) findInvoice(id uuid.UUID, ctx context.Context) (*domains.Invoice, error) {
+	inv, err := s.repo.FindInvoiceByID(id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrInvoiceNotFound) {
+			s.l.Error("error getting invoice", zap.Error(err))
+		}
+		return nil, err
+	}
+	return inv, nil
+}
+
+// uniqueUUIDs remove repetições mantendo a ordem.
+func uniqueUUIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func toBillingSettingsOutput(s *domains.BillingSettings) *BillingSettingsOutput {
+	return &BillingSettingsOutput{
+		HourRateLow:    s.HourRateLow,
+		HourRateMedium: s.HourRateMedium,
+		HourRateHigh:   s.HourRateHigh,
+		TravelFee:      s.TravelFee,
+		TaxRate:        s.TaxRate,
+		UpdatedBy:      s.UpdatedBy,
+		UpdatedAt:      s.UpdatedAt,
+	}
+}
+
+func toInvoiceOutput(inv *domains.Invoice) *InvoiceOutput {
+	items := make([]InvoiceItemOutput, 0, len(inv.Items))
+	for _, item := range inv.Items {
+		items = append(items, InvoiceItemOutput{
+			ID:          item.ID,
+			FormID:      item.FormID,
+			Kind:        item.Kind,
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Total:       item.Total,
+		})
+	}
+	return &InvoiceOutput{
+		ID:           inv.ID,
+		Number:       inv.Number,
+		ClientID:     inv.ClientID,
+		ClientName:   inv.ClientName,
+		Status:       inv.Status,
+		FormIDs:      inv.FormIDs,
+		Items:        items,
+		Subtotal:     inv.Subtotal,
+		Discount:     inv.Discount,
+		TaxRate:      inv.TaxRate,
+		TaxAmount:    inv.TaxAmount,
+		Total:        inv.Total,
+		Notes:        inv.Notes,
+		DueDate:      inv.DueDate,
+		IssuedAt:     inv.IssuedAt,
+		PaidAt:       inv.PaidAt,
+		CanceledAt:   inv.CanceledAt,
+		CancelReason: inv.CancelReason,
+		CreatedBy:    inv.CreatedBy,
+		CreatedAt:    inv.CreatedAt,
+		UpdatedAt:    inv.UpdatedAt,
+	}
+}
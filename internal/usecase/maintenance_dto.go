@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaintenancePlanInput traz os dados editáveis de um plano; a situação é
+// alterada apenas por pausa e retomada.
+type MaintenancePlanInput struct {
+	ClientID        uuid.UUID   `json:"client_id"`
+	Name            string      `json:"name"`
+	Site            string      `json:"site"`
+	Description     string      `json:"description"`
+	Recurrence      string      `json:"recurrence"`
+	StartsAt        time.Time   `json:"starts_at"`
+	DifficultyLevel string      `json:"difficulty_level"`
+	Checklist       []string    `json:"checklist"`
+	TechnicianIDs   []uuid.UUID `json:"technician_ids"`
+	CreatedBy       uuid.UUID   `json:"created_by"`
+}
+
+type ListMaintenancePlansInput struct {
+	ClientID uuid.UUID `json:"client_id"`
+	Status   string    `json:"status"`
+}
+
+// MaintenancePlanOutput traz o plano e, nas consultas de um plano, as
+// próximas visitas.
+type MaintenancePlanOutput struct {
+	ID              uuid.UUID   `json:"id"`
+	ClientID        uuid.UUID   `json:"client_id"`
+	ClientName      string      `json:"client_name"`
+	Name            string      `json:"name"`
+	Site            string      `json:"site"`
+	Description     string      `json:"description"`
+	Recurrence      string      `json:"recurrence"`
+	StartsAt        time.Time   `json:"starts_at"`
+	DifficultyLevel string      `json:"difficulty_level"`
+	Checklist       []string    `json:"checklist"`
+	TechnicianIDs   []uuid.UUID `json:"technician_ids"`
+	Status          string      `json:"status"`
+	GeneratedUntil  time.Time   `json:"generated_until"`
+	NextVisits      []time.Time `json:"next_visits"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+type ListMaintenancePlansOutput struct {
+	Plans []MaintenancePlanOutput `json:"plans"`
+}
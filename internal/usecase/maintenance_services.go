@@ -0,0 +1,287 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maintenanceNextVisits é o número de próximas visitas mostradas no plano.
+const maintenanceNextVisits = 5
+
+type MaintenanceUseCase interface {
+	CreatePlan(MaintenancePlanInput, context.Context) (uuid.UUID, error)
+	GetPlan(uuid.UUID, context.Context) (*MaintenancePlanOutput, error)
+	ListPlans(ListMaintenancePlansInput, context.Context) (*ListMaintenancePlansOutput, error)
+	UpdatePlan(uuid.UUID, MaintenancePlanInput, context.Context) error
+	DeletePlan(uuid.UUID, context.Context) error
+	PausePlan(uuid.UUID, context.Context) error
+	ResumePlan(uuid.UUID, context.Context) error
+	GenerateDue(time.Time, context.Context) (int, error)
+}
+
+type maintenanceService struct {
+	repo         repository.MaintenanceRepository
+	formRepo     repository.FormRepository
+	contractRepo repository.ContractRepository
+	slaRepo      repository.SLARepository
+	clientRepo   repository.ClientRepository
+	hours        domains.BusinessHours
+	loc          *time.Location
+	horizon      time.Duration
+	l            *zap.Logger
+}
+
+// NewMaintenanceService recebe o expediente, cujo fuso mantém o horário das
+// visitas e cujo calendário conta os prazos de SLA, e a antecedência com que
+// as visitas viram atendimentos; uma antecedência não positiva usa
+// domains.DefaultMaintenanceHorizon.
+func NewMaintenanceService(repo repository.MaintenanceRepository, formRepo repository.FormRepository, contractRepo repository.ContractRepository, slaRepo repository.SLARepository, clientRepo repository.ClientRepository, hours domains.BusinessHours, horizon time.Duration, l *zap.Logger) MaintenanceUseCase {
+	if horizon <= 0 {
+		horizon = domains.DefaultMaintenanceHorizon
+	}
+	return &maintenanceService{
+		repo:         repo,
+		formRepo:     formRepo,
+		contractRepo: contractRepo,
+		slaRepo:      slaRepo,
+		clientRepo:   clientRepo,
+		hours:        hours,
+		loc:          hours.Location,
+		horizon:      horizon,
+		l:            l,
+	}
+}
+
+func (m *maintenanceService) CreatePlan(input MaintenancePlanInput, ctx context.Context) (uuid.UUID, error) {
+	plan := &domains.MaintenancePlan{
+		ClientID:  input.ClientID,
+		Status:    domains.MaintenancePlanActive,
+		CreatedBy: input.CreatedBy,
+	}
+	applyMaintenancePlanInput(plan, input)
+	if err := plan.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := m.repo.SaveMaintenancePlan(plan, ctx); err != nil {
+		if !isMaintenanceInputError(err) {
+			m.l.Error("error creating maintenance plan", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+
+	return plan.ID, nil
+}
+func (m *maintenanceService) GetPlan(id uuid.UUID, ctx context.Context) (*MaintenancePlanOutput, error) {
+	plan, err := m.repo.FindMaintenancePlanByID(id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrMaintenancePlanNotFound) {
+			m.l.Error("error getting maintenance plan", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	output := toMaintenancePlanOutput(plan)
+	output.NextVisits = plan.NextVisits(m.loc, time.Now().UTC(), maintenanceNextVisits)
+	return &output, nil
+}
+func (m *maintenanceService) ListPlans(input ListMaintenancePlansInput, ctx context.Context) (*ListMaintenancePlansOutput, error) {
+	if input.Status != "" && input.Status != domains.MaintenancePlanActive && input.Status != domains.MaintenancePlanPaused {
+		return nil, domains.ErrInvalidMaintenancePlan
+	}
+
+	plans, err := m.repo.ListMaintenancePlans(input.ClientID, input.Status, ctx)
+	if err != nil {
+		m.l.Error("error listing maintenance plans", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]MaintenancePlanOutput, 0, len(plans))
+	for _, plan := range plans {
+		output = append(output, toMaintenancePlanOutput(plan))
+	}
+
+	return &ListMaintenancePlansOutput{Plans: output}, nil
+}
+
+// UpdatePlan altera o plano. As visitas já geradas não mudam; a nova regra
+// vale a partir do fim da janela já gerada.
+func (m *maintenanceService) UpdatePlan(id uuid.UUID, input MaintenancePlanInput, ctx context.Context) error {
+	plan, err := m.repo.FindMaintenancePlanByID(id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrMaintenancePlanNotFound) {
+			m.l.Error("error getting maintenance plan", zap.Error(err))
+		}
+		return err
+	}
+
+	applyMaintenancePlanInput(plan, input)
+	if err := plan.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.repo.UpdateMaintenancePlan(plan, ctx); err != nil {
+		if !isMaintenanceInputError(err) {
+			m.l.Error("error updating maintenance plan", zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+func (m *maintenanceService) DeletePlan(id uuid.UUID, ctx context.Context) error {
+	if err := m.repo.DeleteMaintenancePlan(id, ctx); err != nil {
+		if !errors.Is(err, domains.ErrMaintenancePlanNotFound) {
+			m.l.Error("error deleting maintenance plan", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
+// PausePlan interrompe a geração de visitas; os atendimentos já gerados
+// continuam agendados.
+func (m *maintenanceService) PausePlan(id uuid.UUID, ctx context.Context) error {
+	return m.changeStatus(id, func(plan *domains.MaintenancePlan) error {
+		return plan.Pause()
+	}, ctx)
+}
+
+// ResumePlan retoma a geração a partir de agora, sem gerar as visitas do
+// período pausado.
+func (m *maintenanceService) ResumePlan(id uuid.UUID, ctx context.Context) error {
+	return m.changeStatus(id, func(plan *domains.MaintenancePlan) error {
+		return plan.Resume(time.Now().UTC())
+	}, ctx)
+}
+
+func (m *maintenanceService) changeStatus(id uuid.UUID, change func(*domains.MaintenancePlan) error, ctx context.Context) error {
+	plan, err := m.repo.FindMaintenancePlanByID(id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrMaintenancePlanNotFound) {
+			m.l.Error("error getting maintenance plan", zap.Error(err))
+		}
+		return err
+	}
+	if err := change(plan); err != nil {
+		return err
+	}
+
+	if err := m.repo.UpdateMaintenancePlan(plan, ctx); err != nil {
+		if !errors.Is(err, domains.ErrMaintenancePlanNotFound) {
+			m.l.Error("error updating maintenance plan status", zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GenerateDue cria os atendimentos agendados das visitas dos planos ativos
+// até now mais a antecedência e devolve quantos foram criados. Cada visita
+// gera no máximo um atendimento, então a geração pode ser repetida após
+// falhas ou rodar em várias instâncias. Um plano com erro não avança sua
+// janela e é tentado de novo na próxima execução.
+func (m *maintenanceService) GenerateDue(now time.Time, ctx context.Context) (int, error) {
+	plans, err := m.repo.ListDueMaintenancePlans(now.Add(m.horizon), ctx)
+	if err != nil {
+		m.l.Error("error listing due maintenance plans", zap.Error(err))
+		return 0, err
+	}
+
+	created := 0
+	var errs []error
+	for _, plan := range plans {
+		n, err := m.generatePlan(plan, now, ctx)
+		created += n
+		if err != nil {
+			m.l.Error("error generating maintenance visits", zap.Error(err), zap.String("plan_id", plan.ID.String()))
+			errs = append(errs, err)
+		}
+	}
+
+	return created, errors.Join(errs...)
+}
+
+func (m *maintenanceService) generatePlan(plan *domains.MaintenancePlan, now time.Time, ctx context.Context) (int, error) {
+	from, to := plan.GenerationWindow(now, m.horizon)
+	visits, err := plan.Occurrences(m.loc, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, at := range visits {
+		form := plan.NewForm(at)
+		// O protocolo usa o ano da geração, não o da visita.
+		form.PublicCodeYear = domains.FormCodeYear(now, m.loc)
+
+		// Visitas de clientes com contrato vigente consomem a franquia do contrato.
+		contract, err := m.contractRepo.FindActiveContractByClient(plan.ClientID, at, ctx)
+		switch {
+		case err == nil:
+			form.ContractID = contract.ID
+		case !errors.Is(err, domains.ErrContractNotFound):
+			return created, err
+		}
+		if err := computeFormSLA(m.slaRepo, m.clientRepo, m.contractRepo, m.hours, form, ctx); err != nil {
+			return created, err
+		}
+		assignments := form.AssignTo(plan.TechnicianIDs, uuid.Nil, now)
+
+		_, ok, err := m.repo.SaveMaintenanceForm(plan, form, assignments, ctx)
+		if err != nil {
+			return created, err
+		}
+		if ok {
+			created++
+		}
+	}
+
+	return created, m.repo.AdvanceMaintenancePlan(plan.ID, to, ctx)
+}
+
+func applyMaintenancePlanInput(plan *domains.MaintenancePlan, input MaintenancePlanInput) {
+	plan.Name = input.Name
+	plan.Site = input.Site
+	plan.Description = input.Description
+	plan.Recurrence = input.Recurrence
+	plan.StartsAt = input.StartsAt.UTC()
+	plan.DifficultyLevel = input.DifficultyLevel
+	plan.Checklist = input.Checklist
+	plan.TechnicianIDs = input.TechnicianIDs
+	plan.Normalize()
+}
+
+func isMaintenanceInputError(err error) bool {
+	return errors.Is(err, domains.ErrClientNotFound) ||
+		errors.Is(err, domains.ErrMemberNotFound) ||
+		errors.Is(err, domains.ErrMaintenancePlanNotFound)
+}
+
+func toMaintenancePlanOutput(plan *domains.MaintenancePlan) MaintenancePlanOutput {
+	return MaintenancePlanOutput{
+		ID:              plan.ID,
+		ClientID:        plan.ClientID,
+		ClientName:      plan.ClientName,
+		Name:            plan.Name,
+		Site:            plan.Site,
+		Description:     plan.Description,
+		Recurrence:      plan.Recurrence,
+		StartsAt:        plan.StartsAt,
+		DifficultyLevel: plan.DifficultyLevel,
+		Checklist:       plan.Checklist,
+		TechnicianIDs:   plan.TechnicianIDs,
+		Status:          plan.Status,
+		GeneratedUntil:  plan.GeneratedUntil,
+		NextVisits:      []time.Time{},
+		CreatedAt:       plan.CreatedAt,
+		UpdatedAt:       plan.UpdatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type NotificationsUseCase interface {
+	ListNotifications(ListNotificationsInput, context.Context) (*ListNotificationsOutput, error)
+	MarkRead(uuid.UUID, uuid.UUID, context.Context) error
+	MarkAllRead(uuid.UUID, context.Context) (int64, error)
+}
+
+type notificationService struct {
+	repo repository.NotificationRepository
+	l    *zap.Logger
+}
+
+func NewNotificationService(repo repository.NotificationRepository, l *zap.Logger) NotificationsUseCase {
+	return &notificationService{
+		repo: repo,
+		l:    l,
+	}
+}
+
+func (n *notificationService) ListNotifications(input ListNotificationsInput, ctx context.Context) (*ListNotificationsOutput, error) {
+	limit := input.Limit
+	switch {
+	case limit <= 0:
+		limit = domains.DefaultNotificationPageSize
+	case limit > domains.MaxNotificationPageSize:
+		limit = domains.MaxNotificationPageSize
+	}
+
+	notifications, err := n.repo.ListNotifications(input.UserID, input.UnreadOnly, limit, ctx)
+	if err != nil {
+		n.l.Error("error listing notifications", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]NotificationOutput, 0, len(notifications))
+	for _, item := range notifications {
+		output = append(output, NotificationOutput{
+			ID:         item.ID,
+			FormID:     item.FormID,
+			PublicCode: item.PublicCode,
+			Kind:       item.Kind,
+			Message:    item.Message,
+			CreatedAt:  item.CreatedAt,
+			ReadAt:     item.ReadAt,
+		})
+	}
+
+	return &ListNotificationsOutput{Notifications: output}, nil
+}
+
+// MarkRead marca como lida uma notificação do usuário; notificações de outros
+// usuários resultam em ErrNotificationNotFound.
+func (n *notificationService) MarkRead(id, userID uuid.UUID, ctx context.Context) error {
+	if err := n.repo.MarkNotificationRead(id, userID, ctx); err != nil {
+		if !errors.Is(err, domains.ErrNotificationNotFound) {
+			n.l.Error("error marking notification as read", zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+func (n *notificationService) MarkAllRead(userID uuid.UUID, ctx context.Context) (int64, error) {
+	affected, err := n.repo.MarkAllNotificationsRead(userID, ctx)
+	if err != nil {
+		n.l.Error("error marking notifications as read", zap.Error(err))
+		return 0, err
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CreatePartInput struct {
+	SKU      string          `json:"sku"`
+	Name     string          `json:"name"`
+	Unit     string          `json:"unit"`
+	Cost     decimal.Decimal `json:"cost"`
+	Price    decimal.Decimal `json:"price"`
+	MinStock decimal.Decimal `json:"min_stock"`
+}
+
+type UpdatePartInput struct {
+	SKU      string          `json:"sku"`
+	Name     string          `json:"name"`
+	Unit     string          `json:"unit"`
+	Cost     decimal.Decimal `json:"cost"`
+	Price    decimal.Decimal `json:"price"`
+	MinStock decimal.Decimal `json:"min_stock"`
+	Active   bool            `json:"active"`
+}
+
+type PartOutput struct {
+	ID        uuid.UUID       `json:"id"`
+	SKU       string          `json:"sku"`
+	Name      string          `json:"name"`
+	Unit      string          `json:"unit"`
+	Cost      decimal.Decimal `json:"cost"`
+	Price     decimal.Decimal `json:"price"`
+	MinStock  decimal.Decimal `json:"min_stock"`
+	Active    bool            `json:"active"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+type CreateStockLocationInput struct {
+	Kind     string    `json:"kind"`
+	Name     string    `json:"name"`
+	MemberID uuid.UUID `json:"member_id"`
+}
+
+type StockLocationOutput struct {
+	ID         uuid.UUID `json:"id"`
+	Kind       string    `json:"kind"`
+	Name       string    `json:"name"`
+	MemberID   uuid.UUID `json:"member_id"`
+	MemberName string    `json:"member_name"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type StockLevelOutput struct {
+	LocationID   uuid.UUID       `json:"location_id"`
+	LocationName string          `json:"location_name"`
+	PartID       uuid.UUID       `json:"part_id"`
+	SKU          string          `json:"sku"`
+	PartName     string          `json:"part_name"`
+	Unit         string          `json:"unit"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// CreateStockMovementInput é um lançamento manual. Quantity é positiva,
+// exceto nos ajustes; Destination só é informado nas transferências.
+type CreateStockMovementInput struct {
+	PartID      uuid.UUID       `json:"part_id"`
+	LocationID  uuid.UUID       `json:"location_id"`
+	Destination uuid.UUID       `json:"destination"`
+	Kind        string          `json:"kind"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	Notes       string          `json:"notes"`
+	CreatedBy   uuid.UUID       `json:"created_by"`
+}
+
+type ListStockMovementsInput struct {
+	PartID     uuid.UUID `json:"part_id"`
+	LocationID uuid.UUID `json:"location_id"`
+	FormID     uuid.UUID `json:"form_id"`
+	Limit      int       `json:"limit"`
+}
+
+type StockMovementOutput struct {
+	ID           uuid.UUID       `json:"id"`
+	PartID       uuid.UUID       `json:"part_id"`
+	SKU          string          `json:"sku"`
+	PartName     string          `json:"part_name"`
+	LocationID   uuid.UUID       `json:"location_id"`
+	LocationName string          `json:"location_name"`
+	Kind         string          `json:"kind"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	FormID       uuid.UUID       `json:"form_id"`
+	TransferID   uuid.UUID       `json:"transfer_id"`
+	Notes        string          `json:"notes"`
+	CreatedBy    uuid.UUID       `json:"created_by"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+type LowStockOutput struct {
+	PartID   uuid.UUID       `json:"part_id"`
+	SKU      string          `json:"sku"`
+	Name     string          `json:"name"`
+	Unit     string          `json:"unit"`
+	MinStock decimal.Decimal `json:"min_stock"`
+	Quantity decimal.Decimal `json:"quantity"`
+	Shortage decimal.Decimal `json:"shortage"`
+}
+
+// AddFormPartInput usa o preço de venda do catálogo quando UnitPrice é nil.
+type AddFormPartInput struct {
+	PartID     uuid.UUID        `json:"part_id"`
+	LocationID uuid.UUID        `json:"location_id"`
+	Quantity   decimal.Decimal  `json:"quantity"`
+	UnitPrice  *decimal.Decimal `json:"unit_price"`
+	CreatedBy  uuid.UUID        `json:"created_by"`
+	// Admin permite lançar peças em atendimentos já assinados.
+	Admin bool `json:"admin"`
+}
+
+type FormPartOutput struct {
+	ID           uuid.UUID       `json:"id"`
+	PartID       uuid.UUID       `json:"part_id"`
+	SKU          string          `json:"sku"`
+	PartName     string          `json:"part_name"`
+	Unit         string          `json:"unit"`
+	LocationID   uuid.UUID       `json:"location_id"`
+	LocationName string          `json:"location_name"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	UnitPrice    decimal.Decimal `json:"unit_price"`
+	Total        decimal.Decimal `json:"total"`
+	CreatedBy    uuid.UUID       `json:"created_by"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+// ListFormPartsOutput traz as peças do atendimento com o valor cobrado e o
+// custo somados.
+type ListFormPartsOutput struct {
+	Parts     []FormPartOutput `json:"parts"`
+	Total     decimal.Decimal  `json:"total"`
+	TotalCost decimal.Decimal  `json:"total_cost"`
+}
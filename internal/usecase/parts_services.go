@@ -0,0 +1,395 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type PartsUseCase interface {
+	CreatePart(CreatePartInput, context.Context) (uuid.UUID, error)
+	ListParts(string, bool, context.Context) ([]PartOutput, error)
+	UpdatePart(uuid.UUID, UpdatePartInput, context.Context) error
+	CreateStockLocation(CreateStockLocationInput, context.Context) (uuid.UUID, error)
+	ListStockLocations(context.Context) ([]StockLocationOutput, error)
+	ListStockLevels(uuid.UUID, uuid.UUID, context.Context) ([]StockLevelOutput, error)
+	CreateStockMovement(CreateStockMovementInput, context.Context) ([]uuid.UUID, error)
+	ListStockMovements(ListStockMovementsInput, context.Context) ([]StockMovementOutput, error)
+	LowStockReport(context.Context) ([]LowStockOutput, error)
+	AddFormPart(uuid.UUID, AddFormPartInput, context.Context) (uuid.UUID, error)
+	ListFormParts(uuid.UUID, context.Context) (*ListFormPartsOutput, error)
+	RemoveFormPart(uuid.UUID, uuid.UUID, uuid.UUID, bool, context.Context) error
+}
+
+type partService struct {
+	repo     repository.PartRepository
+	formRepo repository.FormRepository
+	l        *zap.Logger
+}
+
+func NewPartService(repo repository.PartRepository, formRepo repository.FormRepository, l *zap.Logger) PartsUseCase {
+	return &partService{
+		repo:     repo,
+		formRepo: formRepo,
+		l:        l,
+	}
+}
+func (p *partService) CreatePart(input CreatePartInput, ctx context.Context) (uuid.UUID, error) {
+	part := &domains.Part{
+		SKU:      input.SKU,
+		Name:     input.Name,
+		Unit:     input.Unit,
+		Cost:     input.Cost,
+		Price:    input.Price,
+		MinStock: input.MinStock,
+		Active:   true,
+	}
+	part.Normalize()
+	if err := part.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := p.repo.SavePart(part, ctx); err != nil {
+		if !errors.Is(err, domains.ErrPartSKUAlreadyExists) {
+			p.l.Error("error saving part", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	return part.ID, nil
+}
+func (p *partService) ListParts(search string, includeInactive bool, ctx context.Context) ([]PartOutput, error) {
+	parts, err := p.repo.ListParts(search, includeInactive, ctx)
+	if err != nil {
+		p.l.Error("error listing parts", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]PartOutput, 0, len(parts))
+	for _, part := range parts {
+		out = append(out, PartOutput{
+			ID:        part.ID,
+			SKU:       part.SKU,
+			Name:      part.Name,
+			Unit:      part.Unit,
+			Cost:      part.Cost,
+			Price:     part.Price,
+			MinStock:  part.MinStock,
+			Active:    part.Active,
+			CreatedAt: part.CreatedAt,
+			UpdatedAt: part.UpdatedAt,
+		})
+	}
+	return out, nil
+}
+
+// UpdatePart altera o cadastro da peça. Desativar a peça impede novos
+// lançamentos em atendimentos, mas mantém os já feitos e o saldo em estoque.
+func (p *partService) UpdatePart(id uuid.UUID, input UpdatePartInput, ctx context.Context) error {
+	part := &domains.Part{
+		ID:       id,
+		SKU:      input.SKU,
+		Name:     input.Name,
+		Unit:     input.Unit,
+		Cost:     input.Cost,
+		Price:    input.Price,
+		MinStock: input.MinStock,
+		Active:   input.Active,
+	}
+	part.Normalize()
+	if err := part.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.repo.UpdatePart(part, ctx); err != nil {
+		if !errors.Is(err, domains.ErrPartNotFound) && !errors.Is(err, domains.ErrPartSKUAlreadyExists) {
+			p.l.Error("error updating part", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+func (p *partService) CreateStockLocation(input CreateStockLocationInput, ctx context.Context) (uuid.UUID, error) {
+	location := &domains.StockLocation{
+		Kind:     input.Kind,
+		Name:     input.Name,
+		MemberID: input.MemberID,
+	}
+	location.Normalize()
+	if err := location.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := p.repo.SaveStockLocation(location, ctx); err != nil {
+		if !errors.Is(err, domains.ErrStockLocationExists) && !errors.Is(err, domains.ErrMemberNotFound) {
+			p.l.Error("error saving stock location", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	return location.ID, nil
+}
+func (p *partService) ListStockLocations(ctx context.Context) ([]StockLocationOutput, error) {
+	locations, err := p.repo.ListStockLocations(ctx)
+	if err != nil {
+		p.l.Error("error listing stock locations", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]StockLocationOutput, 0, len(locations))
+	for _, l := range locations {
+		out = append(out, StockLocationOutput{
+			ID:         l.ID,
+			Kind:       l.Kind,
+			Name:       l.Name,
+			MemberID:   l.MemberID,
+			MemberName: l.MemberName,
+			CreatedAt:  l.CreatedAt,
+		})
+	}
+	return out, nil
+}
+
+// ListStockLevels filtra os saldos pelo local e pela peça quando informados.
+func (p *partService) ListStockLevels(locationID, partID uuid.UUID, ctx context.Context) ([]StockLevelOutput, error) {
+	levels, err := p.repo.ListStockLevels(domains.StockLevelFilter{
+		LocationID: locationID,
+		PartID:     partID,
+	}, ctx)
+	if err != nil {
+		p.l.Error("error listing stock levels", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]StockLevelOutput, 0, len(levels))
+	for _, s := range levels {
+		out = append(out, StockLevelOutput{
+			LocationID:   s.LocationID,
+			LocationName: s.LocationName,
+			PartID:       s.PartID,
+			SKU:          s.SKU,
+			PartName:     s.PartName,
+			Unit:         s.Unit,
+			Quantity:     s.Quantity,
+			UpdatedAt:    s.UpdatedAt,
+		})
+	}
+	return out, nil
+}
+
+// CreateStockMovement lança uma entrada, saída, ajuste ou transferência e
+// devolve os identificadores das movimentações gravadas (duas nas
+// transferências).
+func (p *partService) CreateStockMovement(input CreateStockMovementInput, ctx context.Context) ([]uuid.UUID, error) {
+	movements, err := domains.StockMovementRequest{
+		PartID:      input.PartID,
+		LocationID:  input.LocationID,
+		Destination: input.Destination,
+		Kind:        input.Kind,
+		Quantity:    input.Quantity,
+		Notes:       input.Notes,
+		CreatedBy:   input.CreatedBy,
+	}.Movements()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.repo.SaveStockMovements(movements, ctx); err != nil {
+		if !isStockLookupError(err) {
+			p.l.Error("error saving stock movements", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(movements))
+	for _, m := range movements {
+		ids = append(ids, m.ID)
+	}
+	return ids, nil
+}
+func (p *partService) ListStockMovements(input ListStockMovementsInput, ctx context.Context) ([]StockMovementOutput, error) {
+	limit := input.Limit
+	switch {
+	case limit <= 0:
+		limit = domains.DefaultStockMovementPageSize
+	case limit > domains.MaxStockMovementPageSize:
+		limit = domains.MaxStockMovementPageSize
+	}
+
+	movements, err := p.repo.ListStockMovements(domains.StockMovementFilter{
+		PartID:     input.PartID,
+		LocationID: input.LocationID,
+		FormID:     input.FormID,
+		Limit:      limit,
+	}, ctx)
+	if err != nil {
+		p.l.Error("error listing stock movements", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]StockMovementOutput, 0, len(movements))
+	for _, m := range movements {
+		out = append(out, StockMovementOutput{
+			ID:           m.ID,
+			PartID:       m.PartID,
+			SKU:          m.SKU,
+			PartName:     m.PartName,
+			LocationID:   m.LocationID,
+			LocationName: m.LocationName,
+			Kind:         m.Kind,
+			Quantity:     m.Quantity,
+			FormID:       m.FormID,
+			TransferID:   m.TransferID,
+			Notes:        m.Notes,
+			CreatedBy:    m.CreatedBy,
+			CreatedAt:    m.CreatedAt,
+		})
+	}
+	return out, nil
+}
+func (p *partService) LowStockReport(ctx context.Context) ([]LowStockOutput, error) {
+	items, err := p.repo.ListLowStock(ctx)
+	if err != nil {
+		p.l.Error("error listing low stock", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]LowStockOutput, 0, len(items))
+	for _, i := range items {
+		out = append(out, LowStockOutput{
+			PartID:   i.PartID,
+			SKU:      i.SKU,
+			Name:     i.Name,
+			Unit:     i.Unit,
+			MinStock: i.MinStock,
+			Quantity: i.Quantity,
+			Shortage: i.Shortage(),
+		})
+	}
+	return out, nil
+}
+
+// AddFormPart lança a peça no atendimento com o custo atual do catálogo e dá
+// baixa no local informado. Peças inativas não podem ser lançadas e, em
+// atendimentos assinados, só administradores lançam peças.
+func (p *partService) AddFormPart(formID uuid.UUID, input AddFormPartInput, ctx context.Context) (uuid.UUID, error) {
+	form, err := p.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			p.l.Error("error getting form", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	if err := form.CheckEditable(input.Admin); err != nil {
+		return uuid.Nil, err
+	}
+
+	part, err := p.repo.FindPartByID(input.PartID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrPartNotFound) {
+			p.l.Error("error getting part", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	if !part.Active {
+		return uuid.Nil, domains.ErrPartInactive
+	}
+
+	price := part.Price
+	if input.UnitPrice != nil {
+		price = *input.UnitPrice
+	}
+	formPart := &domains.FormPart{
+		FormID:     formID,
+		PartID:     part.ID,
+		LocationID: input.LocationID,
+		Quantity:   input.Quantity,
+		UnitPrice:  price,
+		UnitCost:   part.Cost,
+		CreatedBy:  input.CreatedBy,
+	}
+	if err := formPart.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := p.repo.SaveFormPart(formPart, ctx); err != nil {
+		if !isStockLookupError(err) && !errors.Is(err, domains.ErrFormNotFound) {
+			p.l.Error("error saving form part", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	return formPart.ID, nil
+}
+func (p *partService) ListFormParts(formID uuid.UUID, ctx context.Context) (*ListFormPartsOutput, error) {
+	if _, err := p.formRepo.FindFormByID(formID, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			p.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	parts, err := p.repo.ListFormParts(formID, ctx)
+	if err != nil {
+		p.l.Error("error listing form parts", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]FormPartOutput, 0, len(parts))
+	for _, fp := range parts {
+		out = append(out, FormPartOutput{
+			ID:           fp.ID,
+			PartID:       fp.PartID,
+			SKU:          fp.SKU,
+			PartName:     fp.PartName,
+			Unit:         fp.Unit,
+			LocationID:   fp.LocationID,
+			LocationName: fp.LocationName,
+			Quantity:     fp.Quantity,
+			UnitPrice:    fp.UnitPrice,
+			Total:        fp.Total(),
+			CreatedBy:    fp.CreatedBy,
+			CreatedAt:    fp.CreatedAt,
+		})
+	}
+
+	total, cost := domains.SumFormParts(parts)
+	return &ListFormPartsOutput{
+		Parts:     out,
+		Total:     total,
+		TotalCost: cost,
+	}, nil
+}
+
+// RemoveFormPart remove a peça do atendimento e devolve a quantidade ao
+// estoque de onde ela saiu.
+func (p *partService) RemoveFormPart(formID, id, by uuid.UUID, admin bool, ctx context.Context) error {
+	form, err := p.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return domains.ErrFormPartNotFound
+		}
+		p.l.Error("error getting form", zap.Error(err))
+		return err
+	}
+	if err := form.CheckEditable(admin); err != nil {
+		return err
+	}
+
+	if _, err := p.repo.DeleteFormPart(formID, id, by, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormPartNotFound) {
+			p.l.Error("error removing form part", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
+// isStockLookupError indica os erros esperados ao movimentar o estoque, que
+// não precisam ir para o log.
+func isStockLookupError(err error) bool {
+	return errors.Is(err, domains.ErrInsufficientStock) ||
+		errors.Is(err, domains.ErrPartNotFound) ||
+		errors.Is(err, domains.ErrStockLocationNotFound)
+}
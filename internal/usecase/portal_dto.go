@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CreateClientUserInput struct {
+	ClientID uuid.UUID `json:"client_id"`
+	Name     string    `json:"name"`
+	Email    string    `json:"email"`
+	Password string    `json:"password"`
+}
+
+type ClientUserOutput struct {
+	ID          uuid.UUID `json:"id"`
+	ClientID    uuid.UUID `json:"client_id"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+	LastLoginAt time.Time `json:"last_login_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CreatePortalRequestInput struct {
+	Description string `json:"description"`
+}
+
+// ListPortalRequestsInput filtra as solicitações; ClientID vazio lista as de
+// todos os clientes e Status vazio lista todas as situações.
+type ListPortalRequestsInput struct {
+	ClientID uuid.UUID `json:"client_id"`
+	Status   string    `json:"status"`
+}
+
+type ReviewPortalRequestInput struct {
+	Status string    `json:"status"`
+	FormID uuid.UUID `json:"form_id"`
+	Note   string    `json:"note"`
+}
+
+type PortalRequestOutput struct {
+	ID            uuid.UUID `json:"id"`
+	Cliente       Client    `json:"cliente"`
+	RequesterName string    `json:"requester_name"`
+	Description   string    `json:"description"`
+	Status        string    `json:"status"`
+	FormID        uuid.UUID `json:"form_id"`
+	ReviewNote    string    `json:"review_note"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// PortalFormOutput é a visão de um atendimento exibida ao cliente, sem
+// contrato, campos personalizados ou dados internos da equipe.
+type PortalFormOutput struct {
+	ID                  uuid.UUID       `json:"id"`
+	PublicCode          string          `json:"public_code"`
+	DataDeAbertura      time.Time       `json:"data_de_abertura"`
+	SolicitedBy         string          `json:"solicited_by"`
+	DefectDescription   string          `json:"defect_description"`
+	SolutionDescription string          `json:"solution_description"`
+	Status              string          `json:"status"`
+	HoursConsumed       decimal.Decimal `json:"hours_consumed"`
+	Tecnicos            []string        `json:"tecnicos"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+}
@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"olidesk-api-2/internal/utils/tokens"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PortalUseCase reúne as operações do portal do cliente. As consultas feitas
+// em nome de um usuário do portal recebem sempre o ID do cliente dele, nunca
+// um ID vindo da requisição.
+type PortalUseCase interface {
+	CreateClientUser(CreateClientUserInput, context.Context) (uuid.UUID, error)
+	ListClientUsers(uuid.UUID, context.Context) ([]ClientUserOutput, error)
+	DeleteClientUser(uuid.UUID, context.Context) error
+	LoginClientUser(LoginUserInput, context.Context) (LoginUserOutput, error)
+	GetClientUser(uuid.UUID, context.Context) (*domains.ClientUser, error)
+	CreatePortalRequest(*domains.ClientUser, CreatePortalRequestInput, context.Context) (uuid.UUID, error)
+	ListPortalRequests(ListPortalRequestsInput, context.Context) ([]PortalRequestOutput, error)
+	ReviewPortalRequest(uuid.UUID, ReviewPortalRequestInput, context.Context) error
+	ListClientForms(uuid.UUID, context.Context) ([]PortalFormOutput, error)
+	GetClientForm(uuid.UUID, uuid.UUID, context.Context) (*PortalFormOutput, error)
+}
+
+type portalService struct {
+	repo       repository.PortalRepository
+	clientRepo repository.ClientRepository
+	formRepo   repository.FormRepository
+	l          *zap.Logger
+}
+
+func NewPortalService(repo repository.PortalRepository, clientRepo repository.ClientRepository, formRepo repository.FormRepository, l *zap.Logger) PortalUseCase {
+	return &portalService{
+		repo:       repo,
+		clientRepo: clientRepo,
+		formRepo:   formRepo,
+		l:          l,
+	}
+}
+
+func (p *portalService) CreateClientUser(input CreateClientUserInput, ctx context.Context) (uuid.UUID, error) {
+	if _, err := p.clientRepo.FindClientByID(input.ClientID, ctx); err != nil {
+		p.l.Error("error getting client", zap.Error(err))
+		return uuid.Nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		p.l.Error("failed to generate password hash", zap.Error(err))
+		return uuid.Nil, err
+	}
+
+	user := &domains.ClientUser{
+		ClientID: input.ClientID,
+		Name:     strings.TrimSpace(input.Name),
+		Email:    strings.ToLower(strings.TrimSpace(input.Email)),
+		Password: hash,
+	}
+	if err := user.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := p.repo.SaveClientUser(user, ctx)
+	if err != nil {
+		p.l.Error("error saving client user", zap.Error(err))
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+func (p *portalService) ListClientUsers(clientID uuid.UUID, ctx context.Context) ([]ClientUserOutput, error) {
+	users, err := p.repo.ListClientUsers(clientID, ctx)
+	if err != nil {
+		p.l.Error("error listing client users", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]ClientUserOutput, 0, len(users))
+	for _, u := range users {
+		out = append(out, ClientUserOutput{
+			ID:          u.ID,
+			ClientID:    u.ClientID,
+			Name:        u.Name,
+			Email:       u.Email,
+			LastLoginAt: u.LastLoginAt,
+			CreatedAt:   u.CreatedAt,
+		})
+	}
+	return out, nil
+}
+func (p *portalService) DeleteClientUser(id uuid.UUID, ctx context.Context) error {
+	if err := p.repo.DeleteClientUser(id, ctx); err != nil {
+		p.l.Error("error deleting client user", zap.Error(err))
+		return err
+	}
+	return nil
+}
+func (p *portalService) LoginClientUser(input LoginUserInput, ctx context.Context) (LoginUserOutput, error) {
+	user, err := p.repo.FindClientUserByEmail(strings.ToLower(strings.TrimSpace(input.Email)), ctx)
+	if err != nil {
+		if errors.Is(err, domains.ErrClientUserNotFound) {
+			return LoginUserOutput{}, domains.ErrInvalidCredentials
+		}
+		p.l.Error("failed to get client user", zap.Error(err))
+		return LoginUserOutput{}, err
+	}
+
+	if !checkPassword(user.Password, input.Password) {
+		return LoginUserOutput{}, domains.ErrInvalidCredentials
+	}
+
+	token, err := tokens.GenerateClientJWT(user.ID.String(), user.ClientID.String(), user.Email)
+	if err != nil {
+		p.l.Error("failed to generate token", zap.Error(err))
+		return LoginUserOutput{}, err
+	}
+
+	if err := p.repo.TouchClientUserLogin(user.ID, ctx); err != nil {
+		p.l.Warn("failed to record client user login", zap.Error(err))
+	}
+
+	return LoginUserOutput{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   tokens.GetTokenExpirationTime(),
+	}, nil
+}
+func (p *portalService) GetClientUser(id uuid.UUID, ctx context.Context) (*domains.ClientUser, error) {
+	user, err := p.repo.FindClientUserByID(id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrClientUserNotFound) {
+			p.l.Error("error getting client user", zap.Error(err))
+		}
+		return nil, err
+	}
+	return user, nil
+}
+func (p *portalService) CreatePortalRequest(user *domains.ClientUser, input CreatePortalRequestInput, ctx context.Context) (uuid.UUID, error) {
+	request := &domains.PortalRequest{
+		ClientID:      user.ClientID,
+		ClientUserID:  user.ID,
+		RequesterName: user.Name,
+		Description:   strings.TrimSpace(input.Description),
+		Status:        domains.PortalRequestStatusOpen,
+	}
+	if err := request.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := p.repo.SavePortalRequest(request, ctx)
+	if err != nil {
+		p.l.Error("error saving portal request", zap.Error(err))
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+func (p *portalService) ListPortalRequests(input ListPortalRequestsInput, ctx context.Context) ([]PortalRequestOutput, error) {
+	requests, err := p.repo.ListPortalRequests(input.ClientID, input.Status, ctx)
+	if err != nil {
+		p.l.Error("error listing portal requests", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]PortalRequestOutput, 0, len(requests))
+	for _, r := range requests {
+		out = append(out, PortalRequestOutput{
+			ID: r.ID,
+			Cliente: Client{
+				ID:         r.ClientID,
+				ClientName: r.ClientName,
+			},
+			RequesterName: r.RequesterName,
+			Description:   r.Description,
+			Status:        r.Status,
+			FormID:        r.FormID,
+			ReviewNote:    r.ReviewNote,
+			CreatedAt:     r.CreatedAt,
+			UpdatedAt:     r.UpdatedAt,
+		})
+	}
+	return out, nil
+}
+func (p *portalService) ReviewPortalRequest(id uuid.UUID, input ReviewPortalRequestInput, ctx context.Context) error {
+	request, err := p.repo.FindPortalRequestByID(id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrPortalRequestNotFound) {
+			p.l.Error("error getting portal request", zap.Error(err))
+		}
+		return err
+	}
+
+	switch input.Status {
+	case domains.PortalRequestStatusConverted:
+		form, err := p.formRepo.FindFormByID(input.FormID, ctx)
+		if err != nil {
+			return err
+		}
+		if err := request.Convert(form, input.Note); err != nil {
+			return err
+		}
+	case domains.PortalRequestStatusRejected:
+		if err := request.Reject(input.Note); err != nil {
+			return err
+		}
+	default:
+		return domains.ErrInvalidPortalRequestStatus
+	}
+
+	if err := p.repo.UpdatePortalRequestReview(request, ctx); err != nil {
+		if !errors.Is(err, domains.ErrPortalRequestClosed) {
+			p.l.Error("error reviewing portal request", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+func (p *portalService) ListClientForms(clientID uuid.UUID, ctx context.Context) ([]PortalFormOutput, error) {
+	forms, err := p.formRepo.ListForms(domains.FormListFilter{ListFilter: domains.ListFilter{ClientID: clientID}}, ctx)
+	if err != nil {
+		p.l.Error("error listing client forms", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]PortalFormOutput, 0, len(forms))
+	for _, f := range forms {
+		out = append(out, toPortalForm(f))
+	}
+	return out, nil
+}
+func (p *portalService) GetClientForm(clientID, formID uuid.UUID, ctx context.Context) (*PortalFormOutput, error) {
+	form, err := p.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			p.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+	// Atendimentos de outro cliente são tratados como inexistentes para não
+	// revelar quais IDs existem.
+	if form.Cliente.ID != clientID {
+		return nil, domains.ErrFormNotFound
+	}
+
+	out := toPortalForm(form)
+	return &out, nil
+}
+
+func toPortalForm(f *domains.Atendimentos) PortalFormOutput {
+	tecnicos := make([]string, 0, len(f.TecnicoResponsavelId))
+	for _, t := range f.TecnicoResponsavelId {
+		tecnicos = append(tecnicos, t.Name)
+	}
+
+	return PortalFormOutput{
+		ID:                  f.ID,
+		PublicCode:          f.PublicCode,
+		DataDeAbertura:      f.DataDeAbertura,
+		SolicitedBy:         f.SolicitedBy,
+		DefectDescription:   f.DefectDescription,
+		SolutionDescription: f.SolutionDescription,
+		Status:              f.Status,
+		HoursConsumed:       f.HoursConsumed,
+		Tecnicos:            tecnicos,
+		UpdatedAt:           f.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"olidesk-api-2/internal/domains"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AssignmentScheduleInput struct {
+	MemberID uuid.UUID `json:"member_id"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+}
+
+// ScheduleFormInput substitui a agenda do atendimento: os técnicos que não
+// estão em Assignments ficam sem horário. Com IgnoreConflicts, a agenda é
+// gravada mesmo que algum técnico já tenha outra visita no período.
+type ScheduleFormInput struct {
+	Assignments     []AssignmentScheduleInput `json:"assignments"`
+	IgnoreConflicts bool                      `json:"ignore_conflicts"`
+	Admin           bool                      `json:"admin"`
+}
+
+type CalendarEntryOutput struct {
+	FormID            uuid.UUID `json:"form_id"`
+	PublicCode        string    `json:"public_code"`
+	MemberID          uuid.UUID `json:"member_id"`
+	MemberName        string    `json:"member_name"`
+	ClientName        string    `json:"client_name"`
+	Status            string    `json:"status"`
+	DefectDescription string    `json:"defect_description"`
+	Start             time.Time `json:"start"`
+	End               time.Time `json:"end"`
+}
+
+// ScheduleFormOutput traz as visitas que conflitam com a agenda pedida.
+type ScheduleFormOutput struct {
+	Conflicts []CalendarEntryOutput `json:"conflicts"`
+}
+
+// CalendarInput sem MemberID consulta a agenda da equipe toda.
+type CalendarInput struct {
+	MemberID uuid.UUID `json:"member_id"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+}
+
+type CalendarOutput struct {
+	Entries []CalendarEntryOutput `json:"entries"`
+}
+
+// NextFreeSlotInput busca a partir de agora quando From é zero.
+type NextFreeSlotInput struct {
+	MemberID uuid.UUID     `json:"member_id"`
+	Duration time.Duration `json:"duration"`
+	From     time.Time     `json:"from"`
+}
+
+type FreeSlotOutput struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// RouteInput usa a abertura do expediente do dia como saída quando Departure
+// é zero e os valores padrão quando SpeedKmh e StopDuration são zero. Sem
+// Depot, o roteiro começa na primeira parada.
+type RouteInput struct {
+	MemberID     uuid.UUID         `json:"member_id"`
+	Date         time.Time         `json:"date"`
+	Depot        *domains.GeoPoint `json:"depot"`
+	Departure    time.Time         `json:"departure"`
+	SpeedKmh     float64           `json:"speed_kmh"`
+	StopDuration time.Duration     `json:"stop_duration"`
+}
+
+type RouteStopOutput struct {
+	Sequence          int       `json:"sequence"`
+	FormID            uuid.UUID `json:"form_id"`
+	PublicCode        string    `json:"public_code"`
+	ClientID          uuid.UUID `json:"client_id"`
+	ClientName        string    `json:"client_name"`
+	Address           string    `json:"address"`
+	Status            string    `json:"status"`
+	DefectDescription string    `json:"defect_description"`
+	Latitude          float64   `json:"latitude"`
+	Longitude         float64   `json:"longitude"`
+	DistanceKm        float64   `json:"distance_km"`
+	CumulativeKm      float64   `json:"cumulative_km"`
+	Arrival           time.Time `json:"arrival"`
+	Departure         time.Time `json:"departure"`
+}
+
+// RouteOutput traz as paradas em ordem de visita, os atendimentos cujo
+// cliente não tem coordenadas e o trajeto em coordenadas [longitude,
+// latitude].
+type RouteOutput struct {
+	Date      time.Time         `json:"date"`
+	Departure time.Time         `json:"departure"`
+	TotalKm   float64           `json:"total_km"`
+	Stops     []RouteStopOutput `json:"stops"`
+	Unlocated []RouteStopOutput `json:"unlocated"`
+	Path      [][]float64       `json:"path"`
+}
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ScheduleUseCase interface {
+	ScheduleForm(uuid.UUID, ScheduleFormInput, context.Context) (*ScheduleFormOutput, error)
+	Calendar(CalendarInput, context.Context) (*CalendarOutput, error)
+	NextFreeSlot(NextFreeSlotInput, context.Context) (*FreeSlotOutput, error)
+	Route(RouteInput, context.Context) (*RouteOutput, error)
+}
+
+type scheduleService struct {
+	repo     repository.ScheduleRepository
+	formRepo repository.FormRepository
+	slaRepo  repository.SLARepository
+	hours    domains.BusinessHours
+	l        *zap.Logger
+}
+
+func NewScheduleService(repo repository.ScheduleRepository, formRepo repository.FormRepository, slaRepo repository.SLARepository, hours domains.BusinessHours, l *zap.Logger) ScheduleUseCase {
+	return &scheduleService{
+		repo:     repo,
+		formRepo: formRepo,
+		slaRepo:  slaRepo,
+		hours:    hours,
+		l:        l,
+	}
+}
+
+// ScheduleForm grava o horário de cada técnico do atendimento. Quando algum
+// técnico já tem outra visita no período, a agenda é recusada com
+// ErrScheduleConflict e os conflitos vêm na saída, a menos que
+// IgnoreConflicts seja pedido.
+func (s *scheduleService) ScheduleForm(formID uuid.UUID, input ScheduleFormInput, ctx context.Context) (*ScheduleFormOutput, error) {
+	form, err := s.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			s.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+	if err := form.CheckEditable(input.Admin); err != nil {
+		return nil, err
+	}
+
+	schedules := make([]domains.AssignmentSchedule, 0, len(input.Assignments))
+	for _, a := range input.Assignments {
+		schedules = append(schedules, domains.AssignmentSchedule{
+			FormID:   formID,
+			MemberID: a.MemberID,
+			Start:    a.Start.UTC(),
+			End:      a.End.UTC(),
+		})
+	}
+	if err := domains.ValidateSchedules(schedules); err != nil {
+		return nil, err
+	}
+
+	conflicts, err := s.repo.SaveFormSchedule(formID, schedules, input.IgnoreConflicts, ctx)
+	if err != nil {
+		if errors.Is(err, domains.ErrScheduleConflict) {
+			return &ScheduleFormOutput{Conflicts: toCalendarEntryOutputs(conflicts)}, err
+		}
+		if !errors.Is(err, domains.ErrMemberNotAssigned) {
+			s.l.Error("error saving form schedule", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &ScheduleFormOutput{Conflicts: toCalendarEntryOutputs(conflicts)}, nil
+}
+func (s *scheduleService) Calendar(input CalendarInput, ctx context.Context) (*CalendarOutput, error) {
+	filter := domains.CalendarFilter{
+		MemberID: input.MemberID,
+		From:     input.From.UTC(),
+		To:       input.To.UTC(),
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repo.ListCalendar(filter, ctx)
+	if err != nil {
+		s.l.Error("error listing calendar", zap.Error(err))
+		return nil, err
+	}
+
+	return &CalendarOutput{Entries: toCalendarEntryOutputs(entries)}, nil
+}
+
+// NextFreeSlot busca o primeiro horário do técnico, com a duração pedida,
+// que cabe no expediente de um dia útil sem conflitar com as visitas já
+// agendadas.
+func (s *scheduleService) NextFreeSlot(input NextFreeSlotInput, ctx context.Context) (*FreeSlotOutput, error) {
+	if input.MemberID == uuid.Nil || input.Duration <= 0 || input.Duration > domains.MaxAssignmentDuration {
+		return nil, domains.ErrInvalidSchedule
+	}
+	from := input.From.UTC()
+	if from.IsZero() {
+		from = time.Now().UTC()
+	}
+
+	busy, err := s.repo.ListCalendar(domains.CalendarFilter{
+		MemberID: input.MemberID,
+		From:     from,
+		To:       from.Add(domains.FreeSlotSearchRange),
+	}, ctx)
+	if err != nil {
+		s.l.Error("error listing calendar", zap.Error(err))
+		return nil, err
+	}
+	holidays, err := s.slaRepo.ListHolidays(from, ctx)
+	if err != nil {
+		s.l.Error("error listing holidays", zap.Error(err))
+		return nil, err
+	}
+
+	start, ok := domains.NewBusinessCalendar(s.hours, holidays).NextFreeSlot(busy, from, input.Duration)
+	if !ok {
+		return nil, domains.ErrNoFreeSlot
+	}
+
+	return &FreeSlotOutput{Start: start, End: start.Add(input.Duration)}, nil
+}
+
+// Route monta o roteiro do técnico para os atendimentos abertos no dia, no
+// fuso do expediente, ordenados para reduzir o deslocamento.
+func (s *scheduleService) Route(input RouteInput, ctx context.Context) (*RouteOutput, error) {
+	if input.MemberID == uuid.Nil || input.Date.IsZero() {
+		return nil, domains.ErrInvalidRouteOptions
+	}
+	loc := s.hours.Location
+	y, m, d := input.Date.Date()
+	dayStart := time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	opts := domains.RouteOptions{
+		Depot:        input.Depot,
+		Departure:    input.Departure.UTC(),
+		SpeedKmh:     input.SpeedKmh,
+		StopDuration: input.StopDuration,
+	}
+	if input.Departure.IsZero() {
+		opts.Departure = dayStart.Add(time.Duration(s.hours.Start) * time.Minute).UTC()
+	}
+	if opts.SpeedKmh == 0 {
+		opts.SpeedKmh = domains.DefaultRouteSpeedKmh
+	}
+	if opts.StopDuration == 0 {
+		opts.StopDuration = domains.DefaultRouteStopDuration
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	stops, err := s.repo.ListRouteStops(input.MemberID, dayStart, dayStart.AddDate(0, 0, 1), ctx)
+	if err != nil {
+		s.l.Error("error listing route stops", zap.Error(err))
+		return nil, err
+	}
+
+	plan := domains.PlanRoute(stops, opts)
+
+	output := &RouteOutput{
+		Date:      dayStart,
+		Departure: opts.Departure,
+		TotalKm:   plan.TotalKm,
+		Stops:     make([]RouteStopOutput, 0, len(plan.Stops)),
+		Unlocated: make([]RouteStopOutput, 0, len(plan.Unlocated)),
+		Path:      plan.LineString(),
+	}
+	for _, stop := range plan.Stops {
+		item := toRouteStopOutput(stop.RouteStop)
+		item.Sequence = stop.Sequence
+		item.DistanceKm = stop.DistanceKm
+		item.CumulativeKm = stop.CumulativeKm
+		item.Arrival = stop.Arrival
+		item.Departure = stop.Departure
+		output.Stops = append(output.Stops, item)
+	}
+	for _, stop := range plan.Unlocated {
+		output.Unlocated = append(output.Unlocated, toRouteStopOutput(stop))
+	}
+
+	return output, nil
+}
+
+func toRouteStopOutput(stop domains.RouteStop) RouteStopOutput {
+	return RouteStopOutput{
+		FormID:            stop.FormID,
+		PublicCode:        stop.PublicCode,
+		ClientID:          stop.ClientID,
+		ClientName:        stop.ClientName,
+		Address:           stop.Address,
+		Status:            stop.Status,
+		DefectDescription: stop.DefectDescription,
+		Latitude:          stop.Location.Latitude,
+		Longitude:         stop.Location.Longitude,
+	}
+}
+
+func toCalendarEntryOutputs(entries []*domains.CalendarEntry) []CalendarEntryOutput {
+	output := make([]CalendarEntryOutput, 0, len(entries))
+	for _, e := range entries {
+		output = append(output, CalendarEntryOutput{
+			FormID:            e.FormID,
+			PublicCode:        e.PublicCode,
+			MemberID:          e.MemberID,
+			MemberName:        e.MemberName,
+			ClientName:        e.ClientName,
+			Status:            e.Status,
+			DefectDescription: e.DefectDescription,
+			Start:             e.Start,
+			End:               e.End,
+		})
+	}
+	return output
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpdateServiceOrderTemplateInput traz os dados do modelo; cor e fuso vazios
+// voltam ao padrão. O logotipo é enviado à parte.
+type UpdateServiceOrderTemplateInput struct {
+	CompanyName     string    `json:"company_name"`
+	CompanyDocument string    `json:"company_document"`
+	Address         string    `json:"address"`
+	Phone           string    `json:"phone"`
+	Email           string    `json:"email"`
+	Website         string    `json:"website"`
+	FooterText      string    `json:"footer_text"`
+	PrimaryColor    string    `json:"primary_color"`
+	Timezone        string    `json:"timezone"`
+	UpdatedBy       uuid.UUID `json:"updated_by"`
+}
+
+// UploadServiceOrderLogoInput traz o logotipo enviado; Content é lido até o
+// limite de domains.MaxServiceOrderLogoSize mais um byte.
+type UploadServiceOrderLogoInput struct {
+	Content   io.Reader `json:"-"`
+	UpdatedBy uuid.UUID `json:"updated_by"`
+}
+
+type ServiceOrderTemplateOutput struct {
+	CompanyName     string    `json:"company_name"`
+	CompanyDocument string    `json:"company_document"`
+	Address         string    `json:"address"`
+	Phone           string    `json:"phone"`
+	Email           string    `json:"email"`
+	Website         string    `json:"website"`
+	FooterText      string    `json:"footer_text"`
+	PrimaryColor    string    `json:"primary_color"`
+	Timezone        string    `json:"timezone"`
+	UpdatedBy       uuid.UUID `json:"updated_by"`
+	UpdatedAt       time.Time `json:"updated_at"`
+
+	// LogoURL é assinada e expira em LogoURLExpiresAt; vazia quando não há
+	// logotipo.
+	LogoURL          string    `json:"logo_url"`
+	LogoURLExpiresAt time.Time `json:"logo_url_expires_at"`
+}
+
+// ServiceOrderOutput é o PDF gerado e o nome sugerido para o arquivo.
+type ServiceOrderOutput struct {
+	FileName string `json:"file_name"`
+	Content  []byte `json:"-"`
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/pdf"
+	"olidesk-api-2/internal/repository"
+	"olidesk-api-2/internal/storage"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ServiceOrderUseCase interface {
+	GetTemplate(context.Context) (*ServiceOrderTemplateOutput, error)
+	UpdateTemplate(UpdateServiceOrderTemplateInput, context.Context) (*ServiceOrderTemplateOutput, error)
+	UploadLogo(UploadServiceOrderLogoInput, context.Context) (*ServiceOrderTemplateOutput, error)
+	DeleteLogo(uuid.UUID, context.Context) (*ServiceOrderTemplateOutput, error)
+	RenderServiceOrder(uuid.UUID, context.Context) (*ServiceOrderOutput, error)
+}
+
+type serviceOrderService struct {
+	repo          repository.ServiceOrderTemplateRepository
+	formRepo      repository.FormRepository
+	clientRepo    repository.ClientRepository
+	signatureRepo repository.SignatureRepository
+	store         storage.Storage
+	urlTTL        time.Duration
+	l             *zap.Logger
+}
+
+func NewServiceOrderService(repo repository.ServiceOrderTemplateRepository, formRepo repository.FormRepository, clientRepo repository.ClientRepository, signatureRepo repository.SignatureRepository, store storage.Storage, urlTTL time.Duration, l *zap.Logger) ServiceOrderUseCase {
+	return &serviceOrderService{
+		repo:          repo,
+		formRepo:      formRepo,
+		clientRepo:    clientRepo,
+		signatureRepo: signatureRepo,
+		store:         store,
+		urlTTL:        urlTTL,
+		l:             l,
+	}
+}
+
+func (s *serviceOrderService) GetTemplate(ctx context.Context) (*ServiceOrderTemplateOutput, error) {
+	template, err := s.repo.GetServiceOrderTemplate(ctx)
+	if err != nil {
+		s.l.Error("error getting service order template", zap.Error(err))
+		return nil, err
+	}
+	return s.toOutput(template)
+}
+
+func (s *serviceOrderService) UpdateTemplate(input UpdateServiceOrderTemplateInput, ctx context.Context) (*ServiceOrderTemplateOutput, error) {
+	template, err := s.repo.GetServiceOrderTemplate(ctx)
+	if err != nil {
+		s.l.Error("error getting service order template", zap.Error(err))
+		return nil, err
+	}
+
+	template.CompanyName = input.CompanyName
+	template.CompanyDocument = input.CompanyDocument
+	template.Address = input.Address
+	template.Phone = input.Phone
+	template.Email = input.Email
+	template.Website = input.Website
+	template.FooterText = input.FooterText
+	template.PrimaryColor = input.PrimaryColor
+	template.Timezone = input.Timezone
+	template.UpdatedBy = input.UpdatedBy
+	template.Normalize()
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SaveServiceOrderTemplate(template, ctx); err != nil {
+		s.l.Error("error saving service order template", zap.Error(err))
+		return nil, err
+	}
+	return s.toOutput(template)
+}
+
+// UploadLogo grava o logotipo sob uma chave nova e só remove o anterior depois
+// que o modelo é salvo; se o modelo não for salvo, o arquivo novo é removido.
+func (s *serviceOrderService) UploadLogo(input UploadServiceOrderLogoInput, ctx context.Context) (*ServiceOrderTemplateOutput, error) {
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, io.LimitReader(input.Content, domains.MaxServiceOrderLogoSize+1)); err != nil {
+		return nil, err
+	}
+	content := buf.Bytes()
+	if len(content) > domains.MaxServiceOrderLogoSize {
+		return nil, domains.ErrServiceOrderLogoTooLarge
+	}
+	contentType := sniffContentType(content)
+	if len(content) == 0 || !domains.IsAllowedServiceOrderLogoType(contentType) {
+		return nil, domains.ErrServiceOrderLogoType
+	}
+
+	template, err := s.repo.GetServiceOrderTemplate(ctx)
+	if err != nil {
+		s.l.Error("error getting service order template", zap.Error(err))
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	oldKey := template.LogoKey
+	template.LogoKey = domains.ServiceOrderLogoKey(id)
+	template.LogoContentType = contentType
+	template.UpdatedBy = input.UpdatedBy
+
+	if err := s.store.Put(ctx, template.LogoKey, bytes.NewReader(content), int64(len(content)), contentType); err != nil {
+		s.l.Error("error storing service order logo", zap.Error(err))
+		return nil, err
+	}
+	if err := s.repo.SaveServiceOrderTemplate(template, ctx); err != nil {
+		s.l.Error("error saving service order template", zap.Error(err))
+		if delErr := s.store.Delete(context.WithoutCancel(ctx), template.LogoKey); delErr != nil {
+			s.l.Error("error removing orphan logo", zap.String("key", template.LogoKey), zap.Error(delErr))
+		}
+		return nil, err
+	}
+	s.removeLogo(oldKey, ctx)
+
+	return s.toOutput(template)
+}
+
+func (s *serviceOrderService) DeleteLogo(updatedBy uuid.UUID, ctx context.Context) (*ServiceOrderTemplateOutput, error) {
+	template, err := s.repo.GetServiceOrderTemplate(ctx)
+	if err != nil {
+		s.l.Error("error getting service order template", zap.Error(err))
+		return nil, err
+	}
+	if template.LogoKey == "" {
+		return s.toOutput(template)
+	}
+
+	oldKey := template.LogoKey
+	template.LogoKey = ""
+	template.LogoContentType = ""
+	template.UpdatedBy = updatedBy
+	if err := s.repo.SaveServiceOrderTemplate(template, ctx); err != nil {
+		s.l.Error("error saving service order template", zap.Error(err))
+		return nil, err
+	}
+	s.removeLogo(oldKey, ctx)
+
+	return s.toOutput(template)
+}
+
+// RenderServiceOrder gera o PDF do atendimento com o modelo da organização.
+// Cliente excluído, logotipo ou assinatura indisponíveis no storage não
+// impedem a emissão: a ordem sai sem essas partes.
+func (s *serviceOrderService) RenderServiceOrder(formID uuid.UUID, ctx context.Context) (*ServiceOrderOutput, error) {
+	form, err := s.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			s.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	template, err := s.repo.GetServiceOrderTemplate(ctx)
+	if err != nil {
+		s.l.Error("error getting service order template", zap.Error(err))
+		return nil, err
+	}
+
+	so := pdf.ServiceOrder{
+		Form:        form,
+		Template:    *template,
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	client, err := s.clientRepo.FindClientByID(form.Cliente.ID, ctx)
+	switch {
+	case err == nil:
+		so.Client = client
+	case !errors.Is(err, domains.ErrClientNotFound):
+		s.l.Error("error getting client", zap.Error(err))
+		return nil, err
+	}
+
+	if template.LogoKey != "" {
+		so.Logo = readObject(s.store, template.LogoKey, domains.MaxServiceOrderLogoSize, s.l, ctx)
+	}
+
+	signature, err := s.signatureRepo.FindSignature(formID, ctx)
+	switch {
+	case err == nil:
+		so.Signature = signature
+		so.SignatureContent = readObject(s.store, signature.StorageKey, domains.MaxSignatureSize, s.l, ctx)
+	case !errors.Is(err, domains.ErrSignatureNotFound):
+		s.l.Error("error getting signature", zap.Error(err))
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.RenderServiceOrder(&buf, so); err != nil {
+		s.l.Error("error rendering service order", zap.Error(err))
+		return nil, err
+	}
+
+	return &ServiceOrderOutput{
+		FileName: "ordem-de-servico-" + serviceOrderNumber(form) + ".pdf",
+		Content:  buf.Bytes(),
+	}, nil
+}
+
+// readObject lê um arquivo do storage até o limite informado, devolvendo nil
+// se ele não puder ser lido. É usado pelos PDFs, que saem sem a imagem nesse
+// caso.
+func readObject(store storage.Storage, key string, limit int64, l *zap.Logger, ctx context.Context) []byte {
+	rc, err := store.Open(ctx, key)
+	if err != nil {
+		l.Warn("error opening object for pdf", zap.String("key", key), zap.Error(err))
+		return nil
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, limit))
+	if err != nil {
+		l.Warn("error reading object for pdf", zap.String("key", key), zap.Error(err))
+		return nil
+	}
+	return content
+}
+
+func (s *serviceOrderService) removeLogo(key string, ctx context.Context) {
+	if key == "" {
+		return
+	}
+	if err := s.store.Delete(context.WithoutCancel(ctx), key); err != nil {
+		s.l.Error("error removing old logo", zap.String("key", key), zap.Error(err))
+	}
+}
+
+func (s *serviceOrderService) toOutput(template *domains.ServiceOrderTemplate) (*ServiceOrderTemplateOutput, error) {
+	out := &ServiceOrderTemplateOutput{
+		CompanyName:     template.CompanyName,
+		CompanyDocument: template.CompanyDocument,
+		Address:         template.Address,
+		Phone:           template.Phone,
+		Email:           template.Email,
+		Website:         template.Website,
+		FooterText:      template.FooterText,
+		PrimaryColor:    template.PrimaryColor,
+		Timezone:        template.Timezone,
+		UpdatedBy:       template.UpdatedBy,
+		UpdatedAt:       template.UpdatedAt,
+	}
+	if template.LogoKey == "" {
+		return out, nil
+	}
+
+	expiresAt := time.Now().Add(s.urlTTL).UTC()
+	url, err := s.store.SignedURL(template.LogoKey, "logotipo"+logoExtension(template.LogoContentType), s.urlTTL)
+	if err != nil {
+		s.l.Error("error signing logo url", zap.Error(err))
+		return nil, err
+	}
+	out.LogoURL = url
+	out.LogoURLExpiresAt = expiresAt.Truncate(time.Second)
+	return out, nil
+}
+
+func logoExtension(contentType string) string {
+	if contentType == "image/jpeg" {
+		return ".jpg"
+	}
+	return ".png"
+}
+
+// serviceOrderNumber identifica a ordem de serviço pelo protocolo do
+// atendimento ou, na falta dele, pelo ID.
+func serviceOrderNumber(form *domains.Atendimentos) string {
+	if form.PublicCode != "" {
+		return form.PublicCode
+	}
+	return form.ID.String()
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"olidesk-api-2/internal/domains"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SignFormInput traz a assinatura coletada no local. Image é usado nos
+// formatos png e svg e Strokes no formato tracos; AccuracyMeters zero indica
+// que o dispositivo não informou a precisão.
+type SignFormInput struct {
+	FormID         uuid.UUID                 `json:"form_id"`
+	Format         string                    `json:"format"`
+	Image          []byte                    `json:"-"`
+	Strokes        []domains.SignatureStroke `json:"strokes"`
+	SignerName     string                    `json:"signer_name"`
+	SignerDocument string                    `json:"signer_document"`
+	SignedAt       time.Time                 `json:"signed_at"`
+	Latitude       float64                   `json:"latitude"`
+	Longitude      float64                   `json:"longitude"`
+	AccuracyMeters float64                   `json:"accuracy_meters"`
+	CapturedBy     uuid.UUID                 `json:"captured_by"`
+}
+
+// SignatureOutput traz a assinatura e a conferência do atendimento: Intact é
+// falso quando o hash atual difere do registrado na assinatura.
+type SignatureOutput struct {
+	ID             uuid.UUID `json:"id"`
+	FormID         uuid.UUID `json:"form_id"`
+	SignerName     string    `json:"signer_name"`
+	SignerDocument string    `json:"signer_document"`
+	SignedAt       time.Time `json:"signed_at"`
+	Format         string    `json:"format"`
+	ContentType    string    `json:"content_type"`
+	Size           int64     `json:"size"`
+	Checksum       string    `json:"checksum"`
+	Latitude       float64   `json:"latitude"`
+	Longitude      float64   `json:"longitude"`
+	AccuracyMeters float64   `json:"accuracy_meters"`
+	CapturedBy     uuid.UUID `json:"captured_by"`
+	CapturedByName string    `json:"captured_by_name"`
+	CreatedAt      time.Time `json:"created_at"`
+
+	SnapshotHash string `json:"snapshot_hash"`
+	CurrentHash  string `json:"current_hash"`
+	Intact       bool   `json:"intact"`
+
+	// DownloadURL é assinada e expira em URLExpiresAt.
+	DownloadURL  string    `json:"download_url"`
+	URLExpiresAt time.Time `json:"url_expires_at"`
+}
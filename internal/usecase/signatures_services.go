@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"olidesk-api-2/internal/storage"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type SignaturesUseCase interface {
+	SignForm(SignFormInput, context.Context) (*SignatureOutput, error)
+	GetSignature(uuid.UUID, context.Context) (*SignatureOutput, error)
+}
+
+type signatureService struct {
+	repo     repository.SignatureRepository
+	formRepo repository.FormRepository
+	store    storage.Storage
+	urlTTL   time.Duration
+	l        *zap.Logger
+}
+
+func NewSignatureService(repo repository.SignatureRepository, formRepo repository.FormRepository, store storage.Storage, urlTTL time.Duration, l *zap.Logger) SignaturesUseCase {
+	return &signatureService{
+		repo:     repo,
+		formRepo: formRepo,
+		store:    store,
+		urlTTL:   urlTTL,
+		l:        l,
+	}
+}
+
+// SignForm registra a assinatura de um atendimento concluído junto com o hash
+// do seu conteúdo. O arquivo é gravado no storage antes do registro e
+// removido se o registro falhar, como nos anexos.
+func (s *signatureService) SignForm(input SignFormInput, ctx context.Context) (*SignatureOutput, error) {
+	form, err := s.formRepo.FindFormByID(input.FormID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			s.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+	if err := form.CanBeSigned(); err != nil {
+		return nil, err
+	}
+
+	content, err := signatureContent(input)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := form.SnapshotHash(domains.CurrentSignatureSnapshot)
+	if err != nil {
+		s.l.Error("error hashing form", zap.Error(err))
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(content)
+	signature := &domains.FormSignature{
+		ID:              id,
+		FormID:          form.ID,
+		SignerName:      input.SignerName,
+		SignerDocument:  input.SignerDocument,
+		SignedAt:        input.SignedAt.UTC(),
+		Format:          input.Format,
+		ContentType:     domains.SignatureContentType(input.Format),
+		Size:            int64(len(content)),
+		Checksum:        hex.EncodeToString(sum[:]),
+		StorageKey:      domains.SignatureStorageKey(form.ID, id),
+		Latitude:        input.Latitude,
+		Longitude:       input.Longitude,
+		AccuracyMeters:  input.AccuracyMeters,
+		SnapshotHash:    snapshot,
+		SnapshotVersion: domains.CurrentSignatureSnapshot,
+		CapturedBy:      input.CapturedBy,
+	}
+	if err := signature.Validate(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Put(ctx, signature.StorageKey, bytes.NewReader(content), signature.Size, signature.ContentType); err != nil {
+		s.l.Error("error storing signature", zap.Error(err))
+		return nil, err
+	}
+	if err := s.repo.SaveSignature(signature, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormAlreadySigned) {
+			s.l.Error("error saving signature", zap.Error(err))
+		}
+		if delErr := s.store.Delete(context.WithoutCancel(ctx), signature.StorageKey); delErr != nil {
+			s.l.Error("error removing orphan signature", zap.String("key", signature.StorageKey), zap.Error(delErr))
+		}
+		return nil, err
+	}
+
+	return s.toOutput(signature, snapshot)
+}
+
+// GetSignature devolve a assinatura do atendimento e confere se o conteúdo
+// atual ainda corresponde ao que foi assinado, com os campos da versão
+// gravada na assinatura.
+func (s *signatureService) GetSignature(formID uuid.UUID, ctx context.Context) (*SignatureOutput, error) {
+	form, err := s.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			s.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	signature, err := s.repo.FindSignature(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrSignatureNotFound) {
+			s.l.Error("error getting signature", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	current, err := form.SnapshotHash(signature.SnapshotVersion)
+	if err != nil {
+		s.l.Error("error hashing form", zap.Error(err))
+		return nil, err
+	}
+
+	return s.toOutput(signature, current)
+}
+
+func (s *signatureService) toOutput(signature *domains.FormSignature, currentHash string) (*SignatureOutput, error) {
+	expiresAt := time.Now().Add(s.urlTTL).UTC()
+	url, err := s.store.SignedURL(signature.StorageKey, "assinatura"+signatureExtension(signature.Format), s.urlTTL)
+	if err != nil {
+		s.l.Error("error signing signature url", zap.Error(err))
+		return nil, err
+	}
+
+	return &SignatureOutput{
+		ID:             signature.ID,
+		FormID:         signature.FormID,
+		SignerName:     signature.SignerName,
+		SignerDocument: signature.SignerDocument,
+		SignedAt:       signature.SignedAt,
+		Format:         signature.Format,
+		ContentType:    signature.ContentType,
+		Size:           signature.Size,
+		Checksum:       signature.Checksum,
+		Latitude:       signature.Latitude,
+		Longitude:      signature.Longitude,
+		AccuracyMeters: signature.AccuracyMeters,
+		CapturedBy:     signature.CapturedBy,
+		CapturedByName: signature.CapturedByName,
+		CreatedAt:      signature.CreatedAt,
+		SnapshotHash:   signature.SnapshotHash,
+		CurrentHash:    currentHash,
+		Intact:         currentHash == signature.SnapshotHash,
+		DownloadURL:    url,
+		URLExpiresAt:   expiresAt.Truncate(time.Second),
+	}, nil
+}
+
+// signatureContent monta o arquivo gravado no storage: a imagem enviada ou os
+// traços serializados em JSON.
+func signatureContent(input SignFormInput) ([]byte, error) {
+	switch input.Format {
+	case domains.SignatureFormatPNG, domains.SignatureFormatSVG:
+		if err := domains.ValidateSignatureImage(input.Format, input.Image); err != nil {
+			return nil, err
+		}
+		return input.Image, nil
+	case domains.SignatureFormatStrokes:
+		if err := domains.ValidateSignatureStrokes(input.Strokes); err != nil {
+			return nil, err
+		}
+		return json.Marshal(input.Strokes)
+	}
+	return nil, domains.ErrInvalidSignatureFormat
+}
+
+func signatureExtension(format string) string {
+	switch format {
+	case domains.SignatureFormatPNG:
+		return ".png"
+	case domains.SignatureFormatSVG:
+		return ".svg"
+	}
+	return ".json"
+}
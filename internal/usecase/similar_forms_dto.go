@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SimilarFormsInput compara uma descrição ainda não gravada, como na abertura
+// de um atendimento. ClientID é opcional e favorece o mesmo cliente.
+type SimilarFormsInput struct {
+	Description string    `json:"description"`
+	ClientID    uuid.UUID `json:"client_id"`
+	Limit       int       `json:"limit"`
+}
+
+type SimilarFormFeedbackInput struct {
+	FormID        uuid.UUID `json:"form_id"`
+	SimilarFormID uuid.UUID `json:"similar_form_id"`
+	UserID        uuid.UUID `json:"user_id"`
+}
+
+// SimilarFormOutput traz a semelhança do texto (Similarity) e a pontuação
+// final usada na ordem (Score), ambas de 0 a 1.
+type SimilarFormOutput struct {
+	FormID              uuid.UUID `json:"form_id"`
+	PublicCode          string    `json:"public_code"`
+	ClientID            uuid.UUID `json:"client_id"`
+	ClientName          string    `json:"client_name"`
+	DefectDescription   string    `json:"defect_description"`
+	SolutionDescription string    `json:"solution_description"`
+	Status              string    `json:"status"`
+	ResolvedAt          time.Time `json:"resolved_at"`
+	Similarity          float64   `json:"similarity"`
+	Score               float64   `json:"score"`
+	HelpfulCount        int       `json:"helpful_count"`
+}
+
+type ListSimilarFormsOutput struct {
+	Forms []SimilarFormOutput `json:"forms"`
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type SimilarFormsUseCase interface {
+	SimilarToForm(uuid.UUID, int, context.Context) (*ListSimilarFormsOutput, error)
+	SimilarToDescription(SimilarFormsInput, context.Context) (*ListSimilarFormsOutput, error)
+	MarkHelpful(SimilarFormFeedbackInput, context.Context) error
+	UnmarkHelpful(SimilarFormFeedbackInput, context.Context) error
+}
+
+type similarFormService struct {
+	repo     repository.SimilarFormRepository
+	formRepo repository.FormRepository
+	l        *zap.Logger
+}
+
+func NewSimilarFormService(repo repository.SimilarFormRepository, formRepo repository.FormRepository, l *zap.Logger) SimilarFormsUseCase {
+	return &similarFormService{
+		repo:     repo,
+		formRepo: formRepo,
+		l:        l,
+	}
+}
+
+// SimilarToForm sugere atendimentos resolvidos parecidos com o defeito do
+// atendimento aberto, favorecendo o mesmo cliente.
+func (s *similarFormService) SimilarToForm(formID uuid.UUID, limit int, ctx context.Context) (*ListSimilarFormsOutput, error) {
+	form, err := s.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			s.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return s.similar(domains.SimilarFormsQuery{
+		FormID:      form.ID,
+		ClientID:    form.Cliente.ID,
+		Description: form.DefectDescription,
+		Limit:       limit,
+	}, ctx)
+}
+
+func (s *similarFormService) SimilarToDescription(input SimilarFormsInput, ctx context.Context) (*ListSimilarFormsOutput, error) {
+	return s.similar(domains.SimilarFormsQuery{
+		ClientID:    input.ClientID,
+		Description: input.Description,
+		Limit:       input.Limit,
+	}, ctx)
+}
+
+func (s *similarFormService) similar(q domains.SimilarFormsQuery, ctx context.Context) (*ListSimilarFormsOutput, error) {
+	if q.Limit == 0 {
+		q.Limit = domains.DefaultSimilarFormsLimit
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	candidates, err := s.repo.ListSimilarFormCandidates(q, ctx)
+	if err != nil {
+		s.l.Error("error listing similar forms", zap.Error(err))
+		return nil, err
+	}
+
+	ranked := domains.RankSimilarForms(q, candidates, time.Now().UTC())
+	output := make([]SimilarFormOutput, 0, len(ranked))
+	for _, r := range ranked {
+		output = append(output, SimilarFormOutput{
+			FormID:              r.Form.ID,
+			PublicCode:          r.Form.PublicCode,
+			ClientID:            r.Form.Cliente.ID,
+			ClientName:          r.Form.Cliente.ClientName,
+			DefectDescription:   r.Form.DefectDescription,
+			SolutionDescription: r.Form.SolutionDescription,
+			Status:              r.Form.Status,
+			ResolvedAt:          r.Form.SLA.ResolvedAt,
+			Similarity:          r.Similarity,
+			Score:               r.Score,
+			HelpfulCount:        r.HelpfulCount,
+		})
+	}
+
+	return &ListSimilarFormsOutput{Forms: output}, nil
+}
+
+// MarkHelpful registra que o atendimento sugerido ajudou. Cada usuário vota
+// uma vez por par de atendimentos; os votos sobem o sugerido nas próximas
+// buscas de qualquer atendimento.
+func (s *similarFormService) MarkHelpful(input SimilarFormFeedbackInput, ctx context.Context) error {
+	feedback, err := s.feedback(input, ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.SaveSimilarFormFeedback(feedback, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			s.l.Error("error saving similar form feedback", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
+// UnmarkHelpful desfaz o voto do usuário.
+func (s *similarFormService) UnmarkHelpful(input SimilarFormFeedbackInput, ctx context.Context) error {
+	feedback, err := s.feedback(input, ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteSimilarFormFeedback(feedback, ctx); err != nil {
+		s.l.Error("error deleting similar form feedback", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// feedback valida o par e confere que os dois atendimentos existem.
+func (s *similarFormService) feedback(input SimilarFormFeedbackInput, ctx context.Context) (*domains.SimilarFormFeedback, error) {
+	feedback := &domains.SimilarFormFeedback{
+		FormID:        input.FormID,
+		SimilarFormID: input.SimilarFormID,
+		UserID:        input.UserID,
+	}
+	if err := feedback.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, id := range []uuid.UUID{feedback.FormID, feedback.SimilarFormID} {
+		if _, err := s.formRepo.FindFormByID(id, ctx); err != nil {
+			if !errors.Is(err, domains.ErrFormNotFound) {
+				s.l.Error("error getting form", zap.Error(err))
+			}
+			return nil, err
+		}
+	}
+
+	return feedback, nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UpdateSLAPolicyInput struct {
+	DifficultyLevel   string `json:"difficulty_level"`
+	ClientType        string `json:"client_type"`
+	ResponseMinutes   int    `json:"response_minutes"`
+	ResolutionMinutes int    `json:"resolution_minutes"`
+}
+
+type SLAPolicyOutput struct {
+	DifficultyLevel   string    `json:"difficulty_level"`
+	ClientType        string    `json:"client_type"`
+	ResponseMinutes   int       `json:"response_minutes"`
+	ResolutionMinutes int       `json:"resolution_minutes"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type ListSLAPoliciesOutput struct {
+	Policies []SLAPolicyOutput `json:"policies"`
+}
+
+// CreateHolidayInput traz o dia do feriado; o horário de Date é ignorado.
+type CreateHolidayInput struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+}
+
+type HolidayOutput struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+}
+
+type ListHolidaysOutput struct {
+	Holidays []HolidayOutput `json:"holidays"`
+}
+
+// FormSLAOutput traz a situação do SLA e os prazos do atendimento; State é
+// vazio quando o atendimento não tem SLA.
+type FormSLAOutput struct {
+	State           string    `json:"state"`
+	ResponseDueAt   time.Time `json:"response_due_at"`
+	ResolutionDueAt time.Time `json:"resolution_due_at"`
+	RespondedAt     time.Time `json:"responded_at"`
+	ResolvedAt      time.Time `json:"resolved_at"`
+}
+
+// ListNotificationsInput filtra as notificações do usuário; Limit zero usa o
+// tamanho padrão.
+type ListNotificationsInput struct {
+	UserID     uuid.UUID `json:"user_id"`
+	UnreadOnly bool      `json:"unread_only"`
+	Limit      int       `json:"limit"`
+}
+
+type NotificationOutput struct {
+	ID         uuid.UUID `json:"id"`
+	FormID     uuid.UUID `json:"form_id"`
+	PublicCode string    `json:"public_code"`
+	Kind       string    `json:"kind"`
+	Message    string    `json:"message"`
+	CreatedAt  time.Time `json:"created_at"`
+	ReadAt     time.Time `json:"read_at"`
+}
+
+type ListNotificationsOutput struct {
+	Notifications []NotificationOutput `json:"notifications"`
+}
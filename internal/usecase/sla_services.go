@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Textos das notificações do monitor de SLA.
+const (
+	slaAtRiskMessage   = "Atendimento de %s em risco de violar o SLA"
+	slaBreachedMessage = "Atendimento de %s com SLA violado"
+)
+
+type SLAUseCase interface {
+	ListPolicies(context.Context) (*ListSLAPoliciesOutput, error)
+	UpdatePolicy(UpdateSLAPolicyInput, context.Context) (*SLAPolicyOutput, error)
+	ListHolidays(time.Time, context.Context) (*ListHolidaysOutput, error)
+	CreateHoliday(CreateHolidayInput, context.Context) error
+	DeleteHoliday(time.Time, context.Context) error
+	CheckSLA(time.Time, context.Context) (int, error)
+}
+
+type slaService struct {
+	repo repository.SLARepository
+	l    *zap.Logger
+}
+
+func NewSLAService(repo repository.SLARepository, l *zap.Logger) SLAUseCase {
+	return &slaService{
+		repo: repo,
+		l:    l,
+	}
+}
+
+func (s *slaService) ListPolicies(ctx context.Context) (*ListSLAPoliciesOutput, error) {
+	policies, err := s.repo.ListSLAPolicies(ctx)
+	if err != nil {
+		s.l.Error("error listing sla policies", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]SLAPolicyOutput, 0, len(policies))
+	for _, p := range policies {
+		output = append(output, toSLAPolicyOutput(p))
+	}
+
+	return &ListSLAPoliciesOutput{Policies: output}, nil
+}
+
+// UpdatePolicy altera os prazos de um nível e tipo de cliente. Os novos prazos
+// valem para os atendimentos abertos a partir de então.
+func (s *slaService) UpdatePolicy(input UpdateSLAPolicyInput, ctx context.Context) (*SLAPolicyOutput, error) {
+	policy := &domains.SLAPolicy{
+		DifficultyLevel:   input.DifficultyLevel,
+		ClientType:        input.ClientType,
+		ResponseMinutes:   input.ResponseMinutes,
+		ResolutionMinutes: input.ResolutionMinutes,
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SaveSLAPolicy(policy, ctx); err != nil {
+		s.l.Error("error saving sla policy", zap.Error(err))
+		return nil, err
+	}
+
+	output := toSLAPolicyOutput(policy)
+	return &output, nil
+}
+func (s *slaService) ListHolidays(from time.Time, ctx context.Context) (*ListHolidaysOutput, error) {
+	holidays, err := s.repo.ListHolidays(from, ctx)
+	if err != nil {
+		s.l.Error("error listing holidays", zap.Error(err))
+		return nil, err
+	}
+
+	output := make([]HolidayOutput, 0, len(holidays))
+	for _, h := range holidays {
+		output = append(output, HolidayOutput{
+			Date: h.Date,
+			Name: h.Name,
+		})
+	}
+
+	return &ListHolidaysOutput{Holidays: output}, nil
+}
+func (s *slaService) CreateHoliday(input CreateHolidayInput, ctx context.Context) error {
+	y, m, d := input.Date.Date()
+	holiday := &domains.Holiday{
+		Date: time.Date(y, m, d, 0, 0, 0, 0, time.UTC),
+		Name: input.Name,
+	}
+	if err := holiday.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.repo.SaveHoliday(holiday, ctx); err != nil {
+		if !errors.Is(err, domains.ErrHolidayAlreadyExists) {
+			s.l.Error("error creating holiday", zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+func (s *slaService) DeleteHoliday(date time.Time, ctx context.Context) error {
+	if err := s.repo.DeleteHoliday(date, ctx); err != nil {
+		if !errors.Is(err, domains.ErrHolidayNotFound) {
+			s.l.Error("error deleting holiday", zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// CheckSLA procura os atendimentos que passaram a estar em risco ou violados
+// desde a última verificação e notifica os técnicos de cada um. Devolve
+// quantos atendimentos foram notificados.
+func (s *slaService) CheckSLA(now time.Time, ctx context.Context) (int, error) {
+	flags, err := s.repo.ListSLAFlags(now, ctx)
+	if err != nil {
+		s.l.Error("error listing sla flags", zap.Error(err))
+		return 0, err
+	}
+
+	notified := 0
+	for _, flag := range flags {
+		if !flag.NeedsNotification() {
+			continue
+		}
+		message := fmt.Sprintf(slaAtRiskMessage, flag.ClientName)
+		if flag.State == domains.SLAStateBreached {
+			message = fmt.Sprintf(slaBreachedMessage, flag.ClientName)
+		}
+
+		ok, err := s.repo.FlagFormSLA(flag, message, ctx)
+		if err != nil {
+			s.l.Error("error flagging form sla", zap.Error(err), zap.String("form_id", flag.FormID.String()))
+			return notified, err
+		}
+		if ok {
+			notified++
+		}
+	}
+
+	return notified, nil
+}
+
+// computeFormSLA calcula os prazos do atendimento, mantendo a primeira
+// resposta e a resolução já registradas. O contrato do atendimento tem
+// precedência: seus prazos, em horas úteis, valem no lugar da política. Sem
+// contrato vale a política do nível e tipo de cliente, e sem política o
+// atendimento fica sem SLA.
+func computeFormSLA(slaRepo repository.SLARepository, clientRepo repository.ClientRepository, contractRepo repository.ContractRepository, hours domains.BusinessHours, form *domains.Atendimentos, ctx context.Context) error {
+	client, err := clientRepo.FindClientByID(form.Cliente.ID, ctx)
+	if err != nil {
+		return err
+	}
+
+	var policy *domains.SLAPolicy
+	if form.ContractID != uuid.Nil {
+		contract, err := contractRepo.FindContractByID(form.ContractID, ctx)
+		switch {
+		case err == nil:
+			policy = contract.SLAPolicy()
+		case !errors.Is(err, domains.ErrContractNotFound):
+			return err
+		}
+	}
+	if policy == nil {
+		policy, err = slaRepo.FindSLAPolicy(form.DifficultyLevel, client.ClientType, ctx)
+		if err != nil && !errors.Is(err, domains.ErrSLAPolicyNotFound) {
+			return err
+		}
+	}
+
+	sla := domains.FormSLA{}
+	if policy != nil {
+		holidays, err := slaRepo.ListHolidays(form.DataDeAbertura, ctx)
+		if err != nil {
+			return err
+		}
+		sla = domains.NewFormSLA(policy, domains.NewBusinessCalendar(hours, holidays), form.DataDeAbertura)
+	}
+
+	sla.RespondedAt = form.SLA.RespondedAt
+	sla.ResolvedAt = form.SLA.ResolvedAt
+	form.SLA = sla
+	return nil
+}
+
+func toFormSLAOutput(form *domains.Atendimentos, now time.Time) FormSLAOutput {
+	return FormSLAOutput{
+		State:           form.SLAState(now),
+		ResponseDueAt:   form.SLA.ResponseDueAt,
+		ResolutionDueAt: form.SLA.ResolutionDueAt,
+		RespondedAt:     form.SLA.RespondedAt,
+		ResolvedAt:      form.SLA.ResolvedAt,
+	}
+}
+
+func toSLAPolicyOutput(p *domains.SLAPolicy) SLAPolicyOutput {
+	return SLAPolicyOutput{
+		DifficultyLevel:   p.DifficultyLevel,
+		ClientType:        p.ClientType,
+		ResponseMinutes:   p.ResponseMinutes,
+		ResolutionMinutes: p.ResolutionMinutes,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
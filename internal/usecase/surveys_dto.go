@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AnswerSurveyInput struct {
+	CSATScore int    `json:"csat_score"`
+	NPSScore  int    `json:"nps_score"`
+	Comment   string `json:"comment"`
+}
+
+type SurveySummaryInput struct {
+	GroupBy     string    `json:"group_by"`
+	Granularity string    `json:"granularity"`
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	ClientID    uuid.UUID `json:"client_id"`
+	MemberID    uuid.UUID `json:"member_id"`
+}
+
+// PublicSurveyOutput é o que o cliente vê ao abrir o link: só os dados do
+// atendimento necessários para reconhecê-lo.
+type PublicSurveyOutput struct {
+	FormCode     string    `json:"form_code"`
+	ClientName   string    `json:"client_name"`
+	Technicians  []string  `json:"technicians"`
+	ResolvedAt   time.Time `json:"resolved_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	Answered     bool      `json:"answered"`
+	MinCSATScore int       `json:"min_csat_score"`
+	MaxCSATScore int       `json:"max_csat_score"`
+	MinNPSScore  int       `json:"min_nps_score"`
+	MaxNPSScore  int       `json:"max_nps_score"`
+}
+
+// SurveyOutput traz SentAt, ExpiresAt e RespondedAt zero e notas zero
+// enquanto a pesquisa não foi enviada ou respondida.
+type SurveyOutput struct {
+	ID           uuid.UUID `json:"id"`
+	FormID       uuid.UUID `json:"form_id"`
+	Status       string    `json:"status"`
+	SentTo       string    `json:"sent_to"`
+	SendAttempts int       `json:"send_attempts"`
+	SentAt       time.Time `json:"sent_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CSATScore    int       `json:"csat_score"`
+	NPSScore     int       `json:"nps_score"`
+	Comment      string    `json:"comment"`
+	RespondedAt  time.Time `json:"responded_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type SurveyTotalOutput struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	PeriodStart  time.Time `json:"period_start"`
+	Sent         int       `json:"sent"`
+	Answered     int       `json:"answered"`
+	ResponseRate float64   `json:"response_rate"`
+	CSATAverage  float64   `json:"csat_average"`
+	CSATPercent  float64   `json:"csat_percent"`
+	NPS          float64   `json:"nps"`
+	Promoters    int       `json:"promoters"`
+	Passives     int       `json:"passives"`
+	Detractors   int       `json:"detractors"`
+}
+
+// SurveySummaryOutput traz os grupos do resumo. No agrupamento por técnico um
+// atendimento com vários técnicos conta uma vez para cada um.
+type SurveySummaryOutput struct {
+	GroupBy     string              `json:"group_by"`
+	Granularity string              `json:"granularity"`
+	Groups      []SurveyTotalOutput `json:"groups"`
+}
@@ -0,0 +1,305 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/resend/resend-go/v3"
+	"go.uber.org/zap"
+)
+
+// surveyPath é o caminho, no front-end, da página que responde a pesquisa.
+const surveyPath = "/pesquisa/"
+
+type SurveysUseCase interface {
+	SendPendingSurveys(time.Time, context.Context) (int, error)
+	GetPublicSurvey(string, context.Context) (*PublicSurveyOutput, error)
+	AnswerSurvey(string, AnswerSurveyInput, context.Context) error
+	GetFormSurvey(uuid.UUID, context.Context) (*SurveyOutput, error)
+	SurveySummary(SurveySummaryInput, context.Context) (*SurveySummaryOutput, error)
+}
+
+type surveyService struct {
+	repo        repository.SurveyRepository
+	formRepo    repository.FormRepository
+	mail        *resend.Client
+	from        string
+	frontendURL string
+	ttl         time.Duration
+	loc         *time.Location
+	l           *zap.Logger
+}
+
+// NewSurveyService recebe o remetente dos e-mails, o endereço do front-end
+// usado no link, a validade do link (não positiva usa
+// domains.DefaultSurveyTTL) e o fuso dos períodos do resumo.
+func NewSurveyService(repo repository.SurveyRepository, formRepo repository.FormRepository, mail *resend.Client, from, frontendURL string, ttl time.Duration, loc *time.Location, l *zap.Logger) SurveysUseCase {
+	if ttl <= 0 {
+		ttl = domains.DefaultSurveyTTL
+	}
+	return &surveyService{
+		repo:        repo,
+		formRepo:    formRepo,
+		mail:        mail,
+		from:        from,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
+		ttl:         ttl,
+		loc:         loc,
+		l:           l,
+	}
+}
+
+// SendPendingSurveys envia as pesquisas dos atendimentos resolvidos e devolve
+// quantas foram enviadas. Pesquisas enfileiradas há mais tempo que a validade
+// do link deixam de ser enviadas; uma falha de envio conta uma tentativa e a
+// pesquisa volta a ser tentada na próxima rodada. Pesquisas reservadas por
+// outra instância são ignoradas.
+func (s *surveyService) SendPendingSurveys(now time.Time, ctx context.Context) (int, error) {
+	surveys, err := s.repo.ListPendingSurveys(now.Add(-s.ttl), domains.SurveySendBatchSize, ctx)
+	if err != nil {
+		s.l.Error("error listing pending surveys", zap.Error(err))
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	for _, survey := range surveys {
+		if err := s.send(survey, now, ctx); err != nil {
+			if errors.Is(err, domains.ErrSurveyNotFound) {
+				continue
+			}
+			s.l.Error("error sending satisfaction survey", zap.Error(err), zap.String("survey_id", survey.ID.String()))
+			errs = append(errs, err)
+			continue
+		}
+		sent++
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// send gera o token, reserva a pesquisa e só então envia o e-mail, para que
+// duas instâncias não enviem a mesma pesquisa. Se o e-mail falhar, a
+// pesquisa volta para a fila. O token só existe no e-mail; o banco guarda o
+// hash.
+func (s *surveyService) send(survey *domains.SatisfactionSurvey, now time.Time, ctx context.Context) error {
+	token, hash, err := domains.NewSurveyToken()
+	if err != nil {
+		return err
+	}
+
+	survey.TokenHash = hash
+	survey.SentTo = survey.ContactEmail
+	survey.SentAt = now
+	survey.ExpiresAt = now.Add(s.ttl)
+	if err := s.repo.ClaimSurvey(survey, ctx); err != nil {
+		return err
+	}
+
+	subject, text, body := s.surveyEmail(survey, s.frontendURL+surveyPath+token)
+	if _, err := s.mail.Emails.SendWithContext(ctx, &resend.SendEmailRequest{
+		From:    s.from,
+		To:      []string{survey.ContactEmail},
+		Subject: subject,
+		Html:    body,
+		Text:    text,
+	}); err != nil {
+		if releaseErr := s.repo.ReleaseSurvey(survey, context.WithoutCancel(ctx)); releaseErr != nil {
+			s.l.Error("error releasing survey after send failure", zap.Error(releaseErr), zap.String("survey_id", survey.ID.String()))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *surveyService) surveyEmail(survey *domains.SatisfactionSurvey, link string) (subject, text, body string) {
+	name := survey.ContactName
+	if name == "" {
+		name = survey.ClientName
+	}
+	days := int(s.ttl.Hours() / 24)
+
+	subject = fmt.Sprintf("Como foi o atendimento %s?", survey.FormCode)
+	text = fmt.Sprintf("Olá, %s.\n\n"+
+		"O atendimento %s foi concluído. Conte como foi respondendo a uma pesquisa rápida:\n\n%s\n\n"+
+		"O link é válido por %d dias.\n", name, survey.FormCode, link, days)
+	body = fmt.Sprintf("<p>Olá, %s.</p>"+
+		"<p>O atendimento <strong>%s</strong> foi concluído. Conte como foi respondendo a uma pesquisa rápida:</p>"+
+		"<p><a href=\"%s\">Responder à pesquisa</a></p>"+
+		"<p>O link é válido por %d dias.</p>",
+		html.EscapeString(name), html.EscapeString(survey.FormCode), html.EscapeString(link), days)
+	return subject, text, body
+}
+
+// GetPublicSurvey devolve a pesquisa do link. Pesquisas expiradas resultam em
+// ErrSurveyExpired; as já respondidas são devolvidas com Answered verdadeiro.
+func (s *surveyService) GetPublicSurvey(token string, ctx context.Context) (*PublicSurveyOutput, error) {
+	survey, err := s.findByToken(token, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	answered := survey.Status(now) == domains.SurveyStatusAnswered
+	if err := survey.CanAnswer(now); err != nil && !answered {
+		return nil, err
+	}
+
+	form, err := s.formRepo.FindFormByID(survey.FormID, ctx)
+	if err != nil {
+		if errors.Is(err, domains.ErrFormNotFound) {
+			return nil, domains.ErrSurveyNotFound
+		}
+		s.l.Error("error getting survey form", zap.Error(err))
+		return nil, err
+	}
+
+	technicians := make([]string, 0, len(form.TecnicoResponsavelId))
+	for _, m := range form.TecnicoResponsavelId {
+		technicians = append(technicians, m.Name)
+	}
+
+	return &PublicSurveyOutput{
+		FormCode:     survey.FormCode,
+		ClientName:   survey.ClientName,
+		Technicians:  technicians,
+		ResolvedAt:   survey.CreatedAt,
+		ExpiresAt:    survey.ExpiresAt,
+		Answered:     answered,
+		MinCSATScore: domains.MinCSATScore,
+		MaxCSATScore: domains.MaxCSATScore,
+		MinNPSScore:  domains.MinNPSScore,
+		MaxNPSScore:  domains.MaxNPSScore,
+	}, nil
+}
+
+// AnswerSurvey grava a resposta do cliente; cada pesquisa aceita uma única
+// resposta, dentro da validade do link.
+func (s *surveyService) AnswerSurvey(token string, input AnswerSurveyInput, ctx context.Context) error {
+	answer := domains.SurveyAnswer{
+		CSATScore: input.CSATScore,
+		NPSScore:  input.NPSScore,
+		Comment:   input.Comment,
+	}
+	answer.Normalize()
+	if err := answer.Validate(); err != nil {
+		return err
+	}
+
+	survey, err := s.findByToken(token, ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := survey.CanAnswer(now); err != nil {
+		return err
+	}
+
+	if err := s.repo.AnswerSurvey(survey.ID, answer, now, ctx); err != nil {
+		if !errors.Is(err, domains.ErrSurveyAlreadyAnswered) {
+			s.l.Error("error answering survey", zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *surveyService) findByToken(token string, ctx context.Context) (*domains.SatisfactionSurvey, error) {
+	if token == "" {
+		return nil, domains.ErrSurveyNotFound
+	}
+
+	survey, err := s.repo.FindSurveyByToken(token, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrSurveyNotFound) {
+			s.l.Error("error getting survey by token", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return survey, nil
+}
+
+func (s *surveyService) GetFormSurvey(formID uuid.UUID, ctx context.Context) (*SurveyOutput, error) {
+	survey, err := s.repo.FindSurveyByForm(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrSurveyNotFound) {
+			s.l.Error("error getting form survey", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &SurveyOutput{
+		ID:           survey.ID,
+		FormID:       survey.FormID,
+		Status:       survey.Status(time.Now()),
+		SentTo:       survey.SentTo,
+		SendAttempts: survey.SendAttempts,
+		SentAt:       survey.SentAt,
+		ExpiresAt:    survey.ExpiresAt,
+		CSATScore:    survey.CSATScore,
+		NPSScore:     survey.NPSScore,
+		Comment:      survey.Comment,
+		RespondedAt:  survey.RespondedAt,
+		CreatedAt:    survey.CreatedAt,
+	}, nil
+}
+
+// SurveySummary agrupa as pesquisas enviadas no período por técnico, cliente
+// ou período, com taxa de resposta, CSAT e NPS de cada grupo.
+func (s *surveyService) SurveySummary(input SurveySummaryInput, ctx context.Context) (*SurveySummaryOutput, error) {
+	filter := domains.SurveySummaryFilter{
+		GroupBy:     input.GroupBy,
+		Granularity: input.Granularity,
+		From:        input.From,
+		To:          input.To,
+		ClientID:    input.ClientID,
+		MemberID:    input.MemberID,
+	}
+	if filter.GroupBy != domains.SurveyGroupPeriod {
+		filter.Granularity = ""
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	totals, err := s.repo.SurveySummary(filter, s.loc.String(), ctx)
+	if err != nil {
+		s.l.Error("error getting survey summary", zap.Error(err))
+		return nil, err
+	}
+
+	groups := make([]SurveyTotalOutput, 0, len(totals))
+	for _, t := range totals {
+		groups = append(groups, SurveyTotalOutput{
+			ID:           t.ID,
+			Name:         t.Name,
+			PeriodStart:  t.PeriodStart,
+			Sent:         t.Sent,
+			Answered:     t.Answered,
+			ResponseRate: t.ResponseRate(),
+			CSATAverage:  t.CSATAverage(),
+			CSATPercent:  t.CSATPercent(),
+			NPS:          t.NPS(),
+			Promoters:    t.Promoters,
+			Passives:     t.Passives,
+			Detractors:   t.Detractors,
+		})
+	}
+
+	return &SurveySummaryOutput{
+		GroupBy:     filter.GroupBy,
+		Granularity: filter.Granularity,
+		Groups:      groups,
+	}, nil
+}
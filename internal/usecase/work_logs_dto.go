@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type StartWorkLogInput struct {
+	MemberID  uuid.UUID `json:"member_id"`
+	Kind      string    `json:"kind"`
+	Notes     string    `json:"notes"`
+	CreatedBy uuid.UUID `json:"created_by"`
+}
+
+type CreateWorkLogInput struct {
+	MemberID  uuid.UUID `json:"member_id"`
+	Kind      string    `json:"kind"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	Notes     string    `json:"notes"`
+	CreatedBy uuid.UUID `json:"created_by"`
+}
+
+// StopWorkLogInput encerra o cronômetro agora quando EndedAt é nil e mantém
+// as observações quando Notes é nil.
+type StopWorkLogInput struct {
+	EndedAt *time.Time `json:"ended_at"`
+	Notes   *string    `json:"notes"`
+}
+
+type WorkLogTotalsInput struct {
+	GroupBy  string    `json:"group_by"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	ClientID uuid.UUID `json:"client_id"`
+	MemberID uuid.UUID `json:"member_id"`
+}
+
+// WorkLogOutput traz EndedAt zero e Running verdadeiro para cronômetros em
+// andamento; nesse caso DurationSeconds é o tempo decorrido até a consulta.
+type WorkLogOutput struct {
+	ID              uuid.UUID `json:"id"`
+	MemberID        uuid.UUID `json:"member_id"`
+	MemberName      string    `json:"member_name"`
+	Kind            string    `json:"kind"`
+	Source          string    `json:"source"`
+	StartedAt       time.Time `json:"started_at"`
+	EndedAt         time.Time `json:"ended_at"`
+	Running         bool      `json:"running"`
+	DurationSeconds int64     `json:"duration_seconds"`
+	Notes           string    `json:"notes"`
+	CreatedBy       uuid.UUID `json:"created_by"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type WorkLogTotalOutput struct {
+	ID            uuid.UUID       `json:"id"`
+	Name          string          `json:"name"`
+	TravelSeconds int64           `json:"travel_seconds"`
+	OnSiteSeconds int64           `json:"on_site_seconds"`
+	TotalSeconds  int64           `json:"total_seconds"`
+	TotalHours    decimal.Decimal `json:"total_hours"`
+}
+
+// ListWorkLogsOutput traz os apontamentos do atendimento e o total dos
+// encerrados.
+type ListWorkLogsOutput struct {
+	WorkLogs []WorkLogOutput    `json:"work_logs"`
+	Total    WorkLogTotalOutput `json:"total"`
+}
+
+type WorkLogTotalsOutput struct {
+	GroupBy string               `json:"group_by"`
+	Totals  []WorkLogTotalOutput `json:"totals"`
+}
@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"olidesk-api-2/internal/domains"
+	"olidesk-api-2/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+type WorkLogsUseCase interface {
+	StartWorkLog(uuid.UUID, StartWorkLogInput, context.Context) (uuid.UUID, error)
+	StopWorkLog(uuid.UUID, uuid.UUID, StopWorkLogInput, context.Context) error
+	CreateWorkLog(uuid.UUID, CreateWorkLogInput, context.Context) (uuid.UUID, error)
+	DeleteWorkLog(uuid.UUID, uuid.UUID, context.Context) error
+	ListWorkLogs(uuid.UUID, context.Context) (*ListWorkLogsOutput, error)
+	WorkLogTotals(WorkLogTotalsInput, context.Context) (*WorkLogTotalsOutput, error)
+}
+
+type workLogService struct {
+	repo     repository.WorkLogRepository
+	formRepo repository.FormRepository
+	l        *zap.Logger
+}
+
+func NewWorkLogService(repo repository.WorkLogRepository, formRepo repository.FormRepository, l *zap.Logger) WorkLogsUseCase {
+	return &workLogService{
+		repo:     repo,
+		formRepo: formRepo,
+		l:        l,
+	}
+}
+
+// StartWorkLog inicia um cronômetro para o técnico. Um técnico só pode ter um
+// cronômetro em andamento, em qualquer atendimento.
+func (w *workLogService) StartWorkLog(formID uuid.UUID, input StartWorkLogInput, ctx context.Context) (uuid.UUID, error) {
+	now := time.Now().UTC()
+	return w.save(&domains.WorkLog{
+		FormID:    formID,
+		MemberID:  input.MemberID,
+		Kind:      input.Kind,
+		Source:    domains.WorkLogSourceTimer,
+		StartedAt: now,
+		Notes:     input.Notes,
+		CreatedBy: input.CreatedBy,
+	}, now, ctx)
+}
+
+// CreateWorkLog registra um apontamento manual, já com início e fim.
+func (w *workLogService) CreateWorkLog(formID uuid.UUID, input CreateWorkLogInput, ctx context.Context) (uuid.UUID, error) {
+	if input.EndedAt.IsZero() {
+		return uuid.Nil, domains.ErrInvalidWorkLogPeriod
+	}
+	return w.save(&domains.WorkLog{
+		FormID:    formID,
+		MemberID:  input.MemberID,
+		Kind:      input.Kind,
+		Source:    domains.WorkLogSourceManual,
+		StartedAt: input.StartedAt.UTC(),
+		EndedAt:   input.EndedAt.UTC(),
+		Notes:     input.Notes,
+		CreatedBy: input.CreatedBy,
+	}, time.Now().UTC(), ctx)
+}
+func (w *workLogService) save(log *domains.WorkLog, now time.Time, ctx context.Context) (uuid.UUID, error) {
+	if err := log.Validate(now); err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := w.formRepo.FindFormByID(log.FormID, ctx); err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			w.l.Error("error getting form", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+
+	if err := w.repo.SaveWorkLog(log, ctx); err != nil {
+		if !errors.Is(err, domains.ErrWorkLogOverlap) && !errors.Is(err, domains.ErrMemberNotFound) {
+			w.l.Error("error saving work log", zap.Error(err))
+		}
+		return uuid.Nil, err
+	}
+	return log.ID, nil
+}
+func (w *workLogService) StopWorkLog(formID, id uuid.UUID, input StopWorkLogInput, ctx context.Context) error {
+	log, err := w.repo.FindWorkLog(formID, id, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrWorkLogNotFound) {
+			w.l.Error("error getting work log", zap.Error(err))
+		}
+		return err
+	}
+
+	now := time.Now().UTC()
+	at := now
+	if input.EndedAt != nil {
+		at = input.EndedAt.UTC()
+	}
+	if input.Notes != nil {
+		log.Notes = *input.Notes
+	}
+	if err := log.Stop(at, now); err != nil {
+		return err
+	}
+
+	if err := w.repo.StopWorkLog(log, ctx); err != nil {
+		if !errors.Is(err, domains.ErrWorkLogNotRunning) {
+			w.l.Error("error stopping work log", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+func (w *workLogService) DeleteWorkLog(formID, id uuid.UUID, ctx context.Context) error {
+	if err := w.repo.DeleteWorkLog(formID, id, ctx); err != nil {
+		if !errors.Is(err, domains.ErrWorkLogNotFound) {
+			w.l.Error("error deleting work log", zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+func (w *workLogService) ListWorkLogs(formID uuid.UUID, ctx context.Context) (*ListWorkLogsOutput, error) {
+	form, err := w.formRepo.FindFormByID(formID, ctx)
+	if err != nil {
+		if !errors.Is(err, domains.ErrFormNotFound) {
+			w.l.Error("error getting form", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	logs, err := w.repo.ListWorkLogs(formID, ctx)
+	if err != nil {
+		w.l.Error("error listing work logs", zap.Error(err))
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	out := make([]WorkLogOutput, 0, len(logs))
+	for _, l := range logs {
+		out = append(out, WorkLogOutput{
+			ID:              l.ID,
+			MemberID:        l.MemberID,
+			MemberName:      l.MemberName,
+			Kind:            l.Kind,
+			Source:          l.Source,
+			StartedAt:       l.StartedAt,
+			EndedAt:         l.EndedAt,
+			Running:         l.Running(),
+			DurationSeconds: int64(l.Duration(now) / time.Second),
+			Notes:           l.Notes,
+			CreatedBy:       l.CreatedBy,
+			CreatedAt:       l.CreatedAt,
+		})
+	}
+
+	total := domains.SumWorkLogs(logs)
+	total.ID = form.ID
+	total.Name = form.Cliente.ClientName
+
+	return &ListWorkLogsOutput{
+		WorkLogs: out,
+		Total:    toWorkLogTotalOutput(total),
+	}, nil
+}
+
+// WorkLogTotals soma os apontamentos encerrados por atendimento, técnico ou
+// cliente no período informado.
+func (w *workLogService) WorkLogTotals(input WorkLogTotalsInput, ctx context.Context) (*WorkLogTotalsOutput, error) {
+	filter := domains.WorkLogTotalsFilter{
+		GroupBy:  input.GroupBy,
+		From:     input.From,
+		To:       input.To,
+		ClientID: input.ClientID,
+		MemberID: input.MemberID,
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	totals, err := w.repo.WorkLogTotals(filter, ctx)
+	if err != nil {
+		w.l.Error("error getting work log totals", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]WorkLogTotalOutput, 0, len(totals))
+	for _, t := range totals {
+		out = append(out, toWorkLogTotalOutput(t))
+	}
+
+	return &WorkLogTotalsOutput{GroupBy: input.GroupBy, Totals: out}, nil
+}
+
+func toWorkLogTotalOutput(t domains.WorkLogTotal) WorkLogTotalOutput {
+	return WorkLogTotalOutput{
+		ID:            t.ID,
+		Name:          t.Name,
+		TravelSeconds: t.TravelSeconds,
+		OnSiteSeconds: t.OnSiteSeconds,
+		TotalSeconds:  t.TotalSeconds(),
+		TotalHours:    decimal.NewFromInt(t.TotalSeconds()).Div(decimal.NewFromInt(3600)).Round(2),
+	}
+}
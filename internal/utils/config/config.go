@@ -15,9 +15,58 @@ type Config struct {
 	Database     DatabaseConfig
 	Server       ServerConfig
 	Redis        RedisConfig
+	Storage      StorageConfig
+	SLA          SLAConfig
+	Maintenance  MaintenanceConfig
+	Survey       SurveyConfig
 	ResendAPIKey string
 }
 
+// StorageConfig configura onde ficam os anexos: "local" grava em LocalDir e
+// "s3" em um bucket compatível com S3 (AWS, MinIO).
+type StorageConfig struct {
+	Backend    string
+	LocalDir   string
+	SigningKey string
+	URLTTL     time.Duration
+
+	S3Endpoint     string
+	S3Region       string
+	S3Bucket       string
+	S3AccessKey    string
+	S3SecretKey    string
+	S3UsePathStyle bool
+}
+
+// SLAConfig define o expediente usado nos prazos de SLA: das BusinessStart às
+// BusinessEnd (HH:MM) nos Workdays (0 = domingo, separados por vírgula), no
+// fuso Timezone. O monitor verifica os atendimentos a cada CheckInterval.
+type SLAConfig struct {
+	Timezone      string
+	BusinessStart string
+	BusinessEnd   string
+	Workdays      string
+	CheckInterval time.Duration
+}
+
+// MaintenanceConfig configura o agendador dos planos de manutenção: a cada
+// Interval ele gera os atendimentos das visitas até Horizon à frente. O
+// horário das visitas segue o fuso de SLAConfig.
+type MaintenanceConfig struct {
+	Horizon  time.Duration
+	Interval time.Duration
+}
+
+// SurveyConfig configura o envio das pesquisas de satisfação: a cada Interval
+// as pesquisas pendentes são enviadas por e-mail a partir de From, com links
+// válidos por TTL. O link aponta para FrontendUrl; sem From ou sem a chave
+// do Resend as pesquisas ficam na fila.
+type SurveyConfig struct {
+	TTL      time.Duration
+	Interval time.Duration
+	From     string
+}
+
 type RedisConfig struct {
 	Host         string
 	User         string
@@ -89,6 +138,34 @@ func Load() *Config {
 			MinIdleConns: getEnvAsInt("REDIS_MIN_IDLE_CONNS", 2),
 			MaxConnAge:   getEnvAsDuration("REDIS_MAX_CONN_AGE", "5m"),
 		},
+		Storage: StorageConfig{
+			Backend:        getEnv("STORAGE_BACKEND", "local"),
+			LocalDir:       getEnv("STORAGE_LOCAL_DIR", "./data/attachments"),
+			SigningKey:     getEnv("STORAGE_SIGNING_KEY", getEnv("JWT_SECRET", "")),
+			URLTTL:         getEnvAsDuration("STORAGE_URL_TTL", "15m"),
+			S3Endpoint:     getEnv("S3_ENDPOINT", ""),
+			S3Region:       getEnv("S3_REGION", "us-east-1"),
+			S3Bucket:       getEnv("S3_BUCKET", ""),
+			S3AccessKey:    getEnv("S3_ACCESS_KEY", ""),
+			S3SecretKey:    getEnv("S3_SECRET_KEY", ""),
+			S3UsePathStyle: getEnv("S3_USE_PATH_STYLE", "true") == "true",
+		},
+		SLA: SLAConfig{
+			Timezone:      getEnv("SLA_TIMEZONE", "America/Sao_Paulo"),
+			BusinessStart: getEnv("SLA_BUSINESS_START", "08:00"),
+			BusinessEnd:   getEnv("SLA_BUSINESS_END", "18:00"),
+			Workdays:      getEnv("SLA_WORKDAYS", "1,2,3,4,5"),
+			CheckInterval: getEnvAsDuration("SLA_CHECK_INTERVAL", "1m"),
+		},
+		Maintenance: MaintenanceConfig{
+			Horizon:  getEnvAsDuration("MAINTENANCE_HORIZON", "336h"),
+			Interval: getEnvAsDuration("MAINTENANCE_INTERVAL", "1h"),
+		},
+		Survey: SurveyConfig{
+			TTL:      getEnvAsDuration("SURVEY_TTL", "168h"),
+			Interval: getEnvAsDuration("SURVEY_INTERVAL", "1m"),
+			From:     getEnv("SURVEY_FROM", ""),
+		},
 		ResendAPIKey: getEnv("RESEND_API_KEY", ""),
 	}
 }
@@ -7,24 +7,44 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// ScopeClient identifica tokens emitidos para usuários do portal do cliente.
+// Tokens da equipe interna não possuem escopo.
+const ScopeClient = "cliente"
+
 type CustomClaims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
+	UserID   string `json:"user_id"`
+	Email    string `json:"email"`
+	Scope    string `json:"scope,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
 func GenerateJWT(userID, email string) (string, error) {
-	expirationTime := time.Now().Add(24 * time.Hour)
-
-	// Cria as claims do token
-	claims := &CustomClaims{
+	return signClaims(&CustomClaims{
 		UserID: userID,
 		Email:  email,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-		},
+	})
+}
+
+// GenerateClientJWT gera o token de um usuário do portal do cliente. O escopo
+// impede que o token seja aceito nas rotas da equipe interna.
+func GenerateClientJWT(clientUserID, clientID, email string) (string, error) {
+	return signClaims(&CustomClaims{
+		UserID:   clientUserID,
+		Email:    email,
+		Scope:    ScopeClient,
+		ClientID: clientID,
+	})
+}
+
+func signClaims(claims *CustomClaims) (string, error) {
+	expirationTime := time.Now().Add(24 * time.Hour)
+
+	// Define a validade do token
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expirationTime),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		NotBefore: jwt.NewNumericDate(time.Now()),
 	}
 
 	// Cria o token com as claims
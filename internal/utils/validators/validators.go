@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/go-playground/validator/v10"
 )
 
 func OneOf(v interface{}, param string) error {
@@ -21,3 +23,19 @@ func OneOf(v interface{}, param string) error {
 	}
 	return errors.New("valor inválido")
 }
+
+// CpfCnpj aceita documentos com 11 (CPF) ou 14 (CNPJ) dígitos, com ou sem
+// pontuação.
+func CpfCnpj(fl validator.FieldLevel) bool {
+	digits := 0
+	for _, r := range fl.Field().String() {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case strings.ContainsRune(".-/ ", r):
+		default:
+			return false
+		}
+	}
+	return digits == 11 || digits == 14
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// MaintenanceGenerator cria os atendimentos das visitas preventivas devidas
+// em now e devolve quantos foram criados; é implementado por
+// usecase.MaintenanceUseCase.
+type MaintenanceGenerator interface {
+	GenerateDue(time.Time, context.Context) (int, error)
+}
+
+// MaintenanceScheduler gera periodicamente os atendimentos dos planos de
+// manutenção. Várias instâncias podem rodar ao mesmo tempo: cada visita gera
+// um único atendimento.
+type MaintenanceScheduler struct {
+	generator MaintenanceGenerator
+	interval  time.Duration
+	now       func() time.Time
+	l         *zap.Logger
+}
+
+// DefaultMaintenanceInterval é usado quando o intervalo configurado não é
+// positivo.
+const DefaultMaintenanceInterval = time.Hour
+
+func NewMaintenanceScheduler(generator MaintenanceGenerator, interval time.Duration, l *zap.Logger) *MaintenanceScheduler {
+	if interval <= 0 {
+		interval = DefaultMaintenanceInterval
+	}
+	return &MaintenanceScheduler{
+		generator: generator,
+		interval:  interval,
+		now:       time.Now,
+		l:         l,
+	}
+}
+
+// Run gera na partida e depois a cada intervalo, até ctx ser cancelado.
+// Uma geração com erro não interrompe o agendador; a próxima tenta de novo.
+func (s *MaintenanceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.generate(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *MaintenanceScheduler) generate(ctx context.Context) {
+	created, err := s.generator.GenerateDue(s.now().UTC(), ctx)
+	if err != nil && ctx.Err() == nil {
+		s.l.Error("error generating maintenance visits", zap.Error(err))
+	}
+	if created > 0 {
+		s.l.Info("maintenance visits scheduled", zap.Int("forms", created))
+	}
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type fakeGenerator struct {
+	mu    sync.Mutex
+	calls []time.Time
+	err   error
+	done  chan struct{}
+	want  int
+}
+
+func (f *fakeGenerator) GenerateDue(now time.Time, _ context.Context) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, now)
+	if len(f.calls) == f.want {
+		close(f.done)
+	}
+	return 1, f.err
+}
+
+func TestMaintenanceScheduler_Run(t *testing.T) {
+	for name, generateErr := range map[string]error{"ok": nil, "keeps running after errors": errors.New("db down")} {
+		t.Run(name, func(t *testing.T) {
+			generator := &fakeGenerator{err: generateErr, done: make(chan struct{}), want: 3}
+			scheduler := NewMaintenanceScheduler(generator, time.Millisecond, zap.NewNop())
+			fixed := time.Date(2026, 3, 10, 12, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+			scheduler.now = func() time.Time { return fixed }
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stopped := make(chan struct{})
+			go func() {
+				scheduler.Run(ctx)
+				close(stopped)
+			}()
+
+			select {
+			case <-generator.done:
+			case <-time.After(time.Second):
+				t.Fatal("scheduler did not generate three times")
+			}
+			cancel()
+			select {
+			case <-stopped:
+			case <-time.After(time.Second):
+				t.Fatal("scheduler did not stop after cancel")
+			}
+
+			generator.mu.Lock()
+			defer generator.mu.Unlock()
+			assert.GreaterOrEqual(t, len(generator.calls), 3)
+			assert.Equal(t, time.UTC, generator.calls[0].Location())
+			assert.True(t, fixed.Equal(generator.calls[0]))
+		})
+	}
+}
+
+func TestNewMaintenanceScheduler_DefaultInterval(t *testing.T) {
+	assert.Equal(t, DefaultMaintenanceInterval, NewMaintenanceScheduler(&fakeGenerator{}, 0, zap.NewNop()).interval)
+}
@@ -0,0 +1,68 @@
+// Package worker reúne as tarefas em segundo plano executadas junto da API.
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SLAChecker verifica o SLA dos atendimentos em now e devolve quantos foram
+// notificados; é implementado por usecase.SLAUseCase.
+type SLAChecker interface {
+	CheckSLA(time.Time, context.Context) (int, error)
+}
+
+// SLAMonitor verifica periodicamente os atendimentos em risco ou com SLA
+// violado e gera as notificações dos técnicos.
+type SLAMonitor struct {
+	checker  SLAChecker
+	interval time.Duration
+	now      func() time.Time
+	l        *zap.Logger
+}
+
+// DefaultSLACheckInterval é usado quando o intervalo configurado não é positivo.
+const DefaultSLACheckInterval = time.Minute
+
+func NewSLAMonitor(checker SLAChecker, interval time.Duration, l *zap.Logger) *SLAMonitor {
+	if interval <= 0 {
+		interval = DefaultSLACheckInterval
+	}
+	return &SLAMonitor{
+		checker:  checker,
+		interval: interval,
+		now:      time.Now,
+		l:        l,
+	}
+}
+
+// Run verifica na partida e depois a cada intervalo, até ctx ser cancelado.
+// Uma verificação com erro não interrompe o monitor; a próxima tenta de novo.
+func (m *SLAMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *SLAMonitor) check(ctx context.Context) {
+	notified, err := m.checker.CheckSLA(m.now().UTC(), ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			m.l.Error("error checking sla", zap.Error(err))
+		}
+		return
+	}
+	if notified > 0 {
+		m.l.Info("sla notifications sent", zap.Int("forms", notified))
+	}
+}
@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type fakeChecker struct {
+	mu    sync.Mutex
+	calls []time.Time
+	err   error
+	done  chan struct{}
+	want  int
+}
+
+func (f *fakeChecker) CheckSLA(now time.Time, _ context.Context) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, now)
+	if len(f.calls) == f.want {
+		close(f.done)
+	}
+	return 1, f.err
+}
+
+func TestSLAMonitor_Run(t *testing.T) {
+	for name, checkErr := range map[string]error{"ok": nil, "keeps running after errors": errors.New("db down")} {
+		t.Run(name, func(t *testing.T) {
+			checker := &fakeChecker{err: checkErr, done: make(chan struct{}), want: 3}
+			monitor := NewSLAMonitor(checker, time.Millisecond, zap.NewNop())
+			fixed := time.Date(2026, 3, 10, 12, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+			monitor.now = func() time.Time { return fixed }
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stopped := make(chan struct{})
+			go func() {
+				monitor.Run(ctx)
+				close(stopped)
+			}()
+
+			select {
+			case <-checker.done:
+			case <-time.After(time.Second):
+				t.Fatal("monitor did not check three times")
+			}
+			cancel()
+			select {
+			case <-stopped:
+			case <-time.After(time.Second):
+				t.Fatal("monitor did not stop after cancel")
+			}
+
+			checker.mu.Lock()
+			defer checker.mu.Unlock()
+			assert.GreaterOrEqual(t, len(checker.calls), 3)
+			assert.Equal(t, time.UTC, checker.calls[0].Location())
+			assert.True(t, fixed.Equal(checker.calls[0]))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SurveySender envia as pesquisas de satisfação pendentes em now e devolve
+// quantas foram enviadas; é implementado por usecase.SurveysUseCase.
+type SurveySender interface {
+	SendPendingSurveys(time.Time, context.Context) (int, error)
+}
+
+// SurveyDispatcher envia periodicamente as pesquisas dos atendimentos
+// resolvidos. Várias instâncias podem rodar ao mesmo tempo: cada pesquisa é
+// reservada no banco antes do envio, então sai um único e-mail.
+type SurveyDispatcher struct {
+	sender   SurveySender
+	interval time.Duration
+	now      func() time.Time
+	l        *zap.Logger
+}
+
+// DefaultSurveyInterval é usado quando o intervalo configurado não é
+// positivo.
+const DefaultSurveyInterval = time.Minute
+
+func NewSurveyDispatcher(sender SurveySender, interval time.Duration, l *zap.Logger) *SurveyDispatcher {
+	if interval <= 0 {
+		interval = DefaultSurveyInterval
+	}
+	return &SurveyDispatcher{
+		sender:   sender,
+		interval: interval,
+		now:      time.Now,
+		l:        l,
+	}
+}
+
+// Run envia na partida e depois a cada intervalo, até ctx ser cancelado.
+// Um envio com erro não interrompe o despachante; a próxima rodada tenta de
+// novo.
+func (d *SurveyDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *SurveyDispatcher) dispatch(ctx context.Context) {
+	sent, err := d.sender.SendPendingSurveys(d.now().UTC(), ctx)
+	if err != nil && ctx.Err() == nil {
+		d.l.Error("error sending satisfaction surveys", zap.Error(err))
+	}
+	if sent > 0 {
+		d.l.Info("satisfaction surveys sent", zap.Int("surveys", sent))
+	}
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type fakeSender struct {
+	mu    sync.Mutex
+	calls []time.Time
+	err   error
+	done  chan struct{}
+	want  int
+}
+
+func (f *fakeSender) SendPendingSurveys(now time.Time, _ context.Context) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, now)
+	if len(f.calls) == f.want {
+		close(f.done)
+	}
+	return 1, f.err
+}
+
+func TestSurveyDispatcher_Run(t *testing.T) {
+	for name, sendErr := range map[string]error{"ok": nil, "keeps running after errors": errors.New("mail down")} {
+		t.Run(name, func(t *testing.T) {
+			sender := &fakeSender{err: sendErr, done: make(chan struct{}), want: 3}
+			dispatcher := NewSurveyDispatcher(sender, time.Millisecond, zap.NewNop())
+			fixed := time.Date(2026, 3, 10, 12, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+			dispatcher.now = func() time.Time { return fixed }
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stopped := make(chan struct{})
+			go func() {
+				dispatcher.Run(ctx)
+				close(stopped)
+			}()
+
+			select {
+			case <-sender.done:
+			case <-time.After(time.Second):
+				t.Fatal("dispatcher did not send three times")
+			}
+			cancel()
+			select {
+			case <-stopped:
+			case <-time.After(time.Second):
+				t.Fatal("dispatcher did not stop after cancel")
+			}
+
+			sender.mu.Lock()
+			defer sender.mu.Unlock()
+			assert.GreaterOrEqual(t, len(sender.calls), 3)
+			assert.Equal(t, time.UTC, sender.calls[0].Location())
+			assert.True(t, fixed.Equal(sender.calls[0]))
+		})
+	}
+}
+
+func TestNewSurveyDispatcher_DefaultInterval(t *testing.T) {
+	assert.Equal(t, DefaultSurveyInterval, NewSurveyDispatcher(&fakeSender{}, 0, zap.NewNop()).interval)
+}